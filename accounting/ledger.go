@@ -0,0 +1,784 @@
+package accounting
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/closereport"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// queryBatchSize is the number of forwarding events, payments and invoices
+// that are fetched from the database at once.
+const queryBatchSize = 1000
+
+// ErrInvalidRange is returned when the end of the requested time range lies
+// before its start.
+var ErrInvalidRange = errors.New("end time before start time")
+
+// EntryType is the type of the event that a ledger entry records.
+type EntryType uint8
+
+const (
+	// EntryTypeForward is an htlc that we forwarded. The amount of the
+	// entry is the fee that we earned.
+	EntryTypeForward EntryType = iota
+
+	// EntryTypePayment is a payment that we sent.
+	EntryTypePayment
+
+	// EntryTypeInvoice is a payment that we received.
+	EntryTypeInvoice
+
+	// EntryTypeChannelOpen is a wallet transaction that funded a channel.
+	EntryTypeChannelOpen
+
+	// EntryTypeChannelClose is a closing transaction that paid out to our
+	// wallet.
+	EntryTypeChannelClose
+
+	// EntryTypeSweep is a wallet transaction that swept the outputs of a
+	// closed channel.
+	EntryTypeSweep
+
+	// EntryTypeJustice is a justice transaction that swept the outputs of
+	// a breached channel.
+	EntryTypeJustice
+
+	// EntryTypeOnChain is any other wallet transaction, such as a send to
+	// or a receive from an external address.
+	EntryTypeOnChain
+
+	// EntryTypeChannelFee is an on-chain fee that was paid from the funds
+	// of a closed channel rather than from our wallet, such as the fee of
+	// the closing transaction or the fees of the transactions that
+	// resolved its outputs.
+	EntryTypeChannelFee
+)
+
+// String returns a human-readable name of the entry type.
+func (t EntryType) String() string {
+	switch t {
+	case EntryTypeForward:
+		return "forward"
+
+	case EntryTypePayment:
+		return "payment"
+
+	case EntryTypeInvoice:
+		return "invoice"
+
+	case EntryTypeChannelOpen:
+		return "channel_open"
+
+	case EntryTypeChannelClose:
+		return "channel_close"
+
+	case EntryTypeSweep:
+		return "sweep"
+
+	case EntryTypeJustice:
+		return "justice"
+
+	case EntryTypeOnChain:
+		return "onchain"
+
+	case EntryTypeChannelFee:
+		return "channel_fee"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Unit is the unit that the amounts of a ledger are expressed in.
+type Unit uint8
+
+const (
+	// UnitMsat expresses amounts in millisatoshis.
+	UnitMsat Unit = iota
+
+	// UnitSat expresses amounts in satoshis, with three decimals.
+	UnitSat
+
+	// UnitBTC expresses amounts in bitcoin, with eleven decimals.
+	UnitBTC
+)
+
+// decimals returns the number of decimals of an amount in the unit.
+func (u Unit) decimals() int {
+	switch u {
+	case UnitSat:
+		return 3
+
+	case UnitBTC:
+		return 11
+
+	default:
+		return 0
+	}
+}
+
+// Format returns the exact decimal representation of an amount in
+// millisatoshis in the unit.
+func (u Unit) Format(amtMsat int64) string {
+	sign := ""
+	abs := uint64(amtMsat)
+	if amtMsat < 0 {
+		sign = "-"
+		abs = -abs
+	}
+
+	digits := strconv.FormatUint(abs, 10)
+
+	decimals := u.decimals()
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	split := len(digits) - decimals
+
+	return fmt.Sprintf("%v%v.%v", sign, digits[:split], digits[split:])
+}
+
+// Entry is a single entry of the ledger.
+type Entry struct {
+	// Timestamp is the time at which the event took place. For on-chain
+	// events, this is the time of the block that confirmed the
+	// transaction.
+	Timestamp time.Time
+
+	// Type is the type of the event.
+	Type EntryType
+
+	// Amount is the amount in millisatoshis that we received (positive) or
+	// sent (negative), excluding the fees that we paid.
+	Amount int64
+
+	// Fee is the fee that we paid for the event.
+	Fee lnwire.MilliSatoshi
+
+	// IncomingChanID is the channel that the funds arrived over, if any.
+	IncomingChanID lnwire.ShortChannelID
+
+	// OutgoingChanID is the channel that the funds left over, if any.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// TxHash is the hash of the transaction of on-chain events.
+	TxHash *chainhash.Hash
+
+	// PaymentHash is the payment hash of payments and invoices.
+	PaymentHash *lntypes.Hash
+
+	// Label is the label of the transaction of on-chain events, or the
+	// memo of invoices.
+	Label string
+}
+
+// Config contains the dependencies of the Ledger.
+type Config struct {
+	// QueryForwardingLog returns a slice of our forwarding events.
+	QueryForwardingLog func(q channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// QueryPayments returns a slice of our outgoing payments.
+	QueryPayments func(q channeldb.PaymentsQuery) (
+		channeldb.PaymentsResponse, error)
+
+	// QueryInvoices returns a slice of our invoices.
+	QueryInvoices func(ctx context.Context, q invoices.InvoiceQuery) (
+		invoices.InvoiceSlice, error)
+
+	// ListTransactions returns all the transactions of our wallet.
+	ListTransactions func() ([]*lnwallet.TransactionDetail, error)
+
+	// ChannelReports returns the reports of the on-chain fees of our
+	// closed channels.
+	ChannelReports func() ([]*closereport.ChannelReport, error)
+
+	// BlockTimestamp returns the timestamp of the block at the given
+	// height.
+	BlockTimestamp func(height int32) (time.Time, error)
+}
+
+// Ledger builds a normalised ledger of the forwards, payments, invoices and
+// on-chain transactions of the node.
+type Ledger struct {
+	cfg *Config
+}
+
+// NewLedger creates a new ledger.
+func NewLedger(cfg *Config) *Ledger {
+	return &Ledger{
+		cfg: cfg,
+	}
+}
+
+// entryPage is a page of ledger entries of one source.
+type entryPage struct {
+	// entries are the entries of the page, in any order.
+	entries []*Entry
+
+	// watermark is a lower bound of the timestamps of the entries of the
+	// later pages of the source.
+	watermark time.Time
+
+	// last is true if the source has no further pages.
+	last bool
+}
+
+// entrySource returns the next page of the entries of one kind.
+type entrySource func(ctx context.Context) (*entryPage, error)
+
+// pendingEntry is an entry that was fetched, but not passed on yet.
+type pendingEntry struct {
+	*Entry
+
+	// source is the index of the source of the entry.
+	source int
+
+	// seq is the order in which the entry was fetched.
+	seq uint64
+}
+
+// entryHeap orders pending entries by their timestamp. Entries with the same
+// timestamp are ordered by their source and then by the order in which they
+// were fetched.
+type entryHeap []*pendingEntry
+
+func (h entryHeap) Len() int { return len(h) }
+
+func (h entryHeap) Less(i, j int) bool {
+	switch {
+	case !h[i].Timestamp.Equal(h[j].Timestamp):
+		return h[i].Timestamp.Before(h[j].Timestamp)
+
+	case h[i].source != h[j].source:
+		return h[i].source < h[j].source
+
+	default:
+		return h[i].seq < h[j].seq
+	}
+}
+
+func (h entryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *entryHeap) Push(x interface{}) {
+	*h = append(*h, x.(*pendingEntry))
+}
+
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return entry
+}
+
+// ForEachEntry calls the callback for each entry of the ledger whose
+// timestamp lies within the given time range, ordered by their timestamp.
+// Both ends of the range are inclusive. The sources of the entries are read
+// page by page and merged, so an entry is passed on as soon as no entry of a
+// page that wasn't read yet can precede it.
+func (l *Ledger) ForEachEntry(ctx context.Context, start, end time.Time,
+	cb func(*Entry) error) error {
+
+	if end.Before(start) {
+		return ErrInvalidRange
+	}
+
+	sources := []entrySource{
+		l.forwardSource(start, end), l.paymentSource(start, end),
+		l.invoiceSource(start, end),
+		singlePageSource(l.walletEntries, start, end),
+		singlePageSource(l.channelFeeEntries, start, end),
+	}
+
+	var (
+		watermarks = make([]time.Time, len(sources))
+		done       = make([]bool, len(sources))
+		pending    entryHeap
+		seq        uint64
+	)
+	for {
+		// The next page is read from the source that lags behind the
+		// most.
+		next := -1
+		for i := range sources {
+			if done[i] {
+				continue
+			}
+
+			if next == -1 ||
+				watermarks[i].Before(watermarks[next]) {
+
+				next = i
+			}
+		}
+
+		// The entries before the lowest watermark can't be preceded
+		// by an entry that wasn't read yet.
+		for pending.Len() > 0 {
+			if next != -1 &&
+				!pending[0].Timestamp.Before(watermarks[next]) {
+
+				break
+			}
+
+			entry := heap.Pop(&pending).(*pendingEntry)
+			if err := cb(entry.Entry); err != nil {
+				return err
+			}
+		}
+
+		if next == -1 {
+			return nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, err := sources[next](ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range page.entries {
+			heap.Push(&pending, &pendingEntry{
+				Entry:  entry,
+				source: next,
+				seq:    seq,
+			})
+			seq++
+		}
+
+		watermarks[next] = page.watermark
+		done[next] = page.last
+	}
+}
+
+// singlePageSource returns a source that reads all its entries at once, for
+// the sources that can't be queried page by page.
+func singlePageSource(entries func(context.Context, time.Time,
+	time.Time) ([]*Entry, error), start, end time.Time) entrySource {
+
+	return func(ctx context.Context) (*entryPage, error) {
+		pageEntries, err := entries(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		return &entryPage{
+			entries: pageEntries,
+			last:    true,
+		}, nil
+	}
+}
+
+// inRange returns whether the timestamp lies within the time range.
+func inRange(timestamp, start, end time.Time) bool {
+	return !timestamp.Before(start) && !timestamp.After(end)
+}
+
+// forwardSource returns an entry for each htlc that we forwarded. The
+// forwarding log is ordered by timestamp, so the watermark of a page is the
+// timestamp of its last event.
+func (l *Ledger) forwardSource(start, end time.Time) entrySource {
+	var offset uint32
+
+	return func(context.Context) (*entryPage, error) {
+		resp, err := l.cfg.QueryForwardingLog(
+			channeldb.ForwardingEventQuery{
+				StartTime:    start,
+				EndTime:      end,
+				IndexOffset:  offset,
+				NumMaxEvents: queryBatchSize,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events := resp.ForwardingEvents
+		if len(events) == 0 {
+			return &entryPage{last: true}, nil
+		}
+
+		page := &entryPage{
+			watermark: events[len(events)-1].Timestamp,
+		}
+		for _, event := range events {
+			page.entries = append(page.entries, &Entry{
+				Timestamp: event.Timestamp,
+				Type:      EntryTypeForward,
+				Amount: int64(event.AmtIn) -
+					int64(event.AmtOut),
+				IncomingChanID: event.IncomingChanID,
+				OutgoingChanID: event.OutgoingChanID,
+			})
+		}
+
+		offset = resp.LastIndexOffset
+
+		return page, nil
+	}
+}
+
+// paymentSource returns an entry for each payment that succeeded within the
+// time range. Payments are read in the order of their creation, and settle
+// after they were created, so the watermark of a page is the creation time
+// of its last payment.
+func (l *Ledger) paymentSource(start, end time.Time) entrySource {
+	var offset uint64
+
+	return func(context.Context) (*entryPage, error) {
+		// Payments settle after they were created, so we only restrict
+		// the creation date by the end of the range.
+		resp, err := l.cfg.QueryPayments(channeldb.PaymentsQuery{
+			IndexOffset:     offset,
+			MaxPayments:     queryBatchSize,
+			CreationDateEnd: end,
+			Statuses: []channeldb.PaymentStatus{
+				channeldb.StatusSucceeded,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		payments := resp.Payments
+		if len(payments) == 0 {
+			return &entryPage{last: true}, nil
+		}
+
+		page := &entryPage{
+			watermark: payments[len(payments)-1].Info.CreationTime,
+		}
+		for _, payment := range payments {
+			entry := paymentEntry(payment)
+			if entry == nil {
+				continue
+			}
+
+			if !inRange(entry.Timestamp, start, end) {
+				continue
+			}
+
+			page.entries = append(page.entries, entry)
+		}
+
+		offset = resp.LastIndexOffset
+
+		return page, nil
+	}
+}
+
+// paymentEntry returns the entry of a payment, or nil if the payment didn't
+// succeed.
+func paymentEntry(payment *channeldb.MPPayment) *Entry {
+	if payment.Status != channeldb.StatusSucceeded {
+		return nil
+	}
+
+	settleInfo, _ := payment.TerminalInfo()
+	if settleInfo == nil {
+		return nil
+	}
+
+	amt, fee := payment.SentAmt()
+	hash := payment.Info.PaymentIdentifier
+
+	// The outgoing channel is only known if all the settled htlcs left
+	// over the same channel.
+	var chanIDs []uint64
+	for _, htlc := range payment.HTLCs {
+		if htlc.Settle == nil || len(htlc.Route.Hops) == 0 {
+			continue
+		}
+
+		chanIDs = append(chanIDs, htlc.Route.Hops[0].ChannelID)
+	}
+
+	return &Entry{
+		Timestamp:      settleInfo.SettleTime,
+		Type:           EntryTypePayment,
+		Amount:         -int64(amt),
+		Fee:            fee,
+		OutgoingChanID: uniqueChanID(chanIDs),
+		PaymentHash:    &hash,
+	}
+}
+
+// uniqueChanID returns the channel id if all given ids are the same, and the
+// zero channel id otherwise.
+func uniqueChanID(chanIDs []uint64) lnwire.ShortChannelID {
+	if len(chanIDs) == 0 {
+		return lnwire.ShortChannelID{}
+	}
+
+	for _, chanID := range chanIDs[1:] {
+		if chanID != chanIDs[0] {
+			return lnwire.ShortChannelID{}
+		}
+	}
+
+	return lnwire.NewShortChanIDFromInt(chanIDs[0])
+}
+
+// invoiceSource returns an entry for each invoice that settled within the
+// time range. AMP invoices get an entry for each of their settled sets.
+// Invoices are read in the order of their creation, and settle after they
+// were created, so the watermark of a page is the creation date of its last
+// invoice.
+func (l *Ledger) invoiceSource(start, end time.Time) entrySource {
+	var offset uint64
+
+	return func(ctx context.Context) (*entryPage, error) {
+		// Invoices settle after they were created, so we only restrict
+		// the creation date by the end of the range.
+		resp, err := l.cfg.QueryInvoices(ctx, invoices.InvoiceQuery{
+			IndexOffset:     offset,
+			NumMaxInvoices:  queryBatchSize,
+			CreationDateEnd: end,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Invoices) == 0 {
+			return &entryPage{last: true}, nil
+		}
+
+		lastInvoice := resp.Invoices[len(resp.Invoices)-1]
+		page := &entryPage{
+			watermark: lastInvoice.CreationDate,
+		}
+		for i := range resp.Invoices {
+			invoice := &resp.Invoices[i]
+			for _, entry := range invoiceEntries(invoice) {
+				if !inRange(entry.Timestamp, start, end) {
+					continue
+				}
+
+				page.entries = append(page.entries, entry)
+			}
+		}
+
+		offset = resp.LastIndexOffset
+
+		return page, nil
+	}
+}
+
+// invoiceEntries returns the entries of the settled parts of an invoice.
+func invoiceEntries(invoice *invoices.Invoice) []*Entry {
+	newEntry := func(settleDate time.Time, amtPaid lnwire.MilliSatoshi,
+		htlcs map[invoices.CircuitKey]*invoices.InvoiceHTLC) *Entry {
+
+		chanIDs := make([]uint64, 0, len(htlcs))
+		for key := range htlcs {
+			chanIDs = append(chanIDs, key.ChanID.ToUint64())
+		}
+
+		return &Entry{
+			Timestamp:      settleDate,
+			Type:           EntryTypeInvoice,
+			Amount:         int64(amtPaid),
+			IncomingChanID: uniqueChanID(chanIDs),
+			Label:          string(invoice.Memo),
+		}
+	}
+
+	if invoice.IsAMP() {
+		var entries []*Entry
+		for setID, state := range invoice.AMPState {
+			if state.State != invoices.HtlcStateSettled {
+				continue
+			}
+
+			setID := [32]byte(setID)
+			htlcs := invoice.HTLCSet(
+				&setID, invoices.HtlcStateSettled,
+			)
+			entries = append(entries, newEntry(
+				state.SettleDate, state.AmtPaid, htlcs,
+			))
+		}
+
+		return entries
+	}
+
+	if invoice.State != invoices.ContractSettled {
+		return nil
+	}
+
+	entry := newEntry(
+		invoice.SettleDate, invoice.AmtPaid,
+		invoice.HTLCSet(nil, invoices.HtlcStateSettled),
+	)
+	if invoice.Terms.PaymentPreimage != nil {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		entry.PaymentHash = &hash
+	}
+
+	return []*Entry{entry}
+}
+
+// walletEntries returns an entry for each confirmed wallet transaction within
+// the time range.
+func (l *Ledger) walletEntries(_ context.Context, start,
+	end time.Time) ([]*Entry, error) {
+
+	txs, err := l.cfg.ListTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, tx := range txs {
+		if tx.NumConfirmations == 0 {
+			continue
+		}
+
+		timestamp := time.Unix(tx.Timestamp, 0)
+		if !inRange(timestamp, start, end) {
+			continue
+		}
+
+		entries = append(entries, walletEntry(tx, timestamp))
+	}
+
+	return entries, nil
+}
+
+// walletEntry returns the entry of a wallet transaction, using its label to
+// determine the type of the entry and the channel it belongs to.
+func walletEntry(tx *lnwallet.TransactionDetail, timestamp time.Time) *Entry {
+	// The value of the transaction is the change of our balance, which
+	// includes the fee if we funded the transaction.
+	fee := btcAmtToMsat(tx.TotalFees)
+	hash := tx.Hash
+	entry := &Entry{
+		Timestamp: timestamp,
+		Type:      EntryTypeOnChain,
+		Amount:    btcAmtToMsat(int64(tx.Value)) + fee,
+		Fee:       lnwire.MilliSatoshi(fee),
+		TxHash:    &hash,
+		Label:     tx.Label,
+	}
+
+	labelType, chanID, err := labels.ParseLabel(tx.Label)
+	if err != nil {
+		return entry
+	}
+
+	switch labelType {
+	case labels.LabelTypeChannelOpen:
+		entry.Type = EntryTypeChannelOpen
+
+	case labels.LabelTypeChannelClose:
+		entry.Type = EntryTypeChannelClose
+
+	case labels.LabelTypeSweepTransaction:
+		entry.Type = EntryTypeSweep
+
+	case labels.LabelTypeJusticeTransaction:
+		entry.Type = EntryTypeJustice
+	}
+
+	if chanID == nil {
+		return entry
+	}
+
+	// Funds that leave the wallet move into the channel, and funds that
+	// arrive in the wallet come from the channel.
+	if entry.Amount < 0 {
+		entry.OutgoingChanID = *chanID
+	} else {
+		entry.IncomingChanID = *chanID
+	}
+
+	return entry
+}
+
+// channelFeeEntries returns an entry for each on-chain fee that we paid from
+// the funds of a closed channel within the time range.
+func (l *Ledger) channelFeeEntries(_ context.Context, start,
+	end time.Time) ([]*Entry, error) {
+
+	reports, err := l.cfg.ChannelReports()
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up the timestamp of each block only once, as a block may
+	// confirm several transactions of our channels.
+	timestamps := make(map[int32]time.Time)
+	blockTimestamp := func(height int32) (time.Time, error) {
+		if timestamp, ok := timestamps[height]; ok {
+			return timestamp, nil
+		}
+
+		timestamp, err := l.cfg.BlockTimestamp(height)
+		if err != nil {
+			return time.Time{}, err
+		}
+		timestamps[height] = timestamp
+
+		return timestamp, nil
+	}
+
+	var entries []*Entry
+	for _, report := range reports {
+		for _, tx := range report.Txs {
+			if tx.Fee == 0 || tx.ConfirmHeight == 0 {
+				continue
+			}
+
+			timestamp, err := blockTimestamp(tx.ConfirmHeight)
+			if err != nil {
+				return nil, err
+			}
+
+			if !inRange(timestamp, start, end) {
+				continue
+			}
+
+			hash := tx.TxHash
+			fee := lnwire.NewMSatFromSatoshis(tx.Fee)
+			entries = append(entries, &Entry{
+				Timestamp:      timestamp,
+				Type:           EntryTypeChannelFee,
+				Fee:            fee,
+				OutgoingChanID: report.Summary.ShortChanID,
+				TxHash:         &hash,
+				Label:          tx.Label,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// btcAmtToMsat converts an amount in satoshis to millisatoshis.
+func btcAmtToMsat(amt int64) int64 {
+	return amt * 1000
+}
@@ -0,0 +1,455 @@
+package accounting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/closereport"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTime = time.Unix(1_700_000_000, 0)
+
+	chanA = lnwire.NewShortChanIDFromInt(1)
+	chanB = lnwire.NewShortChanIDFromInt(2)
+
+	openTxHash    = chainhash.Hash{1}
+	sendTxHash    = chainhash.Hash{2}
+	closingTxHash = chainhash.Hash{3}
+
+	preimage = lntypes.Preimage{1}
+)
+
+// newTestLedger creates a ledger with a forward, a payment, an invoice, a
+// channel open, an on-chain send and the closing fee of a channel, one minute
+// apart from each other.
+func newTestLedger(t *testing.T) *Ledger {
+	t.Helper()
+
+	at := func(minutes int) time.Time {
+		return testTime.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	forwards := []channeldb.ForwardingEvent{{
+		Timestamp:      at(0),
+		IncomingChanID: chanA,
+		OutgoingChanID: chanB,
+		AmtIn:          10_100,
+		AmtOut:         10_000,
+	}}
+
+	payments := []*channeldb.MPPayment{
+		{
+			Info: &channeldb.PaymentCreationInfo{
+				PaymentIdentifier: preimage.Hash(),
+				CreationTime:      at(1),
+			},
+			HTLCs: []channeldb.HTLCAttempt{{
+				HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+					Route: route.Route{
+						TotalAmount: 5_050,
+						Hops: []*route.Hop{{
+							ChannelID: chanB.
+								ToUint64(),
+							AmtToForward: 5_000,
+						}},
+					},
+				},
+				Settle: &channeldb.HTLCSettleInfo{
+					SettleTime: at(1),
+				},
+			}},
+			Status: channeldb.StatusSucceeded,
+		},
+
+		// Failed payments aren't part of the ledger.
+		{
+			Info: &channeldb.PaymentCreationInfo{
+				CreationTime: at(1),
+			},
+			Status: channeldb.StatusFailed,
+		},
+	}
+
+	invoiceList := []invoices.Invoice{
+		{
+			Memo:       []byte("coffee"),
+			SettleDate: at(2),
+			Terms: invoices.ContractTerm{
+				PaymentPreimage: &preimage,
+			},
+			State:   invoices.ContractSettled,
+			AmtPaid: 20_000,
+			Htlcs: map[invoices.CircuitKey]*invoices.InvoiceHTLC{
+				{ChanID: chanA, HtlcID: 1}: {
+					Amt:   20_000,
+					State: invoices.HtlcStateSettled,
+				},
+			},
+		},
+
+		// Open invoices aren't part of the ledger.
+		{
+			State: invoices.ContractOpen,
+		},
+	}
+
+	txs := []*lnwallet.TransactionDetail{
+		{
+			Hash:             openTxHash,
+			Value:            -100_200,
+			NumConfirmations: 6,
+			Timestamp:        at(3).Unix(),
+			TotalFees:        200,
+			Label: labels.MakeLabel(
+				labels.LabelTypeChannelOpen, &chanA,
+			),
+		},
+		{
+			Hash:             sendTxHash,
+			Value:            -50_100,
+			NumConfirmations: 1,
+			Timestamp:        at(4).Unix(),
+			TotalFees:        100,
+			Label:            "rent",
+		},
+
+		// Unconfirmed transactions aren't part of the ledger.
+		{
+			Value:     10_000,
+			Timestamp: at(4).Unix(),
+		},
+	}
+
+	reports := []*closereport.ChannelReport{{
+		Summary: &channeldb.ChannelCloseSummary{
+			ShortChanID: chanB,
+		},
+		Txs: []*closereport.TxReport{
+			{
+				TxHash:        closingTxHash,
+				Type:          closereport.TxTypeClosing,
+				Fee:           1_000,
+				TotalFee:      1_000,
+				ConfirmHeight: 100,
+			},
+
+			// Transactions that didn't cost us anything aren't
+			// part of the ledger.
+			{
+				Type:          closereport.TxTypeSweep,
+				ConfirmHeight: 101,
+			},
+		},
+	}}
+
+	return NewLedger(&Config{
+		QueryForwardingLog: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			resp := channeldb.ForwardingLogTimeSlice{
+				ForwardingEventQuery: q,
+				LastIndexOffset:      1,
+			}
+			if q.IndexOffset != 0 {
+				return resp, nil
+			}
+
+			for _, event := range forwards {
+				if inRange(event.Timestamp, q.StartTime,
+					q.EndTime) {
+
+					resp.ForwardingEvents = append(
+						resp.ForwardingEvents, event,
+					)
+				}
+			}
+
+			return resp, nil
+		},
+		QueryPayments: func(q channeldb.PaymentsQuery) (
+			channeldb.PaymentsResponse, error) {
+
+			resp := channeldb.PaymentsResponse{
+				LastIndexOffset: 2,
+			}
+			if q.IndexOffset == 0 {
+				resp.Payments = payments
+			}
+
+			return resp, nil
+		},
+		QueryInvoices: func(_ context.Context,
+			q invoices.InvoiceQuery) (invoices.InvoiceSlice,
+			error) {
+
+			resp := invoices.InvoiceSlice{
+				InvoiceQuery:    q,
+				LastIndexOffset: 2,
+			}
+			if q.IndexOffset == 0 {
+				resp.Invoices = invoiceList
+			}
+
+			return resp, nil
+		},
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return txs, nil
+		},
+		ChannelReports: func() ([]*closereport.ChannelReport, error) {
+			return reports, nil
+		},
+		BlockTimestamp: func(height int32) (time.Time, error) {
+			require.EqualValues(t, 100, height)
+
+			return at(5), nil
+		},
+	})
+}
+
+// collectEntries returns the entries of the ledger within the time range.
+func collectEntries(ledger *Ledger, start, end time.Time) ([]*Entry, error) {
+	var entries []*Entry
+	err := ledger.ForEachEntry(
+		context.Background(), start, end, func(entry *Entry) error {
+			entries = append(entries, entry)
+
+			return nil
+		},
+	)
+
+	return entries, err
+}
+
+// TestLedgerEntries tests that the ledger contains a normalised entry for
+// each event of the node.
+func TestLedgerEntries(t *testing.T) {
+	t.Parallel()
+
+	ledger := newTestLedger(t)
+
+	entries, err := collectEntries(
+		ledger, testTime, testTime.Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	paymentHash := preimage.Hash()
+	require.Equal(t, []*Entry{
+		{
+			Timestamp:      testTime,
+			Type:           EntryTypeForward,
+			Amount:         100,
+			IncomingChanID: chanA,
+			OutgoingChanID: chanB,
+		},
+		{
+			Timestamp:      testTime.Add(time.Minute),
+			Type:           EntryTypePayment,
+			Amount:         -5_000,
+			Fee:            50,
+			OutgoingChanID: chanB,
+			PaymentHash:    &paymentHash,
+		},
+		{
+			Timestamp:      testTime.Add(2 * time.Minute),
+			Type:           EntryTypeInvoice,
+			Amount:         20_000,
+			IncomingChanID: chanA,
+			PaymentHash:    &paymentHash,
+			Label:          "coffee",
+		},
+		{
+			Timestamp:      testTime.Add(3 * time.Minute),
+			Type:           EntryTypeChannelOpen,
+			Amount:         -100_000_000,
+			Fee:            200_000,
+			OutgoingChanID: chanA,
+			TxHash:         &openTxHash,
+			Label:          "0:openchannel:shortchanid-1",
+		},
+		{
+			Timestamp: testTime.Add(4 * time.Minute),
+			Type:      EntryTypeOnChain,
+			Amount:    -50_000_000,
+			Fee:       100_000,
+			TxHash:    &sendTxHash,
+			Label:     "rent",
+		},
+		{
+			Timestamp: testTime.Add(5 * time.Minute),
+			Type:      EntryTypeChannelFee,
+			Fee: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(1_000),
+			),
+			OutgoingChanID: chanB,
+			TxHash:         &closingTxHash,
+		},
+	}, entries)
+
+	// Only the entries within the time range are returned.
+	entries, err = collectEntries(
+		ledger, testTime.Add(time.Minute),
+		testTime.Add(2*time.Minute),
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, EntryTypePayment, entries[0].Type)
+	require.Equal(t, EntryTypeInvoice, entries[1].Type)
+
+	_, err = collectEntries(ledger, testTime, testTime.Add(-time.Minute))
+	require.ErrorIs(t, err, ErrInvalidRange)
+}
+
+// TestLedgerPaging tests that the ledger merges the pages of its sources by
+// timestamp, and passes on entries before all pages are read.
+func TestLedgerPaging(t *testing.T) {
+	t.Parallel()
+
+	at := func(minutes int) time.Time {
+		return testTime.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	// Every page of the forwarding log holds a single event.
+	forwards := []channeldb.ForwardingEvent{
+		{Timestamp: at(1), AmtIn: 1},
+		{Timestamp: at(7), AmtIn: 7},
+		{Timestamp: at(12), AmtIn: 12},
+	}
+
+	// Every page of the payments holds a single payment. The first
+	// payment settles after the second one.
+	newPayment := func(created, settled int) *channeldb.MPPayment {
+		return &channeldb.MPPayment{
+			Info: &channeldb.PaymentCreationInfo{
+				CreationTime: at(created),
+			},
+			HTLCs: []channeldb.HTLCAttempt{{
+				HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+					Route: route.Route{
+						TotalAmount: 1_000,
+					},
+				},
+				Settle: &channeldb.HTLCSettleInfo{
+					SettleTime: at(settled),
+				},
+			}},
+			Status: channeldb.StatusSucceeded,
+		}
+	}
+	payments := []*channeldb.MPPayment{
+		newPayment(0, 10), newPayment(5, 6),
+	}
+
+	var forwardQueries int
+	ledger := NewLedger(&Config{
+		QueryForwardingLog: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			forwardQueries++
+
+			resp := channeldb.ForwardingLogTimeSlice{
+				ForwardingEventQuery: q,
+				LastIndexOffset:      q.IndexOffset + 1,
+			}
+			if int(q.IndexOffset) < len(forwards) {
+				resp.ForwardingEvents = []channeldb.
+					ForwardingEvent{forwards[q.IndexOffset]}
+			}
+
+			return resp, nil
+		},
+		QueryPayments: func(q channeldb.PaymentsQuery) (
+			channeldb.PaymentsResponse, error) {
+
+			require.Equal(
+				t, []channeldb.PaymentStatus{
+					channeldb.StatusSucceeded,
+				}, q.Statuses,
+			)
+
+			resp := channeldb.PaymentsResponse{
+				LastIndexOffset: q.IndexOffset + 1,
+			}
+			if int(q.IndexOffset) < len(payments) {
+				resp.Payments = []*channeldb.MPPayment{
+					payments[q.IndexOffset],
+				}
+			}
+
+			return resp, nil
+		},
+		QueryInvoices: func(_ context.Context,
+			q invoices.InvoiceQuery) (invoices.InvoiceSlice,
+			error) {
+
+			return invoices.InvoiceSlice{InvoiceQuery: q}, nil
+		},
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return nil, nil
+		},
+		ChannelReports: func() ([]*closereport.ChannelReport, error) {
+			return nil, nil
+		},
+	})
+
+	var (
+		timestamps []time.Time
+		queries    []int
+	)
+	err := ledger.ForEachEntry(
+		context.Background(), testTime, at(60),
+		func(entry *Entry) error {
+			timestamps = append(timestamps, entry.Timestamp)
+			queries = append(queries, forwardQueries)
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []time.Time{
+		at(1), at(6), at(7), at(10), at(12),
+	}, timestamps)
+
+	// The first entry is passed on before the last page of the
+	// forwarding log is read.
+	require.Less(t, queries[0], forwardQueries)
+}
+
+// TestUnitFormat tests the formatting of amounts in the units of the ledger.
+func TestUnitFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		unit     Unit
+		amtMsat  int64
+		expected string
+	}{
+		{UnitMsat, 1_234, "1234"},
+		{UnitMsat, -1_234, "-1234"},
+		{UnitSat, 1_234, "1.234"},
+		{UnitSat, -5, "-0.005"},
+		{UnitSat, 0, "0.000"},
+		{UnitBTC, 100_000_000_000, "1.00000000000"},
+		{UnitBTC, -12_345, "-0.00000012345"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tc.unit.Format(tc.amtMsat))
+	}
+}
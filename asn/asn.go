@@ -0,0 +1,155 @@
+package asn
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ipRange is a range of IP addresses that is announced by an autonomous
+// system.
+type ipRange struct {
+	// start is the first address of the range.
+	start netip.Addr
+
+	// end is the last address of the range.
+	end netip.Addr
+
+	// asn is the number of the autonomous system that announces the range.
+	asn uint32
+}
+
+// DB maps IP addresses to the autonomous systems that announce them.
+type DB struct {
+	// ranges are the known IP ranges, sorted by their first address. The
+	// ranges don't overlap.
+	ranges []ipRange
+}
+
+// Open reads an IP to ASN database from the file at the given path. See
+// Read for the format of the file.
+func Open(path string) (*DB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read reads an IP to ASN database in the tab separated format of
+// iptoasn.com. Each line holds the first and the last address of a range
+// and the number of the autonomous system that announces it, optionally
+// followed by further columns that are ignored. Ranges with AS number 0 are
+// not routed and skipped.
+func Read(r io.Reader) (*DB, error) {
+	var (
+		db      = &DB{}
+		scanner = bufio.NewScanner(r)
+		lineNum int
+	)
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rng, err := parseRange(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+
+		if rng.asn == 0 {
+			continue
+		}
+
+		db.ranges = append(db.ranges, rng)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	for i := 1; i < len(db.ranges); i++ {
+		if !db.ranges[i-1].end.Less(db.ranges[i].start) {
+			return nil, fmt.Errorf("range %v-%v overlaps with %v",
+				db.ranges[i].start, db.ranges[i].end,
+				db.ranges[i-1].start)
+		}
+	}
+
+	return db, nil
+}
+
+// parseRange parses a single line of the database.
+func parseRange(line string) (ipRange, error) {
+	cols := strings.Split(line, "\t")
+	if len(cols) < 3 {
+		return ipRange{}, fmt.Errorf("expected at least 3 columns, "+
+			"got %d", len(cols))
+	}
+
+	start, err := netip.ParseAddr(cols[0])
+	if err != nil {
+		return ipRange{}, err
+	}
+
+	end, err := netip.ParseAddr(cols[1])
+	if err != nil {
+		return ipRange{}, err
+	}
+
+	start, end = start.Unmap(), end.Unmap()
+	if start.Is4() != end.Is4() || end.Less(start) {
+		return ipRange{}, fmt.Errorf("invalid range %v-%v", start, end)
+	}
+
+	asn, err := strconv.ParseUint(cols[2], 10, 32)
+	if err != nil {
+		return ipRange{}, err
+	}
+
+	return ipRange{
+		start: start,
+		end:   end,
+		asn:   uint32(asn),
+	}, nil
+}
+
+// Lookup returns the number of the autonomous system that announces the
+// given IP address. False is returned if the address isn't part of any
+// known range.
+func (d *DB) Lookup(ip net.IP) (uint32, bool) {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return 0, false
+	}
+	addr = addr.Unmap()
+
+	// Find the last range that starts at or before the address.
+	i := sort.Search(len(d.ranges), func(i int) bool {
+		return addr.Less(d.ranges[i].start)
+	})
+	if i == 0 {
+		return 0, false
+	}
+
+	rng := d.ranges[i-1]
+	if rng.end.Less(addr) {
+		return 0, false
+	}
+
+	return rng.asn, true
+}
@@ -0,0 +1,80 @@
+package asn
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDB = `1.0.0.0	1.0.0.255	13335	US	CLOUDFLARENET
+1.0.1.0	1.0.3.255	0	None	Not routed
+# A comment.
+5.9.0.0	5.9.255.255	24940	DE	HETZNER-AS
+
+2a01:4f8::	2a01:4f8:ffff:ffff:ffff:ffff:ffff:ffff	24940	DE	HETZNER-AS
+`
+
+// TestLookup tests that IP addresses are mapped to the autonomous systems of
+// the ranges that contain them.
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	db, err := Read(strings.NewReader(testDB))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		ip    string
+		asn   uint32
+		found bool
+	}{
+		{ip: "1.0.0.0", asn: 13335, found: true},
+		{ip: "1.0.0.255", asn: 13335, found: true},
+		{ip: "::ffff:1.0.0.1", asn: 13335, found: true},
+		{ip: "1.0.2.1"},
+		{ip: "5.9.10.20", asn: 24940, found: true},
+		{ip: "5.10.0.0"},
+		{ip: "0.0.0.1"},
+		{ip: "2a01:4f8:1:2::3", asn: 24940, found: true},
+		{ip: "2a01:4f9::1"},
+	}
+	for _, testCase := range testCases {
+		asn, found := db.Lookup(net.ParseIP(testCase.ip))
+		require.Equal(t, testCase.found, found, testCase.ip)
+		require.Equal(t, testCase.asn, asn, testCase.ip)
+	}
+}
+
+// TestReadInvalid tests that malformed databases are rejected.
+func TestReadInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		db   string
+	}{{
+		name: "missing column",
+		db:   "1.0.0.0\t1.0.0.255\n",
+	}, {
+		name: "invalid address",
+		db:   "1.0.0\t1.0.0.255\t13335\n",
+	}, {
+		name: "reversed range",
+		db:   "1.0.0.255\t1.0.0.0\t13335\n",
+	}, {
+		name: "mixed families",
+		db:   "1.0.0.0\t::1\t13335\n",
+	}, {
+		name: "invalid asn",
+		db:   "1.0.0.0\t1.0.0.255\tAS13335\n",
+	}, {
+		name: "overlapping ranges",
+		db: "1.0.0.0\t1.0.0.255\t13335\n" +
+			"1.0.0.128\t1.0.1.255\t24940\n",
+	}}
+	for _, testCase := range testCases {
+		_, err := Read(strings.NewReader(testCase.db))
+		require.Error(t, err, testCase.name)
+	}
+}
@@ -0,0 +1,97 @@
+package bolt12
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+// charset is the set of characters used in the data section of bech32
+// strings.
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// encodeBech32 encodes the data as a bech32 string with the given human
+// readable part. Unlike BOLT 11 invoices, BOLT 12 strings don't carry a
+// checksum, as their integrity is protected by the signature (or in the case
+// of offers, not needed at all).
+func encodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(converted))
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, c := range converted {
+		b.WriteByte(charset[c])
+	}
+
+	return b.String(), nil
+}
+
+// decodeBech32 decodes a bech32 string without a checksum, returning its
+// human readable part and data. A string may be split into several parts by
+// joining them with a '+' that is optionally followed by whitespace.
+func decodeBech32(s string) (string, []byte, error) {
+	s, err := joinParts(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Mixed case strings are invalid, so we only need to lower the
+	// string if it is entirely upper case.
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, errors.New("string uses mixed case")
+	}
+	s = lower
+
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep == len(s)-1 {
+		return "", nil, errors.New("invalid separator position")
+	}
+
+	hrp := s[:sep]
+	data := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		idx := strings.IndexByte(charset, s[i])
+		if idx == -1 {
+			return "", nil, fmt.Errorf("invalid character not "+
+				"part of charset: %v", s[i])
+		}
+
+		data = append(data, byte(idx))
+	}
+
+	// The conversion adds up to 4 bits of padding, which must be zero.
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, converted, nil
+}
+
+// joinParts removes the '+' separators (and any whitespace following them)
+// that were used to split a string into several parts.
+func joinParts(s string) (string, error) {
+	parts := strings.Split(s, "+")
+	for i, part := range parts {
+		if i > 0 {
+			part = strings.TrimLeftFunc(part, unicode.IsSpace)
+		}
+
+		if part == "" {
+			return "", errors.New("empty part in string")
+		}
+
+		parts[i] = part
+	}
+
+	return strings.Join(parts, ""), nil
+}
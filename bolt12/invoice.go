@@ -0,0 +1,461 @@
+package bolt12
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// InvoiceHRP is the human readable part of an encoded invoice.
+	InvoiceHRP = "lni"
+
+	// invoiceName is the name of the message that is used in the tag of
+	// its signature.
+	invoiceName = "invoice"
+
+	// DefaultInvoiceExpiry is the time that an invoice can be paid for
+	// after its creation if it doesn't specify a relative expiry.
+	DefaultInvoiceExpiry = 2 * time.Hour
+
+	// The following types are the records of an invoice.
+	invoicePathsType          tlv.Type = 160
+	invoiceBlindedPayType     tlv.Type = 162
+	invoiceCreatedAtType      tlv.Type = 164
+	invoiceRelativeExpiryType tlv.Type = 166
+	invoicePaymentHashType    tlv.Type = 168
+	invoiceAmountType         tlv.Type = 170
+	invoiceFeaturesType       tlv.Type = 174
+	invoiceNodeIDType         tlv.Type = 176
+)
+
+var (
+	// invoiceTypes is the range of types that are used by invoices, not
+	// including the invoice request records that they copy.
+	invoiceTypes = typeRange{min: 160, max: 239}
+
+	// invoiceExperimentalTypes is the range of types that are reserved
+	// for experimental invoice records.
+	invoiceExperimentalTypes = typeRange{
+		min: 3_000_000_000, max: 3_999_999_999,
+	}
+
+	// ErrInvoiceExpired is returned when an invoice's expiry has passed.
+	ErrInvoiceExpired = errors.New("invoice has expired")
+)
+
+// BlindedPayInfo holds the aggregate relay parameters of a blinded path that
+// a payment can be sent along.
+type BlindedPayInfo struct {
+	// FeeBaseMsat is the total base fee of the path.
+	FeeBaseMsat uint32
+
+	// FeeProportionalMillionths is the total proportional fee of the
+	// path.
+	FeeProportionalMillionths uint32
+
+	// CltvExpiryDelta is the total CLTV delta of the path, including the
+	// final CLTV delta of the recipient.
+	CltvExpiryDelta uint16
+
+	// HtlcMinimumMsat is the smallest htlc that all hops of the path
+	// accept.
+	HtlcMinimumMsat uint64
+
+	// HtlcMaximumMsat is the largest htlc that all hops of the path
+	// accept.
+	HtlcMaximumMsat uint64
+
+	// Features is the set of features required to use the path.
+	Features *lnwire.RawFeatureVector
+}
+
+// encode serializes the pay info.
+func (p *BlindedPayInfo) encode(w *bytes.Buffer) error {
+	var buf [8]byte
+
+	binary.BigEndian.PutUint32(buf[:4], p.FeeBaseMsat)
+	w.Write(buf[:4])
+	binary.BigEndian.PutUint32(buf[:4], p.FeeProportionalMillionths)
+	w.Write(buf[:4])
+	binary.BigEndian.PutUint16(buf[:2], p.CltvExpiryDelta)
+	w.Write(buf[:2])
+	binary.BigEndian.PutUint64(buf[:], p.HtlcMinimumMsat)
+	w.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], p.HtlcMaximumMsat)
+	w.Write(buf[:])
+
+	features := p.Features
+	if features == nil {
+		features = lnwire.NewRawFeatureVector()
+	}
+
+	return features.Encode(w)
+}
+
+// decodeBlindedPayInfo deserializes a pay info.
+func decodeBlindedPayInfo(r io.Reader) (*BlindedPayInfo, error) {
+	var (
+		info BlindedPayInfo
+		buf  [26]byte
+	)
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return nil, err
+	}
+
+	info.FeeBaseMsat = binary.BigEndian.Uint32(buf[0:4])
+	info.FeeProportionalMillionths = binary.BigEndian.Uint32(buf[4:8])
+	info.CltvExpiryDelta = binary.BigEndian.Uint16(buf[8:10])
+	info.HtlcMinimumMsat = binary.BigEndian.Uint64(buf[10:18])
+	info.HtlcMaximumMsat = binary.BigEndian.Uint64(buf[18:26])
+
+	info.Features = lnwire.NewRawFeatureVector()
+	if err := info.Features.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// PaymentPath is a blinded path to the recipient of an invoice that the
+// payment can be sent along.
+type PaymentPath struct {
+	// Path is the blinded path to the recipient.
+	Path *sphinx.BlindedPath
+
+	// PayInfo holds the relay parameters of the path.
+	PayInfo *BlindedPayInfo
+}
+
+// Invoice is a request for payment that is sent in reply to an invoice
+// request.
+type Invoice struct {
+	// Request holds the fields of the invoice request that the invoice
+	// was created for.
+	Request *InvoiceRequest
+
+	// Paths is the set of blinded paths that the payment can be sent
+	// along.
+	Paths []*PaymentPath
+
+	// CreatedAt is the time at which the invoice was created.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the time after its creation that the invoice
+	// expires. If zero, the default expiry applies.
+	RelativeExpiry time.Duration
+
+	// PaymentHash is the hash of the payment preimage.
+	PaymentHash lntypes.Hash
+
+	// Amount is the amount that must be paid.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features required to pay the invoice.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the public key that signs the invoice.
+	NodeID *btcec.PublicKey
+
+	// Signature is the signature of the invoice by the node id.
+	Signature *schnorr.Signature
+
+	// ExtraRecords holds the odd invoice records that we don't know.
+	ExtraRecords map[tlv.Type][]byte
+}
+
+// DecodeInvoice decodes a bech32 encoded invoice.
+func DecodeInvoice(s string) (*Invoice, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != InvoiceHRP {
+		return nil, fmt.Errorf("invalid invoice prefix: %v", hrp)
+	}
+
+	return ParseInvoice(data)
+}
+
+// ParseInvoice parses an invoice from its TLV stream and verifies its
+// signature against its node id.
+func ParseInvoice(b []byte) (*Invoice, error) {
+	recs, err := parseRecords(b)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := append([]typeRange{
+		offerTypes, offerExperimentalTypes, invreqExperimentalTypes,
+		invoiceTypes, invoiceExperimentalTypes, signatureTypes,
+	}, invreqTypes...)
+	if err := recs.checkTypes(ranges...); err != nil {
+		return nil, err
+	}
+
+	parsed := recs.filter(func(tlv.Type) bool { return true })
+
+	var invoice Invoice
+	invoice.Paths, err = takePaymentPaths(recs)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.CreatedAt, err = recs.takeTime(invoiceCreatedAtType)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry, err := recs.takeTU32(invoiceRelativeExpiryType)
+	if err != nil {
+		return nil, err
+	}
+	invoice.RelativeExpiry = time.Duration(expiry) * time.Second
+
+	paymentHash, err := recs.takeHash(invoicePaymentHashType)
+	if err != nil {
+		return nil, err
+	}
+	if paymentHash != nil {
+		invoice.PaymentHash = lntypes.Hash(*paymentHash)
+	}
+
+	amt, err := recs.takeTU64(invoiceAmountType)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Amount = lnwire.MilliSatoshi(amt)
+
+	invoice.Features, err = recs.takeFeatures(invoiceFeaturesType)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.NodeID, err = recs.takePubKey(invoiceNodeIDType)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig := recs.takeBytes(SignatureType); sig != nil {
+		invoice.Signature, err = schnorr.ParseSignature(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+	}
+
+	invoice.ExtraRecords, err = recs.takeExtra(
+		invoiceTypes, invoiceExperimentalTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remaining records are the ones copied from the invoice
+	// request, which may not carry its own signature.
+	if _, err := recs.takeExtra(signatureTypes); err != nil {
+		return nil, err
+	}
+	reqRecords := recs.filter(func(tlv.Type) bool { return true })
+
+	invoice.Request, err = invoiceRequestFromRecords(recs)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Request.parsed = reqRecords
+
+	if err := invoice.validate(); err != nil {
+		return nil, err
+	}
+
+	err = verify(invoiceName, parsed, invoice.Signature, invoice.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
+
+// takePaymentPaths removes the blinded paths and their pay info from the
+// given records.
+func takePaymentPaths(recs records) ([]*PaymentPath, error) {
+	paths, err := recs.takePaths(invoicePathsType)
+	if err != nil {
+		return nil, err
+	}
+
+	payInfo := recs.takeBytes(invoiceBlindedPayType)
+	reader := bytes.NewReader(payInfo)
+
+	paymentPaths := make([]*PaymentPath, 0, len(paths))
+	for _, path := range paths {
+		info, err := decodeBlindedPayInfo(reader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded pay info: %w",
+				err)
+		}
+
+		paymentPaths = append(paymentPaths, &PaymentPath{
+			Path:    path,
+			PayInfo: info,
+		})
+	}
+
+	if reader.Len() != 0 {
+		return nil, errors.New("number of blinded paths and pay " +
+			"infos don't match")
+	}
+
+	return paymentPaths, nil
+}
+
+// validate checks that the invoice is well formed.
+func (i *Invoice) validate() error {
+	switch {
+	case len(i.Paths) == 0:
+		return errors.New("invoice has no paths")
+
+	case i.CreatedAt.IsZero():
+		return errors.New("invoice has no creation time")
+
+	case i.PaymentHash == lntypes.ZeroHash:
+		return errors.New("invoice has no payment hash")
+
+	case i.Amount == 0:
+		return errors.New("invoice has no amount")
+
+	case i.NodeID == nil:
+		return errors.New("invoice has no node id")
+	}
+
+	return nil
+}
+
+// records returns the invoice as a set of TLV records, not including its
+// signature.
+func (i *Invoice) records() (records, error) {
+	recs, err := i.Request.records()
+	if err != nil {
+		return nil, err
+	}
+
+	for t, value := range i.ExtraRecords {
+		recs[t] = value
+	}
+
+	paths := make([]*sphinx.BlindedPath, len(i.Paths))
+	var payInfo bytes.Buffer
+	for idx, path := range i.Paths {
+		paths[idx] = path.Path
+		if err := path.PayInfo.encode(&payInfo); err != nil {
+			return nil, err
+		}
+	}
+	if err := recs.putPaths(invoicePathsType, paths); err != nil {
+		return nil, err
+	}
+	recs.putBytes(invoiceBlindedPayType, payInfo.Bytes())
+
+	recs.putTime(invoiceCreatedAtType, i.CreatedAt)
+	if i.RelativeExpiry != 0 {
+		var (
+			b   bytes.Buffer
+			buf [8]byte
+		)
+		secs := uint32(i.RelativeExpiry / time.Second)
+		if err := tlv.ETUint32T(&b, secs, &buf); err != nil {
+			return nil, err
+		}
+		recs[invoiceRelativeExpiryType] = b.Bytes()
+	}
+	recs[invoicePaymentHashType] = i.PaymentHash[:]
+	recs.putTU64(invoiceAmountType, uint64(i.Amount))
+	recs.putFeatures(invoiceFeaturesType, i.Features)
+	recs.putPubKey(invoiceNodeIDType, i.NodeID)
+
+	return recs, nil
+}
+
+// Sign signs the invoice. The signer must sign with the private key of the
+// node id.
+func (i *Invoice) Sign(signer SignFunc) error {
+	recs, err := i.records()
+	if err != nil {
+		return err
+	}
+
+	i.Signature, err = sign(invoiceName, recs, signer)
+
+	return err
+}
+
+// Serialize returns the TLV stream of the signed invoice.
+func (i *Invoice) Serialize() ([]byte, error) {
+	if i.Signature == nil {
+		return nil, ErrMissingSignature
+	}
+
+	recs, err := i.records()
+	if err != nil {
+		return nil, err
+	}
+	recs[SignatureType] = i.Signature.Serialize()
+
+	return recs.serialize(), nil
+}
+
+// Encode returns the bech32 encoding of the signed invoice.
+func (i *Invoice) Encode() (string, error) {
+	b, err := i.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceHRP, b)
+}
+
+// Expiry returns the time at which the invoice expires.
+func (i *Invoice) Expiry() time.Time {
+	expiry := i.RelativeExpiry
+	if expiry == 0 {
+		expiry = DefaultInvoiceExpiry
+	}
+
+	return i.CreatedAt.Add(expiry)
+}
+
+// IsExpired returns true if the invoice can no longer be paid.
+func (i *Invoice) IsExpired(now time.Time) bool {
+	return !now.Before(i.Expiry())
+}
+
+// MatchesRequest checks that the invoice was created for the given invoice
+// request, which requires that all of the request's fields were copied into
+// the invoice unchanged.
+func (i *Invoice) MatchesRequest(req *InvoiceRequest) error {
+	invoiceReq, err := i.Request.records()
+	if err != nil {
+		return err
+	}
+
+	sentReq, err := req.records()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(invoiceReq.serialize(), sentReq.serialize()) {
+		return errors.New("invoice doesn't match invoice request")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package bolt12
+
+import (
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// The following types are the records of an invoice error.
+	invoiceErrorFieldType          tlv.Type = 1
+	invoiceErrorSuggestedValueType tlv.Type = 3
+	invoiceErrorMessageType        tlv.Type = 5
+)
+
+// InvoiceError is sent in reply to an invoice request or an invoice that
+// couldn't be processed.
+type InvoiceError struct {
+	// ErroneousField is the type of the field that caused the error, if
+	// the error was caused by a single field.
+	ErroneousField *tlv.Type
+
+	// SuggestedValue is an optional value for the erroneous field that
+	// would have been accepted.
+	SuggestedValue []byte
+
+	// Message is an explanation of the error.
+	Message string
+}
+
+// Error returns the explanation of the error.
+//
+// NOTE: This is part of the error interface.
+func (e *InvoiceError) Error() string {
+	return e.Message
+}
+
+// ParseInvoiceError parses an invoice error from its TLV stream.
+func ParseInvoiceError(b []byte) (*InvoiceError, error) {
+	recs, err := parseRecords(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoiceErr InvoiceError
+	if _, ok := recs[invoiceErrorFieldType]; ok {
+		field, err := recs.takeTU64(invoiceErrorFieldType)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldType := tlv.Type(field)
+		invoiceErr.ErroneousField = &fieldType
+	}
+
+	invoiceErr.SuggestedValue = recs.takeBytes(
+		invoiceErrorSuggestedValueType,
+	)
+
+	invoiceErr.Message, err = recs.takeString(invoiceErrorMessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remaining records are unknown to us, so we only need to make
+	// sure that none of them are required.
+	if _, err := recs.takeExtra(typeRange{max: ^tlv.Type(0)}); err != nil {
+		return nil, err
+	}
+
+	return &invoiceErr, nil
+}
+
+// Serialize returns the TLV stream of the invoice error.
+func (e *InvoiceError) Serialize() []byte {
+	recs := make(records)
+	if e.ErroneousField != nil {
+		recs[invoiceErrorFieldType] = []byte{}
+		recs.putTU64(invoiceErrorFieldType, uint64(*e.ErroneousField))
+	}
+	recs.putBytes(invoiceErrorSuggestedValueType, e.SuggestedValue)
+	recs.putBytes(invoiceErrorMessageType, []byte(e.Message))
+
+	return recs.serialize()
+}
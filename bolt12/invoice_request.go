@@ -0,0 +1,330 @@
+package bolt12
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// InvoiceRequestHRP is the human readable part of an encoded invoice
+	// request.
+	InvoiceRequestHRP = "lnr"
+
+	// invoiceRequestName is the name of the message that is used in the
+	// tag of its signature.
+	invoiceRequestName = "invoice_request"
+
+	// The following types are the records of an invoice request.
+	invreqMetadataType  tlv.Type = 0
+	invreqChainType     tlv.Type = 80
+	invreqAmountType    tlv.Type = 82
+	invreqFeaturesType  tlv.Type = 84
+	invreqQuantityType  tlv.Type = 86
+	invreqPayerIDType   tlv.Type = 88
+	invreqPayerNoteType tlv.Type = 89
+)
+
+var (
+	// invreqTypes are the ranges of types that are used by invoice
+	// requests, not including the offer records that they copy.
+	invreqTypes = []typeRange{
+		{min: 0, max: 0},
+		{min: 80, max: 159},
+	}
+
+	// invreqExperimentalTypes is the range of types that are reserved for
+	// experimental invoice request records.
+	invreqExperimentalTypes = typeRange{
+		min: 2_000_000_000, max: 2_999_999_999,
+	}
+)
+
+// InvoiceRequest is a request for an invoice that pays an offer. It is sent
+// to the issuer of the offer, who replies with an invoice.
+type InvoiceRequest struct {
+	// Offer holds the fields of the offer that the invoice is requested
+	// for.
+	Offer *Offer
+
+	// Metadata is unpredictable data that the payer includes to make the
+	// request unique.
+	Metadata []byte
+
+	// Chain is the chain that the payer wants to pay on. If nil, the
+	// payment is made on bitcoin mainnet.
+	Chain *chainhash.Hash
+
+	// Amount is the amount that the payer wants to pay in millisatoshis.
+	// If zero, the amount is derived from the offer.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features that the payer supports.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items that the payer requests, which must
+	// be set for offers that have a maximum quantity.
+	Quantity uint64
+
+	// PayerID is the public key that the payer signs the request with.
+	PayerID *btcec.PublicKey
+
+	// PayerNote is an optional note from the payer to the issuer.
+	PayerNote string
+
+	// Signature is the signature of the request by the payer id.
+	Signature *schnorr.Signature
+
+	// ExtraRecords holds the odd invoice request records that we don't
+	// know.
+	ExtraRecords map[tlv.Type][]byte
+
+	// parsed holds the records of a request that was parsed, which are
+	// copied into invoices exactly as they were received.
+	parsed records
+}
+
+// DecodeInvoiceRequest decodes a bech32 encoded invoice request.
+func DecodeInvoiceRequest(s string) (*InvoiceRequest, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != InvoiceRequestHRP {
+		return nil, fmt.Errorf("invalid invoice request prefix: %v",
+			hrp)
+	}
+
+	return ParseInvoiceRequest(data)
+}
+
+// ParseInvoiceRequest parses an invoice request from its TLV stream and
+// verifies its signature.
+func ParseInvoiceRequest(b []byte) (*InvoiceRequest, error) {
+	recs, err := parseRecords(b)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := append([]typeRange{
+		offerTypes, offerExperimentalTypes, invreqExperimentalTypes,
+		signatureTypes,
+	}, invreqTypes...)
+	if err := recs.checkTypes(ranges...); err != nil {
+		return nil, err
+	}
+
+	parsed := recs.filter(func(tlv.Type) bool { return true })
+
+	req, err := invoiceRequestFromRecords(recs)
+	if err != nil {
+		return nil, err
+	}
+	req.parsed = parsed.filter(func(t tlv.Type) bool {
+		return !signatureTypes.contains(t)
+	})
+
+	if _, err := recs.takeExtra(signatureTypes); err != nil {
+		return nil, err
+	}
+
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	err = verify(invoiceRequestName, parsed, req.Signature, req.PayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// invoiceRequestFromRecords takes the invoice request records, including
+// its signature, out of the given set.
+func invoiceRequestFromRecords(recs records) (*InvoiceRequest, error) {
+	var (
+		req InvoiceRequest
+		err error
+	)
+
+	req.Offer, err = offerFromRecords(recs)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Metadata = recs.takeBytes(invreqMetadataType)
+
+	req.Chain, err = recs.takeHash(invreqChainType)
+	if err != nil {
+		return nil, err
+	}
+
+	amt, err := recs.takeTU64(invreqAmountType)
+	if err != nil {
+		return nil, err
+	}
+	req.Amount = lnwire.MilliSatoshi(amt)
+
+	req.Features, err = recs.takeFeatures(invreqFeaturesType)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Quantity, err = recs.takeTU64(invreqQuantityType)
+	if err != nil {
+		return nil, err
+	}
+
+	req.PayerID, err = recs.takePubKey(invreqPayerIDType)
+	if err != nil {
+		return nil, err
+	}
+
+	req.PayerNote, err = recs.takeString(invreqPayerNoteType)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig := recs.takeBytes(SignatureType); sig != nil {
+		req.Signature, err = schnorr.ParseSignature(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+	}
+
+	req.ExtraRecords, err = recs.takeExtra(
+		append(invreqTypes, invreqExperimentalTypes)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// validate checks that the invoice request is well formed.
+func (r *InvoiceRequest) validate() error {
+	switch {
+	case len(r.Metadata) == 0:
+		return errors.New("invoice request has no metadata")
+
+	case r.PayerID == nil:
+		return errors.New("invoice request has no payer id")
+	}
+
+	return r.Offer.validate()
+}
+
+// records returns the invoice request as a set of TLV records, not
+// including its signature.
+func (r *InvoiceRequest) records() (records, error) {
+	if r.parsed != nil {
+		return r.parsed.filter(func(tlv.Type) bool { return true }), nil
+	}
+
+	recs, err := r.Offer.records()
+	if err != nil {
+		return nil, err
+	}
+
+	for t, value := range r.ExtraRecords {
+		recs[t] = value
+	}
+
+	recs.putBytes(invreqMetadataType, r.Metadata)
+	if r.Chain != nil {
+		recs[invreqChainType] = r.Chain[:]
+	}
+	recs.putTU64(invreqAmountType, uint64(r.Amount))
+	recs.putFeatures(invreqFeaturesType, r.Features)
+	recs.putTU64(invreqQuantityType, r.Quantity)
+	recs.putPubKey(invreqPayerIDType, r.PayerID)
+	recs.putBytes(invreqPayerNoteType, []byte(r.PayerNote))
+
+	return recs, nil
+}
+
+// Sign signs the invoice request. The signer must sign with the private key
+// of the payer id.
+func (r *InvoiceRequest) Sign(signer SignFunc) error {
+	recs, err := r.records()
+	if err != nil {
+		return err
+	}
+
+	r.Signature, err = sign(invoiceRequestName, recs, signer)
+
+	return err
+}
+
+// Serialize returns the TLV stream of the signed invoice request.
+func (r *InvoiceRequest) Serialize() ([]byte, error) {
+	if r.Signature == nil {
+		return nil, ErrMissingSignature
+	}
+
+	recs, err := r.records()
+	if err != nil {
+		return nil, err
+	}
+	recs[SignatureType] = r.Signature.Serialize()
+
+	return recs.serialize(), nil
+}
+
+// Encode returns the bech32 encoding of the signed invoice request.
+func (r *InvoiceRequest) Encode() (string, error) {
+	b, err := r.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceRequestHRP, b)
+}
+
+// ChainHash returns the genesis hash of the chain that the request wants
+// to pay on.
+func (r *InvoiceRequest) ChainHash() chainhash.Hash {
+	if r.Chain != nil {
+		return *r.Chain
+	}
+
+	return *chaincfg.MainNetParams.GenesisHash
+}
+
+// AmountToPay returns the amount that is paid for the request, which is
+// either the requested amount or the amount of the offer for the requested
+// quantity.
+func (r *InvoiceRequest) AmountToPay() (lnwire.MilliSatoshi, error) {
+	if r.Amount != 0 {
+		return r.Amount, nil
+	}
+
+	if r.Offer.Amount == 0 {
+		return 0, errors.New("invoice request has no amount")
+	}
+
+	if r.Offer.Currency != "" {
+		return 0, ErrUnsupportedCurrency
+	}
+
+	quantity := r.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	if r.Offer.Amount > math.MaxUint64/quantity {
+		return 0, errors.New("invoice request amount overflows")
+	}
+
+	return lnwire.MilliSatoshi(r.Offer.Amount * quantity), nil
+}
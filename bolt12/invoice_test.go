@@ -0,0 +1,180 @@
+package bolt12
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRequest returns an invoice request for an offer by the issuer,
+// signed by the payer.
+func newTestRequest(t *testing.T, issuer *btcec.PublicKey,
+	payer *btcec.PrivateKey) *InvoiceRequest {
+
+	t.Helper()
+
+	req := &InvoiceRequest{
+		Offer: &Offer{
+			Amount:      500,
+			Description: "subscription",
+			Features:    lnwire.NewRawFeatureVector(),
+			HasQuantity: true,
+			QuantityMax: 10,
+			IssuerID:    issuer,
+		},
+		Metadata:  []byte{9, 9, 9},
+		Quantity:  3,
+		PayerID:   payer.PubKey(),
+		PayerNote: "thanks",
+		ExtraRecords: map[tlv.Type][]byte{
+			101: {7},
+		},
+	}
+	require.NoError(t, req.Sign(PrivKeySigner(payer)))
+
+	return req
+}
+
+// TestInvoiceRequestEncoding tests that invoice requests are encoded and
+// decoded, and that their signature is verified.
+func TestInvoiceRequestEncoding(t *testing.T) {
+	t.Parallel()
+
+	payer, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	req := newTestRequest(t, newTestKey(t), payer)
+
+	encoded, err := req.Encode()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "lnr1"))
+
+	decoded, err := DecodeInvoiceRequest(encoded)
+	require.NoError(t, err)
+	require.Equal(t, req.Offer, decoded.Offer)
+	require.Equal(t, req.Metadata, decoded.Metadata)
+	require.Equal(t, req.Quantity, decoded.Quantity)
+	require.Equal(t, req.PayerNote, decoded.PayerNote)
+	require.Equal(t, req.ExtraRecords, decoded.ExtraRecords)
+	require.True(t, req.PayerID.IsEqual(decoded.PayerID))
+
+	amt, err := decoded.AmountToPay()
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(1500), amt)
+
+	// A request that is signed by another key is rejected.
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, req.Sign(PrivKeySigner(otherKey)))
+
+	b, err := req.Serialize()
+	require.NoError(t, err)
+
+	_, err = ParseInvoiceRequest(b)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// Unsigned requests can't be serialized.
+	req.Signature = nil
+	_, err = req.Serialize()
+	require.ErrorIs(t, err, ErrMissingSignature)
+}
+
+// TestInvoiceEncoding tests that invoices are encoded and decoded, that
+// their signature is verified and that they copy all fields of the invoice
+// request they were created for.
+func TestInvoiceEncoding(t *testing.T) {
+	t.Parallel()
+
+	payer, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	issuer, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	req := newTestRequest(t, issuer.PubKey(), payer)
+	b, err := req.Serialize()
+	require.NoError(t, err)
+
+	// The issuer creates the invoice from the request that it received.
+	received, err := ParseInvoiceRequest(b)
+	require.NoError(t, err)
+
+	invoice := &Invoice{
+		Request: received,
+		Paths: []*PaymentPath{
+			{
+				Path: newTestPath(t, 3),
+				PayInfo: &BlindedPayInfo{
+					FeeBaseMsat:               1,
+					FeeProportionalMillionths: 2,
+					CltvExpiryDelta:           144,
+					HtlcMinimumMsat:           1000,
+					HtlcMaximumMsat:           100000,
+					Features: lnwire.
+						NewRawFeatureVector(),
+				},
+			},
+		},
+		CreatedAt:      time.Unix(1_700_000_000, 0),
+		RelativeExpiry: time.Hour,
+		PaymentHash:    lntypes.Hash{1, 2, 3},
+		Amount:         1500,
+		Features:       lnwire.NewRawFeatureVector(),
+		NodeID:         issuer.PubKey(),
+	}
+	require.NoError(t, invoice.Sign(PrivKeySigner(issuer)))
+
+	encoded, err := invoice.Encode()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "lni1"))
+
+	decoded, err := DecodeInvoice(encoded)
+	require.NoError(t, err)
+	require.Equal(t, invoice.Paths, decoded.Paths)
+	require.Equal(t, invoice.CreatedAt, decoded.CreatedAt)
+	require.Equal(t, invoice.PaymentHash, decoded.PaymentHash)
+	require.Equal(t, invoice.Amount, decoded.Amount)
+	require.Equal(t, time.Unix(1_700_003_600, 0), decoded.Expiry())
+	require.True(t, decoded.IsExpired(time.Unix(1_700_003_600, 0)))
+	require.False(t, decoded.IsExpired(time.Unix(1_700_000_000, 0)))
+
+	// The payer accepts the invoice, as it was created for its request.
+	require.NoError(t, decoded.MatchesRequest(req))
+
+	// An invoice for another request is detected.
+	otherReq := newTestRequest(t, issuer.PubKey(), payer)
+	otherReq.Quantity = 4
+	require.NoError(t, otherReq.Sign(PrivKeySigner(payer)))
+	require.Error(t, decoded.MatchesRequest(otherReq))
+
+	// An invoice that isn't signed by its node id is rejected.
+	require.NoError(t, invoice.Sign(PrivKeySigner(payer)))
+	b, err = invoice.Serialize()
+	require.NoError(t, err)
+
+	_, err = ParseInvoice(b)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+// TestInvoiceError tests the encoding of invoice errors.
+func TestInvoiceError(t *testing.T) {
+	t.Parallel()
+
+	field := invreqAmountType
+	invoiceErr := &InvoiceError{
+		ErroneousField: &field,
+		SuggestedValue: []byte{1, 2},
+		Message:        "amount too low",
+	}
+
+	decoded, err := ParseInvoiceError(invoiceErr.Serialize())
+	require.NoError(t, err)
+	require.Equal(t, invoiceErr, decoded)
+	require.EqualError(t, decoded, "amount too low")
+}
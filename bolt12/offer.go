@@ -0,0 +1,307 @@
+package bolt12
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// OfferHRP is the human readable part of an encoded offer.
+	OfferHRP = "lno"
+
+	// The following types are the records of an offer.
+	offerChainsType         tlv.Type = 2
+	offerMetadataType       tlv.Type = 4
+	offerCurrencyType       tlv.Type = 6
+	offerAmountType         tlv.Type = 8
+	offerDescriptionType    tlv.Type = 10
+	offerFeaturesType       tlv.Type = 12
+	offerAbsoluteExpiryType tlv.Type = 14
+	offerPathsType          tlv.Type = 16
+	offerIssuerType         tlv.Type = 18
+	offerQuantityMaxType    tlv.Type = 20
+	offerIssuerIDType       tlv.Type = 22
+)
+
+var (
+	// offerTypes is the range of types that are used by offers.
+	offerTypes = typeRange{min: 1, max: 79}
+
+	// offerExperimentalTypes is the range of types that are reserved for
+	// experimental offer records.
+	offerExperimentalTypes = typeRange{
+		min: 1_000_000_000, max: 1_999_999_999,
+	}
+
+	// ErrOfferExpired is returned when an offer's absolute expiry has
+	// passed.
+	ErrOfferExpired = errors.New("offer has expired")
+
+	// ErrUnsupportedCurrency is returned for offers whose amount is
+	// denominated in a currency other than bitcoin.
+	ErrUnsupportedCurrency = errors.New("offer currency is not supported")
+)
+
+// Offer is a reusable request for payment. Instead of paying the offer
+// directly, the payer uses it to request an invoice from the offer's issuer.
+type Offer struct {
+	// Chains is the set of chains that the offer is valid for. If empty,
+	// the offer is only valid for bitcoin mainnet.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data that the issuer includes in the offer, and
+	// gets back in each invoice request.
+	Metadata []byte
+
+	// Currency is the ISO 4217 code of the currency that the amount is
+	// denominated in. If empty, the amount is in millisatoshis.
+	Currency string
+
+	// Amount is the amount that is expected per item. If zero, the payer
+	// chooses the amount.
+	Amount uint64
+
+	// Description is a description of the purpose of the payment.
+	Description string
+
+	// Features is the set of features required to pay the offer.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry is the time after which the offer can no longer be
+	// paid. If zero, the offer doesn't expire.
+	AbsoluteExpiry time.Time
+
+	// Paths is an optional set of blinded paths to the issuer, which are
+	// used to send invoice requests without revealing the issuer's node.
+	Paths []*sphinx.BlindedPath
+
+	// Issuer is a description of the issuer of the offer.
+	Issuer string
+
+	// QuantityMax is the maximum number of items that can be requested.
+	// It is only valid if HasQuantity is true, and zero means that any
+	// quantity can be requested.
+	QuantityMax uint64
+
+	// HasQuantity signals that invoice requests must specify a quantity.
+	HasQuantity bool
+
+	// IssuerID is the public key that the issuer uses to sign invoices.
+	// If nil, the invoices are signed with the key of the final blinded
+	// hop of the path that the invoice request was sent along.
+	IssuerID *btcec.PublicKey
+
+	// ExtraRecords holds the odd offer records that we don't know.
+	ExtraRecords map[tlv.Type][]byte
+}
+
+// DecodeOffer decodes a bech32 encoded offer.
+func DecodeOffer(s string) (*Offer, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != OfferHRP {
+		return nil, fmt.Errorf("invalid offer prefix: %v", hrp)
+	}
+
+	return ParseOffer(data)
+}
+
+// ParseOffer parses an offer from its TLV stream.
+func ParseOffer(b []byte) (*Offer, error) {
+	recs, err := parseRecords(b)
+	if err != nil {
+		return nil, err
+	}
+
+	err = recs.checkTypes(offerTypes, offerExperimentalTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := offerFromRecords(recs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := offer.validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// Serialize returns the TLV stream of the offer.
+func (o *Offer) Serialize() ([]byte, error) {
+	recs, err := o.records()
+	if err != nil {
+		return nil, err
+	}
+
+	return recs.serialize(), nil
+}
+
+// Encode returns the bech32 encoding of the offer.
+func (o *Offer) Encode() (string, error) {
+	b, err := o.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(OfferHRP, b)
+}
+
+// records returns the offer as a set of TLV records.
+func (o *Offer) records() (records, error) {
+	recs := make(records)
+	for t, value := range o.ExtraRecords {
+		recs[t] = value
+	}
+
+	if len(o.Chains) > 0 {
+		chains := make([]byte, 0, len(o.Chains)*chainhash.HashSize)
+		for _, chain := range o.Chains {
+			chains = append(chains, chain[:]...)
+		}
+		recs[offerChainsType] = chains
+	}
+
+	recs.putBytes(offerMetadataType, o.Metadata)
+	recs.putBytes(offerCurrencyType, []byte(o.Currency))
+	recs.putTU64(offerAmountType, o.Amount)
+	recs.putBytes(offerDescriptionType, []byte(o.Description))
+	recs.putFeatures(offerFeaturesType, o.Features)
+	recs.putTime(offerAbsoluteExpiryType, o.AbsoluteExpiry)
+	if err := recs.putPaths(offerPathsType, o.Paths); err != nil {
+		return nil, err
+	}
+	recs.putBytes(offerIssuerType, []byte(o.Issuer))
+
+	// A maximum quantity of zero is encoded as an empty record, which is
+	// still meaningful.
+	if o.HasQuantity {
+		recs[offerQuantityMaxType] = []byte{}
+		recs.putTU64(offerQuantityMaxType, o.QuantityMax)
+	}
+
+	recs.putPubKey(offerIssuerIDType, o.IssuerID)
+
+	return recs, nil
+}
+
+// offerFromRecords takes the offer records out of the given set.
+func offerFromRecords(recs records) (*Offer, error) {
+	var (
+		offer Offer
+		err   error
+	)
+
+	offer.Chains, err = recs.takeHashes(offerChainsType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Metadata = recs.takeBytes(offerMetadataType)
+
+	offer.Currency, err = recs.takeString(offerCurrencyType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Amount, err = recs.takeTU64(offerAmountType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Description, err = recs.takeString(offerDescriptionType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Features, err = recs.takeFeatures(offerFeaturesType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.AbsoluteExpiry, err = recs.takeTime(offerAbsoluteExpiryType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Paths, err = recs.takePaths(offerPathsType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Issuer, err = recs.takeString(offerIssuerType)
+	if err != nil {
+		return nil, err
+	}
+
+	_, offer.HasQuantity = recs[offerQuantityMaxType]
+	offer.QuantityMax, err = recs.takeTU64(offerQuantityMaxType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.IssuerID, err = recs.takePubKey(offerIssuerIDType)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.ExtraRecords, err = recs.takeExtra(
+		offerTypes, offerExperimentalTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &offer, nil
+}
+
+// validate checks that the offer is well formed.
+func (o *Offer) validate() error {
+	switch {
+	case o.Currency != "" && o.Amount == 0:
+		return errors.New("offer has a currency but no amount")
+
+	case o.Amount != 0 && o.Description == "":
+		return errors.New("offer has an amount but no description")
+
+	case o.IssuerID == nil && len(o.Paths) == 0:
+		return errors.New("offer has neither an issuer id nor paths")
+	}
+
+	return nil
+}
+
+// SupportsChain returns true if the offer can be paid on the given chain.
+func (o *Offer) SupportsChain(params *chaincfg.Params) bool {
+	if len(o.Chains) == 0 {
+		mainnet := chaincfg.MainNetParams.GenesisHash
+		return *params.GenesisHash == *mainnet
+	}
+
+	for _, chain := range o.Chains {
+		if chain == *params.GenesisHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExpired returns true if the offer's absolute expiry has passed.
+func (o *Offer) IsExpired(now time.Time) bool {
+	return !o.AbsoluteExpiry.IsZero() && !now.Before(o.AbsoluteExpiry)
+}
@@ -0,0 +1,186 @@
+package bolt12
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestKey returns a fresh public key.
+func newTestKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return key.PubKey()
+}
+
+// newTestPath returns a blinded path with the given number of hops.
+func newTestPath(t *testing.T, numHops int) *sphinx.BlindedPath {
+	t.Helper()
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: newTestKey(t),
+		BlindingPoint:     newTestKey(t),
+	}
+	for i := 0; i < numHops; i++ {
+		path.BlindedHops = append(path.BlindedHops,
+			&sphinx.BlindedHopInfo{
+				BlindedNodePub: newTestKey(t),
+				CipherText:     []byte{byte(i), 1, 2, 3},
+			},
+		)
+	}
+
+	return path
+}
+
+// TestOfferEncoding tests that offers are encoded and decoded without losing
+// any of their fields.
+func TestOfferEncoding(t *testing.T) {
+	t.Parallel()
+
+	features := lnwire.NewRawFeatureVector()
+	features.Set(lnwire.FeatureBit(101))
+
+	testnet := *chaincfg.TestNet3Params.GenesisHash
+	offer := &Offer{
+		Chains:         []chainhash.Hash{testnet},
+		Metadata:       []byte{1, 2, 3},
+		Amount:         1000,
+		Description:    "coffee",
+		Features:       features,
+		AbsoluteExpiry: time.Unix(1_700_000_000, 0),
+		Paths:          []*sphinx.BlindedPath{newTestPath(t, 2)},
+		Issuer:         "cafe",
+		HasQuantity:    true,
+		IssuerID:       newTestKey(t),
+		ExtraRecords: map[tlv.Type][]byte{
+			51: {4, 5},
+		},
+	}
+
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "lno1"))
+
+	decoded, err := DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// Offers are also accepted in upper case, or when they are split into
+	// several parts.
+	decoded, err = DecodeOffer(strings.ToUpper(encoded))
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	split := encoded[:20] + "+ \n" + encoded[20:40] + "+" + encoded[40:]
+	decoded, err = DecodeOffer(split)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// Mixed case and empty parts are rejected.
+	_, err = DecodeOffer(strings.ToUpper(encoded[:10]) + encoded[10:])
+	require.Error(t, err)
+
+	_, err = DecodeOffer(encoded[:20] + "++" + encoded[20:])
+	require.Error(t, err)
+
+	require.True(t, decoded.SupportsChain(&chaincfg.TestNet3Params))
+	require.False(t, decoded.SupportsChain(&chaincfg.MainNetParams))
+	require.True(t, decoded.IsExpired(time.Unix(1_700_000_000, 0)))
+	require.False(t, decoded.IsExpired(time.Unix(1_600_000_000, 0)))
+}
+
+// TestOfferValidation tests that malformed offers are rejected.
+func TestOfferValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		offer *Offer
+		err   string
+	}{
+		{
+			name: "valid",
+			offer: &Offer{
+				Description: "donation",
+				IssuerID:    newTestKey(t),
+			},
+		},
+		{
+			name: "no issuer",
+			offer: &Offer{
+				Description: "donation",
+			},
+			err: "neither an issuer id nor paths",
+		},
+		{
+			name: "amount without description",
+			offer: &Offer{
+				Amount:   1000,
+				IssuerID: newTestKey(t),
+			},
+			err: "amount but no description",
+		},
+		{
+			name: "currency without amount",
+			offer: &Offer{
+				Currency:    "USD",
+				Description: "donation",
+				IssuerID:    newTestKey(t),
+			},
+			err: "currency but no amount",
+		},
+		{
+			name: "unknown even field",
+			offer: &Offer{
+				Description: "donation",
+				IssuerID:    newTestKey(t),
+				ExtraRecords: map[tlv.Type][]byte{
+					50: {1},
+				},
+			},
+			err: ErrUnknownRequiredField.Error(),
+		},
+		{
+			name: "invoice request field",
+			offer: &Offer{
+				Description: "donation",
+				IssuerID:    newTestKey(t),
+				ExtraRecords: map[tlv.Type][]byte{
+					89: {1},
+				},
+			},
+			err: "unexpected field",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := tc.offer.Encode()
+			require.NoError(t, err)
+
+			_, err = DecodeOffer(encoded)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,408 @@
+package bolt12
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// ErrUnknownRequiredField is returned when a message contains a record with
+// an unknown even type, which we are required to understand.
+var ErrUnknownRequiredField = errors.New("unknown required field")
+
+// typeRange is an inclusive range of TLV types.
+type typeRange struct {
+	min, max tlv.Type
+}
+
+// contains returns true if the type is within the range.
+func (r typeRange) contains(t tlv.Type) bool {
+	return t >= r.min && t <= r.max
+}
+
+// records is a set of raw TLV records, keyed by their type. Messages are kept
+// in this form while they are being parsed and serialized, as the signatures
+// and the copies of fields between messages operate on the raw records.
+type records map[tlv.Type][]byte
+
+// parseRecords parses a serialized TLV stream into its records. Since the
+// stream doesn't know any types, all records are returned with their raw
+// values and it's up to the caller to reject unknown even types.
+func parseRecords(b []byte) (records, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make(records, len(parsedTypes))
+	for t, value := range parsedTypes {
+		if value == nil {
+			value = []byte{}
+		}
+		recs[t] = value
+	}
+
+	return recs, nil
+}
+
+// sortedTypes returns the types of the records in ascending order.
+func (r records) sortedTypes() []tlv.Type {
+	types := make([]tlv.Type, 0, len(r))
+	for t := range r {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	return types
+}
+
+// serialize encodes the records as a TLV stream in ascending type order.
+func (r records) serialize() []byte {
+	var b bytes.Buffer
+	for _, t := range r.sortedTypes() {
+		b.Write(serializeRecord(t, r[t]))
+	}
+
+	return b.Bytes()
+}
+
+// filter returns the records whose type satisfies the predicate.
+func (r records) filter(keep func(tlv.Type) bool) records {
+	filtered := make(records)
+	for t, value := range r {
+		if keep(t) {
+			filtered[t] = value
+		}
+	}
+
+	return filtered
+}
+
+// serializeRecord encodes a single TLV record.
+func serializeRecord(t tlv.Type, value []byte) []byte {
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+
+	// Writing to a bytes.Buffer never fails.
+	_ = tlv.WriteVarInt(&b, uint64(t), &buf)
+	_ = tlv.WriteVarInt(&b, uint64(len(value)), &buf)
+	b.Write(value)
+
+	return b.Bytes()
+}
+
+// takeExtra moves the records within the given ranges that we don't know
+// into a new set, failing if any of them is an unknown even record.
+func (r records) takeExtra(ranges ...typeRange) (map[tlv.Type][]byte,
+	error) {
+
+	var extra map[tlv.Type][]byte
+	for t, value := range r {
+		inRange := false
+		for _, typeRange := range ranges {
+			if typeRange.contains(t) {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			continue
+		}
+
+		if t%2 == 0 {
+			return nil, fmt.Errorf("%w: %d",
+				ErrUnknownRequiredField, t)
+		}
+
+		if extra == nil {
+			extra = make(map[tlv.Type][]byte)
+		}
+		extra[t] = value
+		delete(r, t)
+	}
+
+	return extra, nil
+}
+
+// checkTypes ensures that all records are within one of the given ranges.
+func (r records) checkTypes(ranges ...typeRange) error {
+	for t := range r {
+		inRange := false
+		for _, typeRange := range ranges {
+			if typeRange.contains(t) {
+				inRange = true
+				break
+			}
+		}
+
+		if !inRange {
+			return fmt.Errorf("unexpected field of type %d", t)
+		}
+	}
+
+	return nil
+}
+
+// putTU64 adds a truncated uint64 record if the value is non-zero.
+func (r records) putTU64(t tlv.Type, v uint64) {
+	if v == 0 {
+		return
+	}
+
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	_ = tlv.ETUint64T(&b, v, &buf)
+	r[t] = b.Bytes()
+}
+
+// putBytes adds a record with the given value if it is non-empty.
+func (r records) putBytes(t tlv.Type, v []byte) {
+	if len(v) > 0 {
+		r[t] = v
+	}
+}
+
+// putPubKey adds a record holding a compressed public key if it is set.
+func (r records) putPubKey(t tlv.Type, key *btcec.PublicKey) {
+	if key != nil {
+		r[t] = key.SerializeCompressed()
+	}
+}
+
+// putFeatures adds a record holding the feature vector if any feature is
+// set.
+func (r records) putFeatures(t tlv.Type, fv *lnwire.RawFeatureVector) {
+	if fv == nil || fv.SerializeSize() == 0 {
+		return
+	}
+
+	var b bytes.Buffer
+	_ = fv.EncodeBase256(&b)
+	r[t] = b.Bytes()
+}
+
+// putTime adds a record holding a timestamp in seconds if it is set.
+func (r records) putTime(t tlv.Type, ts time.Time) {
+	if !ts.IsZero() {
+		r.putTU64(t, uint64(ts.Unix()))
+	}
+}
+
+// putPaths adds a record holding a list of blinded paths if any are given.
+func (r records) putPaths(t tlv.Type, paths []*sphinx.BlindedPath) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	var b bytes.Buffer
+	for _, path := range paths {
+		if err := onionmessage.EncodeBlindedPath(&b, path); err != nil {
+			return err
+		}
+	}
+	r[t] = b.Bytes()
+
+	return nil
+}
+
+// takeTU64 removes a truncated uint64 record, returning zero if the record
+// isn't present.
+func (r records) takeTU64(t tlv.Type) (uint64, error) {
+	value, ok := r[t]
+	if !ok {
+		return 0, nil
+	}
+	delete(r, t)
+
+	var (
+		v   uint64
+		buf [8]byte
+	)
+	err := tlv.DTUint64(
+		bytes.NewReader(value), &v, &buf, uint64(len(value)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("invalid field %d: %w", t, err)
+	}
+
+	return v, nil
+}
+
+// takeTU32 removes a truncated uint32 record, returning zero if the record
+// isn't present.
+func (r records) takeTU32(t tlv.Type) (uint32, error) {
+	value, ok := r[t]
+	if !ok {
+		return 0, nil
+	}
+	delete(r, t)
+
+	var (
+		v   uint32
+		buf [8]byte
+	)
+	err := tlv.DTUint32(
+		bytes.NewReader(value), &v, &buf, uint64(len(value)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("invalid field %d: %w", t, err)
+	}
+
+	return v, nil
+}
+
+// takeBytes removes a record and returns its value, or nil if the record
+// isn't present.
+func (r records) takeBytes(t tlv.Type) []byte {
+	value, ok := r[t]
+	if !ok {
+		return nil
+	}
+	delete(r, t)
+
+	return value
+}
+
+// takeString removes a record that holds a UTF-8 string.
+func (r records) takeString(t tlv.Type) (string, error) {
+	value := r.takeBytes(t)
+	if !utf8.Valid(value) {
+		return "", fmt.Errorf("field %d is not valid utf-8", t)
+	}
+
+	return string(value), nil
+}
+
+// takePubKey removes a record that holds a compressed public key, returning
+// nil if the record isn't present.
+func (r records) takePubKey(t tlv.Type) (*btcec.PublicKey, error) {
+	value, ok := r[t]
+	if !ok {
+		return nil, nil
+	}
+	delete(r, t)
+
+	key, err := btcec.ParsePubKey(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field %d: %w", t, err)
+	}
+
+	return key, nil
+}
+
+// takeFeatures removes a record that holds a feature vector, returning an
+// empty vector if the record isn't present.
+func (r records) takeFeatures(t tlv.Type) (*lnwire.RawFeatureVector,
+	error) {
+
+	fv := lnwire.NewRawFeatureVector()
+	value := r.takeBytes(t)
+	err := fv.DecodeBase256(bytes.NewReader(value), len(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid field %d: %w", t, err)
+	}
+
+	return fv, nil
+}
+
+// takeTime removes a record that holds a timestamp in seconds, returning the
+// zero time if the record isn't present.
+func (r records) takeTime(t tlv.Type) (time.Time, error) {
+	_, ok := r[t]
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	secs, err := r.takeTU64(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(secs), 0), nil
+}
+
+// takeHash removes a record that holds a 32-byte hash, returning nil if the
+// record isn't present.
+func (r records) takeHash(t tlv.Type) (*chainhash.Hash, error) {
+	value, ok := r[t]
+	if !ok {
+		return nil, nil
+	}
+	delete(r, t)
+
+	hash, err := chainhash.NewHash(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field %d: %w", t, err)
+	}
+
+	return hash, nil
+}
+
+// takeHashes removes a record that holds a list of 32-byte hashes.
+func (r records) takeHashes(t tlv.Type) ([]chainhash.Hash, error) {
+	value := r.takeBytes(t)
+	if len(value)%chainhash.HashSize != 0 {
+		return nil, fmt.Errorf("invalid field %d: length %d is not a "+
+			"multiple of %d", t, len(value), chainhash.HashSize)
+	}
+
+	var hashes []chainhash.Hash
+	for i := 0; i < len(value); i += chainhash.HashSize {
+		var hash chainhash.Hash
+		copy(hash[:], value[i:i+chainhash.HashSize])
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+// takePaths removes a record that holds a list of blinded paths.
+func (r records) takePaths(t tlv.Type) ([]*sphinx.BlindedPath, error) {
+	value, ok := r[t]
+	if !ok {
+		return nil, nil
+	}
+	delete(r, t)
+
+	if len(value) == 0 {
+		return nil, fmt.Errorf("field %d holds no blinded paths", t)
+	}
+
+	var (
+		paths  []*sphinx.BlindedPath
+		reader = bytes.NewReader(value)
+	)
+	for reader.Len() > 0 {
+		path, err := onionmessage.DecodeBlindedPath(reader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %d: %w", t, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
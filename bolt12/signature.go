@@ -0,0 +1,154 @@
+package bolt12
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// SignatureType is the type of the record that holds the signature of
+	// an invoice request or an invoice.
+	SignatureType tlv.Type = 240
+)
+
+var (
+	// signatureTypes is the range of types that are reserved for
+	// signatures, which are excluded from the merkle tree.
+	signatureTypes = typeRange{min: 240, max: 1000}
+
+	// leafTag is the tag used to hash the TLV records into leaves.
+	leafTag = []byte("LnLeaf")
+
+	// nonceTag is the prefix of the tag used to hash the nonce leaves,
+	// which is followed by the first record of the stream.
+	nonceTag = []byte("LnNonce")
+
+	// branchTag is the tag used to hash the inner nodes of the tree.
+	branchTag = []byte("LnBranch")
+
+	// ErrInvalidSignature is returned when the signature of a message
+	// doesn't match its signing key.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrMissingSignature is returned when a message that must be signed
+	// doesn't contain a signature.
+	ErrMissingSignature = errors.New("missing signature")
+)
+
+// SignFunc signs the message with a BIP-340 signature over the single SHA256
+// hash of the message.
+type SignFunc func(msg []byte) (*schnorr.Signature, error)
+
+// PrivKeySigner returns a SignFunc that signs with the given private key.
+func PrivKeySigner(privKey *btcec.PrivateKey) SignFunc {
+	return func(msg []byte) (*schnorr.Signature, error) {
+		digest := sha256.Sum256(msg)
+		return schnorr.Sign(privKey, digest[:])
+	}
+}
+
+// merkleRoot computes the merkle root of the non-signature records of a TLV
+// stream, as defined in BOLT 12.
+func merkleRoot(recs records) chainhash.Hash {
+	recs = recs.filter(func(t tlv.Type) bool {
+		return !signatureTypes.contains(t)
+	})
+
+	types := recs.sortedTypes()
+	if len(types) == 0 {
+		return chainhash.Hash{}
+	}
+
+	// The nonce tag commits to the first record of the stream, which
+	// prevents an attacker from guessing the contents of the other leaves.
+	firstRecord := serializeRecord(types[0], recs[types[0]])
+	nonceTag := append(append([]byte{}, nonceTag...), firstRecord...)
+
+	hashes := make([]chainhash.Hash, len(types))
+	for i, t := range types {
+		var (
+			typeBytes bytes.Buffer
+			buf       [8]byte
+		)
+		_ = tlv.WriteVarInt(&typeBytes, uint64(t), &buf)
+
+		leaf := chainhash.TaggedHash(
+			leafTag, serializeRecord(t, recs[t]),
+		)
+		nonce := chainhash.TaggedHash(nonceTag, typeBytes.Bytes())
+		hashes[i] = branchHash(*leaf, *nonce)
+	}
+
+	// If the number of leaves isn't a power of two, the deepest part of
+	// the tree is the one with the lowest order leaves.
+	for level := 1; level < len(hashes); level *= 2 {
+		for i := 0; i < len(hashes)-level; i += 2 * level {
+			hashes[i] = branchHash(hashes[i], hashes[i+level])
+		}
+	}
+
+	return hashes[0]
+}
+
+// branchHash computes an inner node of the merkle tree. The children are
+// sorted, so that verifiers don't need to know the order of the branches.
+func branchHash(a, b chainhash.Hash) chainhash.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+
+	return *chainhash.TaggedHash(branchTag, a[:], b[:])
+}
+
+// signatureTag returns the tag of the signature of the given message.
+func signatureTag(messageName string) []byte {
+	return []byte("lightning" + messageName + "signature")
+}
+
+// signatureMessage returns the message that is signed to create the
+// signature of a message with the given name and records. BOLT 12 defines
+// the signature over the tagged hash of the merkle root, which is equal to
+// the single SHA256 of the tag hash, repeated twice, followed by the root.
+// Returning the preimage allows any signer that hashes the message once to
+// be used.
+func signatureMessage(messageName string, recs records) []byte {
+	root := merkleRoot(recs)
+	tagHash := sha256.Sum256(signatureTag(messageName))
+
+	msg := make([]byte, 0, 3*sha256.Size)
+	msg = append(msg, tagHash[:]...)
+	msg = append(msg, tagHash[:]...)
+	msg = append(msg, root[:]...)
+
+	return msg
+}
+
+// sign signs the records of a message with the given signer.
+func sign(messageName string, recs records, signer SignFunc) (
+	*schnorr.Signature, error) {
+
+	return signer(signatureMessage(messageName, recs))
+}
+
+// verify checks the signature of the records of a message against the given
+// key.
+func verify(messageName string, recs records, sig *schnorr.Signature,
+	key *btcec.PublicKey) error {
+
+	if sig == nil {
+		return ErrMissingSignature
+	}
+
+	digest := sha256.Sum256(signatureMessage(messageName, recs))
+	if !sig.Verify(digest[:], key) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package bolt12
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMerkleRoot tests the computation of the merkle root of a TLV stream
+// against the test vectors of BOLT 12.
+func TestMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		stream string
+		root   string
+	}{
+		{
+			name:   "single record",
+			stream: "010203e8",
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2" +
+				"fa8b6c08938caa6f93",
+		},
+		{
+			name:   "two records",
+			stream: "010203e802080000010000020003",
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c6" +
+				"20a252534f434bc0d1",
+		},
+		{
+			name: "three records",
+			stream: "010203e8020800000100000200030331026" +
+				"6e4598d1d3c415f572a8488830b60f7e744ed9235e" +
+				"b0b1ba93283b315c03518000000000000000100000" +
+				"00000000002",
+			root: "ab2e79b1283b0b31e0b035258de23782df6b89a38cfa72" +
+				"37bde69aed1a658c5d",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := hex.DecodeString(tc.stream)
+			require.NoError(t, err)
+
+			recs, err := parseRecords(stream)
+			require.NoError(t, err)
+
+			root := merkleRoot(recs)
+			require.Equal(t, tc.root, hex.EncodeToString(root[:]))
+
+			// Signatures are not part of the merkle tree.
+			recs[SignatureType] = make([]byte, 64)
+			root = merkleRoot(recs)
+			require.Equal(t, tc.root, hex.EncodeToString(root[:]))
+		})
+	}
+}
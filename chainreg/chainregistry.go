@@ -29,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/chainview"
@@ -157,6 +158,11 @@ type PartialChainControl struct {
 	// notifications for received funds, etc.
 	ChainSource chain.Interface
 
+	// PackageSubmitter is used to submit packages of transactions to the
+	// mempool of the chain backend. It's nil if the chain backend doesn't
+	// support package relay.
+	PackageSubmitter btcwallet.PackageSubmitter
+
 	// RoutingPolicy is the routing policy we have decided to use.
 	RoutingPolicy models.ForwardingPolicy
 
@@ -411,6 +417,36 @@ func NewPartialChainControl(cfg *Config) (*PartialChainControl, func(), error) {
 			}
 		}
 
+		// If requested, we'll blend the fee estimates with a
+		// projection of the mempool of bitcoind. An external fee
+		// estimator would replace it, so we refuse to use both.
+		if bitcoindMode.MempoolFeeEstimator && cfg.FeeURL != "" {
+			return nil, nil, errors.New("feeurl and " +
+				"bitcoind.mempoolfeeestimator are mutually " +
+				"exclusive")
+		}
+		if bitcoindMode.MempoolFeeEstimator {
+			source, err := chainfee.NewRPCMempoolSource(*rpcConfig)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			cc.FeeEstimator = newMempoolFeeEstimator(
+				source, cc.FeeEstimator,
+			)
+		}
+
+		// Packages of transactions are submitted to bitcoind over a
+		// separate connection, as btcwallet doesn't expose the
+		// submitpackage RPC.
+		packageSubmitter, err := btcwallet.NewBitcoindPackageSubmitter(
+			*rpcConfig,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		cc.PackageSubmitter = packageSubmitter
+
 		// We need to use some apis that are not exposed by btcwallet,
 		// for a health check function so we create an ad-hoc bitcoind
 		// connection.
@@ -647,6 +683,25 @@ func NewPartialChainControl(cfg *Config) (*PartialChainControl, func(), error) {
 			}
 		}
 
+		// If requested, we'll blend the fee estimates with a
+		// projection of the mempool of btcd. An external fee
+		// estimator would replace it, so we refuse to use both.
+		if btcdMode.MempoolFeeEstimator && cfg.FeeURL != "" {
+			return nil, nil, errors.New("feeurl and " +
+				"btcd.mempoolfeeestimator are mutually " +
+				"exclusive")
+		}
+		if btcdMode.MempoolFeeEstimator {
+			source, err := chainfee.NewBtcdMempoolSource(*rpcConfig)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			cc.FeeEstimator = newMempoolFeeEstimator(
+				source, cc.FeeEstimator,
+			)
+		}
+
 	case "nochainbackend":
 		backend := &NoChainBackend{}
 		source := &NoChainSource{
@@ -714,6 +769,21 @@ func NewPartialChainControl(cfg *Config) (*PartialChainControl, func(), error) {
 	return cc, ccCleanup, nil
 }
 
+// newMempoolFeeEstimator creates a fee estimator that blends the estimates of
+// the given backend estimator with a projection of the mempool that's read
+// from the given source.
+func newMempoolFeeEstimator(source chainfee.MempoolSource,
+	backend chainfee.Estimator) chainfee.Estimator {
+
+	log.Infof("Initializing mempool fee estimator")
+
+	return chainfee.NewMempoolEstimator(chainfee.MempoolEstimatorConfig{
+		Source:         source,
+		Backend:        backend,
+		UpdateInterval: chainfee.DefaultMempoolUpdateInterval,
+	})
+}
+
 // NewChainControl attempts to create a ChainControl instance according
 // to the parameters in the passed configuration. Currently three
 // branches of ChainControl instances exist: one backed by a running btcd
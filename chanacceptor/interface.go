@@ -24,8 +24,13 @@ type ChannelAcceptRequest struct {
 	Node *btcec.PublicKey
 
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
-	// sent to us.
+	// sent to us. If the channel is dual funded, this holds the parameters
+	// of the OpenChannel2 message that the peer sent.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// DualFund indicates that the channel is opened with the dual funding
+	// protocol, so we may contribute funds to it.
+	DualFund bool
 }
 
 // ChannelAcceptResponse is a struct containing the response to a request to
@@ -66,6 +71,10 @@ type ChannelAcceptResponse struct {
 	// ZeroConf indicates that the fundee wishes to send min_depth = 0 and
 	// request a zero-conf channel with the counter-party.
 	ZeroConf bool
+
+	// FundingAmt is the amount that we contribute to a dual funded
+	// channel.
+	FundingAmt btcutil.Amount
 }
 
 // NewChannelAcceptResponse is a constructor for a channel accept response,
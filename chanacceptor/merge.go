@@ -18,6 +18,7 @@ const (
 	fieldMinIn           = "min htlc in"
 	fieldInFlightTotal   = "in flight total"
 	fieldUpfrontShutdown = "upfront shutdown"
+	fieldFundingAmt      = "funding amount"
 )
 
 var (
@@ -158,6 +159,15 @@ func mergeResponse(current,
 		return current, err
 	}
 
+	fundingAmt, err := mergeInt64(
+		fieldFundingAmt, int64(current.FundingAmt),
+		int64(newValue.FundingAmt),
+	)
+	if err != nil {
+		return current, err
+	}
+	current.FundingAmt = btcutil.Amount(fundingAmt)
+
 	current.UpfrontShutdown, err = mergeDeliveryAddress(
 		fieldUpfrontShutdown, current.UpfrontShutdown,
 		newValue.UpfrontShutdown,
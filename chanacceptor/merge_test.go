@@ -21,6 +21,7 @@ func TestMergeResponse(t *testing.T) {
 			HtlcLimit:       5,
 			MinHtlcIn:       6,
 			MinAcceptDepth:  7,
+			FundingAmt:      8,
 		}
 	)
 
@@ -58,6 +59,16 @@ func TestMergeResponse(t *testing.T) {
 			},
 			err: fieldMismatchError(fieldCSV, 1, 2),
 		},
+		{
+			name: "different funding amount",
+			current: ChannelAcceptResponse{
+				FundingAmt: 1,
+			},
+			new: ChannelAcceptResponse{
+				FundingAmt: 2,
+			},
+			err: fieldMismatchError(fieldFundingAmt, 1, 2),
+		},
 		{
 			name: "different reserve",
 			current: ChannelAcceptResponse{
@@ -43,6 +43,11 @@ var (
 	errMaxHtlcTooHigh = fmt.Errorf("htlc limit exceeds spec limit of: %v",
 		input.MaxHTLCNumber/2)
 
+	// errFundingNotDualFunded is returned when a funding amount is set
+	// for a channel that isn't dual funded.
+	errFundingNotDualFunded = errors.New("funding amount set for channel " +
+		"that isn't dual funded")
+
 	// maxErrorLength is the maximum error length we allow the error we
 	// send to our peer to be.
 	maxErrorLength = 500
@@ -217,6 +222,7 @@ func (r *RPCAcceptor) receiveResponses(errChan chan error,
 			MinHtlcIn:       resp.MinHtlcIn,
 			MinAcceptDepth:  resp.MinAcceptDepth,
 			ZeroConf:        resp.ZeroConf,
+			FundingAmt:      resp.FundingAmt,
 		}
 
 		// We have received a decision for one of our channel
@@ -405,6 +411,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				CommitmentType:   commitmentType,
 				WantsZeroConf:    wantsZeroConf,
 				WantsScidAlias:   wantsScidAlias,
+				DualFunded:       req.DualFund,
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -429,13 +436,14 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 			// valid, we log our error and proceed to deliver the
 			// rejection.
 			accept, acceptErr, shutdown, err := r.validateAcceptorResponse(
-				requestInfo.request.OpenChanMsg.DustLimit, resp,
+				requestInfo.request.OpenChanMsg.DustLimit,
+				requestInfo.request.DualFund, resp,
 			)
 			if err != nil {
 				log.Errorf("Invalid acceptor response: %v", err)
 			}
 
-			requestInfo.response <- NewChannelAcceptResponse(
+			acceptResp := NewChannelAcceptResponse(
 				accept, acceptErr, shutdown,
 				uint16(resp.CsvDelay),
 				uint16(resp.MaxHtlcCount),
@@ -445,6 +453,13 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				lnwire.MilliSatoshi(resp.MinHtlcIn),
 				resp.ZeroConf,
 			)
+			if accept {
+				acceptResp.FundingAmt = btcutil.Amount(
+					resp.FundingAmt,
+				)
+			}
+
+			requestInfo.response <- acceptResp
 
 			// Delete the channel from the acceptRequests map.
 			delete(acceptRequests, pendingID)
@@ -465,11 +480,20 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 // acceptor, returning a boolean indicating whether to accept the channel, an
 // error to send to the peer, and any validation errors that occurred.
 func (r *RPCAcceptor) validateAcceptorResponse(dustLimit btcutil.Amount,
-	req *lnrpc.ChannelAcceptResponse) (bool, error, lnwire.DeliveryAddress,
-	error) {
+	dualFund bool, req *lnrpc.ChannelAcceptResponse) (bool, error,
+	lnwire.DeliveryAddress, error) {
 
 	channelStr := hex.EncodeToString(req.PendingChanId)
 
+	// We can only contribute funds to channels that are opened with the
+	// dual funding protocol.
+	if req.FundingAmt != 0 && !dualFund {
+		log.Errorf("Funding amount: %v sat for channel: %v which "+
+			"isn't dual funded", req.FundingAmt, channelStr)
+
+		return false, errChannelRejected, nil, errFundingNotDualFunded
+	}
+
 	// Check that the max htlc count is within the BOLT 2 hard-limit of 483.
 	// The initiating side should fail values above this anyway, but we
 	// catch the invalid user input here.
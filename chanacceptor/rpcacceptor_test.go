@@ -27,6 +27,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 	tests := []struct {
 		name        string
 		dustLimit   btcutil.Amount
+		dualFund    bool
 		response    *lnrpc.ChannelAcceptResponse
 		accept      bool
 		acceptorErr error
@@ -115,6 +116,27 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			acceptorErr: errChannelRejected,
 			error:       errMaxHtlcTooHigh,
 		},
+		{
+			name: "funding amount without dual funding",
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:     true,
+				FundingAmt: 100_000,
+			},
+			accept:      false,
+			acceptorErr: errChannelRejected,
+			error:       errFundingNotDualFunded,
+		},
+		{
+			name:     "funding amount with dual funding",
+			dualFund: true,
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:     true,
+				FundingAmt: 100_000,
+			},
+			accept:      true,
+			acceptorErr: nil,
+			error:       nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -128,7 +150,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			)
 
 			accept, acceptErr, shutdown, err := acceptor.validateAcceptorResponse(
-				test.dustLimit, test.response,
+				test.dustLimit, test.dualFund, test.response,
 			)
 			require.Equal(t, test.accept, accept)
 			require.Equal(t, test.acceptorErr, acceptErr)
@@ -384,8 +384,8 @@ func (c ChannelType) IsTaproot() bool {
 // economically relevant. This struct will be mirrored for both sides of the
 // channel, as each side will enforce various constraints that MUST be adhered
 // to for the life time of the channel. The parameters for each of these
-// constraints are static for the duration of the channel, meaning the channel
-// must be torn down for them to change.
+// constraints are static for the duration of the channel, unless they're
+// changed with a dynamic commitment upgrade.
 type ChannelConstraints struct {
 	// DustLimit is the threshold (in satoshis) below which any outputs
 	// should be trimmed. When an output is trimmed, it isn't materialized
@@ -597,6 +597,12 @@ var (
 	// ChanStatusRemoteCloseInitiator indicates that the remote node
 	// initiated closing the channel.
 	ChanStatusRemoteCloseInitiator ChannelStatus = 1 << 6
+
+	// ChanStatusPendingSplice indicates that a splice transaction that
+	// spends the funding output of this channel has been signed. Until
+	// the splice confirms or is aborted, every commitment is also signed
+	// for the funding output of the splice.
+	ChanStatusPendingSplice ChannelStatus = 1 << 7
 )
 
 // chanStatusStrings maps a ChannelStatus to a human friendly string that
@@ -610,6 +616,7 @@ var chanStatusStrings = map[ChannelStatus]string{
 	ChanStatusCoopBroadcasted:      "ChanStatusCoopBroadcasted",
 	ChanStatusLocalCloseInitiator:  "ChanStatusLocalCloseInitiator",
 	ChanStatusRemoteCloseInitiator: "ChanStatusRemoteCloseInitiator",
+	ChanStatusPendingSplice:        "ChanStatusPendingSplice",
 }
 
 // orderedChanStatusFlags is an in-order list of all that channel status flags.
@@ -621,6 +628,7 @@ var orderedChanStatusFlags = []ChannelStatus{
 	ChanStatusCoopBroadcasted,
 	ChanStatusLocalCloseInitiator,
 	ChanStatusRemoteCloseInitiator,
+	ChanStatusPendingSplice,
 }
 
 // String returns a human-readable representation of the ChannelStatus.
@@ -837,6 +845,10 @@ type OpenChannel struct {
 	// default ShortChannelID. This is only set for zero-conf channels.
 	confirmedScid lnwire.ShortChannelID
 
+	// upgrades are the dynamic commitment upgrades that have been applied
+	// to the channel, ordered by height.
+	upgrades []ChannelUpgrade
+
 	// Memo is any arbitrary information we wish to store locally about the
 	// channel that will be useful to our future selves.
 	Memo []byte
@@ -996,6 +1008,13 @@ func (c *OpenChannel) Refresh() error {
 				"%v", err)
 		}
 
+		// Finally, retrieve the upgrades, as the channel info may have
+		// been changed by one.
+		if err := fetchChanUpgrades(chanBucket, c); err != nil {
+			return fmt.Errorf("unable to fetch chan upgrades: "+
+				"%v", err)
+		}
+
 		return nil
 	}, func() {})
 	if err != nil {
@@ -1050,6 +1069,30 @@ func fetchChanBucket(tx kvdb.RTx, nodeKey *btcec.PublicKey,
 	return chanBucket, nil
 }
 
+// fetchChainBucketRw returns the writeable bucket that stores the channels
+// with the given node on the given chain.
+func fetchChainBucketRw(tx kvdb.RwTx, nodeKey *btcec.PublicKey,
+	chainHash chainhash.Hash) (kvdb.RwBucket, error) {
+
+	openChanBucket := tx.ReadWriteBucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil, ErrNoChanDBExists
+	}
+
+	nodePub := nodeKey.SerializeCompressed()
+	nodeChanBucket := openChanBucket.NestedReadWriteBucket(nodePub)
+	if nodeChanBucket == nil {
+		return nil, ErrNoActiveChannels
+	}
+
+	chainBucket := nodeChanBucket.NestedReadWriteBucket(chainHash[:])
+	if chainBucket == nil {
+		return nil, ErrNoActiveChannels
+	}
+
+	return chainBucket, nil
+}
+
 // fetchChanBucketRw is a helper function that returns the bucket where a
 // channel's data resides in given: the public key for the node, the outpoint,
 // and the chainhash that the channel resides on. This differs from
@@ -1537,6 +1580,15 @@ func (c *OpenChannel) ChanSyncMsg() (*lnwire.ChannelReestablish, error) {
 		nextTaprootNonce = (*lnwire.Musig2Nonce)(&nextNonce.PubNonce)
 	}
 
+	// If the channel has been upgraded, we'll send the number of upgrades
+	// so that the remote party can complete an upgrade that it proposed,
+	// but whose acknowledgement it never received.
+	var dynHeight *lnwire.DynHeight
+	if len(c.upgrades) > 0 {
+		height := lnwire.DynHeight(len(c.upgrades))
+		dynHeight = &height
+	}
+
 	return &lnwire.ChannelReestablish{
 		ChanID: lnwire.NewChanIDFromOutPoint(
 			&c.FundingOutpoint,
@@ -1548,6 +1600,7 @@ func (c *OpenChannel) ChanSyncMsg() (*lnwire.ChannelReestablish, error) {
 			currentCommitSecret[:],
 		),
 		LocalNonce: nextTaprootNonce,
+		DynHeight:  dynHeight,
 	}, nil
 }
 
@@ -1562,7 +1615,11 @@ func (c *OpenChannel) isBorked(chanBucket kvdb.RBucket) (bool, error) {
 		return false, err
 	}
 
-	return channel.chanStatus != ChanStatusDefault, nil
+	// A channel with a pending splice continues to be updated until the
+	// splice transaction confirms.
+	status := channel.chanStatus &^ ChanStatusPendingSplice
+
+	return status != ChanStatusDefault, nil
 }
 
 // MarkCommitmentBroadcasted marks the channel as a commitment transaction has
@@ -1599,9 +1656,12 @@ func (c *OpenChannel) MarkCoopBroadcasted(closeTx *wire.MsgTx,
 // markBroadcasted is a helper function which modifies the channel status of the
 // receiving channel and inserts a close transaction under the requested key,
 // which should specify either a coop or force close. It adds a status which
-// indicates the party that initiated the channel close.
+// indicates the party that initiated the channel close. The optional closures
+// fs are given the chanBucket to atomically store extra information about the
+// close.
 func (c *OpenChannel) markBroadcasted(status ChannelStatus, key []byte,
-	closeTx *wire.MsgTx, locallyInitiated bool) error {
+	closeTx *wire.MsgTx, locallyInitiated bool,
+	fs ...func(kvdb.RwBucket) error) error {
 
 	c.Lock()
 	defer c.Unlock()
@@ -1629,7 +1689,7 @@ func (c *OpenChannel) markBroadcasted(status ChannelStatus, key []byte,
 		status |= ChanStatusRemoteCloseInitiator
 	}
 
-	return c.putChanStatus(status, putClosingTx)
+	return c.putChanStatus(status, append(fs, putClosingTx)...)
 }
 
 // BroadcastedCommitment retrieves the stored unilateral closing tx set during
@@ -1787,6 +1847,12 @@ func putOpenChannel(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 		return fmt.Errorf("unable to store chan revocations: %v", err)
 	}
 
+	// If the channel has been upgraded, we'll also store the parameters
+	// that prior commitments used.
+	if err := putChanUpgrades(chanBucket, channel); err != nil {
+		return fmt.Errorf("unable to store chan upgrades: %v", err)
+	}
+
 	return nil
 }
 
@@ -1829,6 +1895,10 @@ func fetchOpenChannel(chanBucket kvdb.RBucket,
 		return nil, fmt.Errorf("unable to fetch chan revocations: %v", err)
 	}
 
+	if err := fetchChanUpgrades(chanBucket, channel); err != nil {
+		return nil, fmt.Errorf("unable to fetch chan upgrades: %v", err)
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return channel, nil
@@ -1896,13 +1966,15 @@ func syncNewChannel(tx kvdb.RwTx, c *OpenChannel, addrs []net.Addr) error {
 // have acked, but not signed a remote commitment for yet. These need to be
 // persisted to be able to produce a valid commit signature if a restart would
 // occur. This method its to be called when we revoke our prior commitment
-// state.
+// state. If the channel is being spliced, spliceCommitment is our new
+// commitment that spends the funding output of the splice.
 //
 // A map is returned of all the htlc resolutions that were locked in this
 // commitment. Keys correspond to htlc indices and values indicate whether the
 // htlc was settled or failed.
 func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
-	unsignedAckedUpdates []LogUpdate) (map[uint64]bool, error) {
+	unsignedAckedUpdates []LogUpdate,
+	spliceCommitment *ChannelCommitment) (map[uint64]bool, error) {
 
 	c.Lock()
 	defer c.Unlock()
@@ -1948,6 +2020,13 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
 				"revocations: %v", err)
 		}
 
+		// If the channel is being spliced, our commitment that spends
+		// the new funding output is replaced along with it.
+		err = putLocalSpliceCommitment(chanBucket, spliceCommitment)
+		if err != nil {
+			return err
+		}
+
 		// Persist unsigned but acked remote updates that need to be
 		// restored after a restart.
 		var b bytes.Buffer
@@ -2366,6 +2445,12 @@ type CommitDiff struct {
 	// and also the HTLC's within the new commitment state.
 	CommitSig *lnwire.CommitSig
 
+	// SpliceCommitment is the commitment of the remote party that spends
+	// the funding output of the pending splice of the channel, at the same
+	// height as the commitment above. It's only set while the channel is
+	// being spliced.
+	SpliceCommitment *ChannelCommitment
+
 	// OpenedCircuitKeys is a set of unique identifiers for any downstream
 	// Add packets included in this commitment txn. After a restart, this
 	// set of htlcs is acked from the link's incoming mailbox to ensure
@@ -2470,7 +2555,17 @@ func serializeCommitDiff(w io.Writer, diff *CommitDiff) error { // nolint: dupl
 		}
 	}
 
-	return nil
+	// The splice commitment is only appended if the channel is being
+	// spliced, so that the diffs of other channels stay the same.
+	if diff.SpliceCommitment == nil {
+		return nil
+	}
+
+	if err := WriteElement(w, true); err != nil {
+		return err
+	}
+
+	return serializeChanCommit(w, diff.SpliceCommitment)
 }
 
 func deserializeCommitDiff(r io.Reader) (*CommitDiff, error) {
@@ -2530,6 +2625,25 @@ func deserializeCommitDiff(r io.Reader) (*CommitDiff, error) {
 		}
 	}
 
+	// Diffs of channels that aren't being spliced end here.
+	var hasSpliceCommit bool
+	err = ReadElement(r, &hasSpliceCommit)
+	switch {
+	case errors.Is(err, io.EOF):
+		return &d, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	if hasSpliceCommit {
+		spliceCommit, err := deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+		d.SpliceCommitment = &spliceCommit
+	}
+
 	return &d, nil
 }
 
@@ -2762,9 +2876,12 @@ func (c *OpenChannel) InsertNextRevocation(revKey *btcec.PublicKey) error {
 // remote party to the revocation log, and promote the current pending
 // commitment to the current remote commitment. The updates parameter is the
 // set of local updates that the peer still needs to send us a signature for.
-// We store this set of updates in case we go down.
+// We store this set of updates in case we go down. If the channel is being
+// spliced, spliceIndexes are the output indexes of the revoked remote
+// commitment that spends the funding output of the splice.
 func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg,
-	updates []LogUpdate, ourOutputIndex, theirOutputIndex uint32) error {
+	updates []LogUpdate, ourOutputIndex, theirOutputIndex uint32,
+	spliceIndexes *SpliceOutputIndexes) error {
 
 	c.Lock()
 	defer c.Unlock()
@@ -2845,6 +2962,17 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg,
 			return err
 		}
 
+		// If the channel is being spliced, the commitment of the
+		// remote party that spends the new funding output is revoked
+		// as well.
+		err = advanceSpliceCommitChainTail(
+			chanBucket, newCommit.SpliceCommitment, spliceIndexes,
+			c.Db.parent.noRevLogAmtData,
+		)
+		if err != nil {
+			return err
+		}
+
 		// Lastly, we write the forwarding package to disk so that we
 		// can properly recover from failures and reforward HTLCs that
 		// have not received a corresponding settle/fail.
@@ -3350,69 +3478,13 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary,
 			return err
 		}
 
-		// Delete all the forwarding packages stored for this particular
-		// channel.
-		if err = chanState.Packager.Wipe(tx); err != nil {
-			return err
-		}
-
-		// Now that the index to this channel has been deleted, purge
-		// the remaining channel metadata from the database.
-		err = deleteOpenChannel(chanBucket)
+		// Delete the channel state and mark its outpoint as closed in
+		// the outpoint index.
+		err = deleteChannelState(tx, chainBucket, chanKey, chanState)
 		if err != nil {
 			return err
 		}
 
-		// We'll also remove the channel from the frozen channel bucket
-		// if we need to.
-		if c.ChanType.IsFrozen() || c.ChanType.HasLeaseExpiration() {
-			err := deleteThawHeight(chanBucket)
-			if err != nil {
-				return err
-			}
-		}
-
-		// With the base channel data deleted, attempt to delete the
-		// information stored within the revocation log.
-		if err := deleteLogBucket(chanBucket); err != nil {
-			return err
-		}
-
-		err = chainBucket.DeleteNestedBucket(chanPointBuf.Bytes())
-		if err != nil {
-			return err
-		}
-
-		// Fetch the outpoint bucket to see if the outpoint exists or
-		// not.
-		opBucket := tx.ReadWriteBucket(outpointBucket)
-
-		// Add the closed outpoint to our outpoint index. This should
-		// replace an open outpoint in the index.
-		if opBucket.Get(chanPointBuf.Bytes()) == nil {
-			return ErrMissingIndexEntry
-		}
-
-		status := uint8(outpointClosed)
-
-		// Write the IndexStatus of this outpoint as the first entry in a tlv
-		// stream.
-		statusRecord := tlv.MakePrimitiveRecord(indexStatusType, &status)
-		opStream, err := tlv.NewStream(statusRecord)
-		if err != nil {
-			return err
-		}
-
-		var b bytes.Buffer
-		if err := opStream.Encode(&b); err != nil {
-			return err
-		}
-
-		// Finally add the closed outpoint and tlv stream to the index.
-		if err := opBucket.Put(chanPointBuf.Bytes(), b.Bytes()); err != nil {
-			return err
-		}
-
 		// Add channel state to the historical channel bucket.
 		historicalBucket, err := tx.CreateTopLevelBucket(
 			historicalChannelBucket,
@@ -3445,6 +3517,76 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary,
 	}, func() {})
 }
 
+// deleteChannelState deletes all state of the channel that is stored in the
+// chain bucket under chanKey, and marks its funding outpoint as closed in the
+// outpoint index.
+func deleteChannelState(tx kvdb.RwTx, chainBucket kvdb.RwBucket,
+	chanKey []byte, chanState *OpenChannel) error {
+
+	chanBucket := chainBucket.NestedReadWriteBucket(chanKey)
+	if chanBucket == nil {
+		return ErrNoActiveChannels
+	}
+
+	// Delete all the forwarding packages stored for this particular
+	// channel.
+	if err := chanState.Packager.Wipe(tx); err != nil {
+		return err
+	}
+
+	// Now that the index to this channel has been deleted, purge the
+	// remaining channel metadata from the database.
+	if err := deleteOpenChannel(chanBucket); err != nil {
+		return err
+	}
+
+	// We'll also remove the channel from the frozen channel bucket if we
+	// need to.
+	chanType := chanState.ChanType
+	if chanType.IsFrozen() || chanType.HasLeaseExpiration() {
+		if err := deleteThawHeight(chanBucket); err != nil {
+			return err
+		}
+	}
+
+	// With the base channel data deleted, attempt to delete the
+	// information stored within the revocation log.
+	if err := deleteLogBucket(chanBucket); err != nil {
+		return err
+	}
+
+	if err := chainBucket.DeleteNestedBucket(chanKey); err != nil {
+		return err
+	}
+
+	// Fetch the outpoint bucket to see if the outpoint exists or not.
+	opBucket := tx.ReadWriteBucket(outpointBucket)
+
+	// Add the closed outpoint to our outpoint index. This should replace
+	// an open outpoint in the index.
+	if opBucket.Get(chanKey) == nil {
+		return ErrMissingIndexEntry
+	}
+
+	status := uint8(outpointClosed)
+
+	// Write the IndexStatus of this outpoint as the first entry in a tlv
+	// stream.
+	statusRecord := tlv.MakePrimitiveRecord(indexStatusType, &status)
+	opStream, err := tlv.NewStream(statusRecord)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := opStream.Encode(&b); err != nil {
+		return err
+	}
+
+	// Finally add the closed outpoint and tlv stream to the index.
+	return opBucket.Put(chanKey, b.Bytes())
+}
+
 // ChannelSnapshot is a frozen snapshot of the current channel state. A
 // snapshot is detached from the original channel that generated it, providing
 // read-only access to the current or prior state of an active channel.
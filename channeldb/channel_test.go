@@ -657,7 +657,9 @@ func TestChannelStateTransition(t *testing.T) {
 		},
 	}
 
-	_, err = channel.UpdateCommitment(&commitment, unsignedAckedUpdates)
+	_, err = channel.UpdateCommitment(
+		&commitment, unsignedAckedUpdates, nil,
+	)
 	require.NoError(t, err, "unable to update commitment")
 
 	// Assert that update is correctly written to the database.
@@ -769,7 +771,7 @@ func TestChannelStateTransition(t *testing.T) {
 		diskCommitDiff.LogUpdates, nil)
 
 	err = channel.AdvanceCommitChainTail(
-		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
 	)
 	require.NoError(t, err, "unable to append to revocation log")
 
@@ -821,7 +823,7 @@ func TestChannelStateTransition(t *testing.T) {
 	fwdPkg = NewFwdPkg(channel.ShortChanID(), oldRemoteCommit.CommitHeight, nil, nil)
 
 	err = channel.AdvanceCommitChainTail(
-		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
 	)
 	require.NoError(t, err, "unable to append to revocation log")
 
@@ -1633,3 +1635,54 @@ func TestOnionBlobIncorrectLength(t *testing.T) {
 	_, err := DeserializeHtlcs(&b)
 	require.ErrorIs(t, err, ErrOnionBlobLength)
 }
+
+// TestSimpleCloseState tests that the state of a cooperative close negotiated
+// with option_simple_close is persisted along with its closing tx, and that a
+// replacement overwrites both.
+func TestSimpleCloseState(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	_, err = channel.SimpleCloseState()
+	require.ErrorIs(t, err, ErrNoSimpleCloseState)
+
+	closeTx := wire.NewMsgTx(2)
+	closeTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: channel.FundingOutpoint,
+	})
+	state := &SimpleCloseState{
+		LocalDeliveryScript:  []byte{0x00, 0x14, 0x01},
+		RemoteDeliveryScript: []byte{0x00, 0x14, 0x02},
+		LockTime:             800_000,
+		ClosingFee:           1_000,
+		FeeRate:              253,
+	}
+	err = channel.MarkSimpleCoopBroadcasted(closeTx, true, state)
+	require.NoError(t, err)
+	require.True(t, channel.HasChanStatus(ChanStatusCoopBroadcasted))
+
+	dbState, err := channel.SimpleCloseState()
+	require.NoError(t, err)
+	require.Equal(t, state, dbState)
+
+	// A replacement overwrites the closing tx and its state.
+	replacementTx := closeTx.Copy()
+	replacementTx.LockTime = 1
+	state.ClosingFee = 2_000
+	state.FeeRate = 506
+	err = channel.MarkSimpleCoopBroadcasted(replacementTx, true, state)
+	require.NoError(t, err)
+
+	dbState, err = channel.SimpleCloseState()
+	require.NoError(t, err)
+	require.Equal(t, state, dbState)
+
+	dbTx, err := channel.BroadcastedCooperative()
+	require.NoError(t, err)
+	require.Equal(t, replacementTx.TxHash(), dbTx.TxHash())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb/migration29"
 	"github.com/lightningnetwork/lnd/channeldb/migration30"
 	"github.com/lightningnetwork/lnd/channeldb/migration31"
+	"github.com/lightningnetwork/lnd/channeldb/migration32"
 	"github.com/lightningnetwork/lnd/channeldb/migration_01_to_11"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/invoices"
@@ -286,6 +287,13 @@ var (
 			number:    31,
 			migration: migration31.DeleteLastPublishedTxTLB,
 		},
+		{
+			// Indexes the payments by status, destination and
+			// creation time so that they can be filtered without
+			// deserializing all of them.
+			number:    32,
+			migration: migration32.MigratePaymentFilterIndex,
+		},
 	}
 
 	// optionalVersions stores all optional migrations that are applied
@@ -447,6 +455,12 @@ var dbTopLevelBuckets = [][]byte{
 	outpointBucket,
 	chanIDBucket,
 	historicalChannelBucket,
+	paymentsSummaryIndexBucket,
+	paymentsStatusIndexBucket,
+	paymentsDestinationIndexBucket,
+	paymentsCreationIndexBucket,
+	paymentTraceBucket,
+	paymentTraceIndexBucket,
 }
 
 // Wipe completely deletes all saved state within all used buckets within the
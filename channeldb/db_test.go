@@ -364,7 +364,7 @@ func TestRestoreChannelShells(t *testing.T) {
 	// Ensure that it isn't possible to modify the commitment state machine
 	// of this restored channel.
 	channel := nodeChans[0]
-	_, err = channel.UpdateCommitment(nil, nil)
+	_, err = channel.UpdateCommitment(nil, nil, nil)
 	if err != ErrNoRestoredChannelMutation {
 		t.Fatalf("able to mutate restored channel")
 	}
@@ -373,7 +373,7 @@ func TestRestoreChannelShells(t *testing.T) {
 		t.Fatalf("able to mutate restored channel")
 	}
 	err = channel.AdvanceCommitChainTail(
-		nil, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		nil, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
 	)
 	if err != ErrNoRestoredChannelMutation {
 		t.Fatalf("able to mutate restored channel")
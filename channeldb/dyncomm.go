@@ -0,0 +1,438 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// chanUpgradesKey stores the dynamic commitment upgrades that have
+	// been applied to a channel.
+	chanUpgradesKey = []byte("chan-upgrades-key")
+
+	// pendingUpgradeKey stores the parameters of a dynamic commitment
+	// upgrade that we've proposed, but that the remote party hasn't
+	// acknowledged yet.
+	pendingUpgradeKey = []byte("pending-upgrade-key")
+
+	// ErrNoPendingUpgrade is returned when the pending upgrade of a
+	// channel is requested, but no upgrade has been proposed.
+	ErrNoPendingUpgrade = fmt.Errorf("no pending upgrade found")
+)
+
+// ChannelParams holds the parameters of a channel that can be changed with a
+// dynamic commitment upgrade while the channel is open.
+type ChannelParams struct {
+	// ChanType is the type of the channel, which determines the format of
+	// its commitment transactions.
+	ChanType ChannelType
+
+	// LocalConstraints are the constraints of our channel config.
+	LocalConstraints ChannelConstraints
+
+	// RemoteConstraints are the constraints of the channel config of the
+	// remote party.
+	RemoteConstraints ChannelConstraints
+}
+
+// ChannelUpgrade records a dynamic commitment upgrade of a channel, along
+// with the parameters that were in effect before it. The new parameters apply
+// to our commitments from LocalHeight and to the commitments of the remote
+// party from RemoteHeight onward.
+type ChannelUpgrade struct {
+	// LocalHeight is the height of our first commitment that uses the
+	// new parameters.
+	LocalHeight uint64
+
+	// RemoteHeight is the height of the first commitment of the remote
+	// party that uses the new parameters.
+	RemoteHeight uint64
+
+	// PrevParams are the parameters of the commitments prior to the
+	// upgrade.
+	PrevParams ChannelParams
+}
+
+// writeChanConstraints writes the channel constraints to the passed writer.
+func writeChanConstraints(w io.Writer, c *ChannelConstraints) error {
+	return WriteElements(w,
+		c.DustLimit, c.MaxPendingAmount, c.ChanReserve, c.MinHTLC,
+		c.MaxAcceptedHtlcs, c.CsvDelay,
+	)
+}
+
+// readChanConstraints reads channel constraints that were written with
+// writeChanConstraints.
+func readChanConstraints(r io.Reader, c *ChannelConstraints) error {
+	return ReadElements(r,
+		&c.DustLimit, &c.MaxPendingAmount, &c.ChanReserve, &c.MinHTLC,
+		&c.MaxAcceptedHtlcs, &c.CsvDelay,
+	)
+}
+
+// serializeChannelParams writes the channel parameters to the passed writer.
+func serializeChannelParams(w io.Writer, p *ChannelParams) error {
+	if err := WriteElement(w, p.ChanType); err != nil {
+		return err
+	}
+
+	if err := writeChanConstraints(w, &p.LocalConstraints); err != nil {
+		return err
+	}
+
+	return writeChanConstraints(w, &p.RemoteConstraints)
+}
+
+// deserializeChannelParams reads channel parameters that were written with
+// serializeChannelParams.
+func deserializeChannelParams(r io.Reader) (*ChannelParams, error) {
+	p := &ChannelParams{}
+	if err := ReadElement(r, &p.ChanType); err != nil {
+		return nil, err
+	}
+
+	if err := readChanConstraints(r, &p.LocalConstraints); err != nil {
+		return nil, err
+	}
+
+	if err := readChanConstraints(r, &p.RemoteConstraints); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// putChanUpgrades stores the upgrades of the channel, if it has any.
+func putChanUpgrades(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
+	if len(channel.upgrades) == 0 {
+		return nil
+	}
+
+	var b bytes.Buffer
+	err := WriteElement(&b, uint32(len(channel.upgrades)))
+	if err != nil {
+		return err
+	}
+
+	for i := range channel.upgrades {
+		upgrade := &channel.upgrades[i]
+		err := WriteElements(
+			&b, upgrade.LocalHeight, upgrade.RemoteHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = serializeChannelParams(&b, &upgrade.PrevParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	return chanBucket.Put(chanUpgradesKey, b.Bytes())
+}
+
+// fetchChanUpgrades reads the upgrades of the channel. Channels that have
+// never been upgraded don't store any.
+func fetchChanUpgrades(chanBucket kvdb.RBucket, channel *OpenChannel) error {
+	upgradeBytes := chanBucket.Get(chanUpgradesKey)
+	if upgradeBytes == nil {
+		channel.upgrades = nil
+		return nil
+	}
+
+	r := bytes.NewReader(upgradeBytes)
+
+	var numUpgrades uint32
+	if err := ReadElement(r, &numUpgrades); err != nil {
+		return err
+	}
+
+	upgrades := make([]ChannelUpgrade, numUpgrades)
+	for i := range upgrades {
+		err := ReadElements(
+			r, &upgrades[i].LocalHeight, &upgrades[i].RemoteHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		params, err := deserializeChannelParams(r)
+		if err != nil {
+			return err
+		}
+		upgrades[i].PrevParams = *params
+	}
+
+	channel.upgrades = upgrades
+
+	return nil
+}
+
+// params returns the current parameters of the channel.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) params() ChannelParams {
+	return ChannelParams{
+		ChanType:          c.ChanType,
+		LocalConstraints:  c.LocalChanCfg.ChannelConstraints,
+		RemoteConstraints: c.RemoteChanCfg.ChannelConstraints,
+	}
+}
+
+// setParams replaces the parameters of the channel.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) setParams(p *ChannelParams) {
+	c.ChanType = p.ChanType
+	c.LocalChanCfg.ChannelConstraints = p.LocalConstraints
+	c.RemoteChanCfg.ChannelConstraints = p.RemoteConstraints
+}
+
+// paramsAt returns the parameters of the commitment of the local or remote
+// party at the given height.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) paramsAt(local bool, height uint64) ChannelParams {
+	// The upgrades are ordered by height, so the parameters of the first
+	// upgrade that applies from a later height are the ones that were in
+	// effect.
+	for _, upgrade := range c.upgrades {
+		upgradeHeight := upgrade.RemoteHeight
+		if local {
+			upgradeHeight = upgrade.LocalHeight
+		}
+
+		if height < upgradeHeight {
+			return upgrade.PrevParams
+		}
+	}
+
+	return c.params()
+}
+
+// Params returns the current parameters of the channel.
+func (c *OpenChannel) Params() ChannelParams {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.params()
+}
+
+// ParamsAt returns the parameters of the commitment of the local or remote
+// party at the given height.
+func (c *OpenChannel) ParamsAt(local bool, height uint64) ChannelParams {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.paramsAt(local, height)
+}
+
+// NumUpgrades returns the number of dynamic commitment upgrades that have
+// been applied to the channel.
+func (c *OpenChannel) NumUpgrades() uint64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	return uint64(len(c.upgrades))
+}
+
+// UpgradeHeights returns the heights of our commitment and of the commitment
+// of the remote party from which the current parameters of the channel
+// apply. Both are zero if the channel has never been upgraded.
+func (c *OpenChannel) UpgradeHeights() (uint64, uint64) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(c.upgrades) == 0 {
+		return 0, 0
+	}
+
+	upgrade := c.upgrades[len(c.upgrades)-1]
+
+	return upgrade.LocalHeight, upgrade.RemoteHeight
+}
+
+// ChannelAtHeight returns the channel with the parameters that were in effect
+// for the commitment of the local or remote party at the given height. The
+// channel is read from disk, as the upgrades may have been applied through
+// another instance of the channel. If the channel has never been upgraded or
+// is no longer open, the channel itself is returned.
+func (c *OpenChannel) ChannelAtHeight(local bool,
+	height uint64) (*OpenChannel, error) {
+
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.Db == nil {
+		return c, nil
+	}
+
+	var channel *OpenChannel
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+
+		return err
+	}, func() {
+		channel = nil
+	})
+	switch err {
+	case nil:
+	case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+		return c, nil
+	default:
+		return nil, err
+	}
+
+	if len(channel.upgrades) == 0 {
+		return c, nil
+	}
+
+	params := channel.paramsAt(local, height)
+	channel.setParams(&params)
+	channel.Db = c.Db
+
+	return channel, nil
+}
+
+// ApplyUpgrade replaces the parameters of the channel with the new
+// parameters of a dynamic commitment upgrade, which apply to the commitments
+// of both parties from their next height onward. The parameters that were in
+// effect before are recorded, so that prior commitments can still be
+// resolved. A pending upgrade of the channel is removed.
+func (c *OpenChannel) ApplyUpgrade(params *ChannelParams) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var upgrades []ChannelUpgrade
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.upgrades = append(channel.upgrades, ChannelUpgrade{
+			LocalHeight:  channel.LocalCommitment.CommitHeight + 1,
+			RemoteHeight: channel.RemoteCommitment.CommitHeight + 1,
+			PrevParams:   channel.params(),
+		})
+		channel.setParams(params)
+
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		upgrades = channel.upgrades
+
+		return chanBucket.Delete(pendingUpgradeKey)
+	}, func() {
+		upgrades = nil
+	}); err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	c.setParams(params)
+	c.upgrades = upgrades
+
+	return nil
+}
+
+// MarkUpgradePending stores the parameters of a dynamic commitment upgrade
+// that we're about to propose, so that the upgrade can be applied if the
+// remote party acknowledged it while we were disconnected. A previously
+// stored upgrade is replaced.
+func (c *OpenChannel) MarkUpgradePending(params *ChannelParams) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var b bytes.Buffer
+	if err := serializeChannelParams(&b, params); err != nil {
+		return err
+	}
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(pendingUpgradeKey, b.Bytes())
+	}, func() {})
+}
+
+// PendingUpgrade returns the parameters of the pending upgrade of the
+// channel. If no upgrade has been proposed, ErrNoPendingUpgrade is returned.
+func (c *OpenChannel) PendingUpgrade() (*ChannelParams, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var params *ChannelParams
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoPendingUpgrade
+		default:
+			return err
+		}
+
+		paramBytes := chanBucket.Get(pendingUpgradeKey)
+		if paramBytes == nil {
+			return ErrNoPendingUpgrade
+		}
+
+		params, err = deserializeChannelParams(
+			bytes.NewReader(paramBytes),
+		)
+
+		return err
+	}, func() {
+		params = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// ClearPendingUpgrade removes the pending upgrade of the channel, once the
+// remote party has rejected it or never received it.
+func (c *OpenChannel) ClearPendingUpgrade() error {
+	c.Lock()
+	defer c.Unlock()
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(pendingUpgradeKey)
+	}, func() {})
+}
@@ -0,0 +1,77 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelUpgrade tests that a pending upgrade can be stored and removed,
+// and that applying an upgrade records the prior parameters of the channel
+// for the commitments from before the upgrade.
+func TestChannelUpgrade(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	_, err = channel.PendingUpgrade()
+	require.ErrorIs(t, err, ErrNoPendingUpgrade)
+	require.Zero(t, channel.NumUpgrades())
+
+	prevParams := channel.Params()
+
+	params := prevParams
+	params.ChanType |= AnchorOutputsBit | ZeroHtlcTxFeeBit
+	params.LocalConstraints.CsvDelay++
+	params.RemoteConstraints.DustLimit++
+
+	// A pending upgrade is stored until it's removed.
+	require.NoError(t, channel.MarkUpgradePending(&params))
+
+	pending, err := channel.PendingUpgrade()
+	require.NoError(t, err)
+	require.Equal(t, params, *pending)
+
+	require.NoError(t, channel.ClearPendingUpgrade())
+	_, err = channel.PendingUpgrade()
+	require.ErrorIs(t, err, ErrNoPendingUpgrade)
+
+	// Applying the upgrade removes the pending upgrade, and the new
+	// parameters apply from the next height of both commitments.
+	require.NoError(t, channel.MarkUpgradePending(&params))
+	require.NoError(t, channel.ApplyUpgrade(&params))
+
+	_, err = channel.PendingUpgrade()
+	require.ErrorIs(t, err, ErrNoPendingUpgrade)
+	require.EqualValues(t, 1, channel.NumUpgrades())
+	require.Equal(t, params, channel.Params())
+
+	localHeight, remoteHeight := channel.UpgradeHeights()
+	require.Equal(t, channel.LocalCommitment.CommitHeight+1, localHeight)
+	require.Equal(t, channel.RemoteCommitment.CommitHeight+1, remoteHeight)
+
+	require.Equal(t, prevParams, channel.ParamsAt(true, localHeight-1))
+	require.Equal(t, params, channel.ParamsAt(true, localHeight))
+	require.Equal(t, prevParams, channel.ParamsAt(false, remoteHeight-1))
+	require.Equal(t, params, channel.ParamsAt(false, remoteHeight))
+
+	// The upgrade is persisted with the channel.
+	dbChan, err := cdb.FetchChannel(nil, channel.FundingOutpoint)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, dbChan.NumUpgrades())
+	require.Equal(t, params, dbChan.Params())
+	require.Equal(t, prevParams, dbChan.ParamsAt(true, localHeight-1))
+
+	// The channel at a height before the upgrade has the prior parameters.
+	prevChan, err := channel.ChannelAtHeight(true, localHeight-1)
+	require.NoError(t, err)
+	require.Equal(t, prevParams, prevChan.Params())
+
+	curChan, err := channel.ChannelAtHeight(true, localHeight)
+	require.NoError(t, err)
+	require.Equal(t, params, curChan.Params())
+}
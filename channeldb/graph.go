@@ -2,6 +2,7 @@ package channeldb
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
@@ -22,6 +23,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/aliasmgr"
 	"github.com/lightningnetwork/lnd/batch"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -186,6 +188,10 @@ type ChannelGraph struct {
 
 	chanScheduler batch.Scheduler
 	nodeScheduler batch.Scheduler
+
+	// sqlStore is the native SQL store that holds the graph instead of the
+	// key-value database if it is set. See UseSQLStore.
+	sqlStore *SQLGraphStore
 }
 
 // NewChannelGraph allocates a new ChannelGraph backed by a DB instance. The
@@ -249,6 +255,70 @@ func NewChannelGraph(db kvdb.Backend, rejectCacheSize, chanCacheSize int,
 	return g, nil
 }
 
+// UseSQLStore switches the channel graph over to the passed native SQL graph
+// store. All nodes and channels are read from and written to the SQL store
+// from then on, while the graph cache is kept in memory as before. If the SQL
+// store is still empty, the graph in the key-value database is migrated into
+// it first.
+//
+// NOTE: The graph in the key-value database isn't updated anymore once the
+// SQL store is used, so the node can't switch back to it.
+func (c *ChannelGraph) UseSQLStore(ctx context.Context,
+	store *SQLGraphStore) error {
+
+	// Without the graph cache, path finding would need a key-value
+	// transaction to traverse the graph.
+	if c.graphCache == nil {
+		return fmt.Errorf("the native SQL graph store requires the " +
+			"graph cache")
+	}
+
+	isEmpty, err := store.isEmpty(ctx)
+	if err != nil {
+		return err
+	}
+	if isEmpty {
+		if err := MigrateGraphToSQL(ctx, c, store.db); err != nil {
+			return fmt.Errorf("unable to migrate graph to SQL "+
+				"store: %w", err)
+		}
+	}
+
+	// The graph cache was populated from the key-value database, which
+	// may be behind the SQL store, so we populate it again.
+	graphCache := NewGraphCache(len(c.graphCache.nodeFeatures))
+	err = store.ForEachNode(func(node *LightningNode) error {
+		graphCache.AddNodeFeatures(
+			newGraphCacheNode(node.PubKeyBytes, node.Features),
+		)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = store.ForEachChannel(func(info *ChannelEdgeInfo,
+		policy1, policy2 *ChannelEdgePolicy) error {
+
+		graphCache.AddChannel(info, policy1, policy2)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Using native SQL channel graph store (%s)",
+		graphCache.Stats())
+
+	store.graphCache = graphCache
+	c.graphCache = graphCache
+	c.sqlStore = store
+
+	return nil
+}
+
 // channelMapKey is the key structure used for storing channel edge policies.
 type channelMapKey struct {
 	nodeKey route.Vertex
@@ -325,6 +395,10 @@ var graphTopLevelBuckets = [][]byte{
 // database. The deletion is done in a single transaction, therefore this
 // operation is fully atomic.
 func (c *ChannelGraph) Wipe() error {
+	if c.sqlStore != nil {
+		return c.sqlStore.Wipe()
+	}
+
 	err := kvdb.Update(c.db, func(tx kvdb.RwTx) error {
 		for _, tlb := range graphTopLevelBuckets {
 			err := tx.DeleteTopLevelBucket(tlb)
@@ -413,6 +487,9 @@ func (c *ChannelGraph) NewPathFindTx() (kvdb.RTx, error) {
 // callback.
 func (c *ChannelGraph) ForEachChannel(cb func(*ChannelEdgeInfo,
 	*ChannelEdgePolicy, *ChannelEdgePolicy) error) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.ForEachChannel(cb)
+	}
 
 	return c.db.View(func(tx kvdb.RTx) error {
 		edges := tx.ReadBucket(edgeBucket)
@@ -502,6 +579,14 @@ func (c *ChannelGraph) ForEachNodeChannel(tx kvdb.RTx, node route.Vertex,
 			InPolicy:     cachedInPolicy,
 		}
 
+		if p1 != nil {
+			directedChannel.InboundFee = p1.inboundFee()
+			if cachedInPolicy != nil {
+				cachedInPolicy.InboundFee =
+					directedChannel.InboundFee
+			}
+		}
+
 		if node == e.NodeKey2Bytes {
 			directedChannel.OtherNode = e.NodeKey1Bytes
 		}
@@ -587,6 +672,10 @@ func (c *ChannelGraph) ForEachNodeCached(cb func(node route.Vertex,
 				InPolicy:     cachedInPolicy,
 			}
 
+			if p1 != nil {
+				directedChannel.InboundFee = p1.inboundFee()
+			}
+
 			if node.PubKeyBytes == e.NodeKey2Bytes {
 				directedChannel.OtherNode = e.NodeKey1Bytes
 			}
@@ -607,6 +696,10 @@ func (c *ChannelGraph) ForEachNodeCached(cb func(node route.Vertex,
 // A channel is disabled when two of the associated ChanelEdgePolicies
 // have their disabled bit on.
 func (c *ChannelGraph) DisabledChannelIDs() ([]uint64, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.DisabledChannelIDs()
+	}
+
 	var disabledChanIDs []uint64
 	var chanEdgeFound map[uint64]struct{}
 
@@ -657,6 +750,13 @@ func (c *ChannelGraph) DisabledChannelIDs() ([]uint64, error) {
 // traversal when graph gets mega
 func (c *ChannelGraph) ForEachNode(
 	cb func(kvdb.RTx, *LightningNode) error) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.ForEachNode(func(node *LightningNode) error {
+			node.sqlStore = c.sqlStore
+
+			return cb(nil, node)
+		})
+	}
 
 	traversal := func(tx kvdb.RTx) error {
 		// First grab the nodes bucket which stores the mapping from
@@ -696,6 +796,13 @@ func (c *ChannelGraph) ForEachNode(
 // stops early.
 func (c *ChannelGraph) ForEachNodeCacheable(cb func(kvdb.RTx,
 	GraphCacheNode) error) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.ForEachNode(func(node *LightningNode) error {
+			return cb(nil, newGraphCacheNode(
+				node.PubKeyBytes, node.Features,
+			))
+		})
+	}
 
 	traversal := func(tx kvdb.RTx) error {
 		// First grab the nodes bucket which stores the mapping from
@@ -735,6 +842,16 @@ func (c *ChannelGraph) ForEachNodeCacheable(cb func(kvdb.RTx,
 // a path finding algorithm in order to explore the reachability of another
 // node based off the source node.
 func (c *ChannelGraph) SourceNode() (*LightningNode, error) {
+	if c.sqlStore != nil {
+		node, err := c.sqlStore.SourceNode()
+		if err != nil {
+			return nil, err
+		}
+		node.sqlStore = c.sqlStore
+
+		return node, nil
+	}
+
 	var source *LightningNode
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
 		// First grab the nodes bucket which stores the mapping from
@@ -786,6 +903,10 @@ func (c *ChannelGraph) sourceNode(nodes kvdb.RBucket) (*LightningNode, error) {
 // node is to be used as the center of a star-graph within path finding
 // algorithms.
 func (c *ChannelGraph) SetSourceNode(node *LightningNode) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.SetSourceNode(node)
+	}
+
 	nodePubBytes := node.PubKeyBytes[:]
 
 	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
@@ -818,6 +939,9 @@ func (c *ChannelGraph) SetSourceNode(node *LightningNode) error {
 // TODO(roasbeef): also need sig of announcement
 func (c *ChannelGraph) AddLightningNode(node *LightningNode,
 	op ...batch.SchedulerOption) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.AddLightningNode(node, op...)
+	}
 
 	r := &batch.Request{
 		Update: func(tx kvdb.RwTx) error {
@@ -866,6 +990,10 @@ func addLightningNode(tx kvdb.RwTx, node *LightningNode) error {
 // LookupAlias attempts to return the alias as advertised by the target node.
 // TODO(roasbeef): currently assumes that aliases are unique...
 func (c *ChannelGraph) LookupAlias(pub *btcec.PublicKey) (string, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.LookupAlias(pub)
+	}
+
 	var alias string
 
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
@@ -902,6 +1030,10 @@ func (c *ChannelGraph) LookupAlias(pub *btcec.PublicKey) (string, error) {
 // DeleteLightningNode starts a new database transaction to remove a vertex/node
 // from the database according to the node's public key.
 func (c *ChannelGraph) DeleteLightningNode(nodePub route.Vertex) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.DeleteLightningNode(nodePub)
+	}
+
 	// TODO(roasbeef): ensure dangling edges are removed...
 	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
 		nodes := tx.ReadWriteBucket(nodeBucket)
@@ -969,6 +1101,9 @@ func (c *ChannelGraph) deleteLightningNode(nodes kvdb.RwBucket,
 // globally within the database.
 func (c *ChannelGraph) AddChannelEdge(edge *ChannelEdgeInfo,
 	op ...batch.SchedulerOption) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.AddChannelEdge(edge, op...)
+	}
 
 	var alreadyExists bool
 	r := &batch.Request{
@@ -1115,6 +1250,9 @@ func (c *ChannelGraph) addChannelEdge(tx kvdb.RwTx, edge *ChannelEdgeInfo) error
 // as the second boolean.
 func (c *ChannelGraph) HasChannelEdge(
 	chanID uint64) (time.Time, time.Time, bool, bool, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.HasChannelEdge(chanID)
+	}
 
 	var (
 		upd1Time time.Time
@@ -1221,6 +1359,10 @@ func (c *ChannelGraph) HasChannelEdge(
 // that an edge info hasn't yet been created yet, but someone attempts to update
 // it.
 func (c *ChannelGraph) UpdateChannelEdge(edge *ChannelEdgeInfo) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.UpdateChannelEdge(edge)
+	}
+
 	// Construct the channel's primary key which is the 8-byte channel ID.
 	var chanKey [8]byte
 	binary.BigEndian.PutUint64(chanKey[:], edge.ChannelID)
@@ -1266,6 +1408,9 @@ const (
 // the target block are returned if the function succeeds without error.
 func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 	blockHash *chainhash.Hash, blockHeight uint32) ([]*ChannelEdgeInfo, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.PruneGraph(spentOutputs, blockHash, blockHeight)
+	}
 
 	c.cacheMu.Lock()
 	defer c.cacheMu.Unlock()
@@ -1393,6 +1538,10 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 // that we only maintain a graph of reachable nodes. In the event that a pruned
 // node gains more channels, it will be re-added back to the graph.
 func (c *ChannelGraph) PruneGraphNodes() error {
+	if c.sqlStore != nil {
+		return c.sqlStore.PruneGraphNodes()
+	}
+
 	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
 		nodes := tx.ReadWriteBucket(nodeBucket)
 		if nodes == nil {
@@ -1520,6 +1669,9 @@ func (c *ChannelGraph) pruneGraphNodes(nodes kvdb.RwBucket,
 // disconnected block are returned.
 func (c *ChannelGraph) DisconnectBlockAtHeight(height uint32) ([]*ChannelEdgeInfo,
 	error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.DisconnectBlockAtHeight(height)
+	}
 
 	// Every channel having a ShortChannelID starting at 'height'
 	// will no longer be confirmed.
@@ -1651,6 +1803,10 @@ func (c *ChannelGraph) DisconnectBlockAtHeight(height uint32) ([]*ChannelEdgeInf
 // to tell if the graph is currently in sync with the current best known UTXO
 // state.
 func (c *ChannelGraph) PruneTip() (*chainhash.Hash, uint32, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.PruneTip()
+	}
+
 	var (
 		tipHash   chainhash.Hash
 		tipHeight uint32
@@ -1699,6 +1855,11 @@ func (c *ChannelGraph) PruneTip() (*chainhash.Hash, uint32, error) {
 // denotes whether or not to mark the channel as a zombie.
 func (c *ChannelGraph) DeleteChannelEdges(strictZombiePruning, markZombie bool,
 	chanIDs ...uint64) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.DeleteChannelEdges(
+			strictZombiePruning, markZombie, chanIDs...,
+		)
+	}
 
 	// TODO(roasbeef): possibly delete from node bucket if node has no more
 	// channels
@@ -1759,6 +1920,10 @@ func (c *ChannelGraph) DeleteChannelEdges(strictZombiePruning, markZombie bool,
 // passed channel point (outpoint). If the passed channel doesn't exist within
 // the database, then ErrEdgeNotFound is returned.
 func (c *ChannelGraph) ChannelID(chanPoint *wire.OutPoint) (uint64, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.ChannelID(chanPoint)
+	}
+
 	var chanID uint64
 	if err := kvdb.View(c.db, func(tx kvdb.RTx) error {
 		var err error
@@ -1805,6 +1970,10 @@ func getChanID(tx kvdb.RTx, chanPoint *wire.OutPoint) (uint64, error) {
 // This represents the "newest" channel from the PoV of the chain. This method
 // can be used by peers to quickly determine if they're graphs are in sync.
 func (c *ChannelGraph) HighestChanID() (uint64, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.HighestChanID()
+	}
+
 	var cid uint64
 
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
@@ -1863,6 +2032,9 @@ type ChannelEdge struct {
 // one edge that has an update timestamp within the specified horizon.
 func (c *ChannelGraph) ChanUpdatesInHorizon(startTime,
 	endTime time.Time) ([]ChannelEdge, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.ChanUpdatesInHorizon(startTime, endTime)
+	}
 
 	// To ensure we don't return duplicate ChannelEdges, we'll use an
 	// additional map to keep track of the edges already seen to prevent
@@ -1998,6 +2170,9 @@ func (c *ChannelGraph) ChanUpdatesInHorizon(startTime,
 // announcements.
 func (c *ChannelGraph) NodeUpdatesInHorizon(startTime,
 	endTime time.Time) ([]LightningNode, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.NodeUpdatesInHorizon(startTime, endTime)
+	}
 
 	var nodesInHorizon []LightningNode
 
@@ -2063,6 +2238,10 @@ func (c *ChannelGraph) NodeUpdatesInHorizon(startTime,
 // passed in. This method can be used by callers to determine the set of
 // channels another peer knows of that we don't.
 func (c *ChannelGraph) FilterKnownChanIDs(chanIDs []uint64) ([]uint64, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.FilterKnownChanIDs(chanIDs)
+	}
+
 	var newChanIDs []uint64
 
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
@@ -2138,6 +2317,9 @@ type BlockChannelRange struct {
 // up after a period of time offline.
 func (c *ChannelGraph) FilterChannelRange(startHeight,
 	endHeight uint32) ([]BlockChannelRange, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.FilterChannelRange(startHeight, endHeight)
+	}
 
 	startChanID := &lnwire.ShortChannelID{
 		BlockHeight: startHeight,
@@ -2234,6 +2416,10 @@ func (c *ChannelGraph) FilterChannelRange(startHeight,
 // of the query. This can be used to respond to peer queries that are seeking to
 // fill in gaps in their view of the channel graph.
 func (c *ChannelGraph) FetchChanInfos(chanIDs []uint64) ([]ChannelEdge, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.FetchChanInfos(chanIDs)
+	}
+
 	// TODO(roasbeef): sort cids?
 
 	var (
@@ -2471,6 +2657,9 @@ func makeZombiePubkeys(info *ChannelEdgeInfo,
 // nodes on either side of the channel.
 func (c *ChannelGraph) UpdateEdgePolicy(edge *ChannelEdgePolicy,
 	op ...batch.SchedulerOption) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.UpdateEdgePolicy(edge, op...)
+	}
 
 	var (
 		isUpdate1    bool
@@ -2659,6 +2848,10 @@ type LightningNode struct {
 
 	db kvdb.Backend
 
+	// sqlStore is the native SQL store that the node was read from, if
+	// the graph isn't stored in the key-value database.
+	sqlStore *SQLGraphStore
+
 	// TODO(roasbeef): discovery will need storage to keep it's last IP
 	// address and re-announce if interface changes?
 
@@ -2783,6 +2976,15 @@ func (l *LightningNode) isPublic(tx kvdb.RTx, sourcePubKey []byte) (bool, error)
 // returned.
 func (c *ChannelGraph) FetchLightningNode(nodePub route.Vertex) (
 	*LightningNode, error) {
+	if c.sqlStore != nil {
+		node, err := c.sqlStore.FetchLightningNode(nodePub)
+		if err != nil {
+			return nil, err
+		}
+		node.sqlStore = c.sqlStore
+
+		return node, nil
+	}
 
 	var node *LightningNode
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
@@ -2872,6 +3074,10 @@ var _ GraphCacheNode = (*graphCacheNode)(nil)
 // with a true boolean. Otherwise, an empty time.Time is returned with a false
 // boolean.
 func (c *ChannelGraph) HasLightningNode(nodePub [33]byte) (time.Time, bool, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.HasLightningNode(nodePub)
+	}
+
 	var (
 		updateTime time.Time
 		exists     bool
@@ -3020,6 +3226,18 @@ func (l *LightningNode) ForEachChannel(tx kvdb.RTx,
 	cb func(kvdb.RTx, *ChannelEdgeInfo, *ChannelEdgePolicy,
 		*ChannelEdgePolicy) error) error {
 
+	if l.sqlStore != nil {
+		return l.sqlStore.ForEachNodeChannel(l.PubKeyBytes,
+			func(info *ChannelEdgeInfo, p1,
+				p2 *ChannelEdgePolicy) error {
+
+				info.sqlStore = l.sqlStore
+
+				return cb(nil, info, p1, p2)
+			},
+		)
+	}
+
 	nodePub := l.PubKeyBytes[:]
 	db := l.db
 
@@ -3087,6 +3305,10 @@ type ChannelEdgeInfo struct {
 	ExtraOpaqueData []byte
 
 	db kvdb.Backend
+
+	// sqlStore is the native SQL store that the channel was read from, if
+	// the graph isn't stored in the key-value database.
+	sqlStore *SQLGraphStore
 }
 
 // AddNodeKeys is a setter-like method that can be used to replace the set of
@@ -3223,6 +3445,18 @@ func (c *ChannelEdgeInfo) FetchOtherNode(tx kvdb.RTx,
 		return nil, fmt.Errorf("node not participating in this channel")
 	}
 
+	if c.sqlStore != nil {
+		targetNode, err := c.sqlStore.FetchLightningNode(
+			targetNodeBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		targetNode.sqlStore = c.sqlStore
+
+		return targetNode, nil
+	}
+
 	var targetNode *LightningNode
 	fetchNodeFunc := func(tx kvdb.RTx) error {
 		// First grab the nodes bucket which stores the mapping from
@@ -3483,6 +3717,38 @@ func (c *ChannelEdgePolicy) IsDisabled() bool {
 	return c.ChannelFlags.IsDisabled()
 }
 
+// InboundFee returns the inbound fee that's carried in the extra opaque data of
+// the policy. A policy without an inbound fee has a zero inbound fee.
+func (c *ChannelEdgePolicy) InboundFee() (models.InboundFee, error) {
+	var (
+		fee       lnwire.Fee
+		extraData = lnwire.ExtraOpaqueData(c.ExtraOpaqueData)
+	)
+	typeMap, err := extraData.ExtractRecords(&fee)
+	if err != nil {
+		return models.InboundFee{}, err
+	}
+
+	if _, ok := typeMap[lnwire.FeeRecordType]; !ok {
+		return models.InboundFee{}, nil
+	}
+
+	return models.NewInboundFeeFromWire(fee), nil
+}
+
+// inboundFee returns the inbound fee of the policy, treating a malformed fee
+// record as no inbound fee at all, so that the policy can still be used for
+// path finding.
+func (c *ChannelEdgePolicy) inboundFee() models.InboundFee {
+	fee, err := c.InboundFee()
+	if err != nil {
+		log.Debugf("Unable to parse inbound fee of channel %v: %v",
+			c.ChannelID, err)
+	}
+
+	return fee
+}
+
 // ComputeFee computes the fee to forward an HTLC of `amt` milli-satoshis over
 // the passed active payment channel. This value is currently computed as
 // specified in BOLT07, but will likely change in the near future.
@@ -3515,6 +3781,14 @@ func (c *ChannelEdgePolicy) ComputeFeeFromIncoming(
 // contain the routing policies for the channel in either direction.
 func (c *ChannelGraph) FetchChannelEdgesByOutpoint(op *wire.OutPoint,
 ) (*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy, error) {
+	if c.sqlStore != nil {
+		info, p1, p2, err := c.sqlStore.FetchChannelEdgesByOutpoint(op)
+		if info != nil {
+			info.sqlStore = c.sqlStore
+		}
+
+		return info, p1, p2, err
+	}
 
 	var (
 		edgeInfo *ChannelEdgeInfo
@@ -3602,6 +3876,14 @@ func (c *ChannelGraph) FetchChannelEdgesByOutpoint(op *wire.OutPoint,
 // the ChannelEdgeInfo will only include the public keys of each node.
 func (c *ChannelGraph) FetchChannelEdgesByID(chanID uint64,
 ) (*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy, error) {
+	if c.sqlStore != nil {
+		info, p1, p2, err := c.sqlStore.FetchChannelEdgesByID(chanID)
+		if info != nil {
+			info.sqlStore = c.sqlStore
+		}
+
+		return info, p1, p2, err
+	}
 
 	var (
 		edgeInfo  *ChannelEdgeInfo
@@ -3703,6 +3985,10 @@ func (c *ChannelGraph) FetchChannelEdgesByID(chanID uint64,
 // given public key is seen as a public node in the graph from the graph's
 // source node's point of view.
 func (c *ChannelGraph) IsPublicNode(pubKey [33]byte) (bool, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.IsPublicNode(pubKey)
+	}
+
 	var nodeIsPublic bool
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
 		nodes := tx.ReadBucket(nodeBucket)
@@ -3773,6 +4059,10 @@ func (e *EdgePoint) String() string {
 // returned are the ones that need to be watched on chain to detect channel
 // closes on the resident blockchain.
 func (c *ChannelGraph) ChannelView() ([]EdgePoint, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.ChannelView()
+	}
+
 	var edgePoints []EdgePoint
 	if err := kvdb.View(c.db, func(tx kvdb.RTx) error {
 		// We're going to iterate over the entire channel index, so
@@ -3844,6 +4134,9 @@ func (c *ChannelGraph) NewChannelEdgePolicy() *ChannelEdgePolicy {
 // marked as zombies outside the normal pruning cycle.
 func (c *ChannelGraph) MarkEdgeZombie(chanID uint64,
 	pubKey1, pubKey2 [33]byte) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.MarkEdgeZombie(chanID, pubKey1, pubKey2)
+	}
 
 	c.cacheMu.Lock()
 	defer c.cacheMu.Unlock()
@@ -3893,6 +4186,10 @@ func markEdgeZombie(zombieIndex kvdb.RwBucket, chanID uint64, pubKey1,
 
 // MarkEdgeLive clears an edge from our zombie index, deeming it as live.
 func (c *ChannelGraph) MarkEdgeLive(chanID uint64) error {
+	if c.sqlStore != nil {
+		return c.sqlStore.MarkEdgeLive(chanID)
+	}
+
 	c.cacheMu.Lock()
 	defer c.cacheMu.Unlock()
 
@@ -3939,6 +4236,10 @@ func (c *ChannelGraph) MarkEdgeLive(chanID uint64) error {
 // zombie, then the two node public keys corresponding to this edge are also
 // returned.
 func (c *ChannelGraph) IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte) {
+	if c.sqlStore != nil {
+		return c.sqlStore.IsZombieEdge(chanID)
+	}
+
 	var (
 		isZombie         bool
 		pubKey1, pubKey2 [33]byte
@@ -3991,6 +4292,10 @@ func isZombieEdge(zombieIndex kvdb.RBucket,
 
 // NumZombies returns the current number of zombie channels in the graph.
 func (c *ChannelGraph) NumZombies() (uint64, error) {
+	if c.sqlStore != nil {
+		return c.sqlStore.NumZombies()
+	}
+
 	var numZombies uint64
 	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
 		edges := tx.ReadBucket(edgeBucket)
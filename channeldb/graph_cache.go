@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -81,6 +82,13 @@ type CachedEdgePolicy struct {
 	// the edge is in the cache, only on the copy that is returned in
 	// ForEachChannel().
 	ToNodeFeatures *lnwire.FeatureVector
+
+	// InboundFee is the fee that the to node charges for HTLCs that arrive
+	// over this edge. It's part of the to node's own policy of the
+	// channel, so like the ToNodeFeatures, it's never set while the edge
+	// is in the cache, only on the copy that is returned in
+	// ForEachChannel().
+	InboundFee models.InboundFee
 }
 
 // ComputeFee computes the fee to forward an HTLC of `amt` milli-satoshis over
@@ -143,6 +151,11 @@ type DirectedChannel struct {
 	// source, so we're always interested in the edge that arrives to us
 	// from the other node.
 	InPolicy *CachedEdgePolicy
+
+	// InboundFee is the fee that the node charges for HTLCs that arrive
+	// over this channel. It's taken from the outgoing policy of the node,
+	// and is zero if the node doesn't charge an inbound fee.
+	InboundFee models.InboundFee
 }
 
 // DeepCopy creates a deep copy of the channel, including the incoming policy.
@@ -159,6 +172,7 @@ func (c *DirectedChannel) DeepCopy() *DirectedChannel {
 		// be sure they don't contain any old data.
 		channelCopy.InPolicy.ToNodePubKey = nil
 		channelCopy.InPolicy.ToNodeFeatures = nil
+		channelCopy.InPolicy.InboundFee = models.InboundFee{}
 	}
 
 	return &channelCopy
@@ -324,11 +338,13 @@ func (c *GraphCache) UpdatePolicy(policy *ChannelEdgePolicy, fromNode,
 		// policy for node 1.
 		case channel.IsNode1 && edge1:
 			channel.OutPolicySet = true
+			channel.InboundFee = policy.inboundFee()
 
 		// This is node 2, and it is edge 2, so this is the outgoing
 		// policy for node 2.
 		case !channel.IsNode1 && !edge1:
 			channel.OutPolicySet = true
+			channel.InboundFee = policy.inboundFee()
 
 		// The other two cases left mean it's the inbound policy for the
 		// node.
@@ -442,6 +458,7 @@ func (c *GraphCache) getChannels(node route.Vertex) []*DirectedChannel {
 		if channelCopy.InPolicy != nil {
 			channelCopy.InPolicy.ToNodePubKey = toNodeCallback
 			channelCopy.InPolicy.ToNodeFeatures = features
+			channelCopy.InPolicy.InboundFee = channel.InboundFee
 		}
 
 		channelsCopy[i] = channelCopy
@@ -0,0 +1,2286 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/aliasmgr"
+	"github.com/lightningnetwork/lnd/batch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// graphQueryPaginationLimit is used in the LIMIT clause of the SQL
+	// queries to limit the number of rows returned when paginating
+	// through all the nodes or channels of the graph.
+	graphQueryPaginationLimit = 100
+)
+
+// SQLGraphQueries is an interface that defines the set of operations that can
+// be executed against the channel graph SQL database.
+type SQLGraphQueries interface { //nolint:interfacebloat
+	// Node specific methods.
+	UpsertNode(ctx context.Context, arg sqlc.UpsertNodeParams) (int64,
+		error)
+
+	GetNodeByPubKey(ctx context.Context, pubKey []byte) (sqlc.GraphNode,
+		error)
+
+	GetNodeByID(ctx context.Context, id int64) (sqlc.GraphNode, error)
+
+	GetNodeIDByPubKey(ctx context.Context, pubKey []byte) (int64, error)
+
+	DeleteNodeByPubKey(ctx context.Context, pubKey []byte) (sql.Result,
+		error)
+
+	ListNodesPaginated(ctx context.Context,
+		arg sqlc.ListNodesPaginatedParams) ([]sqlc.GraphNode, error)
+
+	GetNodesByLastUpdateRange(ctx context.Context,
+		arg sqlc.GetNodesByLastUpdateRangeParams) ([]sqlc.GraphNode,
+		error)
+
+	DeleteUnconnectedNodes(ctx context.Context) ([][]byte, error)
+
+	DeleteAllNodes(ctx context.Context) error
+
+	InsertNodeFeature(ctx context.Context,
+		arg sqlc.InsertNodeFeatureParams) error
+
+	GetNodeFeatures(ctx context.Context,
+		nodeID int64) ([]sqlc.GraphNodeFeature, error)
+
+	DeleteNodeFeatures(ctx context.Context, nodeID int64) error
+
+	InsertNodeAddress(ctx context.Context,
+		arg sqlc.InsertNodeAddressParams) error
+
+	GetNodeAddresses(ctx context.Context,
+		nodeID int64) ([]sqlc.GraphNodeAddress, error)
+
+	DeleteNodeAddresses(ctx context.Context, nodeID int64) error
+
+	AddSourceNode(ctx context.Context, nodeID int64) error
+
+	DeleteSourceNodes(ctx context.Context) error
+
+	GetSourceNodeID(ctx context.Context) (int64, error)
+
+	// Channel specific methods.
+	CreateChannel(ctx context.Context, arg sqlc.CreateChannelParams) (int64,
+		error)
+
+	UpdateChannel(ctx context.Context, arg sqlc.UpdateChannelParams) (
+		sql.Result, error)
+
+	GetChannelBySCID(ctx context.Context,
+		scid []byte) (sqlc.GetChannelBySCIDRow, error)
+
+	GetChannelByOutpoint(ctx context.Context,
+		outpoint string) (sqlc.GetChannelByOutpointRow, error)
+
+	GetChannelsByNodeID(ctx context.Context,
+		nodeID int64) ([]sqlc.GetChannelsByNodeIDRow, error)
+
+	GetChannelsBySCIDRange(ctx context.Context,
+		arg sqlc.GetChannelsBySCIDRangeParams) (
+		[]sqlc.GetChannelsBySCIDRangeRow, error)
+
+	ListChannelsPaginated(ctx context.Context,
+		arg sqlc.ListChannelsPaginatedParams) (
+		[]sqlc.ListChannelsPaginatedRow, error)
+
+	GetChannelsByPolicyLastUpdateRange(ctx context.Context,
+		arg sqlc.GetChannelsByPolicyLastUpdateRangeParams) ([][]byte,
+		error)
+
+	HighestSCID(ctx context.Context) ([]byte, error)
+
+	DeleteChannelBySCID(ctx context.Context, scid []byte) (sql.Result,
+		error)
+
+	IsPublicNode(ctx context.Context, arg sqlc.IsPublicNodeParams) (bool,
+		error)
+
+	GetDisabledChannelSCIDs(ctx context.Context) ([][]byte, error)
+
+	// Channel policy specific methods.
+	UpsertChannelPolicy(ctx context.Context,
+		arg sqlc.UpsertChannelPolicyParams) (int64, error)
+
+	GetChannelPolicies(ctx context.Context,
+		channelID int64) ([]sqlc.GraphChannelPolicy, error)
+
+	// Zombie index specific methods.
+	UpsertZombieChannel(ctx context.Context,
+		arg sqlc.UpsertZombieChannelParams) error
+
+	GetZombieChannel(ctx context.Context,
+		scid []byte) (sqlc.GraphZombieChannel, error)
+
+	DeleteZombieChannel(ctx context.Context, scid []byte) (sql.Result,
+		error)
+
+	CountZombieChannels(ctx context.Context) (int64, error)
+
+	DeleteAllZombieChannels(ctx context.Context) error
+
+	// Closed channel specific methods.
+	InsertClosedSCID(ctx context.Context, scid []byte) error
+
+	IsClosedSCID(ctx context.Context, scid []byte) (bool, error)
+
+	DeleteAllClosedSCIDs(ctx context.Context) error
+
+	// Prune log specific methods.
+	UpsertPruneLogEntry(ctx context.Context,
+		arg sqlc.UpsertPruneLogEntryParams) error
+
+	GetPruneTip(ctx context.Context) (sqlc.GraphPruneLog, error)
+
+	DeletePruneLogEntriesFrom(ctx context.Context, blockHeight int64) error
+}
+
+// SQLGraphQueriesTxOptions defines the set of db txn options the
+// SQLGraphQueries understands.
+type SQLGraphQueriesTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions.
+func (a *SQLGraphQueriesTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewSQLGraphQueryReadTx creates a new read transaction option set.
+func NewSQLGraphQueryReadTx() SQLGraphQueriesTxOptions {
+	return SQLGraphQueriesTxOptions{
+		readOnly: true,
+	}
+}
+
+// NewSQLGraphQueryWriteTx creates a new write transaction option set.
+func NewSQLGraphQueryWriteTx() SQLGraphQueriesTxOptions {
+	return SQLGraphQueriesTxOptions{
+		readOnly: false,
+	}
+}
+
+// BatchedSQLGraphQueries is a version of the SQLGraphQueries that's capable
+// of batched database operations.
+type BatchedSQLGraphQueries interface {
+	SQLGraphQueries
+
+	sqldb.BatchedTx[SQLGraphQueries]
+}
+
+// SQLGraphStore is an implementation of the GraphStore interface that uses a
+// native SQL database to store the channel graph.
+//
+// NOTE: Unlike the ChannelGraph, the SQLGraphStore doesn't maintain a reject
+// cache, every call results in a database round trip. If the store is used by
+// a ChannelGraph, the graph cache of the ChannelGraph is kept up to date with
+// every change though.
+type SQLGraphStore struct {
+	db BatchedSQLGraphQueries
+
+	// graphCache is the in-memory graph cache of the ChannelGraph that
+	// uses this store. It is nil if the store is used on its own.
+	graphCache *GraphCache
+}
+
+// A compile-time assertion to ensure that SQLGraphStore implements the
+// GraphStore interface.
+var _ GraphStore = (*SQLGraphStore)(nil)
+
+// NewSQLGraphStore creates a new SQLGraphStore instance given an open
+// BatchedSQLGraphQueries storage backend.
+func NewSQLGraphStore(db BatchedSQLGraphQueries) *SQLGraphStore {
+	return &SQLGraphStore{
+		db: db,
+	}
+}
+
+// isEmpty returns true if the store neither holds any nodes nor has been
+// pruned yet, which is the case before the graph has been migrated to it.
+func (s *SQLGraphStore) isEmpty(ctx context.Context) (bool, error) {
+	readTxOpts := NewSQLGraphQueryReadTx()
+
+	var isEmpty bool
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		nodes, err := db.ListNodesPaginated(
+			ctx, sqlc.ListNodesPaginatedParams{
+				Limit: 1,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.GetPruneTip(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			isEmpty = len(nodes) == 0
+
+		case err != nil:
+			return err
+
+		default:
+			isEmpty = false
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isEmpty, nil
+}
+
+// AddLightningNode adds a vertex/node to the graph database. If the node is
+// not in the database from before, this will add a new, unconnected one to
+// the graph. If it is present from before, this will update that node's
+// information.
+//
+// NOTE: The batch scheduler options are accepted for compatibility with the
+// ChannelGraph, but are ignored as every call runs in its own transaction.
+func (s *SQLGraphStore) AddLightningNode(node *LightningNode,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		_, err := upsertSQLNode(ctx, db, node)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.AddNodeFeatures(
+			newGraphCacheNode(node.PubKeyBytes, node.Features),
+		)
+	}
+
+	return nil
+}
+
+// FetchLightningNode attempts to look up a target node by its identity public
+// key. If the node isn't found in the database, then ErrGraphNodeNotFound is
+// returned.
+func (s *SQLGraphStore) FetchLightningNode(nodePub route.Vertex) (
+	*LightningNode, error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		node       *LightningNode
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		var err error
+		_, node, err = fetchSQLNode(ctx, db, nodePub[:])
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// HasLightningNode determines if the graph has a vertex identified by the
+// target node identity public key. If the node exists in the database, a
+// timestamp of when the data for the node was lasted updated is returned
+// along with a true boolean. Otherwise, an empty time.Time is returned with a
+// false boolean.
+func (s *SQLGraphStore) HasLightningNode(nodePub [33]byte) (time.Time, bool,
+	error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		updateTime time.Time
+		exists     bool
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		dbNode, err := db.GetNodeByPubKey(ctx, nodePub[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		exists = true
+		updateTime = time.Unix(dbNode.LastUpdate, 0)
+
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return updateTime, exists, nil
+}
+
+// LookupAlias attempts to return the alias as advertised by the target node.
+// ErrNodeAliasNotFound is returned if we don't have a node announcement for
+// the target node.
+func (s *SQLGraphStore) LookupAlias(pub *btcec.PublicKey) (string, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		alias      string
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		pubKey := pub.SerializeCompressed()
+		dbNode, err := db.GetNodeByPubKey(ctx, pubKey)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrNodeAliasNotFound
+
+		case err != nil:
+			return err
+
+		case !dbNode.Alias.Valid:
+			return ErrNodeAliasNotFound
+		}
+
+		alias = dbNode.Alias.String
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return alias, nil
+}
+
+// DeleteLightningNode removes a vertex/node from the database according to
+// the node's public key.
+//
+// NOTE: Any channel that the node is part of is removed along with it.
+func (s *SQLGraphStore) DeleteLightningNode(nodePub route.Vertex) error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		res, err := db.DeleteNodeByPubKey(ctx, nodePub[:])
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrGraphNodeNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.RemoveNode(nodePub)
+	}
+
+	return nil
+}
+
+// SourceNode returns the source node of the graph. The source node is treated
+// as the center node within a star-graph. ErrSourceNodeNotSet is returned if
+// no source node has been set yet.
+func (s *SQLGraphStore) SourceNode() (*LightningNode, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		node       *LightningNode
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		nodeID, err := db.GetSourceNodeID(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrSourceNodeNotSet
+
+		case err != nil:
+			return err
+		}
+
+		dbNode, err := db.GetNodeByID(ctx, nodeID)
+		if err != nil {
+			return err
+		}
+
+		node, err = buildSQLNode(ctx, db, dbNode)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+// SetSourceNode sets the source node within the graph database. The source
+// node is to be used as the center of a star-graph within path finding
+// algorithms.
+func (s *SQLGraphStore) SetSourceNode(node *LightningNode) error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		nodeID, err := upsertSQLNode(ctx, db, node)
+		if err != nil {
+			return err
+		}
+
+		// There can only be a single source node, so we'll remove the
+		// reference to any previous one first.
+		if err := db.DeleteSourceNodes(ctx); err != nil {
+			return err
+		}
+
+		return db.AddSourceNode(ctx, nodeID)
+	})
+}
+
+// ForEachNode iterates through all the stored vertices/nodes in the graph,
+// executing the passed callback with each node encountered. If the callback
+// returns an error, then the iteration is halted with the error propagated
+// back up to the caller.
+func (s *SQLGraphStore) ForEachNode(cb func(*LightningNode) error) error {
+	ctx := context.TODO()
+	readTxOpts := NewSQLGraphQueryReadTx()
+
+	return s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		var lastID int64
+		for {
+			dbNodes, err := db.ListNodesPaginated(
+				ctx, sqlc.ListNodesPaginatedParams{
+					ID:    lastID,
+					Limit: graphQueryPaginationLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, dbNode := range dbNodes {
+				node, err := buildSQLNode(ctx, db, dbNode)
+				if err != nil {
+					return err
+				}
+
+				if err := cb(node); err != nil {
+					return err
+				}
+
+				lastID = dbNode.ID
+			}
+
+			if len(dbNodes) < graphQueryPaginationLimit {
+				return nil
+			}
+		}
+	})
+}
+
+// NodeUpdatesInHorizon returns all the known lightning node which have an
+// update timestamp within the passed range. Only nodes for which we've
+// received a node announcement are returned.
+func (s *SQLGraphStore) NodeUpdatesInHorizon(startTime,
+	endTime time.Time) ([]LightningNode, error) {
+
+	var (
+		ctx            = context.TODO()
+		readTxOpts     = NewSQLGraphQueryReadTx()
+		nodesInHorizon []LightningNode
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		nodesInHorizon = nil
+
+		dbNodes, err := db.GetNodesByLastUpdateRange(
+			ctx, sqlc.GetNodesByLastUpdateRangeParams{
+				StartTime: startTime.Unix(),
+				EndTime:   endTime.Unix(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, dbNode := range dbNodes {
+			node, err := buildSQLNode(ctx, db, dbNode)
+			if err != nil {
+				return err
+			}
+
+			nodesInHorizon = append(nodesInHorizon, *node)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesInHorizon, nil
+}
+
+// IsPublicNode is a helper method that determines whether the node with the
+// given public key is seen as a public node in the graph from the graph's
+// source node's point of view.
+func (s *SQLGraphStore) IsPublicNode(pubKey [33]byte) (bool, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		isPublic   bool
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		sourceID, err := db.GetSourceNodeID(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrSourceNodeNotSet
+
+		case err != nil:
+			return err
+		}
+
+		nodeID, err := db.GetNodeIDByPubKey(ctx, pubKey[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrGraphNodeNotFound
+
+		case err != nil:
+			return err
+		}
+
+		isPublic, err = db.IsPublicNode(ctx, sqlc.IsPublicNodeParams{
+			NodeID:   nodeID,
+			SourceID: sourceID,
+		})
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isPublic, nil
+}
+
+// PruneGraphNodes is a garbage collection method which attempts to prune out
+// any nodes from the channel graph that are currently unconnected. The source
+// node is never pruned.
+func (s *SQLGraphStore) PruneGraphNodes() error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	var prunedNodes []route.Vertex
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		var err error
+		prunedNodes, err = pruneSQLGraphNodes(ctx, db)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.removeCachedNodes(prunedNodes)
+
+	return nil
+}
+
+// AddChannelEdge adds a new (undirected, blank) edge to the graph database.
+// If either of the two nodes of the channel isn't known yet, a shell node
+// that only includes its public key is created for it. ErrEdgeAlreadyExist is
+// returned if the channel is already known.
+//
+// NOTE: The batch scheduler options are accepted for compatibility with the
+// ChannelGraph, but are ignored as every call runs in its own transaction.
+func (s *SQLGraphStore) AddChannelEdge(edge *ChannelEdgeInfo,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		return insertSQLChannel(ctx, db, edge)
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.AddChannel(edge, nil, nil)
+	}
+
+	return nil
+}
+
+// UpdateChannelEdge updates the static information of a channel that was
+// previously added to the graph. ErrEdgeNotFound is returned if the channel
+// isn't known.
+func (s *SQLGraphStore) UpdateChannelEdge(edge *ChannelEdgeInfo) error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	n1Sig, n2Sig, b1Sig, b2Sig := authProofSigs(edge.AuthProof)
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		res, err := db.UpdateChannel(ctx, sqlc.UpdateChannelParams{
+			Scid:              chanIDToSCID(edge.ChannelID),
+			ChainHash:         edge.ChainHash[:],
+			Outpoint:          edge.ChannelPoint.String(),
+			Capacity:          int64(edge.Capacity),
+			BitcoinKey1:       edge.BitcoinKey1Bytes[:],
+			BitcoinKey2:       edge.BitcoinKey2Bytes[:],
+			Features:          edge.Features,
+			Node1Signature:    n1Sig,
+			Node2Signature:    n2Sig,
+			Bitcoin1Signature: b1Sig,
+			Bitcoin2Signature: b2Sig,
+			ExtraOpaqueData:   edge.ExtraOpaqueData,
+		})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrEdgeNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.UpdateChannel(edge)
+	}
+
+	return nil
+}
+
+// HasChannelEdge returns true if the database knows of a channel edge with
+// the passed channel ID, and false otherwise. If an edge with that ID is
+// found within the graph, then two time stamps representing the last time the
+// edge was updated for both directed edges are returned along with the
+// boolean. If it is not found, then the zombie index is checked and its
+// result is returned as the second boolean.
+func (s *SQLGraphStore) HasChannelEdge(chanID uint64) (time.Time, time.Time,
+	bool, bool, error) {
+
+	var (
+		ctx                = context.TODO()
+		readTxOpts         = NewSQLGraphQueryReadTx()
+		upd1Time, upd2Time time.Time
+		exists, isZombie   bool
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		upd1Time, upd2Time = time.Time{}, time.Time{}
+		exists, isZombie = false, false
+
+		scid := chanIDToSCID(chanID)
+		row, err := db.GetChannelBySCID(ctx, scid)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			_, err := db.GetZombieChannel(ctx, scid)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return nil
+
+			case err != nil:
+				return err
+			}
+
+			isZombie = true
+
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		exists = true
+
+		policies, err := db.GetChannelPolicies(ctx, row.GraphChannel.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range policies {
+			if policy.Direction == 0 {
+				upd1Time = time.Unix(policy.LastUpdate, 0)
+			} else {
+				upd2Time = time.Unix(policy.LastUpdate, 0)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, time.Time{}, false, false, err
+	}
+
+	return upd1Time, upd2Time, exists, isZombie, nil
+}
+
+// UpdateEdgePolicy updates the edge routing policy for a single directed edge
+// within the database for the referenced channel. The direction bit of the
+// channel flags determines which of the directed edges is being updated.
+// ErrEdgeNotFound is returned if the channel isn't known.
+//
+// NOTE: The batch scheduler options are accepted for compatibility with the
+// ChannelGraph, but are ignored as every call runs in its own transaction.
+func (s *SQLGraphStore) UpdateEdgePolicy(edge *ChannelEdgePolicy,
+	_ ...batch.SchedulerOption) error {
+
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	var fromNode, toNode route.Vertex
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		row, err := db.GetChannelBySCID(
+			ctx, chanIDToSCID(edge.ChannelID),
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEdgeNotFound
+
+		case err != nil:
+			return err
+		}
+
+		copy(fromNode[:], row.Node1PubKey)
+		copy(toNode[:], row.Node2PubKey)
+
+		return upsertSQLPolicy(ctx, db, row.GraphChannel.ID, edge)
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		isUpdate1 := edge.ChannelFlags&lnwire.ChanUpdateDirection == 0
+		if !isUpdate1 {
+			fromNode, toNode = toNode, fromNode
+		}
+
+		s.graphCache.UpdatePolicy(edge, fromNode, toNode, isUpdate1)
+	}
+
+	return nil
+}
+
+// ForEachChannel iterates through all the channel edges stored within the
+// graph and invokes the passed callback for each edge. If the callback
+// returns an error, then the iteration is halted with the error propagated
+// back up to the caller.
+//
+// NOTE: If an edge can't be found, or wasn't advertised, then a nil pointer
+// for that particular channel edge routing policy will be passed into the
+// callback.
+func (s *SQLGraphStore) ForEachChannel(cb func(*ChannelEdgeInfo,
+	*ChannelEdgePolicy, *ChannelEdgePolicy) error) error {
+
+	ctx := context.TODO()
+	readTxOpts := NewSQLGraphQueryReadTx()
+
+	return s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		cbEdge := func(edge *ChannelEdge) error {
+			return cb(edge.Info, edge.Policy1, edge.Policy2)
+		}
+
+		return forEachSQLChannel(ctx, db, cbEdge)
+	})
+}
+
+// ForEachNodeChannel iterates through all channels of the given node,
+// executing the passed callback with an edge info structure and the policies
+// of each end of the channel. The first edge policy is the outgoing edge *to*
+// the connecting node, while the second is the incoming edge *from* the
+// connecting node. If the callback returns an error, then the iteration is
+// halted with the error propagated back up to the caller.
+//
+// Unknown policies are passed into the callback as nil values.
+func (s *SQLGraphStore) ForEachNodeChannel(nodePub route.Vertex,
+	cb func(*ChannelEdgeInfo, *ChannelEdgePolicy,
+		*ChannelEdgePolicy) error) error {
+
+	ctx := context.TODO()
+	readTxOpts := NewSQLGraphQueryReadTx()
+
+	return s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		nodeID, err := db.GetNodeIDByPubKey(ctx, nodePub[:])
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrGraphNodeNotFound
+
+		case err != nil:
+			return err
+		}
+
+		rows, err := db.GetChannelsByNodeID(ctx, nodeID)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			edge, err := buildSQLChannelEdge(
+				ctx, db, row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			outPolicy, inPolicy := edge.Policy1, edge.Policy2
+			if row.GraphChannel.NodeID2 == nodeID {
+				outPolicy, inPolicy = inPolicy, outPolicy
+			}
+
+			err = cb(edge.Info, outPolicy, inPolicy)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchChannelEdgesByID attempts to lookup the two directed edges for the
+// channel identified by the channel ID. If the channel can't be found, then
+// ErrEdgeNotFound is returned. ErrZombieEdge is returned if the channel is
+// currently marked as a zombie, in which case the ChannelEdgeInfo only
+// includes the public keys of each node.
+func (s *SQLGraphStore) FetchChannelEdgesByID(chanID uint64) (
+	*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy, error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		edge       *ChannelEdge
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		scid := chanIDToSCID(chanID)
+		row, err := db.GetChannelBySCID(ctx, scid)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			zombie, err := db.GetZombieChannel(ctx, scid)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return ErrEdgeNotFound
+
+			case err != nil:
+				return err
+			}
+
+			info := &ChannelEdgeInfo{}
+			copy(info.NodeKey1Bytes[:], zombie.NodeKey1)
+			copy(info.NodeKey2Bytes[:], zombie.NodeKey2)
+			edge = &ChannelEdge{Info: info}
+
+			return ErrZombieEdge
+
+		case err != nil:
+			return err
+		}
+
+		edge, err = buildSQLChannelEdge(
+			ctx, db, row.GraphChannel, row.Node1PubKey,
+			row.Node2PubKey,
+		)
+
+		return err
+	})
+	if errors.Is(err, ErrZombieEdge) {
+		return edge.Info, nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return edge.Info, edge.Policy1, edge.Policy2, nil
+}
+
+// FetchChannelEdgesByOutpoint attempts to lookup the two directed edges for
+// the channel identified by the funding outpoint. If the channel can't be
+// found, then ErrEdgeNotFound is returned.
+func (s *SQLGraphStore) FetchChannelEdgesByOutpoint(op *wire.OutPoint) (
+	*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy, error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		edge       *ChannelEdge
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		row, err := db.GetChannelByOutpoint(ctx, op.String())
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEdgeNotFound
+
+		case err != nil:
+			return err
+		}
+
+		edge, err = buildSQLChannelEdge(
+			ctx, db, row.GraphChannel, row.Node1PubKey,
+			row.Node2PubKey,
+		)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return edge.Info, edge.Policy1, edge.Policy2, nil
+}
+
+// FetchChanInfos returns the set of channel edges that correspond to the
+// passed channel ID's. If an edge in the query is unknown to the database, it
+// will be skipped and the result will contain only those edges that exist at
+// the time of the query.
+func (s *SQLGraphStore) FetchChanInfos(chanIDs []uint64) ([]ChannelEdge,
+	error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		chanEdges  []ChannelEdge
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		chanEdges = nil
+
+		for _, chanID := range chanIDs {
+			row, err := db.GetChannelBySCID(
+				ctx, chanIDToSCID(chanID),
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				continue
+
+			case err != nil:
+				return err
+			}
+
+			edge, err := buildSQLChannelEdge(
+				ctx, db, row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			chanEdges = append(chanEdges, *edge)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanEdges, nil
+}
+
+// ChannelID attempts to lookup the 8-byte compact channel ID which maps to
+// the passed channel point (outpoint). If the passed channel doesn't exist
+// within the database, then ErrEdgeNotFound is returned.
+func (s *SQLGraphStore) ChannelID(chanPoint *wire.OutPoint) (uint64, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		chanID     uint64
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		row, err := db.GetChannelByOutpoint(ctx, chanPoint.String())
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrEdgeNotFound
+
+		case err != nil:
+			return err
+		}
+
+		chanID = byteOrder.Uint64(row.GraphChannel.Scid)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return chanID, nil
+}
+
+// HighestChanID returns the "highest" known channel ID in the channel graph.
+// Zero is returned if we don't know of any channels yet.
+func (s *SQLGraphStore) HighestChanID() (uint64, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		chanID     uint64
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		scid, err := db.HighestSCID(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			chanID = 0
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		chanID = byteOrder.Uint64(scid)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return chanID, nil
+}
+
+// ChanUpdatesInHorizon returns all the known channel edges which have at
+// least one edge that has an update timestamp within the specified horizon.
+func (s *SQLGraphStore) ChanUpdatesInHorizon(startTime,
+	endTime time.Time) ([]ChannelEdge, error) {
+
+	var (
+		ctx            = context.TODO()
+		readTxOpts     = NewSQLGraphQueryReadTx()
+		edgesInHorizon []ChannelEdge
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		edgesInHorizon = nil
+
+		scids, err := db.GetChannelsByPolicyLastUpdateRange(
+			ctx, sqlc.GetChannelsByPolicyLastUpdateRangeParams{
+				StartTime: startTime.Unix(),
+				EndTime:   endTime.Unix(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, scid := range scids {
+			row, err := db.GetChannelBySCID(ctx, scid)
+			if err != nil {
+				return fmt.Errorf("unable to fetch info for "+
+					"edge with chan_id=%v: %w",
+					byteOrder.Uint64(scid), err)
+			}
+
+			edge, err := buildSQLChannelEdge(
+				ctx, db, row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			edgesInHorizon = append(edgesInHorizon, *edge)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return edgesInHorizon, nil
+}
+
+// FilterKnownChanIDs takes a set of channel IDs and return the subset of
+// chan ID's that we don't know and are not known zombies of the passed set.
+func (s *SQLGraphStore) FilterKnownChanIDs(chanIDs []uint64) ([]uint64,
+	error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		newChanIDs []uint64
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		newChanIDs = nil
+
+		for _, chanID := range chanIDs {
+			scid := chanIDToSCID(chanID)
+
+			// If the edge is already known, skip it.
+			_, err := db.GetChannelBySCID(ctx, scid)
+			switch {
+			case err == nil:
+				continue
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			// If the edge is a known zombie, skip it.
+			_, err = db.GetZombieChannel(ctx, scid)
+			switch {
+			case err == nil:
+				continue
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			newChanIDs = append(newChanIDs, chanID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newChanIDs, nil
+}
+
+// FilterChannelRange returns the channel ID's of all known announced channels
+// which were mined in a block height within the passed range. The channel IDs
+// are grouped by their common block height.
+func (s *SQLGraphStore) FilterChannelRange(startHeight,
+	endHeight uint32) ([]BlockChannelRange, error) {
+
+	startChanID := lnwire.ShortChannelID{
+		BlockHeight: startHeight,
+	}
+	endChanID := lnwire.ShortChannelID{
+		BlockHeight: endHeight,
+		TxIndex:     math.MaxUint32 & 0x00ffffff,
+		TxPosition:  math.MaxUint16,
+	}
+
+	var (
+		ctx              = context.TODO()
+		readTxOpts       = NewSQLGraphQueryReadTx()
+		channelsPerBlock map[uint32][]lnwire.ShortChannelID
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		channelsPerBlock = make(map[uint32][]lnwire.ShortChannelID)
+
+		rows, err := db.GetChannelsBySCIDRange(
+			ctx, sqlc.GetChannelsBySCIDRangeParams{
+				StartScid: chanIDToSCID(startChanID.ToUint64()),
+				EndScid:   chanIDToSCID(endChanID.ToUint64()),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			// Only announced channels are shared during gossip
+			// sync.
+			if row.GraphChannel.Node1Signature == nil {
+				continue
+			}
+
+			cid := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(row.GraphChannel.Scid),
+			)
+			channelsPerBlock[cid.BlockHeight] = append(
+				channelsPerBlock[cid.BlockHeight], cid,
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(channelsPerBlock) == 0 {
+		return nil, nil
+	}
+
+	// Return the channel ranges in ascending block height order.
+	blocks := make([]uint32, 0, len(channelsPerBlock))
+	for block := range channelsPerBlock {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i] < blocks[j]
+	})
+
+	channelRanges := make([]BlockChannelRange, 0, len(channelsPerBlock))
+	for _, block := range blocks {
+		channelRanges = append(channelRanges, BlockChannelRange{
+			Height:   block,
+			Channels: channelsPerBlock[block],
+		})
+	}
+
+	return channelRanges, nil
+}
+
+// DisabledChannelIDs returns the channel ids of disabled channels. A channel
+// is disabled when both of its policies have their disabled bit on.
+func (s *SQLGraphStore) DisabledChannelIDs() ([]uint64, error) {
+	var (
+		ctx             = context.TODO()
+		readTxOpts      = NewSQLGraphQueryReadTx()
+		disabledChanIDs []uint64
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		disabledChanIDs = nil
+
+		scids, err := db.GetDisabledChannelSCIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, scid := range scids {
+			disabledChanIDs = append(
+				disabledChanIDs, byteOrder.Uint64(scid),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return disabledChanIDs, nil
+}
+
+// ChannelView returns the verifiable edge information for each active
+// channel within the known channel graph. The set of UTXO's (along with their
+// scripts) returned are the ones that need to be watched on chain to detect
+// channel closes on the resident blockchain.
+func (s *SQLGraphStore) ChannelView() ([]EdgePoint, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		edgePoints []EdgePoint
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		edgePoints = nil
+
+		var lastID int64
+		for {
+			rows, err := db.ListChannelsPaginated(
+				ctx, sqlc.ListChannelsPaginatedParams{
+					ID:    lastID,
+					Limit: graphQueryPaginationLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				dbChan := row.GraphChannel
+				lastID = dbChan.ID
+
+				chanPoint, err := wire.NewOutPointFromString(
+					dbChan.Outpoint,
+				)
+				if err != nil {
+					return err
+				}
+
+				pkScript, err := genMultiSigP2WSH(
+					dbChan.BitcoinKey1, dbChan.BitcoinKey2,
+				)
+				if err != nil {
+					return err
+				}
+
+				edgePoints = append(edgePoints, EdgePoint{
+					FundingPkScript: pkScript,
+					OutPoint:        *chanPoint,
+				})
+			}
+
+			if len(rows) < graphQueryPaginationLimit {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return edgePoints, nil
+}
+
+// DeleteChannelEdges removes edges with the given channel IDs from the
+// database and optionally marks them as zombies. If an edge does not exist
+// within the database, then ErrEdgeNotFound will be returned. If
+// strictZombiePruning is true, then the zombie entry is set up such that only
+// the node that failed to send a fresh update can resurrect the channel.
+func (s *SQLGraphStore) DeleteChannelEdges(strictZombiePruning,
+	markZombie bool, chanIDs ...uint64) error {
+
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	var deletedChans []*ChannelEdgeInfo
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		deletedChans = nil
+
+		for _, chanID := range chanIDs {
+			scid := chanIDToSCID(chanID)
+			row, err := db.GetChannelBySCID(ctx, scid)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return ErrEdgeNotFound
+
+			case err != nil:
+				return err
+			}
+
+			info, err := buildSQLEdgeInfo(
+				row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+			deletedChans = append(deletedChans, info)
+
+			if markZombie {
+				edge, err := buildSQLChannelEdge(
+					ctx, db, row.GraphChannel,
+					row.Node1PubKey, row.Node2PubKey,
+				)
+				if err != nil {
+					return err
+				}
+
+				nodeKey1 := edge.Info.NodeKey1Bytes
+				nodeKey2 := edge.Info.NodeKey2Bytes
+				if strictZombiePruning {
+					nodeKey1, nodeKey2 = makeZombiePubkeys(
+						edge.Info, edge.Policy1,
+						edge.Policy2,
+					)
+				}
+
+				err = db.UpsertZombieChannel(
+					ctx, sqlc.UpsertZombieChannelParams{
+						Scid:     scid,
+						NodeKey1: nodeKey1[:],
+						NodeKey2: nodeKey2[:],
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = db.DeleteChannelBySCID(ctx, scid)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.removeCachedChannels(deletedChans)
+
+	return nil
+}
+
+// PruneGraph prunes newly closed channels from the channel graph in response
+// to a new block being solved on the network. Any transactions which spend
+// the funding output of any known channels within the graph will be deleted.
+// Additionally, the "prune tip" is updated and any nodes left without
+// channels are removed. A slice of channels that have been closed by the
+// target block are returned if the function succeeds without error.
+func (s *SQLGraphStore) PruneGraph(spentOutputs []*wire.OutPoint,
+	blockHash *chainhash.Hash, blockHeight uint32) ([]*ChannelEdgeInfo,
+	error) {
+
+	var (
+		ctx         = context.TODO()
+		writeTxOpts = NewSQLGraphQueryWriteTx()
+		chansClosed []*ChannelEdgeInfo
+	)
+
+	var prunedNodes []route.Vertex
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		chansClosed = nil
+
+		for _, chanPoint := range spentOutputs {
+			row, err := db.GetChannelByOutpoint(
+				ctx, chanPoint.String(),
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				continue
+
+			case err != nil:
+				return err
+			}
+
+			info, err := buildSQLEdgeInfo(
+				row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = db.DeleteChannelBySCID(
+				ctx, row.GraphChannel.Scid,
+			)
+			if err != nil {
+				return err
+			}
+
+			chansClosed = append(chansClosed, info)
+		}
+
+		err := db.UpsertPruneLogEntry(
+			ctx, sqlc.UpsertPruneLogEntryParams{
+				BlockHeight: int64(blockHeight),
+				BlockHash:   blockHash[:],
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		prunedNodes, err = pruneSQLGraphNodes(ctx, db)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeCachedChannels(chansClosed)
+	s.removeCachedNodes(prunedNodes)
+
+	return chansClosed, nil
+}
+
+// PruneTip returns the block height and hash of the latest block that has
+// been used to prune channels in the graph. ErrGraphNeverPruned is returned if
+// the graph hasn't been pruned yet.
+func (s *SQLGraphStore) PruneTip() (*chainhash.Hash, uint32, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		tipHash    chainhash.Hash
+		tipHeight  uint32
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		tip, err := db.GetPruneTip(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrGraphNeverPruned
+
+		case err != nil:
+			return err
+		}
+
+		copy(tipHash[:], tip.BlockHash)
+		tipHeight = uint32(tip.BlockHeight)
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &tipHash, tipHeight, nil
+}
+
+// DisconnectBlockAtHeight is used to indicate that the block specified by the
+// passed height has been disconnected from the main chain. This will "rewind"
+// the graph back to the height below, deleting channels that are no longer
+// confirmed from the graph. The prune log will be set to the last prune
+// height valid for the remaining chain. Channels that were removed from the
+// graph resulting from the disconnected block are returned.
+func (s *SQLGraphStore) DisconnectBlockAtHeight(height uint32) (
+	[]*ChannelEdgeInfo, error) {
+
+	// Every channel having a ShortChannelID starting at 'height' will no
+	// longer be confirmed. We delete everything up until the SCID alias
+	// range, but we make sure not to include the StartingAlias itself.
+	startChanID := lnwire.ShortChannelID{
+		BlockHeight: height,
+	}
+	endChanID := aliasmgr.StartingAlias.ToUint64() - 1
+
+	var (
+		ctx          = context.TODO()
+		writeTxOpts  = NewSQLGraphQueryWriteTx()
+		removedChans []*ChannelEdgeInfo
+	)
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		removedChans = nil
+
+		rows, err := db.GetChannelsBySCIDRange(
+			ctx, sqlc.GetChannelsBySCIDRangeParams{
+				StartScid: chanIDToSCID(startChanID.ToUint64()),
+				EndScid:   chanIDToSCID(endChanID),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			info, err := buildSQLEdgeInfo(
+				row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = db.DeleteChannelBySCID(
+				ctx, row.GraphChannel.Scid,
+			)
+			if err != nil {
+				return err
+			}
+
+			removedChans = append(removedChans, info)
+		}
+
+		// Delete all the entries in the prune log having a height
+		// greater or equal to the block disconnected.
+		return db.DeletePruneLogEntriesFrom(ctx, int64(height))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeCachedChannels(removedChans)
+
+	return removedChans, nil
+}
+
+// MarkEdgeZombie attempts to mark a channel identified by its channel ID as a
+// zombie. This method is used on an ad-hoc basis, when channels need to be
+// marked as zombies outside the normal pruning cycle.
+func (s *SQLGraphStore) MarkEdgeZombie(chanID uint64,
+	pubKey1, pubKey2 [33]byte) error {
+
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		return db.UpsertZombieChannel(
+			ctx, sqlc.UpsertZombieChannelParams{
+				Scid:     chanIDToSCID(chanID),
+				NodeKey1: pubKey1[:],
+				NodeKey2: pubKey2[:],
+			},
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.graphCache != nil {
+		s.graphCache.RemoveChannel(pubKey1, pubKey2, chanID)
+	}
+
+	return nil
+}
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+func (s *SQLGraphStore) MarkEdgeLive(chanID uint64) error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		_, err := db.DeleteZombieChannel(ctx, chanIDToSCID(chanID))
+		return err
+	})
+	if err != nil || s.graphCache == nil {
+		return err
+	}
+
+	// We need to add the channel back into the graph cache, otherwise we
+	// won't use it for path finding.
+	edges, err := s.FetchChanInfos([]uint64{chanID})
+	if err != nil {
+		return err
+	}
+	for _, edge := range edges {
+		s.graphCache.AddChannel(edge.Info, edge.Policy1, edge.Policy2)
+	}
+
+	return nil
+}
+
+// IsZombieEdge returns whether the edge is considered zombie. If it is a
+// zombie, then the two node public keys corresponding to this edge are also
+// returned.
+func (s *SQLGraphStore) IsZombieEdge(chanID uint64) (bool, [33]byte,
+	[33]byte) {
+
+	var (
+		ctx              = context.TODO()
+		readTxOpts       = NewSQLGraphQueryReadTx()
+		isZombie         bool
+		pubKey1, pubKey2 [33]byte
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		zombie, err := db.GetZombieChannel(ctx, chanIDToSCID(chanID))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			isZombie = false
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		isZombie = true
+		copy(pubKey1[:], zombie.NodeKey1)
+		copy(pubKey2[:], zombie.NodeKey2)
+
+		return nil
+	})
+	if err != nil {
+		return false, [33]byte{}, [33]byte{}
+	}
+
+	return isZombie, pubKey1, pubKey2
+}
+
+// NumZombies returns the current number of zombie channels in the graph.
+func (s *SQLGraphStore) NumZombies() (uint64, error) {
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		numZombies int64
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		var err error
+		numZombies, err = db.CountZombieChannels(ctx)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(numZombies), nil
+}
+
+// PutClosedScid stores a short channel ID of a channel that we know has been
+// closed on-chain.
+func (s *SQLGraphStore) PutClosedScid(scid lnwire.ShortChannelID) error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		return db.InsertClosedSCID(ctx, chanIDToSCID(scid.ToUint64()))
+	})
+}
+
+// IsClosedScid checks whether a channel identified by the passed short
+// channel ID is known to be closed on-chain.
+func (s *SQLGraphStore) IsClosedScid(scid lnwire.ShortChannelID) (bool,
+	error) {
+
+	var (
+		ctx        = context.TODO()
+		readTxOpts = NewSQLGraphQueryReadTx()
+		isClosed   bool
+	)
+
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLGraphQueries) error {
+		var err error
+		isClosed, err = db.IsClosedSCID(
+			ctx, chanIDToSCID(scid.ToUint64()),
+		)
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isClosed, nil
+}
+
+// Wipe deletes all nodes, channels, policies, zombie and closed channel
+// entries and the prune log of the graph within a single transaction.
+func (s *SQLGraphStore) Wipe() error {
+	ctx := context.TODO()
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		// Deleting the nodes cascades to their addresses, features,
+		// channels and the policies of those channels.
+		if err := db.DeleteAllNodes(ctx); err != nil {
+			return err
+		}
+
+		if err := db.DeleteAllZombieChannels(ctx); err != nil {
+			return err
+		}
+
+		if err := db.DeleteAllClosedSCIDs(ctx); err != nil {
+			return err
+		}
+
+		return db.DeletePruneLogEntriesFrom(ctx, 0)
+	})
+}
+
+// chanIDToSCID converts the passed channel ID to the 8 byte big endian
+// representation that's used to store it in the database. The big endian
+// encoding makes sure that range queries follow the block height ordering.
+func chanIDToSCID(chanID uint64) []byte {
+	var scid [8]byte
+	binary.BigEndian.PutUint64(scid[:], chanID)
+
+	return scid[:]
+}
+
+// authProofSigs returns the four signatures of the passed proof, or nil
+// values if the channel hasn't been announced yet.
+func authProofSigs(proof *ChannelAuthProof) ([]byte, []byte, []byte, []byte) {
+	if proof == nil {
+		return nil, nil, nil, nil
+	}
+
+	return proof.NodeSig1Bytes, proof.NodeSig2Bytes,
+		proof.BitcoinSig1Bytes, proof.BitcoinSig2Bytes
+}
+
+// upsertSQLNode inserts the passed node into the database or updates it if
+// it's already known. The database id of the node is returned.
+func upsertSQLNode(ctx context.Context, db SQLGraphQueries,
+	node *LightningNode) (int64, error) {
+
+	params := sqlc.UpsertNodeParams{
+		PubKey:           node.PubKeyBytes[:],
+		HaveAnnouncement: node.HaveNodeAnnouncement,
+		LastUpdate:       node.LastUpdate.Unix(),
+	}
+	if node.HaveNodeAnnouncement {
+		params.Alias = sql.NullString{
+			String: node.Alias,
+			Valid:  true,
+		}
+		params.Color = []byte{node.Color.R, node.Color.G, node.Color.B}
+		params.Signature = node.AuthSigBytes
+		params.ExtraOpaqueData = node.ExtraOpaqueData
+	}
+
+	nodeID, err := db.UpsertNode(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("unable to upsert node %x: %w",
+			node.PubKeyBytes, err)
+	}
+
+	// Replace the features and addresses of the node with the current
+	// ones.
+	if err := db.DeleteNodeFeatures(ctx, nodeID); err != nil {
+		return 0, err
+	}
+	if err := db.DeleteNodeAddresses(ctx, nodeID); err != nil {
+		return 0, err
+	}
+
+	if !node.HaveNodeAnnouncement {
+		return nodeID, nil
+	}
+
+	if node.Features != nil {
+		for bit := range node.Features.Features() {
+			err := db.InsertNodeFeature(
+				ctx, sqlc.InsertNodeFeatureParams{
+					NodeID:     nodeID,
+					FeatureBit: int32(bit),
+				},
+			)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for i, addr := range node.Addresses {
+		var b bytes.Buffer
+		if err := serializeAddr(&b, addr); err != nil {
+			return 0, err
+		}
+
+		err := db.InsertNodeAddress(ctx, sqlc.InsertNodeAddressParams{
+			NodeID:   nodeID,
+			Position: int32(i),
+			Address:  b.Bytes(),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return nodeID, nil
+}
+
+// fetchSQLNode fetches the node with the given public key from the database
+// along with its database id. ErrGraphNodeNotFound is returned if the node
+// isn't known.
+func fetchSQLNode(ctx context.Context, db SQLGraphQueries,
+	pubKey []byte) (int64, *LightningNode, error) {
+
+	dbNode, err := db.GetNodeByPubKey(ctx, pubKey)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, nil, ErrGraphNodeNotFound
+
+	case err != nil:
+		return 0, nil, err
+	}
+
+	node, err := buildSQLNode(ctx, db, dbNode)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return dbNode.ID, node, nil
+}
+
+// buildSQLNode builds a LightningNode from the passed database row, fetching
+// the features and addresses of the node.
+func buildSQLNode(ctx context.Context, db SQLGraphQueries,
+	dbNode sqlc.GraphNode) (*LightningNode, error) {
+
+	node := &LightningNode{
+		HaveNodeAnnouncement: dbNode.HaveAnnouncement,
+		LastUpdate:           time.Unix(dbNode.LastUpdate, 0),
+		Features:             lnwire.EmptyFeatureVector(),
+	}
+	copy(node.PubKeyBytes[:], dbNode.PubKey)
+
+	// The rest of the data is only there if we got a node announcement
+	// for this node.
+	if !dbNode.HaveAnnouncement {
+		return node, nil
+	}
+
+	node.Alias = dbNode.Alias.String
+	node.AuthSigBytes = dbNode.Signature
+	node.ExtraOpaqueData = dbNode.ExtraOpaqueData
+	if len(dbNode.Color) == 3 {
+		node.Color = color.RGBA{
+			R: dbNode.Color[0],
+			G: dbNode.Color[1],
+			B: dbNode.Color[2],
+		}
+	}
+
+	features, err := db.GetNodeFeatures(ctx, dbNode.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rawFeatures := lnwire.NewRawFeatureVector()
+	for _, feature := range features {
+		rawFeatures.Set(lnwire.FeatureBit(feature.FeatureBit))
+	}
+	node.Features = lnwire.NewFeatureVector(rawFeatures, lnwire.Features)
+
+	addresses, err := db.GetNodeAddresses(ctx, dbNode.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []net.Addr
+	for _, address := range addresses {
+		addr, err := deserializeAddr(
+			bytes.NewReader(address.Address),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, addr)
+	}
+	node.Addresses = addrs
+
+	return node, nil
+}
+
+// shellSQLNodeID returns the database id of the node with the given public
+// key. If the node isn't known yet, a "shell" node that only includes its
+// public key is inserted.
+func shellSQLNodeID(ctx context.Context, db SQLGraphQueries,
+	pubKey [33]byte) (int64, error) {
+
+	nodeID, err := db.GetNodeIDByPubKey(ctx, pubKey[:])
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return upsertSQLNode(ctx, db, &LightningNode{
+			PubKeyBytes:          pubKey,
+			HaveNodeAnnouncement: false,
+		})
+
+	case err != nil:
+		return 0, err
+	}
+
+	return nodeID, nil
+}
+
+// pruneSQLGraphNodes removes all the nodes that are no longer part of any
+// channel, except for the source node. The public keys of the removed nodes
+// are returned.
+func pruneSQLGraphNodes(ctx context.Context, db SQLGraphQueries) (
+	[]route.Vertex, error) {
+
+	pubKeys, err := db.DeleteUnconnectedNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prunedNodes := make([]route.Vertex, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		log.Infof("Pruned unconnected node %x from channel graph",
+			pubKey)
+
+		var nodePub route.Vertex
+		copy(nodePub[:], pubKey)
+		prunedNodes = append(prunedNodes, nodePub)
+	}
+
+	if len(prunedNodes) > 0 {
+		log.Infof("Pruned %v unconnected nodes from the channel graph",
+			len(prunedNodes))
+	}
+
+	return prunedNodes, nil
+}
+
+// removeCachedChannels removes the passed channels from the graph cache, if
+// the store keeps one up to date.
+func (s *SQLGraphStore) removeCachedChannels(chans []*ChannelEdgeInfo) {
+	if s.graphCache == nil {
+		return
+	}
+
+	for _, info := range chans {
+		s.graphCache.RemoveChannel(
+			info.NodeKey1Bytes, info.NodeKey2Bytes, info.ChannelID,
+		)
+	}
+}
+
+// removeCachedNodes removes the passed nodes from the graph cache, if the
+// store keeps one up to date.
+func (s *SQLGraphStore) removeCachedNodes(nodes []route.Vertex) {
+	if s.graphCache == nil {
+		return
+	}
+
+	for _, node := range nodes {
+		s.graphCache.RemoveNode(node)
+	}
+}
+
+// insertSQLChannel inserts the static information of the passed channel into
+// the database, creating shell nodes for any of the two nodes that aren't
+// known yet. ErrEdgeAlreadyExist is returned if the channel is already known.
+func insertSQLChannel(ctx context.Context, db SQLGraphQueries,
+	edge *ChannelEdgeInfo) error {
+
+	scid := chanIDToSCID(edge.ChannelID)
+	_, err := db.GetChannelBySCID(ctx, scid)
+	switch {
+	case err == nil:
+		return ErrEdgeAlreadyExist
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	node1ID, err := shellSQLNodeID(ctx, db, edge.NodeKey1Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to create shell node for: %x",
+			edge.NodeKey1Bytes)
+	}
+	node2ID, err := shellSQLNodeID(ctx, db, edge.NodeKey2Bytes)
+	if err != nil {
+		return fmt.Errorf("unable to create shell node for: %x",
+			edge.NodeKey2Bytes)
+	}
+
+	n1Sig, n2Sig, b1Sig, b2Sig := authProofSigs(edge.AuthProof)
+	_, err = db.CreateChannel(ctx, sqlc.CreateChannelParams{
+		Scid:              scid,
+		ChainHash:         edge.ChainHash[:],
+		Outpoint:          edge.ChannelPoint.String(),
+		Capacity:          int64(edge.Capacity),
+		NodeID1:           node1ID,
+		NodeID2:           node2ID,
+		BitcoinKey1:       edge.BitcoinKey1Bytes[:],
+		BitcoinKey2:       edge.BitcoinKey2Bytes[:],
+		Features:          edge.Features,
+		Node1Signature:    n1Sig,
+		Node2Signature:    n2Sig,
+		Bitcoin1Signature: b1Sig,
+		Bitcoin2Signature: b2Sig,
+		ExtraOpaqueData:   edge.ExtraOpaqueData,
+	})
+
+	return err
+}
+
+// upsertSQLPolicy inserts or updates the directed policy of the channel with
+// the given database id.
+func upsertSQLPolicy(ctx context.Context, db SQLGraphQueries, channelID int64,
+	edge *ChannelEdgePolicy) error {
+
+	var direction int16
+	if edge.ChannelFlags&lnwire.ChanUpdateDirection != 0 {
+		direction = 1
+	}
+
+	// The max htlc value is only stored if it's signalled by the message
+	// flags, mirroring the encoding of the key-value graph.
+	var maxHTLC sql.NullInt64
+	if edge.MessageFlags.HasMaxHtlc() {
+		maxHTLC = sqldb.SQLInt64(edge.MaxHTLC)
+	}
+
+	_, err := db.UpsertChannelPolicy(ctx, sqlc.UpsertChannelPolicyParams{
+		ChannelID:       channelID,
+		Direction:       direction,
+		LastUpdate:      edge.LastUpdate.Unix(),
+		MessageFlags:    int16(edge.MessageFlags),
+		ChannelFlags:    int16(edge.ChannelFlags),
+		Timelock:        int32(edge.TimeLockDelta),
+		MinHtlcMsat:     int64(edge.MinHTLC),
+		MaxHtlcMsat:     maxHTLC,
+		FeeBaseMsat:     int64(edge.FeeBaseMSat),
+		FeePpm:          int64(edge.FeeProportionalMillionths),
+		Disabled:        edge.ChannelFlags.IsDisabled(),
+		Signature:       edge.SigBytes,
+		ExtraOpaqueData: edge.ExtraOpaqueData,
+	})
+
+	return err
+}
+
+// buildSQLEdgeInfo builds a ChannelEdgeInfo from the passed database row and
+// the public keys of the two nodes of the channel.
+func buildSQLEdgeInfo(dbChan sqlc.GraphChannel, node1Pub,
+	node2Pub []byte) (*ChannelEdgeInfo, error) {
+
+	chanPoint, err := wire.NewOutPointFromString(dbChan.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ChannelEdgeInfo{
+		ChannelID:       byteOrder.Uint64(dbChan.Scid),
+		ChannelPoint:    *chanPoint,
+		Capacity:        btcutil.Amount(dbChan.Capacity),
+		Features:        dbChan.Features,
+		ExtraOpaqueData: dbChan.ExtraOpaqueData,
+	}
+	copy(info.ChainHash[:], dbChan.ChainHash)
+	copy(info.NodeKey1Bytes[:], node1Pub)
+	copy(info.NodeKey2Bytes[:], node2Pub)
+	copy(info.BitcoinKey1Bytes[:], dbChan.BitcoinKey1)
+	copy(info.BitcoinKey2Bytes[:], dbChan.BitcoinKey2)
+
+	if dbChan.Node1Signature != nil {
+		info.AuthProof = &ChannelAuthProof{
+			NodeSig1Bytes:    dbChan.Node1Signature,
+			NodeSig2Bytes:    dbChan.Node2Signature,
+			BitcoinSig1Bytes: dbChan.Bitcoin1Signature,
+			BitcoinSig2Bytes: dbChan.Bitcoin2Signature,
+		}
+	}
+
+	return info, nil
+}
+
+// buildSQLChannelEdge builds a ChannelEdge from the passed database row,
+// fetching the policies of the channel along with the node each policy
+// points to.
+func buildSQLChannelEdge(ctx context.Context, db SQLGraphQueries,
+	dbChan sqlc.GraphChannel, node1Pub, node2Pub []byte) (*ChannelEdge,
+	error) {
+
+	info, err := buildSQLEdgeInfo(dbChan, node1Pub, node2Pub)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := db.GetChannelPolicies(ctx, dbChan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	edge := &ChannelEdge{Info: info}
+	for _, dbPolicy := range policies {
+		policy := &ChannelEdgePolicy{
+			SigBytes:   dbPolicy.Signature,
+			ChannelID:  info.ChannelID,
+			LastUpdate: time.Unix(dbPolicy.LastUpdate, 0),
+			MessageFlags: lnwire.ChanUpdateMsgFlags(
+				dbPolicy.MessageFlags,
+			),
+			ChannelFlags: lnwire.ChanUpdateChanFlags(
+				dbPolicy.ChannelFlags,
+			),
+			TimeLockDelta: uint16(dbPolicy.Timelock),
+			MinHTLC: lnwire.MilliSatoshi(
+				dbPolicy.MinHtlcMsat,
+			),
+			FeeBaseMSat: lnwire.MilliSatoshi(
+				dbPolicy.FeeBaseMsat,
+			),
+			FeeProportionalMillionths: lnwire.MilliSatoshi(
+				dbPolicy.FeePpm,
+			),
+			ExtraOpaqueData: dbPolicy.ExtraOpaqueData,
+		}
+		if dbPolicy.MaxHtlcMsat.Valid {
+			policy.MaxHTLC = lnwire.MilliSatoshi(
+				dbPolicy.MaxHtlcMsat.Int64,
+			)
+		}
+
+		// The node of a policy is the node the policy points to, so
+		// the first policy points to the second node and vice versa.
+		toNodeID := dbChan.NodeID2
+		if dbPolicy.Direction != 0 {
+			toNodeID = dbChan.NodeID1
+		}
+
+		dbNode, err := db.GetNodeByID(ctx, toNodeID)
+		if err != nil {
+			return nil, err
+		}
+		policy.Node, err = buildSQLNode(ctx, db, dbNode)
+		if err != nil {
+			return nil, err
+		}
+
+		if dbPolicy.Direction == 0 {
+			edge.Policy1 = policy
+		} else {
+			edge.Policy2 = policy
+		}
+	}
+
+	return edge, nil
+}
+
+// forEachSQLChannel iterates over all the channels in the database in pages
+// and calls the passed callback for each of them.
+func forEachSQLChannel(ctx context.Context, db SQLGraphQueries,
+	cb func(*ChannelEdge) error) error {
+
+	var lastID int64
+	for {
+		rows, err := db.ListChannelsPaginated(
+			ctx, sqlc.ListChannelsPaginatedParams{
+				ID:    lastID,
+				Limit: graphQueryPaginationLimit,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			lastID = row.GraphChannel.ID
+
+			edge, err := buildSQLChannelEdge(
+				ctx, db, row.GraphChannel, row.Node1PubKey,
+				row.Node2PubKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := cb(edge); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < graphQueryPaginationLimit {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package channeldb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+// MigrateGraphToSQL copies the full content of the passed key-value channel
+// graph into the native SQL graph store: the nodes (including the source
+// node), the channels along with their policies, the zombie index and the
+// prune log. The migration is executed within a single SQL transaction, so
+// either the whole graph is migrated or nothing is.
+func MigrateGraphToSQL(ctx context.Context, kvGraph *ChannelGraph,
+	sqlDB BatchedSQLGraphQueries) error {
+
+	log.Infof("Migrating channel graph to the native SQL store")
+
+	writeTxOpts := NewSQLGraphQueryWriteTx()
+
+	var numNodes, numChannels, numZombies int
+	err := sqlDB.ExecTx(ctx, &writeTxOpts, func(db SQLGraphQueries) error {
+		numNodes, numChannels, numZombies = 0, 0, 0
+
+		// First, migrate all the nodes so that the channels can refer
+		// to them.
+		err := kvGraph.ForEachNode(
+			func(_ kvdb.RTx, node *LightningNode) error {
+				_, err := upsertSQLNode(ctx, db, node)
+				if err != nil {
+					return err
+				}
+				numNodes++
+
+				return nil
+			},
+		)
+		if err != nil && !errors.Is(err, ErrGraphNodesNotFound) {
+			return fmt.Errorf("unable to migrate nodes: %w", err)
+		}
+
+		sourceNode, err := kvGraph.SourceNode()
+		switch {
+		case errors.Is(err, ErrSourceNodeNotSet),
+			errors.Is(err, ErrGraphNotFound):
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch source node: %w",
+				err)
+
+		default:
+			nodeID, err := db.GetNodeIDByPubKey(
+				ctx, sourceNode.PubKeyBytes[:],
+			)
+			if err != nil {
+				return err
+			}
+			if err := db.AddSourceNode(ctx, nodeID); err != nil {
+				return err
+			}
+		}
+
+		// Then migrate the channels along with their policies.
+		err = kvGraph.ForEachChannel(func(info *ChannelEdgeInfo,
+			policy1, policy2 *ChannelEdgePolicy) error {
+
+			if err := insertSQLChannel(ctx, db, info); err != nil {
+				return fmt.Errorf("unable to migrate channel "+
+					"%v: %w", info.ChannelID, err)
+			}
+
+			row, err := db.GetChannelBySCID(
+				ctx, chanIDToSCID(info.ChannelID),
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, policy := range []*ChannelEdgePolicy{
+				policy1, policy2,
+			} {
+				if policy == nil {
+					continue
+				}
+
+				err := upsertSQLPolicy(
+					ctx, db, row.GraphChannel.ID,
+					policy,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			numChannels++
+
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrGraphNoEdgesFound) {
+			return fmt.Errorf("unable to migrate channels: %w", err)
+		}
+
+		// Finally, migrate the zombie index and the prune log which
+		// aren't exposed by the ChannelGraph API, so we read the
+		// buckets directly.
+		return kvdb.View(kvGraph.db, func(tx kvdb.RTx) error {
+			var err error
+			numZombies, err = migrateZombiesToSQL(ctx, tx, db)
+			if err != nil {
+				return fmt.Errorf("unable to migrate zombie "+
+					"index: %w", err)
+			}
+
+			err = migratePruneLogToSQL(ctx, tx, db)
+			if err != nil {
+				return fmt.Errorf("unable to migrate prune "+
+					"log: %w", err)
+			}
+
+			return nil
+		}, func() {})
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Migrated %d nodes, %d channels and %d zombie channels to "+
+		"the native SQL store", numNodes, numChannels, numZombies)
+
+	return nil
+}
+
+// migrateZombiesToSQL copies the zombie index of the key-value graph into the
+// SQL store and returns the number of zombie channels migrated.
+func migrateZombiesToSQL(ctx context.Context, tx kvdb.RTx,
+	db SQLGraphQueries) (int, error) {
+
+	edges := tx.ReadBucket(edgeBucket)
+	if edges == nil {
+		return 0, nil
+	}
+	zombieIndex := edges.NestedReadBucket(zombieBucket)
+	if zombieIndex == nil {
+		return 0, nil
+	}
+
+	var numZombies int
+	err := zombieIndex.ForEach(func(k, v []byte) error {
+		numZombies++
+
+		params := sqlc.UpsertZombieChannelParams{
+			Scid:     k,
+			NodeKey1: v[:33],
+			NodeKey2: v[33:66],
+		}
+
+		return db.UpsertZombieChannel(ctx, params)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numZombies, nil
+}
+
+// migratePruneLogToSQL copies the prune log of the key-value graph into the
+// SQL store.
+func migratePruneLogToSQL(ctx context.Context, tx kvdb.RTx,
+	db SQLGraphQueries) error {
+
+	graphMeta := tx.ReadBucket(graphMetaBucket)
+	if graphMeta == nil {
+		return nil
+	}
+	pruneLog := graphMeta.NestedReadBucket(pruneLogBucket)
+	if pruneLog == nil {
+		return nil
+	}
+
+	return pruneLog.ForEach(func(k, v []byte) error {
+		params := sqlc.UpsertPruneLogEntryParams{
+			BlockHeight: int64(byteOrder.Uint32(k)),
+			BlockHash:   v[:pruneTipBytes],
+		}
+
+		return db.UpsertPruneLogEntry(ctx, params)
+	})
+}
+
+// graphMigratedKey is the key of the marker that is added once the channel
+// graph was migrated to the native SQL graph store.
+var graphMigratedKey = []byte("graph-migrated-to-sql")
+
+// GraphMigratedToSQL returns true if the channel graph of the database was
+// migrated to the native SQL graph store. The graph in the key-value store
+// isn't updated anymore from then on.
+func (d *DB) GraphMigratedToSQL() (bool, error) {
+	var migrated bool
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		_, err := CheckMarkerPresent(tx, graphMigratedKey)
+		switch {
+		case errors.Is(err, ErrMarkerNotPresent):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		migrated = true
+
+		return nil
+	}, func() {
+		migrated = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return migrated, nil
+}
+
+// SetGraphMigratedToSQL marks the channel graph of the database as migrated to
+// the native SQL graph store.
+func (d *DB) SetGraphMigratedToSQL() error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		return AddMarker(
+			tx, graphMigratedKey, []byte("native sql graph"),
+		)
+	}, func() {})
+}
@@ -0,0 +1,680 @@
+package channeldb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSQLGraphDB creates a new batched SQL graph query executor backed by
+// a fresh test database.
+func newTestSQLGraphDB(t *testing.T) BatchedSQLGraphQueries {
+	t.Helper()
+
+	db := sqldb.NewTestDB(t).BaseDB
+
+	return sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLGraphQueries {
+			return db.WithTx(tx)
+		},
+	)
+}
+
+// newTestSQLGraphStore creates a new SQL graph store backed by a fresh test
+// database.
+func newTestSQLGraphStore(t *testing.T) *SQLGraphStore {
+	t.Helper()
+
+	return NewSQLGraphStore(newTestSQLGraphDB(t))
+}
+
+// addTestSQLChannel adds a channel between the two passed nodes at the given
+// block height to the store, along with both of its policies.
+func addTestSQLChannel(t *testing.T, store GraphStore, height uint32,
+	outPointIndex uint32, node1, node2 *LightningNode) (*ChannelEdgeInfo,
+	*ChannelEdgePolicy, *ChannelEdgePolicy) {
+
+	t.Helper()
+
+	edgeInfo, shortChanID := createEdge(
+		height, 0, 0, outPointIndex, node1, node2,
+	)
+	require.NoError(t, store.AddChannelEdge(&edgeInfo))
+
+	chanID := shortChanID.ToUint64()
+	policy1 := newEdgePolicy(chanID, nil, time.Now().Unix())
+	policy1.ChannelFlags = 0
+	policy1.SigBytes = testSig.Serialize()
+	require.NoError(t, store.UpdateEdgePolicy(policy1))
+
+	policy2 := newEdgePolicy(chanID, nil, time.Now().Unix()+1)
+	policy2.ChannelFlags = lnwire.ChanUpdateDirection
+	policy2.SigBytes = testSig.Serialize()
+	require.NoError(t, store.UpdateEdgePolicy(policy2))
+
+	return &edgeInfo, policy1, policy2
+}
+
+// orderedTestNodes creates two test nodes ordered by their public keys, as
+// they'd appear in a channel announcement.
+func orderedTestNodes(t *testing.T) (*LightningNode, *LightningNode) {
+	t.Helper()
+
+	node1, err := createTestVertex(nil)
+	require.NoError(t, err)
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+
+	if string(node1.PubKeyBytes[:]) > string(node2.PubKeyBytes[:]) {
+		node1, node2 = node2, node1
+	}
+
+	return node1, node2
+}
+
+// TestSQLGraphNodes tests the insertion, retrieval and deletion of nodes in
+// the SQL graph store.
+func TestSQLGraphNodes(t *testing.T) {
+	t.Parallel()
+
+	store := newTestSQLGraphStore(t)
+
+	// The source node isn't set yet.
+	_, err := store.SourceNode()
+	require.ErrorIs(t, err, ErrSourceNodeNotSet)
+
+	node, err := createTestVertex(nil)
+	require.NoError(t, err)
+	node.ExtraOpaqueData = []byte("extra new data")
+
+	_, exists, err := store.HasLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, store.AddLightningNode(node))
+
+	dbNode, err := store.FetchLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(node, dbNode))
+	require.Equal(t, testFeatures, dbNode.Features)
+
+	updateTime, exists, err := store.HasLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, node.LastUpdate, updateTime)
+
+	pubKey, err := node.PubKey()
+	require.NoError(t, err)
+	alias, err := store.LookupAlias(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, node.Alias, alias)
+
+	// Updating the node should replace its addresses.
+	node.Addresses = node.Addresses[:1]
+	node.LastUpdate = node.LastUpdate.Add(time.Second)
+	require.NoError(t, store.AddLightningNode(node))
+
+	dbNode, err = store.FetchLightningNode(node.PubKeyBytes)
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(node, dbNode))
+
+	// The node should be returned within its update horizon.
+	nodes, err := store.NodeUpdatesInHorizon(
+		node.LastUpdate.Add(-time.Second),
+		node.LastUpdate.Add(time.Second),
+	)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.NoError(t, compareNodes(node, &nodes[0]))
+
+	nodes, err = store.NodeUpdatesInHorizon(
+		node.LastUpdate.Add(time.Second),
+		node.LastUpdate.Add(2*time.Second),
+	)
+	require.NoError(t, err)
+	require.Empty(t, nodes)
+
+	// Set a source node and make sure it can be retrieved.
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, store.SetSourceNode(sourceNode))
+
+	dbSource, err := store.SourceNode()
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(sourceNode, dbSource))
+
+	var numNodes int
+	err = store.ForEachNode(func(*LightningNode) error {
+		numNodes++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numNodes)
+
+	// Finally, delete the node.
+	require.NoError(t, store.DeleteLightningNode(node.PubKeyBytes))
+
+	_, err = store.FetchLightningNode(node.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+
+	err = store.DeleteLightningNode(node.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+}
+
+// TestSQLGraphChannels tests the insertion and retrieval of channels and
+// their policies in the SQL graph store.
+func TestSQLGraphChannels(t *testing.T) {
+	t.Parallel()
+
+	store := newTestSQLGraphStore(t)
+
+	node1, node2 := orderedTestNodes(t)
+	require.NoError(t, store.AddLightningNode(node1))
+
+	// Adding the channel should create a shell node for the unknown
+	// second node.
+	edgeInfo, policy1, policy2 := addTestSQLChannel(
+		t, store, 100, 1, node1, node2,
+	)
+	chanID := edgeInfo.ChannelID
+
+	shellNode, err := store.FetchLightningNode(node2.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, shellNode.HaveNodeAnnouncement)
+
+	require.NoError(t, store.AddLightningNode(node2))
+
+	// Adding the same channel again should fail.
+	err = store.AddChannelEdge(edgeInfo)
+	require.ErrorIs(t, err, ErrEdgeAlreadyExist)
+
+	// The policies point to the node on the other end of the channel.
+	policy1.Node = node2
+	policy2.Node = node1
+
+	dbInfo, dbPolicy1, dbPolicy2, err := store.FetchChannelEdgesByID(chanID)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, edgeInfo, dbInfo)
+	require.NoError(t, compareEdgePolicies(policy1, dbPolicy1))
+	require.NoError(t, compareEdgePolicies(policy2, dbPolicy2))
+
+	dbInfo, _, _, err = store.FetchChannelEdgesByOutpoint(
+		&edgeInfo.ChannelPoint,
+	)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, edgeInfo, dbInfo)
+
+	dbChanID, err := store.ChannelID(&edgeInfo.ChannelPoint)
+	require.NoError(t, err)
+	require.Equal(t, chanID, dbChanID)
+
+	upd1, upd2, exists, isZombie, err := store.HasChannelEdge(chanID)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.False(t, isZombie)
+	require.Equal(t, policy1.LastUpdate, upd1)
+	require.Equal(t, policy2.LastUpdate, upd2)
+
+	// Add a second, private channel at a higher height.
+	privInfo, _ := createEdge(200, 0, 0, 2, node1, node2)
+	privInfo.AuthProof = nil
+	require.NoError(t, store.AddChannelEdge(&privInfo))
+
+	highest, err := store.HighestChanID()
+	require.NoError(t, err)
+	require.Equal(t, privInfo.ChannelID, highest)
+
+	// Only the announced channel is returned in the channel range.
+	ranges, err := store.FilterChannelRange(0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, []BlockChannelRange{{
+		Height: 100,
+		Channels: []lnwire.ShortChannelID{
+			lnwire.NewShortChanIDFromInt(chanID),
+		},
+	}}, ranges)
+
+	// Both channels are returned when iterating over the graph, and the
+	// channel view.
+	var numChans int
+	err = store.ForEachChannel(func(_ *ChannelEdgeInfo, _,
+		_ *ChannelEdgePolicy) error {
+
+		numChans++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numChans)
+
+	edgePoints, err := store.ChannelView()
+	require.NoError(t, err)
+	require.Len(t, edgePoints, 2)
+
+	// From the PoV of the second node, the outgoing policy is the second
+	// one.
+	err = store.ForEachNodeChannel(node2.PubKeyBytes, func(
+		info *ChannelEdgeInfo, out, in *ChannelEdgePolicy) error {
+
+		if info.ChannelID != chanID {
+			require.Nil(t, out)
+			require.Nil(t, in)
+
+			return nil
+		}
+
+		require.NoError(t, compareEdgePolicies(policy2, out))
+		require.NoError(t, compareEdgePolicies(policy1, in))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	edges, err := store.ChanUpdatesInHorizon(
+		policy1.LastUpdate, policy1.LastUpdate,
+	)
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	assertEdgeInfoEqual(t, edgeInfo, edges[0].Info)
+
+	edges, err = store.FetchChanInfos([]uint64{
+		chanID, privInfo.ChannelID, 1234,
+	})
+	require.NoError(t, err)
+	require.Len(t, edges, 2)
+
+	// Disable both directions of the announced channel.
+	disabled, err := store.DisabledChannelIDs()
+	require.NoError(t, err)
+	require.Empty(t, disabled)
+
+	policy1.ChannelFlags |= lnwire.ChanUpdateDisabled
+	require.NoError(t, store.UpdateEdgePolicy(policy1))
+	policy2.ChannelFlags |= lnwire.ChanUpdateDisabled
+	require.NoError(t, store.UpdateEdgePolicy(policy2))
+
+	disabled, err = store.DisabledChannelIDs()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{chanID}, disabled)
+
+	// Updating the static information of the private channel should make
+	// it public.
+	privInfo.AuthProof = edgeInfo.AuthProof
+	require.NoError(t, store.UpdateChannelEdge(&privInfo))
+
+	dbInfo, _, _, err = store.FetchChannelEdgesByID(privInfo.ChannelID)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, &privInfo, dbInfo)
+
+	// Updating unknown channels should fail.
+	unknownInfo, _ := createEdge(300, 0, 0, 3, node1, node2)
+	err = store.UpdateChannelEdge(&unknownInfo)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+
+	err = store.UpdateEdgePolicy(
+		newEdgePolicy(unknownInfo.ChannelID, nil, time.Now().Unix()),
+	)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+}
+
+// TestSQLGraphPublicNode tests that the SQL graph store determines whether a
+// node is public the same way as the key-value graph does.
+func TestSQLGraphPublicNode(t *testing.T) {
+	t.Parallel()
+
+	store := newTestSQLGraphStore(t)
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	_, err = store.IsPublicNode(sourceNode.PubKeyBytes)
+	require.ErrorIs(t, err, ErrSourceNodeNotSet)
+
+	require.NoError(t, store.SetSourceNode(sourceNode))
+
+	node1, node2 := orderedTestNodes(t)
+	require.NoError(t, store.AddLightningNode(node1))
+	require.NoError(t, store.AddLightningNode(node2))
+
+	// A node with only a private channel to us isn't public.
+	privInfo, _ := createEdge(100, 0, 0, 1, sourceNode, node1)
+	privInfo.NodeKey1Bytes = sourceNode.PubKeyBytes
+	privInfo.NodeKey2Bytes = node1.PubKeyBytes
+	privInfo.AuthProof = nil
+	require.NoError(t, store.AddChannelEdge(&privInfo))
+
+	isPublic, err := store.IsPublicNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.False(t, isPublic)
+
+	// Once it has a channel to another node, it is.
+	chanInfo, _ := createEdge(101, 0, 0, 2, node1, node2)
+	require.NoError(t, store.AddChannelEdge(&chanInfo))
+
+	isPublic, err = store.IsPublicNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+	require.True(t, isPublic)
+}
+
+// TestSQLGraphPruning tests the pruning of channels, nodes and the handling
+// of zombie channels in the SQL graph store.
+func TestSQLGraphPruning(t *testing.T) {
+	t.Parallel()
+
+	store := newTestSQLGraphStore(t)
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, store.SetSourceNode(sourceNode))
+
+	_, _, err = store.PruneTip()
+	require.ErrorIs(t, err, ErrGraphNeverPruned)
+
+	node1, node2 := orderedTestNodes(t)
+	require.NoError(t, store.AddLightningNode(node1))
+	require.NoError(t, store.AddLightningNode(node2))
+
+	info1, _, _ := addTestSQLChannel(t, store, 100, 1, node1, node2)
+	info2, _, _ := addTestSQLChannel(t, store, 101, 2, node1, node2)
+	info3, _, _ := addTestSQLChannel(t, store, 102, 3, node1, node2)
+
+	// Prune the first channel by spending its funding output.
+	blockHash := chainhash.Hash{1}
+	closed, err := store.PruneGraph(
+		[]*wire.OutPoint{&info1.ChannelPoint}, &blockHash, 150,
+	)
+	require.NoError(t, err)
+	require.Len(t, closed, 1)
+	assertEdgeInfoEqual(t, info1, closed[0])
+
+	tipHash, tipHeight, err := store.PruneTip()
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *tipHash)
+	require.EqualValues(t, 150, tipHeight)
+
+	_, _, _, err = store.FetchChannelEdgesByID(info1.ChannelID)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+
+	// Disconnecting block 102 should remove the third channel and the
+	// prune log entry.
+	removed, err := store.DisconnectBlockAtHeight(102)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	require.Equal(t, info3.ChannelID, removed[0].ChannelID)
+
+	_, _, err = store.PruneTip()
+	require.ErrorIs(t, err, ErrGraphNeverPruned)
+
+	// Delete the second channel and mark it as a zombie.
+	require.NoError(t, store.DeleteChannelEdges(
+		false, true, info2.ChannelID,
+	))
+	err = store.DeleteChannelEdges(false, true, info2.ChannelID)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+
+	isZombie, pub1, pub2 := store.IsZombieEdge(info2.ChannelID)
+	require.True(t, isZombie)
+	require.Equal(t, node1.PubKeyBytes, pub1)
+	require.Equal(t, node2.PubKeyBytes, pub2)
+
+	numZombies, err := store.NumZombies()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, numZombies)
+
+	_, _, exists, isZombie, err := store.HasChannelEdge(info2.ChannelID)
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.True(t, isZombie)
+
+	zombieInfo, _, _, err := store.FetchChannelEdgesByID(info2.ChannelID)
+	require.ErrorIs(t, err, ErrZombieEdge)
+	require.Equal(t, node1.PubKeyBytes, zombieInfo.NodeKey1Bytes)
+
+	// Neither the pruned nor the zombie channel should be reported as
+	// known.
+	unknown, err := store.FilterKnownChanIDs([]uint64{
+		info1.ChannelID, info2.ChannelID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{info1.ChannelID}, unknown)
+
+	require.NoError(t, store.MarkEdgeLive(info2.ChannelID))
+	isZombie, _, _ = store.IsZombieEdge(info2.ChannelID)
+	require.False(t, isZombie)
+
+	// With all channels gone, pruning the graph nodes should remove both
+	// nodes but keep the source node.
+	require.NoError(t, store.PruneGraphNodes())
+
+	_, err = store.FetchLightningNode(node1.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+	_, err = store.FetchLightningNode(node2.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+	_, err = store.SourceNode()
+	require.NoError(t, err)
+
+	// Finally, test the closed channel index.
+	scid := lnwire.NewShortChanIDFromInt(info1.ChannelID)
+	isClosed, err := store.IsClosedScid(scid)
+	require.NoError(t, err)
+	require.False(t, isClosed)
+
+	require.NoError(t, store.PutClosedScid(scid))
+	require.NoError(t, store.PutClosedScid(scid))
+
+	isClosed, err = store.IsClosedScid(scid)
+	require.NoError(t, err)
+	require.True(t, isClosed)
+}
+
+// TestMigrateGraphToSQL tests that a key-value channel graph is fully copied
+// over to the SQL graph store.
+func TestMigrateGraphToSQL(t *testing.T) {
+	t.Parallel()
+
+	kvGraph, err := MakeTestGraph(t)
+	require.NoError(t, err)
+
+	sourceNode, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, kvGraph.SetSourceNode(sourceNode))
+
+	node1, node2 := orderedTestNodes(t)
+	require.NoError(t, kvGraph.AddLightningNode(node1))
+	require.NoError(t, kvGraph.AddLightningNode(node2))
+
+	edgeInfo, policy1, policy2 := addTestSQLChannel(
+		t, kvGraph, 100, 1, node1, node2,
+	)
+
+	zombieInfo, _ := createEdge(101, 0, 0, 2, node1, node2)
+	require.NoError(t, kvGraph.AddChannelEdge(&zombieInfo))
+	require.NoError(t, kvGraph.DeleteChannelEdges(
+		false, true, zombieInfo.ChannelID,
+	))
+
+	blockHash := chainhash.Hash{1}
+	_, err = kvGraph.PruneGraph(nil, &blockHash, 150)
+	require.NoError(t, err)
+
+	sqlDB := newTestSQLGraphDB(t)
+	require.NoError(t, MigrateGraphToSQL(
+		context.Background(), kvGraph, sqlDB,
+	))
+	store := NewSQLGraphStore(sqlDB)
+
+	dbSource, err := store.SourceNode()
+	require.NoError(t, err)
+	require.NoError(t, compareNodes(sourceNode, dbSource))
+
+	for _, node := range []*LightningNode{node1, node2} {
+		dbNode, err := store.FetchLightningNode(node.PubKeyBytes)
+		require.NoError(t, err)
+		require.NoError(t, compareNodes(node, dbNode))
+	}
+
+	policy1.Node = node2
+	policy2.Node = node1
+
+	dbInfo, dbPolicy1, dbPolicy2, err := store.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, edgeInfo, dbInfo)
+	require.NoError(t, compareEdgePolicies(policy1, dbPolicy1))
+	require.NoError(t, compareEdgePolicies(policy2, dbPolicy2))
+
+	isZombie, _, _ := store.IsZombieEdge(zombieInfo.ChannelID)
+	require.True(t, isZombie)
+
+	tipHash, tipHeight, err := store.PruneTip()
+	require.NoError(t, err)
+	require.Equal(t, blockHash, *tipHash)
+	require.EqualValues(t, 150, tipHeight)
+}
+
+// TestChannelGraphUseSQLStore tests that a ChannelGraph that was switched over
+// to the SQL graph store migrates its graph, stores new channels in the SQL
+// store and keeps its graph cache up to date.
+func TestChannelGraphUseSQLStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	graph, err := MakeTestGraph(t)
+	require.NoError(t, err)
+
+	sourceNode, node1 := orderedTestNodes(t)
+	require.NoError(t, graph.SetSourceNode(sourceNode))
+	require.NoError(t, graph.AddLightningNode(node1))
+	kvInfo, _, _ := addTestSQLChannel(t, graph, 100, 1, sourceNode, node1)
+
+	// Switching to the empty SQL store migrates the existing graph.
+	store := newTestSQLGraphStore(t)
+	require.NoError(t, graph.UseSQLStore(ctx, store))
+
+	_, _, _, err = store.FetchChannelEdgesByID(kvInfo.ChannelID)
+	require.NoError(t, err)
+
+	// New channels end up in the SQL store and in the graph cache, but
+	// not in the key-value database.
+	node2, err := createTestVertex(nil)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node2))
+	node1Key, node2Key := node1, node2
+	if string(node1Key.PubKeyBytes[:]) > string(node2Key.PubKeyBytes[:]) {
+		node1Key, node2Key = node2Key, node1Key
+	}
+	sqlInfo, _, _ := addTestSQLChannel(
+		t, graph, 101, 2, node1Key, node2Key,
+	)
+
+	_, _, _, err = store.FetchChannelEdgesByID(sqlInfo.ChannelID)
+	require.NoError(t, err)
+
+	kvGraph, err := NewChannelGraph(
+		graph.db, DefaultRejectCacheSize, DefaultChannelCacheSize,
+		0, 0, true, true,
+	)
+	require.NoError(t, err)
+	_, _, _, err = kvGraph.FetchChannelEdgesByID(sqlInfo.ChannelID)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+
+	assertCachedChannels := func(graph *ChannelGraph, node route.Vertex,
+		chanIDs ...uint64) {
+
+		t.Helper()
+
+		var cached []uint64
+		err := graph.ForEachNodeChannel(nil, node,
+			func(c *DirectedChannel) error {
+				cached = append(cached, c.ChannelID)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(t, chanIDs, cached)
+	}
+	assertCachedChannels(
+		graph, node1.PubKeyBytes, kvInfo.ChannelID, sqlInfo.ChannelID,
+	)
+
+	// The channels of a node are read from the SQL store as well.
+	dbNode, err := graph.FetchLightningNode(node1.PubKeyBytes)
+	require.NoError(t, err)
+
+	var nodeChans []uint64
+	err = dbNode.ForEachChannel(nil, func(_ kvdb.RTx,
+		info *ChannelEdgeInfo, _, _ *ChannelEdgePolicy) error {
+
+		nodeChans = append(nodeChans, info.ChannelID)
+
+		otherNode, err := info.FetchOtherNode(
+			nil, node1.PubKeyBytes[:],
+		)
+		require.NoError(t, err)
+		require.NotEqual(t, node1.PubKeyBytes, otherNode.PubKeyBytes)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []uint64{kvInfo.ChannelID, sqlInfo.ChannelID}, nodeChans,
+	)
+
+	// Deleting a channel removes it from the graph cache.
+	require.NoError(t, graph.DeleteChannelEdges(
+		false, true, kvInfo.ChannelID,
+	))
+	assertCachedChannels(graph, node1.PubKeyBytes, sqlInfo.ChannelID)
+
+	// After a restart, the SQL store isn't migrated again and the graph
+	// cache is populated from it.
+	restartedGraph, err := NewChannelGraph(
+		graph.db, DefaultRejectCacheSize, DefaultChannelCacheSize,
+		0, 0, true, true,
+	)
+	require.NoError(t, err)
+	require.NoError(t, restartedGraph.UseSQLStore(ctx, store))
+	assertCachedChannels(
+		restartedGraph, node1.PubKeyBytes, sqlInfo.ChannelID,
+	)
+	isZombie, _, _ := restartedGraph.IsZombieEdge(kvInfo.ChannelID)
+	require.True(t, isZombie)
+
+	// Wiping the graph clears the SQL store.
+	require.NoError(t, restartedGraph.Wipe())
+
+	_, _, _, err = store.FetchChannelEdgesByID(sqlInfo.ChannelID)
+	require.ErrorIs(t, err, ErrEdgeNotFound)
+	_, err = store.FetchLightningNode(node1.PubKeyBytes)
+	require.ErrorIs(t, err, ErrGraphNodeNotFound)
+	_, err = store.SourceNode()
+	require.ErrorIs(t, err, ErrSourceNodeNotSet)
+	isZombie, _, _ = store.IsZombieEdge(kvInfo.ChannelID)
+	require.False(t, isZombie)
+}
+
+// TestGraphMigratedToSQL asserts that the marker of the graph migration to the
+// native SQL store is persisted.
+func TestGraphMigratedToSQL(t *testing.T) {
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	migrated, err := db.GraphMigratedToSQL()
+	require.NoError(t, err)
+	require.False(t, migrated)
+
+	require.NoError(t, db.SetGraphMigratedToSQL())
+
+	migrated, err = db.GraphMigratedToSQL()
+	require.NoError(t, err)
+	require.True(t, migrated)
+}
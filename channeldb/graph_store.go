@@ -0,0 +1,161 @@
+package channeldb
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/batch"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// GraphStore is the set of channel graph operations that don't depend on a
+// specific database backend. It is implemented by both the key-value backed
+// ChannelGraph and the native SQL SQLGraphStore.
+type GraphStore interface { //nolint:interfacebloat
+	// AddLightningNode adds a vertex/node to the graph database. If the
+	// node is already known, its information is updated.
+	AddLightningNode(node *LightningNode, op ...batch.SchedulerOption) error
+
+	// FetchLightningNode attempts to look up a target node by its identity
+	// public key. ErrGraphNodeNotFound is returned if the node isn't
+	// known.
+	FetchLightningNode(nodePub route.Vertex) (*LightningNode, error)
+
+	// HasLightningNode determines if the graph has a vertex identified by
+	// the target node identity public key, returning the time of its last
+	// update if so.
+	HasLightningNode(nodePub [33]byte) (time.Time, bool, error)
+
+	// LookupAlias attempts to return the alias as advertised by the target
+	// node.
+	LookupAlias(pub *btcec.PublicKey) (string, error)
+
+	// DeleteLightningNode removes a vertex/node from the database
+	// according to the node's public key.
+	DeleteLightningNode(nodePub route.Vertex) error
+
+	// SourceNode returns the source node of the graph.
+	SourceNode() (*LightningNode, error)
+
+	// SetSourceNode sets the source node within the graph database.
+	SetSourceNode(node *LightningNode) error
+
+	// NodeUpdatesInHorizon returns all the known lightning nodes which
+	// have an update timestamp within the passed range.
+	NodeUpdatesInHorizon(startTime, endTime time.Time) ([]LightningNode,
+		error)
+
+	// IsPublicNode determines whether the node with the given public key
+	// is seen as a public node from the source node's point of view.
+	IsPublicNode(pubKey [33]byte) (bool, error)
+
+	// PruneGraphNodes removes any nodes from the channel graph that are
+	// currently unconnected.
+	PruneGraphNodes() error
+
+	// AddChannelEdge adds a new (undirected, blank) edge to the graph
+	// database.
+	AddChannelEdge(edge *ChannelEdgeInfo, op ...batch.SchedulerOption) error
+
+	// UpdateChannelEdge updates the static information of a channel that
+	// was previously added to the graph.
+	UpdateChannelEdge(edge *ChannelEdgeInfo) error
+
+	// HasChannelEdge returns whether the channel is known along with the
+	// update times of both directed edges, or whether it's a zombie.
+	HasChannelEdge(chanID uint64) (time.Time, time.Time, bool, bool, error)
+
+	// UpdateEdgePolicy updates the edge routing policy for a single
+	// directed edge within the database for the referenced channel.
+	UpdateEdgePolicy(edge *ChannelEdgePolicy,
+		op ...batch.SchedulerOption) error
+
+	// ForEachChannel iterates through all the channel edges stored within
+	// the graph and invokes the passed callback for each edge.
+	ForEachChannel(cb func(*ChannelEdgeInfo, *ChannelEdgePolicy,
+		*ChannelEdgePolicy) error) error
+
+	// FetchChannelEdgesByID attempts to lookup the two directed edges for
+	// the channel identified by the channel ID.
+	FetchChannelEdgesByID(chanID uint64) (*ChannelEdgeInfo,
+		*ChannelEdgePolicy, *ChannelEdgePolicy, error)
+
+	// FetchChannelEdgesByOutpoint attempts to lookup the two directed
+	// edges for the channel identified by the funding outpoint.
+	FetchChannelEdgesByOutpoint(op *wire.OutPoint) (*ChannelEdgeInfo,
+		*ChannelEdgePolicy, *ChannelEdgePolicy, error)
+
+	// FetchChanInfos returns the set of channel edges that correspond to
+	// the passed channel ID's, skipping the unknown ones.
+	FetchChanInfos(chanIDs []uint64) ([]ChannelEdge, error)
+
+	// ChannelID attempts to lookup the 8-byte compact channel ID which
+	// maps to the passed channel point.
+	ChannelID(chanPoint *wire.OutPoint) (uint64, error)
+
+	// HighestChanID returns the "highest" known channel ID in the channel
+	// graph.
+	HighestChanID() (uint64, error)
+
+	// ChanUpdatesInHorizon returns all the known channel edges which have
+	// at least one edge that has an update timestamp within the specified
+	// horizon.
+	ChanUpdatesInHorizon(startTime, endTime time.Time) ([]ChannelEdge,
+		error)
+
+	// FilterKnownChanIDs returns the subset of the passed channel IDs
+	// that are neither known channels nor known zombies.
+	FilterKnownChanIDs(chanIDs []uint64) ([]uint64, error)
+
+	// FilterChannelRange returns the channel ID's of all known channels
+	// which were mined in a block height within the passed range.
+	FilterChannelRange(startHeight, endHeight uint32) ([]BlockChannelRange,
+		error)
+
+	// DisabledChannelIDs returns the channel ids of disabled channels.
+	DisabledChannelIDs() ([]uint64, error)
+
+	// ChannelView returns the verifiable edge information for each active
+	// channel within the known channel graph.
+	ChannelView() ([]EdgePoint, error)
+
+	// DeleteChannelEdges removes edges with the given channel IDs from the
+	// database and optionally marks them as zombies.
+	DeleteChannelEdges(strictZombiePruning, markZombie bool,
+		chanIDs ...uint64) error
+
+	// PruneGraph prunes newly closed channels from the channel graph in
+	// response to a new block being solved on the network.
+	PruneGraph(spentOutputs []*wire.OutPoint, blockHash *chainhash.Hash,
+		blockHeight uint32) ([]*ChannelEdgeInfo, error)
+
+	// PruneTip returns the block height and hash of the latest block that
+	// has been used to prune channels in the graph.
+	PruneTip() (*chainhash.Hash, uint32, error)
+
+	// DisconnectBlockAtHeight rewinds the graph back to the height below
+	// the passed one, deleting channels that are no longer confirmed.
+	DisconnectBlockAtHeight(height uint32) ([]*ChannelEdgeInfo, error)
+
+	// MarkEdgeZombie marks a channel identified by its channel ID as a
+	// zombie.
+	MarkEdgeZombie(chanID uint64, pubKey1, pubKey2 [33]byte) error
+
+	// MarkEdgeLive clears an edge from our zombie index, deeming it as
+	// live.
+	MarkEdgeLive(chanID uint64) error
+
+	// IsZombieEdge returns whether the edge is considered zombie, along
+	// with the two node public keys corresponding to the edge.
+	IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte)
+
+	// NumZombies returns the current number of zombie channels in the
+	// graph.
+	NumZombies() (uint64, error)
+}
+
+// A compile-time assertion to ensure that ChannelGraph implements the
+// GraphStore interface.
+var _ GraphStore = (*ChannelGraph)(nil)
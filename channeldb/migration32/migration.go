@@ -0,0 +1,498 @@
+package migration32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	byteOrder = binary.BigEndian
+
+	paymentsRootBucket = []byte("payments-root-bucket")
+
+	paymentSequenceKey = []byte("payment-sequence-key")
+
+	paymentCreationInfoKey = []byte("payment-creation-info")
+
+	paymentHtlcsBucket = []byte("payment-htlcs-bucket")
+
+	htlcAttemptInfoKey = []byte("ai")
+
+	htlcSettleInfoKey = []byte("si")
+
+	htlcFailInfoKey = []byte("fi")
+
+	paymentFailInfoKey = []byte("payment-fail-info")
+
+	duplicatePaymentsBucket = []byte("payment-duplicate-bucket")
+
+	duplicatePaymentSequenceKey = []byte("payment-sequence-key")
+
+	duplicatePaymentCreationInfoKey = []byte("payment-creation-info")
+
+	duplicatePaymentAttemptInfoKey = []byte("payment-attempt-info")
+
+	duplicatePaymentSettleInfoKey = []byte("payment-settle-info")
+
+	duplicatePaymentFailInfoKey = []byte("payment-fail-info")
+
+	paymentsSummaryIndexBucket = []byte("payments-summary-index-bucket")
+
+	paymentsStatusIndexBucket = []byte("payments-status-index-bucket")
+
+	paymentsDestinationIndexBucket = []byte(
+		"payments-destination-index-bucket",
+	)
+
+	paymentsCreationIndexBucket = []byte("payments-creation-index-bucket")
+)
+
+// paymentStatus is the status of a payment.
+type paymentStatus byte
+
+const (
+	statusInitiated paymentStatus = 1
+	statusInFlight  paymentStatus = 2
+	statusSucceeded paymentStatus = 3
+	statusFailed    paymentStatus = 4
+)
+
+// maxOnionPayloadSize is the largest Sphinx payload possible, so we don't need
+// to read a TLV stream larger than this.
+const maxOnionPayloadSize = 1300
+
+// vertexSize is the size of a serialized public key.
+const vertexSize = 33
+
+// paymentSummary holds the fields of a payment that payments can be filtered
+// by.
+type paymentSummary struct {
+	status        paymentStatus
+	creationNanos int64
+	value         uint64
+	fee           uint64
+	destination   [vertexSize]byte
+}
+
+// serialize serializes the payment summary in the format of the payment
+// summary index: the status, the creation time in unix nanoseconds, the value,
+// the fee and the destination.
+func (s *paymentSummary) serialize() []byte {
+	var b [1 + 8 + 8 + 8 + vertexSize]byte
+
+	b[0] = byte(s.status)
+	byteOrder.PutUint64(b[1:9], uint64(s.creationNanos))
+	byteOrder.PutUint64(b[9:17], s.value)
+	byteOrder.PutUint64(b[17:25], s.fee)
+	copy(b[25:], s.destination[:])
+
+	return b[:]
+}
+
+// indexedPayment is a payment along with the sequence number it's indexed by.
+type indexedPayment struct {
+	sequenceNumber []byte
+	summary        *paymentSummary
+}
+
+// MigratePaymentFilterIndex creates the indexes that payments can be filtered
+// by: a summary of each payment keyed by its sequence number, and indexes of
+// the sequence numbers by status, destination and creation time.
+func MigratePaymentFilterIndex(tx kvdb.RwTx) error {
+	log.Infof("Migrating payments to add filter indexes")
+
+	payments, err := fetchPaymentSummaries(tx)
+	if err != nil {
+		return err
+	}
+
+	buckets := make(map[string]kvdb.RwBucket)
+	for _, name := range [][]byte{
+		paymentsSummaryIndexBucket, paymentsStatusIndexBucket,
+		paymentsDestinationIndexBucket, paymentsCreationIndexBucket,
+	} {
+		bucket, err := tx.CreateTopLevelBucket(name)
+		if err != nil {
+			return err
+		}
+
+		buckets[string(name)] = bucket
+	}
+
+	summaries := buckets[string(paymentsSummaryIndexBucket)]
+	statusIndex := buckets[string(paymentsStatusIndexBucket)]
+	destIndex := buckets[string(paymentsDestinationIndexBucket)]
+	creationIndex := buckets[string(paymentsCreationIndexBucket)]
+
+	for _, payment := range payments {
+		seq, summary := payment.sequenceNumber, payment.summary
+
+		err := summaries.Put(seq, summary.serialize())
+		if err != nil {
+			return err
+		}
+
+		statusBucket, err := statusIndex.CreateBucketIfNotExists(
+			[]byte{byte(summary.status)},
+		)
+		if err != nil {
+			return err
+		}
+		if err := statusBucket.Put(seq, nil); err != nil {
+			return err
+		}
+
+		if summary.destination != [vertexSize]byte{} {
+			destBucket, err := destIndex.CreateBucketIfNotExists(
+				summary.destination[:],
+			)
+			if err != nil {
+				return err
+			}
+			if err := destBucket.Put(seq, nil); err != nil {
+				return err
+			}
+		}
+
+		creationKey := make([]byte, 8+len(seq))
+		byteOrder.PutUint64(
+			creationKey[:8], uint64(summary.creationNanos),
+		)
+		copy(creationKey[8:], seq)
+		if err := creationIndex.Put(creationKey, nil); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Indexed %d payments", len(payments))
+
+	return nil
+}
+
+// fetchPaymentSummaries returns the summaries of all payments, including the
+// duplicate payments of older versions of lnd.
+func fetchPaymentSummaries(tx kvdb.RTx) ([]indexedPayment, error) {
+	paymentsBucket := tx.ReadBucket(paymentsRootBucket)
+	if paymentsBucket == nil {
+		return nil, nil
+	}
+
+	var payments []indexedPayment
+	err := paymentsBucket.ForEach(func(k, _ []byte) error {
+		bucket := paymentsBucket.NestedReadBucket(k)
+		if bucket == nil {
+			return fmt.Errorf("non bucket element in payments " +
+				"bucket")
+		}
+
+		seq := bucket.Get(paymentSequenceKey)
+		if seq == nil {
+			return errors.New("expected sequence number")
+		}
+
+		summary, err := fetchPaymentSummary(bucket)
+		if err != nil {
+			return fmt.Errorf("unable to summarize payment %x: %w",
+				k, err)
+		}
+
+		payments = append(payments, indexedPayment{
+			sequenceNumber: seq,
+			summary:        summary,
+		})
+
+		duplicates := bucket.NestedReadBucket(duplicatePaymentsBucket)
+		if duplicates == nil {
+			return nil
+		}
+
+		return duplicates.ForEach(func(k, _ []byte) error {
+			dup := duplicates.NestedReadBucket(k)
+			if dup == nil {
+				return fmt.Errorf("non bucket element in " +
+					"duplicate bucket")
+			}
+
+			seq := dup.Get(duplicatePaymentSequenceKey)
+			if seq == nil {
+				return errors.New("expected sequence number")
+			}
+
+			summary, err := fetchDuplicatePaymentSummary(dup)
+			if err != nil {
+				return fmt.Errorf("unable to summarize "+
+					"duplicate payment %x: %w", k, err)
+			}
+
+			payments = append(payments, indexedPayment{
+				sequenceNumber: seq,
+				summary:        summary,
+			})
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// fetchPaymentSummary returns the summary of a payment from its bucket.
+func fetchPaymentSummary(bucket kvdb.RBucket) (*paymentSummary, error) {
+	info := bucket.Get(paymentCreationInfoKey)
+	if info == nil {
+		return nil, errors.New("creation info not found")
+	}
+
+	// The creation info starts with the payment hash, followed by the
+	// value and the creation time in unix nanoseconds.
+	if len(info) < 32+8+8 {
+		return nil, errors.New("creation info too short")
+	}
+
+	summary := &paymentSummary{
+		value:         byteOrder.Uint64(info[32:40]),
+		creationNanos: int64(byteOrder.Uint64(info[40:48])),
+	}
+
+	var (
+		inflight, settled, htlcFailed bool
+		haveDestination               bool
+	)
+
+	htlcs := bucket.NestedReadBucket(paymentHtlcsBucket)
+	if htlcs != nil {
+		err := htlcs.ForEach(func(k, v []byte) error {
+			if !bytes.HasPrefix(k, htlcAttemptInfoKey) {
+				return nil
+			}
+
+			aid := k[len(htlcAttemptInfoKey):]
+
+			// The attempt info starts with the session key,
+			// followed by the route.
+			if len(v) < 32 {
+				return errors.New("attempt info too short")
+			}
+
+			fee, dest, err := readRouteFeeAndDestination(
+				bytes.NewReader(v[32:]),
+			)
+			if err != nil {
+				return err
+			}
+
+			if !haveDestination && dest != nil {
+				summary.destination = *dest
+				haveDestination = true
+			}
+
+			failKey := append(
+				append([]byte{}, htlcFailInfoKey...), aid...,
+			)
+			if htlcs.Get(failKey) != nil {
+				htlcFailed = true
+				return nil
+			}
+
+			// Settled and in-flight attempts count towards the
+			// fee of the payment.
+			summary.fee += fee
+
+			settleKey := append(
+				append([]byte{}, htlcSettleInfoKey...), aid...,
+			)
+			if htlcs.Get(settleKey) != nil {
+				settled = true
+			} else {
+				inflight = true
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	paymentFailed := bucket.Get(paymentFailInfoKey) != nil
+
+	switch {
+	case inflight:
+		summary.status = statusInFlight
+
+	case settled:
+		summary.status = statusSucceeded
+
+	case paymentFailed:
+		summary.status = statusFailed
+
+	case htlcFailed:
+		summary.status = statusInFlight
+
+	default:
+		summary.status = statusInitiated
+	}
+
+	return summary, nil
+}
+
+// fetchDuplicatePaymentSummary returns the summary of a duplicate payment
+// from its bucket.
+func fetchDuplicatePaymentSummary(bucket kvdb.RBucket) (*paymentSummary,
+	error) {
+
+	info := bucket.Get(duplicatePaymentCreationInfoKey)
+	if info == nil {
+		return nil, errors.New("creation info not found")
+	}
+
+	// The creation info of duplicate payments starts with the payment
+	// hash, followed by the value and the creation time in unix seconds.
+	if len(info) < 32+8+8 {
+		return nil, errors.New("creation info too short")
+	}
+
+	creationSeconds := int64(byteOrder.Uint64(info[40:48]))
+	summary := &paymentSummary{
+		value:         byteOrder.Uint64(info[32:40]),
+		creationNanos: creationSeconds * 1e9,
+	}
+
+	settled := bucket.Get(duplicatePaymentSettleInfoKey) != nil
+
+	switch {
+	case settled:
+		summary.status = statusSucceeded
+
+	case bucket.Get(duplicatePaymentFailInfoKey) != nil:
+		summary.status = statusFailed
+
+	default:
+		summary.status = statusInFlight
+	}
+
+	attempt := bucket.Get(duplicatePaymentAttemptInfoKey)
+	if attempt == nil {
+		return summary, nil
+	}
+
+	// The attempt info starts with the attempt id and the session key,
+	// followed by the route.
+	if len(attempt) < 8+32 {
+		return nil, errors.New("attempt info too short")
+	}
+
+	fee, dest, err := readRouteFeeAndDestination(
+		bytes.NewReader(attempt[8+32:]),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dest != nil {
+		summary.destination = *dest
+	}
+
+	// Only the attempt of a settled duplicate payment counts towards its
+	// fee, as all others are considered failed.
+	if settled {
+		summary.fee = fee
+	}
+
+	return summary, nil
+}
+
+// readRouteFeeAndDestination reads a serialized route, returning its fee and
+// the public key of its final hop. The returned destination is nil for routes
+// without hops.
+func readRouteFeeAndDestination(r io.Reader) (uint64, *[vertexSize]byte,
+	error) {
+
+	var (
+		totalTimeLock uint32
+		totalAmount   uint64
+	)
+	if err := binary.Read(r, byteOrder, &totalTimeLock); err != nil {
+		return 0, nil, err
+	}
+	if err := binary.Read(r, byteOrder, &totalAmount); err != nil {
+		return 0, nil, err
+	}
+
+	// Skip the source public key.
+	if _, err := wire.ReadVarBytes(r, 0, vertexSize, "source"); err != nil {
+		return 0, nil, err
+	}
+
+	var numHops uint32
+	if err := binary.Read(r, byteOrder, &numHops); err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		dest         [vertexSize]byte
+		amtToForward uint64
+	)
+	for i := uint32(0); i < numHops; i++ {
+		pub, err := wire.ReadVarBytes(r, 0, vertexSize, "pubkey")
+		if err != nil {
+			return 0, nil, err
+		}
+		copy(dest[:], pub)
+
+		var (
+			chanID           uint64
+			outgoingTimeLock uint32
+			legacyPayload    bool
+			numRecords       uint32
+		)
+		if err := binary.Read(r, byteOrder, &chanID); err != nil {
+			return 0, nil, err
+		}
+		err = binary.Read(r, byteOrder, &outgoingTimeLock)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := binary.Read(r, byteOrder, &amtToForward); err != nil {
+			return 0, nil, err
+		}
+		err = binary.Read(r, byteOrder, &legacyPayload)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := binary.Read(r, byteOrder, &numRecords); err != nil {
+			return 0, nil, err
+		}
+
+		// Skip the tlv records of the hop.
+		for j := uint32(0); j < numRecords; j++ {
+			var recordType uint64
+			err := binary.Read(r, byteOrder, &recordType)
+			if err != nil {
+				return 0, nil, err
+			}
+
+			_, err = wire.ReadVarBytes(
+				r, 0, maxOnionPayloadSize, "tlv",
+			)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+
+	if numHops == 0 {
+		return 0, nil, nil
+	}
+
+	return totalAmount - amtToForward, &dest, nil
+}
@@ -0,0 +1,300 @@
+package migration32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/migtest"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTime = time.Unix(1_700_000_000, 0)
+
+	source = [vertexSize]byte{2, 1}
+	destA  = [vertexSize]byte{2, 2}
+	destB  = [vertexSize]byte{3, 3}
+
+	seq1 = string(uint64Bytes(1))
+	seq2 = string(uint64Bytes(2))
+	seq3 = string(uint64Bytes(3))
+	seq4 = string(uint64Bytes(4))
+
+	attemptID0 = string(uint64Bytes(0))
+	attemptID1 = string(uint64Bytes(1))
+)
+
+// uint64Bytes returns the big endian serialization of the given integer.
+func uint64Bytes(i uint64) []byte {
+	var b [8]byte
+	byteOrder.PutUint64(b[:], i)
+
+	return b[:]
+}
+
+// creationInfo serializes the creation info of a payment.
+func creationInfo(hash byte, value uint64, creationTime uint64) string {
+	var b bytes.Buffer
+	b.Write(bytes.Repeat([]byte{hash}, 32))
+	b.Write(uint64Bytes(value))
+	b.Write(uint64Bytes(creationTime))
+
+	// The payment request is empty.
+	b.Write(make([]byte, 4))
+
+	return b.String()
+}
+
+// serializeRoute serializes a route with a single hop to the given
+// destination, with a tlv record so that it's skipped properly.
+func serializeRoute(t *testing.T, totalAmt, amtToForward uint64,
+	dest [vertexSize]byte) []byte {
+
+	var b bytes.Buffer
+	write := func(v interface{}) {
+		require.NoError(t, binary.Write(&b, byteOrder, v))
+	}
+
+	write(uint32(100))
+	write(totalAmt)
+	require.NoError(t, wire.WriteVarBytes(&b, 0, source[:]))
+	write(uint32(1))
+
+	require.NoError(t, wire.WriteVarBytes(&b, 0, dest[:]))
+	write(uint64(1234))
+	write(uint32(90))
+	write(amtToForward)
+	write(false)
+	write(uint32(1))
+	write(uint64(8))
+	require.NoError(t, wire.WriteVarBytes(&b, 0, []byte{1, 2, 3}))
+
+	return b.Bytes()
+}
+
+// attemptInfo serializes the info of an htlc attempt.
+func attemptInfo(t *testing.T, totalAmt, amtToForward uint64,
+	dest [vertexSize]byte) string {
+
+	var b bytes.Buffer
+	b.Write(bytes.Repeat([]byte{1}, 32))
+	b.Write(serializeRoute(t, totalAmt, amtToForward, dest))
+
+	// The attempt time follows the route.
+	b.Write(uint64Bytes(uint64(testTime.UnixNano())))
+
+	return b.String()
+}
+
+// duplicateAttemptInfo serializes the attempt info of a duplicate payment.
+func duplicateAttemptInfo(t *testing.T, totalAmt, amtToForward uint64,
+	dest [vertexSize]byte) string {
+
+	var b bytes.Buffer
+	b.Write(uint64Bytes(0))
+	b.Write(bytes.Repeat([]byte{1}, 32))
+	b.Write(serializeRoute(t, totalAmt, amtToForward, dest))
+
+	return b.String()
+}
+
+// summary serializes a payment summary.
+func summary(status paymentStatus, creationNanos int64, value, fee uint64,
+	dest [vertexSize]byte) string {
+
+	s := &paymentSummary{
+		status:        status,
+		creationNanos: creationNanos,
+		value:         value,
+		fee:           fee,
+		destination:   dest,
+	}
+
+	return string(s.serialize())
+}
+
+// creationKey returns the key of a payment in the creation time index.
+func creationKey(creationNanos int64, seq string) string {
+	return string(uint64Bytes(uint64(creationNanos))) + seq
+}
+
+// TestMigratePaymentFilterIndex asserts that the filter indexes are created
+// for all payments, including duplicate payments.
+func TestMigratePaymentFilterIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		time1 = testTime.UnixNano()
+		time2 = testTime.Add(time.Minute).UnixNano()
+		time3 = testTime.Add(2 * time.Minute).UnixNano()
+		time4 = testTime.Add(-time.Hour).Unix() * 1e9
+	)
+
+	duplicate := map[string]interface{}{
+		string(duplicatePaymentSequenceKey): seq4,
+		string(duplicatePaymentCreationInfoKey): creationInfo(
+			1, 1_000, uint64(time4/1e9),
+		),
+		string(duplicatePaymentAttemptInfoKey): duplicateAttemptInfo(
+			t, 1_005, 1_000, destA,
+		),
+		string(duplicatePaymentSettleInfoKey): "settle",
+	}
+
+	payments := map[string]interface{}{
+		// A payment that succeeded with one of two htlcs while the
+		// other one failed, and that has a legacy duplicate payment
+		// that succeeded as well.
+		string(bytes.Repeat([]byte{1}, 32)): map[string]interface{}{
+			string(paymentSequenceKey): seq1,
+			string(paymentCreationInfoKey): creationInfo(
+				1, 1_000, uint64(time1),
+			),
+			string(paymentHtlcsBucket): map[string]interface{}{
+				"ai" + attemptID0: attemptInfo(
+					t, 1_020, 1_000, destA,
+				),
+				"fi" + attemptID0: "fail",
+				"ai" + attemptID1: attemptInfo(
+					t, 1_010, 1_000, destA,
+				),
+				"si" + attemptID1: "settle",
+			},
+			string(duplicatePaymentsBucket): map[string]interface{}{
+				seq4: duplicate,
+			},
+		},
+
+		// A payment that failed.
+		string(bytes.Repeat([]byte{2}, 32)): map[string]interface{}{
+			string(paymentSequenceKey): seq2,
+			string(paymentCreationInfoKey): creationInfo(
+				2, 2_000, uint64(time2),
+			),
+			string(paymentHtlcsBucket): map[string]interface{}{
+				"ai" + attemptID0: attemptInfo(
+					t, 2_020, 2_000, destB,
+				),
+				"fi" + attemptID0: "fail",
+			},
+			string(paymentFailInfoKey): string([]byte{1}),
+		},
+
+		// A payment without any htlc attempts.
+		string(bytes.Repeat([]byte{3}, 32)): map[string]interface{}{
+			string(paymentSequenceKey): seq3,
+			string(paymentCreationInfoKey): creationInfo(
+				3, 3_000, uint64(time3),
+			),
+		},
+	}
+
+	summariesAfter := map[string]interface{}{
+		seq1: summary(statusSucceeded, time1, 1_000, 10, destA),
+		seq2: summary(statusFailed, time2, 2_000, 0, destB),
+		seq3: summary(statusInitiated, time3, 3_000, 0, [33]byte{}),
+		seq4: summary(statusSucceeded, time4, 1_000, 5, destA),
+	}
+
+	statusAfter := map[string]interface{}{
+		string([]byte{byte(statusSucceeded)}): map[string]interface{}{
+			seq1: "",
+			seq4: "",
+		},
+		string([]byte{byte(statusFailed)}): map[string]interface{}{
+			seq2: "",
+		},
+		string([]byte{byte(statusInitiated)}): map[string]interface{}{
+			seq3: "",
+		},
+	}
+
+	destinationAfter := map[string]interface{}{
+		string(destA[:]): map[string]interface{}{
+			seq1: "",
+			seq4: "",
+		},
+		string(destB[:]): map[string]interface{}{
+			seq2: "",
+		},
+	}
+
+	creationAfter := map[string]interface{}{
+		creationKey(time1, seq1): "",
+		creationKey(time2, seq2): "",
+		creationKey(time3, seq3): "",
+		creationKey(time4, seq4): "",
+	}
+
+	before := func(tx kvdb.RwTx) error {
+		return migtest.RestoreDB(tx, paymentsRootBucket, payments)
+	}
+
+	after := func(tx kvdb.RwTx) error {
+		err := migtest.VerifyDB(tx, paymentsRootBucket, payments)
+		if err != nil {
+			return err
+		}
+
+		err = migtest.VerifyDB(
+			tx, paymentsSummaryIndexBucket, summariesAfter,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = migtest.VerifyDB(
+			tx, paymentsStatusIndexBucket, statusAfter,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = migtest.VerifyDB(
+			tx, paymentsDestinationIndexBucket, destinationAfter,
+		)
+		if err != nil {
+			return err
+		}
+
+		return migtest.VerifyDB(
+			tx, paymentsCreationIndexBucket, creationAfter,
+		)
+	}
+
+	migtest.ApplyMigration(
+		t, before, after, MigratePaymentFilterIndex, false,
+	)
+}
+
+// TestMigratePaymentFilterIndexEmpty asserts that the indexes are created
+// when there are no payments.
+func TestMigratePaymentFilterIndexEmpty(t *testing.T) {
+	t.Parallel()
+
+	after := func(tx kvdb.RwTx) error {
+		for _, bucket := range [][]byte{
+			paymentsSummaryIndexBucket, paymentsStatusIndexBucket,
+			paymentsDestinationIndexBucket,
+			paymentsCreationIndexBucket,
+		} {
+			err := migtest.VerifyDB(
+				tx, bucket, map[string]interface{}{},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	migtest.ApplyMigration(
+		t, func(kvdb.RwTx) error { return nil }, after,
+		MigratePaymentFilterIndex, false,
+	)
+}
@@ -126,5 +126,10 @@ type ForwardingPolicy struct {
 	// per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee that's charged for HTLCs that arrive over the
+	// channel, in addition to the outbound fee of the channel that they
+	// are forwarded over.
+	InboundFee InboundFee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
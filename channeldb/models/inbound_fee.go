@@ -0,0 +1,61 @@
+package models
+
+import "github.com/lightningnetwork/lnd/lnwire"
+
+const (
+	// feeRateParts is the total number of parts used to express fee rates.
+	feeRateParts = 1_000_000
+)
+
+// InboundFee is the fee that a node charges for HTLCs that arrive over one of
+// its channels, on top of the outbound fee of the channel that the HTLC is
+// forwarded over. It can be negative to give a discount on the outbound fee.
+type InboundFee struct {
+	// Base is the fixed fee in msat.
+	Base int32
+
+	// Rate is the proportional fee in parts per million.
+	Rate int32
+}
+
+// NewInboundFeeFromWire creates an inbound fee from its wire representation.
+func NewInboundFeeFromWire(fee lnwire.Fee) InboundFee {
+	return InboundFee{
+		Base: fee.BaseFee,
+		Rate: fee.FeeRate,
+	}
+}
+
+// ToWire returns the wire representation of the inbound fee.
+func (i InboundFee) ToWire() lnwire.Fee {
+	return lnwire.Fee{
+		BaseFee: i.Base,
+		FeeRate: i.Rate,
+	}
+}
+
+// IsZero returns true if the inbound fee is neither a discount nor a
+// surcharge.
+func (i InboundFee) IsZero() bool {
+	return i.Base == 0 && i.Rate == 0
+}
+
+// CalcFee returns the inbound fee for the given amount, which is the amount
+// that is forwarded plus the outbound fee. The proportional part is rounded
+// towards zero, so a discount is never larger than advertised.
+func (i InboundFee) CalcFee(amt lnwire.MilliSatoshi) int64 {
+	return int64(i.Base) + int64(i.Rate)*int64(amt)/feeRateParts
+}
+
+// TotalFee returns the total fee of a forward, given the outbound fee and the
+// amount that's forwarded. A discount never makes the total fee negative.
+func (i InboundFee) TotalFee(outboundFee,
+	amtToForward lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	total := int64(outboundFee) + i.CalcFee(amtToForward+outboundFee)
+	if total < 0 {
+		return 0
+	}
+
+	return lnwire.MilliSatoshi(total)
+}
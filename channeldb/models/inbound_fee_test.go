@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInboundFeeTotalFee tests that inbound fees are added to the outbound
+// fee, and that a discount never results in a negative total fee.
+func TestInboundFeeTotalFee(t *testing.T) {
+	t.Parallel()
+
+	// A surcharge of 100 msat plus 1000ppm on 1_001_000 msat.
+	surcharge := InboundFee{Base: 100, Rate: 1000}
+	require.EqualValues(t, 2101, surcharge.TotalFee(1000, 1_000_000))
+
+	// A discount of 500 msat plus 1000ppm, where the proportional part is
+	// rounded towards zero.
+	discount := InboundFee{Base: -500, Rate: -1000}
+	require.EqualValues(t, -1501, discount.CalcFee(1_001_999))
+	require.EqualValues(t, 0, discount.TotalFee(1000, 1_000_000))
+	require.EqualValues(t, 498, discount.TotalFee(2000, 1_000_000))
+}
@@ -192,6 +192,11 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 			if err := indexBucket.Delete(seqBytes); err != nil {
 				return err
 			}
+
+			err := deletePaymentSummary(tx, seqBytes)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Once we have obtained a sequence number, we add an entry
@@ -204,6 +209,17 @@ func (p *PaymentControl) InitPayment(paymentHash lntypes.Hash,
 			return err
 		}
 
+		// We also add the summary of the new payment to the indexes
+		// that payments can be filtered by.
+		err = putPaymentSummary(tx, sequenceNum, &paymentSummary{
+			status:       StatusInitiated,
+			creationTime: info.CreationTime,
+			value:        info.Value,
+		})
+		if err != nil {
+			return err
+		}
+
 		err = bucket.Put(paymentSequenceKey, sequenceNum)
 		if err != nil {
 			return err
@@ -396,7 +412,11 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 
 		// Retrieve attempt info for the notification.
 		payment, err = fetchPayment(bucket)
-		return err
+		if err != nil {
+			return err
+		}
+
+		return updatePaymentSummary(tx, payment)
 	})
 	if err != nil {
 		return nil, err
@@ -493,7 +513,11 @@ func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
 
 		// Retrieve attempt info for the notification.
 		payment, err = fetchPayment(bucket)
-		return err
+		if err != nil {
+			return err
+		}
+
+		return updatePaymentSummary(tx, payment)
 	})
 	if err != nil {
 		return nil, err
@@ -553,7 +577,7 @@ func (p *PaymentControl) Fail(paymentHash lntypes.Hash,
 			return err
 		}
 
-		return nil
+		return updatePaymentSummary(tx, payment)
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,474 @@
+package channeldb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// paymentsSummaryIndexBucket is the name of the top-level bucket
+	// within the database that stores a fixed size summary of each
+	// payment, keyed by its sequence number. The summary holds the fields
+	// that payments can be filtered by, so that queries don't need to
+	// deserialize payments that don't match.
+	//
+	// payments-summary-index-bucket
+	// 	|--<sequence-number>: <status><time><value><fee><dest>
+	// 	|--...
+	paymentsSummaryIndexBucket = []byte("payments-summary-index-bucket")
+
+	// paymentsStatusIndexBucket is the name of the top-level bucket within
+	// the database that indexes the sequence numbers of payments by their
+	// status.
+	//
+	// payments-status-index-bucket
+	// 	|--<status>
+	// 	|	|--<sequence-number>: <empty>
+	// 	|	|--...
+	// 	|--...
+	paymentsStatusIndexBucket = []byte("payments-status-index-bucket")
+
+	// paymentsDestinationIndexBucket is the name of the top-level bucket
+	// within the database that indexes the sequence numbers of payments
+	// by the final hop of their routes. Payments are added to this index
+	// once their first htlc attempt is registered.
+	//
+	// payments-destination-index-bucket
+	// 	|--<destination pubkey>
+	// 	|	|--<sequence-number>: <empty>
+	// 	|	|--...
+	// 	|--...
+	paymentsDestinationIndexBucket = []byte(
+		"payments-destination-index-bucket",
+	)
+
+	// paymentsCreationIndexBucket is the name of the top-level bucket
+	// within the database that indexes the sequence numbers of payments
+	// by their creation time.
+	//
+	// payments-creation-index-bucket
+	// 	|--<creation time><sequence-number>: <empty>
+	// 	|--...
+	paymentsCreationIndexBucket = []byte("payments-creation-index-bucket")
+)
+
+// paymentSummaryLen is the length of a serialized payment summary: the
+// status, the creation time in unix nanoseconds, the value, the fee and the
+// destination.
+const paymentSummaryLen = 1 + 8 + 8 + 8 + route.VertexSize
+
+// errStopPagination is returned by the fetch function of a paginated query to
+// end the query early.
+var errStopPagination = errors.New("stop pagination")
+
+// paymentSummary holds the fields of a payment that payments can be filtered
+// by.
+type paymentSummary struct {
+	// status is the status of the payment.
+	status PaymentStatus
+
+	// creationTime is the time at which the payment was created.
+	creationTime time.Time
+
+	// value is the amount that the payment pays to the receiver.
+	value lnwire.MilliSatoshi
+
+	// fee is the routing fee of the htlcs of the payment that are settled
+	// or still in flight.
+	fee lnwire.MilliSatoshi
+
+	// destination is the final hop of the routes of the payment. It is
+	// zero until the first htlc attempt is registered.
+	destination route.Vertex
+}
+
+// newPaymentSummary creates the summary of a payment.
+func newPaymentSummary(payment *MPPayment) *paymentSummary {
+	_, fee := payment.SentAmt()
+
+	summary := &paymentSummary{
+		status:       payment.Status,
+		creationTime: payment.Info.CreationTime,
+		value:        payment.Info.Value,
+		fee:          fee,
+	}
+
+	for _, htlc := range payment.HTLCs {
+		if len(htlc.Route.Hops) == 0 {
+			continue
+		}
+
+		summary.destination = htlc.Route.FinalHop().PubKeyBytes
+
+		break
+	}
+
+	return summary
+}
+
+// serialize serializes the payment summary.
+func (s *paymentSummary) serialize() []byte {
+	var b [paymentSummaryLen]byte
+
+	b[0] = byte(s.status)
+	byteOrder.PutUint64(b[1:9], uint64(unixNano(s.creationTime)))
+	byteOrder.PutUint64(b[9:17], uint64(s.value))
+	byteOrder.PutUint64(b[17:25], uint64(s.fee))
+	copy(b[25:], s.destination[:])
+
+	return b[:]
+}
+
+// deserializePaymentSummary deserializes a payment summary.
+func deserializePaymentSummary(b []byte) (*paymentSummary, error) {
+	if len(b) != paymentSummaryLen {
+		return nil, fmt.Errorf("invalid payment summary length: %v",
+			len(b))
+	}
+
+	s := &paymentSummary{
+		status: PaymentStatus(b[0]),
+		value:  lnwire.MilliSatoshi(byteOrder.Uint64(b[9:17])),
+		fee:    lnwire.MilliSatoshi(byteOrder.Uint64(b[17:25])),
+	}
+
+	if nanos := byteOrder.Uint64(b[1:9]); nanos != 0 {
+		s.creationTime = time.Unix(0, int64(nanos))
+	}
+
+	copy(s.destination[:], b[25:])
+
+	return s, nil
+}
+
+// unixNano returns the time in unix nanoseconds, or zero for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// creationIndexKey returns the key of a payment in the creation time index.
+func creationIndexKey(creationTime time.Time, sequenceNumber []byte) []byte {
+	key := make([]byte, 8+len(sequenceNumber))
+	byteOrder.PutUint64(key[:8], uint64(unixNano(creationTime)))
+	copy(key[8:], sequenceNumber)
+
+	return key
+}
+
+// putPaymentSummary writes the summary of the payment with the given sequence
+// number and updates the status, destination and creation time indexes to
+// match it.
+func putPaymentSummary(tx kvdb.RwTx, sequenceNumber []byte,
+	summary *paymentSummary) error {
+
+	if err := deletePaymentSummary(tx, sequenceNumber); err != nil {
+		return err
+	}
+
+	summaries, err := fetchIndexBucket(tx, paymentsSummaryIndexBucket)
+	if err != nil {
+		return err
+	}
+	err = summaries.Put(sequenceNumber, summary.serialize())
+	if err != nil {
+		return err
+	}
+
+	statusIndex, err := fetchIndexBucket(tx, paymentsStatusIndexBucket)
+	if err != nil {
+		return err
+	}
+	statusBucket, err := statusIndex.CreateBucketIfNotExists(
+		[]byte{byte(summary.status)},
+	)
+	if err != nil {
+		return err
+	}
+	if err := statusBucket.Put(sequenceNumber, nil); err != nil {
+		return err
+	}
+
+	if summary.destination != (route.Vertex{}) {
+		destIndex, err := fetchIndexBucket(
+			tx, paymentsDestinationIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+		destBucket, err := destIndex.CreateBucketIfNotExists(
+			summary.destination[:],
+		)
+		if err != nil {
+			return err
+		}
+		if err := destBucket.Put(sequenceNumber, nil); err != nil {
+			return err
+		}
+	}
+
+	creationIndex, err := fetchIndexBucket(tx, paymentsCreationIndexBucket)
+	if err != nil {
+		return err
+	}
+
+	return creationIndex.Put(
+		creationIndexKey(summary.creationTime, sequenceNumber), nil,
+	)
+}
+
+// fetchIndexBucket returns the top-level index bucket with the given name.
+func fetchIndexBucket(tx kvdb.RwTx, name []byte) (kvdb.RwBucket, error) {
+	bucket := tx.ReadWriteBucket(name)
+	if bucket == nil {
+		return nil, fmt.Errorf("%s bucket does not exist", name)
+	}
+
+	return bucket, nil
+}
+
+// updatePaymentSummary updates the summary of the payment, and the indexes
+// that are derived from it, to reflect the current state of the payment.
+func updatePaymentSummary(tx kvdb.RwTx, payment *MPPayment) error {
+	var sequenceNumber [8]byte
+	byteOrder.PutUint64(sequenceNumber[:], payment.SequenceNum)
+
+	return putPaymentSummary(
+		tx, sequenceNumber[:], newPaymentSummary(payment),
+	)
+}
+
+// deletePaymentSummary deletes the summary of the payment with the given
+// sequence number, along with its entries in the status, destination and
+// creation time indexes.
+func deletePaymentSummary(tx kvdb.RwTx, sequenceNumber []byte) error {
+	summaries := tx.ReadWriteBucket(paymentsSummaryIndexBucket)
+	if summaries == nil {
+		return nil
+	}
+
+	summaryBytes := summaries.Get(sequenceNumber)
+	if summaryBytes == nil {
+		return nil
+	}
+
+	summary, err := deserializePaymentSummary(summaryBytes)
+	if err != nil {
+		return err
+	}
+
+	statusBucket := nestedIndexBucket(
+		tx, paymentsStatusIndexBucket, []byte{byte(summary.status)},
+	)
+	if statusBucket != nil {
+		if err := statusBucket.Delete(sequenceNumber); err != nil {
+			return err
+		}
+	}
+
+	destBucket := nestedIndexBucket(
+		tx, paymentsDestinationIndexBucket, summary.destination[:],
+	)
+	if destBucket != nil {
+		if err := destBucket.Delete(sequenceNumber); err != nil {
+			return err
+		}
+	}
+
+	creationIndex := tx.ReadWriteBucket(paymentsCreationIndexBucket)
+	if creationIndex != nil {
+		err := creationIndex.Delete(
+			creationIndexKey(summary.creationTime, sequenceNumber),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return summaries.Delete(sequenceNumber)
+}
+
+// fetchPaymentSummary returns the summary of the payment with the given
+// sequence number, or nil if the payment isn't summarized.
+func fetchPaymentSummary(tx kvdb.RTx, sequenceNumber []byte) (*paymentSummary,
+	error) {
+
+	summaries := tx.ReadBucket(paymentsSummaryIndexBucket)
+	if summaries == nil {
+		return nil, nil
+	}
+
+	summaryBytes := summaries.Get(sequenceNumber)
+	if summaryBytes == nil {
+		return nil, nil
+	}
+
+	return deserializePaymentSummary(summaryBytes)
+}
+
+// nestedIndexBucket returns the nested bucket with the given key of a
+// top-level index bucket, or nil if either doesn't exist.
+func nestedIndexBucket(tx kvdb.RwTx, index, key []byte) kvdb.RwBucket {
+	indexBucket := tx.ReadWriteBucket(index)
+	if indexBucket == nil {
+		return nil
+	}
+
+	return indexBucket.NestedReadWriteBucket(key)
+}
+
+// sequenceRange returns the lowest and the highest sequence number of the
+// payments that were created within the given time range. Either end of the
+// range may be zero to leave it open. The returned bool is false if no
+// payments were created within the range.
+func sequenceRange(tx kvdb.RTx, start, end time.Time) (uint64, uint64,
+	bool) {
+
+	creationIndex := tx.ReadBucket(paymentsCreationIndexBucket)
+	if creationIndex == nil {
+		return 0, 0, false
+	}
+
+	var startKey [8]byte
+	byteOrder.PutUint64(startKey[:], uint64(unixNano(start)))
+
+	var (
+		minSeq, maxSeq uint64
+		found          bool
+		cursor         = creationIndex.ReadCursor()
+	)
+	for k, _ := cursor.Seek(startKey[:]); k != nil; k, _ = cursor.Next() {
+		creationNanos := int64(byteOrder.Uint64(k[:8]))
+		if !end.IsZero() && creationNanos > unixNano(end) {
+			break
+		}
+
+		seq := byteOrder.Uint64(k[8:])
+		if !found || seq < minSeq {
+			minSeq = seq
+		}
+		if !found || seq > maxSeq {
+			maxSeq = seq
+		}
+		found = true
+	}
+
+	return minSeq, maxSeq, found
+}
+
+// paymentFilter filters the payments of a payments query by their summaries.
+type paymentFilter struct {
+	query PaymentsQuery
+
+	// statuses are the statuses that payments may have. It is empty if
+	// payments of any status match.
+	statuses []PaymentStatus
+}
+
+// newPaymentFilter creates the filter of the given payments query.
+func newPaymentFilter(query PaymentsQuery) *paymentFilter {
+	f := &paymentFilter{
+		query:    query,
+		statuses: query.Statuses,
+	}
+
+	// To keep compatibility with the old API, we only return non-succeeded
+	// payments if requested.
+	if len(f.statuses) == 0 && !query.IncludeIncomplete {
+		f.statuses = []PaymentStatus{StatusSucceeded}
+	}
+
+	return f
+}
+
+// matches returns whether a payment with the given summary matches the query.
+func (f *paymentFilter) matches(s *paymentSummary) bool {
+	q := f.query
+
+	if len(f.statuses) > 0 {
+		var statusMatch bool
+		for _, status := range f.statuses {
+			if s.status == status {
+				statusMatch = true
+				break
+			}
+		}
+
+		if !statusMatch {
+			return false
+		}
+	}
+
+	if q.Destination != nil && s.destination != *q.Destination {
+		return false
+	}
+
+	// Skip any payments that were created outside of the specified time
+	// range.
+	if !q.CreationDateStart.IsZero() &&
+		s.creationTime.Before(q.CreationDateStart) {
+
+		return false
+	}
+	if !q.CreationDateEnd.IsZero() &&
+		s.creationTime.After(q.CreationDateEnd) {
+
+		return false
+	}
+
+	if q.MinAmount != 0 && s.value < q.MinAmount {
+		return false
+	}
+	if q.MaxAmount != 0 && s.value > q.MaxAmount {
+		return false
+	}
+
+	if q.MinFee != 0 && s.fee < q.MinFee {
+		return false
+	}
+	if q.MaxFee != 0 && s.fee > q.MaxFee {
+		return false
+	}
+
+	return true
+}
+
+// candidates returns the bucket that holds the sequence numbers of the
+// smallest known set of payments that may match the query, or nil if no
+// payments can match it.
+func (f *paymentFilter) candidates(tx kvdb.RTx,
+	indexes kvdb.RBucket) kvdb.RBucket {
+
+	switch {
+	case f.query.Destination != nil:
+		destIndex := tx.ReadBucket(paymentsDestinationIndexBucket)
+		if destIndex == nil {
+			return nil
+		}
+
+		return destIndex.NestedReadBucket(f.query.Destination[:])
+
+	// If the query only matches payments of a single status, we only need
+	// to look at those.
+	case len(f.statuses) == 1:
+		statusIndex := tx.ReadBucket(paymentsStatusIndexBucket)
+		if statusIndex == nil {
+			return nil
+		}
+
+		return statusIndex.NestedReadBucket(
+			[]byte{byte(f.statuses[0])},
+		)
+
+	default:
+		return indexes
+	}
+}
@@ -0,0 +1,213 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryPaymentsFilters tests that payments can be filtered by their
+// status, destination, value and fee, and that the indexes are kept up to
+// date when payments change.
+func TestQueryPaymentsFilters(t *testing.T) {
+	t.Parallel()
+
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	pControl := NewPaymentControl(db)
+
+	// initPayment initiates a payment created at the given unix time, and
+	// returns its hash and its first htlc attempt.
+	initPayment := func(creationTime int64) (lntypes.Hash,
+		*HTLCAttemptInfo) {
+
+		info, attempt, _, err := genInfo()
+		require.NoError(t, err)
+
+		info.CreationTime = time.Unix(creationTime, 0)
+		err = pControl.InitPayment(info.PaymentIdentifier, info)
+		require.NoError(t, err)
+
+		return info.PaymentIdentifier, attempt
+	}
+
+	// The first payment succeeds.
+	succeeded, attempt := initPayment(100)
+	_, err = pControl.RegisterAttempt(succeeded, attempt)
+	require.NoError(t, err)
+	_, err = pControl.SettleAttempt(
+		succeeded, attempt.AttemptID,
+		&HTLCSettleInfo{Preimage: lntypes.Preimage{1}},
+	)
+	require.NoError(t, err)
+
+	// The second payment fails.
+	failed, attempt := initPayment(200)
+	_, err = pControl.RegisterAttempt(failed, attempt)
+	require.NoError(t, err)
+	_, err = pControl.FailAttempt(
+		failed, attempt.AttemptID,
+		&HTLCFailInfo{Reason: HTLCFailUnreadable},
+	)
+	require.NoError(t, err)
+	_, err = pControl.Fail(failed, FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	// The third payment doesn't have any htlc attempts yet.
+	initiated, _ := initPayment(300)
+
+	var (
+		otherVertex = route.Vertex{1}
+		fee         = testRoute.TotalFees()
+	)
+
+	testCases := []struct {
+		name     string
+		query    PaymentsQuery
+		expected []lntypes.Hash
+	}{
+		{
+			name: "single status",
+			query: PaymentsQuery{
+				Statuses: []PaymentStatus{StatusSucceeded},
+			},
+			expected: []lntypes.Hash{succeeded},
+		},
+		{
+			name: "multiple statuses",
+			query: PaymentsQuery{
+				Statuses: []PaymentStatus{
+					StatusFailed, StatusInitiated,
+				},
+			},
+			expected: []lntypes.Hash{failed, initiated},
+		},
+		{
+			name: "legacy succeeded only",
+			query: PaymentsQuery{
+				IncludeIncomplete: false,
+			},
+			expected: []lntypes.Hash{succeeded},
+		},
+		{
+			name: "destination",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				Destination:       &vertex,
+			},
+			expected: []lntypes.Hash{succeeded, failed},
+		},
+		{
+			name: "unknown destination",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				Destination:       &otherVertex,
+			},
+		},
+		{
+			name: "destination and time range",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				Destination:       &vertex,
+				CreationDateStart: time.Unix(150, 0),
+				CreationDateEnd:   time.Unix(300, 0),
+			},
+			expected: []lntypes.Hash{failed},
+		},
+		{
+			name: "time range without payments",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				CreationDateStart: time.Unix(400, 0),
+			},
+		},
+		{
+			name: "min fee",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				MinFee:            fee,
+			},
+			expected: []lntypes.Hash{succeeded},
+		},
+		{
+			name: "max fee",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				MaxFee:            fee - 1,
+			},
+			expected: []lntypes.Hash{failed, initiated},
+		},
+		{
+			name: "amount range",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				MinAmount:         testRoute.ReceiverAmt(),
+				MaxAmount:         testRoute.ReceiverAmt(),
+			},
+			expected: []lntypes.Hash{succeeded, failed, initiated},
+		},
+		{
+			name: "amount above value",
+			query: PaymentsQuery{
+				IncludeIncomplete: true,
+				MinAmount:         testRoute.ReceiverAmt() + 1,
+			},
+		},
+		{
+			name: "reversed with max payments",
+			query: PaymentsQuery{
+				Statuses: []PaymentStatus{
+					StatusFailed, StatusInitiated,
+				},
+				Reversed:    true,
+				MaxPayments: 1,
+			},
+			expected: []lntypes.Hash{initiated},
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.query.MaxPayments == 0 {
+			tc.query.MaxPayments = 10
+		}
+
+		resp, err := db.QueryPayments(tc.query)
+		require.NoError(t, err, tc.name)
+
+		var hashes []lntypes.Hash
+		for _, payment := range resp.Payments {
+			hashes = append(hashes, payment.Info.PaymentIdentifier)
+		}
+		require.Equal(t, tc.expected, hashes, tc.name)
+	}
+
+	// Once the succeeded payment is deleted, it is removed from the
+	// indexes as well.
+	require.NoError(t, db.DeletePayment(succeeded, false))
+
+	resp, err := db.QueryPayments(PaymentsQuery{
+		MaxPayments: 10,
+		Destination: &vertex,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Payments)
+
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		summaries := tx.ReadBucket(paymentsSummaryIndexBucket)
+
+		var numSummaries int
+		err := summaries.ForEach(func(_, _ []byte) error {
+			numSummaries++
+			return nil
+		})
+		require.Equal(t, 2, numSummaries)
+
+		return err
+	}, func() {})
+	require.NoError(t, err)
+}
@@ -0,0 +1,646 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// paymentTraceBucket is the name of the top-level bucket within the
+	// database that stores the path finding traces of payments that
+	// requested them. The bucket is created when the first trace is
+	// stored.
+	//
+	// payment-trace-bucket
+	// 	|--<payment hash>
+	// 	|	|--trace-creation-time: <time>
+	// 	|	|--<sequence number>: <path finding trace>
+	// 	|	|--...
+	// 	|--...
+	paymentTraceBucket = []byte("payment-trace-bucket")
+
+	// paymentTraceCreationKey is a key used in the sub-bucket of a payment
+	// trace to store the time at which the first path finding trace of
+	// the payment was stored.
+	paymentTraceCreationKey = []byte("trace-creation-time")
+
+	// paymentTraceIndexBucket is the name of the top-level bucket within
+	// the database that indexes the payment traces by their creation
+	// time, so that expired traces can be pruned without scanning all
+	// traces.
+	//
+	// payment-trace-index-bucket
+	// 	|--<creation time><payment hash>: {}
+	// 	|--...
+	paymentTraceIndexBucket = []byte("payment-trace-index-bucket")
+
+	// ErrPaymentTraceNotFound is returned when the trace of a payment is
+	// requested that wasn't traced or whose trace was pruned.
+	ErrPaymentTraceNotFound = errors.New("payment trace not found")
+)
+
+// TraceRejectReason is the reason why path finding rejected an edge.
+type TraceRejectReason uint8
+
+const (
+	// TraceRejectNone is used for edges that weren't rejected.
+	TraceRejectNone TraceRejectReason = 0
+
+	// TraceRejectBandwidth indicates that none of the channels between
+	// the nodes can carry the amount, because of their bandwidth, their
+	// capacity or their htlc limits.
+	TraceRejectBandwidth TraceRejectReason = 1
+
+	// TraceRejectFeeLimit indicates that the edge would have exceeded the
+	// fee limit of the payment.
+	TraceRejectFeeLimit TraceRejectReason = 2
+
+	// TraceRejectCltvLimit indicates that the edge would have exceeded
+	// the cltv limit of the payment.
+	TraceRejectCltvLimit TraceRejectReason = 3
+
+	// TraceRejectZeroProbability indicates that the success probability
+	// of the edge is zero.
+	TraceRejectZeroProbability TraceRejectReason = 4
+
+	// TraceRejectMinProbability indicates that the success probability of
+	// the path including the edge is below the minimum probability.
+	TraceRejectMinProbability TraceRejectReason = 5
+
+	// TraceRejectFeatures indicates that the node that the edge starts at
+	// has unknown required features or misses feature dependencies.
+	TraceRejectFeatures TraceRejectReason = 6
+
+	// TraceRejectAvoidedNode indicates that the node that the edge starts
+	// at is avoided by the payment.
+	TraceRejectAvoidedNode TraceRejectReason = 7
+
+	// TraceRejectHopLimit indicates that the edge would have exceeded the
+	// maximum number of hops of the payment.
+	TraceRejectHopLimit TraceRejectReason = 8
+
+	// TraceRejectPayloadSize indicates that the edge would have exceeded
+	// the maximum size of the onion payload.
+	TraceRejectPayloadSize TraceRejectReason = 9
+
+	// TraceRejectLastHop indicates that the edge doesn't start at the
+	// last hop that the payment is restricted to.
+	TraceRejectLastHop TraceRejectReason = 10
+)
+
+// String returns a human readable representation of the reject reason.
+func (r TraceRejectReason) String() string {
+	switch r {
+	case TraceRejectNone:
+		return "none"
+
+	case TraceRejectBandwidth:
+		return "bandwidth"
+
+	case TraceRejectFeeLimit:
+		return "fee_limit"
+
+	case TraceRejectCltvLimit:
+		return "cltv_limit"
+
+	case TraceRejectZeroProbability:
+		return "zero_probability"
+
+	case TraceRejectMinProbability:
+		return "min_probability"
+
+	case TraceRejectFeatures:
+		return "features"
+
+	case TraceRejectAvoidedNode:
+		return "avoided_node"
+
+	case TraceRejectHopLimit:
+		return "hop_limit"
+
+	case TraceRejectPayloadSize:
+		return "payload_size"
+
+	case TraceRejectLastHop:
+		return "last_hop"
+	}
+
+	return "unknown"
+}
+
+// TracedPairHistory is the mission control entry of a node pair that path
+// finding consulted.
+type TracedPairHistory struct {
+	// FailTime is the time of the last failure.
+	FailTime time.Time
+
+	// FailAmt is the amount of the last failure.
+	FailAmt lnwire.MilliSatoshi
+
+	// SuccessTime is the time of the last success.
+	SuccessTime time.Time
+
+	// SuccessAmt is the highest amount that successfully forwarded.
+	SuccessAmt lnwire.MilliSatoshi
+}
+
+// TracedEdge is an edge that path finding considered.
+type TracedEdge struct {
+	// ChannelID is the channel of the edge. It is zero if the edge was
+	// rejected before a channel between the nodes was selected.
+	ChannelID uint64
+
+	// From is the node that the edge starts at.
+	From route.Vertex
+
+	// To is the node that the edge ends at.
+	To route.Vertex
+
+	// Amount is the amount that the edge would have to carry.
+	Amount lnwire.MilliSatoshi
+
+	// Probability is the success probability of the edge that path
+	// finding used. It is zero if the edge was rejected before its
+	// probability was requested.
+	Probability float64
+
+	// Reason is the reason why the edge was rejected.
+	Reason TraceRejectReason
+
+	// History is the mission control entry of the node pair, or nil if
+	// mission control had no entry for it.
+	History *TracedPairHistory
+}
+
+// PathFindingTrace describes a path finding run for a payment.
+type PathFindingTrace struct {
+	// Time is the time at which path finding started.
+	Time time.Time
+
+	// Amount is the amount that path finding looked for a route for.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee of the route.
+	FeeLimit lnwire.MilliSatoshi
+
+	// CltvLimit is the maximum time lock of the route, excluding the
+	// final cltv delta.
+	CltvLimit uint32
+
+	// Error is the reason why path finding didn't find a route. It is
+	// empty if a route was found.
+	Error string
+
+	// EdgesConsidered is the number of edges that path finding
+	// considered.
+	EdgesConsidered uint32
+
+	// Rejections holds the number of rejected edges by reason.
+	Rejections map[TraceRejectReason]uint32
+
+	// RejectedEdges holds the first rejected edges. Rejections beyond
+	// are only counted.
+	RejectedEdges []*TracedEdge
+
+	// Route holds the edges of the route that was found.
+	Route []*TracedEdge
+}
+
+// PaymentTrace holds the path finding traces of a payment.
+type PaymentTrace struct {
+	// PaymentIdentifier is the hash that identifies the payment.
+	PaymentIdentifier lntypes.Hash
+
+	// CreationTime is the time at which the first path finding trace of
+	// the payment was stored.
+	CreationTime time.Time
+
+	// PathFindings holds the path finding traces in the order in which
+	// path finding ran.
+	PathFindings []*PathFindingTrace
+}
+
+// AddPathFindingTrace appends a path finding trace to the trace of the
+// payment with the given hash.
+func (d *DB) AddPathFindingTrace(paymentHash lntypes.Hash,
+	trace *PathFindingTrace) error {
+
+	var b bytes.Buffer
+	if err := serializePathFindingTrace(&b, trace); err != nil {
+		return err
+	}
+
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		traces, err := tx.CreateTopLevelBucket(paymentTraceBucket)
+		if err != nil {
+			return err
+		}
+
+		bucket := traces.NestedReadWriteBucket(paymentHash[:])
+		if bucket == nil {
+			bucket, err = traces.CreateBucket(paymentHash[:])
+			if err != nil {
+				return err
+			}
+
+			var t bytes.Buffer
+			if err := serializeTime(&t, trace.Time); err != nil {
+				return err
+			}
+
+			err = bucket.Put(paymentTraceCreationKey, t.Bytes())
+			if err != nil {
+				return err
+			}
+
+			index, err := tx.CreateTopLevelBucket(
+				paymentTraceIndexBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = index.Put(
+				traceIndexKey(trace.Time, paymentHash[:]), nil,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		seqNo, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], seqNo)
+
+		return bucket.Put(key[:], b.Bytes())
+	}, func() {})
+}
+
+// FetchPaymentTrace returns the trace of the payment with the given hash.
+func (d *DB) FetchPaymentTrace(paymentHash lntypes.Hash) (*PaymentTrace,
+	error) {
+
+	var trace *PaymentTrace
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		traces := tx.ReadBucket(paymentTraceBucket)
+		if traces == nil {
+			return ErrPaymentTraceNotFound
+		}
+
+		bucket := traces.NestedReadBucket(paymentHash[:])
+		if bucket == nil {
+			return ErrPaymentTraceNotFound
+		}
+
+		creationTime, err := fetchTraceCreationTime(bucket)
+		if err != nil {
+			return err
+		}
+
+		trace = &PaymentTrace{
+			PaymentIdentifier: paymentHash,
+			CreationTime:      creationTime,
+		}
+
+		// The sequence numbers are stored in big endian, so the path
+		// finding traces are iterated in the order they were added.
+		return bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, paymentTraceCreationKey) {
+				return nil
+			}
+
+			pathFinding, err := deserializePathFindingTrace(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			trace.PathFindings = append(
+				trace.PathFindings, pathFinding,
+			)
+
+			return nil
+		})
+	}, func() {
+		trace = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trace, nil
+}
+
+// DeletePaymentTraces deletes the traces of all payments that were created
+// before the given time, and returns the number of deleted traces.
+func (d *DB) DeletePaymentTraces(createdBefore time.Time) (int, error) {
+	var deleted int
+	err := kvdb.Update(d, func(tx kvdb.RwTx) error {
+		traces := tx.ReadWriteBucket(paymentTraceBucket)
+		index := tx.ReadWriteBucket(paymentTraceIndexBucket)
+		if traces == nil || index == nil {
+			return nil
+		}
+
+		// The index is ordered by creation time, so we only visit the
+		// traces that are deleted. To avoid modifying the bucket while
+		// traversing, we delete the keys in a second loop.
+		end := traceIndexKey(createdBefore, nil)
+		var indexKeys [][]byte
+		cursor := index.ReadWriteCursor()
+		for k, _ := cursor.First(); k != nil &&
+			bytes.Compare(k, end) < 0; k, _ = cursor.Next() {
+
+			indexKeys = append(indexKeys, k)
+		}
+
+		for _, k := range indexKeys {
+			if err := index.Delete(k); err != nil {
+				return err
+			}
+
+			paymentHash := k[8:]
+			if traces.NestedReadWriteBucket(paymentHash) == nil {
+				continue
+			}
+
+			err := traces.DeleteNestedBucket(paymentHash)
+			if err != nil {
+				return err
+			}
+		}
+
+		deleted = len(indexKeys)
+
+		return nil
+	}, func() {
+		deleted = 0
+	})
+
+	return deleted, err
+}
+
+// deletePaymentTrace deletes the trace of the payment with the given hash,
+// if the payment was traced.
+func deletePaymentTrace(tx kvdb.RwTx, paymentHash []byte) error {
+	traces := tx.ReadWriteBucket(paymentTraceBucket)
+	if traces == nil {
+		return nil
+	}
+
+	bucket := traces.NestedReadWriteBucket(paymentHash)
+	if bucket == nil {
+		return nil
+	}
+
+	creationTime, err := fetchTraceCreationTime(bucket)
+	if err != nil {
+		return err
+	}
+
+	if index := tx.ReadWriteBucket(paymentTraceIndexBucket); index != nil {
+		err := index.Delete(traceIndexKey(creationTime, paymentHash))
+		if err != nil {
+			return err
+		}
+	}
+
+	return traces.DeleteNestedBucket(paymentHash)
+}
+
+// traceIndexKey returns the key of a payment trace in the creation time
+// index.
+func traceIndexKey(creationTime time.Time, paymentHash []byte) []byte {
+	key := make([]byte, 8+len(paymentHash))
+	byteOrder.PutUint64(key[:8], uint64(creationTime.UnixNano()))
+	copy(key[8:], paymentHash)
+
+	return key
+}
+
+// fetchTraceCreationTime returns the creation time of a payment trace.
+func fetchTraceCreationTime(bucket kvdb.RBucket) (time.Time, error) {
+	b := bucket.Get(paymentTraceCreationKey)
+	if b == nil {
+		return time.Time{}, errors.New("payment trace creation time " +
+			"not found")
+	}
+
+	return deserializeTime(bytes.NewReader(b))
+}
+
+func serializePathFindingTrace(w io.Writer, t *PathFindingTrace) error {
+	if err := serializeTime(w, t.Time); err != nil {
+		return err
+	}
+
+	err := WriteElements(
+		w, t.Amount, t.FeeLimit, t.CltvLimit, []byte(t.Error),
+		t.EdgesConsidered, uint32(len(t.Rejections)),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Write the rejection counts in the order of their reasons, so that
+	// the serialization is deterministic.
+	reasons := make([]TraceRejectReason, 0, len(t.Rejections))
+	for reason := range t.Rejections {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		return reasons[i] < reasons[j]
+	})
+
+	for _, reason := range reasons {
+		err := WriteElements(w, uint8(reason), t.Rejections[reason])
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := serializeTracedEdges(w, t.RejectedEdges); err != nil {
+		return err
+	}
+
+	return serializeTracedEdges(w, t.Route)
+}
+
+func deserializePathFindingTrace(r io.Reader) (*PathFindingTrace, error) {
+	var (
+		t   PathFindingTrace
+		err error
+	)
+
+	t.Time, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errBytes      []byte
+		numRejections uint32
+	)
+	err = ReadElements(
+		r, &t.Amount, &t.FeeLimit, &t.CltvLimit, &errBytes,
+		&t.EdgesConsidered, &numRejections,
+	)
+	if err != nil {
+		return nil, err
+	}
+	t.Error = string(errBytes)
+
+	t.Rejections = make(map[TraceRejectReason]uint32, numRejections)
+	for i := uint32(0); i < numRejections; i++ {
+		var (
+			reason uint8
+			count  uint32
+		)
+		if err := ReadElements(r, &reason, &count); err != nil {
+			return nil, err
+		}
+
+		t.Rejections[TraceRejectReason(reason)] = count
+	}
+
+	t.RejectedEdges, err = deserializeTracedEdges(r)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Route, err = deserializeTracedEdges(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func serializeTracedEdges(w io.Writer, edges []*TracedEdge) error {
+	if err := WriteElement(w, uint32(len(edges))); err != nil {
+		return err
+	}
+
+	for _, e := range edges {
+		if err := WriteElement(w, e.ChannelID); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(e.From[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(e.To[:]); err != nil {
+			return err
+		}
+
+		err := WriteElements(
+			w, e.Amount, math.Float64bits(e.Probability),
+			uint8(e.Reason), e.History != nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		if e.History == nil {
+			continue
+		}
+
+		if err := serializeTime(w, e.History.FailTime); err != nil {
+			return err
+		}
+
+		if err := WriteElement(w, e.History.FailAmt); err != nil {
+			return err
+		}
+
+		if err := serializeTime(w, e.History.SuccessTime); err != nil {
+			return err
+		}
+
+		if err := WriteElement(w, e.History.SuccessAmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeTracedEdges(r io.Reader) ([]*TracedEdge, error) {
+	var numEdges uint32
+	if err := ReadElement(r, &numEdges); err != nil {
+		return nil, err
+	}
+
+	var edges []*TracedEdge
+	for i := uint32(0); i < numEdges; i++ {
+		var e TracedEdge
+		if err := ReadElement(r, &e.ChannelID); err != nil {
+			return nil, err
+		}
+
+		if _, err := io.ReadFull(r, e.From[:]); err != nil {
+			return nil, err
+		}
+
+		if _, err := io.ReadFull(r, e.To[:]); err != nil {
+			return nil, err
+		}
+
+		var (
+			probability uint64
+			reason      uint8
+			hasHistory  bool
+		)
+		err := ReadElements(
+			r, &e.Amount, &probability, &reason, &hasHistory,
+		)
+		if err != nil {
+			return nil, err
+		}
+		e.Probability = math.Float64frombits(probability)
+		e.Reason = TraceRejectReason(reason)
+
+		if hasHistory {
+			var h TracedPairHistory
+
+			h.FailTime, err = deserializeTime(r)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := ReadElement(r, &h.FailAmt); err != nil {
+				return nil, err
+			}
+
+			h.SuccessTime, err = deserializeTime(r)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := ReadElement(r, &h.SuccessAmt); err != nil {
+				return nil, err
+			}
+
+			e.History = &h
+		}
+
+		edges = append(edges, &e)
+	}
+
+	return edges, nil
+}
@@ -0,0 +1,120 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPaymentTrace tests that path finding traces are appended to the trace
+// of their payment, and that traces are deleted when their retention ends or
+// their payment is deleted.
+func TestPaymentTrace(t *testing.T) {
+	t.Parallel()
+
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	hash := lntypes.Hash{1}
+	_, err = db.FetchPaymentTrace(hash)
+	require.ErrorIs(t, err, ErrPaymentTraceNotFound)
+
+	notFound := &PathFindingTrace{
+		Time:            time.Unix(100, 0),
+		Amount:          1000,
+		FeeLimit:        10,
+		CltvLimit:       144,
+		Error:           "unable to find a path to destination",
+		EdgesConsidered: 3,
+		Rejections: map[TraceRejectReason]uint32{
+			TraceRejectFeeLimit:        2,
+			TraceRejectZeroProbability: 1,
+		},
+		RejectedEdges: []*TracedEdge{
+			{
+				ChannelID: 5,
+				From:      route.Vertex{2},
+				To:        route.Vertex{3},
+				Amount:    1000,
+				Reason:    TraceRejectZeroProbability,
+				History: &TracedPairHistory{
+					FailTime: time.Unix(50, 0),
+					FailAmt:  500,
+				},
+			},
+		},
+	}
+
+	found := &PathFindingTrace{
+		Time:            time.Unix(101, 0),
+		Amount:          500,
+		FeeLimit:        10,
+		CltvLimit:       144,
+		EdgesConsidered: 1,
+		Rejections:      map[TraceRejectReason]uint32{},
+		Route: []*TracedEdge{
+			{
+				ChannelID:   6,
+				From:        route.Vertex{4},
+				To:          route.Vertex{3},
+				Amount:      500,
+				Probability: 0.6,
+			},
+		},
+	}
+
+	require.NoError(t, db.AddPathFindingTrace(hash, notFound))
+	require.NoError(t, db.AddPathFindingTrace(hash, found))
+
+	trace, err := db.FetchPaymentTrace(hash)
+	require.NoError(t, err)
+	require.Equal(t, hash, trace.PaymentIdentifier)
+	require.Equal(t, notFound.Time, trace.CreationTime)
+	require.Equal(t, []*PathFindingTrace{notFound, found},
+		trace.PathFindings)
+
+	// Traces that were created before the retention end are deleted.
+	other := lntypes.Hash{2}
+	otherTrace := &PathFindingTrace{
+		Time:       time.Unix(200, 0),
+		Rejections: map[TraceRejectReason]uint32{},
+	}
+	require.NoError(t, db.AddPathFindingTrace(other, otherTrace))
+
+	deleted, err := db.DeletePaymentTraces(time.Unix(150, 0))
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = db.FetchPaymentTrace(hash)
+	require.ErrorIs(t, err, ErrPaymentTraceNotFound)
+
+	// The trace of a payment is deleted along with the payment.
+	pControl := NewPaymentControl(db)
+	info, _, _, err := genInfo()
+	require.NoError(t, err)
+	require.NoError(t, pControl.InitPayment(info.PaymentIdentifier, info))
+	_, err = pControl.Fail(info.PaymentIdentifier, FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	err = db.AddPathFindingTrace(info.PaymentIdentifier, otherTrace)
+	require.NoError(t, err)
+	require.NoError(t, db.DeletePayment(info.PaymentIdentifier, false))
+
+	_, err = db.FetchPaymentTrace(info.PaymentIdentifier)
+	require.ErrorIs(t, err, ErrPaymentTraceNotFound)
+
+	_, err = db.FetchPaymentTrace(other)
+	require.NoError(t, err)
+
+	// The deleted payment is removed from the creation time index, so
+	// only the remaining trace is pruned.
+	deleted, err = db.DeletePaymentTraces(time.Unix(201, 0))
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = db.FetchPaymentTrace(other)
+	require.ErrorIs(t, err, ErrPaymentTraceNotFound)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"time"
 
@@ -478,6 +479,29 @@ type PaymentsQuery struct {
 	// CreationDateEnd, if set, filters out all payments with a creation
 	// date less than or euqal to it.
 	CreationDateEnd time.Time
+
+	// Statuses, if set, only returns payments with one of the given
+	// statuses. It takes precedence over IncludeIncomplete.
+	Statuses []PaymentStatus
+
+	// Destination, if set, only returns payments to the given node.
+	Destination *route.Vertex
+
+	// MinAmount, if non-zero, only returns payments with a value of at
+	// least it.
+	MinAmount lnwire.MilliSatoshi
+
+	// MaxAmount, if non-zero, only returns payments with a value of at
+	// most it.
+	MaxAmount lnwire.MilliSatoshi
+
+	// MinFee, if non-zero, only returns payments that paid a routing fee
+	// of at least it.
+	MinFee lnwire.MilliSatoshi
+
+	// MaxFee, if non-zero, only returns payments that paid a routing fee
+	// of at most it.
+	MaxFee lnwire.MilliSatoshi
 }
 
 // PaymentsResponse contains the result of a query to the payments database.
@@ -516,6 +540,7 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 		resp         PaymentsResponse
 		startDateSet = !query.CreationDateStart.IsZero()
 		endDateSet   = !query.CreationDateEnd.IsZero()
+		filter       = newPaymentFilter(query)
 	)
 
 	if err := kvdb.View(d, func(tx kvdb.RTx) error {
@@ -533,13 +558,53 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 			return fmt.Errorf("index bucket does not exist")
 		}
 
+		// If the query is limited to a time range, we only need to
+		// look at the payments with sequence numbers between the
+		// lowest and the highest one of the payments that were created
+		// within it.
+		var (
+			minSeq uint64
+			maxSeq uint64 = math.MaxUint64
+			found         = true
+		)
+		if startDateSet || endDateSet {
+			minSeq, maxSeq, found = sequenceRange(
+				tx, query.CreationDateStart,
+				query.CreationDateEnd,
+			)
+		}
+
 		// accumulatePayments gets payments with the sequence number
-		// and hash provided and adds them to our list of payments if
-		// they meet the criteria of our query. It returns the number
-		// of payments that were added.
-		accumulatePayments := func(sequenceKey, hash []byte) (bool,
+		// provided and adds them to our list of payments if they meet
+		// the criteria of our query. It returns the number of payments
+		// that were added.
+		accumulatePayments := func(sequenceKey, _ []byte) (bool,
 			error) {
 
+			// Once we're past the sequence numbers of the time
+			// range, no further payments can match.
+			seq := byteOrder.Uint64(sequenceKey)
+			if seq < minSeq || seq > maxSeq {
+				return false, errStopPagination
+			}
+
+			// Payments are only fetched if their summary matches
+			// the query, so that we don't need to deserialize
+			// payments that are filtered out anyway.
+			summary, err := fetchPaymentSummary(tx, sequenceKey)
+			if err != nil {
+				return false, err
+			}
+			if summary != nil && !filter.matches(summary) {
+				return false, nil
+			}
+
+			hash := indexes.Get(sequenceKey)
+			if hash == nil {
+				return false, fmt.Errorf("no index for "+
+					"payment with sequence number %v", seq)
+			}
+
 			r := bytes.NewReader(hash)
 			paymentHash, err := deserializePaymentIndex(r)
 			if err != nil {
@@ -553,28 +618,10 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 				return false, err
 			}
 
-			// To keep compatibility with the old API, we only
-			// return non-succeeded payments if requested.
-			if payment.Status != StatusSucceeded &&
-				!query.IncludeIncomplete {
-
-				return false, err
-			}
-
-			// Skip any payments that were created before the
-			// specified time.
-			if startDateSet && payment.Info.CreationTime.Before(
-				query.CreationDateStart,
-			) {
-
-				return false, nil
-			}
-
-			// Skip any payments that were created after the
-			// specified time.
-			if endDateSet && payment.Info.CreationTime.After(
-				query.CreationDateEnd,
-			) {
+			// Payments without a summary are filtered by their
+			// full payment instead.
+			if summary == nil &&
+				!filter.matches(newPaymentSummary(payment)) {
 
 				return false, nil
 			}
@@ -585,16 +632,37 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 			return true, nil
 		}
 
-		// Create a paginator which reads from our sequence index bucket
-		// with the parameters provided by the payments query.
-		paginator := newPaginator(
-			indexes.ReadCursor(), query.Reversed, query.IndexOffset,
-			query.MaxPayments,
-		)
+		// Skip the payments before the start of the time range, or
+		// after its end when paginating backwards.
+		indexOffset := query.IndexOffset
+		switch {
+		case !query.Reversed && minSeq > 0 &&
+			indexOffset < minSeq-1:
 
-		// Run a paginated query, adding payments to our response.
-		if err := paginator.query(accumulatePayments); err != nil {
-			return err
+			indexOffset = minSeq - 1
+
+		case query.Reversed && maxSeq < math.MaxUint64 &&
+			(indexOffset == 0 || indexOffset > maxSeq+1):
+
+			indexOffset = maxSeq + 1
+		}
+
+		// Create a paginator which reads from the sequence numbers of
+		// the payments that may match the query, with the parameters
+		// provided by the payments query.
+		candidates := filter.candidates(tx, indexes)
+		if candidates != nil && found {
+			paginator := newPaginator(
+				candidates.ReadCursor(), query.Reversed,
+				indexOffset, query.MaxPayments,
+			)
+
+			// Run a paginated query, adding payments to our
+			// response.
+			err := paginator.query(accumulatePayments)
+			if err != nil && !errors.Is(err, errStopPagination) {
+				return err
+			}
 		}
 
 		// Counting the total number of payments is expensive, since we
@@ -808,11 +876,19 @@ func (d *DB) DeletePayment(paymentHash lntypes.Hash,
 			return err
 		}
 
+		if err := deletePaymentTrace(tx, paymentHash[:]); err != nil {
+			return err
+		}
+
 		indexBucket := tx.ReadWriteBucket(paymentsIndexBucket)
 		for _, k := range seqNrs {
 			if err := indexBucket.Delete(k); err != nil {
 				return err
 			}
+
+			if err := deletePaymentSummary(tx, k); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -939,6 +1015,10 @@ func (d *DB) DeletePayments(failedOnly, failedHtlcsOnly bool) error {
 			if err := payments.DeleteNestedBucket(k); err != nil {
 				return err
 			}
+
+			if err := deletePaymentTrace(tx, k); err != nil {
+				return err
+			}
 		}
 
 		// Get our index bucket and delete all indexes pointing to the
@@ -948,6 +1028,10 @@ func (d *DB) DeletePayments(failedOnly, failedHtlcsOnly bool) error {
 			if err := indexBucket.Delete(k); err != nil {
 				return err
 			}
+
+			if err := deletePaymentSummary(tx, k); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -1168,6 +1252,12 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		)
 	}
 
+	if h.TrampolineOnion != nil {
+		records = append(records, record.NewTrampolineOnionRecord(
+			&h.TrampolineOnion,
+		))
+	}
+
 	// Final sanity check to absolutely rule out custom records that are not
 	// custom and write into the standard range.
 	if err := h.CustomRecords.Validate(); err != nil {
@@ -1330,6 +1420,13 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		h.TotalAmtMsat = lnwire.MilliSatoshi(totalAmtMsatInt)
 	}
 
+	trampolineType := uint64(record.TrampolineOnionType)
+	if trampolineOnion, ok := tlvMap[trampolineType]; ok {
+		delete(tlvMap, trampolineType)
+
+		h.TrampolineOnion = trampolineOnion
+	}
+
 	h.CustomRecords = tlvMap
 
 	return h, nil
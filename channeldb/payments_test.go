@@ -32,8 +32,9 @@ var (
 			65536: []byte{},
 			80001: []byte{},
 		},
-		MPP:      record.NewMPP(32, [32]byte{0x42}),
-		Metadata: []byte{1, 2, 3},
+		MPP:             record.NewMPP(32, [32]byte{0x42}),
+		Metadata:        []byte{1, 2, 3},
+		TrampolineOnion: []byte{4, 5, 6},
 	}
 
 	testHop2 = &route.Hop{
@@ -734,7 +735,14 @@ func appendDuplicatePayment(t *testing.T, db *DB, paymentHash lntypes.Hash,
 		err = createPaymentIndexEntry(tx, sequenceKey[:], paymentHash)
 		require.NoError(t, err)
 
-		return nil
+		// Duplicate payments are summarized by the migration that
+		// created the payment filter indexes, so we do the same here.
+		payment, err := fetchPaymentWithSequenceNumber(
+			tx, paymentHash, sequenceKey[:],
+		)
+		require.NoError(t, err)
+
+		return updatePaymentSummary(tx, payment)
 	}, func() {})
 	require.NoError(t, err, "could not create payment")
 }
@@ -769,7 +777,9 @@ func putDuplicatePayment(t *testing.T, duplicateBucket kvdb.RwBucket,
 	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
-	err = serializeTime(&b, info.CreationTime)
+	// Legacy payments store their creation time in unix seconds.
+	byteOrder.PutUint64(scratch[:], uint64(info.CreationTime.Unix()))
+	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
 	byteOrder.PutUint32(scratch[:4], 0)
@@ -0,0 +1,318 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// probeCampaignBucket is the name of the top-level bucket within the
+	// database that stores the probe campaigns of the prober, keyed by
+	// their id. The bucket is created when the first campaign is stored.
+	//
+	// probe-campaign-bucket
+	// 	|--<campaign id>: <probe campaign>
+	// 	|--...
+	probeCampaignBucket = []byte("probe-campaign-bucket")
+)
+
+// ProbeCampaignResult is the outcome of the latest probe of a campaign to a
+// target through an outgoing channel.
+type ProbeCampaignResult struct {
+	// Target is the node that the probe was sent to.
+	Target route.Vertex
+
+	// OutgoingChan is the channel that the probe had to leave our node
+	// through, or zero if any channel could be used.
+	OutgoingChan uint64
+
+	// Reached is true if the probe reached its target.
+	Reached bool
+
+	// Failure is the reason why the probe didn't reach its target.
+	Failure string
+
+	// Time is the time at which the probe was resolved.
+	Time time.Time
+}
+
+// ProbeCampaign is the persisted state of a probe campaign.
+type ProbeCampaign struct {
+	// ID identifies the campaign.
+	ID uint64
+
+	// Targets are the nodes that are probed.
+	Targets []route.Vertex
+
+	// OutgoingChans are the channels that the probes must leave our node
+	// through.
+	OutgoingChans []uint64
+
+	// Amount is the amount that every probe delivers to its target.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee of the routes that the probes may try.
+	FeeLimit lnwire.MilliSatoshi
+
+	// Interval is the time between the starts of two probe rounds.
+	Interval time.Duration
+
+	// MaxProbes is the number of probes after which the campaign ends.
+	MaxProbes uint32
+
+	// Active is true while the campaign sends probes.
+	Active bool
+
+	// ProbesSent is the number of probes that the campaign sent.
+	ProbesSent uint32
+
+	// ProbesReached is the number of probes that reached their target.
+	ProbesReached uint32
+
+	// EndTime is the time at which the campaign ended. It is zero while
+	// the campaign is active.
+	EndTime time.Time
+
+	// Results holds the outcome of the latest probe to each target
+	// through each outgoing channel.
+	Results []*ProbeCampaignResult
+}
+
+// PutProbeCampaign stores a probe campaign, replacing a previously stored
+// state of the campaign.
+func (d *DB) PutProbeCampaign(campaign *ProbeCampaign) error {
+	var b bytes.Buffer
+	if err := serializeProbeCampaign(&b, campaign); err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], campaign.ID)
+
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		campaigns, err := tx.CreateTopLevelBucket(probeCampaignBucket)
+		if err != nil {
+			return err
+		}
+
+		return campaigns.Put(key[:], b.Bytes())
+	}, func() {})
+}
+
+// FetchProbeCampaigns returns all stored probe campaigns, ordered by id.
+func (d *DB) FetchProbeCampaigns() ([]*ProbeCampaign, error) {
+	var campaigns []*ProbeCampaign
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(probeCampaignBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		// The ids are stored in big endian, so the campaigns are
+		// iterated in the order of their ids.
+		return bucket.ForEach(func(_, v []byte) error {
+			campaign, err := deserializeProbeCampaign(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			campaigns = append(campaigns, campaign)
+
+			return nil
+		})
+	}, func() {
+		campaigns = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+// DeleteProbeCampaigns deletes the campaigns that ended before the given
+// time, and returns their ids. Active campaigns are never deleted.
+func (d *DB) DeleteProbeCampaigns(endedBefore time.Time) ([]uint64, error) {
+	var deleted []uint64
+	err := kvdb.Update(d, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(probeCampaignBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		var toDelete [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			campaign, err := deserializeProbeCampaign(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			if campaign.Active ||
+				!campaign.EndTime.Before(endedBefore) {
+
+				return nil
+			}
+
+			toDelete = append(toDelete, k)
+			deleted = append(deleted, campaign.ID)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range toDelete {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {
+		deleted = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func serializeProbeCampaign(w io.Writer, c *ProbeCampaign) error {
+	err := WriteElements(
+		w, c.ID, c.Amount, c.FeeLimit, int64(c.Interval), c.MaxProbes,
+		c.Active, c.ProbesSent, c.ProbesReached,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeTime(w, c.EndTime); err != nil {
+		return err
+	}
+
+	if err := WriteElement(w, uint32(len(c.Targets))); err != nil {
+		return err
+	}
+	for _, target := range c.Targets {
+		if _, err := w.Write(target[:]); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, uint32(len(c.OutgoingChans))); err != nil {
+		return err
+	}
+	for _, outgoingChan := range c.OutgoingChans {
+		if err := WriteElement(w, outgoingChan); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, uint32(len(c.Results))); err != nil {
+		return err
+	}
+	for _, result := range c.Results {
+		if _, err := w.Write(result.Target[:]); err != nil {
+			return err
+		}
+
+		err := WriteElements(
+			w, result.OutgoingChan, result.Reached,
+			[]byte(result.Failure),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := serializeTime(w, result.Time); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeProbeCampaign(r io.Reader) (*ProbeCampaign, error) {
+	var (
+		c        ProbeCampaign
+		interval int64
+	)
+	err := ReadElements(
+		r, &c.ID, &c.Amount, &c.FeeLimit, &interval, &c.MaxProbes,
+		&c.Active, &c.ProbesSent, &c.ProbesReached,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.Interval = time.Duration(interval)
+
+	c.EndTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numTargets uint32
+	if err := ReadElement(r, &numTargets); err != nil {
+		return nil, err
+	}
+	c.Targets = make([]route.Vertex, numTargets)
+	for i := range c.Targets {
+		if _, err := io.ReadFull(r, c.Targets[i][:]); err != nil {
+			return nil, err
+		}
+	}
+
+	var numChans uint32
+	if err := ReadElement(r, &numChans); err != nil {
+		return nil, err
+	}
+	c.OutgoingChans = make([]uint64, numChans)
+	for i := range c.OutgoingChans {
+		if err := ReadElement(r, &c.OutgoingChans[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var numResults uint32
+	if err := ReadElement(r, &numResults); err != nil {
+		return nil, err
+	}
+	c.Results = make([]*ProbeCampaignResult, numResults)
+	for i := range c.Results {
+		var (
+			result  ProbeCampaignResult
+			failure []byte
+		)
+		if _, err := io.ReadFull(r, result.Target[:]); err != nil {
+			return nil, err
+		}
+
+		err := ReadElements(
+			r, &result.OutgoingChan, &result.Reached, &failure,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.Failure = string(failure)
+
+		result.Time, err = deserializeTime(r)
+		if err != nil {
+			return nil, err
+		}
+
+		c.Results[i] = &result
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,91 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProbeCampaigns tests that probe campaigns are stored, updated and
+// deleted once they ended before the retention.
+func TestProbeCampaigns(t *testing.T) {
+	t.Parallel()
+
+	db, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	campaigns, err := db.FetchProbeCampaigns()
+	require.NoError(t, err)
+	require.Empty(t, campaigns)
+
+	deleted, err := db.DeleteProbeCampaigns(time.Unix(1000, 0))
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+
+	active := &ProbeCampaign{
+		ID:            2,
+		Targets:       []route.Vertex{{1}, {2}},
+		OutgoingChans: []uint64{5, 6},
+		Amount:        1000,
+		FeeLimit:      10,
+		Interval:      time.Minute,
+		MaxProbes:     100,
+		Active:        true,
+		ProbesSent:    2,
+		ProbesReached: 1,
+		Results: []*ProbeCampaignResult{{
+			Target:       route.Vertex{1},
+			OutgoingChan: 5,
+			Reached:      true,
+			Time:         time.Unix(100, 0),
+		}, {
+			Target:       route.Vertex{1},
+			OutgoingChan: 6,
+			Failure:      "no route",
+			Time:         time.Unix(101, 0),
+		}},
+	}
+	ended := &ProbeCampaign{
+		ID:            1,
+		Targets:       []route.Vertex{{3}},
+		OutgoingChans: []uint64{},
+		Amount:        2000,
+		Interval:      time.Hour,
+		MaxProbes:     1,
+		ProbesSent:    1,
+		EndTime:       time.Unix(200, 0),
+		Results:       []*ProbeCampaignResult{},
+	}
+	require.NoError(t, db.PutProbeCampaign(active))
+	require.NoError(t, db.PutProbeCampaign(ended))
+
+	// The campaigns are returned in the order of their ids.
+	campaigns, err = db.FetchProbeCampaigns()
+	require.NoError(t, err)
+	require.Equal(t, []*ProbeCampaign{ended, active}, campaigns)
+
+	// Storing a campaign again replaces it.
+	active.ProbesSent = 3
+	require.NoError(t, db.PutProbeCampaign(active))
+
+	campaigns, err = db.FetchProbeCampaigns()
+	require.NoError(t, err)
+	require.Len(t, campaigns, 2)
+	require.EqualValues(t, 3, campaigns[1].ProbesSent)
+
+	// Campaigns that ended at or after the given time are kept.
+	deleted, err = db.DeleteProbeCampaigns(time.Unix(200, 0))
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+
+	// Active campaigns are never deleted.
+	deleted, err = db.DeleteProbeCampaigns(time.Unix(201, 0))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, deleted)
+
+	campaigns, err = db.FetchProbeCampaigns()
+	require.NoError(t, err)
+	require.Equal(t, []*ProbeCampaign{active}, campaigns)
+}
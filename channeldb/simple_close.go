@@ -0,0 +1,171 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// simpleCloseStateKey points to the state of a cooperative close that
+	// was negotiated with option_simple_close. It's stored along with the
+	// closing tx under coopCloseTxKey.
+	simpleCloseStateKey = []byte("simple-close-state-key")
+
+	// ErrNoSimpleCloseState is returned when no state of a cooperative
+	// close negotiated with option_simple_close is found for a channel.
+	ErrNoSimpleCloseState = fmt.Errorf("no simple close state found")
+)
+
+const (
+	// A set of tlv type definitions used to serialize the state of a
+	// cooperative close negotiated with option_simple_close.
+	simpleCloseLocalScriptType  tlv.Type = 0
+	simpleCloseRemoteScriptType tlv.Type = 1
+	simpleCloseLockTimeType     tlv.Type = 2
+	simpleCloseFeeType          tlv.Type = 3
+	simpleCloseFeeRateType      tlv.Type = 4
+)
+
+// SimpleCloseState is the state of a cooperative close that was negotiated
+// with option_simple_close. Along with the closing transaction, it allows
+// either party to propose a replacement of the closing transaction after the
+// connection was restarted.
+type SimpleCloseState struct {
+	// LocalDeliveryScript is the script that our output of the closing
+	// transaction pays to.
+	LocalDeliveryScript []byte
+
+	// RemoteDeliveryScript is the script that the output of the remote
+	// party pays to.
+	RemoteDeliveryScript []byte
+
+	// LockTime is the lock time of the closing transactions, which is the
+	// height at which the negotiation started.
+	LockTime uint32
+
+	// ClosingFee is the fee of the closing transaction that was broadcast
+	// last.
+	ClosingFee btcutil.Amount
+
+	// FeeRate is the fee rate of the closing transaction that we proposed
+	// last. It's zero if we didn't propose any.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// encode serializes the simple close state to the given writer.
+func (s *SimpleCloseState) encode(w io.Writer) error {
+	closingFee := uint64(s.ClosingFee)
+	feeRate := uint64(s.FeeRate)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			simpleCloseLocalScriptType, &s.LocalDeliveryScript,
+		),
+		tlv.MakePrimitiveRecord(
+			simpleCloseRemoteScriptType, &s.RemoteDeliveryScript,
+		),
+		tlv.MakePrimitiveRecord(simpleCloseLockTimeType, &s.LockTime),
+		tlv.MakePrimitiveRecord(simpleCloseFeeType, &closingFee),
+		tlv.MakePrimitiveRecord(simpleCloseFeeRateType, &feeRate),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// decode deserializes the simple close state from the given reader.
+func (s *SimpleCloseState) decode(r io.Reader) error {
+	var closingFee, feeRate uint64
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			simpleCloseLocalScriptType, &s.LocalDeliveryScript,
+		),
+		tlv.MakePrimitiveRecord(
+			simpleCloseRemoteScriptType, &s.RemoteDeliveryScript,
+		),
+		tlv.MakePrimitiveRecord(simpleCloseLockTimeType, &s.LockTime),
+		tlv.MakePrimitiveRecord(simpleCloseFeeType, &closingFee),
+		tlv.MakePrimitiveRecord(simpleCloseFeeRateType, &feeRate),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	s.ClosingFee = btcutil.Amount(closingFee)
+	s.FeeRate = chainfee.SatPerKWeight(feeRate)
+
+	return nil
+}
+
+// MarkSimpleCoopBroadcasted marks the channel to indicate that a cooperative
+// close transaction that was negotiated with option_simple_close has been
+// broadcast, just like MarkCoopBroadcasted. The passed state of the
+// negotiation is persisted along with the closing transaction, replacing the
+// one of a prior closing transaction.
+func (c *OpenChannel) MarkSimpleCoopBroadcasted(closeTx *wire.MsgTx,
+	locallyInitiated bool, state *SimpleCloseState) error {
+
+	var b bytes.Buffer
+	if err := state.encode(&b); err != nil {
+		return err
+	}
+
+	putState := func(chanBucket kvdb.RwBucket) error {
+		return chanBucket.Put(simpleCloseStateKey, b.Bytes())
+	}
+
+	return c.markBroadcasted(
+		ChanStatusCoopBroadcasted, coopCloseTxKey, closeTx,
+		locallyInitiated, putState,
+	)
+}
+
+// SimpleCloseState returns the state of the cooperative close that was stored
+// by MarkSimpleCoopBroadcasted. If the closing transaction wasn't negotiated
+// with option_simple_close, ErrNoSimpleCloseState is returned.
+func (c *OpenChannel) SimpleCloseState() (*SimpleCloseState, error) {
+	var state *SimpleCloseState
+
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoSimpleCloseState
+		default:
+			return err
+		}
+
+		stateBytes := chanBucket.Get(simpleCloseStateKey)
+		if stateBytes == nil {
+			return ErrNoSimpleCloseState
+		}
+
+		state = &SimpleCloseState{}
+
+		return state.decode(bytes.NewReader(stateBytes))
+	}, func() {
+		state = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
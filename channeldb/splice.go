@@ -0,0 +1,514 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// pendingSpliceKey stores the splice of a channel that has been
+	// signed but hasn't confirmed yet.
+	pendingSpliceKey = []byte("pending-splice-key")
+
+	// spliceRevocationLogBucket is a sub-bucket of the channel bucket that
+	// stores the revocation log of the remote commitments that spend the
+	// funding output of the pending splice. It becomes the revocation log
+	// of the channel once the splice is completed.
+	spliceRevocationLogBucket = []byte("splice-revocation-log")
+
+	// ErrNoPendingSplice is returned when the pending splice of a channel
+	// is requested, but the channel isn't being spliced.
+	ErrNoPendingSplice = fmt.Errorf("no pending splice found")
+)
+
+// ChannelSplice holds the state of a channel that replaces its current
+// funding output once the splice transaction confirms.
+type ChannelSplice struct {
+	// FundingOutpoint is the new funding output that is created by the
+	// splice transaction.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// FundingTxn is the splice transaction, which spends the current
+	// funding output of the channel.
+	FundingTxn *wire.MsgTx
+
+	// BroadcastHeight is the height at which the splice transaction was
+	// broadcast.
+	BroadcastHeight uint32
+
+	// LocalCommitment is our commitment that spends the new funding
+	// output, at the current commitment height.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the commitment of the remote party that spends
+	// the new funding output, at the current commitment height.
+	RemoteCommitment ChannelCommitment
+
+	// InputScripts are the pkScripts of the outputs that are spent by the
+	// splice transaction, in the order of its inputs. They're used to
+	// detect whether an input of the splice transaction is double spent,
+	// in which case the splice can never confirm.
+	InputScripts [][]byte
+
+	// NewChanType is the type of the channel after the splice if the
+	// splice upgrades the commitment type of the channel, which is the
+	// case if the new funding output is a taproot output. It's nil for
+	// splices that only change the capacity of the channel.
+	NewChanType *ChannelType
+}
+
+// IsUpgrade returns true if the splice upgrades the commitment type of the
+// channel.
+func (s *ChannelSplice) IsUpgrade() bool {
+	return s.NewChanType != nil
+}
+
+// SpliceOutputIndexes are the indexes of our and their output on a revoked
+// commitment of the remote party that spends the funding output of a pending
+// splice.
+type SpliceOutputIndexes struct {
+	// OurOutputIndex is the index of our output on the commitment.
+	OurOutputIndex uint32
+
+	// TheirOutputIndex is the index of the output of the remote party on
+	// the commitment.
+	TheirOutputIndex uint32
+}
+
+// serializeChannelSplice writes the splice to the passed writer.
+func serializeChannelSplice(w io.Writer, s *ChannelSplice) error {
+	err := WriteElements(
+		w, s.FundingOutpoint, s.Capacity, s.FundingTxn,
+		s.BroadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &s.LocalCommitment); err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &s.RemoteCommitment); err != nil {
+		return err
+	}
+
+	numScripts := uint16(len(s.InputScripts))
+	if err := WriteElement(w, numScripts); err != nil {
+		return err
+	}
+	for _, script := range s.InputScripts {
+		if err := WriteElement(w, script); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, s.NewChanType != nil); err != nil {
+		return err
+	}
+	if s.NewChanType != nil {
+		return WriteElement(w, *s.NewChanType)
+	}
+
+	return nil
+}
+
+// deserializeChannelSplice reads a splice that was written with
+// serializeChannelSplice.
+func deserializeChannelSplice(r io.Reader) (*ChannelSplice, error) {
+	s := &ChannelSplice{}
+	err := ReadElements(
+		r, &s.FundingOutpoint, &s.Capacity, &s.FundingTxn,
+		&s.BroadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.LocalCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RemoteCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Splices that were stored before the input scripts were added end
+	// here.
+	var numScripts uint16
+	err = ReadElement(r, &numScripts)
+	switch {
+	case errors.Is(err, io.EOF):
+		return s, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	s.InputScripts = make([][]byte, numScripts)
+	for i := range s.InputScripts {
+		if err := ReadElement(r, &s.InputScripts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// Splices that were stored before upgrade splices were added end
+	// here.
+	var isUpgrade bool
+	err = ReadElement(r, &isUpgrade)
+	switch {
+	case errors.Is(err, io.EOF):
+		return s, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	if isUpgrade {
+		var chanType ChannelType
+		if err := ReadElement(r, &chanType); err != nil {
+			return nil, err
+		}
+		s.NewChanType = &chanType
+	}
+
+	return s, nil
+}
+
+// MarkSplicePending stores the splice of the channel once the splice
+// transaction has been signed, and marks the channel with the
+// ChanStatusPendingSplice status. A previously stored splice is replaced.
+func (c *OpenChannel) MarkSplicePending(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	storeSplice := func(chanBucket kvdb.RwBucket) error {
+		return putSplice(chanBucket, splice)
+	}
+
+	return c.putChanStatus(ChanStatusPendingSplice, storeSplice)
+}
+
+// fetchSplice reads the pending splice from the channel bucket. It returns
+// nil if the channel isn't being spliced.
+func fetchSplice(chanBucket kvdb.RBucket) (*ChannelSplice, error) {
+	spliceBytes := chanBucket.Get(pendingSpliceKey)
+	if spliceBytes == nil {
+		return nil, nil
+	}
+
+	return deserializeChannelSplice(bytes.NewReader(spliceBytes))
+}
+
+// putSplice writes the pending splice to the channel bucket.
+func putSplice(chanBucket kvdb.RwBucket, splice *ChannelSplice) error {
+	var b bytes.Buffer
+	if err := serializeChannelSplice(&b, splice); err != nil {
+		return err
+	}
+
+	return chanBucket.Put(pendingSpliceKey, b.Bytes())
+}
+
+// putLocalSpliceCommitment replaces our commitment that spends the funding
+// output of the pending splice. The commitment must be set if, and only if,
+// the channel is being spliced.
+func putLocalSpliceCommitment(chanBucket kvdb.RwBucket,
+	commit *ChannelCommitment) error {
+
+	splice, err := fetchSplice(chanBucket)
+	switch {
+	case err != nil:
+		return err
+
+	case splice == nil && commit == nil:
+		return nil
+
+	case splice == nil:
+		return ErrNoPendingSplice
+
+	case commit == nil:
+		return errors.New("splice commitment missing for channel " +
+			"with pending splice")
+	}
+
+	splice.LocalCommitment = *commit
+
+	return putSplice(chanBucket, splice)
+}
+
+// advanceSpliceCommitChainTail adds the current remote commitment that spends
+// the funding output of the pending splice to the splice revocation log, and
+// replaces it with the passed commitment. It's a no-op if the channel isn't
+// being spliced.
+func advanceSpliceCommitChainTail(chanBucket kvdb.RwBucket,
+	newCommit *ChannelCommitment, indexes *SpliceOutputIndexes,
+	noAmtData bool) error {
+
+	splice, err := fetchSplice(chanBucket)
+	switch {
+	case err != nil:
+		return err
+
+	case splice == nil:
+		return nil
+
+	case newCommit == nil || indexes == nil:
+		return errors.New("splice commitment missing for channel " +
+			"with pending splice")
+	}
+
+	logBucket, err := chanBucket.CreateBucketIfNotExists(
+		spliceRevocationLogBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = putRevocationLog(
+		logBucket, &splice.RemoteCommitment, indexes.OurOutputIndex,
+		indexes.TheirOutputIndex, noAmtData,
+	)
+	if err != nil {
+		return err
+	}
+
+	splice.RemoteCommitment = *newCommit
+
+	return putSplice(chanBucket, splice)
+}
+
+// PendingSplice returns the pending splice of the channel. If the channel
+// isn't being spliced, ErrNoPendingSplice is returned.
+func (c *OpenChannel) PendingSplice() (*ChannelSplice, error) {
+	var splice *ChannelSplice
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoPendingSplice
+		default:
+			return err
+		}
+
+		splice, err = fetchSplice(chanBucket)
+		if err == nil && splice == nil {
+			return ErrNoPendingSplice
+		}
+
+		return err
+	}, func() {
+		splice = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// AbortSplice removes the pending splice of the channel and clears the
+// ChanStatusPendingSplice status. It must only be called if the splice
+// transaction can no longer confirm, or if the remote party never received
+// our signatures for it.
+func (c *OpenChannel) AbortSplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var status ChannelStatus
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		if err := chanBucket.Delete(pendingSpliceKey); err != nil {
+			return err
+		}
+
+		// The revoked commitments that spend the funding output of the
+		// splice can never confirm.
+		err = chanBucket.DeleteNestedBucket(spliceRevocationLogBucket)
+		if err != nil && !errors.Is(err, kvdb.ErrBucketNotFound) {
+			return err
+		}
+
+		status = channel.chanStatus & ^ChanStatusPendingSplice
+		channel.chanStatus = status
+
+		return putOpenChannel(chanBucket, channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.chanStatus = status
+
+	return nil
+}
+
+// CompleteSplice replaces the channel with the channel of its pending splice
+// once the splice transaction has confirmed. The state of the channel that
+// is bound to the spent funding output is deleted, and the channel is stored
+// again under the new funding outpoint. The new channel is pending until it
+// has been marked as open with the short channel id of the splice
+// transaction, in the same way as a newly funded channel.
+func (c *OpenChannel) CompleteSplice() (*OpenChannel, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	var newChan *OpenChannel
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chainBucket, err := fetchChainBucketRw(
+			tx, c.IdentityPub, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var chanPointBuf bytes.Buffer
+		err = writeOutpoint(&chanPointBuf, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+		chanKey := chanPointBuf.Bytes()
+
+		chanBucket := chainBucket.NestedReadWriteBucket(chanKey)
+		if chanBucket == nil {
+			return ErrChannelNotFound
+		}
+
+		chanState, err := fetchOpenChannel(
+			chanBucket, &c.FundingOutpoint,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice, err := fetchSplice(chanBucket)
+		switch {
+		case err != nil:
+			return err
+
+		case splice == nil:
+			return ErrNoPendingSplice
+		}
+
+		// The revoked remote commitments that spend the new funding
+		// output become the revocation log of the new channel.
+		revLog, err := fetchSpliceRevocationLog(chanBucket)
+		if err != nil {
+			return err
+		}
+
+		// The state of the spliced channel is no longer needed, as
+		// none of its commitments can confirm anymore.
+		err = deleteChannelState(tx, chainBucket, chanKey, chanState)
+		if err != nil {
+			return err
+		}
+
+		// The channel keeps its configuration and revocation state,
+		// but continues with the commitments that spend the new
+		// funding output.
+		chanState.Db = c.Db
+		chanState.FundingOutpoint = splice.FundingOutpoint
+		chanState.Capacity = splice.Capacity
+		chanState.FundingTxn = splice.FundingTxn
+		chanState.FundingBroadcastHeight = splice.BroadcastHeight
+		chanState.LocalCommitment = splice.LocalCommitment
+		chanState.RemoteCommitment = splice.RemoteCommitment
+		chanState.IsPending = true
+		chanState.ShortChannelID = lnwire.ShortChannelID{}
+		chanState.confirmedScid = lnwire.ShortChannelID{}
+		chanState.RemoteNextRevocation = nil
+		chanState.chanStatus = ChanStatusDefault
+		chanState.Packager = NewChannelPackager(
+			chanState.ShortChannelID,
+		)
+
+		// An upgrade splice moves the channel to a funding output of
+		// the new channel type, whose commitments it uses from now on.
+		if splice.IsUpgrade() {
+			chanState.ChanType = *splice.NewChanType
+		}
+
+		if err := chanState.fullSync(tx); err != nil {
+			return err
+		}
+
+		newChanBucket, err := fetchChanBucketRw(
+			tx, chanState.IdentityPub, &chanState.FundingOutpoint,
+			chanState.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+		logBucket, err := newChanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+		for _, entry := range revLog {
+			if err := logBucket.Put(entry[0], entry[1]); err != nil {
+				return err
+			}
+		}
+
+		newChan = chanState
+
+		return nil
+	}, func() {
+		newChan = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newChan, nil
+}
+
+// fetchSpliceRevocationLog returns copies of the keys and values of the
+// entries of the splice revocation log of the channel.
+func fetchSpliceRevocationLog(chanBucket kvdb.RBucket) ([][2][]byte, error) {
+	logBucket := chanBucket.NestedReadBucket(spliceRevocationLogBucket)
+	if logBucket == nil {
+		return nil, nil
+	}
+
+	var entries [][2][]byte
+	err := logBucket.ForEach(func(k, v []byte) error {
+		entries = append(entries, [2][]byte{
+			append([]byte(nil), k...), append([]byte(nil), v...),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,247 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSplice returns a splice of the passed channel that adds 100k
+// satoshis to our balance.
+func newTestSplice(channel *OpenChannel) *ChannelSplice {
+	fundingTx := wire.NewMsgTx(2)
+	fundingTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: channel.FundingOutpoint,
+		SignatureScript:  []byte{},
+	})
+	fundingTx.AddTxOut(wire.NewTxOut(200_000, []byte{0x00, 0x20}))
+
+	localCommit := channel.LocalCommitment
+	localCommit.LocalBalance += 100_000_000
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.LocalBalance += 100_000_000
+
+	return &ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  fundingTx.TxHash(),
+			Index: 0,
+		},
+		Capacity:         channel.Capacity + btcutil.Amount(100_000),
+		FundingTxn:       fundingTx,
+		BroadcastHeight:  200,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+		InputScripts: [][]byte{
+			{0x00, 0x20}, {0x51, 0x20},
+		},
+	}
+}
+
+// TestChannelSplice tests that a pending splice can be stored, aborted and
+// completed, and that completing it moves the channel to the new funding
+// outpoint.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	_, err = channel.PendingSplice()
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	splice := newTestSplice(channel)
+
+	// Storing the splice marks the channel, both in memory and on disk.
+	require.NoError(t, channel.MarkSplicePending(splice))
+	require.True(t, channel.HasChanStatus(ChanStatusPendingSplice))
+
+	dbSplice, err := channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, splice, dbSplice)
+
+	dbChan, err := cdb.FetchChannel(nil, channel.FundingOutpoint)
+	require.NoError(t, err)
+	require.True(t, dbChan.HasChanStatus(ChanStatusPendingSplice))
+
+	// Aborting the splice removes it again.
+	require.NoError(t, channel.AbortSplice())
+	require.False(t, channel.HasChanStatus(ChanStatusPendingSplice))
+
+	_, err = channel.PendingSplice()
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	_, err = channel.CompleteSplice()
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	// Once the splice is completed, the channel is pending under the new
+	// funding outpoint and the old one is gone.
+	require.NoError(t, channel.MarkSplicePending(splice))
+	newChan, err := channel.CompleteSplice()
+	require.NoError(t, err)
+
+	_, err = cdb.FetchChannel(nil, channel.FundingOutpoint)
+	require.ErrorIs(t, err, ErrChannelNotFound)
+
+	dbChan, err = cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.True(t, dbChan.IsPending)
+	require.Equal(t, ChanStatusDefault, dbChan.ChanStatus())
+	require.Equal(t, splice.Capacity, dbChan.Capacity)
+	require.Equal(t, splice.LocalCommitment, dbChan.LocalCommitment)
+	require.Equal(t, splice.RemoteCommitment, dbChan.RemoteCommitment)
+	require.Equal(t, channel.IdentityPub, dbChan.IdentityPub)
+	require.Equal(t, channel.RevocationProducer, dbChan.RevocationProducer)
+
+	require.Equal(t, splice.FundingOutpoint, newChan.FundingOutpoint)
+	require.True(t, newChan.IsPending)
+}
+
+// TestChannelSpliceCommitments tests that the commitments that spend the
+// funding output of a pending splice are updated along with the commitments
+// of the channel, and that the revoked remote ones become the revocation log
+// of the channel once the splice is completed.
+func TestChannelSpliceCommitments(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+	splice := newTestSplice(channel)
+
+	localCommit := channel.LocalCommitment
+	localCommit.CommitHeight++
+	spliceLocalCommit := splice.LocalCommitment
+	spliceLocalCommit.CommitHeight++
+
+	// A splice commitment can't be stored without a pending splice.
+	_, err = channel.UpdateCommitment(&localCommit, nil, &spliceLocalCommit)
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	require.NoError(t, channel.MarkSplicePending(splice))
+
+	// Once the channel is being spliced, the splice commitment must be
+	// updated along with our commitment.
+	_, err = channel.UpdateCommitment(&localCommit, nil, nil)
+	require.Error(t, err)
+
+	_, err = channel.UpdateCommitment(&localCommit, nil, &spliceLocalCommit)
+	require.NoError(t, err)
+
+	dbSplice, err := channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, spliceLocalCommit, dbSplice.LocalCommitment)
+
+	// The next remote commitment is extended together with the remote
+	// splice commitment.
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.CommitHeight++
+	spliceRemoteCommit := splice.RemoteCommitment
+	spliceRemoteCommit.CommitHeight++
+	spliceRemoteCommit.CommitTx = spliceRemoteCommit.CommitTx.Copy()
+	spliceRemoteCommit.CommitTx.LockTime++
+
+	commitDiff := &CommitDiff{
+		Commitment: remoteCommit,
+		CommitSig: &lnwire.CommitSig{
+			ChanID: lnwire.NewChanIDFromOutPoint(
+				&channel.FundingOutpoint,
+			),
+			CommitSig: wireSig,
+			SpliceSig: &lnwire.SpliceSig{
+				CommitSig: wireSig,
+			},
+			ExtraData: make([]byte, 0),
+		},
+		LogUpdates:        []LogUpdate{},
+		OpenedCircuitKeys: []models.CircuitKey{},
+		ClosedCircuitKeys: []models.CircuitKey{},
+		SpliceCommitment:  &spliceRemoteCommit,
+	}
+	require.NoError(t, channel.AppendRemoteCommitChain(commitDiff))
+
+	diskCommitDiff, err := channel.RemoteCommitChainTip()
+	require.NoError(t, err)
+	require.Equal(t, commitDiff, diskCommitDiff)
+
+	// Revoking the remote commitment requires the output indexes of the
+	// revoked splice commitment.
+	fwdPkg := NewFwdPkg(
+		channel.ShortChanID(), remoteCommit.CommitHeight, nil, nil,
+	)
+	err = channel.AdvanceCommitChainTail(
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex, nil,
+	)
+	require.Error(t, err)
+
+	err = channel.AdvanceCommitChainTail(
+		fwdPkg, nil, dummyLocalOutputIndex, dummyRemoteOutIndex,
+		&SpliceOutputIndexes{
+			OurOutputIndex:   dummyRemoteOutIndex,
+			TheirOutputIndex: dummyLocalOutputIndex,
+		},
+	)
+	require.NoError(t, err)
+
+	dbSplice, err = channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, spliceRemoteCommit, dbSplice.RemoteCommitment)
+
+	// Once the splice is completed, the revoked splice commitment is part
+	// of the revocation log of the new channel.
+	newChan, err := channel.CompleteSplice()
+	require.NoError(t, err)
+
+	revLog, _, err := newChan.FindPreviousState(
+		splice.RemoteCommitment.CommitHeight,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, splice.RemoteCommitment.CommitTx.TxHash(),
+		chainhash.Hash(revLog.CommitTxHash),
+	)
+	require.EqualValues(t, dummyRemoteOutIndex, revLog.OurOutputIndex)
+	require.EqualValues(t, dummyLocalOutputIndex, revLog.TheirOutputIndex)
+}
+
+// TestChannelSpliceUpgrade tests that the channel type of an upgrade splice is
+// stored with the splice, and that completing the splice upgrades the
+// channel.
+func TestChannelSpliceUpgrade(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	newChanType := channel.ChanType | SingleFunderTweaklessBit |
+		AnchorOutputsBit | ZeroHtlcTxFeeBit | SimpleTaprootFeatureBit
+	splice := newTestSplice(channel)
+	splice.NewChanType = &newChanType
+	require.True(t, splice.IsUpgrade())
+
+	require.NoError(t, channel.MarkSplicePending(splice))
+
+	dbSplice, err := channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, splice, dbSplice)
+
+	_, err = channel.CompleteSplice()
+	require.NoError(t, err)
+
+	dbChan, err := cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.Equal(t, newChanType, dbChan.ChanType)
+	require.True(t, dbChan.ChanType.IsTaproot())
+}
@@ -0,0 +1,562 @@
+package closereport
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// ErrChannelNotFound is returned when the report of a channel is requested
+// that isn't closed.
+var ErrChannelNotFound = errors.New("closed channel not found")
+
+// TxType is the type of a transaction that was published to close a channel or
+// to resolve its outputs.
+type TxType uint8
+
+const (
+	// TxTypeClosing is the cooperative close tx or the commitment that
+	// closed the channel.
+	TxTypeClosing TxType = iota
+
+	// TxTypeSecondLevel is a htlc success or timeout tx that spent a htlc
+	// output of our commitment.
+	TxTypeSecondLevel
+
+	// TxTypeSweep is a tx that swept outputs of the channel to our wallet.
+	TxTypeSweep
+
+	// TxTypeAnchorSweep is a tx that swept our anchor output to bump the
+	// fee of the commitment.
+	TxTypeAnchorSweep
+)
+
+// String returns a human-readable name of the tx type.
+func (t TxType) String() string {
+	switch t {
+	case TxTypeClosing:
+		return "closing"
+
+	case TxTypeSecondLevel:
+		return "second_level"
+
+	case TxTypeSweep:
+		return "sweep"
+
+	case TxTypeAnchorSweep:
+		return "anchor_sweep"
+
+	default:
+		return "unknown"
+	}
+}
+
+// TxReport is a transaction that was published to close a channel or to
+// resolve its outputs, along with the fee it paid.
+type TxReport struct {
+	// TxHash is the hash of the transaction.
+	TxHash chainhash.Hash
+
+	// Type is the type of the transaction.
+	Type TxType
+
+	// Fee is the part of the fee of the transaction that we paid for the
+	// channel. Sweeps can spend outputs of several channels, in which case
+	// their fee is split by the weight of the inputs of each channel.
+	Fee btcutil.Amount
+
+	// TotalFee is the fee of the whole transaction.
+	TotalFee btcutil.Amount
+
+	// ConfirmHeight is the height at which the transaction confirmed. It's
+	// zero if the height isn't known.
+	ConfirmHeight int32
+
+	// Label is the label of the transaction in our wallet, if any.
+	Label string
+}
+
+// ChannelReport is the breakdown of the on-chain fees paid and the funds
+// recovered when a channel was closed.
+type ChannelReport struct {
+	// Summary is the close summary of the channel.
+	Summary *channeldb.ChannelCloseSummary
+
+	// LocalOpener is true if we opened the channel, and therefore paid the
+	// fee of the closing transaction.
+	LocalOpener bool
+
+	// Resolutions are the outcomes of the outputs of the closing
+	// transaction that had to be resolved on chain.
+	Resolutions []*channeldb.ResolverReport
+
+	// Txs are the transactions that closed the channel and resolved its
+	// outputs.
+	Txs []*TxReport
+
+	// ClosingFee is the fee of the closing transaction. It's zero if it
+	// isn't known.
+	ClosingFee btcutil.Amount
+
+	// SecondLevelFees are the fees that we paid for htlc success and
+	// timeout transactions.
+	SecondLevelFees btcutil.Amount
+
+	// SweepFees are the fees that we paid to sweep the outputs of the
+	// channel to our wallet.
+	SweepFees btcutil.Amount
+
+	// AnchorFees are the fees that we paid to sweep our anchor output to
+	// bump the fee of the commitment.
+	AnchorFees btcutil.Amount
+
+	// Recovered is the amount that was paid to our wallet by the closing
+	// transaction, or claimed on chain afterwards.
+	Recovered btcutil.Amount
+}
+
+// TotalFees returns the on-chain fees that we paid to close the channel and to
+// resolve its outputs.
+func (c *ChannelReport) TotalFees() btcutil.Amount {
+	fees := c.SecondLevelFees + c.SweepFees + c.AnchorFees
+	if c.LocalOpener {
+		fees += c.ClosingFee
+	}
+
+	return fees
+}
+
+// Config contains the dependencies of the Reporter.
+type Config struct {
+	// ChainHash is the hash of the chain that our channels are on.
+	ChainHash chainhash.Hash
+
+	// FetchClosedChannels returns the close summaries of our closed
+	// channels.
+	FetchClosedChannels func(pendingOnly bool) (
+		[]*channeldb.ChannelCloseSummary, error)
+
+	// FetchHistoricalChannel returns the last state of a closed channel.
+	FetchHistoricalChannel func(outPoint *wire.OutPoint) (
+		*channeldb.OpenChannel, error)
+
+	// FetchChannelReports returns the reports of the resolvers of a
+	// closed channel.
+	FetchChannelReports func(chainHash chainhash.Hash,
+		outPoint *wire.OutPoint) ([]*channeldb.ResolverReport, error)
+
+	// ListSweepRecords returns the records of all publish attempts of the
+	// sweeper.
+	ListSweepRecords func() ([]*sweep.SweepRecord, error)
+
+	// ListTransactions returns all the transactions of our wallet.
+	ListTransactions func() ([]*lnwallet.TransactionDetail, error)
+}
+
+// Reporter builds the reports of our closed channels from their close
+// summaries, the outcomes of their resolvers, the records of the sweeper and
+// the transactions of our wallet.
+type Reporter struct {
+	cfg *Config
+}
+
+// NewReporter creates a new reporter.
+func NewReporter(cfg *Config) *Reporter {
+	return &Reporter{
+		cfg: cfg,
+	}
+}
+
+// ChannelReports returns the reports of our closed channels, ordered by their
+// close height. If a channel point is given, only the report of that channel
+// is returned. Channels whose close is still pending are skipped.
+func (r *Reporter) ChannelReports(chanPoint *wire.OutPoint) ([]*ChannelReport,
+	error) {
+
+	summaries, err := r.cfg.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CloseHeight < summaries[j].CloseHeight
+	})
+
+	sweeps, err := r.cfg.ListSweepRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := r.cfg.ListTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	walletTxs := make(map[chainhash.Hash]*lnwallet.TransactionDetail)
+	for _, tx := range txs {
+		walletTxs[tx.Hash] = tx
+	}
+
+	var reports []*ChannelReport
+	for _, summary := range summaries {
+		if summary.IsPending {
+			continue
+		}
+
+		if chanPoint != nil && summary.ChanPoint != *chanPoint {
+			continue
+		}
+
+		report, err := r.channelReport(summary, sweeps, walletTxs)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	if chanPoint != nil && len(reports) == 0 {
+		return nil, ErrChannelNotFound
+	}
+
+	return reports, nil
+}
+
+// channelReport builds the report of a single closed channel.
+func (r *Reporter) channelReport(summary *channeldb.ChannelCloseSummary,
+	sweeps []*sweep.SweepRecord,
+	walletTxs map[chainhash.Hash]*lnwallet.TransactionDetail) (
+	*ChannelReport, error) {
+
+	report := &ChannelReport{
+		Summary: summary,
+	}
+
+	// The last state of the channel isn't available for channels that
+	// were closed by older versions of lnd.
+	histChan, err := r.cfg.FetchHistoricalChannel(&summary.ChanPoint)
+	switch {
+	case errors.Is(err, channeldb.ErrNoHistoricalBucket),
+		errors.Is(err, channeldb.ErrChannelNotFound):
+
+		histChan = nil
+
+	case err != nil:
+		return nil, err
+
+	default:
+		report.LocalOpener = histChan.IsInitiator
+	}
+
+	// Channels without any outputs to resolve don't have resolver
+	// reports.
+	resolutions, err := r.cfg.FetchChannelReports(
+		r.cfg.ChainHash, &summary.ChanPoint,
+	)
+	switch {
+	case errors.Is(err, channeldb.ErrNoChainHashBucket),
+		errors.Is(err, channeldb.ErrNoChannelSummaries):
+
+	case err != nil:
+		return nil, err
+
+	default:
+		report.Resolutions = resolutions
+	}
+
+	report.addClosingTx(histChan, walletTxs)
+	report.addSweeps(sweeps, walletTxs)
+	report.addSecondLevelTxs(walletTxs)
+	report.addRecovered()
+
+	return report, nil
+}
+
+// addClosingTx adds the closing transaction of the channel to the report. Its
+// fee is the part of the capacity that it doesn't pay out, if our wallet knows
+// the transaction. Otherwise the fee of the commitment that was broadcast is
+// used.
+func (c *ChannelReport) addClosingTx(histChan *channeldb.OpenChannel,
+	walletTxs map[chainhash.Hash]*lnwallet.TransactionDetail) {
+
+	summary := c.Summary
+
+	// Channels whose funding tx never confirmed don't have a closing
+	// transaction.
+	switch summary.CloseType {
+	case channeldb.FundingCanceled, channeldb.Abandoned:
+		return
+	}
+
+	tx := &TxReport{
+		TxHash:        summary.ClosingTXID,
+		Type:          TxTypeClosing,
+		ConfirmHeight: int32(summary.CloseHeight),
+	}
+
+	feeKnown := false
+	if walletTx, ok := walletTxs[summary.ClosingTXID]; ok {
+		tx.Label = walletTx.Label
+
+		paidOut, err := outputValue(walletTx.RawTx)
+		if err == nil {
+			tx.TotalFee = summary.Capacity - paidOut
+			feeKnown = true
+		}
+	}
+
+	if !feeKnown && histChan != nil {
+		switch summary.CloseType {
+		case channeldb.LocalForceClose:
+			tx.TotalFee = histChan.LocalCommitment.CommitFee
+
+		case channeldb.RemoteForceClose:
+			tx.TotalFee = histChan.RemoteCommitment.CommitFee
+		}
+	}
+
+	if c.LocalOpener {
+		tx.Fee = tx.TotalFee
+	}
+
+	c.ClosingFee = tx.TotalFee
+	c.Txs = append(c.Txs, tx)
+}
+
+// addSweeps adds the confirmed sweeps that spent outputs of the channel to the
+// report. Sweeps that were replaced or haven't confirmed yet didn't pay a fee.
+func (c *ChannelReport) addSweeps(sweeps []*sweep.SweepRecord,
+	walletTxs map[chainhash.Hash]*lnwallet.TransactionDetail) {
+
+	// The second-level transactions are the ones that spent the first
+	// stage of a htlc.
+	secondLevel := make(map[chainhash.Hash]struct{})
+	for _, res := range c.Resolutions {
+		if res.ResolverOutcome == channeldb.ResolverOutcomeFirstStage &&
+			res.SpendTxID != nil {
+
+			secondLevel[*res.SpendTxID] = struct{}{}
+		}
+	}
+
+	for _, record := range sweeps {
+		if record.ConfirmHeight == 0 {
+			continue
+		}
+
+		// The fee is split between the channels by the weight of their
+		// inputs. Wallet inputs only pay for the inputs of the
+		// channels, so they're left out.
+		var (
+			chanWeight, totalWeight int64
+			anchor                  bool
+		)
+		for _, inp := range record.Inputs {
+			if inp.ChanPoint == nil {
+				continue
+			}
+
+			weight := inputWeight(inp)
+			totalWeight += weight
+
+			if *inp.ChanPoint != c.Summary.ChanPoint {
+				continue
+			}
+
+			chanWeight += weight
+
+			switch inp.WitnessType {
+			case input.CommitmentAnchor,
+				input.TaprootAnchorSweepSpend:
+
+				anchor = true
+			}
+		}
+
+		if chanWeight == 0 {
+			continue
+		}
+
+		fee := record.Fee * btcutil.Amount(chanWeight) /
+			btcutil.Amount(totalWeight)
+
+		tx := &TxReport{
+			TxHash:        record.TxHash,
+			Type:          TxTypeSweep,
+			Fee:           fee,
+			TotalFee:      record.Fee,
+			ConfirmHeight: record.ConfirmHeight,
+		}
+		if walletTx, ok := walletTxs[record.TxHash]; ok {
+			tx.Label = walletTx.Label
+		}
+
+		_, isSecondLevel := secondLevel[record.TxHash]
+		switch {
+		case isSecondLevel:
+			tx.Type = TxTypeSecondLevel
+			c.SecondLevelFees += fee
+
+		case anchor:
+			tx.Type = TxTypeAnchorSweep
+			c.AnchorFees += fee
+
+		default:
+			c.SweepFees += fee
+		}
+
+		c.Txs = append(c.Txs, tx)
+	}
+}
+
+// addSecondLevelTxs adds the second-level transactions to the report that
+// weren't published by the sweeper. These pay their fee out of the htlc
+// amount, so their fee is the difference between the htlcs they spent and the
+// outputs they created. It's only known once all these outputs were resolved
+// too.
+func (c *ChannelReport) addSecondLevelTxs(
+	walletTxs map[chainhash.Hash]*lnwallet.TransactionDetail) {
+
+	known := make(map[chainhash.Hash]struct{})
+	for _, tx := range c.Txs {
+		known[tx.TxHash] = struct{}{}
+	}
+
+	type stages struct {
+		spent, created       btcutil.Amount
+		numSpent, numCreated int
+	}
+
+	var (
+		txStages = make(map[chainhash.Hash]*stages)
+		txHashes []chainhash.Hash
+	)
+	for _, res := range c.Resolutions {
+		if res.ResolverOutcome != channeldb.ResolverOutcomeFirstStage ||
+			res.SpendTxID == nil {
+
+			continue
+		}
+
+		hash := *res.SpendTxID
+		if _, ok := known[hash]; ok {
+			continue
+		}
+
+		s, ok := txStages[hash]
+		if !ok {
+			s = &stages{}
+			txStages[hash] = s
+			txHashes = append(txHashes, hash)
+		}
+
+		s.spent += res.Amount
+		s.numSpent++
+	}
+
+	for _, res := range c.Resolutions {
+		if res.ResolverOutcome == channeldb.ResolverOutcomeFirstStage {
+			continue
+		}
+
+		s, ok := txStages[res.OutPoint.Hash]
+		if !ok {
+			continue
+		}
+
+		s.created += res.Amount
+		s.numCreated++
+	}
+
+	for _, hash := range txHashes {
+		s := txStages[hash]
+		if s.numCreated != s.numSpent {
+			continue
+		}
+
+		fee := s.spent - s.created
+
+		tx := &TxReport{
+			TxHash:   hash,
+			Type:     TxTypeSecondLevel,
+			Fee:      fee,
+			TotalFee: fee,
+		}
+		if walletTx, ok := walletTxs[hash]; ok {
+			tx.Label = walletTx.Label
+			tx.ConfirmHeight = walletTx.BlockHeight
+		}
+
+		c.SecondLevelFees += fee
+		c.Txs = append(c.Txs, tx)
+	}
+}
+
+// addRecovered adds up the amounts that were recovered to our wallet.
+func (c *ChannelReport) addRecovered() {
+	commitResolved := false
+	for _, res := range c.Resolutions {
+		if res.ResolverType == channeldb.ResolverTypeCommit {
+			commitResolved = true
+		}
+
+		switch {
+		// Outgoing htlcs that were claimed were claimed by the remote
+		// party with the preimage.
+		case res.ResolverOutcome == channeldb.ResolverOutcomeClaimed &&
+			res.ResolverType != channeldb.ResolverTypeOutgoingHtlc:
+
+			c.Recovered += res.Amount
+
+		// Incoming htlcs that timed out were timed out by the remote
+		// party.
+		case res.ResolverOutcome == channeldb.ResolverOutcomeTimeout &&
+			res.ResolverType == channeldb.ResolverTypeOutgoingHtlc:
+
+			c.Recovered += res.Amount
+		}
+	}
+
+	// Unless our commitment output had to be resolved, our balance was
+	// paid to our wallet by the closing transaction directly.
+	if !commitResolved {
+		c.Recovered += c.Summary.SettledBalance
+	}
+}
+
+// inputWeight returns the estimated weight of an input of a sweep.
+func inputWeight(inp sweep.SweepInputRecord) int64 {
+	weight := int64(input.InputSize * blockchain.WitnessScaleFactor)
+
+	witnessSize, _, err := inp.WitnessType.SizeUpperBound()
+	if err != nil {
+		return weight
+	}
+
+	return weight + int64(witnessSize)
+}
+
+// outputValue returns the total value of the outputs of a serialized
+// transaction.
+func outputValue(rawTx []byte) (btcutil.Amount, error) {
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return 0, err
+	}
+
+	var total btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		total += btcutil.Amount(txOut.Value)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,288 @@
+package closereport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	forceCloseChan = wire.OutPoint{Hash: chainhash.Hash{1}}
+	coopCloseChan  = wire.OutPoint{Hash: chainhash.Hash{2}}
+	pendingChan    = wire.OutPoint{Hash: chainhash.Hash{3}}
+	otherChan      = wire.OutPoint{Hash: chainhash.Hash{4}}
+
+	closingTxHash     = chainhash.Hash{10}
+	coopClosingTxHash = chainhash.Hash{11}
+	secondLevelTxHash = chainhash.Hash{12}
+	sweepTxHash       = chainhash.Hash{13}
+	anchorTxHash      = chainhash.Hash{14}
+	replacedTxHash    = chainhash.Hash{15}
+	incomingTxHash    = chainhash.Hash{16}
+)
+
+// newTestReporter creates a reporter for a local force close with anchors and
+// a legacy second-level htlc tx, a cooperative close and a pending close.
+func newTestReporter(t *testing.T) *Reporter {
+	t.Helper()
+
+	// The closing tx of the force close pays out all but 1000 sat of the
+	// capacity.
+	closingTx := wire.NewMsgTx(2)
+	closingTx.AddTxIn(&wire.TxIn{PreviousOutPoint: forceCloseChan})
+	closingTx.AddTxOut(&wire.TxOut{Value: 60_000})
+	closingTx.AddTxOut(&wire.TxOut{Value: 39_000})
+
+	var rawTx bytes.Buffer
+	require.NoError(t, closingTx.Serialize(&rawTx))
+
+	summaries := []*channeldb.ChannelCloseSummary{
+		{
+			ChanPoint:      coopCloseChan,
+			ClosingTXID:    coopClosingTxHash,
+			Capacity:       50_000,
+			CloseHeight:    200,
+			SettledBalance: 20_000,
+			CloseType:      channeldb.CooperativeClose,
+		},
+		{
+			ChanPoint:      forceCloseChan,
+			ClosingTXID:    closingTxHash,
+			Capacity:       100_000,
+			CloseHeight:    100,
+			SettledBalance: 50_000,
+			CloseType:      channeldb.LocalForceClose,
+		},
+		{
+			ChanPoint: pendingChan,
+			CloseType: channeldb.LocalForceClose,
+			IsPending: true,
+		},
+	}
+
+	resolutions := []*channeldb.ResolverReport{
+		{
+			OutPoint:        wire.OutPoint{Hash: closingTxHash},
+			Amount:          330,
+			ResolverType:    channeldb.ResolverTypeAnchor,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &anchorTxHash,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash:  closingTxHash,
+				Index: 1,
+			},
+			Amount:          50_000,
+			ResolverType:    channeldb.ResolverTypeCommit,
+			ResolverOutcome: channeldb.ResolverOutcomeClaimed,
+			SpendTxID:       &sweepTxHash,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash:  closingTxHash,
+				Index: 2,
+			},
+			Amount:          10_000,
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeFirstStage,
+			SpendTxID:       &secondLevelTxHash,
+		},
+		{
+			OutPoint:        wire.OutPoint{Hash: secondLevelTxHash},
+			Amount:          9_000,
+			ResolverType:    channeldb.ResolverTypeOutgoingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeTimeout,
+			SpendTxID:       &sweepTxHash,
+		},
+		{
+			OutPoint: wire.OutPoint{
+				Hash:  closingTxHash,
+				Index: 3,
+			},
+			Amount:          2_000,
+			ResolverType:    channeldb.ResolverTypeIncomingHtlc,
+			ResolverOutcome: channeldb.ResolverOutcomeTimeout,
+			SpendTxID:       &incomingTxHash,
+		},
+	}
+
+	chanPoint := forceCloseChan
+	other := otherChan
+	sweeps := []*sweep.SweepRecord{
+		// The sweep of the commitment output and the second-level
+		// output also swept an output of another channel, and was
+		// funded by a wallet input. It replaced an earlier attempt.
+		{
+			TxHash: replacedTxHash,
+			Inputs: []sweep.SweepInputRecord{{
+				WitnessType: input.CommitmentTimeLock,
+				ChanPoint:   &chanPoint,
+			}},
+			Fee: 1_000,
+		},
+		{
+			TxHash: sweepTxHash,
+			Inputs: []sweep.SweepInputRecord{
+				{
+					WitnessType: input.CommitmentTimeLock,
+					ChanPoint:   &chanPoint,
+				},
+				{
+					WitnessType: input.CommitmentTimeLock,
+					ChanPoint:   &chanPoint,
+				},
+				{
+					WitnessType: input.CommitmentTimeLock,
+					ChanPoint:   &other,
+				},
+				{
+					WitnessType: input.WitnessKeyHash,
+				},
+			},
+			Fee:           3_000,
+			ConfirmHeight: 250,
+		},
+		{
+			TxHash: anchorTxHash,
+			Inputs: []sweep.SweepInputRecord{
+				{
+					WitnessType: input.CommitmentAnchor,
+					ChanPoint:   &chanPoint,
+				},
+				{
+					WitnessType: input.WitnessKeyHash,
+				},
+			},
+			Fee:           500,
+			ConfirmHeight: 101,
+		},
+	}
+
+	return NewReporter(&Config{
+		FetchClosedChannels: func(bool) (
+			[]*channeldb.ChannelCloseSummary, error) {
+
+			return summaries, nil
+		},
+		FetchHistoricalChannel: func(op *wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			if *op == forceCloseChan {
+				return &channeldb.OpenChannel{
+					IsInitiator: true,
+				}, nil
+			}
+
+			return nil, channeldb.ErrChannelNotFound
+		},
+		FetchChannelReports: func(_ chainhash.Hash, op *wire.OutPoint) (
+			[]*channeldb.ResolverReport, error) {
+
+			if *op == forceCloseChan {
+				return resolutions, nil
+			}
+
+			return nil, channeldb.ErrNoChannelSummaries
+		},
+		ListSweepRecords: func() ([]*sweep.SweepRecord, error) {
+			return sweeps, nil
+		},
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return []*lnwallet.TransactionDetail{
+				{
+					Hash:  closingTxHash,
+					RawTx: rawTx.Bytes(),
+					Label: "closechannel",
+				},
+				{
+					Hash:  sweepTxHash,
+					Label: "sweep",
+				},
+			}, nil
+		},
+	})
+}
+
+// TestChannelReports tests the fees and recovered amounts of the reports of
+// closed channels.
+func TestChannelReports(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporter(t)
+
+	reports, err := reporter.ChannelReports(nil)
+	require.NoError(t, err)
+
+	// The pending close is skipped, and the reports are ordered by close
+	// height.
+	require.Len(t, reports, 2)
+	forceClose, coopClose := reports[0], reports[1]
+	require.Equal(t, forceCloseChan, forceClose.Summary.ChanPoint)
+	require.Equal(t, coopCloseChan, coopClose.Summary.ChanPoint)
+
+	// We opened the force closed channel, so we paid the closing fee.
+	require.True(t, forceClose.LocalOpener)
+	require.Equal(t, btcutil.Amount(1_000), forceClose.ClosingFee)
+
+	// The fee of the legacy second-level tx is paid from the htlc amount.
+	require.Equal(t, btcutil.Amount(1_000), forceClose.SecondLevelFees)
+
+	// Two thirds of the channel inputs of the confirmed sweep belong to
+	// the channel.
+	require.Equal(t, btcutil.Amount(2_000), forceClose.SweepFees)
+	require.Equal(t, btcutil.Amount(500), forceClose.AnchorFees)
+	require.Equal(t, btcutil.Amount(4_500), forceClose.TotalFees())
+
+	// The incoming htlc that timed out wasn't recovered.
+	require.Equal(t, btcutil.Amount(59_330), forceClose.Recovered)
+
+	txTypes := make(map[chainhash.Hash]TxType)
+	for _, tx := range forceClose.Txs {
+		txTypes[tx.TxHash] = tx.Type
+	}
+	require.Equal(t, map[chainhash.Hash]TxType{
+		closingTxHash:     TxTypeClosing,
+		sweepTxHash:       TxTypeSweep,
+		anchorTxHash:      TxTypeAnchorSweep,
+		secondLevelTxHash: TxTypeSecondLevel,
+	}, txTypes)
+
+	require.Equal(t, "closechannel", forceClose.Txs[0].Label)
+
+	// The fee of the cooperative close isn't known, and our balance was
+	// paid out by the closing tx.
+	require.False(t, coopClose.LocalOpener)
+	require.Zero(t, coopClose.TotalFees())
+	require.Equal(t, btcutil.Amount(20_000), coopClose.Recovered)
+	require.Len(t, coopClose.Txs, 1)
+}
+
+// TestChannelReportsFilter tests that the report of a single channel can be
+// requested.
+func TestChannelReportsFilter(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporter(t)
+
+	reports, err := reporter.ChannelReports(&coopCloseChan)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, coopCloseChan, reports[0].Summary.ChanPoint)
+
+	_, err = reporter.ChannelReports(&pendingChan)
+	require.ErrorIs(t, err, ErrChannelNotFound)
+
+	_, err = reporter.ChannelReports(&otherChan)
+	require.ErrorIs(t, err, ErrChannelNotFound)
+}
@@ -74,6 +74,29 @@ var addInvoiceCommand = cli.Command{
 			Usage: "creates an AMP invoice. If true, preimage " +
 				"should not be set.",
 		},
+		cli.BoolFlag{
+			Name: "blind",
+			Usage: "include blinded paths to this node in the " +
+				"invoice instead of routing hints, hiding " +
+				"the identity of the recipient from the payer",
+		},
+		cli.UintFlag{
+			Name: "num_dummy_hops",
+			Usage: "the number of dummy hops to append to each " +
+				"blinded path; only used with --blind",
+		},
+		cli.UintFlag{
+			Name: "max_num_paths",
+			Usage: "the maximum number of blinded paths to " +
+				"include in the invoice; only used with " +
+				"--blind",
+		},
+		cli.StringSliceFlag{
+			Name: "blinded_path_omit_node",
+			Usage: "the hex-encoded pubkey of a node that must " +
+				"not be used as the introduction node of a " +
+				"blinded path; can be specified multiple times",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -128,6 +151,28 @@ func addInvoice(ctx *cli.Context) error {
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
 		IsAmp:           ctx.Bool("amp"),
+		IsBlinded:       ctx.Bool("blind"),
+	}
+
+	if ctx.Bool("blind") {
+		pathCfg := &lnrpc.BlindedPathConfig{
+			NumDummyHops: uint32(ctx.Uint("num_dummy_hops")),
+			MaxNumPaths:  uint32(ctx.Uint("max_num_paths")),
+		}
+
+		for _, node := range ctx.StringSlice("blinded_path_omit_node") {
+			nodeID, err := hex.DecodeString(node)
+			if err != nil {
+				return fmt.Errorf("unable to parse node "+
+					"to omit: %v", err)
+			}
+
+			pathCfg.NodeOmissionList = append(
+				pathCfg.NodeOmissionList, nodeID,
+			)
+		}
+
+		invoice.BlindedPathConfig = pathCfg
 	}
 
 	resp, err := client.AddInvoice(ctxc, invoice)
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/csv"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
 	"os"
 	"runtime"
 	"strconv"
@@ -100,6 +103,70 @@ var (
 		Usage: "(optional) expresses time preference (range -1 to 1)",
 	}
 
+	trampolineFlag = cli.StringFlag{
+		Name: "trampoline",
+		Usage: "(optional) the hex encoded public key of a " +
+			"trampoline node that pays the destination on our " +
+			"behalf, " +
+			"which allows paying destinations that we don't " +
+			"know a route to",
+	}
+
+	trampolineFeeFlag = cli.Uint64Flag{
+		Name: "trampoline_fee_msat",
+		Usage: "(optional) the fee in milli-satoshis that is paid " +
+			"to the trampoline node, out of the fee limit of " +
+			"the payment",
+	}
+
+	trampolineCltvDeltaFlag = cli.UintFlag{
+		Name: "trampoline_cltv_delta",
+		Usage: "(optional) the time lock delta that is reserved " +
+			"for the trampoline node",
+	}
+
+	avoidNodeFlag = cli.StringSliceFlag{
+		Name: "avoid_node",
+		Usage: "(optional) the hex encoded public key of a node " +
+			"that the payment may not be routed through. This " +
+			"flag can be specified multiple times",
+	}
+
+	avoidTorOnlyFlag = cli.BoolFlag{
+		Name: "avoid_tor_only",
+		Usage: "(optional) if set, the payment isn't routed " +
+			"through nodes that only announce Tor addresses",
+	}
+
+	avoidASNFlag = cli.Int64SliceFlag{
+		Name: "avoid_asn",
+		Usage: "(optional) the number of an autonomous system; the " +
+			"payment isn't routed through nodes that announce an " +
+			"IP address in it. Requires routing.asndb to be set " +
+			"on the node. This flag can be specified multiple " +
+			"times",
+	}
+
+	preferNodeFlag = cli.StringSliceFlag{
+		Name: "prefer_node",
+		Usage: "(optional) the hex encoded public key of a node " +
+			"that the payment should preferably be routed " +
+			"through. This flag can be specified multiple times",
+	}
+
+	maxHopsFlag = cli.UintFlag{
+		Name: "max_hops",
+		Usage: "(optional) the maximum number of hops of the " +
+			"routes of the payment",
+	}
+
+	tracePathfindingFlag = cli.BoolFlag{
+		Name: "trace_pathfinding",
+		Usage: "(optional) trace the path finding runs of the " +
+			"payment; the trace can be retrieved with " +
+			"paymenttrace",
+	}
+
 	introductionNodeFlag = cli.StringFlag{
 		Name: "introduction_node",
 		Usage: "(blinded paths) the hex encoded, cleartext node ID " +
@@ -185,7 +252,14 @@ func paymentFlags() []cli.Flag {
 		},
 		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
 		maxShardSizeSatFlag, maxShardSizeMsatFlag, ampFlag,
-		timePrefFlag,
+		timePrefFlag, trampolineFlag, trampolineFeeFlag,
+		trampolineCltvDeltaFlag,
+		avoidNodeFlag,
+		avoidTorOnlyFlag,
+		avoidASNFlag,
+		preferNodeFlag,
+		maxHopsFlag,
+		tracePathfindingFlag,
 	}
 }
 
@@ -479,6 +553,40 @@ func sendPaymentRequest(ctx *cli.Context,
 
 	req.CltvLimit = int32(ctx.Int(cltvLimitFlag.Name))
 
+	if ctx.IsSet(trampolineFlag.Name) {
+		trampoline, err := route.NewVertexFromStr(
+			ctx.String(trampolineFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+		req.TrampolineNode = trampoline[:]
+		req.TrampolineFeeMsat = ctx.Uint64(trampolineFeeFlag.Name)
+		req.TrampolineCltvDelta = uint32(
+			ctx.Uint(trampolineCltvDeltaFlag.Name),
+		)
+	}
+
+	avoidNodes, err := parseNodeFlags(ctx, avoidNodeFlag.Name)
+	if err != nil {
+		return err
+	}
+	req.AvoidNodes = avoidNodes
+
+	preferredNodes, err := parseNodeFlags(ctx, preferNodeFlag.Name)
+	if err != nil {
+		return err
+	}
+	req.PreferredNodes = preferredNodes
+
+	req.AvoidTorOnly = ctx.Bool(avoidTorOnlyFlag.Name)
+	req.AvoidAsns, err = parseASNFlags(ctx)
+	if err != nil {
+		return err
+	}
+	req.MaxHops = uint32(ctx.Uint(maxHopsFlag.Name))
+	req.TracePathfinding = ctx.Bool(tracePathfindingFlag.Name)
+
 	pmtTimeout := ctx.Duration("timeout")
 	if pmtTimeout <= 0 {
 		return errors.New("payment timeout must be greater than zero")
@@ -650,6 +758,51 @@ func trackPayment(ctx *cli.Context) error {
 	return err
 }
 
+var paymentTraceCommand = cli.Command{
+	Name:     "paymenttrace",
+	Category: "Payments",
+	Usage:    "Show the path finding trace of a payment.",
+	Description: `
+	Show the path finding trace of a payment that was sent with
+	--trace_pathfinding. For every path finding run of the payment, the
+	trace lists the edges that were rejected and why, the success
+	probabilities that were used and the mission control entries that were
+	consulted.
+	`,
+	ArgsUsage: "hash",
+	Action:    actionDecorator(paymentTrace),
+}
+
+func paymentTrace(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	if !args.Present() {
+		return fmt.Errorf("hash argument missing")
+	}
+
+	hash, err := hex.DecodeString(args.First())
+	if err != nil {
+		return err
+	}
+
+	resp, err := routerClient.GetPaymentTrace(
+		ctxc, &routerrpc.GetPaymentTraceRequest{PaymentHash: hash},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 // printLivePayment receives payment updates from the given stream and either
 // outputs them as json or as a more user-friendly formatted table. The table
 // option uses terminal control codes to rewrite the output. This call
@@ -1097,6 +1250,11 @@ var queryRoutesCommand = cli.Command{
 		},
 		timePrefFlag,
 		cltvLimitFlag,
+		avoidNodeFlag,
+		avoidTorOnlyFlag,
+		avoidASNFlag,
+		preferNodeFlag,
+		maxHopsFlag,
 		introductionNodeFlag,
 		blindingPointFlag,
 		blindedHopsFlag,
@@ -1178,6 +1336,21 @@ func queryRoutes(ctx *cli.Context) error {
 		}
 	}
 
+	ignoredNodes, err := parseNodeFlags(ctx, avoidNodeFlag.Name)
+	if err != nil {
+		return err
+	}
+
+	preferredNodes, err := parseNodeFlags(ctx, preferNodeFlag.Name)
+	if err != nil {
+		return err
+	}
+
+	avoidASNs, err := parseASNFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	blindedRoutes, err := parseBlindedPaymentParameters(ctx)
 	if err != nil {
 		return err
@@ -1192,8 +1365,13 @@ func queryRoutes(ctx *cli.Context) error {
 		CltvLimit:           uint32(ctx.Uint64(cltvLimitFlag.Name)),
 		OutgoingChanId:      ctx.Uint64("outgoing_chan_id"),
 		TimePref:            ctx.Float64(timePrefFlag.Name),
+		IgnoredNodes:        ignoredNodes,
 		IgnoredPairs:        ignoredPairs,
 		BlindedPaymentPaths: blindedRoutes,
+		AvoidTorOnly:        ctx.Bool(avoidTorOnlyFlag.Name),
+		AvoidAsns:           avoidASNs,
+		PreferredNodes:      preferredNodes,
+		MaxHops:             uint32(ctx.Uint(maxHopsFlag.Name)),
 	}
 
 	route, err := client.QueryRoutes(ctxc, req)
@@ -1205,6 +1383,36 @@ func queryRoutes(ctx *cli.Context) error {
 	return nil
 }
 
+// parseNodeFlags parses the hex encoded node public keys of a string slice
+// flag.
+func parseNodeFlags(ctx *cli.Context, name string) ([][]byte, error) {
+	var nodes [][]byte
+	for _, pubKey := range ctx.StringSlice(name) {
+		node, err := route.NewVertexFromStr(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, node[:])
+	}
+
+	return nodes, nil
+}
+
+// parseASNFlags parses the autonomous system numbers of the avoid_asn flag.
+func parseASNFlags(ctx *cli.Context) ([]uint32, error) {
+	var asns []uint32
+	for _, asn := range ctx.Int64Slice(avoidASNFlag.Name) {
+		if asn <= 0 || asn > math.MaxUint32 {
+			return nil, fmt.Errorf("invalid ASN %d", asn)
+		}
+
+		asns = append(asns, uint32(asn))
+	}
+
+	return asns, nil
+}
+
 func parseBlindedPaymentParameters(ctx *cli.Context) (
 	[]*lnrpc.BlindedPaymentPath, error) {
 
@@ -1382,6 +1590,38 @@ var listPaymentsCommand = cli.Command{
 				"payments with creation date less than or " +
 				"equal to it",
 		},
+		cli.StringSliceFlag{
+			Name: "status",
+			Usage: "if set, only return payments with this " +
+				"status (initiated, in_flight, succeeded or " +
+				"failed); can be specified multiple times, " +
+				"takes precedence over include_incomplete",
+		},
+		cli.StringFlag{
+			Name: "dest",
+			Usage: "if set, only return payments to the node " +
+				"with this hex encoded public key",
+		},
+		cli.Uint64Flag{
+			Name: "min_amt_msat",
+			Usage: "if set, only return payments with a value " +
+				"of at least this amount",
+		},
+		cli.Uint64Flag{
+			Name: "max_amt_msat",
+			Usage: "if set, only return payments with a value " +
+				"of at most this amount",
+		},
+		cli.Uint64Flag{
+			Name: "min_fee_msat",
+			Usage: "if set, only return payments that paid a " +
+				"routing fee of at least this amount",
+		},
+		cli.Uint64Flag{
+			Name: "max_fee_msat",
+			Usage: "if set, only return payments that paid a " +
+				"routing fee of at most this amount",
+		},
 	},
 	Action: actionDecorator(listPayments),
 }
@@ -1399,6 +1639,31 @@ func listPayments(ctx *cli.Context) error {
 		CountTotalPayments: ctx.Bool("count_total_payments"),
 		CreationDateStart:  ctx.Uint64("creation_date_start"),
 		CreationDateEnd:    ctx.Uint64("creation_date_end"),
+		MinAmtMsat:         ctx.Uint64("min_amt_msat"),
+		MaxAmtMsat:         ctx.Uint64("max_amt_msat"),
+		MinFeeMsat:         ctx.Uint64("min_fee_msat"),
+		MaxFeeMsat:         ctx.Uint64("max_fee_msat"),
+	}
+
+	statuses := lnrpc.Payment_PaymentStatus_value
+	for _, status := range ctx.StringSlice("status") {
+		rpcStatus, ok := statuses[strings.ToUpper(status)]
+		if !ok || rpcStatus == int32(lnrpc.Payment_UNKNOWN) {
+			return fmt.Errorf("unknown payment status %v", status)
+		}
+
+		req.Statuses = append(
+			req.Statuses, lnrpc.Payment_PaymentStatus(rpcStatus),
+		)
+	}
+
+	if ctx.IsSet("dest") {
+		dest, err := hex.DecodeString(ctx.String("dest"))
+		if err != nil {
+			return fmt.Errorf("unable to decode dest: %w", err)
+		}
+
+		req.Destination = dest
 	}
 
 	payments, err := client.ListPayments(ctxc, req)
@@ -1546,6 +1811,166 @@ func forwardingHistory(ctx *cli.Context) error {
 	return nil
 }
 
+var exportLedgerCommand = cli.Command{
+	Name:      "exportledger",
+	Category:  "Payments",
+	Usage:     "Export the accounting ledger of the node.",
+	ArgsUsage: "[start_time] [end_time]",
+	Description: `
+	Export a normalised ledger of the forwards, payments, invoices and
+	on-chain transactions of the node over a particular time range
+	(--start_time and --end_time). On-chain fees that were paid from the
+	funds of closed channels are exported as separate entries.
+
+	The start and end times are meant to be expressed in seconds since the
+	Unix epoch. Alternatively negative time ranges can be used, e.g. "-3d".
+	Supports s(seconds), m(minutes), h(ours), d(ays), w(eeks), M(onths),
+	y(ears). Month equals 30.44 days, year equals 365.25 days.
+	If --start_time isn't provided, then the whole history is exported. If
+	--end_time isn't provided, then the current time is used.
+
+	Amounts are positive for funds that we received and negative for funds
+	that we sent, and don't include the fees that we paid. They're
+	expressed in the unit given by --unit, which is one of msat, sat or btc.
+
+	The ledger is written as CSV by default, or as a stream of JSON
+	objects if --format=json is set.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "start_time",
+			Usage: "the start of the time range of the ledger " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+		cli.StringFlag{
+			Name: "end_time",
+			Usage: "the end of the time range of the ledger " +
+				`as unix timestamp or relative e.g. "-1w"`,
+		},
+		cli.StringFlag{
+			Name:  "unit",
+			Usage: "the unit of the amounts; msat, sat or btc",
+			Value: "sat",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "the output format; csv or json",
+			Value: "csv",
+		},
+	},
+	Action: actionDecorator(exportLedger),
+}
+
+func exportLedger(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		startTime, endTime uint64
+		err                error
+	)
+	args := ctx.Args()
+	now := time.Now()
+
+	switch {
+	case ctx.IsSet("start_time"):
+		startTime, err = parseTime(ctx.String("start_time"), now)
+	case args.Present():
+		startTime, err = parseTime(args.First(), now)
+		args = args.Tail()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode start_time: %w", err)
+	}
+
+	switch {
+	case ctx.IsSet("end_time"):
+		endTime, err = parseTime(ctx.String("end_time"), now)
+	case args.Present():
+		endTime, err = parseTime(args.First(), now)
+	default:
+		endTime = uint64(now.Unix())
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode end_time: %w", err)
+	}
+
+	unitName := strings.ToUpper(ctx.String("unit"))
+	unit, ok := lnrpc.LedgerUnit_value[unitName]
+	if !ok {
+		return fmt.Errorf("unknown unit: %v", ctx.String("unit"))
+	}
+
+	format := ctx.String("format")
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("unknown format: %v", format)
+	}
+
+	stream, err := client.ExportLedger(ctxc, &lnrpc.ExportLedgerRequest{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Unit:      lnrpc.LedgerUnit(unit),
+	})
+	if err != nil {
+		return err
+	}
+
+	var csvWriter *csv.Writer
+	if format == "csv" {
+		csvWriter = csv.NewWriter(os.Stdout)
+		defer csvWriter.Flush()
+
+		unitSuffix := strings.ToLower(unitName)
+		err := csvWriter.Write([]string{
+			"timestamp", "type", "amount_" + unitSuffix,
+			"fee_" + unitSuffix, "chan_id_in", "chan_id_out",
+			"txid", "payment_hash", "label",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if csvWriter == nil {
+			printRespJSON(entry)
+			continue
+		}
+
+		timestamp := time.Unix(0, int64(entry.TimestampNs)).UTC()
+		err = csvWriter.Write([]string{
+			timestamp.Format(time.RFC3339Nano),
+			strings.ToLower(entry.EntryType.String()),
+			entry.Amount, entry.Fee,
+			formatLedgerChanID(entry.ChanIdIn),
+			formatLedgerChanID(entry.ChanIdOut),
+			entry.Txid, entry.PaymentHash, entry.Label,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// formatLedgerChanID formats the channel id of a ledger entry for the csv
+// export, leaving the field empty if the entry has no channel.
+func formatLedgerChanID(chanID uint64) string {
+	if chanID == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(chanID, 10)
+}
+
 var buildRouteCommand = cli.Command{
 	Name:     "buildroute",
 	Category: "Payments",
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var probeCommand = cli.Command{
+	Name:     "probe",
+	Category: "Mission Control",
+	Usage:    "Manage probe campaigns that warm up mission control.",
+	Description: `
+	A probe campaign periodically sends payments with a random payment hash
+	to a set of target nodes, until its budget of probes is spent. The
+	probes can't be settled, but mission control learns from their
+	attempts like from real payments.
+	`,
+	Subcommands: []cli.Command{
+		probeStartCommand,
+		probeStopCommand,
+		probeListCommand,
+	},
+}
+
+var probeStartCommand = cli.Command{
+	Name:  "start",
+	Usage: "Start a probe campaign.",
+	ArgsUsage: "--target=<pubkey> [--target=<pubkey>...] " +
+		"--amt_msat=<amt> --max_probes=<n>",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "target",
+			Usage: "the hex encoded public key of a node to " +
+				"probe. This flag can be specified multiple " +
+				"times",
+		},
+		cli.StringSliceFlag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) a channel that the probes must " +
+				"leave our node through. Every target is " +
+				"probed through each of the channels. This " +
+				"flag can be specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: "amt_msat",
+			Usage: "the amount in milli-satoshis that every " +
+				"probe delivers to its target",
+		},
+		cli.Uint64Flag{
+			Name: "fee_limit_msat",
+			Usage: "(optional) the maximum fee in " +
+				"milli-satoshis of the routes that the " +
+				"probes may try",
+		},
+		cli.DurationFlag{
+			Name: "interval",
+			Usage: "the time between the starts of two probe " +
+				"rounds",
+			Value: 10 * time.Minute,
+		},
+		cli.UintFlag{
+			Name: "max_probes",
+			Usage: "the number of probes after which the " +
+				"campaign ends",
+		},
+	},
+	Action: actionDecorator(probeStart),
+}
+
+func probeStart(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	targets, err := parseNodeFlags(ctx, "target")
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errors.New("at least one target must be specified")
+	}
+
+	var outgoingChans []uint64
+	for _, chanID := range ctx.StringSlice("outgoing_chan_id") {
+		outgoingChan, err := strconv.ParseUint(chanID, 10, 64)
+		if err != nil {
+			return err
+		}
+		outgoingChans = append(outgoingChans, outgoingChan)
+	}
+
+	req := &routerrpc.StartProbeCampaignRequest{
+		Targets:         targets,
+		OutgoingChanIds: outgoingChans,
+		AmtMsat:         ctx.Uint64("amt_msat"),
+		FeeLimitMsat:    ctx.Uint64("fee_limit_msat"),
+		IntervalSeconds: uint32(ctx.Duration("interval").Seconds()),
+		MaxProbes:       uint32(ctx.Uint("max_probes")),
+	}
+
+	resp, err := client.StartProbeCampaign(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var probeStopCommand = cli.Command{
+	Name:      "stop",
+	Usage:     "Stop a probe campaign.",
+	ArgsUsage: "campaign_id",
+	Action:    actionDecorator(probeStop),
+}
+
+func probeStop(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "stop")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.StopProbeCampaign(
+		ctxc, &routerrpc.StopProbeCampaignRequest{CampaignId: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var probeListCommand = cli.Command{
+	Name:   "list",
+	Usage:  "List the probe campaigns and their latest results.",
+	Action: actionDecorator(probeList),
+}
+
+func probeList(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.ListProbeCampaigns(
+		ctxc, &routerrpc.ListProbeCampaignsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -742,6 +742,12 @@ var closeChannelCommand = cli.Command{
 	--sat_per_vbyte arguments. This will be the starting value used during
 	fee negotiation. This is optional.
 
+	If the closing transaction of a cooperative closure has already been
+	broadcast and both peers support option_simple_close, running the
+	command again with a higher fee rate replaces the closing transaction
+	with one that pays the new fee rate from our output. This isn't
+	supported for taproot channels.
+
 	In the case of a cooperative closure, one can manually set the address
 	to deliver funds to upon closure. This is optional, and may only be used
 	if an upfront shutdown address has not already been set. If neither are
@@ -1233,6 +1239,342 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+var spliceChannelCommand = cli.Command{
+	Name:     "splicechannel",
+	Category: "Channels",
+	Usage:    "Splice funds into or out of an existing channel.",
+	Description: `
+	Splice funds into or out of an existing channel without closing it.
+
+	If the --addr flag is set, the amount is spliced out of the channel and
+	sent to the address. Otherwise, the amount is spliced into the channel
+	from the wallet. The channel remains usable until the splice
+	transaction has reached a reorg safe depth, after which it is drained
+	of HTLCs and continues with its new channel point. A pending splice in
+	can be aborted with the abortsplice command.
+
+	One can manually set the fee to be used for the splice transaction via
+	either the --conf_target or --sat_per_vbyte arguments. This is
+	optional.
+
+	To view which funding_txids/output_indexes can be used for a splice,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel point. If set, " +
+				"funding_txid and output_index flags and " +
+				"positional arguments will be ignored",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to splice in or out",
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "(optional) the address to splice the " +
+				"amount out to; if not set, the amount is " +
+				"spliced in from the wallet",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of " +
+				"confirmations each one of your outputs used " +
+				"for a splice in must satisfy",
+			Value: defaultUtxoMinConf,
+		},
+	},
+	Action: actionDecorator(spliceChannel),
+}
+
+func spliceChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "splicechannel")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint:     channelPoint,
+		Amount:           ctx.Int64("amt"),
+		Addr:             ctx.String("addr"),
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerVbyte:      ctx.Uint64("sat_per_vbyte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+	}
+
+	resp, err := client.SpliceChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var abortSpliceCommand = cli.Command{
+	Name:     "abortsplice",
+	Category: "Channels",
+	Usage:    "Abort the pending splice of a channel.",
+	Description: `
+	Abort the pending splice of a channel by double spending the wallet
+	coins that were spliced in. The transaction that double spends the
+	coins must pay a higher fee than the splice transaction to replace it.
+	Once it has reached a reorg safe depth, the channel continues with its
+	current channel point.
+
+	Only splices that were initiated by this node and spliced funds in can
+	be aborted.
+
+	One can manually set the fee to be used for the transaction via either
+	the --conf_target or --sat_per_vbyte arguments. This is optional.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel point. If set, " +
+				"funding_txid and output_index flags and " +
+				"positional arguments will be ignored",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the transaction",
+		},
+	},
+	Action: actionDecorator(abortSplice),
+}
+
+func abortSplice(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "abortsplice")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.AbortSpliceRequest{
+		ChannelPoint: channelPoint,
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	}
+
+	resp, err := client.AbortSplice(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var upgradeChannelCommand = cli.Command{
+	Name:     "upgradechannel",
+	Category: "Channels",
+	Usage:    "Upgrade the commitment type or constraints of a channel.",
+	Description: `
+	Upgrade the commitment type or the constraints of an existing channel
+	without closing it, using a dynamic commitment upgrade.
+
+	The channel is quiesced while the upgrade is negotiated with the remote
+	peer, which must support dynamic commitments. Constraints that aren't
+	set are left unchanged. The remote peer may reject the upgrade, in
+	which case the channel continues with its current parameters.
+
+	Private channels can also be upgraded to taproot, which requires a new
+	funding output. The channel is spliced to a taproot funding output
+	instead, which requires splicing to be enabled on both nodes, and is
+	paused until the splice transaction confirms. The fee of the splice
+	transaction can be set via either the --conf_target or --sat_per_vbyte
+	arguments. This is optional. The commitment type of taproot channels
+	can't be changed, but their other parameters can be upgraded.
+
+	To view which funding_txids/output_indexes can be used for an upgrade,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel point. If set, " +
+				"funding_txid and output_index flags and " +
+				"positional arguments will be ignored",
+		},
+		cli.StringFlag{
+			Name: "channel_type",
+			Usage: fmt.Sprintf("(optional) the commitment type to "+
+				"upgrade the channel to (%q, %q, %q)",
+				channelTypeTweakless, channelTypeAnchors,
+				channelTypeSimpleTaproot),
+		},
+		cli.Uint64Flag{
+			Name: "dust_limit_sat",
+			Usage: "(optional) the new dust limit in satoshis of " +
+				"our commitment",
+		},
+		cli.Uint64Flag{
+			Name: "remote_reserve_sats",
+			Usage: "(optional) the new number of satoshis the " +
+				"remote peer must keep in its balance",
+		},
+		cli.Uint64Flag{
+			Name: "remote_max_value_in_flight_msat",
+			Usage: "(optional) the new maximum value in msat " +
+				"of the htlcs the remote peer may offer at " +
+				"once",
+		},
+		cli.Uint64Flag{
+			Name: "remote_max_htlcs",
+			Usage: "(optional) the new maximum number of htlcs " +
+				"the remote peer may offer at once",
+		},
+		cli.Uint64Flag{
+			Name: "remote_csv_delay",
+			Usage: "(optional) the new number of blocks the " +
+				"funds of the remote peer are timelocked for " +
+				"in case of a force close",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"splice transaction of an upgrade to taproot " +
+				"*should* confirm in, will be used for fee " +
+				"estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the splice transaction of an upgrade to " +
+				"taproot",
+		},
+	},
+	Action: actionDecorator(upgradeChannel),
+}
+
+func upgradeChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "upgradechannel")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.UpgradeChannelRequest{
+		ChannelPoint:         channelPoint,
+		DustLimitSat:         ctx.Uint64("dust_limit_sat"),
+		RemoteChanReserveSat: ctx.Uint64("remote_reserve_sats"),
+		RemoteMaxValueInFlightMsat: ctx.Uint64(
+			"remote_max_value_in_flight_msat",
+		),
+		RemoteMaxHtlcs: uint32(ctx.Uint64("remote_max_htlcs")),
+		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
+		TargetConf:     int32(ctx.Int64("conf_target")),
+		SatPerVbyte:    ctx.Uint64("sat_per_vbyte"),
+	}
+
+	channelType := ctx.String("channel_type")
+	switch channelType {
+	case "":
+		break
+	case channelTypeTweakless:
+		req.CommitmentType = lnrpc.CommitmentType_STATIC_REMOTE_KEY
+	case channelTypeAnchors:
+		req.CommitmentType = lnrpc.CommitmentType_ANCHORS
+	case channelTypeSimpleTaproot:
+		req.CommitmentType = lnrpc.CommitmentType_SIMPLE_TAPROOT
+	default:
+		return fmt.Errorf("unsupported channel type %v", channelType)
+	}
+
+	resp, err := client.UpgradeChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1596,6 +1938,59 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var closedChannelReportsCommand = cli.Command{
+	Name:     "closedchannelreports",
+	Category: "Channels",
+	Usage: "List the on-chain fees paid and funds recovered for closed " +
+		"channels.",
+	ArgsUsage: "[chan_point]",
+	Description: `
+	Lists a breakdown of the on-chain fees that were paid and the funds
+	that were recovered when our channels were closed. This includes the
+	fee of the closing transaction, the fees of the second-level htlc
+	transactions, of the sweeps of the channel outputs and of the anchor
+	sweeps, along with the labels of these transactions in our wallet.
+
+	If a channel point is given, only the report of that channel is
+	listed. Channel points are encoded as: funding_txid:output_index
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel whose report should be listed, " +
+				"takes the form of txid:output_index",
+		},
+	},
+	Action: actionDecorator(closedChannelReports),
+}
+
+func closedChannelReports(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var chanPoint string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPoint = ctx.String("chan_point")
+	case ctx.Args().Present():
+		chanPoint = ctx.Args().First()
+	}
+
+	req := &lnrpc.ClosedChannelReportsRequest{
+		ChanPoint: chanPoint,
+	}
+
+	resp, err := client.ClosedChannelReports(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var describeGraphCommand = cli.Command{
 	Name:     "describegraph",
 	Category: "Graph",
@@ -2092,6 +2487,22 @@ var updateChannelPolicyCommand = cli.Command{
 				"applied to all forwarded HTLCs. If unset, " +
 				"the max HTLC is left unchanged",
 		},
+		cli.Int64Flag{
+			Name: "inbound_base_fee_msat",
+			Usage: "the base fee in milli-satoshis that will be " +
+				"charged for each HTLC that arrives over the " +
+				"channel, a negative value is a discount. If " +
+				"neither inbound fee is set, the inbound fee " +
+				"is left unchanged",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_rate_ppm",
+			Usage: "the fee rate ppm (parts per million) that " +
+				"will be charged for each HTLC that arrives " +
+				"over the channel, a negative value is a " +
+				"discount. If neither inbound fee is set, " +
+				"the inbound fee is left unchanged",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "the channel which this policy update should " +
@@ -2237,6 +2648,29 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		req.MinHtlcMsatSpecified = true
 	}
 
+	if ctx.IsSet("inbound_base_fee_msat") ||
+		ctx.IsSet("inbound_fee_rate_ppm") {
+
+		inboundBaseFee := ctx.Int64("inbound_base_fee_msat")
+		if inboundBaseFee < math.MinInt32 ||
+			inboundBaseFee > math.MaxInt32 {
+
+			return fmt.Errorf("inbound_base_fee_msat out of range")
+		}
+
+		inboundFeeRate := ctx.Int64("inbound_fee_rate_ppm")
+		if inboundFeeRate < math.MinInt32 ||
+			inboundFeeRate > math.MaxInt32 {
+
+			return fmt.Errorf("inbound_fee_rate_ppm out of range")
+		}
+
+		req.InboundFee = &lnrpc.InboundFee{
+			BaseFeeMsat: int32(inboundBaseFee),
+			FeeRatePpm:  int32(inboundFeeRate),
+		}
+	}
+
 	if chanPoint != nil {
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
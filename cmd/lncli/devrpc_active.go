@@ -24,6 +24,25 @@ func devCommands() []cli.Command {
 			ArgsUsage:   "graph-json-file",
 			Action:      actionDecorator(importGraph),
 		},
+		{
+			Name:     "quiesce",
+			Category: "Development",
+			Description: "Makes a channel quiescent, after " +
+				"which no updates are sent over the " +
+				"channel until it's resumed.",
+			Usage:     "Quiesce a channel.",
+			ArgsUsage: "[chan_point]",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name: "chan_point",
+					Usage: "the channel point of the " +
+						"channel to quiesce in the " +
+						"form funding_txid:" +
+						"output_index",
+				},
+			},
+			Action: actionDecorator(quiesce),
+		},
 	}
 }
 
@@ -60,3 +79,30 @@ func importGraph(ctx *cli.Context) error {
 	printRespJSON(res)
 	return nil
 }
+
+func quiesce(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getDevClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "quiesce")
+		return nil
+	}
+
+	chanPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Quiesce(ctxc, &devrpc.QuiescenceRequest{
+		ChanPoint: chanPoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res)
+	return nil
+}
@@ -0,0 +1,216 @@
+//go:build offersrpc
+// +build offersrpc
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/offersrpc"
+	"github.com/urfave/cli"
+)
+
+// offersCommands will return the set of commands to enable for offersrpc
+// builds.
+func offersCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "offers",
+			Category: "Offers",
+			Usage:    "Create and pay BOLT 12 offers",
+			Subcommands: []cli.Command{
+				createOfferCommand,
+				decodeOfferCommand,
+				payOfferCommand,
+			},
+		},
+	}
+}
+
+func getOffersClient(ctx *cli.Context) (offersrpc.OffersClient, func()) {
+	conn := getClientConn(ctx, false)
+	cleanUp := func() {
+		conn.Close()
+	}
+	return offersrpc.NewOffersClient(conn), cleanUp
+}
+
+var createOfferCommand = cli.Command{
+	Name:     "create",
+	Category: "Offers",
+	Usage:    "Create an offer that is paid to our node.",
+	Description: `
+	Create a reusable BOLT 12 offer. Payers request an invoice for the offer
+	from our node over onion messages, which requires onion messages to be
+	enabled with the protocol.onion-messages option.
+
+	If no amount is set, the payer chooses the amount. Offers with an amount
+	require a description.`,
+	ArgsUsage: "[--amt_msat=<amt>] [--description=<text>] " +
+		"[--issuer=<text>] [--expiry=<seconds>] [--quantity] " +
+		"[--quantity_max=<max>]",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount per item in millisatoshis",
+		},
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "a description of the purpose of the payment",
+		},
+		cli.StringFlag{
+			Name:  "issuer",
+			Usage: "a description of the issuer of the offer",
+		},
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "the number of seconds after which the offer " +
+				"expires. If not set, the offer doesn't expire",
+		},
+		cli.BoolFlag{
+			Name: "quantity",
+			Usage: "require the payer to choose a quantity of " +
+				"items to pay for",
+		},
+		cli.Uint64Flag{
+			Name: "quantity_max",
+			Usage: "the maximum quantity of items that can be " +
+				"paid for, implies --quantity",
+		},
+	},
+	Action: actionDecorator(createOffer),
+}
+
+func createOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getOffersClient(ctx)
+	defer cleanUp()
+
+	req := &offersrpc.CreateOfferRequest{
+		AmountMsat:  ctx.Uint64("amt_msat"),
+		Description: ctx.String("description"),
+		Issuer:      ctx.String("issuer"),
+		Expiry:      ctx.Uint64("expiry"),
+		HasQuantity: ctx.Bool("quantity") || ctx.IsSet("quantity_max"),
+		QuantityMax: ctx.Uint64("quantity_max"),
+	}
+
+	resp, err := client.CreateOffer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var decodeOfferCommand = cli.Command{
+	Name:      "decode",
+	Category:  "Offers",
+	Usage:     "Decode a BOLT 12 offer.",
+	ArgsUsage: "offer",
+	Action:    actionDecorator(decodeOffer),
+}
+
+func decodeOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getOffersClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "decode")
+	}
+
+	resp, err := client.DecodeOffer(ctxc, &offersrpc.DecodeOfferRequest{
+		Offer: ctx.Args().First(),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var payOfferCommand = cli.Command{
+	Name:     "pay",
+	Category: "Offers",
+	Usage:    "Pay a BOLT 12 offer.",
+	Description: `
+	Request an invoice for the offer from its issuer over onion messages
+	and pay it. The amount is required for offers without an amount, and
+	the quantity is required for offers that have a quantity.`,
+	ArgsUsage: "offer [--amt_msat=<amt>] [--quantity=<n>] " +
+		"[--payer_note=<text>]",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "amt_msat",
+			Usage: "the amount to pay in millisatoshis, which " +
+				"may be larger than the amount of the offer",
+		},
+		cli.Uint64Flag{
+			Name:  "quantity",
+			Usage: "the quantity of items to pay for",
+		},
+		cli.StringFlag{
+			Name:  "payer_note",
+			Usage: "a note to the issuer of the offer",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when " +
+				"sending the payment",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the payment's amount used as " +
+				"the maximum fee allowed when sending the " +
+				"payment",
+		},
+		cli.Uint64Flag{
+			Name: "timeout",
+			Usage: "the number of seconds to wait for the issuer " +
+				"to reply with an invoice",
+		},
+	},
+	Action: actionDecorator(payOffer),
+}
+
+func payOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getOffersClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "pay")
+	}
+
+	feeLimit, err := retrieveFeeLimitLegacy(ctx)
+	if err != nil {
+		return err
+	}
+
+	timeout := ctx.Uint64("timeout")
+	if timeout > uint64(^uint32(0)) {
+		return fmt.Errorf("timeout of %v seconds is too large",
+			timeout)
+	}
+
+	resp, err := client.PayOffer(ctxc, &offersrpc.PayOfferRequest{
+		Offer:          ctx.Args().First(),
+		AmtMsat:        ctx.Uint64("amt_msat"),
+		Quantity:       ctx.Uint64("quantity"),
+		PayerNote:      ctx.String("payer_note"),
+		FeeLimit:       feeLimit,
+		TimeoutSeconds: uint32(timeout),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
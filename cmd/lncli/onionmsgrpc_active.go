@@ -0,0 +1,194 @@
+//go:build onionmsgrpc
+// +build onionmsgrpc
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc/onionmsgrpc"
+	"github.com/urfave/cli"
+)
+
+// onionMsgCommands will return the set of commands to enable for onionmsgrpc
+// builds.
+func onionMsgCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:     "onionmsg",
+			Category: "Onion Messages",
+			Usage:    "Send and receive onion messages",
+			Subcommands: []cli.Command{
+				sendOnionMessageCommand,
+				subscribeOnionMessagesCommand,
+			},
+		},
+	}
+}
+
+func getOnionMsgClient(ctx *cli.Context) (onionmsgrpc.OnionMessengerClient,
+	func()) {
+
+	conn := getClientConn(ctx, false)
+	cleanUp := func() {
+		conn.Close()
+	}
+	return onionmsgrpc.NewOnionMessengerClient(conn), cleanUp
+}
+
+var sendOnionMessageCommand = cli.Command{
+	Name:     "send",
+	Category: "Onion Messages",
+	Usage:    "Send an onion message along a path of nodes.",
+	Description: `
+	Send an onion message through the nodes set with --path. The first node
+	must be one of our peers, the last node is the recipient of the message.
+
+	Application level records are set with --tlv in the form type=hexdata.
+	Record types must be at least 64. If --reply_path is set, a blinded path
+	to our node through the nodes set with --reply_hop is included so that
+	the recipient is able to reply.`,
+	ArgsUsage: "--path=<pubkey> [--path=<pubkey>...] " +
+		"[--tlv=type=data...] [--reply_path] " +
+		"[--reply_hop=<pubkey>...] [--path_id=<hex>]",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "path",
+			Usage: "the hex encoded public key of a node the " +
+				"message is sent through. Can be set " +
+				"multiple times, in the order of the path",
+		},
+		cli.StringSliceFlag{
+			Name: "tlv",
+			Usage: "an application level record for the " +
+				"recipient in the form type=hexdata. Can be " +
+				"set multiple times",
+		},
+		cli.BoolFlag{
+			Name: "reply_path",
+			Usage: "include a blinded reply path to our node in " +
+				"the message",
+		},
+		cli.StringSliceFlag{
+			Name: "reply_hop",
+			Usage: "the hex encoded public key of a node that a " +
+				"reply travels through before it reaches our " +
+				"node. Can be set multiple times",
+		},
+		cli.StringFlag{
+			Name: "path_id",
+			Usage: "the hex encoded path id that is returned " +
+				"with replies sent over the reply path",
+		},
+	},
+	Action: actionDecorator(sendOnionMessage),
+}
+
+func sendOnionMessage(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getOnionMsgClient(ctx)
+	defer cleanUp()
+
+	path, err := parseHexSlice(ctx.StringSlice("path"))
+	if err != nil {
+		return fmt.Errorf("unable to parse path: %w", err)
+	}
+
+	req := &onionmsgrpc.SendOnionMessageRequest{
+		Path:         path,
+		FinalHopTlvs: make(map[uint64][]byte),
+	}
+
+	for _, record := range ctx.StringSlice("tlv") {
+		parts := strings.SplitN(record, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid tlv record %v, expected "+
+				"type=hexdata", record)
+		}
+
+		tlvType, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tlv type %v: %w", parts[0],
+				err)
+		}
+
+		req.FinalHopTlvs[tlvType], err = hex.DecodeString(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid tlv data %v: %w", parts[1],
+				err)
+		}
+	}
+
+	if ctx.Bool("reply_path") {
+		hops, err := parseHexSlice(ctx.StringSlice("reply_hop"))
+		if err != nil {
+			return fmt.Errorf("unable to parse reply hops: %w", err)
+		}
+
+		pathID, err := hex.DecodeString(ctx.String("path_id"))
+		if err != nil {
+			return fmt.Errorf("unable to parse path id: %w", err)
+		}
+
+		req.ReplyPath = &onionmsgrpc.ReplyPathRequest{
+			Hops:   hops,
+			PathId: pathID,
+		}
+	}
+
+	resp, err := client.SendOnionMessage(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parseHexSlice decodes a list of hex encoded strings.
+func parseHexSlice(values []string) ([][]byte, error) {
+	decoded := make([][]byte, 0, len(values))
+	for _, value := range values {
+		b, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		decoded = append(decoded, b)
+	}
+
+	return decoded, nil
+}
+
+var subscribeOnionMessagesCommand = cli.Command{
+	Name:     "subscribe",
+	Category: "Onion Messages",
+	Usage:    "Subscribe to onion messages that are addressed to us.",
+	Action:   actionDecorator(subscribeOnionMessages),
+}
+
+func subscribeOnionMessages(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getOnionMsgClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeOnionMessages(
+		ctxc, &onionmsgrpc.SubscribeOnionMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
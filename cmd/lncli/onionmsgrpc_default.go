@@ -0,0 +1,11 @@
+//go:build !onionmsgrpc
+// +build !onionmsgrpc
+
+package main
+
+import "github.com/urfave/cli"
+
+// onionMsgCommands will return nil for non-onionmsgrpc builds.
+func onionMsgCommands() []cli.Command {
+	return nil
+}
@@ -13,5 +13,7 @@ func routerCommands() []cli.Command {
 		getCfgCommand,
 		setCfgCommand,
 		updateChanStatusCommand,
+		probeCommand,
+		paymentTraceCommand,
 	}
 }
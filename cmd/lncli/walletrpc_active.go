@@ -412,6 +412,12 @@ var listSweepsCommand = cli.Command{
 			Name:  "verbose",
 			Usage: "lookup full transaction",
 		},
+		cli.BoolFlag{
+			Name: "history",
+			Usage: "list every publish attempt of our sweeps, " +
+				"including replaced ones, with their inputs " +
+				"and fees",
+		},
 	},
 	Description: `
 	Get a list of the hex-encoded transaction ids of every sweep that our
@@ -420,6 +426,12 @@ var listSweepsCommand = cli.Command{
 
 	If the verbose flag is set, the full set of transactions will be 
 	returned, otherwise only the sweep transaction ids will be returned. 
+
+	If the history flag is set, a record of every publish attempt of our
+	sweeps is returned instead, including the attempts that were
+	replaced-by-fee. Each record lists the swept outputs with their witness
+	types and channel points, the fee rate and fee of the attempt, and the
+	height at which it confirmed.
 	`,
 	Action: actionDecorator(listSweeps),
 }
@@ -432,6 +444,7 @@ func listSweeps(ctx *cli.Context) error {
 	resp, err := client.ListSweeps(
 		ctxc, &walletrpc.ListSweepsRequest{
 			Verbose: ctx.IsSet("verbose"),
+			History: ctx.IsSet("history"),
 		},
 	)
 	if err != nil {
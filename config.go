@@ -29,12 +29,15 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/offersrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/onionmsgrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/peersrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
@@ -44,6 +47,7 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/trampoline"
 )
 
 const (
@@ -475,6 +479,10 @@ type Config struct {
 
 	Htlcswitch *lncfg.Htlcswitch `group:"htlcswitch" namespace:"htlcswitch"`
 
+	FeePolicy *lncfg.FeePolicy `group:"feepolicy" namespace:"feepolicy"`
+
+	Trampoline *lncfg.Trampoline `group:"trampoline" namespace:"trampoline"`
+
 	GRPC *GRPCConfig `group:"grpc" namespace:"grpc"`
 
 	// LogWriter is the root logger that all of the daemon's subloggers are
@@ -583,9 +591,11 @@ func DefaultConfig() Config {
 		MaxBackoff:         defaultMaxBackoff,
 		ConnectionTimeout:  tor.DefaultConnTimeout,
 		SubRPCServers: &subRPCServerConfigs{
-			SignRPC:   &signrpc.Config{},
-			RouterRPC: routerrpc.DefaultConfig(),
-			PeersRPC:  &peersrpc.Config{},
+			SignRPC:     &signrpc.Config{},
+			RouterRPC:   routerrpc.DefaultConfig(),
+			PeersRPC:    &peersrpc.Config{},
+			OnionMsgRPC: &onionmsgrpc.Config{},
+			OffersRPC:   &offersrpc.Config{},
 		},
 		Autopilot: &lncfg.AutoPilot{
 			MaxChannels:    5,
@@ -696,6 +706,24 @@ func DefaultConfig() Config {
 		Htlcswitch: &lncfg.Htlcswitch{
 			MailboxDeliveryTimeout: htlcswitch.DefaultMailboxDeliveryTimeout,
 		},
+		FeePolicy: &lncfg.FeePolicy{
+			Strategy:          feepolicy.StrategyBalance,
+			Interval:          feepolicy.DefaultInterval,
+			BaseFee:           feepolicy.DefaultBaseFee,
+			MinFeeRate:        feepolicy.DefaultMinFeeRate,
+			MaxFeeRate:        feepolicy.DefaultMaxFeeRate,
+			ForwardingWindow:  feepolicy.DefaultForwardingWindow,
+			MinUpdateInterval: feepolicy.DefaultMinUpdateInterval,
+			MaxUpdates:        feepolicy.DefaultMaxUpdates,
+			MinChange:         feepolicy.DefaultMinChange,
+		},
+		Trampoline: &lncfg.Trampoline{
+			BaseFee:    trampoline.DefaultBaseFee,
+			FeeRate:    trampoline.DefaultFeeRate,
+			CltvDelta:  trampoline.DefaultCltvDelta,
+			MppTimeout: trampoline.DefaultMppTimeout,
+			MaxParts:   trampoline.DefaultMaxParts,
+		},
 		GRPC: &GRPCConfig{
 			ServerPingTime:    defaultGrpcServerPingTime,
 			ServerPingTimeout: defaultGrpcServerPingTimeout,
@@ -941,6 +969,7 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 	cfg.Tor.WatchtowerKeyPath = CleanAndExpandPath(cfg.Tor.WatchtowerKeyPath)
 	cfg.Watchtower.TowerDir = CleanAndExpandPath(cfg.Watchtower.TowerDir)
 	cfg.BackupFilePath = CleanAndExpandPath(cfg.BackupFilePath)
+	cfg.Routing.ASNDB = CleanAndExpandPath(cfg.Routing.ASNDB)
 	cfg.WalletUnlockPasswordFile = CleanAndExpandPath(
 		cfg.WalletUnlockPasswordFile,
 	)
@@ -1680,6 +1709,8 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.RemoteSigner,
 		cfg.Sweeper,
 		cfg.Htlcswitch,
+		cfg.FeePolicy,
+		cfg.Trampoline,
 	)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package lnd
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -31,6 +32,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainreg"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/kvdb"
@@ -42,6 +44,7 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/sqldb"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"github.com/lightningnetwork/lnd/watchtower"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
@@ -582,6 +585,7 @@ func (d *DefaultWalletImpl) BuildWalletConfig(ctx context.Context,
 		ChainSource:      partialChainControl.ChainSource,
 		WatchOnly:        d.watchOnly,
 		MigrateWatchOnly: d.migrateWatchOnly,
+		PackageSubmitter: partialChainControl.PackageSubmitter,
 	}
 
 	// Parse coin selection strategy.
@@ -869,6 +873,11 @@ type DatabaseInstances struct {
 	// WalletDB is the configuration for loading the wallet database using
 	// the btcwallet's loader.
 	WalletDB btcwallet.LoaderOption
+
+	// NativeSQLStore is a pointer to a native SQL store that can be used
+	// for native SQL queries for tables that already support it. This may
+	// be nil if the use-native-sql flag was not set.
+	NativeSQLStore *sqldb.BaseDB
 }
 
 // DefaultDatabaseBuilder is a type that builds the default database backends
@@ -927,6 +936,8 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 		MacaroonDB:   databaseBackends.MacaroonDB,
 		DecayedLogDB: databaseBackends.DecayedLogDB,
 		WalletDB:     databaseBackends.WalletDB,
+
+		NativeSQLStore: databaseBackends.NativeSQLStore,
 	}
 	cleanUp := func() {
 		// We can just close the returned close functions directly. Even
@@ -1011,11 +1022,111 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 	// using the same struct (and DB backend) instance.
 	dbs.ChanStateDB = dbs.GraphDB
 
-	// For now the only InvoiceDB implementation is the *channeldb.DB.
-	//
-	// TODO(positiveblue): use a sql first implementation for this
-	// interface.
-	dbs.InvoiceDB = dbs.GraphDB
+	invoicesMigrated, err := dbs.GraphDB.InvoicesMigratedToSQL()
+	if err != nil {
+		cleanUp()
+
+		err := fmt.Errorf("unable to check invoice migration: %w", err)
+		d.logger.Error(err)
+		return nil, nil, err
+	}
+
+	// Instantiate a native SQL invoice store if the flag is set.
+	if d.cfg.DB.UseNativeSQL {
+		executor := sqldb.NewTransactionExecutor(
+			dbs.NativeSQLStore,
+			func(tx *sql.Tx) invoices.SQLInvoiceQueries {
+				return dbs.NativeSQLStore.WithTx(tx)
+			},
+		)
+
+		sqlInvoiceDB := invoices.NewSQLStore(
+			executor, clock.NewDefaultClock(),
+		)
+
+		// On the first start with the option, the invoices in the
+		// key-value store are migrated to the native SQL tables. The
+		// migration replaces what the SQL store holds, so it's simply
+		// repeated if we stop before it was marked as done.
+		if !invoicesMigrated {
+			err := invoices.MigrateInvoicesToSQL(
+				ctx, dbs.GraphDB, sqlInvoiceDB,
+			)
+			if err == nil {
+				err = dbs.GraphDB.SetInvoicesMigratedToSQL()
+			}
+			if err != nil {
+				cleanUp()
+
+				err := fmt.Errorf("unable to migrate invoices "+
+					"to native SQL store: %w", err)
+				d.logger.Error(err)
+				return nil, nil, err
+			}
+		}
+
+		dbs.InvoiceDB = sqlInvoiceDB
+	} else {
+		// The invoices in the key-value store are stale once they were
+		// migrated, so we can't switch back to them.
+		if invoicesMigrated {
+			cleanUp()
+
+			err := fmt.Errorf("the invoices were migrated to the " +
+				"native SQL store, db.use-native-sql can't be " +
+				"disabled anymore")
+			d.logger.Error(err)
+			return nil, nil, err
+		}
+
+		dbs.InvoiceDB = dbs.GraphDB
+	}
+
+	graphMigrated, err := dbs.GraphDB.GraphMigratedToSQL()
+	if err != nil {
+		cleanUp()
+
+		err := fmt.Errorf("unable to check graph migration: %w", err)
+		d.logger.Error(err)
+		return nil, nil, err
+	}
+
+	// Keep the channel graph in the native SQL tables if the flag is set.
+	// On the first start with the option, the graph in the key-value store
+	// is migrated to them.
+	if d.cfg.DB.UseNativeSQLGraph {
+		graphExecutor := sqldb.NewTransactionExecutor(
+			dbs.NativeSQLStore,
+			func(tx *sql.Tx) channeldb.SQLGraphQueries {
+				return dbs.NativeSQLStore.WithTx(tx)
+			},
+		)
+
+		err = dbs.GraphDB.ChannelGraph().UseSQLStore(
+			ctx, channeldb.NewSQLGraphStore(graphExecutor),
+		)
+		if err == nil && !graphMigrated {
+			err = dbs.GraphDB.SetGraphMigratedToSQL()
+		}
+		if err != nil {
+			cleanUp()
+
+			err := fmt.Errorf("unable to use native SQL graph "+
+				"store: %w", err)
+			d.logger.Error(err)
+			return nil, nil, err
+		}
+	} else if graphMigrated {
+		// The graph in the key-value store is stale once it was
+		// migrated, so we can't switch back to it.
+		cleanUp()
+
+		err := fmt.Errorf("the channel graph was migrated to the " +
+			"native SQL store, db.use-native-sql-graph can't be " +
+			"disabled anymore")
+		d.logger.Error(err)
+		return nil, nil, err
+	}
 
 	// Wrap the watchtower client DB and make sure we clean up.
 	if cfg.WtClient.Active {
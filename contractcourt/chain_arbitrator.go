@@ -7,12 +7,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/kvdb"
@@ -169,6 +171,28 @@ type ChainArbitratorConfig struct {
 	// resolved (which includes sweeping any time locked funds).
 	NotifyFullyResolvedChannel func(point wire.OutPoint)
 
+	// NotifySplicedChannel is a function closure that the ChainArbitrator
+	// will use to notify other subsystems once the splice of a channel has
+	// confirmed, and the channel has been replaced by the channel with the
+	// new funding output.
+	NotifySplicedChannel func(oldChanPoint wire.OutPoint,
+		newChan *channeldb.OpenChannel)
+
+	// NotifySpliceAborted is a function closure that the ChainArbitrator
+	// will use to notify other subsystems once the pending splice of a
+	// channel has been aborted because one of its inputs was double
+	// spent. The channel continues with its current funding output.
+	NotifySpliceAborted func(chanPoint wire.OutPoint,
+		peer *btcec.PublicKey)
+
+	// DrainSplicedChannel is a function closure that the ChainArbitrator
+	// will use once the splice transaction of a channel has reached a
+	// reorg safe depth. It stops new HTLCs from being added to the channel
+	// and returns ErrSpliceNotDrained until the channel has no HTLCs or
+	// pending updates left, and its link has been shut down.
+	DrainSplicedChannel func(chanPoint wire.OutPoint,
+		peer *btcec.PublicKey) error
+
 	// OnionProcessor is used to decode onion payloads for on-chain
 	// resolution.
 	OnionProcessor OnionProcessor
@@ -182,6 +206,11 @@ type ChainArbitratorConfig struct {
 	// htlcIndex, if it is a forwarded one.
 	IsForwardedHTLC func(chanID lnwire.ShortChannelID, htlcIndex uint64) bool
 
+	// QueryIncomingCircuit returns the incoming circuit key of the
+	// forwarded HTLC identified by the given outgoing circuit key, or nil
+	// if there's no open circuit for it.
+	QueryIncomingCircuit func(circuit models.CircuitKey) *models.CircuitKey
+
 	// Clock is the clock implementation that ChannelArbitrator uses.
 	// It is useful for testing.
 	Clock clock.Clock
@@ -381,6 +410,13 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			chanStateDB := c.chanSource.ChannelStateDB()
 			return chanStateDB.FetchHistoricalChannel(&chanPoint)
 		},
+		FindOutgoingHTLCDeadline: func(
+			htlc channeldb.HTLC) (int32, bool) {
+
+			return c.FindOutgoingHTLCDeadline(
+				channel.ShortChanID(), htlc,
+			)
+		},
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -491,6 +527,161 @@ func (c *ChainArbitrator) ResolveContract(chanPoint wire.OutPoint) error {
 	return nil
 }
 
+// completeSplice replaces a channel with the channel of its pending splice
+// once the splice transaction has reached a reorg safe depth. Unless force is
+// set, the channel is drained first, and ErrSpliceNotDrained is returned until
+// it has no HTLCs left. The channel arbitrator of the spliced channel is
+// stopped without marking the channel closed, and a new arbitrator is launched
+// to watch over the new funding output.
+func (c *ChainArbitrator) completeSplice(chanPoint wire.OutPoint,
+	force bool) error {
+
+	c.Lock()
+	chainWatcher, ok := c.activeWatchers[chanPoint]
+	c.Unlock()
+
+	if !ok {
+		return fmt.Errorf("unable to find watcher for: %v", chanPoint)
+	}
+
+	if !force && c.cfg.DrainSplicedChannel != nil {
+		err := c.cfg.DrainSplicedChannel(
+			chanPoint, chainWatcher.cfg.chanState.IdentityPub,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Completing splice of ChannelPoint(%v)", chanPoint)
+
+	c.Lock()
+	chainArb := c.activeChannels[chanPoint]
+	delete(c.activeChannels, chanPoint)
+
+	// The chain watcher exits once it has dispatched the splice, so it
+	// doesn't need to be stopped.
+	delete(c.activeWatchers, chanPoint)
+	c.Unlock()
+
+	if chainArb != nil {
+		if err := chainArb.Stop(); err != nil {
+			log.Warnf("unable to stop ChannelArbitrator(%v): %v",
+				chanPoint, err)
+		}
+	}
+
+	newChan, err := chainWatcher.cfg.chanState.CompleteSplice()
+	if err != nil {
+		return err
+	}
+
+	// The state of the arbitrator of the spliced channel is no longer
+	// needed, as none of its commitments can confirm anymore.
+	if chainArb != nil {
+		if err := chainArb.log.WipeHistory(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.WatchNewChannel(newChan); err != nil {
+		return err
+	}
+
+	if c.cfg.NotifySplicedChannel != nil {
+		c.cfg.NotifySplicedChannel(chanPoint, newChan)
+	}
+
+	return nil
+}
+
+// abortSplice removes the pending splice of a channel once an input of the
+// splice transaction has been double spent, so that the channel can resume
+// operation with its current funding output.
+func (c *ChainArbitrator) abortSplice(chanPoint wire.OutPoint) error {
+	log.Infof("Aborting splice of ChannelPoint(%v)", chanPoint)
+
+	c.Lock()
+	chainWatcher := c.activeWatchers[chanPoint]
+	c.Unlock()
+
+	if chainWatcher == nil {
+		return fmt.Errorf("unable to find watcher for: %v", chanPoint)
+	}
+
+	chanState := chainWatcher.cfg.chanState
+	if err := chanState.AbortSplice(); err != nil {
+		return err
+	}
+
+	if c.cfg.NotifySpliceAborted != nil {
+		c.cfg.NotifySpliceAborted(chanPoint, chanState.IdentityPub)
+	}
+
+	return nil
+}
+
+// WatchSplice instructs the chain watcher of a channel to watch the inputs of
+// its pending splice transaction, so that the splice is aborted if any of
+// them is double spent.
+func (c *ChainArbitrator) WatchSplice(chanPoint wire.OutPoint) error {
+	c.Lock()
+	chainWatcher, ok := c.activeWatchers[chanPoint]
+	c.Unlock()
+
+	if !ok {
+		return fmt.Errorf("unable to find watcher for: %v", chanPoint)
+	}
+
+	return chainWatcher.WatchSplice()
+}
+
+// IsSpliceLocked returns true if the pending splice transaction of a channel
+// has reached a reorg safe depth, in which case the channel must no longer
+// accept new HTLCs so that it can be drained before the splice completes.
+func (c *ChainArbitrator) IsSpliceLocked(chanPoint wire.OutPoint) bool {
+	c.Lock()
+	chainWatcher, ok := c.activeWatchers[chanPoint]
+	c.Unlock()
+
+	return ok && chainWatcher.isSpliceLocked()
+}
+
+// FindOutgoingHTLCDeadline returns the height by which the given outgoing HTLC
+// of the channel must be timed out on chain, which is the expiry height of the
+// incoming HTLC it was forwarded from. If the HTLC isn't a forward, or the
+// incoming HTLC can't be found, false is returned.
+func (c *ChainArbitrator) FindOutgoingHTLCDeadline(scid lnwire.ShortChannelID,
+	outgoingHTLC channeldb.HTLC) (int32, bool) {
+
+	if c.cfg.QueryIncomingCircuit == nil {
+		return 0, false
+	}
+
+	incomingCircuit := c.cfg.QueryIncomingCircuit(models.CircuitKey{
+		ChanID: scid,
+		HtlcID: outgoingHTLC.HtlcIndex,
+	})
+	if incomingCircuit == nil {
+		return 0, false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	for _, channelArb := range c.activeChannels {
+		if channelArb.cfg.ShortChanID != incomingCircuit.ChanID {
+			continue
+		}
+
+		return channelArb.findIncomingHTLCExpiry(
+			incomingCircuit.HtlcID, outgoingHTLC.RHash,
+		)
+	}
+
+	return 0, false
+}
+
 // Start launches all goroutines that the ChainArbitrator needs to operate.
 func (c *ChainArbitrator) Start() error {
 	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
@@ -523,6 +714,13 @@ func (c *ChainArbitrator) Start() error {
 			return c.cfg.ContractBreach(chanPoint, ret)
 		}
 
+		spliceClosure := func(force bool) error {
+			return c.completeSplice(chanPoint, force)
+		}
+		abortSpliceClosure := func() error {
+			return c.abortSplice(chanPoint)
+		}
+
 		chainWatcher, err := newChainWatcher(
 			chainWatcherConfig{
 				chanState:           channel,
@@ -531,6 +729,8 @@ func (c *ChainArbitrator) Start() error {
 				isOurAddr:           c.cfg.IsOurAddress,
 				contractBreach:      breachClosure,
 				extractStateNumHint: lnwallet.GetStateNumHint,
+				completeSplice:      spliceClosure,
+				abortSplice:         abortSpliceClosure,
 			},
 		)
 		if err != nil {
@@ -577,6 +777,7 @@ func (c *ChainArbitrator) Start() error {
 		// We can leave off the CloseContract and ForceCloseChan
 		// methods as the channel is already closed at this point.
 		chanPoint := closeChanInfo.ChanPoint
+		scid := closeChanInfo.ShortChanID
 		arbCfg := ChannelArbitratorConfig{
 			ChanPoint:             chanPoint,
 			ShortChanID:           closeChanInfo.ShortChanID,
@@ -596,6 +797,11 @@ func (c *ChainArbitrator) Start() error {
 				chanStateDB := c.chanSource.ChannelStateDB()
 				return chanStateDB.FetchHistoricalChannel(&chanPoint)
 			},
+			FindOutgoingHTLCDeadline: func(
+				htlc channeldb.HTLC) (int32, bool) {
+
+				return c.FindOutgoingHTLCDeadline(scid, htlc)
+			},
 		}
 		chanLog, err := newBoltArbitratorLog(
 			c.chanSource.Backend, arbCfg, c.cfg.ChainHash, chanPoint,
@@ -1151,6 +1357,12 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 				)
 			},
 			extractStateNumHint: lnwallet.GetStateNumHint,
+			completeSplice: func(force bool) error {
+				return c.completeSplice(chanPoint, force)
+			},
+			abortSplice: func() error {
+				return c.abortSplice(chanPoint)
+			},
 		},
 	)
 	if err != nil {
@@ -2,6 +2,7 @@ package contractcourt
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -28,6 +29,44 @@ const (
 	// maxCommitPointPollTimeout is the maximum time we'll wait before
 	// polling the database for a channel's commitpoint.
 	maxCommitPointPollTimeout = 10 * time.Minute
+
+	// spliceConfDepth is the number of confirmations after which the
+	// splice transaction of a channel, or a transaction that double spends
+	// one of its inputs, is considered safe from reorgs.
+	spliceConfDepth = 6
+
+	// spliceDrainInterval is the interval in which we retry to complete a
+	// splice that has reached spliceConfDepth while the channel is being
+	// drained of its HTLCs.
+	spliceDrainInterval = 10 * time.Second
+)
+
+var (
+	// ErrSpliceNotDrained is returned when a splice can't be completed yet
+	// because the channel still has HTLCs or pending updates.
+	ErrSpliceNotDrained = errors.New("spliced channel isn't drained yet")
+)
+
+// spliceEvent is the outcome of waiting for a transaction that affects the
+// pending splice of a channel to reach a reorg safe depth.
+type spliceEvent uint8
+
+const (
+	// spliceEventDepth indicates that the transaction has reached
+	// spliceConfDepth.
+	spliceEventDepth spliceEvent = iota
+
+	// spliceEventReorg indicates that the transaction has been reorged
+	// out of the chain.
+	spliceEventReorg
+
+	// spliceEventFundingSpent indicates that the new funding output of the
+	// splice has been spent before the splice transaction has reached
+	// spliceConfDepth.
+	spliceEventFundingSpent
+
+	// spliceEventExit indicates that the watcher is exiting.
+	spliceEventExit
 )
 
 // LocalUnilateralCloseInfo encapsulates all the information we need to act on
@@ -187,6 +226,22 @@ type chainWatcherConfig struct {
 	// obfuscater. This is used by the chain watcher to identify which
 	// state was broadcast and confirmed on-chain.
 	extractStateNumHint func(*wire.MsgTx, [lnwallet.StateHintSize]byte) uint64
+
+	// completeSplice is called by the watcher once the pending splice
+	// transaction of the channel has reached spliceConfDepth. Unless force
+	// is set, it returns ErrSpliceNotDrained while the channel still has
+	// HTLCs or pending updates, and the watcher retries later. The splice
+	// is forced once the new funding output has been spent, so the
+	// watcher of the new funding output can act on the spend. The watcher
+	// exits afterwards, as the channel continues with the new funding
+	// output.
+	completeSplice func(force bool) error
+
+	// abortSplice is called by the watcher once a transaction that spends
+	// an input of the pending splice transaction of the channel has
+	// reached spliceConfDepth. The splice can never confirm in that case,
+	// so the channel continues with its current funding output.
+	abortSplice func() error
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -198,6 +253,11 @@ type chainWatcher struct {
 	started int32 // To be used atomically.
 	stopped int32 // To be used atomically.
 
+	// spliceLocked is set once the pending splice transaction of the
+	// channel has reached spliceConfDepth, after which the channel is
+	// drained before the splice is completed.
+	spliceLocked int32 // To be used atomically.
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 
@@ -224,6 +284,10 @@ type chainWatcher struct {
 	// clientSubscriptions is a map that keeps track of all the active
 	// client subscriptions for events related to this channel.
 	clientSubscriptions map[uint64]*ChainEventSubscription
+
+	// watchedSplice is the txid of the pending splice transaction whose
+	// inputs are being watched for double spends.
+	watchedSplice chainhash.Hash
 }
 
 // newChainWatcher returns a new instance of a chainWatcher for a channel given
@@ -333,9 +397,210 @@ func (c *chainWatcher) Start() error {
 	c.wg.Add(1)
 	go c.closeObserver(spendNtfn)
 
+	// If the channel was being spliced when we went down, we continue to
+	// watch the inputs of the splice transaction.
+	return c.WatchSplice()
+}
+
+// WatchSplice watches the inputs of the pending splice transaction of the
+// channel, except for the funding output, which is watched by the
+// closeObserver. If any of them is spent by another transaction, the splice
+// is aborted. It's a no-op if the channel isn't being spliced, or if the
+// splice is already watched.
+func (c *chainWatcher) WatchSplice() error {
+	splice, err := c.cfg.chanState.PendingSplice()
+	switch {
+	case errors.Is(err, channeldb.ErrNoPendingSplice):
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	spliceTxid := splice.FundingTxn.TxHash()
+
+	c.Lock()
+	if c.watchedSplice == spliceTxid {
+		c.Unlock()
+		return nil
+	}
+	c.watchedSplice = spliceTxid
+	c.Unlock()
+
+	for i, txIn := range splice.FundingTxn.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		if outpoint == c.cfg.chanState.FundingOutpoint {
+			continue
+		}
+
+		var pkScript []byte
+		if i < len(splice.InputScripts) {
+			pkScript = splice.InputScripts[i]
+		}
+
+		spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
+			&outpoint, pkScript, splice.BroadcastHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		c.wg.Add(1)
+		go c.spliceInputObserver(spendNtfn, spliceTxid)
+	}
+
 	return nil
 }
 
+// spliceInputObserver waits for the spend of an input of the splice
+// transaction with the passed txid. If the input is spent by another
+// transaction, the splice is aborted once that transaction has reached a reorg
+// safe depth.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *chainWatcher) spliceInputObserver(spendNtfn *chainntnfs.SpendEvent,
+	spliceTxid chainhash.Hash) {
+
+	defer c.wg.Done()
+	defer spendNtfn.Cancel()
+
+	for {
+		var spend *chainntnfs.SpendDetail
+		select {
+		case s, ok := <-spendNtfn.Spend:
+			if !ok {
+				return
+			}
+			spend = s
+
+		case <-c.quit:
+			return
+		}
+
+		// If the input is spent by the splice transaction, the close
+		// observer takes over, which watches the inputs again if the
+		// splice transaction is reorged out.
+		if *spend.SpenderTxHash == spliceTxid {
+			return
+		}
+
+		// The splice may have been replaced or completed in the
+		// meantime.
+		splice, err := c.cfg.chanState.PendingSplice()
+		switch {
+		case errors.Is(err, channeldb.ErrNoPendingSplice):
+			return
+
+		case err != nil:
+			log.Errorf("Unable to fetch pending splice of "+
+				"ChannelPoint(%v): %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return
+		}
+
+		if splice.FundingTxn.TxHash() != spliceTxid {
+			return
+		}
+
+		log.Warnf("Input of splice tx %v of ChannelPoint(%v) double "+
+			"spent by %v, waiting for %v confirmations",
+			spliceTxid, c.cfg.chanState.FundingOutpoint,
+			spend.SpenderTxHash, spliceConfDepth)
+
+		var pkScript []byte
+		if len(spend.SpendingTx.TxOut) > 0 {
+			pkScript = spend.SpendingTx.TxOut[0].PkScript
+		}
+		event, err := c.waitForSpliceDepth(
+			spendNtfn.Reorg, *spend.SpenderTxHash, pkScript,
+			uint32(spend.SpendingHeight), nil,
+		)
+		if err != nil {
+			log.Errorf("Unable to wait for double spend of splice "+
+				"tx %v: %v", spliceTxid, err)
+			return
+		}
+
+		switch event {
+		case spliceEventExit:
+			return
+
+		// If the double spend is reorged out, the splice may still
+		// confirm, so we'll wait for the next spend of the input.
+		case spliceEventReorg:
+			log.Infof("Double spend %v of splice tx %v reorged out",
+				spend.SpenderTxHash, spliceTxid)
+			continue
+		}
+
+		break
+	}
+
+	log.Warnf("Aborting splice tx %v of ChannelPoint(%v)", spliceTxid,
+		c.cfg.chanState.FundingOutpoint)
+
+	if c.cfg.abortSplice == nil {
+		log.Errorf("Unable to abort splice of ChannelPoint(%v)",
+			c.cfg.chanState.FundingOutpoint)
+		return
+	}
+
+	if err := c.cfg.abortSplice(); err != nil {
+		log.Errorf("Unable to abort splice of ChannelPoint(%v): %v",
+			c.cfg.chanState.FundingOutpoint, err)
+		return
+	}
+
+	c.Lock()
+	c.watchedSplice = chainhash.Hash{}
+	c.Unlock()
+}
+
+// waitForSpliceDepth waits until the transaction with the passed txid has
+// reached spliceConfDepth. It returns early if the transaction is reorged out,
+// which is signaled over the passed reorg channel, or if the optional funding
+// spend channel delivers a spend of the new funding output of the splice.
+func (c *chainWatcher) waitForSpliceDepth(reorg <-chan struct{},
+	txid chainhash.Hash, pkScript []byte, heightHint uint32,
+	fundingSpend <-chan *chainntnfs.SpendDetail) (spliceEvent, error) {
+
+	confNtfn, err := c.cfg.notifier.RegisterConfirmationsNtfn(
+		&txid, pkScript, spliceConfDepth, heightHint,
+	)
+	if err != nil {
+		return spliceEventExit, err
+	}
+	defer confNtfn.Cancel()
+
+	select {
+	case _, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return spliceEventExit, nil
+		}
+
+		return spliceEventDepth, nil
+
+	case <-reorg:
+		return spliceEventReorg, nil
+
+	case _, ok := <-fundingSpend:
+		if !ok {
+			return spliceEventExit, nil
+		}
+
+		return spliceEventFundingSpent, nil
+
+	case <-c.quit:
+		return spliceEventExit, nil
+	}
+}
+
+// isSpliceLocked returns true if the pending splice transaction of the channel
+// has reached spliceConfDepth.
+func (c *chainWatcher) isSpliceLocked() bool {
+	return atomic.LoadInt32(&c.spliceLocked) == 1
+}
+
 // Stop signals the close observer to gracefully exit.
 func (c *chainWatcher) Stop() error {
 	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
@@ -409,22 +674,32 @@ func (c *chainWatcher) handleUnknownLocalState(
 	}
 	commitPoint := input.ComputeCommitmentPoint(commitSecret[:])
 
+	// The state may predate a dynamic commitment upgrade of the channel,
+	// in which case its scripts are derived with the parameters that were
+	// in effect at the time.
+	chanState, err := c.cfg.chanState.ChannelAtHeight(
+		true, broadcastStateNum,
+	)
+	if err != nil {
+		return false, err
+	}
+
 	// Now that we have the commit point, we'll derive the tweaked local
 	// and remote keys for this state. We use our point as only we can
 	// revoke our own commitment.
 	commitKeyRing := lnwallet.DeriveCommitmentKeys(
-		commitPoint, true, c.cfg.chanState.ChanType,
-		&c.cfg.chanState.LocalChanCfg, &c.cfg.chanState.RemoteChanCfg,
+		commitPoint, true, chanState.ChanType,
+		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 	)
 
 	// With the keys derived, we'll construct the remote script that'll be
 	// present if they have a non-dust balance on the commitment.
 	var leaseExpiry uint32
-	if c.cfg.chanState.ChanType.HasLeaseExpiration() {
-		leaseExpiry = c.cfg.chanState.ThawHeight
+	if chanState.ChanType.HasLeaseExpiration() {
+		leaseExpiry = chanState.ThawHeight
 	}
 	remoteScript, _, err := lnwallet.CommitScriptToRemote(
-		c.cfg.chanState.ChanType, c.cfg.chanState.IsInitiator,
+		chanState.ChanType, chanState.IsInitiator,
 		commitKeyRing.ToRemoteKey, leaseExpiry,
 	)
 	if err != nil {
@@ -435,9 +710,9 @@ func (c *chainWatcher) handleUnknownLocalState(
 	// the remote party allowing them to claim this output before the CSV
 	// delay if we breach.
 	localScript, err := lnwallet.CommitScriptToSelf(
-		c.cfg.chanState.ChanType, c.cfg.chanState.IsInitiator,
+		chanState.ChanType, chanState.IsInitiator,
 		commitKeyRing.ToLocalKey, commitKeyRing.RevocationKey,
-		uint32(c.cfg.chanState.LocalChanCfg.CsvDelay), leaseExpiry,
+		uint32(chanState.LocalChanCfg.CsvDelay), leaseExpiry,
 	)
 	if err != nil {
 		return false, err
@@ -630,6 +905,20 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// Until the pending splice of the channel confirms, both the
+		// splice transaction and the commitments that spend the
+		// current funding output may confirm. If it's the splice,
+		// the channel continues with the new funding output.
+		commitSpend, err := c.handleSplice(spendNtfn, commitSpend)
+		if err != nil {
+			log.Errorf("Unable to handle splice: %v", err)
+			return
+		}
+
+		if commitSpend == nil {
+			return
+		}
+
 		// Otherwise, the remote party might have broadcast a prior
 		// revoked state...!!!
 		commitTxBroadcast := commitSpend.SpendingTx
@@ -743,6 +1032,152 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
+// handleSplice checks whether the passed spend is the pending splice
+// transaction of the channel. If so, the splice is completed once the splice
+// transaction has reached a reorg safe depth. If it's reorged out before, we
+// wait for the next spend of the funding output. The spend that must be
+// handled as a close of the channel is returned, which is nil if the splice
+// has been completed or the watcher is exiting.
+func (c *chainWatcher) handleSplice(spendNtfn *chainntnfs.SpendEvent,
+	commitSpend *chainntnfs.SpendDetail) (*chainntnfs.SpendDetail, error) {
+
+	for {
+		splice, err := c.cfg.chanState.PendingSplice()
+		switch {
+		case errors.Is(err, channeldb.ErrNoPendingSplice):
+			return commitSpend, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		if splice.FundingTxn.TxHash() != *commitSpend.SpenderTxHash {
+			return commitSpend, nil
+		}
+
+		reorged, err := c.awaitSplice(spendNtfn, commitSpend, splice)
+		if err != nil || !reorged {
+			return nil, err
+		}
+
+		// The inputs of the splice transaction are watched again, as
+		// they may be double spent now.
+		c.Lock()
+		c.watchedSplice = chainhash.Hash{}
+		c.Unlock()
+
+		if err := c.WatchSplice(); err != nil {
+			return nil, err
+		}
+
+		select {
+		case spend, ok := <-spendNtfn.Spend:
+			if !ok {
+				return nil, nil
+			}
+			commitSpend = spend
+
+		case <-c.quit:
+			return nil, nil
+		}
+	}
+}
+
+// awaitSplice waits until the confirmed splice transaction of the channel has
+// reached spliceConfDepth, and completes the splice once the channel has been
+// drained. If the new funding output is spent before, the splice is completed
+// right away. It returns true if the splice transaction was reorged out
+// instead.
+func (c *chainWatcher) awaitSplice(spendNtfn *chainntnfs.SpendEvent,
+	commitSpend *chainntnfs.SpendDetail,
+	splice *channeldb.ChannelSplice) (bool, error) {
+
+	chanPoint := c.cfg.chanState.FundingOutpoint
+	spliceTxid := splice.FundingTxn.TxHash()
+	pkScript := splice.FundingTxn.TxOut[splice.FundingOutpoint.Index].PkScript
+	heightHint := uint32(commitSpend.SpendingHeight)
+
+	log.Infof("Splice of ChannelPoint(%v) confirmed, waiting for %v "+
+		"confirmations of new ChannelPoint(%v)", chanPoint,
+		spliceConfDepth, splice.FundingOutpoint)
+
+	if c.cfg.completeSplice == nil {
+		return false, fmt.Errorf("unable to complete splice of "+
+			"ChannelPoint(%v)", chanPoint)
+	}
+
+	// The commitments that spend the new funding output are valid as soon
+	// as the splice transaction has confirmed, so we'll watch for their
+	// spend until the splice is completed.
+	fundingSpend, err := c.cfg.notifier.RegisterSpendNtfn(
+		&splice.FundingOutpoint, pkScript, heightHint,
+	)
+	if err != nil {
+		return false, err
+	}
+	defer fundingSpend.Cancel()
+
+	event, err := c.waitForSpliceDepth(
+		spendNtfn.Reorg, spliceTxid, pkScript, heightHint,
+		fundingSpend.Spend,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	switch event {
+	case spliceEventExit:
+		return false, nil
+
+	case spliceEventReorg:
+		log.Warnf("Splice tx %v of ChannelPoint(%v) reorged out",
+			spliceTxid, chanPoint)
+		return true, nil
+
+	case spliceEventFundingSpent:
+		log.Warnf("New ChannelPoint(%v) of splice spent before the "+
+			"splice was locked, completing splice of "+
+			"ChannelPoint(%v)", splice.FundingOutpoint, chanPoint)
+		return false, c.cfg.completeSplice(true)
+	}
+
+	log.Infof("Splice of ChannelPoint(%v) locked, draining channel",
+		chanPoint)
+	atomic.StoreInt32(&c.spliceLocked, 1)
+
+	ticker := time.NewTicker(spliceDrainInterval)
+	defer ticker.Stop()
+
+	for {
+		err := c.cfg.completeSplice(false)
+		switch {
+		case err == nil:
+			return false, nil
+
+		case !errors.Is(err, ErrSpliceNotDrained):
+			return false, err
+		}
+
+		select {
+		case <-ticker.C:
+
+		case _, ok := <-fundingSpend.Spend:
+			if !ok {
+				return false, nil
+			}
+
+			log.Warnf("New ChannelPoint(%v) of splice spent while "+
+				"draining, completing splice of "+
+				"ChannelPoint(%v)", splice.FundingOutpoint,
+				chanPoint)
+			return false, c.cfg.completeSplice(true)
+
+		case <-c.quit:
+			return false, nil
+		}
+	}
+}
+
 // handleKnownLocalState checks whether the passed spend is a local state that
 // is known to us (the current state). If so we will act on this state using
 // the passed chainSet. If this is not a known local state, false is returned.
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -599,3 +602,457 @@ func TestChainWatcherLocalForceCloseDetect(t *testing.T) {
 		})
 	}
 }
+
+// TestChainWatcherSplice tests that the chain watcher completes the pending
+// splice of a channel once the splice transaction that spends the funding
+// output has reached a reorg safe depth.
+func TestChainWatcherSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	// Alice and Bob sign a splice that moves the channel to a new funding
+	// output, which Alice stores.
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(
+		aliceChannel.State().Capacity,
+	))
+	markTestSplicePending(t, aliceChannel, bobChannel, spliceTx)
+
+	aliceNotifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	spliceCompleted := make(chan bool, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceChannel.State(),
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		completeSplice: func(force bool) error {
+			spliceCompleted <- force
+			return nil
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	spliceTxHash := spliceTx.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &spliceTxHash,
+		SpendingTx:    spliceTx,
+	}
+
+	// The splice isn't completed before the splice transaction has
+	// reached a reorg safe depth.
+	select {
+	case <-spliceCompleted:
+		t.Fatalf("splice completed before reaching depth")
+	case <-time.After(time.Millisecond * 100):
+	}
+	require.False(t, aliceChainWatcher.isSpliceLocked())
+
+	aliceNotifier.ConfChan <- &chainntnfs.TxConfirmation{}
+
+	select {
+	case force := <-spliceCompleted:
+		require.False(t, force)
+	case <-time.After(time.Second * 15):
+		t.Fatalf("splice wasn't completed")
+	}
+	require.True(t, aliceChainWatcher.isSpliceLocked())
+
+	// The splice isn't dispatched as a closure of the channel.
+	select {
+	case <-chanEvents.CooperativeClosure:
+		t.Fatalf("unexpected cooperative close")
+	case <-chanEvents.RemoteUnilateralClosure:
+		t.Fatalf("unexpected remote unilateral close")
+	default:
+	}
+}
+
+// markTestSplicePending lets Alice and Bob sign the splice commitments for
+// the passed splice transaction, which creates the first output of the
+// transaction. The splice is stored with Alice's channel.
+func markTestSplicePending(t *testing.T, aliceChannel,
+	bobChannel *lnwallet.LightningChannel, spliceTx *wire.MsgTx) {
+
+	t.Helper()
+
+	fundingOutpoint := wire.OutPoint{Hash: spliceTx.TxHash()}
+
+	aliceSplice, err := aliceChannel.NewSplice(fundingOutpoint, 0, 0)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSplice(fundingOutpoint, 0, 0)
+	require.NoError(t, err)
+
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobSplice, aliceSig,
+	))
+
+	splice, err := aliceChannel.ChannelSplice(aliceSplice, spliceTx, 100)
+	require.NoError(t, err)
+
+	splice.InputScripts = make([][]byte, len(spliceTx.TxIn))
+	for i := range splice.InputScripts {
+		splice.InputScripts[i] = []byte{txscript.OP_0, 0x20}
+	}
+
+	require.NoError(t, aliceChannel.MarkSplicePending(splice))
+}
+
+// outpointSpendNotifier is a mock chain notifier that delivers the spend and
+// the reorg of each outpoint, and the confirmation of each transaction, over
+// its own channel.
+type outpointSpendNotifier struct {
+	*mock.ChainNotifier
+
+	mu     sync.Mutex
+	spends map[wire.OutPoint]chan *chainntnfs.SpendDetail
+	reorgs map[wire.OutPoint]chan struct{}
+	confs  map[chainhash.Hash]chan *chainntnfs.TxConfirmation
+}
+
+// newOutpointSpendNotifier creates a new outpointSpendNotifier.
+func newOutpointSpendNotifier() *outpointSpendNotifier {
+	return &outpointSpendNotifier{
+		ChainNotifier: &mock.ChainNotifier{
+			EpochChan: make(chan *chainntnfs.BlockEpoch),
+		},
+		spends: make(map[wire.OutPoint]chan *chainntnfs.SpendDetail),
+		reorgs: make(map[wire.OutPoint]chan struct{}),
+		confs: make(
+			map[chainhash.Hash]chan *chainntnfs.TxConfirmation,
+		),
+	}
+}
+
+// spendChan returns the channel over which the spend of the outpoint is
+// delivered.
+func (n *outpointSpendNotifier) spendChan(
+	outpoint wire.OutPoint) chan *chainntnfs.SpendDetail {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	spendChan, ok := n.spends[outpoint]
+	if !ok {
+		spendChan = make(chan *chainntnfs.SpendDetail)
+		n.spends[outpoint] = spendChan
+	}
+
+	return spendChan
+}
+
+// reorgChan returns the channel over which the reorg of the spend of the
+// outpoint is delivered.
+func (n *outpointSpendNotifier) reorgChan(
+	outpoint wire.OutPoint) chan struct{} {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	reorgChan, ok := n.reorgs[outpoint]
+	if !ok {
+		reorgChan = make(chan struct{})
+		n.reorgs[outpoint] = reorgChan
+	}
+
+	return reorgChan
+}
+
+// confChan returns the channel over which the confirmation of the transaction
+// is delivered.
+func (n *outpointSpendNotifier) confChan(
+	txid chainhash.Hash) chan *chainntnfs.TxConfirmation {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	confChan, ok := n.confs[txid]
+	if !ok {
+		confChan = make(chan *chainntnfs.TxConfirmation)
+		n.confs[txid] = confChan
+	}
+
+	return confChan
+}
+
+// RegisterSpendNtfn returns a SpendEvent that delivers the spend and the
+// reorg of the outpoint.
+func (n *outpointSpendNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	_ []byte, _ uint32) (*chainntnfs.SpendEvent, error) {
+
+	return &chainntnfs.SpendEvent{
+		Spend:  n.spendChan(*outpoint),
+		Reorg:  n.reorgChan(*outpoint),
+		Cancel: func() {},
+	}, nil
+}
+
+// RegisterConfirmationsNtfn returns a ConfirmationEvent that delivers the
+// confirmation of the transaction.
+func (n *outpointSpendNotifier) RegisterConfirmationsNtfn(
+	txid *chainhash.Hash, _ []byte, _, _ uint32,
+	_ ...chainntnfs.NotifierOption) (*chainntnfs.ConfirmationEvent,
+	error) {
+
+	return &chainntnfs.ConfirmationEvent{
+		Confirmed: n.confChan(*txid),
+		Cancel:    func() {},
+	}, nil
+}
+
+// TestChainWatcherSpliceDrain tests that the chain watcher retries to complete
+// a splice that has reached a reorg safe depth while the channel is drained,
+// and that it forces the splice once the new funding output is spent.
+func TestChainWatcherSpliceDrain(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(
+		aliceChannel.State().Capacity,
+	))
+	markTestSplicePending(t, aliceChannel, bobChannel, spliceTx)
+
+	aliceNotifier := newOutpointSpendNotifier()
+	spliceCompleted := make(chan bool, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceChannel.State(),
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		completeSplice: func(force bool) error {
+			spliceCompleted <- force
+			if !force {
+				return ErrSpliceNotDrained
+			}
+
+			return nil
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	spliceTxHash := spliceTx.TxHash()
+	fundingSpend := aliceNotifier.spendChan(*aliceChannel.ChannelPoint())
+	fundingSpend <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &spliceTxHash,
+		SpendingTx:    spliceTx,
+	}
+	aliceNotifier.confChan(spliceTxHash) <- &chainntnfs.TxConfirmation{}
+
+	// The channel isn't drained yet, so the splice isn't completed.
+	select {
+	case force := <-spliceCompleted:
+		require.False(t, force)
+	case <-time.After(time.Second * 15):
+		t.Fatalf("splice completion wasn't attempted")
+	}
+	require.True(t, aliceChainWatcher.isSpliceLocked())
+
+	// Once the new funding output is spent, the splice is forced, so that
+	// the spend is handled by the watcher of the new funding output.
+	newFundingSpend := aliceNotifier.spendChan(
+		wire.OutPoint{Hash: spliceTxHash},
+	)
+	newFundingSpend <- &chainntnfs.SpendDetail{}
+
+	select {
+	case force := <-spliceCompleted:
+		require.True(t, force)
+	case <-time.After(time.Second * 15):
+		t.Fatalf("splice wasn't forced")
+	}
+}
+
+// TestChainWatcherSpliceReorg tests that the chain watcher keeps watching the
+// funding output of a channel if the splice transaction is reorged out before
+// it reaches a reorg safe depth.
+func TestChainWatcherSpliceReorg(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(
+		aliceChannel.State().Capacity,
+	))
+	markTestSplicePending(t, aliceChannel, bobChannel, spliceTx)
+
+	aliceNotifier := newOutpointSpendNotifier()
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceChannel.State(),
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		completeSplice: func(bool) error {
+			t.Errorf("unexpected splice completion")
+			return nil
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	chanPoint := *aliceChannel.ChannelPoint()
+	spliceTxHash := spliceTx.TxHash()
+	fundingSpend := aliceNotifier.spendChan(chanPoint)
+	fundingSpend <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &spliceTxHash,
+		SpendingTx:    spliceTx,
+	}
+
+	// The splice transaction is reorged out before reaching depth.
+	aliceNotifier.reorgChan(chanPoint) <- struct{}{}
+	require.False(t, aliceChainWatcher.isSpliceLocked())
+
+	// Bob's commitment confirms instead, which is detected as a remote
+	// force close.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	fundingSpend <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("unable to receive remote unilateral close")
+	}
+}
+
+// TestChainWatcherSpliceDoubleSpend tests that the chain watcher aborts the
+// pending splice of a channel once another input of the splice transaction is
+// spent by a different transaction that has reached a reorg safe depth, and
+// that it keeps watching the funding output afterwards.
+func TestChainWatcherSpliceDoubleSpend(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	// The splice adds a wallet input of Alice to the channel.
+	walletInput := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2}
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(&walletInput, nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(
+		aliceChannel.State().Capacity + 100_000,
+	))
+	markTestSplicePending(t, aliceChannel, bobChannel, spliceTx)
+
+	aliceNotifier := newOutpointSpendNotifier()
+	chanState := aliceChannel.State()
+	spliceAborted := make(chan struct{}, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           chanState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		completeSplice: func(bool) error {
+			t.Errorf("unexpected splice completion")
+			return nil
+		},
+		abortSplice: func() error {
+			defer func() {
+				spliceAborted <- struct{}{}
+			}()
+
+			return chanState.AbortSplice()
+		},
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	// Watching the splice again doesn't register the inputs twice.
+	require.NoError(t, aliceChainWatcher.WatchSplice())
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// The wallet input is spent by another transaction, so the splice
+	// can never confirm.
+	doubleSpendTx := wire.NewMsgTx(2)
+	doubleSpendTx.AddTxIn(wire.NewTxIn(&walletInput, nil, nil))
+	doubleSpendHash := doubleSpendTx.TxHash()
+	doubleSpend := &chainntnfs.SpendDetail{
+		SpentOutPoint: &walletInput,
+		SpenderTxHash: &doubleSpendHash,
+		SpendingTx:    doubleSpendTx,
+	}
+	aliceNotifier.spendChan(walletInput) <- doubleSpend
+
+	// If the double spend is reorged out before reaching depth, the splice
+	// may still confirm, so it isn't aborted.
+	aliceNotifier.reorgChan(walletInput) <- struct{}{}
+	select {
+	case <-spliceAborted:
+		t.Fatalf("splice aborted before double spend reached depth")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// Once the next double spend reaches depth, the splice is aborted.
+	aliceNotifier.spendChan(walletInput) <- doubleSpend
+	aliceNotifier.confChan(doubleSpendHash) <- &chainntnfs.TxConfirmation{}
+
+	select {
+	case <-spliceAborted:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("splice wasn't aborted")
+	}
+
+	_, err = chanState.PendingSplice()
+	require.ErrorIs(t, err, channeldb.ErrNoPendingSplice)
+	require.False(t, chanState.HasChanStatus(
+		channeldb.ChanStatusPendingSplice,
+	))
+
+	// The channel is still watched for closes, so a remote force close
+	// is detected.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	fundingSpend := aliceNotifier.spendChan(*aliceChannel.ChannelPoint())
+	fundingSpend <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("unable to receive remote unilateral close")
+	}
+}
@@ -170,6 +170,12 @@ type ChannelArbitratorConfig struct {
 	// additional information required for proper contract resolution.
 	FetchHistoricalChannel func() (*channeldb.OpenChannel, error)
 
+	// FindOutgoingHTLCDeadline returns the height by which an outgoing
+	// HTLC must be timed out on chain, which is the expiry height of the
+	// incoming HTLC it was forwarded from. False is returned if there's no
+	// such incoming HTLC.
+	FindOutgoingHTLCDeadline func(htlc channeldb.HTLC) (int32, bool)
+
 	ChainArbitratorConfig
 }
 
@@ -1312,7 +1318,11 @@ func (c *ChannelArbitrator) sweepAnchors(anchors *lnwallet.AnchorResolutions,
 
 		// Create a force flag that's used to indicate whether we
 		// should force sweeping this anchor.
-		var force bool
+		var (
+			force          bool
+			deadlineHeight int32
+			budget         btcutil.Amount
+		)
 
 		// Check the deadline against the default value. If it's less
 		// than the default value of 144, it means there is a deadline
@@ -1322,6 +1332,17 @@ func (c *ChannelArbitrator) sweepAnchors(anchors *lnwallet.AnchorResolutions,
 			// anchor will be swept even if it isn't economical
 			// purely based on the anchor value.
 			force = true
+
+			// The fee rate of the anchor sweep is raised toward
+			// its budget until the deadline, which is derived from
+			// the value of the HTLCs that are at stake.
+			deadlineHeight = int32(heightHint + deadline)
+
+			value, err := c.findCommitmentValueAtStake(htlcs)
+			if err != nil {
+				return err
+			}
+			budget = calculateBudget(value)
 		}
 
 		log.Debugf("ChannelArbitrator(%v): pre-confirmation sweep of "+
@@ -1348,6 +1369,7 @@ func (c *ChannelArbitrator) sweepAnchors(anchors *lnwallet.AnchorResolutions,
 			&input.TxInfo{
 				Fee:    anchor.CommitFee,
 				Weight: anchor.CommitWeight,
+				Tx:     anchor.CommitTx,
 			},
 		)
 
@@ -1364,6 +1386,9 @@ func (c *ChannelArbitrator) sweepAnchors(anchors *lnwallet.AnchorResolutions,
 				},
 				Force:          force,
 				ExclusiveGroup: &exclusiveGroup,
+				DeadlineHeight: deadlineHeight,
+				Budget:         budget,
+				ChanPoint:      &c.cfg.ChanPoint,
 			},
 		)
 		if err != nil {
@@ -1506,6 +1531,42 @@ func (c *ChannelArbitrator) findCommitmentDeadline(heightHint uint32,
 	return deadline, nil
 }
 
+// findCommitmentValueAtStake returns the total value of the HTLCs that we stand
+// to lose if a commitment transaction doesn't confirm before its deadline,
+// which are the non-dust outgoing HTLCs and the non-dust incoming HTLCs that
+// we have the preimage for.
+func (c *ChannelArbitrator) findCommitmentValueAtStake(
+	htlcs htlcSet) (btcutil.Amount, error) {
+
+	var value btcutil.Amount
+	for _, htlc := range htlcs.outgoingHTLCs {
+		if htlc.OutputIndex < 0 {
+			continue
+		}
+
+		value += htlc.Amt.ToSatoshis()
+	}
+
+	for _, htlc := range htlcs.incomingHTLCs {
+		if htlc.OutputIndex < 0 {
+			continue
+		}
+
+		preimageAvailable, err := c.isPreimageAvailable(htlc.RHash)
+		if err != nil {
+			return 0, err
+		}
+
+		if !preimageAvailable {
+			continue
+		}
+
+		value += htlc.Amt.ToSatoshis()
+	}
+
+	return value, nil
+}
+
 // launchResolvers updates the activeResolvers list and starts the resolvers.
 func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 	c.activeResolversLock.Lock()
@@ -2655,6 +2716,27 @@ func (c *ChannelArbitrator) updateActiveHTLCs() {
 	}
 }
 
+// findIncomingHTLCExpiry returns the expiry height of the incoming HTLC with
+// the given index and payment hash on any of the valid commitments. False is
+// returned if there's no such HTLC.
+func (c *ChannelArbitrator) findIncomingHTLCExpiry(htlcIndex uint64,
+	rHash [32]byte) (int32, bool) {
+
+	c.unmergedMtx.RLock()
+	defer c.unmergedMtx.RUnlock()
+
+	for _, htlcs := range c.unmergedSet {
+		htlc, ok := htlcs.incomingHTLCs[htlcIndex]
+		if !ok || htlc.RHash != rHash {
+			continue
+		}
+
+		return int32(htlc.RefundTimeout), true
+	}
+
+	return 0, false
+}
+
 // channelAttendant is the primary goroutine that acts at the judicial
 // arbitrator between our channel state, the remote channel peer, and the
 // blockchain (Our judge). This goroutine will ensure that we faithfully execute
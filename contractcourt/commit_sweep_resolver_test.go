@@ -110,6 +110,8 @@ type mockSweeper struct {
 	createSweepTxChan chan *wire.MsgTx
 
 	deadlines []int
+
+	deadlineHeights []int32
 }
 
 func newMockSweeper() *mockSweeper {
@@ -131,6 +133,7 @@ func (s *mockSweeper) SweepInput(input input.Input, params sweep.Params) (
 	if params.Fee.ConfTarget != 0 {
 		s.deadlines = append(s.deadlines, int(params.Fee.ConfTarget))
 	}
+	s.deadlineHeights = append(s.deadlineHeights, params.DeadlineHeight)
 
 	result := make(chan sweep.Result, 1)
 	result <- sweep.Result{
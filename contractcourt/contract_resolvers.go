@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btclog"
 	"github.com/lightningnetwork/lnd/build"
@@ -24,8 +25,18 @@ const (
 	// secondLevelConfTarget is the confirmation target we'll use when
 	// adding fees to our second-level HTLC transactions.
 	secondLevelConfTarget = 6
+
+	// sweepBudgetRatio is the share of the value at stake that we're
+	// willing to pay in fees to sweep an output before its deadline.
+	sweepBudgetRatio = 0.5
 )
 
+// calculateBudget returns the maximum fee that we're willing to pay to sweep
+// an output that protects the given value.
+func calculateBudget(value btcutil.Amount) btcutil.Amount {
+	return btcutil.Amount(float64(value) * sweepBudgetRatio)
+}
+
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Bitcoin
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
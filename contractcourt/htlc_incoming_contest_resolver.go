@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -287,7 +288,12 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		hodlChan       <-chan interface{}
 		witnessUpdates <-chan lntypes.Preimage
 	)
-	if payload.FwdInfo.NextHop == hop.Exit {
+	// Htlcs that carry a trampoline onion don't pay to one of our
+	// invoices. Like forwarded htlcs, they are settled with the preimage
+	// that the recipient reveals to our outgoing payment, so we treat
+	// them the same way below.
+	isTrampoline := payload.TrampolineOnion() != nil
+	if payload.FwdInfo.NextHop == hop.Exit && !isTrampoline {
 		// Create a buffered hodl chan to prevent deadlock.
 		hodlQueue := queue.NewConcurrentQueue(10)
 		hodlQueue.Start()
@@ -520,9 +526,20 @@ func (h *htlcIncomingContestResolver) Supplement(htlc channeldb.HTLC) {
 func (h *htlcIncomingContestResolver) decodePayload() (*hop.Payload,
 	[]byte, error) {
 
+	// If the htlc was received within a blinded route, the blinding point
+	// from update_add_htlc is required to decrypt the onion.
+	blindingPoint, err := lnwire.ExtractBlindingPoint(h.htlc.ExtraData)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	onionReader := bytes.NewReader(h.htlc.OnionBlob[:])
 	iterator, err := h.OnionProcessor.ReconstructHopIterator(
-		onionReader, h.htlc.RHash[:],
+		onionReader, h.htlc.RHash[:], hop.ReconstructBlindingInfo{
+			BlindingPoint:  (*btcec.PublicKey)(blindingPoint),
+			IncomingAmount: h.htlc.Amt,
+			IncomingCltv:   h.htlc.RefundTimeout,
+		},
 	)
 	if err != nil {
 		return nil, nil, err
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -14,10 +15,13 @@ import (
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnmock"
+	"github.com/lightningnetwork/lnd/lntest/channels"
 	"github.com/lightningnetwork/lnd/lntest/mock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -259,12 +263,45 @@ func TestHtlcIncomingResolverExitCancelHodl(t *testing.T) {
 	ctx.waitForResult(false)
 }
 
+// TestHtlcIncomingResolverTrampolineSuccess tests that an incoming htlc that
+// carries a trampoline onion is claimed with the preimage that our outgoing
+// payment to the recipient learns, rather than being looked up in the invoice
+// registry.
+func TestHtlcIncomingResolverTrampolineSuccess(t *testing.T) {
+	t.Parallel()
+	defer timeout()()
+
+	ctx := newIncomingResolverTestContext(t, true)
+	ctx.onionProcessor.trampoline = true
+	ctx.resolve()
+
+	// Simulate a new block coming in. HTLC is not yet expired.
+	ctx.notifyEpoch(testInitialBlockHeight + 1)
+
+	// The outgoing payment succeeds while the incoming htlc is on chain.
+	ctx.witnessBeacon.preImageUpdates <- testResPreimage
+	ctx.waitForResult(true)
+
+	// The registry doesn't know about the htlc, so it mustn't have been
+	// asked to resolve it.
+	select {
+	case <-ctx.registry.notifyChan:
+		t.Fatal("unexpected registry notification")
+	default:
+	}
+}
+
 type mockHopIterator struct {
-	isExit bool
+	isExit     bool
+	trampoline bool
 	hop.Iterator
 }
 
 func (h *mockHopIterator) HopPayload() (*hop.Payload, error) {
+	if h.trampoline {
+		return trampolinePayload()
+	}
+
 	var nextAddress [8]byte
 	if !h.isExit {
 		nextAddress = [8]byte{0x01}
@@ -279,17 +316,81 @@ func (h *mockHopIterator) HopPayload() (*hop.Payload, error) {
 	}), nil
 }
 
+// trampolinePayload returns the final hop payload of an htlc that carries a
+// trampoline onion.
+func trampolinePayload() (*hop.Payload, error) {
+	_, recipient := btcec.PrivKeyFromBytes(channels.BobsPrivKey)
+	trampolinePayload, err := record.EncodeTrampolinePayload(
+		&record.TrampolinePayload{
+			AmtToForward: 1_000,
+			OutgoingCltv: 100,
+			NextNode:     recipient,
+			MPP:          record.NewMPP(1_000, [32]byte{1}),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, trampolineNode := btcec.PrivKeyFromBytes(channels.AlicesPrivKey)
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := hop.NewTrampolineOnion(
+		[]hop.TrampolineHop{{
+			NodeKey: trampolineNode,
+			Payload: trampolinePayload,
+		}}, sessionKey, testResHash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var onion bytes.Buffer
+	if err := packet.Encode(&onion); err != nil {
+		return nil, err
+	}
+	trampolineOnion := onion.Bytes()
+
+	var (
+		amt  = uint64(testHtlcAmount)
+		cltv = uint32(testHtlcExpiry)
+		mpp  = record.NewMPP(
+			lnwire.MilliSatoshi(testHtlcAmount), [32]byte{2},
+		)
+	)
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		record.NewTrampolineOnionRecord(&trampolineOnion),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return hop.NewPayloadFromReader(&b, true)
+}
+
 func (h *mockHopIterator) EncodeNextHop(w io.Writer) error {
 	return nil
 }
 
 type mockOnionProcessor struct {
 	isExit           bool
+	trampoline       bool
 	offeredOnionBlob []byte
 }
 
-func (o *mockOnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
-	hop.Iterator, error) {
+func (o *mockOnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte,
+	_ hop.ReconstructBlindingInfo) (hop.Iterator, error) {
 
 	data, err := ioutil.ReadAll(r)
 	if err != nil {
@@ -297,7 +398,10 @@ func (o *mockOnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
 	}
 	o.offeredOnionBlob = data
 
-	return &mockHopIterator{isExit: o.isExit}, nil
+	return &mockHopIterator{
+		isExit:     o.isExit,
+		trampoline: o.trampoline,
+	}, nil
 }
 
 type incomingResolverTestContext struct {
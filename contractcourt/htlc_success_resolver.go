@@ -260,12 +260,20 @@ func (h *htlcSuccessResolver) broadcastReSignedSuccessTx() (
 			)
 		}
 
+		// The second-level transaction must confirm before the HTLC
+		// expires, as the remote party can time it out afterwards, so
+		// its fee rate is raised toward its budget until then.
 		_, err := h.Sweeper.SweepInput(
 			&secondLevelInput,
 			sweep.Params{
 				Fee: sweep.FeePreference{
 					ConfTarget: secondLevelConfTarget,
 				},
+				DeadlineHeight: int32(h.htlc.RefundTimeout),
+				Budget: calculateBudget(
+					h.htlc.Amt.ToSatoshis(),
+				),
+				ChanPoint: &h.ChanPoint,
 			},
 		)
 		if err != nil {
@@ -378,6 +386,7 @@ func (h *htlcSuccessResolver) broadcastReSignedSuccessTx() (
 			Fee: sweep.FeePreference{
 				ConfTarget: sweepConfTarget,
 			},
+			ChanPoint: &h.ChanPoint,
 		},
 	)
 	if err != nil {
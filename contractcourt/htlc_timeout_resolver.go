@@ -456,13 +456,27 @@ func (h *htlcTimeoutResolver) sweepSecondLevelTx() error {
 			h.broadcastHeight,
 		))
 	}
+
+	// If the HTLC was forwarded, the second-level transaction must confirm
+	// before the incoming HTLC expires, as the incoming funds are lost
+	// otherwise, so its fee rate is raised toward its budget until then.
+	var deadline int32
+	if h.FindOutgoingHTLCDeadline != nil {
+		deadline, _ = h.FindOutgoingHTLCDeadline(h.htlc)
+	}
+
 	_, err := h.Sweeper.SweepInput(
 		inp,
 		sweep.Params{
 			Fee: sweep.FeePreference{
 				ConfTarget: secondLevelConfTarget,
 			},
-			Force: true,
+			Force:          true,
+			DeadlineHeight: deadline,
+			Budget: calculateBudget(
+				h.htlc.Amt.ToSatoshis(),
+			),
+			ChanPoint: &h.ChanPoint,
 		},
 	)
 	if err != nil {
@@ -678,6 +692,7 @@ func (h *htlcTimeoutResolver) handleCommitSpend(
 				Fee: sweep.FeePreference{
 					ConfTarget: sweepConfTarget,
 				},
+				ChanPoint: &h.ChanPoint,
 			},
 		)
 		if err != nil {
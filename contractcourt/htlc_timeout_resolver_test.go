@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/kvdb"
@@ -1324,3 +1325,154 @@ func testHtlcTimeout(t *testing.T, resolution lnwallet.OutgoingHtlcResolution,
 		_ = runFromCheckpoint(t, ctx, checkpoints[i+1:])
 	}
 }
+
+// TestHtlcTimeoutSecondLevelDeadline tests that the second-level timeout tx of
+// a forwarded HTLC is offered to the sweeper with the expiry height of the
+// incoming HTLC as its deadline.
+func TestHtlcTimeoutSecondLevelDeadline(t *testing.T) {
+	t.Parallel()
+
+	var (
+		incomingScid = lnwire.NewShortChanIDFromInt(1)
+		outgoingScid = lnwire.NewShortChanIDFromInt(2)
+		rHash        = [32]byte{1, 2, 3}
+	)
+
+	incomingHTLC := channeldb.HTLC{
+		RHash:         rHash,
+		RefundTimeout: 500,
+		HtlcIndex:     7,
+		Incoming:      true,
+	}
+	htlcs := newHtlcSet([]channeldb.HTLC{incomingHTLC})
+	incomingArb := NewChannelArbitrator(
+		ChannelArbitratorConfig{
+			ShortChanID: incomingScid,
+		},
+		map[HtlcSetKey]htlcSet{
+			LocalHtlcSet:  htlcs,
+			RemoteHtlcSet: htlcs,
+		},
+		nil,
+	)
+
+	timeoutTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{Index: 2},
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{
+				Value:    123,
+				PkScript: []byte{0xff, 0xff},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		incomingCircuit  *models.CircuitKey
+		outgoingRHash    [32]byte
+		expectedDeadline int32
+	}{
+		{
+			name: "forwarded htlc",
+			incomingCircuit: &models.CircuitKey{
+				ChanID: incomingScid,
+				HtlcID: incomingHTLC.HtlcIndex,
+			},
+			outgoingRHash:    rHash,
+			expectedDeadline: 500,
+		},
+		{
+			name:             "no circuit",
+			outgoingRHash:    rHash,
+			expectedDeadline: 0,
+		},
+		{
+			name: "payment hash mismatch",
+			incomingCircuit: &models.CircuitKey{
+				ChanID: incomingScid,
+				HtlcID: incomingHTLC.HtlcIndex,
+			},
+			outgoingRHash:    [32]byte{4, 5, 6},
+			expectedDeadline: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			outgoingHTLC := channeldb.HTLC{
+				RHash:         tc.outgoingRHash,
+				RefundTimeout: 400,
+				HtlcIndex:     3,
+				Amt:           testHtlcAmt,
+			}
+
+			queryCircuit := func(
+				circuit models.CircuitKey) *models.CircuitKey {
+
+				require.Equal(t, outgoingScid, circuit.ChanID)
+				require.Equal(
+					t, outgoingHTLC.HtlcIndex,
+					circuit.HtlcID,
+				)
+
+				return tc.incomingCircuit
+			}
+			chainArb := &ChainArbitrator{
+				cfg: ChainArbitratorConfig{
+					QueryIncomingCircuit: queryCircuit,
+				},
+				activeChannels: make(
+					map[wire.OutPoint]*ChannelArbitrator,
+				),
+			}
+			chainArb.activeChannels[wire.OutPoint{}] = incomingArb
+
+			findDeadline := func(
+				htlc channeldb.HTLC) (int32, bool) {
+
+				return chainArb.FindOutgoingHTLCDeadline(
+					outgoingScid, htlc,
+				)
+			}
+
+			sweeper := newMockSweeper()
+			arbCfg := ChannelArbitratorConfig{
+				FindOutgoingHTLCDeadline: findDeadline,
+			}
+			arbCfg.Sweeper = sweeper
+			cfg := ResolverConfig{
+				ChannelArbitratorConfig: arbCfg,
+			}
+			resolver := &htlcTimeoutResolver{
+				contractResolverKit: *newContractResolverKit(
+					cfg,
+				),
+				htlc: outgoingHTLC,
+				htlcResolution: lnwallet.OutgoingHtlcResolution{
+					SignedTimeoutTx: timeoutTx,
+					SignDetails: &input.SignDetails{
+						SignDesc: testSignDesc,
+						PeerSig:  testSig,
+					},
+					SweepSignDesc: testSignDesc,
+				},
+			}
+
+			require.NoError(t, resolver.sweepSecondLevelTx())
+
+			<-sweeper.sweptInputs
+			require.Equal(
+				t, []int32{tc.expectedDeadline},
+				sweeper.deadlineHeights,
+			)
+		})
+	}
+}
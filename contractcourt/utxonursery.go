@@ -830,8 +830,9 @@ func (u *UtxoNursery) sweepMatureOutputs(classHeight uint32,
 		local := output
 
 		resultChan, err := u.cfg.SweepInput(&local, sweep.Params{
-			Fee:   feePref,
-			Force: true,
+			Fee:       feePref,
+			Force:     true,
+			ChanPoint: local.OriginChanPoint(),
 		})
 		if err != nil {
 			return err
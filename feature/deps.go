@@ -72,9 +72,18 @@ var deps = depDesc{
 	lnwire.KeysendOptional: {
 		lnwire.TLVOnionPayloadOptional: {},
 	},
+	lnwire.TrampolineRoutingOptional: {
+		lnwire.PaymentAddrOptional: {},
+	},
 	lnwire.ZeroConfOptional: {
 		lnwire.ScidAliasOptional: {},
 	},
+	lnwire.DynamicCommitmentsOptional: {
+		lnwire.QuiescenceOptional: {},
+	},
+	lnwire.SimpleCloseOptional: {
+		lnwire.ShutdownAnySegwitOptional: {},
+	},
 	lnwire.SimpleTaprootChannelsOptionalStaging: {
 		lnwire.AnchorsZeroFeeHtlcTxOptional: {},
 		lnwire.ExplicitChannelTypeOptional:  {},
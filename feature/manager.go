@@ -47,6 +47,10 @@ type Config struct {
 	// payments.
 	NoKeysend bool
 
+	// NoTrampoline unsets any bits signaling support for forwarding
+	// trampoline payments.
+	NoTrampoline bool
+
 	// NoOptionScidAlias unsets any bits signalling support for
 	// option_scid_alias. This also implicitly disables zero-conf channels.
 	NoOptionScidAlias bool
@@ -60,6 +64,30 @@ type Config struct {
 	// segwit witness versions for co-op closes.
 	NoAnySegwit bool
 
+	// NoOnionMessages unsets any bits that signal support for relaying
+	// onion messages.
+	NoOnionMessages bool
+
+	// NoDualFund unsets any bits that signal support for opening channels
+	// with the dual funding protocol.
+	NoDualFund bool
+
+	// NoSplice unsets any bits that signal support for splicing funds
+	// into and out of existing channels.
+	NoSplice bool
+
+	// NoQuiescence unsets any bits that signal support for quiescing
+	// channels.
+	NoQuiescence bool
+
+	// NoDynamicCommitments unsets any bits that signal support for
+	// upgrading existing channels with dynamic commitments.
+	NoDynamicCommitments bool
+
+	// NoSimpleClose unsets any bits that signal support for cooperative
+	// closes with option_simple_close.
+	NoSimpleClose bool
+
 	// CustomFeatures is a set of custom features to advertise in each
 	// set.
 	CustomFeatures map[Set][]lnwire.FeatureBit
@@ -127,6 +155,8 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.AMPRequired)
 			raw.Unset(lnwire.KeysendOptional)
 			raw.Unset(lnwire.KeysendRequired)
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
 		}
 		if cfg.NoStaticRemoteKey {
 			raw.Unset(lnwire.StaticRemoteKeyOptional)
@@ -163,6 +193,10 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.KeysendOptional)
 			raw.Unset(lnwire.KeysendRequired)
 		}
+		if cfg.NoTrampoline {
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
+		}
 		if cfg.NoOptionScidAlias {
 			raw.Unset(lnwire.ScidAliasOptional)
 			raw.Unset(lnwire.ScidAliasRequired)
@@ -175,6 +209,30 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.ShutdownAnySegwitOptional)
 			raw.Unset(lnwire.ShutdownAnySegwitRequired)
 		}
+		if cfg.NoOnionMessages {
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
+		if cfg.NoDualFund {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+		}
+		if cfg.NoSplice {
+			raw.Unset(lnwire.SpliceOptional)
+			raw.Unset(lnwire.SpliceRequired)
+		}
+		if cfg.NoQuiescence {
+			raw.Unset(lnwire.QuiescenceOptional)
+			raw.Unset(lnwire.QuiescenceRequired)
+		}
+		if cfg.NoDynamicCommitments {
+			raw.Unset(lnwire.DynamicCommitmentsOptional)
+			raw.Unset(lnwire.DynamicCommitmentsRequired)
+		}
+		if cfg.NoSimpleClose {
+			raw.Unset(lnwire.SimpleCloseOptional)
+			raw.Unset(lnwire.SimpleCloseRequired)
+		}
 		if cfg.NoTaprootChans {
 			raw.Unset(lnwire.SimpleTaprootChannelsOptionalStaging)
 			raw.Unset(lnwire.SimpleTaprootChannelsRequiredStaging)
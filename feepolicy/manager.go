@@ -0,0 +1,466 @@
+// Package feepolicy periodically updates the forwarding policies of our
+// channels. A strategy computes the fee rate, base fee and max_htlc of each
+// channel from its local balance ratio, the volume forwarded over it and the
+// number of forwards that failed for lack of local balance. Updates are rate
+// limited, so that they don't flood the network with channel updates.
+package feepolicy
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultInterval is the default interval in which the policies of
+	// our channels are recomputed.
+	DefaultInterval = 10 * time.Minute
+
+	// DefaultForwardingWindow is the default window of forwards and
+	// failures that strategies take into account.
+	DefaultForwardingWindow = 24 * time.Hour
+
+	// DefaultMinUpdateInterval is the default minimum time between two
+	// updates of the policy of a channel.
+	DefaultMinUpdateInterval = time.Hour
+
+	// DefaultMaxUpdates is the default maximum number of channels whose
+	// policy is updated at once.
+	DefaultMaxUpdates = 10
+
+	// DefaultMinChange is the default minimum relative change of the fee
+	// rate or max_htlc of a channel that warrants an update.
+	DefaultMinChange = 0.1
+
+	// DefaultBaseFee is the default base fee in msat that's set on all
+	// channels.
+	DefaultBaseFee = 1000
+
+	// DefaultMinFeeRate is the default lowest fee rate in ppm that's set.
+	DefaultMinFeeRate = 1
+
+	// DefaultMaxFeeRate is the default highest fee rate in ppm that's set.
+	DefaultMaxFeeRate = 2000
+
+	// forwardingQueryBatch is the number of forwarding events that are
+	// queried at once.
+	forwardingQueryBatch = 10_000
+)
+
+var (
+	// errShuttingDown is returned when the manager is shutting down.
+	errShuttingDown = errors.New("fee policy manager shutting down")
+)
+
+// Config holds the configuration of the fee policy manager.
+type Config struct {
+	// Strategy computes the policies of our channels.
+	Strategy Strategy
+
+	// ForwardingWindow is the window of forwards and failures that the
+	// strategy takes into account.
+	ForwardingWindow time.Duration
+
+	// MinUpdateInterval is the minimum time between two updates of the
+	// policy of a channel, including updates that weren't made by the
+	// manager.
+	MinUpdateInterval time.Duration
+
+	// MaxUpdates is the maximum number of channels whose policy is
+	// updated at once. The channels whose fee rate changes the most are
+	// updated first.
+	MaxUpdates int
+
+	// MinChange is the minimum relative change of the fee rate or
+	// max_htlc of a channel that warrants an update. A change of the base
+	// fee always warrants an update.
+	MinChange float64
+
+	// ForAllOutgoingChannels is used to iterate over all our local
+	// channels and their current policies.
+	ForAllOutgoingChannels func(cb func(kvdb.RTx,
+		*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) error) error
+
+	// FetchChannel is used to query local channel parameters. Optionally
+	// an existing db tx can be supplied.
+	FetchChannel func(tx kvdb.RTx, chanPoint wire.OutPoint) (
+		*channeldb.OpenChannel, error)
+
+	// QueryForwardingLog queries the forwarding log for the forwards of a
+	// time slice.
+	QueryForwardingLog func(channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// SubscribeHtlcEvents provides a subscription client which provides a
+	// stream of htlc events, from which failed forwards are counted.
+	SubscribeHtlcEvents func() (subscribe.Subscription, error)
+
+	// UpdatePolicy updates the policy of the given channels, and
+	// propagates it to the network.
+	UpdatePolicy func(routing.ChannelPolicy, ...wire.OutPoint) (
+		[]*lnrpc.FailedUpdate, error)
+
+	// Ticker triggers the recomputation of the policies.
+	Ticker ticker.Ticker
+
+	// Clock is the time source of the manager.
+	Clock clock.Clock
+}
+
+// policyUpdate is a policy update of a channel.
+type policyUpdate struct {
+	state  *ChannelState
+	policy Policy
+
+	// change is the relative change of the fee rate.
+	change float64
+}
+
+// Manager periodically updates the forwarding policies of our channels with
+// a strategy.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// failures holds the times of the forwards that failed for lack of
+	// local balance, by outgoing channel.
+	failures map[lnwire.ShortChannelID][]time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new fee policy manager from the given config.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:      cfg,
+		failures: make(map[lnwire.ShortChannelID][]time.Time),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start starts the manager.
+func (m *Manager) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Infof("Fee policy manager starting with %v strategy",
+			m.cfg.Strategy.Name())
+
+		var htlcEvents subscribe.Subscription
+		htlcEvents, err = m.cfg.SubscribeHtlcEvents()
+		if err != nil {
+			return
+		}
+
+		m.cfg.Ticker.Resume()
+
+		m.wg.Add(1)
+		go m.manage(htlcEvents)
+	})
+
+	return err
+}
+
+// Stop stops the manager.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Fee policy manager shutting down...")
+		defer log.Debug("Fee policy manager shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		m.cfg.Ticker.Stop()
+	})
+
+	return nil
+}
+
+// manage counts failed forwards, and updates the policies of our channels
+// whenever the ticker fires.
+func (m *Manager) manage(htlcEvents subscribe.Subscription) {
+	defer m.wg.Done()
+	defer htlcEvents.Cancel()
+
+	for {
+		select {
+		case event := <-htlcEvents.Updates():
+			m.recordFailure(event)
+
+		case <-m.cfg.Ticker.Ticks():
+			if err := m.updatePolicies(); err != nil {
+				log.Errorf("Unable to update fee policies: %v",
+					err)
+			}
+
+		case <-htlcEvents.Quit():
+			log.Warn("Htlc event subscription cancelled")
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// recordFailure records a forward that failed on its outgoing channel for
+// lack of local balance.
+func (m *Manager) recordFailure(event interface{}) {
+	failEvent, ok := event.(*htlcswitch.LinkFailEvent)
+	if !ok || failEvent.Incoming ||
+		failEvent.HtlcEventType != htlcswitch.HtlcEventTypeForward {
+
+		return
+	}
+
+	if failEvent.LinkError == nil || failEvent.LinkError.FailureDetail !=
+		htlcswitch.OutgoingFailureInsufficientBalance {
+
+		return
+	}
+
+	chanID := failEvent.OutgoingCircuit.ChanID
+	m.failures[chanID] = append(m.failures[chanID], failEvent.Timestamp)
+}
+
+// updatePolicies computes the policies of our channels with the strategy, and
+// updates those that changed enough and weren't updated recently.
+func (m *Manager) updatePolicies() error {
+	now := m.cfg.Clock.Now()
+
+	states, err := m.channelStates(now)
+	if err != nil {
+		return err
+	}
+
+	var updates []*policyUpdate
+	for _, state := range states {
+		policy := m.cfg.Strategy.Policy(state)
+
+		update, ok := m.policyUpdate(state, policy, now)
+		if !ok {
+			continue
+		}
+
+		updates = append(updates, update)
+	}
+
+	// If there are more updates than allowed at once, then we'll update
+	// the channels whose fee rate changes the most first. The others are
+	// updated in a later round.
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].change > updates[j].change
+	})
+	if m.cfg.MaxUpdates > 0 && len(updates) > m.cfg.MaxUpdates {
+		updates = updates[:m.cfg.MaxUpdates]
+	}
+
+	for _, update := range updates {
+		select {
+		case <-m.quit:
+			return errShuttingDown
+		default:
+		}
+
+		state := update.state
+		log.Infof("Updating policy of ChannelPoint(%v) from %v to %v",
+			state.ChanPoint, state.Current, update.policy)
+
+		failedUpdates, err := m.cfg.UpdatePolicy(routing.ChannelPolicy{
+			FeeSchema: routing.FeeSchema{
+				BaseFee: update.policy.BaseFee,
+				FeeRate: update.policy.FeeRate,
+			},
+			TimeLockDelta: state.TimeLockDelta,
+			MaxHTLC:       update.policy.MaxHTLC,
+		}, state.ChanPoint)
+		if err != nil {
+			return err
+		}
+
+		for _, failedUpdate := range failedUpdates {
+			log.Warnf("Unable to update policy of "+
+				"ChannelPoint(%v): %v", state.ChanPoint,
+				failedUpdate.UpdateError)
+		}
+	}
+
+	return nil
+}
+
+// policyUpdate returns the update of the channel with the given state to the
+// given policy, and true if the channel should be updated.
+func (m *Manager) policyUpdate(state *ChannelState, policy Policy,
+	now time.Time) (*policyUpdate, bool) {
+
+	current := state.Current
+	feeRateChange := relativeChange(
+		float64(current.FeeRate), float64(policy.FeeRate),
+	)
+	maxHtlcChange := relativeChange(
+		float64(current.MaxHTLC), float64(policy.MaxHTLC),
+	)
+
+	if policy.BaseFee == current.BaseFee &&
+		feeRateChange < m.cfg.MinChange &&
+		maxHtlcChange < m.cfg.MinChange {
+
+		return nil, false
+	}
+
+	// The channel update of the new policy may be rate limited by our
+	// peers if we update a channel too often.
+	if now.Sub(state.LastUpdate) < m.cfg.MinUpdateInterval {
+		log.Debugf("Deferring policy update of ChannelPoint(%v) to "+
+			"%v, last update was at %v", state.ChanPoint, policy,
+			state.LastUpdate)
+
+		return nil, false
+	}
+
+	return &policyUpdate{
+		state:  state,
+		policy: policy,
+		change: feeRateChange,
+	}, true
+}
+
+// relativeChange returns the change from the old to the new value in relation
+// to the old value. Any change of a zero value is infinite.
+func relativeChange(oldValue, newValue float64) float64 {
+	switch {
+	case oldValue == newValue:
+		return 0
+
+	case oldValue == 0:
+		return math.Inf(1)
+	}
+
+	return math.Abs(newValue-oldValue) / oldValue
+}
+
+// channelStates returns the states of our channels.
+func (m *Manager) channelStates(now time.Time) ([]*ChannelState, error) {
+	start := now.Add(-m.cfg.ForwardingWindow)
+
+	var states []*ChannelState
+	statesByChanID := make(map[lnwire.ShortChannelID]*ChannelState)
+	err := m.cfg.ForAllOutgoingChannels(func(tx kvdb.RTx,
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		// Channels whose policy we haven't created yet are skipped.
+		if edge == nil {
+			return nil
+		}
+
+		// Channels that are no longer open are skipped as well.
+		channel, err := m.cfg.FetchChannel(tx, info.ChannelPoint)
+		if errors.Is(err, channeldb.ErrChannelNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		maxPending := channel.LocalChanCfg.ChannelConstraints.
+			MaxPendingAmount
+
+		chanID := lnwire.NewShortChanIDFromInt(info.ChannelID)
+		state := &ChannelState{
+			ChanPoint:     info.ChannelPoint,
+			ChanID:        chanID,
+			Capacity:      info.Capacity,
+			LocalBalance:  channel.LocalCommitment.LocalBalance,
+			TimeLockDelta: uint32(edge.TimeLockDelta),
+			MinHTLC:       edge.MinHTLC,
+			MaxHTLCLimit:  maxPending,
+			Current: Policy{
+				BaseFee: edge.FeeBaseMSat,
+				FeeRate: uint32(edge.FeeProportionalMillionths),
+				MaxHTLC: edge.MaxHTLC,
+			},
+			LastUpdate: edge.LastUpdate,
+			Failures:   m.countFailures(info.ChannelID, start),
+		}
+
+		states = append(states, state)
+		statesByChanID[state.ChanID] = state
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll sum up the recent forwarding volume of each channel.
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    start,
+		EndTime:      now,
+		NumMaxEvents: forwardingQueryBatch,
+	}
+	for {
+		timeSlice, err := m.cfg.QueryForwardingLog(query)
+		if errors.Is(err, channeldb.ErrNoForwardingEvents) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			outgoing, ok := statesByChanID[event.OutgoingChanID]
+			if ok {
+				outgoing.OutgoingVolume += event.AmtOut
+			}
+
+			incoming, ok := statesByChanID[event.IncomingChanID]
+			if ok {
+				incoming.IncomingVolume += event.AmtIn
+			}
+		}
+
+		if len(timeSlice.ForwardingEvents) < forwardingQueryBatch {
+			break
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+
+	return states, nil
+}
+
+// countFailures returns the number of failed forwards of the given channel
+// since the given time, and prunes older failures.
+func (m *Manager) countFailures(chanID uint64, since time.Time) int {
+	scid := lnwire.NewShortChanIDFromInt(chanID)
+
+	failures := m.failures[scid]
+	for len(failures) > 0 && failures[0].Before(since) {
+		failures = failures[1:]
+	}
+
+	if len(failures) == 0 {
+		delete(m.failures, scid)
+		return 0
+	}
+
+	m.failures[scid] = failures
+
+	return len(failures)
+}
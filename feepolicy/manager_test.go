@@ -0,0 +1,260 @@
+package feepolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Unix(1_700_000_000, 0)
+
+// testChannel is a channel of the test context.
+type testChannel struct {
+	info    *channeldb.ChannelEdgeInfo
+	edge    *channeldb.ChannelEdgePolicy
+	channel *channeldb.OpenChannel
+}
+
+// managerTestContext holds the manager under test and its dependencies.
+type managerTestContext struct {
+	t *testing.T
+
+	manager *Manager
+	clock   *clock.TestClock
+
+	channels []*testChannel
+	forwards []channeldb.ForwardingEvent
+
+	updates chan routing.ChannelPolicy
+}
+
+func newManagerTestContext(t *testing.T) *managerTestContext {
+	ctx := &managerTestContext{
+		t:       t,
+		clock:   clock.NewTestClock(testTime),
+		updates: make(chan routing.ChannelPolicy, 10),
+	}
+
+	strategy, err := NewStrategy(StrategyAdaptive, testStrategyConfig)
+	require.NoError(t, err)
+
+	ctx.manager = NewManager(&Config{
+		Strategy:          strategy,
+		ForwardingWindow:  DefaultForwardingWindow,
+		MinUpdateInterval: DefaultMinUpdateInterval,
+		MaxUpdates:        1,
+		MinChange:         DefaultMinChange,
+		ForAllOutgoingChannels: func(cb func(kvdb.RTx,
+			*channeldb.ChannelEdgeInfo,
+			*channeldb.ChannelEdgePolicy) error) error {
+
+			for _, channel := range ctx.channels {
+				err := cb(nil, channel.info, channel.edge)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		FetchChannel: func(_ kvdb.RTx, chanPoint wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			for _, channel := range ctx.channels {
+				if channel.info.ChannelPoint == chanPoint {
+					return channel.channel, nil
+				}
+			}
+
+			return nil, channeldb.ErrChannelNotFound
+		},
+		QueryForwardingLog: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			return channeldb.ForwardingLogTimeSlice{
+				ForwardingEventQuery: q,
+				ForwardingEvents:     ctx.forwards,
+			}, nil
+		},
+		UpdatePolicy: func(policy routing.ChannelPolicy,
+			_ ...wire.OutPoint) ([]*lnrpc.FailedUpdate, error) {
+
+			ctx.updates <- policy
+			return nil, nil
+		},
+		Clock: ctx.clock,
+	})
+
+	return ctx
+}
+
+// addChannel adds a channel of 1 BTC with the given local balance in sat and
+// fee rate in ppm, whose policy was last updated at the given time.
+func (c *managerTestContext) addChannel(chanID uint64,
+	localBalance btcutil.Amount, feeRate lnwire.MilliSatoshi,
+	lastUpdate time.Time) {
+
+	chanPoint := wire.OutPoint{Index: uint32(chanID)}
+	capacity := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+
+	channel := &channeldb.OpenChannel{}
+	channel.LocalCommitment.LocalBalance = lnwire.NewMSatFromSatoshis(
+		localBalance,
+	)
+	channel.LocalChanCfg.ChannelConstraints.MaxPendingAmount = capacity
+
+	c.channels = append(c.channels, &testChannel{
+		info: &channeldb.ChannelEdgeInfo{
+			ChannelID:    chanID,
+			ChannelPoint: chanPoint,
+			Capacity:     btcutil.SatoshiPerBitcoin,
+		},
+		edge: &channeldb.ChannelEdgePolicy{
+			ChannelID:                 chanID,
+			LastUpdate:                lastUpdate,
+			TimeLockDelta:             80,
+			MinHTLC:                   1000,
+			MaxHTLC:                   capacity,
+			FeeBaseMSat:               1000,
+			FeeProportionalMillionths: feeRate,
+		},
+		channel: channel,
+	})
+}
+
+// updatePolicies runs an update round of the manager.
+func (c *managerTestContext) updatePolicies() {
+	require.NoError(c.t, c.manager.updatePolicies())
+}
+
+// assertUpdate asserts that the policy of a channel was updated to the given
+// fee rate.
+func (c *managerTestContext) assertUpdate(feeRate uint32) {
+	select {
+	case policy := <-c.updates:
+		require.Equal(c.t, feeRate, policy.FeeRate)
+		require.EqualValues(c.t, 80, policy.TimeLockDelta)
+
+	case <-time.After(time.Second):
+		c.t.Fatal("policy not updated")
+	}
+}
+
+// assertNoUpdate asserts that no policy was updated.
+func (c *managerTestContext) assertNoUpdate() {
+	select {
+	case policy := <-c.updates:
+		c.t.Fatalf("unexpected policy update: %v", policy)
+
+	default:
+	}
+}
+
+// TestManagerUpdatePolicies tests that the manager only updates the policies
+// that changed enough and weren't updated recently, most changed first.
+func TestManagerUpdatePolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestContext(t)
+
+	// The first channel was recently updated, the second one already has
+	// the policy of the strategy, and the third and fourth ones need to be
+	// updated, the fourth one more urgently.
+	recent := testTime.Add(-time.Minute)
+	old := testTime.Add(-2 * time.Hour)
+	ctx.addChannel(1, 50_000_000, 100, recent)
+	ctx.addChannel(2, 50_000_000, 440, old)
+	ctx.addChannel(3, 50_000_000, 300, old)
+	ctx.addChannel(4, 50_000_000, 100, old)
+
+	// The first round only updates the fourth channel, as one update is
+	// allowed at once.
+	ctx.updatePolicies()
+	ctx.assertUpdate(450)
+	ctx.assertNoUpdate()
+
+	ctx.channels[3].edge.FeeProportionalMillionths = 450
+	ctx.channels[3].edge.LastUpdate = testTime
+
+	// The next round updates the third channel.
+	ctx.updatePolicies()
+	ctx.assertUpdate(450)
+	ctx.assertNoUpdate()
+}
+
+// TestManagerForwardsAndFailures tests that the forwarding volume and failed
+// forwards of a channel are passed to the strategy.
+func TestManagerForwardsAndFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx := newManagerTestContext(t)
+	ctx.addChannel(1, 50_000_000, 100, time.Time{})
+
+	ctx.forwards = []channeldb.ForwardingEvent{{
+		Timestamp:      testTime.Add(-time.Hour),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		AmtIn:          lnwire.NewMSatFromSatoshis(25_000_100),
+		AmtOut:         lnwire.NewMSatFromSatoshis(25_000_000),
+	}}
+
+	failure := func(detail htlcswitch.FailureDetail, incoming bool,
+		timestamp time.Time) *htlcswitch.LinkFailEvent {
+
+		return &htlcswitch.LinkFailEvent{
+			HtlcKey: htlcswitch.HtlcKey{
+				OutgoingCircuit: models.CircuitKey{
+					ChanID: lnwire.NewShortChanIDFromInt(1),
+				},
+			},
+			HtlcEventType: htlcswitch.HtlcEventTypeForward,
+			LinkError: htlcswitch.NewDetailedLinkError(
+				&lnwire.FailTemporaryChannelFailure{}, detail,
+			),
+			Incoming:  incoming,
+			Timestamp: timestamp,
+		}
+	}
+
+	// Only recent outgoing failures for lack of balance are counted.
+	events := []*htlcswitch.LinkFailEvent{
+		failure(
+			htlcswitch.OutgoingFailureInsufficientBalance, false,
+			testTime.Add(-48*time.Hour),
+		),
+		failure(
+			htlcswitch.OutgoingFailureInsufficientBalance, false,
+			testTime,
+		),
+		failure(
+			htlcswitch.OutgoingFailureInsufficientBalance, false,
+			testTime,
+		),
+		failure(
+			htlcswitch.OutgoingFailureInsufficientBalance, true,
+			testTime,
+		),
+		failure(htlcswitch.OutgoingFailureLinkNotEligible, false,
+			testTime,
+		),
+	}
+	for _, event := range events {
+		ctx.manager.recordFailure(event)
+	}
+
+	// The balanced channel has a fee rate of 600ppm, which is raised by
+	// a quarter for its forwarded volume, and by a fifth for its failures.
+	ctx.updatePolicies()
+	ctx.assertUpdate(900)
+}
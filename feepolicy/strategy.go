@@ -0,0 +1,275 @@
+package feepolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// StrategyBalance is the name of the strategy that sets the fee rate
+	// of a channel from its local balance ratio.
+	StrategyBalance = "balance"
+
+	// StrategyAdaptive is the name of the strategy that adjusts the fee
+	// rate of the balance strategy to the recent forwards and failures of
+	// a channel.
+	StrategyAdaptive = "adaptive"
+
+	// maxHtlcHalvings is the number of times the capacity of a channel is
+	// halved at most to find the max_htlc that its local balance can
+	// carry.
+	maxHtlcHalvings = 10
+
+	// maxFailureCount is the number of failures at which the adaptive
+	// strategy stops raising the fee rate of a channel further.
+	maxFailureCount = 10
+)
+
+// Policy is the part of the forwarding policy of a channel that's managed by
+// a strategy.
+type Policy struct {
+	// BaseFee is the base fee in msat charged for each forward.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee rate in parts per million.
+	FeeRate uint32
+
+	// MaxHTLC is the largest HTLC that's forwarded over the channel.
+	MaxHTLC lnwire.MilliSatoshi
+}
+
+// String returns a human-readable representation of the policy.
+func (p Policy) String() string {
+	return fmt.Sprintf("base_fee=%v, fee_rate=%vppm, max_htlc=%v",
+		p.BaseFee, p.FeeRate, p.MaxHTLC)
+}
+
+// ChannelState is the state of a channel from which a strategy computes its
+// policy.
+type ChannelState struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// TimeLockDelta is the time lock delta of the current policy.
+	TimeLockDelta uint32
+
+	// MinHTLC is the min_htlc of the current policy.
+	MinHTLC lnwire.MilliSatoshi
+
+	// MaxHTLCLimit is the largest max_htlc that the channel allows.
+	MaxHTLCLimit lnwire.MilliSatoshi
+
+	// Current is the current policy of the channel.
+	Current Policy
+
+	// LastUpdate is the time of the last update of the policy of the
+	// channel.
+	LastUpdate time.Time
+
+	// OutgoingVolume is the amount that was forwarded out of the channel
+	// within the forwarding window.
+	OutgoingVolume lnwire.MilliSatoshi
+
+	// IncomingVolume is the amount that was forwarded into the channel
+	// within the forwarding window.
+	IncomingVolume lnwire.MilliSatoshi
+
+	// Failures is the number of forwards that failed within the
+	// forwarding window, as the local balance of the channel was too low.
+	Failures int
+}
+
+// localRatio returns the ratio of the local balance to the capacity of the
+// channel.
+func (c *ChannelState) localRatio() float64 {
+	capacity := lnwire.NewMSatFromSatoshis(c.Capacity)
+	if capacity == 0 {
+		return 0
+	}
+
+	ratio := float64(c.LocalBalance) / float64(capacity)
+	if ratio > 1 {
+		return 1
+	}
+
+	return ratio
+}
+
+// Strategy computes the policy of a channel from its state.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// Policy returns the policy of the channel with the given state.
+	Policy(state *ChannelState) Policy
+}
+
+// StrategyConfig holds the parameters of the strategies.
+type StrategyConfig struct {
+	// BaseFee is the base fee in msat that's set on all channels.
+	BaseFee lnwire.MilliSatoshi
+
+	// MinFeeRate is the lowest fee rate in parts per million that's set,
+	// which is used for channels whose funds are all on our side.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the highest fee rate in parts per million that's set,
+	// which is used for depleted channels.
+	MaxFeeRate uint32
+}
+
+// NewStrategy returns the strategy with the given name.
+func NewStrategy(name string, cfg StrategyConfig) (Strategy, error) {
+	if cfg.MinFeeRate > cfg.MaxFeeRate {
+		return nil, fmt.Errorf("min fee rate of %vppm exceeds max fee "+
+			"rate of %vppm", cfg.MinFeeRate, cfg.MaxFeeRate)
+	}
+
+	switch name {
+	case StrategyBalance:
+		return &balanceStrategy{cfg: cfg}, nil
+
+	case StrategyAdaptive:
+		return &adaptiveStrategy{
+			balanceStrategy: balanceStrategy{cfg: cfg},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown fee policy strategy: %v", name)
+	}
+}
+
+// balanceStrategy raises the fee rate of a channel linearly from the min fee
+// rate to the max fee rate as its local balance is depleted. The max_htlc of
+// the channel is the largest fraction of its capacity, halved up to ten
+// times, that its local balance can carry, so that forwards that would fail
+// aren't attempted while the local balance is only coarsely revealed.
+type balanceStrategy struct {
+	cfg StrategyConfig
+}
+
+// Name returns the name of the strategy.
+//
+// NOTE: This is part of the Strategy interface.
+func (b *balanceStrategy) Name() string {
+	return StrategyBalance
+}
+
+// Policy returns the policy of the channel with the given state.
+//
+// NOTE: This is part of the Strategy interface.
+func (b *balanceStrategy) Policy(state *ChannelState) Policy {
+	return Policy{
+		BaseFee: b.cfg.BaseFee,
+		FeeRate: b.feeRate(state.localRatio()),
+		MaxHTLC: maxHTLC(state),
+	}
+}
+
+// feeRate returns the fee rate of a channel with the given local balance
+// ratio.
+func (b *balanceStrategy) feeRate(localRatio float64) uint32 {
+	span := float64(b.cfg.MaxFeeRate - b.cfg.MinFeeRate)
+
+	return b.cfg.MinFeeRate + uint32(span*(1-localRatio))
+}
+
+// clampFeeRate limits the given fee rate to the configured range.
+func (b *balanceStrategy) clampFeeRate(feeRate float64) uint32 {
+	switch {
+	case feeRate < float64(b.cfg.MinFeeRate):
+		return b.cfg.MinFeeRate
+
+	case feeRate > float64(b.cfg.MaxFeeRate):
+		return b.cfg.MaxFeeRate
+	}
+
+	return uint32(feeRate)
+}
+
+// adaptiveStrategy starts from the fee rate of the balance strategy, and
+// raises it with the volume forwarded out of the channel in relation to its
+// capacity, up to twice the rate, and with the number of forwards that failed
+// for lack of local balance, up to another doubling. A channel that neither
+// forwarded nor failed any payments gets a discount of a quarter, to attract
+// traffic.
+type adaptiveStrategy struct {
+	balanceStrategy
+}
+
+// Name returns the name of the strategy.
+//
+// NOTE: This is part of the Strategy interface.
+func (a *adaptiveStrategy) Name() string {
+	return StrategyAdaptive
+}
+
+// Policy returns the policy of the channel with the given state.
+//
+// NOTE: This is part of the Strategy interface.
+func (a *adaptiveStrategy) Policy(state *ChannelState) Policy {
+	feeRate := float64(a.feeRate(state.localRatio()))
+
+	switch {
+	case state.OutgoingVolume == 0 && state.Failures == 0:
+		feeRate *= 0.75
+
+	default:
+		capacity := lnwire.NewMSatFromSatoshis(state.Capacity)
+		volumeRatio := float64(state.OutgoingVolume) / float64(capacity)
+		if volumeRatio > 1 {
+			volumeRatio = 1
+		}
+
+		failures := state.Failures
+		if failures > maxFailureCount {
+			failures = maxFailureCount
+		}
+
+		feeRate *= 1 + volumeRatio
+		feeRate *= 1 + float64(failures)/maxFailureCount
+	}
+
+	return Policy{
+		BaseFee: a.cfg.BaseFee,
+		FeeRate: a.clampFeeRate(feeRate),
+		MaxHTLC: maxHTLC(state),
+	}
+}
+
+// maxHTLC returns the largest fraction of the capacity of the channel, halved
+// up to ten times, that the local balance of the channel can carry. The result
+// is kept between the min_htlc and the largest max_htlc of the channel, and
+// is the min_htlc if no such fraction can be carried.
+func maxHTLC(state *ChannelState) lnwire.MilliSatoshi {
+	maxHtlc := lnwire.NewMSatFromSatoshis(state.Capacity)
+	for i := 0; i < maxHtlcHalvings && maxHtlc > state.LocalBalance; i++ {
+		maxHtlc /= 2
+	}
+
+	switch {
+	case maxHtlc > state.LocalBalance:
+		return state.MinHTLC
+
+	case maxHtlc > state.MaxHTLCLimit:
+		return state.MaxHTLCLimit
+
+	case maxHtlc < state.MinHTLC:
+		return state.MinHTLC
+	}
+
+	return maxHtlc
+}
@@ -0,0 +1,130 @@
+package feepolicy
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var testStrategyConfig = StrategyConfig{
+	BaseFee:    1000,
+	MinFeeRate: 100,
+	MaxFeeRate: 1100,
+}
+
+// testChannelState returns the state of a channel of 1 BTC with the given
+// local balance in sat.
+func testChannelState(localBalance btcutil.Amount) *ChannelState {
+	return &ChannelState{
+		Capacity:     btcutil.SatoshiPerBitcoin,
+		LocalBalance: lnwire.NewMSatFromSatoshis(localBalance),
+		MinHTLC:      1000,
+		MaxHTLCLimit: lnwire.NewMSatFromSatoshis(90_000_000),
+	}
+}
+
+// TestBalanceStrategy tests that the balance strategy raises the fee rate of
+// a channel as its local balance is depleted.
+func TestBalanceStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := NewStrategy(StrategyBalance, testStrategyConfig)
+	require.NoError(t, err)
+	require.Equal(t, StrategyBalance, strategy.Name())
+
+	testCases := []struct {
+		name         string
+		localBalance btcutil.Amount
+		policy       Policy
+	}{
+		{
+			name:         "full local balance",
+			localBalance: btcutil.SatoshiPerBitcoin,
+			policy: Policy{
+				BaseFee: 1000,
+				FeeRate: 100,
+				MaxHTLC: lnwire.NewMSatFromSatoshis(90_000_000),
+			},
+		},
+		{
+			name:         "balanced",
+			localBalance: 50_000_000,
+			policy: Policy{
+				BaseFee: 1000,
+				FeeRate: 600,
+				MaxHTLC: lnwire.NewMSatFromSatoshis(50_000_000),
+			},
+		},
+		{
+			name:         "mostly depleted",
+			localBalance: 10_000_000,
+			policy: Policy{
+				BaseFee: 1000,
+				FeeRate: 1000,
+				MaxHTLC: lnwire.NewMSatFromSatoshis(6_250_000),
+			},
+		},
+		{
+			name:         "depleted",
+			localBalance: 0,
+			policy: Policy{
+				BaseFee: 1000,
+				FeeRate: 1100,
+				MaxHTLC: 1000,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := testChannelState(testCase.localBalance)
+			policy := strategy.Policy(state)
+			require.Equal(t, testCase.policy, policy)
+		})
+	}
+}
+
+// TestAdaptiveStrategy tests that the adaptive strategy adjusts the fee rate
+// of the balance strategy to the forwards and failures of a channel.
+func TestAdaptiveStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := NewStrategy(StrategyAdaptive, testStrategyConfig)
+	require.NoError(t, err)
+	require.Equal(t, StrategyAdaptive, strategy.Name())
+
+	// An idle channel gets a discount.
+	state := testChannelState(50_000_000)
+	require.EqualValues(t, 450, strategy.Policy(state).FeeRate)
+
+	// The fee rate is raised with the forwarded volume.
+	state.OutgoingVolume = lnwire.NewMSatFromSatoshis(25_000_000)
+	require.EqualValues(t, 750, strategy.Policy(state).FeeRate)
+
+	// And with the failures, up to the max fee rate.
+	state.Failures = 2
+	require.EqualValues(t, 900, strategy.Policy(state).FeeRate)
+
+	state.Failures = 20
+	require.EqualValues(t, 1100, strategy.Policy(state).FeeRate)
+}
+
+// TestNewStrategy tests that invalid strategies are rejected.
+func TestNewStrategy(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewStrategy("unknown", testStrategyConfig)
+	require.ErrorContains(t, err, "unknown fee policy strategy")
+
+	_, err = NewStrategy(StrategyBalance, StrategyConfig{
+		MinFeeRate: 2,
+		MaxFeeRate: 1,
+	})
+	require.ErrorContains(t, err, "exceeds max fee rate")
+}
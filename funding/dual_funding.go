@@ -0,0 +1,1177 @@
+package funding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// errDualFundUnsupportedType is returned when a channel type that
+	// can't be used with the dual funding protocol is proposed.
+	errDualFundUnsupportedType = errors.New("channel type not " +
+		"supported with dual funding")
+)
+
+// dualFundingCtx tracks a channel that is opened with the dual funding
+// protocol, from the exchange of open_channel2 and accept_channel2 until the
+// funding transaction is published.
+type dualFundingCtx struct {
+	// pendingChanID is the id of the reservation of the channel within
+	// the set of active reservations.
+	pendingChanID [32]byte
+
+	// chanID is the id that the channel is referenced by on the wire.
+	// Until accept_channel2 is received, it's the temporary channel id of
+	// the initiator, afterwards it's derived from the revocation
+	// basepoints of both sides.
+	chanID lnwire.ChannelID
+
+	resCtx *reservationWithCtx
+
+	// initiator is true if we sent the open_channel2 message.
+	initiator bool
+
+	// localAmt and remoteAmt are the amounts that we and the remote party
+	// contribute to the channel.
+	localAmt  btcutil.Amount
+	remoteAmt btcutil.Amount
+
+	// fundingFeeRate is the fee rate of the funding transaction, which
+	// each side pays for its own inputs and outputs.
+	fundingFeeRate chainfee.SatPerKWeight
+
+	// lockTime is the lock time of the funding transaction.
+	lockTime uint32
+
+	// session constructs the funding transaction together with the remote
+	// party. It's nil until both sides know each other's contribution.
+	session *interactivetx.Session
+
+	// completeChan is set once the commitment signatures have been
+	// exchanged and the channel has been written to the database.
+	completeChan *channeldb.OpenChannel
+
+	// sentSigs is true once we've sent our tx_signatures.
+	sentSigs bool
+}
+
+// useDualFunding returns true if a channel requested with the InitFundingMsg
+// is opened with the dual funding protocol. Besides both sides signaling the
+// feature, this requires that the parameters of the request are expressible
+// with open_channel2, and that the funds come from our wallet.
+func useDualFunding(msg *InitFundingMsg, commitType lnwallet.CommitmentType,
+	zeroConf bool) bool {
+
+	if !hasFeatures(
+		msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) {
+
+		return false
+	}
+
+	switch {
+	// The dual funding protocol doesn't allow pushing funds to the remote
+	// party, and the channel reserve is always derived from the capacity.
+	case msg.PushAmt != 0 || msg.RemoteChanReserve != 0:
+		return false
+
+	// Only inputs of our wallet can be added to the funding transaction.
+	case msg.ChanFunder != nil:
+		return false
+
+	case zeroConf || commitType.IsTaproot() ||
+		commitType == lnwallet.CommitmentTypeScriptEnforcedLease:
+
+		return false
+	}
+
+	return true
+}
+
+// openChannelFromV2 returns an OpenChannel message with the parameters of the
+// OpenChannel2 message, which is passed to the channel acceptor.
+func openChannelFromV2(msg *lnwire.OpenChannel2) *lnwire.OpenChannel {
+	return &lnwire.OpenChannel{
+		ChainHash:             msg.ChainHash,
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         msg.FundingAmount,
+		DustLimit:             msg.DustLimit,
+		MaxValueInFlight:      msg.MaxValueInFlight,
+		HtlcMinimum:           msg.HtlcMinimum,
+		FeePerKiloWeight:      msg.CommitFeePerKWeight,
+		CsvDelay:              msg.CsvDelay,
+		MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+		FundingKey:            msg.FundingKey,
+		RevocationPoint:       msg.RevocationPoint,
+		PaymentPoint:          msg.PaymentPoint,
+		DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+		HtlcPoint:             msg.HtlcPoint,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		ChannelFlags:          msg.ChannelFlags,
+		UpfrontShutdownScript: msg.UpfrontShutdownScript,
+		ChannelType:           msg.ChannelType,
+	}
+}
+
+// checkRemoteFundingFee returns an error if the inputs and outputs that the
+// remote party added to the funding transaction don't pay for their weight at
+// the agreed upon fee rate. The initiator also pays for the fields that are
+// common to the whole transaction, and for the funding output, which it adds
+// even though both sides fund it.
+func checkRemoteFundingFee(remote interactivetx.Contribution, localAmt,
+	remoteAmt btcutil.Amount, remoteInitiator bool,
+	feeRate chainfee.SatPerKWeight) error {
+
+	weight := remote.Weight
+	paid := remote.Inputs - remote.Outputs - remoteAmt
+	if remoteInitiator {
+		weight += interactivetx.CommonWeight()
+		paid = remote.Inputs - remote.Outputs + localAmt
+	}
+
+	required := feeRate.FeeForWeight(weight)
+	if paid < required {
+		return fmt.Errorf("remote contribution pays fee of %v, "+
+			"expected at least %v", paid, required)
+	}
+
+	return nil
+}
+
+// getDualFundingCtx returns the context of the dual funded channel with the
+// given wire id that is opened with the peer.
+func (f *Manager) getDualFundingCtx(peer lnpeer.Peer,
+	chanID lnwire.ChannelID) (*dualFundingCtx, error) {
+
+	f.resMtx.RLock()
+	dctx, ok := f.dualFundReservations[chanID]
+	f.resMtx.RUnlock()
+
+	if !ok || !dctx.resCtx.peer.IdentityKey().IsEqual(peer.IdentityKey()) {
+		return nil, fmt.Errorf("unknown dual funded channel %v", chanID)
+	}
+
+	return dctx, nil
+}
+
+// failDualFundingFlow fails the opening of a dual funded channel. Unless the
+// channel has already been written to the database, the reservation is
+// canceled and the remote party is informed.
+func (f *Manager) failDualFundingFlow(dctx *dualFundingCtx, fundingErr error) {
+	log.Debugf("Failing dual funding flow for chan_id=%v: %v",
+		dctx.chanID, fundingErr)
+
+	f.resMtx.Lock()
+	delete(f.dualFundReservations, dctx.chanID)
+	f.resMtx.Unlock()
+
+	// Once the channel is in the database, it'll either confirm or be
+	// forgotten after the funding timeout.
+	if dctx.completeChan != nil {
+		select {
+		case dctx.resCtx.err <- fundingErr:
+		default:
+		}
+
+		return
+	}
+
+	peer := dctx.resCtx.peer
+	resCtx, err := f.cancelReservationCtx(
+		peer.IdentityKey(), dctx.pendingChanID, false,
+	)
+	if err != nil {
+		log.Errorf("unable to cancel reservation: %v", err)
+	}
+
+	if resCtx != nil {
+		resCtx.err <- fundingErr
+	}
+
+	// While the funding transaction is constructed, the construction is
+	// aborted with tx_abort, before we use a regular error.
+	data := fundingErrorData(fundingErr)
+	var errMsg lnwire.Message = &lnwire.Error{
+		ChanID: dctx.chanID,
+		Data:   data,
+	}
+	if dctx.session != nil {
+		errMsg = &lnwire.TxAbort{
+			ChannelID: dctx.chanID,
+			Data:      data,
+		}
+	}
+
+	if err := peer.SendMessage(false, errMsg); err != nil {
+		log.Errorf("unable to send error message to peer %v", err)
+	}
+}
+
+// sendOpenChannel2 kicks off the opening of a dual funded channel for which
+// the reservation was created by handleInitFundingMsg. The parameters of the
+// channel are taken from the OpenChannel message that would have been sent
+// otherwise.
+func (f *Manager) sendOpenChannel2(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, open *lnwire.OpenChannel,
+	fundingFeeRate chainfee.SatPerKWeight) error {
+
+	secondPoint, err := resCtx.reservation.SecondCommitmentPoint()
+	if err != nil {
+		return err
+	}
+
+	// The funding transaction can't be mined before the current height,
+	// which discourages fee sniping.
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	tempChanID := lnwire.NewTempChanIDFromRevocationBasepoint(
+		open.RevocationPoint,
+	)
+
+	dctx := &dualFundingCtx{
+		pendingChanID:  pendingChanID,
+		chanID:         tempChanID,
+		resCtx:         resCtx,
+		initiator:      true,
+		localAmt:       open.FundingAmount,
+		fundingFeeRate: fundingFeeRate,
+		lockTime:       uint32(bestHeight),
+	}
+
+	f.resMtx.Lock()
+	f.dualFundReservations[tempChanID] = dctx
+	f.resMtx.Unlock()
+
+	log.Infof("Starting dual funding workflow for pending_id(%x) with "+
+		"temp_chan_id=%v", pendingChanID[:], tempChanID)
+
+	return resCtx.peer.SendMessage(true, &lnwire.OpenChannel2{
+		ChainHash:             open.ChainHash,
+		PendingChannelID:      tempChanID,
+		FundingFeePerKWeight:  uint32(fundingFeeRate),
+		CommitFeePerKWeight:   open.FeePerKiloWeight,
+		FundingAmount:         open.FundingAmount,
+		DustLimit:             open.DustLimit,
+		MaxValueInFlight:      open.MaxValueInFlight,
+		HtlcMinimum:           open.HtlcMinimum,
+		CsvDelay:              open.CsvDelay,
+		MaxAcceptedHTLCs:      open.MaxAcceptedHTLCs,
+		LockTime:              dctx.lockTime,
+		FundingKey:            open.FundingKey,
+		RevocationPoint:       open.RevocationPoint,
+		PaymentPoint:          open.PaymentPoint,
+		DelayedPaymentPoint:   open.DelayedPaymentPoint,
+		HtlcPoint:             open.HtlcPoint,
+		FirstCommitmentPoint:  open.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		ChannelFlags:          open.ChannelFlags,
+		UpfrontShutdownScript: open.UpfrontShutdownScript,
+		ChannelType:           open.ChannelType,
+	})
+}
+
+// fundeeProcessOpenChannel2 creates a reservation for a dual funded channel
+// that the remote peer wants to open with us. The channel acceptor decides how
+// much we contribute to it. Once the reservation is created, we respond with
+// an accept_channel2 message, after which the remote party starts the
+// construction of the funding transaction.
+//
+//nolint:funlen
+func (f *Manager) fundeeProcessOpenChannel2(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel2) {
+
+	peerIDKey := newSerializedKey(peer.IdentityKey())
+	amt := msg.FundingAmount
+
+	// Create the channel identifier.
+	cid := newChanIdentifier(msg.PendingChannelID)
+
+	if err := f.checkPendingChanLimits(peer); err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// We'll, also ensure that the remote party isn't attempting to
+	// contribute less than our current min channel size.
+	if amt < f.cfg.MinChanSize {
+		f.failFundingFlow(
+			peer, cid,
+			lnwallet.ErrChanTooSmall(amt, f.cfg.MinChanSize),
+		)
+		return
+	}
+
+	// Query our channel acceptor to determine whether we should reject
+	// the channel, and how much we contribute to it.
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node:        peer.IdentityKey(),
+		OpenChanMsg: openChannelFromV2(msg),
+		DualFund:    true,
+	}
+	acceptorResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if acceptorResp.RejectChannel() {
+		f.failFundingFlow(peer, cid, acceptorResp.ChanAcceptError)
+		return
+	}
+
+	localAmt := acceptorResp.FundingAmt
+	capacity := amt + localAmt
+
+	// Ensure that the channel respects our maximum channel size,
+	// including our own contribution.
+	if capacity > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			peer, cid,
+			lnwallet.ErrChanTooLarge(capacity, f.cfg.MaxChanSize),
+		)
+		return
+	}
+
+	log.Infof("Recv'd dual fundingRequest(amt=%v, local_amt=%v, "+
+		"delay=%v, pendingId=%x) from peer(%x)", amt, localAmt,
+		msg.CsvDelay, msg.PendingChannelID,
+		peer.IdentityKey().SerializeCompressed())
+
+	chanType, commitType, err := negotiateCommitmentType(
+		msg.ChannelType, peer.LocalFeatures(), peer.RemoteFeatures(),
+	)
+	if err != nil {
+		log.Errorf("channel type negotiation failed: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	var scid bool
+	if chanType != nil {
+		featureVec := lnwire.RawFeatureVector(*chanType)
+		scid = featureVec.IsSet(lnwire.ScidAliasRequired)
+
+		// Zero-conf channels would require us to trust the remote
+		// party with our contribution.
+		if featureVec.IsSet(lnwire.ZeroConfRequired) {
+			f.failFundingFlow(peer, cid, errDualFundUnsupportedType)
+			return
+		}
+	}
+
+	public := msg.ChannelFlags&lnwire.FFAnnounceChannel != 0
+	switch {
+	// Sending the option-scid-alias channel type for a public channel is
+	// disallowed.
+	case public && scid:
+		err = fmt.Errorf("option-scid-alias chantype for public " +
+			"channel")
+		log.Error(err)
+		f.failFundingFlow(peer, cid, err)
+
+		return
+
+	case commitType.IsTaproot() ||
+		commitType == lnwallet.CommitmentTypeScriptEnforcedLease:
+
+		f.failFundingFlow(peer, cid, errDualFundUnsupportedType)
+
+		return
+	}
+
+	scidFeatureVal := hasFeatures(
+		peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.ScidAliasOptional,
+	)
+
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:        &msg.ChainHash,
+		PendingChanID:    msg.PendingChannelID,
+		NodeID:           peer.IdentityKey(),
+		NodeAddr:         peer.Address(),
+		LocalFundingAmt:  localAmt,
+		RemoteFundingAmt: amt,
+		CommitFeePerKw: chainfee.SatPerKWeight(
+			msg.CommitFeePerKWeight,
+		),
+		FundingFeePerKw: chainfee.SatPerKWeight(
+			msg.FundingFeePerKWeight,
+		),
+		Flags:            msg.ChannelFlags,
+		MinConfs:         1,
+		CommitType:       commitType,
+		OptionScidAlias:  scid,
+		ScidAliasFeature: scidFeatureVal,
+		DualFund:         true,
+	}
+
+	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil {
+		log.Errorf("Unable to initialize reservation: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// As we're the responder, we get to specify the number of
+	// confirmations that we require before both of us consider the
+	// channel open.
+	numConfsReq := f.cfg.NumRequiredConfs(capacity, 0)
+	if acceptorResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptorResp.MinAcceptDepth
+	}
+	reservation.SetNumConfsRequired(numConfsReq)
+
+	// Check whether the peer supports upfront shutdown, and get a new
+	// wallet address if our node is configured to set shutdown addresses
+	// by default.
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, peer, acceptorResp.UpfrontShutdown,
+		f.selectShutdownScript,
+	)
+	if err != nil {
+		f.failFundingFlow(
+			peer, cid,
+			fmt.Errorf("getUpfrontShutdownScript error: %v", err),
+		)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
+	// Generate our required constraints for the remote party, using the
+	// values provided by the channel acceptor if they are non-zero. The
+	// channel reserve isn't negotiated, so both sides derive it from the
+	// capacity of the channel.
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	if acceptorResp.CSVDelay != 0 {
+		remoteCsvDelay = acceptorResp.CSVDelay
+	}
+
+	maxDustLimit := reservation.OurContribution().DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, maxDustLimit)
+
+	remoteMaxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	if acceptorResp.InFlightTotal != 0 {
+		remoteMaxValue = acceptorResp.InFlightTotal
+	}
+
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	if acceptorResp.HtlcLimit != 0 {
+		maxHtlcs = acceptorResp.HtlcLimit
+	}
+
+	minHtlc := f.cfg.DefaultMinHtlcIn
+	if acceptorResp.MinHtlcIn != 0 {
+		minHtlc = acceptorResp.MinHtlcIn
+	}
+
+	ourContribution := reservation.OurContribution()
+	forwardingPolicy := f.defaultForwardingPolicy(
+		ourContribution.ChannelConstraints,
+	)
+
+	f.resMtx.Lock()
+	if _, ok := f.activeReservations[peerIDKey]; !ok {
+		f.activeReservations[peerIDKey] = make(pendingChannels)
+	}
+	resCtx := &reservationWithCtx{
+		reservation:       reservation,
+		chanAmt:           capacity,
+		forwardingPolicy:  *forwardingPolicy,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteMaxValue:    remoteMaxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		remoteChanReserve: chanReserve,
+		maxLocalCsv:       f.cfg.MaxLocalCSVDelay,
+		channelType:       chanType,
+		err:               make(chan error, 1),
+		peer:              peer,
+	}
+	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
+	f.resMtx.Unlock()
+
+	// Update the timestamp once the open_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	remoteContribution := &lnwallet.ChannelContribution{
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: remoteMaxValue,
+				ChanReserve:      chanReserve,
+				MinHTLC:          minHtlc,
+				MaxAcceptedHtlcs: maxHtlcs,
+				CsvDelay:         remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+
+	err = reservation.ProcessDualContribution(remoteContribution, amt)
+	if err != nil {
+		log.Errorf("unable to add contribution reservation: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// We'll also validate and apply all the constraints the initiating
+	// party is attempting to dictate for our commitment transaction.
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		channelConstraints, f.cfg.MaxLocalCSVDelay, true,
+	)
+	if err != nil {
+		log.Errorf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	secondPoint, err := reservation.SecondCommitmentPoint()
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// From now on, the channel is referenced by an id that is derived
+	// from both revocation basepoints.
+	chanID := lnwire.NewChanIDFromRevocationBasepoints(
+		msg.RevocationPoint, ourContribution.RevocationBasePoint.PubKey,
+	)
+	session := interactivetx.NewSession(interactivetx.Config{
+		ChanID:   chanID,
+		LockTime: msg.LockTime,
+	})
+	if err := f.addDualFundingInputs(session, ourContribution); err != nil {
+		log.Errorf("Unable to add funding inputs: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	f.resMtx.Lock()
+	f.dualFundReservations[chanID] = &dualFundingCtx{
+		pendingChanID: msg.PendingChannelID,
+		chanID:        chanID,
+		resCtx:        resCtx,
+		localAmt:      localAmt,
+		remoteAmt:     amt,
+		fundingFeeRate: chainfee.SatPerKWeight(
+			msg.FundingFeePerKWeight,
+		),
+		lockTime: msg.LockTime,
+		session:  session,
+	}
+	f.resMtx.Unlock()
+
+	log.Infof("Sending dual fundingResp for pending_id(%x), "+
+		"chan_id=%v", msg.PendingChannelID, chanID)
+	log.Debugf("Remote party accepted commitment constraints: %v",
+		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+
+	fundingAccept := &lnwire.AcceptChannel2{
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         localAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      remoteMaxValue,
+		HtlcMinimum:           minHtlc,
+		MinAcceptDepth:        uint32(numConfsReq),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		ChannelType:           chanType,
+	}
+
+	if err := peer.SendMessage(true, fundingAccept); err != nil {
+		log.Errorf("unable to send funding response to peer: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+}
+
+// funderProcessAcceptChannel2 processes the response of the remote party to
+// our open_channel2 message. Once their contribution is recorded, the funding
+// output is known, and we start the construction of the funding transaction.
+//
+//nolint:funlen
+func (f *Manager) funderProcessAcceptChannel2(peer lnpeer.Peer,
+	msg *lnwire.AcceptChannel2) {
+
+	dctx, err := f.getDualFundingCtx(peer, msg.PendingChannelID)
+	if err != nil || dctx.session != nil {
+		log.Warnf("Can't find dual funding reservation (peerKey:%x, "+
+			"chan_id:%v)", peer.IdentityKey().SerializeCompressed(),
+			msg.PendingChannelID)
+		return
+	}
+
+	resCtx := dctx.resCtx
+	reservation := resCtx.reservation
+
+	// Update the timestamp once the accept_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	log.Infof("Recv'd dual fundingResponse for pending_id(%x), "+
+		"remote_amt=%v", dctx.pendingChanID[:], msg.FundingAmount)
+
+	// The channel type that we proposed must be echoed back.
+	if resCtx.channelType != nil {
+		if msg.ChannelType == nil {
+			err := errors.New("explicit channel type not echoed " +
+				"back")
+			f.failDualFundingFlow(dctx, err)
+			return
+		}
+		proposedFeatures := lnwire.RawFeatureVector(*resCtx.channelType)
+		ackedFeatures := lnwire.RawFeatureVector(*msg.ChannelType)
+		if !proposedFeatures.Equals(&ackedFeatures) {
+			err := errors.New("channel type mismatch")
+			f.failDualFundingFlow(dctx, err)
+			return
+		}
+	} else if msg.ChannelType != nil {
+		err := errors.New("received unexpected channel type")
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	// The required number of confirmations should not be greater than the
+	// maximum number of confirmations required by the ChainNotifier to
+	// properly dispatch confirmations. As we don't open zero-conf
+	// channels with the dual funding protocol, it also can't be zero.
+	if msg.MinAcceptDepth > chainntnfs.MaxNumConfs {
+		err := lnwallet.ErrNumConfsTooLarge(
+			msg.MinAcceptDepth, chainntnfs.MaxNumConfs,
+		)
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+	if msg.MinAcceptDepth == 0 {
+		err := fmt.Errorf("non-zero-conf channel has min depth zero")
+		log.Warn(err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	// Now that the capacity of the channel is known, we can derive the
+	// channel reserve.
+	capacity := dctx.localAmt + msg.FundingAmount
+	maxDustLimit := reservation.OurContribution().DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	resCtx.remoteChanReserve = f.cfg.RequiredRemoteChanReserve(
+		capacity, maxDustLimit,
+	)
+
+	remoteContribution := &lnwallet.ChannelContribution{
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: resCtx.remoteMaxValue,
+				ChanReserve:      resCtx.remoteChanReserve,
+				MinHTLC:          resCtx.remoteMinHtlc,
+				MaxAcceptedHtlcs: resCtx.remoteMaxHtlcs,
+				CsvDelay:         resCtx.remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+
+	err = reservation.ProcessDualContribution(
+		remoteContribution, msg.FundingAmount,
+	)
+	if err != nil {
+		log.Errorf("Unable to process contribution from %x: %v",
+			peer.IdentityKey().SerializeCompressed(), err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      resCtx.remoteChanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		channelConstraints, resCtx.maxLocalCsv, false,
+	)
+	if err != nil {
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+	resCtx.chanAmt = reservation.Capacity()
+
+	// With both contributions known, we add the funding output and our
+	// inputs to the funding transaction, which is now referenced by the
+	// id derived from both revocation basepoints.
+	ourContribution := reservation.OurContribution()
+	chanID := lnwire.NewChanIDFromRevocationBasepoints(
+		ourContribution.RevocationBasePoint.PubKey, msg.RevocationPoint,
+	)
+	session := interactivetx.NewSession(interactivetx.Config{
+		ChanID:    chanID,
+		Initiator: true,
+		LockTime:  dctx.lockTime,
+	})
+
+	fundingOutput, err := reservation.FundingOutput()
+	if err != nil {
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+	err = session.AddOutput(
+		btcutil.Amount(fundingOutput.Value), fundingOutput.PkScript,
+	)
+	if err != nil {
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+	if err := f.addDualFundingInputs(session, ourContribution); err != nil {
+		log.Errorf("Unable to add funding inputs: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	f.resMtx.Lock()
+	delete(f.dualFundReservations, dctx.chanID)
+	dctx.chanID = chanID
+	dctx.remoteAmt = msg.FundingAmount
+	dctx.session = session
+	f.dualFundReservations[chanID] = dctx
+	f.resMtx.Unlock()
+
+	// As the initiator, we send the first message of the construction.
+	if err := f.sendNextInteractiveTxMsg(dctx); err != nil {
+		f.failDualFundingFlow(dctx, err)
+	}
+}
+
+// addDualFundingInputs adds the inputs and change outputs that the wallet
+// selected for our contribution to the funding transaction.
+func (f *Manager) addDualFundingInputs(session *interactivetx.Session,
+	contribution *lnwallet.ChannelContribution) error {
+
+	for _, txIn := range contribution.Inputs {
+		op := txIn.PreviousOutPoint
+		prevTx, err := f.cfg.Wallet.FetchTx(op.Hash)
+		if err != nil {
+			return fmt.Errorf("unable to fetch tx of input %v: %w",
+				op, err)
+		}
+
+		err = session.AddInput(
+			prevTx, op.Index, interactivetx.MaxSequence,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, txOut := range contribution.ChangeOutputs {
+		err := session.AddOutput(
+			btcutil.Amount(txOut.Value), txOut.PkScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendNextInteractiveTxMsg sends the next message of the construction of the
+// funding transaction to the remote party.
+func (f *Manager) sendNextInteractiveTxMsg(dctx *dualFundingCtx) error {
+	msg, err := dctx.session.NextMsg()
+	if err != nil {
+		return err
+	}
+
+	return dctx.resCtx.peer.SendMessage(true, msg)
+}
+
+// processInteractiveTxMsg applies a message of the remote party to the
+// construction of the funding transaction of a dual funded channel. Both sides
+// take turns, so we respond with our next message until both sides have sent
+// tx_complete, after which we sign the remote commitment transaction.
+func (f *Manager) processInteractiveTxMsg(peer lnpeer.Peer,
+	chanID lnwire.ChannelID, msg lnwire.Message) {
+
+	dctx, err := f.getDualFundingCtx(peer, chanID)
+	if err != nil {
+		log.Warnf("Received %v for unknown channel: %v", msg.MsgType(),
+			err)
+		return
+	}
+
+	if dctx.session == nil || dctx.completeChan != nil {
+		err := fmt.Errorf("unexpected %v message", msg.MsgType())
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	defer dctx.resCtx.updateTimestamp()
+
+	if err := dctx.session.ReceiveMsg(msg); err != nil {
+		log.Errorf("Invalid %v for chan_id=%v: %v", msg.MsgType(),
+			chanID, err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	if !dctx.session.IsComplete() {
+		if err := f.sendNextInteractiveTxMsg(dctx); err != nil {
+			f.failDualFundingFlow(dctx, err)
+			return
+		}
+	}
+
+	// If our or their last tx_complete finished the construction, we sign
+	// the remote commitment transaction.
+	if dctx.session.IsComplete() {
+		f.signDualFundingTx(dctx)
+	}
+}
+
+// signDualFundingTx is called once the funding transaction of a dual funded
+// channel has been constructed. We check that the remote party pays its share
+// of the fees, then sign the remote commitment transaction.
+func (f *Manager) signDualFundingTx(dctx *dualFundingCtx) {
+	fundingTx, err := dctx.session.Tx()
+	if err != nil {
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	err = checkRemoteFundingFee(
+		dctx.session.Contribution(false), dctx.localAmt,
+		dctx.remoteAmt, !dctx.initiator,
+		dctx.fundingFeeRate,
+	)
+	if err != nil {
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	reservation := dctx.resCtx.reservation
+	err = reservation.ProcessFundingTx(
+		fundingTx, dctx.session.PrevOutputFetcher(),
+	)
+	if err != nil {
+		log.Errorf("Unable to process funding tx for chan_id=%v: %v",
+			dctx.chanID, err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	log.Infof("Constructed funding tx with ChannelPoint(%v) for "+
+		"chan_id=%v", reservation.FundingOutpoint(), dctx.chanID)
+
+	_, sig := reservation.OurSignatures()
+	commitSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		log.Errorf("Unable to parse signature: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	err = dctx.resCtx.peer.SendMessage(true, &lnwire.CommitSig{
+		ChanID:    dctx.chanID,
+		CommitSig: commitSig,
+	})
+	if err != nil {
+		log.Errorf("Unable to send commitment signature: %v", err)
+		f.failDualFundingFlow(dctx, err)
+	}
+}
+
+// processDualCommitSig processes the remote party's signature for our initial
+// commitment transaction of a dual funded channel. Once it's verified, the
+// channel is written to the database, as the funding transaction may be
+// published as soon as we send our tx_signatures.
+func (f *Manager) processDualCommitSig(peer lnpeer.Peer,
+	msg *lnwire.CommitSig) {
+
+	dctx, err := f.getDualFundingCtx(peer, msg.ChanID)
+	if err != nil {
+		log.Warnf("Received commitment signature for unknown "+
+			"channel: %v", err)
+		return
+	}
+
+	if dctx.session == nil || !dctx.session.IsComplete() ||
+		dctx.completeChan != nil {
+
+		err := errors.New("unexpected commitment signature")
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	commitSig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		log.Errorf("unable to parse signature: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	resCtx := dctx.resCtx
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig,
+	)
+	if err != nil {
+		log.Errorf("Unable to complete reservation: %v", err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+	dctx.completeChan = completeChan
+
+	// The channel is now marked IsPending in the database, and we can
+	// delete it from our set of active reservations.
+	peerKey := peer.IdentityKey()
+	f.deleteReservationCtx(peerKey, dctx.pendingChanID)
+
+	// Just like for single funded channels, the channel is referenced by
+	// the id derived from its funding outpoint from now on.
+	fundingPoint := completeChan.FundingOutpoint
+	permChanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	if err := peer.AddPendingChannel(permChanID, f.quit); err != nil {
+		log.Errorf("Unable to add pending channel %v with peer %x: %v",
+			permChanID, peerKey.SerializeCompressed(), err)
+	}
+
+	err = f.saveInitialForwardingPolicy(
+		permChanID, &resCtx.forwardingPolicy,
+	)
+	if err != nil {
+		log.Errorf("Unable to store the forwarding policy: %v", err)
+	}
+
+	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
+		log.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", fundingPoint, err)
+	}
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a
+	// channel_ready message.
+	f.localDiscoverySignals.Store(permChanID, make(chan struct{}))
+
+	sendsFirst := interactivetx.SendsSignaturesFirst(
+		dctx.session.Contribution(true),
+		dctx.session.Contribution(false), f.cfg.IDKey, peerKey,
+	)
+	if sendsFirst {
+		if err := f.sendTxSignatures(dctx); err != nil {
+			f.failDualFundingFlow(dctx, err)
+		}
+	}
+}
+
+// sendTxSignatures sends the witnesses of our inputs to the funding
+// transaction to the remote party.
+func (f *Manager) sendTxSignatures(dctx *dualFundingCtx) error {
+	localInputs := make(map[wire.OutPoint]struct{})
+	for _, op := range dctx.session.Inputs(true) {
+		localInputs[op] = struct{}{}
+	}
+
+	fundingTx := dctx.completeChan.FundingTxn
+	var witnesses []wire.TxWitness
+	for _, txIn := range fundingTx.TxIn {
+		if _, ok := localInputs[txIn.PreviousOutPoint]; ok {
+			witnesses = append(witnesses, txIn.Witness)
+		}
+	}
+
+	dctx.sentSigs = true
+
+	return dctx.resCtx.peer.SendMessage(true, &lnwire.TxSignatures{
+		ChannelID: dctx.chanID,
+		TxID:      fundingTx.TxHash(),
+		Witnesses: witnesses,
+	})
+}
+
+// processTxSignatures processes the witnesses of the remote party's inputs to
+// the funding transaction. If they're valid, we send ours if we haven't yet,
+// and publish the funding transaction.
+func (f *Manager) processTxSignatures(peer lnpeer.Peer,
+	msg *lnwire.TxSignatures) {
+
+	dctx, err := f.getDualFundingCtx(peer, msg.ChannelID)
+	if err != nil {
+		log.Warnf("Received tx_signatures for unknown channel: %v",
+			err)
+		return
+	}
+
+	if dctx.completeChan == nil {
+		err := errors.New("unexpected tx_signatures")
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	fundingTx := dctx.completeChan.FundingTxn
+	if msg.TxID != fundingTx.TxHash() {
+		err := fmt.Errorf("tx_signatures for unknown tx %v", msg.TxID)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	err = lnwallet.AddFundingWitnesses(
+		fundingTx, msg.Witnesses, dctx.session.PrevOutputFetcher(),
+	)
+	if err != nil {
+		log.Errorf("Invalid funding witnesses for chan_id=%v: %v",
+			dctx.chanID, err)
+		f.failDualFundingFlow(dctx, err)
+		return
+	}
+
+	if !dctx.sentSigs {
+		if err := f.sendTxSignatures(dctx); err != nil {
+			f.failDualFundingFlow(dctx, err)
+			return
+		}
+	}
+
+	f.publishDualFundingTx(dctx)
+}
+
+// publishDualFundingTx publishes the fully signed funding transaction of a
+// dual funded channel, and waits for the channel to confirm.
+func (f *Manager) publishDualFundingTx(dctx *dualFundingCtx) {
+	f.resMtx.Lock()
+	delete(f.dualFundReservations, dctx.chanID)
+	f.resMtx.Unlock()
+
+	completeChan := dctx.completeChan
+	fundingPoint := completeChan.FundingOutpoint
+
+	log.Infof("Broadcasting dual funding tx for ChannelPoint(%v): %v",
+		fundingPoint, spew.Sdump(completeChan.FundingTxn))
+
+	// Set a nil short channel ID at this stage because we do not know it
+	// until our funding tx confirms.
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+
+	err := f.cfg.PublishTransaction(completeChan.FundingTxn, label)
+	if err != nil {
+		// The remote party publishes the transaction as well, so we
+		// watch the channel regardless.
+		log.Errorf("Unable to broadcast funding tx for "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	// Only the initiator has a caller that waits for updates.
+	resCtx := dctx.resCtx
+	if resCtx.updates != nil {
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid:        fundingPoint.Hash[:],
+					OutputIndex: fundingPoint.Index,
+				},
+			},
+			PendingChanId: dctx.pendingChanID[:],
+		}
+
+		select {
+		case resCtx.updates <- upd:
+		case <-f.quit:
+			return
+		}
+	}
+
+	// Inform the ChannelNotifier that the channel has entered pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingPoint, completeChan)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(
+		completeChan, dctx.pendingChanID, resCtx.updates,
+	)
+}
+
+// processTxAbort processes the abort of the construction of the funding
+// transaction of a dual funded channel by the remote party.
+func (f *Manager) processTxAbort(peer lnpeer.Peer, msg *lnwire.TxAbort) {
+	dctx, err := f.getDualFundingCtx(peer, msg.ChannelID)
+	if err != nil {
+		log.Warnf("Received tx_abort for unknown channel: %v", err)
+		return
+	}
+
+	f.failDualFundingFlow(dctx, fmt.Errorf("received %w from %x", msg,
+		peer.IdentityKey().SerializeCompressed()))
+}
@@ -0,0 +1,92 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckRemoteFundingFee asserts that the inputs and outputs of the remote
+// party must pay for their weight at the funding fee rate, and that the
+// initiator also pays for the common fields and the funding output.
+func TestCheckRemoteFundingFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		feeRate   = chainfee.SatPerKWeight(1000)
+		weight    = 1000
+		localAmt  = btcutil.Amount(50_000)
+		remoteAmt = btcutil.Amount(100_000)
+		capacity  = localAmt + remoteAmt
+	)
+
+	initiatorFee := feeRate.FeeForWeight(
+		weight + interactivetx.CommonWeight(),
+	)
+
+	testCases := []struct {
+		name            string
+		remote          interactivetx.Contribution
+		remoteInitiator bool
+		expectErr       bool
+	}{
+		{
+			name: "responder pays fee",
+			remote: interactivetx.Contribution{
+				Inputs:  remoteAmt + 1_000 + 20_000,
+				Outputs: 20_000,
+				Weight:  weight,
+			},
+		},
+		{
+			name: "responder underpays fee",
+			remote: interactivetx.Contribution{
+				Inputs:  remoteAmt + 999 + 20_000,
+				Outputs: 20_000,
+				Weight:  weight,
+			},
+			expectErr: true,
+		},
+		{
+			name: "initiator pays fee",
+			remote: interactivetx.Contribution{
+				Inputs:  remoteAmt + initiatorFee,
+				Outputs: capacity,
+				Weight:  weight,
+			},
+			remoteInitiator: true,
+		},
+		{
+			name: "initiator doesn't pay common fields",
+			remote: interactivetx.Contribution{
+				Inputs:  remoteAmt + 1_000,
+				Outputs: capacity,
+				Weight:  weight,
+			},
+			remoteInitiator: true,
+			expectErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkRemoteFundingFee(
+				tc.remote, localAmt, remoteAmt,
+				tc.remoteInitiator, feeRate,
+			)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -579,7 +579,12 @@ type Manager struct {
 	// signed by both parties.
 	signedReservations map[lnwire.ChannelID][32]byte
 
-	// resMtx guards both of the maps above to ensure that all access is
+	// dualFundReservations maps the wire id of each channel that is
+	// opened with the dual funding protocol to its funding context, until
+	// the funding transaction is published.
+	dualFundReservations map[lnwire.ChannelID]*dualFundingCtx
+
+	// resMtx guards all of the maps above to ensure that all access is
 	// goroutine safe.
 	resMtx sync.RWMutex
 
@@ -647,6 +652,9 @@ func NewFundingManager(cfg Config) (*Manager, error) {
 		signedReservations: make(
 			map[lnwire.ChannelID][32]byte,
 		),
+		dualFundReservations: make(
+			map[lnwire.ChannelID]*dualFundingCtx,
+		),
 		fundingMsgs: make(
 			chan *fundingMsg, msgBufferSize,
 		),
@@ -837,6 +845,15 @@ func (f *Manager) CancelPeerReservations(nodePub [33]byte) {
 		delete(nodeReservations, pendingID)
 	}
 
+	// Dual funded channels that haven't been published yet are forgotten
+	// as well.
+	for chanID, dctx := range f.dualFundReservations {
+		peerKey := newSerializedKey(dctx.resCtx.peer.IdentityKey())
+		if peerKey == nodePub {
+			delete(f.dualFundReservations, chanID)
+		}
+	}
+
 	// Finally, we'll delete the node itself from the set of reservations.
 	delete(f.activeReservations, nodePub)
 }
@@ -921,33 +938,36 @@ func (f *Manager) failFundingFlow(peer lnpeer.Peer, cid *chanIdentifier,
 		ctx.err <- fundingErr
 	}
 
-	// We only send the exact error if it is part of out whitelisted set of
-	// errors (lnwire.FundingError or lnwallet.ReservationError).
-	var msg lnwire.ErrorData
+	errMsg := &lnwire.Error{
+		ChanID: cid.tempChanID,
+		Data:   fundingErrorData(fundingErr),
+	}
+
+	log.Debugf("Sending funding error to peer (%x): %v",
+		peer.IdentityKey().SerializeCompressed(), spew.Sdump(errMsg))
+	if err := peer.SendMessage(false, errMsg); err != nil {
+		log.Errorf("unable to send error message to peer %v", err)
+	}
+}
+
+// fundingErrorData returns the data of the error message that we send to the
+// remote peer when failing a funding flow. We only send the exact error if it
+// is part of our whitelisted set of errors (lnwire.FundingError or
+// lnwallet.ReservationError).
+func fundingErrorData(fundingErr error) lnwire.ErrorData {
 	switch e := fundingErr.(type) {
 	// Let the actual error message be sent to the remote for the
 	// whitelisted types.
 	case lnwallet.ReservationError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 	case lnwire.FundingError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 	case chanacceptor.ChanAcceptError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 
 	// For all other error types we just send a generic error.
 	default:
-		msg = lnwire.ErrorData("funding failed due to internal error")
-	}
-
-	errMsg := &lnwire.Error{
-		ChanID: cid.tempChanID,
-		Data:   msg,
-	}
-
-	log.Debugf("Sending funding error to peer (%x): %v",
-		peer.IdentityKey().SerializeCompressed(), spew.Sdump(errMsg))
-	if err := peer.SendMessage(false, errMsg); err != nil {
-		log.Errorf("unable to send error message to peer %v", err)
+		return lnwire.ErrorData("funding failed due to internal error")
 	}
 }
 
@@ -977,6 +997,46 @@ func (f *Manager) reservationCoordinator() {
 			case *lnwire.FundingSigned:
 				f.funderProcessFundingSigned(fmsg.peer, msg)
 
+			case *lnwire.OpenChannel2:
+				f.fundeeProcessOpenChannel2(fmsg.peer, msg)
+
+			case *lnwire.AcceptChannel2:
+				f.funderProcessAcceptChannel2(fmsg.peer, msg)
+
+			case *lnwire.TxAddInput:
+				f.processInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+
+			case *lnwire.TxAddOutput:
+				f.processInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+
+			case *lnwire.TxRemoveInput:
+				f.processInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+
+			case *lnwire.TxRemoveOutput:
+				f.processInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+
+			case *lnwire.TxComplete:
+				f.processInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+
+			case *lnwire.CommitSig:
+				f.processDualCommitSig(fmsg.peer, msg)
+
+			case *lnwire.TxSignatures:
+				f.processTxSignatures(fmsg.peer, msg)
+
+			case *lnwire.TxAbort:
+				f.processTxAbort(fmsg.peer, msg)
+
 			case *lnwire.ChannelReady:
 				f.wg.Add(1)
 				go f.handleChannelReady(fmsg.peer, msg)
@@ -1332,25 +1392,13 @@ func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
 	}
 }
 
-// fundeeProcessOpenChannel creates an initial 'ChannelReservation' within the
-// wallet, then responds to the source peer with an accept channel message
-// progressing the funding workflow.
-//
-// TODO(roasbeef): add error chan to all, let channelManager handle
-// error+propagate.
-//
-//nolint:funlen
-func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
-	msg *lnwire.OpenChannel) {
-
-	// Check number of pending channels to be smaller than maximum allowed
-	// number and send ErrorGeneric to remote peer if condition is
-	// violated.
+// checkPendingChanLimits returns an error if we can't accept another inbound
+// channel from the peer, either because of the limits on the number of
+// pending channels, or because we aren't synced to the chain yet.
+func (f *Manager) checkPendingChanLimits(peer lnpeer.Peer) error {
 	peerPubKey := peer.IdentityKey()
 	peerIDKey := newSerializedKey(peerPubKey)
 
-	amt := msg.FundingAmount
-
 	// We get all pending channels for this peer. This is the list of the
 	// active reservations and the channels pending open in the database.
 	f.resMtx.RLock()
@@ -1367,15 +1415,11 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	}
 	f.resMtx.RUnlock()
 
-	// Create the channel identifier.
-	cid := newChanIdentifier(msg.PendingChannelID)
-
 	// Also count the channels that are already pending. There we don't know
 	// the underlying intent anymore, unfortunately.
 	channels, err := f.cfg.ChannelDB.FetchOpenChannels(peerPubKey)
 	if err != nil {
-		f.failFundingFlow(peer, cid, err)
-		return
+		return err
 	}
 
 	for _, c := range channels {
@@ -1393,21 +1437,17 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	// TODO(roasbeef): modify to only accept a _single_ pending channel per
 	// block unless white listed
 	if numPending >= f.cfg.MaxPendingChannels {
-		f.failFundingFlow(peer, cid, lnwire.ErrMaxPendingChannels)
-
-		return
+		return lnwire.ErrMaxPendingChannels
 	}
 
 	// Ensure that the pendingChansLimit is respected.
 	pendingChans, err := f.cfg.ChannelDB.FetchPendingChannels()
 	if err != nil {
-		f.failFundingFlow(peer, cid, err)
-		return
+		return err
 	}
 
 	if len(pendingChans) > pendingChansLimit {
-		f.failFundingFlow(peer, cid, lnwire.ErrMaxPendingChannels)
-		return
+		return lnwire.ErrMaxPendingChannels
 	}
 
 	// We'll also reject any requests to create channels until we're fully
@@ -1418,7 +1458,33 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		if err != nil {
 			log.Errorf("unable to query wallet: %v", err)
 		}
-		err := errors.New("Synchronizing blockchain")
+		return errors.New("Synchronizing blockchain")
+	}
+
+	return nil
+}
+
+// fundeeProcessOpenChannel creates an initial 'ChannelReservation' within the
+// wallet, then responds to the source peer with an accept channel message
+// progressing the funding workflow.
+//
+// TODO(roasbeef): add error chan to all, let channelManager handle
+// error+propagate.
+//
+//nolint:funlen
+func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) {
+
+	peerIDKey := newSerializedKey(peer.IdentityKey())
+	amt := msg.FundingAmount
+
+	// Create the channel identifier.
+	cid := newChanIdentifier(msg.PendingChannelID)
+
+	// Check number of pending channels to be smaller than maximum allowed
+	// number and send ErrorGeneric to remote peer if condition is
+	// violated.
+	if err := f.checkPendingChanLimits(peer); err != nil {
 		f.failFundingFlow(peer, cid, err)
 		return
 	}
@@ -4541,6 +4607,11 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		scidFeatureVal = true
 	}
 
+	// If both sides support it, the channel is opened with the dual
+	// funding protocol, which allows the remote party to contribute funds
+	// to the channel as well.
+	dualFund := useDualFunding(msg, commitType, zeroConf)
+
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:         &msg.ChainHash,
 		PendingChanID:     chanID,
@@ -4564,6 +4635,8 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		OptionScidAlias:   scid,
 		ScidAliasFeature:  scidFeatureVal,
 		Memo:              msg.Memo,
+		DualFund:          dualFund,
+		Initiator:         true,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -4744,6 +4817,28 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		LeaseExpiry:           leaseExpiry,
 		LocalNonce:            localNonce,
 	}
+
+	if dualFund {
+		err := f.sendOpenChannel2(
+			resCtx, chanID, &fundingOpen, msg.FundingFeePerKw,
+		)
+		if err != nil {
+			log.Errorf("Unable to start dual funding flow: %v", err)
+
+			_, cancelErr := f.cancelReservationCtx(
+				peerKey, chanID, false,
+			)
+			if cancelErr != nil {
+				log.Errorf("unable to cancel reservation: %v",
+					cancelErr)
+			}
+
+			msg.Err <- err
+		}
+
+		return
+	}
+
 	if err := msg.Peer.SendMessage(true, &fundingOpen); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %v",
 			err)
@@ -4774,6 +4869,18 @@ func (f *Manager) handleErrorMsg(peer lnpeer.Peer, msg *lnwire.Error) {
 	chanID := msg.ChanID
 	peerKey := peer.IdentityKey()
 
+	// Dual funded channels are referenced by a different id on the wire
+	// than within the set of active reservations. Once the channel has
+	// been written to the database, its reservation is gone, and the
+	// channel is forgotten after the funding timeout if it never confirms.
+	if dctx, err := f.getDualFundingCtx(peer, chanID); err == nil {
+		f.resMtx.Lock()
+		delete(f.dualFundReservations, dctx.chanID)
+		f.resMtx.Unlock()
+
+		chanID = dctx.pendingChanID
+	}
+
 	// First, we'll attempt to retrieve and cancel the funding workflow
 	// that this error was tied to. If we're unable to do so, then we'll
 	// exit early as this was an unwarranted error.
@@ -4893,6 +5000,15 @@ func (f *Manager) cancelReservationCtx(peerKey *btcec.PublicKey,
 	if len(nodeReservations) == 0 {
 		delete(f.activeReservations, peerIDKey)
 	}
+
+	// If the channel was dual funded, we also forget the construction of
+	// its funding transaction.
+	for chanID, dctx := range f.dualFundReservations {
+		if dctx.resCtx == ctx {
+			delete(f.dualFundReservations, chanID)
+		}
+	}
+
 	return ctx, nil
 }
 
@@ -4945,6 +5061,10 @@ func (f *Manager) getReservationCtx(peerKey *btcec.PublicKey,
 func (f *Manager) IsPendingChannel(pendingChanID [32]byte,
 	peer lnpeer.Peer) bool {
 
+	if _, err := f.getDualFundingCtx(peer, pendingChanID); err == nil {
+		return true
+	}
+
 	peerIDKey := newSerializedKey(peer.IdentityKey())
 	f.resMtx.RLock()
 	_, ok := f.activeReservations[peerIDKey][pendingChanID]
@@ -0,0 +1,41 @@
+package funding
+
+import (
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// HandleSplicedChannel starts the funding state machine for a channel that
+// has replaced a spliced channel once the splice transaction confirmed. The
+// channel is pending until its funding output has sufficient confirmations,
+// after which it's announced with its new short channel ID in the same way as
+// a newly funded channel. The forwarding policy of the spliced channel is
+// applied to the new channel, unless it's nil.
+func (f *Manager) HandleSplicedChannel(channel *channeldb.OpenChannel,
+	forwardingPolicy *models.ForwardingPolicy) error {
+
+	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+
+	log.Infof("Starting funding flow for spliced ChannelPoint(%v)",
+		channel.FundingOutpoint)
+
+	if forwardingPolicy != nil {
+		err := f.saveInitialForwardingPolicy(chanID, forwardingPolicy)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a
+	// channel_ready message.
+	f.localDiscoverySignals.Store(chanID, make(chan struct{}))
+
+	f.cfg.NotifyPendingOpenChannelEvent(channel.FundingOutpoint, channel)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(channel, chanID, nil)
+
+	return nil
+}
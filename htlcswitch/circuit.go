@@ -201,6 +201,16 @@ func (c *PaymentCircuit) Decode(r io.Reader) error {
 		// Sphinx encrypter was used as this is a forwarded HTLC.
 		c.ErrorEncrypter = hop.NewSphinxErrorEncrypter()
 
+	case hop.EncrypterTypeIntroduction:
+		// Sphinx encrypter for an HTLC that we forwarded as the
+		// introduction node of a blinded route.
+		c.ErrorEncrypter = hop.NewIntroductionErrorEncrypter()
+
+	case hop.EncrypterTypeRelaying:
+		// Sphinx encrypter for an HTLC that we relayed within a
+		// blinded route.
+		c.ErrorEncrypter = hop.NewRelayingErrorEncrypter()
+
 	case hop.EncrypterTypeMock:
 		// Test encrypter.
 		c.ErrorEncrypter = NewMockObfuscator()
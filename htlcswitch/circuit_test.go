@@ -80,6 +80,12 @@ func initTestExtracter() {
 	// We also set this error extracter on startup, otherwise it will be nil
 	// at compile-time.
 	halfCircuitTests[2].encrypter = testExtracter
+	halfCircuitTests[3].encrypter = &hop.IntroductionErrorEncrypter{
+		SphinxErrorEncrypter: testExtracter,
+	}
+	halfCircuitTests[4].encrypter = &hop.RelayingErrorEncrypter{
+		SphinxErrorEncrypter: testExtracter,
+	}
 }
 
 // newOnionProcessor creates starts a new htlcswitch.OnionProcessor using a temp
@@ -94,7 +100,7 @@ func newOnionProcessor(t *testing.T) *hop.OnionProcessor {
 		t.Fatalf("unable to start sphinx router: %v", err)
 	}
 
-	return hop.NewOnionProcessor(sphinxRouter)
+	return hop.NewOnionProcessor(sphinxRouter, sphinxPrivKey.PubKey())
 }
 
 // newCircuitMap creates a new htlcswitch.CircuitMap using a temp db and a
@@ -175,6 +181,24 @@ var halfCircuitTests = []struct {
 		// repopulate this encrypter.
 		encrypter: testExtracter,
 	},
+	{
+		hash:     hash1,
+		inValue:  10000,
+		outValue: 9000,
+		chanID:   lnwire.NewShortChanIDFromInt(4),
+		htlcID:   4,
+		// NOTE: The blinded route encrypters wrap testExtracter, so
+		// they are populated in initTestExtracter as well.
+		encrypter: nil,
+	},
+	{
+		hash:      hash2,
+		inValue:   10000,
+		outValue:  9000,
+		chanID:    lnwire.NewShortChanIDFromInt(5),
+		htlcID:    5,
+		encrypter: nil,
+	},
 }
 
 // TestHalfCircuitSerialization checks that the half circuits can be properly
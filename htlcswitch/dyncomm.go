@@ -0,0 +1,267 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrDynCommitNotSupported is returned when a channel is upgraded
+	// while the dynamic commitments feature wasn't negotiated with the
+	// peer.
+	ErrDynCommitNotSupported = errors.New("dynamic commitments not " +
+		"supported by channel peer")
+
+	// ErrUpgradeInProgress is returned when a channel is upgraded or
+	// resumed while an upgrade of the channel is in progress.
+	ErrUpgradeInProgress = errors.New("channel upgrade in progress")
+
+	// ErrUpgradeNotInitiator is returned when a channel is upgraded while
+	// the remote peer is the initiator of its quiescence.
+	ErrUpgradeNotInitiator = errors.New("channel can't be upgraded when " +
+		"the remote peer initiated the quiescence")
+
+	// ErrUpgradeRejected is returned when the remote peer rejects the
+	// upgrade of a channel.
+	ErrUpgradeRejected = errors.New("channel upgrade rejected by peer")
+)
+
+// upgradeReq is a request to upgrade the channel with a dynamic commitment
+// upgrade. The channelLink sends nil on the err channel once the upgrade has
+// been applied, and an error if it failed or was rejected.
+type upgradeReq struct {
+	proposal *lnwallet.UpgradeProposal
+	err      chan error
+}
+
+// handleUpgradeReq starts the upgrade of the channel. The channel is quiesced
+// first, after which the upgrade is proposed to the remote peer.
+func (l *channelLink) handleUpgradeReq(req *upgradeReq) {
+	if l.pendingUpgrade != nil {
+		req.err <- ErrUpgradeInProgress
+		return
+	}
+
+	// We'll validate the proposal upfront, so that an invalid proposal
+	// doesn't quiesce the channel.
+	if _, _, err := l.channel.ProposeUpgrade(req.proposal); err != nil {
+		req.err <- err
+		return
+	}
+
+	l.pendingUpgrade = req
+
+	if l.quiescer.isQuiescent() {
+		l.proposeUpgrade(l.quiescer.isInitiator())
+		return
+	}
+
+	l.log.Infof("Quiescing channel to upgrade it")
+
+	l.quiescer.initStfu()
+	l.quiescing.Store(true)
+}
+
+// proposeUpgrade sends the proposal of the pending upgrade to the remote peer
+// once the channel is quiescent. Only the initiator of the quiescence may
+// propose an upgrade.
+func (l *channelLink) proposeUpgrade(initiator bool) {
+	req := l.pendingUpgrade
+
+	// If the remote peer initiated the quiescence, it leads the protocol
+	// that's run while the channel is quiet, so the upgrade fails.
+	if !initiator {
+		l.pendingUpgrade = nil
+		req.err <- ErrUpgradeNotInitiator
+		return
+	}
+
+	msg, params, err := l.channel.ProposeUpgrade(req.proposal)
+	if err == nil {
+		// The proposal is stored before it's sent, so that we can
+		// apply the upgrade if the remote peer acknowledges it while
+		// we're disconnected.
+		err = l.channel.State().MarkUpgradePending(params)
+	}
+	if err == nil {
+		err = l.cfg.Peer.SendMessage(false, msg)
+	}
+	if err != nil {
+		l.pendingUpgrade = nil
+		req.err <- err
+		l.resumeAfterUpgrade()
+		return
+	}
+
+	l.log.Infof("Proposed channel upgrade: chan_type=%v, dust_limit=%v, "+
+		"remote_csv_delay=%v", params.ChanType,
+		params.LocalConstraints.DustLimit,
+		params.RemoteConstraints.CsvDelay)
+
+	l.proposedParams = params
+}
+
+// resumeAfterUpgrade resumes the channel once an upgrade has completed, and
+// processes the updates that were held back in the meantime.
+func (l *channelLink) resumeAfterUpgrade() {
+	if err := l.resume(); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to resume channel after upgrade: %v", err)
+		return
+	}
+
+	l.processHeldUpdates()
+}
+
+// failUnexpectedDynMsg fails the link because the remote peer sent a message
+// of the upgrade protocol that we didn't expect.
+func (l *channelLink) failUnexpectedDynMsg(format string, a ...interface{}) {
+	l.fail(
+		LinkFailureError{
+			code:          ErrInvalidUpdate,
+			FailureAction: LinkFailureDisconnect,
+			Warning:       true,
+		},
+		format, a...,
+	)
+}
+
+// handleDynPropose processes an upgrade proposed by the remote peer, which
+// must be the initiator of the quiescence of the channel. The upgrade is
+// applied if its parameters are acceptable, and rejected otherwise. Either
+// way, the channel is resumed afterwards.
+func (l *channelLink) handleDynPropose(msg *lnwire.DynPropose) {
+	switch {
+	case l.cfg.DisallowDynCommit:
+		l.failUnexpectedDynMsg("received dyn_propose, but dynamic " +
+			"commitments weren't negotiated")
+		return
+
+	case !l.quiescer.isQuiescent() || l.quiescer.isInitiator():
+		l.failUnexpectedDynMsg("received dyn_propose, but remote " +
+			"peer isn't the initiator of a quiescent channel")
+		return
+	}
+
+	params, err := l.channel.ProcessUpgrade(msg, l.cfg.MaxLocalCSVDelay)
+	if err != nil {
+		l.log.Warnf("Rejecting channel upgrade: %v", err)
+
+		err = l.cfg.Peer.SendMessage(false, &lnwire.DynReject{
+			ChanID: l.ChanID(),
+			Reason: lnwire.ErrorData(err.Error()),
+		})
+		if err != nil {
+			l.log.Errorf("unable to send dyn_reject: %v", err)
+		}
+
+		l.resumeAfterUpgrade()
+		return
+	}
+
+	if err := l.channel.ApplyUpgrade(params); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to apply channel upgrade: %v", err)
+		return
+	}
+
+	l.log.Infof("Applied channel upgrade: chan_type=%v, dust_limit=%v, "+
+		"local_csv_delay=%v", params.ChanType,
+		params.LocalConstraints.DustLimit,
+		params.LocalConstraints.CsvDelay)
+
+	err = l.cfg.Peer.SendMessage(false, &lnwire.DynAck{
+		ChanID: l.ChanID(),
+	})
+	if err != nil {
+		l.log.Errorf("unable to send dyn_ack: %v", err)
+	}
+
+	// We owe the remote peer a commitment in the new format, which is
+	// signed once the channel has resumed.
+	l.resumeAfterUpgrade()
+}
+
+// handleDynAck applies the upgrade that we've proposed once the remote peer
+// has acknowledged it, and resumes the channel.
+func (l *channelLink) handleDynAck(msg *lnwire.DynAck) {
+	if l.proposedParams == nil {
+		l.failUnexpectedDynMsg("received dyn_ack, but no upgrade " +
+			"was proposed")
+		return
+	}
+
+	req, params := l.pendingUpgrade, l.proposedParams
+	l.pendingUpgrade, l.proposedParams = nil, nil
+
+	if err := l.channel.ApplyUpgrade(params); err != nil {
+		req.err <- err
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to apply channel upgrade: %v", err)
+		return
+	}
+
+	l.log.Infof("Remote peer acknowledged channel upgrade")
+
+	req.err <- nil
+	l.resumeAfterUpgrade()
+}
+
+// handleDynReject removes the upgrade that we've proposed once the remote
+// peer has rejected it, and resumes the channel.
+func (l *channelLink) handleDynReject(msg *lnwire.DynReject) {
+	if l.proposedParams == nil {
+		l.failUnexpectedDynMsg("received dyn_reject, but no upgrade " +
+			"was proposed")
+		return
+	}
+
+	req := l.pendingUpgrade
+	l.pendingUpgrade, l.proposedParams = nil, nil
+
+	l.log.Infof("Remote peer rejected channel upgrade: %v",
+		string(msg.Reason))
+
+	if err := l.channel.State().ClearPendingUpgrade(); err != nil {
+		req.err <- err
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to clear pending upgrade: %v", err)
+		return
+	}
+
+	req.err <- fmt.Errorf("%w: %v", ErrUpgradeRejected, string(msg.Reason))
+	l.resumeAfterUpgrade()
+}
+
+// UpgradeChannel upgrades the channel with a dynamic commitment upgrade,
+// which changes its commitment type or constraints without closing it. The
+// channel is quiesced while the upgrade is negotiated, and resumed
+// afterwards. It blocks until the upgrade has been applied or rejected.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) UpgradeChannel(p *lnwallet.UpgradeProposal) error {
+	if l.cfg.DisallowDynCommit {
+		return ErrDynCommitNotSupported
+	}
+
+	req := &upgradeReq{
+		proposal: p,
+		err:      make(chan error, 1),
+	}
+
+	select {
+	case l.upgradeRequests <- req:
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+
+	select {
+	case err := <-req.err:
+		return err
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+}
@@ -25,8 +25,24 @@ const (
 
 	// EncrypterTypeMock is used to identify a mock obfuscator instance.
 	EncrypterTypeMock = 2
+
+	// EncrypterTypeIntroduction is used to identify a sphinx onion error
+	// encrypter instance for an htlc that we received as the introduction
+	// node of a blinded route.
+	EncrypterTypeIntroduction = 3
+
+	// EncrypterTypeRelaying is used to identify a sphinx onion error
+	// encrypter instance for an htlc that we received as a relaying node
+	// within a blinded route.
+	EncrypterTypeRelaying = 4
 )
 
+// IsBlinded returns true if the encrypter type is used for htlcs that were
+// received within a blinded route.
+func (e EncrypterType) IsBlinded() bool {
+	return e == EncrypterTypeIntroduction || e == EncrypterTypeRelaying
+}
+
 // ErrorEncrypterExtracter defines a function signature that extracts an
 // ErrorEncrypter from an sphinx OnionPacket.
 type ErrorEncrypterExtracter func(*btcec.PublicKey) (ErrorEncrypter,
@@ -203,3 +219,104 @@ func (s *SphinxErrorEncrypter) Reextract(
 // A compile time check to ensure SphinxErrorEncrypter implements the
 // ErrorEncrypter interface.
 var _ ErrorEncrypter = (*SphinxErrorEncrypter)(nil)
+
+// IntroductionErrorEncrypter is a sphinx error encrypter for htlcs that we
+// received as the introduction node of a blinded route. To avoid leaking
+// information about the blinded route to the sender, every failure is
+// replaced with an invalid_onion_blinding failure that originates at our
+// node.
+type IntroductionErrorEncrypter struct {
+	*SphinxErrorEncrypter
+}
+
+// NewIntroductionErrorEncrypter initializes a blank introduction error
+// encrypter, that should be used to deserialize an encoded
+// IntroductionErrorEncrypter.
+func NewIntroductionErrorEncrypter() *IntroductionErrorEncrypter {
+	return &IntroductionErrorEncrypter{
+		SphinxErrorEncrypter: NewSphinxErrorEncrypter(),
+	}
+}
+
+// EncryptFirstHop encrypts an invalid_onion_blinding failure in place of the
+// given failure. If the failure already is an invalid_onion_blinding failure,
+// it is encrypted as is.
+//
+// NOTE: Part of the ErrorEncrypter interface.
+func (i *IntroductionErrorEncrypter) EncryptFirstHop(
+	failure lnwire.FailureMessage) (lnwire.OpaqueReason, error) {
+
+	if _, ok := failure.(*lnwire.FailInvalidBlinding); !ok {
+		failure = lnwire.NewInvalidBlinding(nil)
+	}
+
+	return i.SphinxErrorEncrypter.EncryptFirstHop(failure)
+}
+
+// EncryptMalformedError replaces the malformed failure reported by the next
+// hop with an invalid_onion_blinding failure.
+//
+// NOTE: Part of the ErrorEncrypter interface.
+func (i *IntroductionErrorEncrypter) EncryptMalformedError(
+	_ lnwire.OpaqueReason) lnwire.OpaqueReason {
+
+	return i.blindingFailure()
+}
+
+// IntermediateEncrypt replaces the failure reported by the next hop with an
+// invalid_onion_blinding failure.
+//
+// NOTE: Part of the ErrorEncrypter interface.
+func (i *IntroductionErrorEncrypter) IntermediateEncrypt(
+	_ lnwire.OpaqueReason) lnwire.OpaqueReason {
+
+	return i.blindingFailure()
+}
+
+// blindingFailure returns an encrypted invalid_onion_blinding failure
+// that originates at our node.
+func (i *IntroductionErrorEncrypter) blindingFailure() lnwire.OpaqueReason {
+	var b bytes.Buffer
+	err := lnwire.EncodeFailure(&b, lnwire.NewInvalidBlinding(nil), 0)
+	if err != nil {
+		// Encoding a fixed size failure into a buffer can't fail.
+		log.Errorf("Unable to encode invalid blinding failure: %v", err)
+	}
+
+	return i.EncryptError(true, b.Bytes())
+}
+
+// Type returns the identifier for an introduction error encrypter.
+func (i *IntroductionErrorEncrypter) Type() EncrypterType {
+	return EncrypterTypeIntroduction
+}
+
+// A compile time check to ensure IntroductionErrorEncrypter implements the
+// ErrorEncrypter interface.
+var _ ErrorEncrypter = (*IntroductionErrorEncrypter)(nil)
+
+// RelayingErrorEncrypter is a sphinx error encrypter for htlcs that we
+// received as a relaying node within a blinded route. Failures of such htlcs
+// are sent to the previous hop as malformed invalid_onion_blinding failures,
+// so the encrypted reason is never revealed. The encrypter is only
+// distinguished by its type, which tells the link to do so.
+type RelayingErrorEncrypter struct {
+	*SphinxErrorEncrypter
+}
+
+// NewRelayingErrorEncrypter initializes a blank relaying error encrypter,
+// that should be used to deserialize an encoded RelayingErrorEncrypter.
+func NewRelayingErrorEncrypter() *RelayingErrorEncrypter {
+	return &RelayingErrorEncrypter{
+		SphinxErrorEncrypter: NewSphinxErrorEncrypter(),
+	}
+}
+
+// Type returns the identifier for a relaying error encrypter.
+func (r *RelayingErrorEncrypter) Type() EncrypterType {
+	return EncrypterTypeRelaying
+}
+
+// A compile time check to ensure RelayingErrorEncrypter implements the
+// ErrorEncrypter interface.
+var _ ErrorEncrypter = (*RelayingErrorEncrypter)(nil)
@@ -0,0 +1,74 @@
+package hop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIntroductionErrorEncrypter tests that every failure encrypted by the
+// introduction node of a blinded route is replaced with an
+// invalid_onion_blinding failure that originates at the introduction node.
+func TestIntroductionErrorEncrypter(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	router := sphinx.NewRouter(
+		&keychain.PrivKeyECDH{PrivKey: nodeKey},
+		&chaincfg.RegressionNetParams, nil,
+	)
+
+	processor := NewOnionProcessor(router, nodeKey.PubKey())
+	encrypter, failCode := processor.ExtractErrorEncrypter(
+		sessionKey.PubKey(),
+	)
+	require.Equal(t, lnwire.CodeNone, failCode)
+
+	introEncrypter := &IntroductionErrorEncrypter{
+		SphinxErrorEncrypter: encrypter.(*SphinxErrorEncrypter),
+	}
+	require.True(t, introEncrypter.Type().IsBlinded())
+
+	// The sender is able to decrypt failures from our node, which is the
+	// first hop of the route.
+	decrypter := sphinx.NewOnionErrorDecrypter(&sphinx.Circuit{
+		SessionKey:  sessionKey,
+		PaymentPath: []*btcec.PublicKey{nodeKey.PubKey()},
+	})
+
+	assertInvalidBlinding := func(reason lnwire.OpaqueReason) {
+		t.Helper()
+
+		decrypted, err := decrypter.DecryptError(reason)
+		require.NoError(t, err)
+		require.Equal(t, 1, decrypted.SenderIdx)
+
+		failure, err := lnwire.DecodeFailure(
+			bytes.NewReader(decrypted.Message), 0,
+		)
+		require.NoError(t, err)
+		require.Equal(t, lnwire.NewInvalidBlinding(nil), failure)
+	}
+
+	// A failure at our own node is replaced.
+	reason, err := introEncrypter.EncryptFirstHop(
+		lnwire.NewTemporaryChannelFailure(nil),
+	)
+	require.NoError(t, err)
+	assertInvalidBlinding(reason)
+
+	// Failures from later hops in the blinded route are replaced as well.
+	assertInvalidBlinding(introEncrypter.IntermediateEncrypt(reason))
+	assertInvalidBlinding(introEncrypter.EncryptMalformedError(nil))
+}
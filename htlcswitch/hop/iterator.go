@@ -2,6 +2,7 @@ package hop
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -9,8 +10,21 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
 )
 
+var (
+	// ErrInvalidBlindedData is returned when the route blinding data of a
+	// hop can't be decrypted or doesn't match the onion payload.
+	ErrInvalidBlindedData = errors.New("invalid route blinding data")
+)
+
+// IsBlindingError returns true if the error was caused by the route blinding
+// data of a hop payload.
+func IsBlindingError(err error) bool {
+	return errors.Is(err, ErrInvalidBlindedData)
+}
+
 // Iterator is an interface that abstracts away the routing information
 // included in HTLC's which includes the entirety of the payment path of an
 // HTLC. This interface provides two basic method which carry out: how to
@@ -47,16 +61,50 @@ type sphinxHopIterator struct {
 	// includes the information required to properly forward the packet to
 	// the next hop.
 	processedPacket *sphinx.ProcessedPacket
+
+	// blindingKit holds what is needed to process the payload of an HTLC
+	// that is part of a blinded route.
+	blindingKit blindingKit
+}
+
+// blindingKit contains the components required to decrypt the route blinding
+// data of a hop payload, and to peel the dummy hops that we added to our own
+// blinded paths.
+type blindingKit struct {
+	// router is the sphinx router used to decrypt the route blinding data
+	// and to reconstruct the onion layers of our dummy hops.
+	router *sphinx.Router
+
+	// nodeKey is our node's public key. It is used to detect the dummy
+	// hops of our own blinded paths.
+	nodeKey *btcec.PublicKey
+
+	// rHash is the payment hash of the HTLC, which is used as associated
+	// data when processing the onion.
+	rHash []byte
+
+	// updateAddBlinding is the blinding point that was included in
+	// update_add_htlc, if any.
+	updateAddBlinding *btcec.PublicKey
+
+	// incomingAmount is the amount of the incoming HTLC. It is used to
+	// derive the amount to forward within a blinded route.
+	incomingAmount lnwire.MilliSatoshi
+
+	// incomingCltv is the expiry height of the incoming HTLC. It is used
+	// to derive the outgoing expiry within a blinded route.
+	incomingCltv uint32
 }
 
 // makeSphinxHopIterator converts a processed packet returned from a sphinx
 // router and converts it into an hop iterator for usage in the link.
 func makeSphinxHopIterator(ogPacket *sphinx.OnionPacket,
-	packet *sphinx.ProcessedPacket) *sphinxHopIterator {
+	packet *sphinx.ProcessedPacket, kit blindingKit) *sphinxHopIterator {
 
 	return &sphinxHopIterator{
 		ogPacket:        ogPacket,
 		processedPacket: packet,
+		blindingKit:     kit,
 	}
 }
 
@@ -90,9 +138,15 @@ func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
 	// Otherwise, if this is the TLV payload, then we'll make a new stream
 	// to decode only what we need to make routing decisions.
 	case sphinx.PayloadTLV:
-		return NewPayloadFromReader(bytes.NewReader(
+		isFinal := r.processedPacket.Action == sphinx.ExitNode
+		payload, err := NewPayloadFromReader(bytes.NewReader(
 			r.processedPacket.Payload.Payload,
-		))
+		), isFinal)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.processBlindedPayload(payload, isFinal)
 
 	default:
 		return nil, fmt.Errorf("unknown sphinx payload type: %v",
@@ -100,6 +154,265 @@ func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
 	}
 }
 
+// processBlindedPayload decrypts the route blinding data of a payload that was
+// received within a blinded route. The forwarding instructions of
+// intermediate hops are derived from the decrypted data. If the payload
+// belongs to one of the dummy hops that we appended to our own blinded paths,
+// the next onion layer is peeled and processed instead. Payloads that aren't
+// part of a blinded route are returned as is.
+func (r *sphinxHopIterator) processBlindedPayload(payload *Payload,
+	isFinal bool) (*Payload, error) {
+
+	kit := r.blindingKit
+
+	// A blinding point in update_add_htlc means that we're inside of a
+	// blinded route, so we expect encrypted data in the onion.
+	if payload.encryptedData == nil {
+		if kit.updateAddBlinding != nil {
+			return nil, ErrInvalidPayload{
+				Type:      record.EncryptedDataOnionType,
+				Violation: OmittedViolation,
+				FinalHop:  isFinal,
+			}
+		}
+
+		return payload, nil
+	}
+
+	// The blinding point is either provided by the previous hop, or in
+	// the onion if we're the introduction node, but never both.
+	blindingPoint := kit.updateAddBlinding
+	switch {
+	case blindingPoint != nil && payload.blindingPoint != nil:
+		return nil, ErrInvalidPayload{
+			Type:      record.BlindingPointOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinal,
+		}
+
+	case blindingPoint == nil && payload.blindingPoint == nil:
+		return nil, ErrInvalidPayload{
+			Type:      record.BlindingPointOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  isFinal,
+		}
+
+	case blindingPoint == nil:
+		blindingPoint = payload.blindingPoint
+	}
+
+	if kit.router == nil {
+		return nil, fmt.Errorf("%w: no router to decrypt data",
+			ErrInvalidBlindedData)
+	}
+
+	decrypted, err := kit.router.DecryptBlindedHopData(
+		blindingPoint, payload.encryptedData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlindedData, err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(decrypted),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlindedData, err)
+	}
+
+	if err := routeData.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlindedData, err)
+	}
+
+	// The onion and the recipient's data must agree on whether this is
+	// the last hop of the route.
+	if routeData.IsFinal() != isFinal {
+		return nil, fmt.Errorf("%w: onion final=%v, route data "+
+			"final=%v", ErrInvalidBlindedData, isFinal,
+			routeData.IsFinal())
+	}
+
+	if !isFinal {
+		// If the next node is ourselves, this is one of the dummy
+		// hops of our own blinded path, so we peel the next layer of
+		// the onion and process it with the next blinding point.
+		isSelf := routeData.NextNodeID != nil && kit.nodeKey != nil &&
+			routeData.NextNodeID.IsEqual(kit.nodeKey)
+		if isSelf {
+			return r.peelDummyHop(blindingPoint, routeData)
+		}
+
+		// Otherwise, we relay the HTLC to the next hop of the blinded
+		// route. As the onion doesn't carry any forwarding
+		// instructions for us, they're derived from the route data.
+		fwdInfo, err := r.blindedForwardingInfo(
+			blindingPoint, routeData,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payload.FwdInfo = *fwdInfo
+		payload.blindedRouteData = routeData
+
+		return payload, nil
+	}
+
+	// The final hop of a blinded route doesn't receive an MPP record, as
+	// the path id that we included for ourselves holds the payment
+	// address. We present the HTLC to the invoice registry as a regular
+	// multi-path payment using the path id and total amount.
+	payload.blindedRouteData = routeData
+	if len(routeData.PathID) == 32 {
+		var paymentAddr [32]byte
+		copy(paymentAddr[:], routeData.PathID)
+
+		payload.MPP = record.NewMPP(payload.totalAmtMsat, paymentAddr)
+	}
+
+	return payload, nil
+}
+
+// peelDummyHop processes the next onion layer of a dummy hop within one of
+// our own blinded paths, and returns the payload of that layer.
+func (r *sphinxHopIterator) peelDummyHop(blindingPoint *btcec.PublicKey,
+	routeData *record.BlindedRouteData) (*Payload, error) {
+
+	kit := &r.blindingKit
+
+	nextBlinding, err := r.nextBlindingPoint(blindingPoint, routeData)
+	if err != nil {
+		return nil, err
+	}
+
+	// The outer layer of the onion was already checked for replays, so
+	// we only need to reconstruct the inner layer.
+	nextPacket, err := kit.router.ReconstructOnionPacket(
+		r.processedPacket.NextPacket, kit.rHash,
+		sphinx.WithBlindingPoint(nextBlinding),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to peel dummy hop: %v",
+			ErrInvalidBlindedData, err)
+	}
+
+	// From here on, the iterator behaves as if the inner layer was
+	// delivered to us by the previous hop.
+	r.processedPacket = nextPacket
+	kit.updateAddBlinding = nextBlinding
+
+	return r.HopPayload()
+}
+
+// nextBlindingPoint returns the blinding point for the next hop of a blinded
+// route, which is either set explicitly in the route data or derived from our
+// current blinding point.
+func (r *sphinxHopIterator) nextBlindingPoint(blindingPoint *btcec.PublicKey,
+	routeData *record.BlindedRouteData) (*btcec.PublicKey, error) {
+
+	if routeData.NextBlindingOverride != nil {
+		return routeData.NextBlindingOverride, nil
+	}
+
+	nextBlinding, err := r.blindingKit.router.NextEphemeral(blindingPoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBlindedData, err)
+	}
+
+	return nextBlinding, nil
+}
+
+// blindedForwardingInfo derives the forwarding instructions of an intermediate
+// hop in a blinded route. The amount and expiry of the outgoing HTLC are
+// computed from the incoming HTLC using the relay parameters that the
+// recipient encrypted for us, after checking the HTLC against the recipient's
+// payment constraints.
+func (r *sphinxHopIterator) blindedForwardingInfo(
+	blindingPoint *btcec.PublicKey,
+	routeData *record.BlindedRouteData) (*ForwardingInfo, error) {
+
+	kit := r.blindingKit
+
+	// We only forward over channels, so the recipient must have provided
+	// the outgoing channel rather than the next node.
+	if routeData.ShortChannelID == nil {
+		return nil, fmt.Errorf("%w: forwarding to next node id is "+
+			"not supported", ErrInvalidBlindedData)
+	}
+
+	constraints := routeData.Constraints
+	if constraints != nil {
+		if kit.incomingCltv > constraints.MaxCltvExpiry {
+			return nil, fmt.Errorf("%w: incoming expiry %v "+
+				"exceeds max %v", ErrInvalidBlindedData,
+				kit.incomingCltv, constraints.MaxCltvExpiry)
+		}
+
+		if kit.incomingAmount < constraints.HtlcMinimumMsat {
+			return nil, fmt.Errorf("%w: incoming amount %v below "+
+				"minimum %v", ErrInvalidBlindedData,
+				kit.incomingAmount, constraints.HtlcMinimumMsat)
+		}
+	}
+
+	if routeData.Features != nil {
+		unknown := routeData.Features.UnknownRequiredFeatures()
+		if len(unknown) > 0 {
+			return nil, fmt.Errorf("%w: unknown required "+
+				"features: %v", ErrInvalidBlindedData, unknown)
+		}
+	}
+
+	relay := routeData.RelayInfo
+	amtToForward, err := blindedForwardAmount(
+		kit.incomingAmount, relay.BaseFee, relay.FeeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cltvDelta := uint32(relay.CltvExpiryDelta)
+	if kit.incomingCltv < cltvDelta {
+		return nil, fmt.Errorf("%w: incoming expiry %v below cltv "+
+			"delta %v", ErrInvalidBlindedData, kit.incomingCltv,
+			cltvDelta)
+	}
+
+	nextBlinding, err := r.nextBlindingPoint(blindingPoint, routeData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ForwardingInfo{
+		NextHop:         *routeData.ShortChannelID,
+		AmountToForward: amtToForward,
+		OutgoingCTLV:    kit.incomingCltv - cltvDelta,
+		NextBlinding:    nextBlinding,
+	}, nil
+}
+
+// blindedForwardAmount calculates the amount to forward for an intermediate hop
+// in a blinded route from the amount of the incoming HTLC, rounding up as
+// described in BOLT 04:
+//
+//	amt_to_forward = ceil((incoming - base_fee) * 1e6 / (1e6 + fee_rate))
+func blindedForwardAmount(incomingAmount, baseFee lnwire.MilliSatoshi,
+	feeRate uint32) (lnwire.MilliSatoshi, error) {
+
+	if incomingAmount <= baseFee {
+		return 0, fmt.Errorf("%w: incoming amount %v does not cover "+
+			"base fee %v", ErrInvalidBlindedData, incomingAmount,
+			baseFee)
+	}
+
+	numerator := uint64(incomingAmount-baseFee) * 1_000_000
+	denominator := 1_000_000 + uint64(feeRate)
+
+	return lnwire.MilliSatoshi(
+		(numerator + denominator - 1) / denominator,
+	), nil
+}
+
 // ExtractErrorEncrypter decodes and returns the ErrorEncrypter for this hop,
 // along with a failure code to signal if the decoding was successful. The
 // ErrorEncrypter is used to encrypt errors back to the sender in the event that
@@ -123,11 +436,48 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
 	router *sphinx.Router
+
+	// nodeKey is our node's public key, used to recognize the dummy hops
+	// of our own blinded paths.
+	nodeKey *btcec.PublicKey
 }
 
 // NewOnionProcessor creates new instance of decoder.
-func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
-	return &OnionProcessor{router}
+func NewOnionProcessor(router *sphinx.Router,
+	nodeKey *btcec.PublicKey) *OnionProcessor {
+
+	return &OnionProcessor{
+		router:  router,
+		nodeKey: nodeKey,
+	}
+}
+
+// blindingKit returns the components that a hop iterator needs to process
+// payloads within blinded routes.
+func (p *OnionProcessor) blindingKit(rHash []byte,
+	blindingPoint *btcec.PublicKey, incomingAmount lnwire.MilliSatoshi,
+	incomingCltv uint32) blindingKit {
+
+	return blindingKit{
+		router:            p.router,
+		nodeKey:           p.nodeKey,
+		rHash:             rHash,
+		updateAddBlinding: blindingPoint,
+		incomingAmount:    incomingAmount,
+		incomingCltv:      incomingCltv,
+	}
+}
+
+// blindingOpts returns the sphinx processing options for a packet that was
+// received with the given update_add_htlc blinding point.
+func blindingOpts(blindingPoint *btcec.PublicKey) []sphinx.ProcessOnionOpt {
+	if blindingPoint == nil {
+		return nil
+	}
+
+	return []sphinx.ProcessOnionOpt{
+		sphinx.WithBlindingPoint(blindingPoint),
+	}
 }
 
 // Start spins up the onion processor's sphinx router.
@@ -150,7 +500,8 @@ func (p *OnionProcessor) Stop() error {
 // instance using the rHash as the associated data when checking the relevant
 // MACs during the decoding process.
 func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
-	incomingCltv uint32) (Iterator, lnwire.FailCode) {
+	incomingAmount lnwire.MilliSatoshi, incomingCltv uint32,
+	blindingPoint *btcec.PublicKey) (Iterator, lnwire.FailCode) {
 
 	onionPkt := &sphinx.OnionPacket{}
 	if err := onionPkt.Decode(r); err != nil {
@@ -171,7 +522,7 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 	// case of a replay, an attacker is *forced* to use the same payment
 	// hash twice, thereby losing their money entirely.
 	sphinxPacket, err := p.router.ProcessOnionPacket(
-		onionPkt, rHash, incomingCltv,
+		onionPkt, rHash, incomingCltv, blindingOpts(blindingPoint)...,
 	)
 	if err != nil {
 		switch err {
@@ -187,14 +538,33 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 		}
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
+	return makeSphinxHopIterator(
+		onionPkt, sphinxPacket, p.blindingKit(
+			rHash, blindingPoint, incomingAmount, incomingCltv,
+		),
+	), lnwire.CodeNone
+}
+
+// ReconstructBlindingInfo contains the parameters of a previously received
+// HTLC that are required to reconstruct its hop iterator if it was received
+// within a blinded route.
+type ReconstructBlindingInfo struct {
+	// BlindingPoint is the blinding point that was included in the HTLC's
+	// update_add_htlc, if any.
+	BlindingPoint *btcec.PublicKey
+
+	// IncomingAmount is the amount of the HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// IncomingCltv is the expiry height of the HTLC.
+	IncomingCltv uint32
 }
 
 // ReconstructHopIterator attempts to decode a valid sphinx packet from the passed io.Reader
 // instance using the rHash as the associated data when checking the relevant
 // MACs during the decoding process.
-func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
-	Iterator, error) {
+func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte,
+	blindingInfo ReconstructBlindingInfo) (Iterator, error) {
 
 	onionPkt := &sphinx.OnionPacket{}
 	if err := onionPkt.Decode(r); err != nil {
@@ -206,21 +576,33 @@ func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
 	// associated data in order to thwart attempts a replay attacks. In the
 	// case of a replay, an attacker is *forced* to use the same payment
 	// hash twice, thereby losing their money entirely.
-	sphinxPacket, err := p.router.ReconstructOnionPacket(onionPkt, rHash)
+	sphinxPacket, err := p.router.ReconstructOnionPacket(
+		onionPkt, rHash, blindingOpts(blindingInfo.BlindingPoint)...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), nil
+	return makeSphinxHopIterator(
+		onionPkt, sphinxPacket, p.blindingKit(
+			rHash, blindingInfo.BlindingPoint,
+			blindingInfo.IncomingAmount, blindingInfo.IncomingCltv,
+		),
+	), nil
 }
 
 // DecodeHopIteratorRequest encapsulates all date necessary to process an onion
 // packet, perform sphinx replay detection, and schedule the entry for garbage
 // collection.
 type DecodeHopIteratorRequest struct {
-	OnionReader  io.Reader
-	RHash        []byte
-	IncomingCltv uint32
+	OnionReader    io.Reader
+	RHash          []byte
+	IncomingCltv   uint32
+	IncomingAmount lnwire.MilliSatoshi
+
+	// BlindingPoint is the blinding point that was included in the
+	// update_add_htlc carrying the onion, if any.
+	BlindingPoint *btcec.PublicKey
 }
 
 // DecodeHopIteratorResponse encapsulates the outcome of a batched sphinx onion
@@ -278,6 +660,7 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 
 		err = tx.ProcessOnionPacket(
 			seqNum, onionPkt, req.RHash, req.IncomingCltv,
+			blindingOpts(req.BlindingPoint)...,
 		)
 		switch err {
 		case nil:
@@ -377,7 +760,12 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 
 		// Finally, construct a hop iterator from our processed sphinx
 		// packet, simultaneously caching the original onion packet.
-		resp.HopIterator = makeSphinxHopIterator(&onionPkts[i], &packets[i])
+		resp.HopIterator = makeSphinxHopIterator(
+			&onionPkts[i], &packets[i], p.blindingKit(
+				reqs[i].RHash, reqs[i].BlindingPoint,
+				reqs[i].IncomingAmount, reqs[i].IncomingCltv,
+			),
+		)
 	}
 
 	return resps, nil
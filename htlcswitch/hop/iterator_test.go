@@ -5,8 +5,11 @@ import (
 	"encoding/binary"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/davecgh/go-spew/spew"
 	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -65,14 +68,14 @@ func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
 			},
 			expectedFwdInfo: expectedFwdInfo,
 		},
-		// A TLV payload, we can leave off the action as we'll always
-		// read the cid encoded.
+		// A TLV payload that signals more hops.
 		{
 			sphinxPacket: &sphinx.ProcessedPacket{
 				Payload: sphinx.HopPayload{
 					Type:    sphinx.PayloadTLV,
 					Payload: b.Bytes(),
 				},
+				Action: sphinx.MoreHops,
 			},
 			expectedFwdInfo: expectedFwdInfo,
 		},
@@ -98,3 +101,345 @@ func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
 		}
 	}
 }
+
+// encodeTLVPayload encodes the given records as a sphinx TLV hop payload.
+func encodeTLVPayload(t *testing.T, records ...tlv.Record) sphinx.HopPayload {
+	t.Helper()
+
+	tlvStream, err := tlv.NewStream(records...)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, tlvStream.Encode(&b))
+
+	payload, err := sphinx.NewTLVHopPayload(b.Bytes())
+	require.NoError(t, err)
+
+	return payload
+}
+
+// TestSphinxHopIteratorBlindedDummyHops tests that a payment to one of our own
+// blinded paths is delivered to the final hop, with the dummy hops that we
+// added to the path being peeled transparently.
+func TestSphinxHopIteratorBlindedDummyHops(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	nodePub := nodeKey.PubKey()
+
+	router := sphinx.NewRouter(
+		&keychain.PrivKeyECDH{PrivKey: nodeKey},
+		&chaincfg.RegressionNetParams, sphinx.NewMemoryReplayLog(),
+	)
+	require.NoError(t, router.Start())
+	t.Cleanup(router.Stop)
+
+	processor := NewOnionProcessor(router, nodePub)
+
+	// Build a blinded path that starts and ends with our node, using a
+	// dummy hop that points back to ourselves.
+	var paymentAddr [32]byte
+	copy(paymentAddr[:], bytes.Repeat([]byte{1}, 32))
+
+	dummyData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			NextNodeID: nodePub,
+			RelayInfo:  &record.PaymentRelayInfo{},
+		},
+	)
+	require.NoError(t, err)
+
+	finalData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: paymentAddr[:],
+			Constraints: &record.PaymentConstraints{
+				MaxCltvExpiry:   1000,
+				HtlcMinimumMsat: 1,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	blindingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := sphinx.BuildBlindedPath(blindingKey, []*sphinx.HopInfo{
+		{NodePub: nodePub, PlainText: dummyData},
+		{NodePub: nodePub, PlainText: finalData},
+	})
+	require.NoError(t, err)
+
+	// The sender includes the blinding point in the payload for the
+	// introduction node, and the amount and expiry for the final hop.
+	var (
+		amt       uint64 = 5000
+		cltv      uint32 = 500
+		introData        = path.BlindedHops[0].CipherText
+		hopData          = path.BlindedHops[1].CipherText
+		blinding         = path.BlindingPoint
+	)
+
+	var route sphinx.PaymentPath
+	route[0] = sphinx.OnionHop{
+		NodePub: *path.IntroductionPoint,
+		HopPayload: encodeTLVPayload(t,
+			record.NewEncryptedDataRecord(&introData),
+			record.NewBlindingPointRecord(&blinding),
+		),
+	}
+	route[1] = sphinx.OnionHop{
+		NodePub: *path.BlindedHops[1].BlindedNodePub,
+		HopPayload: encodeTLVPayload(t,
+			record.NewAmtToFwdRecord(&amt),
+			record.NewLockTimeRecord(&cltv),
+			record.NewEncryptedDataRecord(&hopData),
+			record.NewTotalAmtMsatBlinded(&amt),
+		),
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	rHash := bytes.Repeat([]byte{2}, 32)
+	onion, err := sphinx.NewOnionPacket(
+		&route, sessionKey, rHash, sphinx.DeterministicPacketFiller,
+	)
+	require.NoError(t, err)
+
+	var onionBlob bytes.Buffer
+	require.NoError(t, onion.Encode(&onionBlob))
+
+	iterator, failCode := processor.DecodeHopIterator(
+		&onionBlob, rHash, 1000, 100, nil,
+	)
+	require.Equal(t, lnwire.CodeNone, failCode)
+
+	payload, err := iterator.HopPayload()
+	require.NoError(t, err)
+
+	// The payload of the final hop should be returned, with the path id
+	// presented as the payment address of an MPP record.
+	require.NotNil(t, payload.BlindedRouteData())
+	require.Equal(t, paymentAddr[:], payload.BlindedRouteData().PathID)
+	require.Equal(t, lnwire.MilliSatoshi(amt),
+		payload.ForwardingInfo().AmountToForward)
+	require.Equal(t, cltv, payload.ForwardingInfo().OutgoingCTLV)
+
+	require.NotNil(t, payload.MultiPath())
+	require.Equal(t, paymentAddr, payload.MultiPath().PaymentAddr())
+	require.Equal(t, lnwire.MilliSatoshi(amt),
+		payload.MultiPath().TotalMsat())
+}
+
+// TestSphinxHopIteratorBlindedForward tests that the forwarding instructions
+// of an introduction node are derived from its blinded route data, and that
+// htlcs violating the recipient's payment constraints are rejected.
+func TestSphinxHopIteratorBlindedForward(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	nextKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	router := sphinx.NewRouter(
+		&keychain.PrivKeyECDH{PrivKey: nodeKey},
+		&chaincfg.RegressionNetParams, sphinx.NewMemoryReplayLog(),
+	)
+	require.NoError(t, router.Start())
+	t.Cleanup(router.Stop)
+
+	processor := NewOnionProcessor(router, nodeKey.PubKey())
+
+	// We're the introduction node of a blinded path that forwards over one
+	// of our channels to the recipient.
+	scid := lnwire.NewShortChanIDFromInt(1234)
+	introData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			ShortChannelID: &scid,
+			RelayInfo: &record.PaymentRelayInfo{
+				CltvExpiryDelta: 40,
+				FeeRate:         100,
+				BaseFee:         1000,
+			},
+			Constraints: &record.PaymentConstraints{
+				MaxCltvExpiry:   1000,
+				HtlcMinimumMsat: 1000,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	finalData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: bytes.Repeat([]byte{1}, 32),
+		},
+	)
+	require.NoError(t, err)
+
+	blindingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := sphinx.BuildBlindedPath(blindingKey, []*sphinx.HopInfo{
+		{NodePub: nodeKey.PubKey(), PlainText: introData},
+		{NodePub: nextKey.PubKey(), PlainText: finalData},
+	})
+	require.NoError(t, err)
+
+	var (
+		amt      uint64 = 100_000
+		cltv     uint32 = 460
+		encData         = path.BlindedHops[0].CipherText
+		hopData         = path.BlindedHops[1].CipherText
+		blinding        = path.BlindingPoint
+	)
+
+	var route sphinx.PaymentPath
+	route[0] = sphinx.OnionHop{
+		NodePub: *path.IntroductionPoint,
+		HopPayload: encodeTLVPayload(t,
+			record.NewEncryptedDataRecord(&encData),
+			record.NewBlindingPointRecord(&blinding),
+		),
+	}
+	route[1] = sphinx.OnionHop{
+		NodePub: *path.BlindedHops[1].BlindedNodePub,
+		HopPayload: encodeTLVPayload(t,
+			record.NewAmtToFwdRecord(&amt),
+			record.NewLockTimeRecord(&cltv),
+			record.NewEncryptedDataRecord(&hopData),
+			record.NewTotalAmtMsatBlinded(&amt),
+		),
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	rHash := bytes.Repeat([]byte{2}, 32)
+	onion, err := sphinx.NewOnionPacket(
+		&route, sessionKey, rHash, sphinx.DeterministicPacketFiller,
+	)
+	require.NoError(t, err)
+
+	var onionBlob bytes.Buffer
+	require.NoError(t, onion.Encode(&onionBlob))
+
+	nextBlinding, err := router.NextEphemeral(blinding)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		incomingAmount lnwire.MilliSatoshi
+		incomingCltv   uint32
+		expectedErr    bool
+	}{
+		{
+			// The incoming htlc pays exactly the fee of the
+			// introduction node.
+			name:           "valid forward",
+			incomingAmount: 101_010,
+			incomingCltv:   500,
+		},
+		{
+			name:           "expiry too far",
+			incomingAmount: 101_010,
+			incomingCltv:   1001,
+			expectedErr:    true,
+		},
+		{
+			name:           "amount below minimum",
+			incomingAmount: 999,
+			incomingCltv:   500,
+			expectedErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			iterator, err := processor.ReconstructHopIterator(
+				bytes.NewReader(onionBlob.Bytes()), rHash,
+				ReconstructBlindingInfo{
+					IncomingAmount: test.incomingAmount,
+					IncomingCltv:   test.incomingCltv,
+				},
+			)
+			require.NoError(t, err)
+
+			payload, err := iterator.HopPayload()
+			if test.expectedErr {
+				require.True(t, IsBlindingError(err))
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, ForwardingInfo{
+				NextHop:         scid,
+				AmountToForward: lnwire.MilliSatoshi(amt),
+				OutgoingCTLV:    cltv,
+				NextBlinding:    nextBlinding,
+			}, payload.ForwardingInfo())
+		})
+	}
+}
+
+// TestBlindedForwardAmount tests calculation of the amount to forward within
+// a blinded route, which rounds up in favor of the forwarding node.
+func TestBlindedForwardAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		incomingAmount lnwire.MilliSatoshi
+		baseFee        lnwire.MilliSatoshi
+		feeRate        uint32
+		expected       lnwire.MilliSatoshi
+		expectedErr    bool
+	}{
+		{
+			name:           "no fees",
+			incomingAmount: 1000,
+			expected:       1000,
+		},
+		{
+			name:           "exact fees",
+			incomingAmount: 101_010,
+			baseFee:        1000,
+			feeRate:        100,
+			expected:       100_000,
+		},
+		{
+			name:           "rounded up",
+			incomingAmount: 101_011,
+			baseFee:        1000,
+			feeRate:        100,
+			expected:       100_001,
+		},
+		{
+			name:           "amount below base fee",
+			incomingAmount: 1000,
+			baseFee:        1000,
+			expectedErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			amt, err := blindedForwardAmount(
+				test.incomingAmount, test.baseFee,
+				test.feeRate,
+			)
+			if test.expectedErr {
+				require.ErrorIs(t, err, ErrInvalidBlindedData)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, amt)
+		})
+	}
+}
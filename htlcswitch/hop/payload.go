@@ -109,6 +109,16 @@ type Payload struct {
 	// totalAmtMsat holds the info provided in total_amount_msat when
 	// parsed from a TLV onion payload.
 	totalAmtMsat lnwire.MilliSatoshi
+
+	// blindedRouteData is the decrypted route blinding data of the hop.
+	// It is only set if the payload was received within a blinded route.
+	blindedRouteData *record.BlindedRouteData
+
+	// trampoline is the onion packet that holds the instructions to reach
+	// the final recipient of a trampoline payment. It is only set if we
+	// are the trampoline node of the payment, which peels it with its
+	// node key.
+	trampoline *TrampolineOnionPacket
 }
 
 // NewLegacyPayload builds a Payload from the amount, cltv, and next hop
@@ -127,8 +137,10 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 }
 
 // NewPayloadFromReader builds a new Hop from the passed io.Reader. The reader
-// should correspond to the bytes encapsulated in a TLV onion payload.
-func NewPayloadFromReader(r io.Reader) (*Payload, error) {
+// should correspond to the bytes encapsulated in a TLV onion payload. The
+// finalHop boolean indicates whether the onion packet signaled that this is
+// the last hop of the route.
+func NewPayloadFromReader(r io.Reader, finalHop bool) (*Payload, error) {
 	var (
 		cid           uint64
 		amt           uint64
@@ -139,6 +151,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		encryptedData []byte
 		blindingPoint *btcec.PublicKey
 		metadata      []byte
+		trampoline    []byte
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -151,6 +164,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		amp.Record(),
 		record.NewMetadataRecord(&metadata),
 		record.NewTotalAmtMsatBlinded(&totalAmtMsat),
+		record.NewTrampolineOnionRecord(&trampoline),
 	)
 	if err != nil {
 		return nil, err
@@ -166,7 +180,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	// Validate whether the sender properly included or omitted tlv records
 	// in accordance with BOLT 04.
 	nextHop := lnwire.NewShortChanIDFromInt(cid)
-	err = ValidateParsedPayloadTypes(parsedTypes, nextHop)
+	err = ValidateParsedPayloadTypes(parsedTypes, finalHop)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +191,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		return nil, ErrInvalidPayload{
 			Type:      *violatingType,
 			Violation: RequiredViolation,
-			FinalHop:  nextHop == Exit,
+			FinalHop:  finalHop,
 		}
 	}
 
@@ -205,6 +219,17 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		metadata = nil
 	}
 
+	// If a trampoline onion was parsed, decode its packet. It's peeled by
+	// the trampoline handler, which holds our node key.
+	var trampolineOnion *TrampolineOnionPacket
+	if _, ok := parsedTypes[record.TrampolineOnionType]; ok {
+		trampolineOnion, err = DecodeTrampolineOnion(trampoline)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trampoline onion: %w",
+				err)
+		}
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -221,6 +246,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		blindingPoint: blindingPoint,
 		customRecords: customRecords,
 		totalAmtMsat:  lnwire.MilliSatoshi(totalAmtMsat),
+		trampoline:    trampolineOnion,
 	}, nil
 }
 
@@ -248,15 +274,22 @@ func NewCustomRecords(parsedTypes tlv.TypeMap) record.CustomSet {
 // boolean should be true if the payload was parsed for an exit hop. The
 // requirements for this method are described in BOLT 04.
 func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
-	nextHop lnwire.ShortChannelID) error {
-
-	isFinalHop := nextHop == Exit
+	isFinalHop bool) error {
 
 	_, hasAmt := parsedTypes[record.AmtOnionType]
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
 	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasEncryptedData := parsedTypes[record.EncryptedDataOnionType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
+
+	// Hops within a blinded route follow a different set of rules, as
+	// most of the forwarding information is provided by the recipient in
+	// the encrypted data.
+	if hasEncryptedData {
+		return validateBlindedPayloadTypes(parsedTypes, isFinalHop)
+	}
 
 	switch {
 
@@ -276,9 +309,7 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			FinalHop:  isFinalHop,
 		}
 
-	// The exit hop should omit the next hop id. If nextHop != Exit, the
-	// sender must have included a record, so we don't need to test for its
-	// inclusion at intermediate hops directly.
+	// The exit hop should omit the next hop id.
 	case isFinalHop && hasNextHop:
 		return ErrInvalidPayload{
 			Type:      record.NextHopOnionType,
@@ -286,6 +317,14 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			FinalHop:  true,
 		}
 
+	// Intermediate hops must include the next hop id.
+	case !isFinalHop && !hasNextHop:
+		return ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  false,
+		}
+
 	// Intermediate nodes should never receive MPP fields.
 	case !isFinalHop && hasMPP:
 		return ErrInvalidPayload{
@@ -301,6 +340,102 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Only the trampoline node, which is the final hop of the route built
+	// by the payer, may receive a trampoline onion.
+	case !isFinalHop && hasTrampoline:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// The payer must signal the total amount it pays to the trampoline
+	// node, so that the htlcs of a payment can be gathered.
+	case hasTrampoline && !hasMPP:
+		return ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Trampoline payments can't be combined with AMP.
+	case hasTrampoline && hasAMP:
+		return ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	return nil
+}
+
+// validateBlindedPayloadTypes checks the types parsed from the payload of a
+// hop within a blinded route. Intermediate hops receive all of their
+// forwarding information in the encrypted data, so they must not include any
+// of the regular forwarding fields. The final hop must include the amount,
+// expiry and total amount of the payment.
+func validateBlindedPayloadTypes(parsedTypes tlv.TypeMap,
+	isFinalHop bool) error {
+
+	_, hasAmt := parsedTypes[record.AmtOnionType]
+	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
+	_, hasNextHop := parsedTypes[record.NextHopOnionType]
+	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasTotalAmt := parsedTypes[record.TotalAmtMsatBlindedType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
+
+	violation := func(t tlv.Type, v PayloadViolation) error {
+		return ErrInvalidPayload{
+			Type:      t,
+			Violation: v,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	switch {
+	// The next hop is provided in the encrypted data, so it must never
+	// be included in the onion payload.
+	case hasNextHop:
+		return violation(record.NextHopOnionType, IncludedViolation)
+
+	// Neither MPP nor AMP can be used with blinded routes.
+	case hasMPP:
+		return violation(record.MPPOnionType, IncludedViolation)
+
+	case hasAMP:
+		return violation(record.AMPOnionType, IncludedViolation)
+
+	// Trampoline payments can't be received within blinded routes.
+	case hasTrampoline:
+		return violation(record.TrampolineOnionType, IncludedViolation)
+
+	// The final hop must include the amount, expiry and total amount.
+	case isFinalHop && !hasAmt:
+		return violation(record.AmtOnionType, OmittedViolation)
+
+	case isFinalHop && !hasLockTime:
+		return violation(record.LockTimeOnionType, OmittedViolation)
+
+	case isFinalHop && !hasTotalAmt:
+		return violation(
+			record.TotalAmtMsatBlindedType, OmittedViolation,
+		)
+
+	// Intermediate hops derive the amount and expiry from the relay
+	// parameters in the encrypted data.
+	case !isFinalHop && hasAmt:
+		return violation(record.AmtOnionType, IncludedViolation)
+
+	case !isFinalHop && hasLockTime:
+		return violation(record.LockTimeOnionType, IncludedViolation)
+
+	case !isFinalHop && hasTotalAmt:
+		return violation(
+			record.TotalAmtMsatBlindedType, IncludedViolation,
+		)
 	}
 
 	return nil
@@ -347,6 +482,19 @@ func (h *Payload) TotalAmtMsat() lnwire.MilliSatoshi {
 	return h.totalAmtMsat
 }
 
+// BlindedRouteData returns the decrypted route blinding data of the hop, or
+// nil if the payload wasn't received within a blinded route.
+func (h *Payload) BlindedRouteData() *record.BlindedRouteData {
+	return h.blindedRouteData
+}
+
+// TrampolineOnion returns the onion packet that holds the instructions to
+// reach the final recipient of a trampoline payment, or nil if the payload
+// didn't include a trampoline onion.
+func (h *Payload) TrampolineOnion() *TrampolineOnionPacket {
+	return h.trampoline
+}
+
 // getMinRequiredViolation checks for unrecognized required (even) fields in the
 // standard range and returns the lowest required type. Always returning the
 // lowest required type allows a failure message to be deterministic.
@@ -8,8 +8,10 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +26,7 @@ const testUnknownRequiredType = 0x80
 type decodePayloadTest struct {
 	name               string
 	payload            []byte
+	isFinalHop         bool
 	expErr             error
 	expCustomRecords   map[uint64][]byte
 	shouldHaveMPP      bool
@@ -36,8 +39,9 @@ type decodePayloadTest struct {
 
 var decodePayloadTests = []decodePayloadTest{
 	{
-		name:    "final hop valid",
-		payload: []byte{0x02, 0x00, 0x04, 0x00},
+		name:       "final hop valid",
+		isFinalHop: true,
+		payload:    []byte{0x02, 0x00, 0x04, 0x00},
 	},
 	{
 		name: "intermediate hop valid",
@@ -46,8 +50,9 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name:    "final hop no amount",
-		payload: []byte{0x04, 0x00},
+		name:       "final hop no amount",
+		isFinalHop: true,
+		payload:    []byte{0x04, 0x00},
 		expErr: hop.ErrInvalidPayload{
 			Type:      record.AmtOnionType,
 			Violation: hop.OmittedViolation,
@@ -66,8 +71,9 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name:    "final hop no expiry",
-		payload: []byte{0x02, 0x00},
+		name:       "final hop no expiry",
+		isFinalHop: true,
+		payload:    []byte{0x02, 0x00},
 		expErr: hop.ErrInvalidPayload{
 			Type:      record.LockTimeOnionType,
 			Violation: hop.OmittedViolation,
@@ -86,7 +92,8 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name: "final hop next sid present",
+		name:       "final hop next sid present",
+		isFinalHop: true,
 		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x00, 0x00,
 			0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		},
@@ -97,7 +104,8 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name: "required type after omitted hop id",
+		name:       "required type after omitted hop id",
+		isFinalHop: true,
 		payload: []byte{
 			0x02, 0x00, 0x04, 0x00,
 			testUnknownRequiredType, 0x00,
@@ -122,8 +130,9 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name:    "required type zero final hop",
-		payload: []byte{0x00, 0x00, 0x02, 0x00, 0x04, 0x00},
+		name:       "required type zero final hop",
+		isFinalHop: true,
+		payload:    []byte{0x00, 0x00, 0x02, 0x00, 0x04, 0x00},
 		expErr: hop.ErrInvalidPayload{
 			Type:      0,
 			Violation: hop.RequiredViolation,
@@ -131,7 +140,8 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name: "required type zero final hop zero sid",
+		name:       "required type zero final hop zero sid",
+		isFinalHop: true,
 		payload: []byte{0x00, 0x00, 0x02, 0x00, 0x04, 0x00, 0x06, 0x08,
 			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		},
@@ -153,7 +163,8 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name: "required type in custom range",
+		name:       "required type in custom range",
+		isFinalHop: true,
 		payload: []byte{0x02, 0x00, 0x04, 0x00,
 			0xfe, 0x00, 0x01, 0x00, 0x00, 0x02, 0x10, 0x11,
 		},
@@ -169,9 +180,10 @@ var decodePayloadTests = []decodePayloadTest{
 		expErr: nil,
 	},
 	{
-		name:    "valid final hop",
-		payload: []byte{0x02, 0x00, 0x04, 0x00},
-		expErr:  nil,
+		name:       "valid final hop",
+		isFinalHop: true,
+		payload:    []byte{0x02, 0x00, 0x04, 0x00},
+		expErr:     nil,
 	},
 	{
 		name: "intermediate hop with mpp",
@@ -231,10 +243,6 @@ var decodePayloadTests = []decodePayloadTest{
 	{
 		name: "intermediate hop with encrypted data",
 		payload: []byte{
-			// amount
-			0x02, 0x00,
-			// cltv
-			0x04, 0x00,
 			// encrypted data
 			0x0a, 0x03, 0x03, 0x02, 0x01,
 		},
@@ -243,20 +251,96 @@ var decodePayloadTests = []decodePayloadTest{
 	{
 		name: "intermediate hop with blinding point",
 		payload: append([]byte{
-			// amount
-			0x02, 0x00,
-			// cltv
-			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x03, 0x03, 0x02, 0x01,
 			// blinding point (type / length)
 			0x0c, 0x21,
 		},
 			// blinding point (value)
 			testPubKey.SerializeCompressed()...,
 		),
+		shouldHaveEncData:  true,
 		shouldHaveBlinding: true,
 	},
 	{
-		name: "final hop with mpp",
+		name: "blinded intermediate hop with amount",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// encrypted data
+			0x0a, 0x03, 0x03, 0x02, 0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.AmtOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "blinded intermediate hop with next hop",
+		payload: []byte{
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// encrypted data
+			0x0a, 0x03, 0x03, 0x02, 0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name:       "blinded final hop",
+		isFinalHop: true,
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x03, 0x03, 0x02, 0x01,
+			// total amount
+			0x12, 0x01, 0x01,
+		},
+		shouldHaveEncData:  true,
+		shouldHaveTotalAmt: true,
+	},
+	{
+		name:       "blinded final hop no total amount",
+		isFinalHop: true,
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x03, 0x03, 0x02, 0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name: "intermediate hop no next hop",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name:       "final hop with mpp",
+		isFinalHop: true,
 		payload: []byte{
 			// amount
 			0x02, 0x00,
@@ -274,7 +358,8 @@ var decodePayloadTests = []decodePayloadTest{
 		shouldHaveMPP: true,
 	},
 	{
-		name: "final hop with amp",
+		name:       "final hop with amp",
+		isFinalHop: true,
 		payload: []byte{
 			// amount
 			0x02, 0x00,
@@ -298,7 +383,8 @@ var decodePayloadTests = []decodePayloadTest{
 		shouldHaveAMP: true,
 	},
 	{
-		name: "final hop with metadata",
+		name:       "final hop with metadata",
+		isFinalHop: true,
 		payload: []byte{
 			// amount
 			0x02, 0x00,
@@ -310,7 +396,8 @@ var decodePayloadTests = []decodePayloadTest{
 		shouldHaveMetadata: true,
 	},
 	{
-		name: "final hop with total amount",
+		name:       "final hop with total amount",
+		isFinalHop: true,
 		payload: []byte{
 			// amount
 			0x02, 0x00,
@@ -321,6 +408,42 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 		shouldHaveTotalAmt: true,
 	},
+	{
+		name: "intermediate hop with trampoline",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// trampoline onion
+			0x14, 0x01, 0x00,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name:       "final hop with trampoline and no mpp",
+		isFinalHop: true,
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// trampoline onion
+			0x14, 0x01, 0x00,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  true,
+		},
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -361,7 +484,9 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		testChildIndex = uint32(9)
 	)
 
-	p, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
+	p, err := hop.NewPayloadFromReader(
+		bytes.NewReader(test.payload), test.isFinalHop,
+	)
 	if !reflect.DeepEqual(test.expErr, err) {
 		t.Fatalf("expected error mismatch, want: %v, got: %v",
 			test.expErr, err)
@@ -438,3 +563,86 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		t.Fatalf("invalid custom records")
 	}
 }
+
+// TestDecodeTrampolinePayload asserts that the trampoline onion nested in the
+// payload of a final hop is decoded, and that the trampoline node can peel
+// the instructions to reach the recipient off it.
+func TestDecodeTrampolinePayload(t *testing.T) {
+	t.Parallel()
+
+	trampoline := &record.TrampolinePayload{
+		AmtToForward: 1_000,
+		OutgoingCltv: 500,
+		NextNode:     testPubKey,
+		MPP:          record.NewMPP(1_000, [32]byte{1}),
+	}
+	trampolinePayload, err := record.EncodeTrampolinePayload(trampoline)
+	require.NoError(t, err)
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	paymentHash := [32]byte{3}
+	packet, err := hop.NewTrampolineOnion(
+		[]hop.TrampolineHop{{
+			NodeKey: nodeKey.PubKey(),
+			Payload: trampolinePayload,
+		}}, sessionKey, paymentHash[:],
+	)
+	require.NoError(t, err)
+
+	var onion bytes.Buffer
+	require.NoError(t, packet.Encode(&onion))
+	trampolineOnion := onion.Bytes()
+
+	var (
+		amt  uint64 = 1_100
+		cltv uint32 = 600
+		mpp         = record.NewMPP(1_100, [32]byte{2})
+	)
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		record.NewTrampolineOnionRecord(&trampolineOnion),
+	)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, stream.Encode(&b))
+
+	p, err := hop.NewPayloadFromReader(&b, true)
+	require.NoError(t, err)
+	require.Equal(t, packet, p.TrampolineOnion())
+	require.Equal(t, mpp, p.MultiPath())
+
+	payload, next, err := p.TrampolineOnion().Peel(
+		&keychain.PrivKeyECDH{PrivKey: nodeKey}, paymentHash[:],
+	)
+	require.NoError(t, err)
+	require.Nil(t, next)
+
+	decoded, err := record.DecodeTrampolinePayload(
+		bytes.NewReader(payload),
+	)
+	require.NoError(t, err)
+	require.Equal(t, trampoline, decoded)
+
+	// A trampoline onion that's too short to be a packet is rejected.
+	trampolineOnion = []byte{0x02, 0x01, 0x01}
+	stream, err = tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		record.NewTrampolineOnionRecord(&trampolineOnion),
+	)
+	require.NoError(t, err)
+
+	b.Reset()
+	require.NoError(t, stream.Encode(&b))
+
+	_, err = hop.NewPayloadFromReader(&b, true)
+	require.ErrorContains(t, err, "too short")
+}
@@ -0,0 +1,399 @@
+package hop
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// TrampolineOnionVersion is the version of the trampoline onion
+	// packets that we create and process.
+	TrampolineOnionVersion = 0
+
+	// TrampolineRoutingInfoSize is the size of the routing info of a
+	// trampoline onion packet, unless the payloads of its hops require
+	// more. It's smaller than the routing info of the outer onion, so
+	// that the packet fits into the payload of the trampoline node.
+	TrampolineRoutingInfoSize = 400
+
+	// trampolineHMACSize is the size of the HMACs of a trampoline onion.
+	trampolineHMACSize = sha256.Size
+
+	// trampolineOnionOverhead is the size of a serialized trampoline
+	// onion packet without its routing info: the version, the ephemeral
+	// key and the HMAC of the packet.
+	trampolineOnionOverhead = 1 + btcec.PubKeyBytesLenCompressed +
+		trampolineHMACSize
+)
+
+var (
+	// ErrTrampolineOnionVersion is returned when a trampoline onion
+	// packet has an unknown version.
+	ErrTrampolineOnionVersion = errors.New("unknown trampoline onion " +
+		"version")
+
+	// ErrTrampolineOnionHMAC is returned when the HMAC of a trampoline
+	// onion packet doesn't match its content, which means that it wasn't
+	// created for us or was tampered with.
+	ErrTrampolineOnionHMAC = errors.New("invalid trampoline onion hmac")
+)
+
+// TrampolineHop is a hop of the route that a trampoline onion packet is
+// created for.
+type TrampolineHop struct {
+	// NodeKey is the public key of the trampoline node.
+	NodeKey *btcec.PublicKey
+
+	// Payload is the TLV encoded payload that the trampoline node peels
+	// off the onion.
+	Payload []byte
+}
+
+// size returns the number of bytes that the hop takes up in the routing info
+// of a trampoline onion: the length prefixed payload and the HMAC of the next
+// packet.
+func (h *TrampolineHop) size() int {
+	return int(tlv.VarIntSize(uint64(len(h.Payload)))) + len(h.Payload) +
+		trampolineHMACSize
+}
+
+// TrampolineOnionPacket is a Sphinx onion packet that the payer of a
+// trampoline payment nests in the onion payload of a trampoline node. It is
+// built like the outer onion, but its routing info is sized to its payloads,
+// so that it fits into the outer onion. Each trampoline node peels off its
+// layer with its node key, which reveals the instructions to reach the next
+// node.
+type TrampolineOnionPacket struct {
+	// Version is the version of the packet.
+	Version byte
+
+	// EphemeralKey is the ephemeral key that the receiving node derives
+	// the shared secret of its layer from.
+	EphemeralKey *btcec.PublicKey
+
+	// RoutingInfo holds the encrypted payloads of all hops.
+	RoutingInfo []byte
+
+	// HeaderMAC authenticates the routing info of the receiving node.
+	HeaderMAC [trampolineHMACSize]byte
+}
+
+// NewTrampolineOnion creates a trampoline onion packet for the passed route
+// of trampoline nodes. The associated data, which is the payment hash, is
+// authenticated by each hop.
+func NewTrampolineOnion(hops []TrampolineHop, sessionKey *btcec.PrivateKey,
+	assocData []byte) (*TrampolineOnionPacket, error) {
+
+	if len(hops) == 0 {
+		return nil, errors.New("trampoline onion without hops")
+	}
+
+	routingInfoSize := 0
+	for i := range hops {
+		routingInfoSize += hops[i].size()
+	}
+	if routingInfoSize < TrampolineRoutingInfoSize {
+		routingInfoSize = TrampolineRoutingInfoSize
+	}
+
+	// We derive the shared secret of each hop with the ephemeral key of
+	// its layer, which is blinded for the next hop.
+	sharedSecrets := make([][32]byte, len(hops))
+	ephemeralKey := sessionKey
+	for i, hop := range hops {
+		ecdh := &keychain.PrivKeyECDH{PrivKey: ephemeralKey}
+		secret, err := ecdh.ECDH(hop.NodeKey)
+		if err != nil {
+			return nil, err
+		}
+		sharedSecrets[i] = secret
+
+		blindingFactor := trampolineBlindingFactor(
+			ephemeralKey.PubKey(), secret,
+		)
+		var nextKey btcec.ModNScalar
+		nextKey.Mul2(&ephemeralKey.Key, &blindingFactor)
+		ephemeralKey = &btcec.PrivateKey{Key: nextKey}
+	}
+
+	// The routing info is initialized with pseudo random bytes, so that
+	// its unused part doesn't reveal the length of the route.
+	padKey := trampolineKey("pad", sessionKey.Serialize())
+	routingInfo := trampolineCipherStream(padKey, routingInfoSize)
+
+	filler := trampolineFiller(hops, sharedSecrets, routingInfoSize)
+
+	// The layers are added from the last hop to the first one. Each layer
+	// shifts the routing info to the right to make room for the payload
+	// of its hop and the HMAC of the next layer, and encrypts it.
+	var nextHMAC [trampolineHMACSize]byte
+	for i := len(hops) - 1; i >= 0; i-- {
+		hopSize := hops[i].size()
+		copy(routingInfo[hopSize:], routingInfo)
+
+		var b bytes.Buffer
+		var buf [8]byte
+		err := tlv.WriteVarInt(&b, uint64(len(hops[i].Payload)), &buf)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(hops[i].Payload)
+		b.Write(nextHMAC[:])
+		copy(routingInfo, b.Bytes())
+
+		rhoKey := trampolineKey("rho", sharedSecrets[i][:])
+		xorBytes(
+			routingInfo, trampolineCipherStream(
+				rhoKey, routingInfoSize,
+			),
+		)
+
+		// The filler makes sure that the routing info of the last
+		// hop authenticates, as it's what remains of the padding
+		// that the prior hops appended.
+		if i == len(hops)-1 {
+			copy(routingInfo[routingInfoSize-len(filler):], filler)
+		}
+
+		muKey := trampolineKey("mu", sharedSecrets[i][:])
+		nextHMAC = trampolineHMAC(muKey, routingInfo, assocData)
+	}
+
+	return &TrampolineOnionPacket{
+		Version:      TrampolineOnionVersion,
+		EphemeralKey: sessionKey.PubKey(),
+		RoutingInfo:  routingInfo,
+		HeaderMAC:    nextHMAC,
+	}, nil
+}
+
+// Encode writes the serialized trampoline onion packet to the passed writer.
+func (p *TrampolineOnionPacket) Encode(w io.Writer) error {
+	if _, err := w.Write([]byte{p.Version}); err != nil {
+		return err
+	}
+
+	_, err := w.Write(p.EphemeralKey.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(p.RoutingInfo); err != nil {
+		return err
+	}
+
+	_, err = w.Write(p.HeaderMAC[:])
+
+	return err
+}
+
+// DecodeTrampolineOnion decodes a serialized trampoline onion packet. The
+// size of its routing info is derived from the length of the passed bytes.
+func DecodeTrampolineOnion(b []byte) (*TrampolineOnionPacket, error) {
+	if len(b) <= trampolineOnionOverhead {
+		return nil, fmt.Errorf("trampoline onion of %v bytes is too "+
+			"short", len(b))
+	}
+
+	if b[0] != TrampolineOnionVersion {
+		return nil, ErrTrampolineOnionVersion
+	}
+
+	keyEnd := 1 + btcec.PubKeyBytesLenCompressed
+	ephemeralKey, err := btcec.ParsePubKey(b[1:keyEnd])
+	if err != nil {
+		return nil, err
+	}
+
+	macStart := len(b) - trampolineHMACSize
+	packet := &TrampolineOnionPacket{
+		Version:      b[0],
+		EphemeralKey: ephemeralKey,
+		RoutingInfo:  append([]byte(nil), b[keyEnd:macStart]...),
+	}
+	copy(packet.HeaderMAC[:], b[macStart:])
+
+	return packet, nil
+}
+
+// Peel removes our layer of the trampoline onion packet with our node key. It
+// returns our payload, and the packet for the next trampoline node, which is
+// nil if we are the last one.
+func (p *TrampolineOnionPacket) Peel(nodeKey keychain.SingleKeyECDH,
+	assocData []byte) ([]byte, *TrampolineOnionPacket, error) {
+
+	if p.Version != TrampolineOnionVersion {
+		return nil, nil, ErrTrampolineOnionVersion
+	}
+
+	sharedSecret, err := nodeKey.ECDH(p.EphemeralKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	muKey := trampolineKey("mu", sharedSecret[:])
+	headerMAC := trampolineHMAC(muKey, p.RoutingInfo, assocData)
+	if !hmac.Equal(headerMAC[:], p.HeaderMAC[:]) {
+		return nil, nil, ErrTrampolineOnionHMAC
+	}
+
+	// We decrypt the routing info with zero bytes appended to it, which
+	// turn into the padding of the routing info of the next hop.
+	routingInfoSize := len(p.RoutingInfo)
+	routingInfo := make([]byte, 2*routingInfoSize)
+	copy(routingInfo, p.RoutingInfo)
+
+	rhoKey := trampolineKey("rho", sharedSecret[:])
+	xorBytes(
+		routingInfo, trampolineCipherStream(
+			rhoKey, 2*routingInfoSize,
+		),
+	)
+
+	r := bytes.NewReader(routingInfo)
+	var buf [8]byte
+	payloadLen, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if payloadLen > uint64(routingInfoSize) {
+		return nil, nil, fmt.Errorf("trampoline onion payload of %v "+
+			"bytes exceeds routing info", payloadLen)
+	}
+
+	payload := make([]byte, payloadLen)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, nil, err
+	}
+
+	var nextHMAC [trampolineHMACSize]byte
+	if _, err := io.ReadFull(r, nextHMAC[:]); err != nil {
+		return nil, nil, err
+	}
+
+	// An empty HMAC marks the last hop of the route.
+	if nextHMAC == [trampolineHMACSize]byte{} {
+		return payload, nil, nil
+	}
+
+	nextRoutingInfo := make([]byte, routingInfoSize)
+	if _, err := io.ReadFull(r, nextRoutingInfo); err != nil {
+		return nil, nil, err
+	}
+
+	blindingFactor := trampolineBlindingFactor(
+		p.EphemeralKey, sharedSecret,
+	)
+	var ephemeralKey, nextKey btcec.JacobianPoint
+	p.EphemeralKey.AsJacobian(&ephemeralKey)
+	btcec.ScalarMultNonConst(&blindingFactor, &ephemeralKey, &nextKey)
+	nextKey.ToAffine()
+
+	return payload, &TrampolineOnionPacket{
+		Version:      TrampolineOnionVersion,
+		EphemeralKey: btcec.NewPublicKey(&nextKey.X, &nextKey.Y),
+		RoutingInfo:  nextRoutingInfo,
+		HeaderMAC:    nextHMAC,
+	}, nil
+}
+
+// trampolineFiller returns the filler that the last hop of a trampoline onion
+// finds at the end of its routing info, after the prior hops have peeled off
+// their layers.
+func trampolineFiller(hops []TrampolineHop, sharedSecrets [][32]byte,
+	routingInfoSize int) []byte {
+
+	var fillerSize int
+	for i := 0; i < len(hops)-1; i++ {
+		fillerSize += hops[i].size()
+	}
+	filler := make([]byte, fillerSize)
+
+	// Each hop appends as many zero bytes to the routing info as it
+	// consumes, and decrypts them with its stream. So the filler of each
+	// hop continues the filler of the prior hop.
+	fillerStart := routingInfoSize
+	for i := 0; i < len(hops)-1; i++ {
+		fillerEnd := routingInfoSize + hops[i].size()
+
+		rhoKey := trampolineKey("rho", sharedSecrets[i][:])
+		stream := trampolineCipherStream(rhoKey, 2*routingInfoSize)
+		xorBytes(filler, stream[fillerStart:fillerEnd])
+
+		fillerStart -= hops[i].size()
+	}
+
+	return filler
+}
+
+// trampolineKey derives the key of the given type from a shared secret.
+func trampolineKey(keyType string, secret []byte) [32]byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+
+	return key
+}
+
+// trampolineHMAC computes the HMAC of the routing info and the associated
+// data of a trampoline onion.
+func trampolineHMAC(key [32]byte, routingInfo,
+	assocData []byte) [trampolineHMACSize]byte {
+
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+
+	var sum [trampolineHMACSize]byte
+	copy(sum[:], mac.Sum(nil))
+
+	return sum
+}
+
+// trampolineCipherStream generates the given number of pseudo random bytes
+// from the passed key.
+func trampolineCipherStream(key [32]byte, numBytes int) []byte {
+	var nonce [chacha20.NonceSize]byte
+
+	// The cipher only fails for keys and nonces of the wrong size.
+	cipher, _ := chacha20.NewUnauthenticatedCipher(key[:], nonce[:])
+
+	stream := make([]byte, numBytes)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// trampolineBlindingFactor computes the factor that blinds the ephemeral key
+// of a trampoline onion for the next hop.
+func trampolineBlindingFactor(ephemeralKey *btcec.PublicKey,
+	sharedSecret [32]byte) btcec.ModNScalar {
+
+	h := sha256.New()
+	h.Write(ephemeralKey.SerializeCompressed())
+	h.Write(sharedSecret[:])
+
+	var factor btcec.ModNScalar
+	factor.SetByteSlice(h.Sum(nil))
+
+	return factor
+}
+
+// xorBytes xors the passed stream into the destination, up to the length of
+// the shorter one.
+func xorBytes(dst, stream []byte) {
+	for i := 0; i < len(dst) && i < len(stream); i++ {
+		dst[i] ^= stream[i]
+	}
+}
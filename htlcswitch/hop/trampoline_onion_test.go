@@ -0,0 +1,91 @@
+package hop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineOnion tests that each trampoline node of a trampoline onion
+// peels off its own payload, and that the packet only authenticates with the
+// right key and associated data.
+func TestTrampolineOnion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nodeKeys  []*btcec.PrivateKey
+		hops      []TrampolineHop
+		assocData = bytes.Repeat([]byte{1}, 32)
+	)
+	payloads := [][]byte{
+		bytes.Repeat([]byte{2}, 20),
+		bytes.Repeat([]byte{3}, 300),
+		bytes.Repeat([]byte{4}, 100),
+	}
+	for _, payload := range payloads {
+		nodeKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		nodeKeys = append(nodeKeys, nodeKey)
+		hops = append(hops, TrampolineHop{
+			NodeKey: nodeKey.PubKey(),
+			Payload: payload,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	packet, err := NewTrampolineOnion(hops, sessionKey, assocData)
+	require.NoError(t, err)
+
+	// The payloads don't fit into the default routing info, so it's
+	// grown to fit them.
+	var size int
+	for i := range hops {
+		size += hops[i].size()
+	}
+	require.Greater(t, size, TrampolineRoutingInfoSize)
+	require.Len(t, packet.RoutingInfo, size)
+
+	var b bytes.Buffer
+	require.NoError(t, packet.Encode(&b))
+
+	packet, err = DecodeTrampolineOnion(b.Bytes())
+	require.NoError(t, err)
+
+	// The packet doesn't authenticate with another key, or with other
+	// associated data.
+	wrongKey := &keychain.PrivKeyECDH{PrivKey: nodeKeys[1]}
+	_, _, err = packet.Peel(wrongKey, assocData)
+	require.ErrorIs(t, err, ErrTrampolineOnionHMAC)
+
+	firstKey := &keychain.PrivKeyECDH{PrivKey: nodeKeys[0]}
+	_, _, err = packet.Peel(firstKey, assocData[1:])
+	require.ErrorIs(t, err, ErrTrampolineOnionHMAC)
+
+	// Each hop peels off its payload, and passes on a packet of the same
+	// size, until the last hop finds no next packet.
+	for i, nodeKey := range nodeKeys {
+		ecdh := &keychain.PrivKeyECDH{PrivKey: nodeKey}
+		payload, next, err := packet.Peel(ecdh, assocData)
+		require.NoError(t, err)
+		require.Equal(t, payloads[i], payload)
+
+		if i == len(nodeKeys)-1 {
+			require.Nil(t, next)
+			break
+		}
+
+		require.NotNil(t, next)
+		require.Len(t, next.RoutingInfo, size)
+		packet = next
+	}
+
+	// A short packet can't be decoded.
+	_, err = DecodeTrampolineOnion(b.Bytes()[:trampolineOnionOverhead])
+	require.Error(t, err)
+}
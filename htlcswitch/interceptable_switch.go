@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb/models"
@@ -605,6 +606,7 @@ func (f *interceptedForward) Packet() InterceptedPacket {
 		CustomRecords:  f.packet.customRecords,
 		OnionBlob:      f.htlc.OnionBlob,
 		AutoFailHeight: f.autoFailHeight,
+		NextBlinding:   (*btcec.PublicKey)(f.htlc.BlindingPoint),
 	}
 }
 
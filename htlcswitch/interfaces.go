@@ -3,12 +3,15 @@ package htlcswitch
 import (
 	"context"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
@@ -44,6 +47,25 @@ type InvoiceDatabase interface {
 	HodlUnsubscribeAll(subscriber chan<- interface{})
 }
 
+// TrampolineHandler is an interface which represents the subsystem that pays
+// the recipients of trampoline payments on behalf of their payers.
+type TrampolineHandler interface {
+	// NotifyTrampolineHtlc hands an exit hop htlc that carries a
+	// trampoline onion to the handler, which peels it to find the
+	// recipient. The return value describes how the htlc should be
+	// resolved. If the htlc cannot be resolved immediately, the
+	// resolution is sent on the passed in hodlChan later.
+	NotifyTrampolineHtlc(payHash lntypes.Hash,
+		paidAmount lnwire.MilliSatoshi, expiry uint32,
+		currentHeight int32, circuitKey models.CircuitKey,
+		hodlChan chan<- interface{}, mpp *record.MPP,
+		onion *hop.TrampolineOnionPacket) (invoices.HtlcResolution,
+		error)
+
+	// HodlUnsubscribeAll unsubscribes from all htlc resolutions.
+	HodlUnsubscribeAll(subscriber chan<- interface{})
+}
+
 // packetHandler is an interface used exclusively by the Switch to handle
 // htlcPacket and pass them to the link implementation.
 type packetHandler interface {
@@ -138,6 +160,34 @@ type ChannelUpdateHandler interface {
 	// clean. This can be used with dynamic commitment negotiation or coop
 	// close negotiation which require a clean channel state.
 	ShutdownIfChannelClean() error
+
+	// Quiesce requests that the channel becomes quiescent, and blocks
+	// until both sides have sent stfu. No new updates are sent from the
+	// moment it's requested. It returns whether we're the initiator of
+	// the quiescence, which leads the protocol that's run while the
+	// channel is quiet. The channel stays quiescent until it's resumed or
+	// the peer reconnects.
+	Quiesce() (bool, error)
+
+	// Resume resumes the updates of a quiescent channel once the protocol
+	// that required the quiescence has completed.
+	Resume() error
+
+	// IsQuiescent returns true if the channel is quiescent.
+	IsQuiescent() bool
+
+	// DisableAdds prevents any new HTLCs from being added to the channel,
+	// while the existing ones can still be settled or failed. HTLCs that
+	// the remote peer adds are failed back. This is used to drain a
+	// channel before it's replaced by the channel of its confirmed
+	// splice.
+	DisableAdds()
+
+	// UpgradeChannel upgrades the channel with a dynamic commitment
+	// upgrade, which changes its commitment type or constraints without
+	// closing it. It blocks until the upgrade has been applied or
+	// rejected by the remote peer.
+	UpgradeChannel(p *lnwallet.UpgradeProposal) error
 }
 
 // ChannelLink is an interface which represents the subsystem for managing the
@@ -203,7 +253,8 @@ type ChannelLink interface {
 	CheckHtlcForward(payHash [32]byte, incomingAmt lnwire.MilliSatoshi,
 		amtToForward lnwire.MilliSatoshi,
 		incomingTimeout, outgoingTimeout uint32,
-		heightNow uint32, scid lnwire.ShortChannelID) *LinkError
+		inboundFee models.InboundFee, heightNow uint32,
+		scid lnwire.ShortChannelID) *LinkError
 
 	// CheckHtlcTransit should return a nil error if the passed HTLC details
 	// satisfy the current channel policy.  Otherwise, a LinkError with a
@@ -311,6 +362,10 @@ type InterceptedPacket struct {
 	// OnionBlob is the onion packet for the next hop
 	OnionBlob [lnwire.OnionPacketSize]byte
 
+	// NextBlinding is the blinding point that is passed to the next hop if
+	// the htlc is forwarded within a blinded route.
+	NextBlinding *btcec.PublicKey
+
 	// AutoFailHeight is the block height at which this intercept will be
 	// failed back automatically.
 	AutoFailHeight int32
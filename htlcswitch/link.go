@@ -10,6 +10,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btclog"
@@ -28,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/queue"
+	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
@@ -134,6 +136,11 @@ type ChannelLinkConfig struct {
 	// in thread-safe manner.
 	Registry InvoiceDatabase
 
+	// Trampoline is the sub-system that pays the recipients of
+	// trampoline payments on behalf of their payers. If it is nil, htlcs
+	// that carry a trampoline onion are rejected.
+	Trampoline TrampolineHandler
+
 	// PreimageCache is a global witness beacon that houses any new
 	// preimages discovered by other links. We'll use this to add new
 	// witnesses that we discover which will notify any sub-systems
@@ -271,6 +278,28 @@ type ChannelLinkConfig struct {
 	// GetAliases is used by the link and switch to fetch the set of
 	// aliases for a given link.
 	GetAliases func(base lnwire.ShortChannelID) []lnwire.ShortChannelID
+
+	// DisallowQuiescence is true if the quiescence feature wasn't
+	// negotiated with the peer, in which case the channel can't be
+	// quiesced.
+	DisallowQuiescence bool
+
+	// QuiescenceHook is called by the link once the channel has become
+	// quiescent, whether we or the remote peer requested it, and is told
+	// whether we're the initiator of the quiescence. Other subsystems can
+	// use it to make protocol-level changes to the channel while it's
+	// quiet, after which they resume the channel. It's called from the
+	// link's main goroutine, so it must not block.
+	QuiescenceHook func(chanID lnwire.ChannelID, initiator bool)
+
+	// DisallowDynCommit is true if the dynamic commitments feature wasn't
+	// negotiated with the peer, in which case the channel can't be
+	// upgraded.
+	DisallowDynCommit bool
+
+	// MaxLocalCSVDelay is the maximum CSV delay that we accept for our
+	// own funds when the remote peer proposes a channel upgrade.
+	MaxLocalCSVDelay uint16
 }
 
 // shutdownReq contains an error channel that will be used by the channelLink
@@ -280,6 +309,26 @@ type shutdownReq struct {
 	err chan error
 }
 
+// quiescenceReq is a request to quiesce the channel. Once the channel is
+// quiescent, the channelLink sends whether we're the initiator of the
+// quiescence on the initiator channel.
+type quiescenceReq struct {
+	initiator chan bool
+}
+
+// resumeReq is a request to resume a quiescent channel. The channelLink sends
+// an error if the channel isn't quiescent, and nil otherwise.
+type resumeReq struct {
+	err chan error
+}
+
+// deferredAdds holds the adds of a forwarding package that are processed once
+// the channel resumes, as processing them may result in new updates.
+type deferredAdds struct {
+	fwdPkg *channeldb.FwdPkg
+	adds   []*lnwallet.PaymentDescriptor
+}
+
 // channelLink is the service which drives a channel's commitment update
 // state-machine. In the event that an HTLC needs to be propagated to another
 // link, the forward handler from config is used which sends HTLC to the
@@ -344,6 +393,49 @@ type channelLink struct {
 	// service shutdown requests from ShutdownIfChannelClean calls.
 	shutdownRequest chan *shutdownReq
 
+	// quiescer drives the quiescence protocol of the channel. It's only
+	// used by the htlcManager goroutine.
+	quiescer *quiescer
+
+	// quiescenceRequests is a channel that the channelLink will listen on
+	// to service quiescence requests from Quiesce calls.
+	quiescenceRequests chan *quiescenceReq
+
+	// resumeRequests is a channel that the channelLink will listen on to
+	// service resume requests from Resume calls.
+	resumeRequests chan *resumeReq
+
+	// pendingQuiescenceReqs are the quiescence requests that are served
+	// once the channel is quiescent.
+	pendingQuiescenceReqs []*quiescenceReq
+
+	// upgradeRequests is a channel that the channelLink will listen on to
+	// service upgrade requests from UpgradeChannel calls.
+	upgradeRequests chan *upgradeReq
+
+	// pendingUpgrade is the upgrade request that is being served, and
+	// proposedParams are the parameters of the channel that we've proposed
+	// to the remote peer for it.
+	pendingUpgrade *upgradeReq
+	proposedParams *channeldb.ChannelParams
+
+	// deferredAdds are the remote adds that were locked in while no
+	// updates could be sent, which are processed once the channel
+	// resumes.
+	deferredAdds []*deferredAdds
+
+	// quiescing is true if no new updates can be sent because the
+	// channel is being quiesced, and quiescent is true once both sides
+	// have sent stfu. They're set by the htlcManager goroutine so that
+	// other goroutines can refuse new htlcs.
+	quiescing atomic.Bool
+	quiescent atomic.Bool
+
+	// addsDisabled is true once no new htlcs can be added to the channel,
+	// because it's drained before it's replaced by the channel of its
+	// confirmed splice.
+	addsDisabled atomic.Bool
+
 	// updateFeeTimer is the timer responsible for updating the link's
 	// commitment fee every time it fires.
 	updateFeeTimer *time.Timer
@@ -384,16 +476,30 @@ func NewChannelLink(cfg ChannelLinkConfig,
 
 	logPrefix := fmt.Sprintf("ChannelLink(%v):", channel.ChannelPoint())
 
-	return &channelLink{
-		cfg:             cfg,
-		channel:         channel,
-		shortChanID:     channel.ShortChanID(),
-		shutdownRequest: make(chan *shutdownReq),
-		hodlMap:         make(map[models.CircuitKey]hodlHtlc),
-		hodlQueue:       queue.NewConcurrentQueue(10),
-		log:             build.NewPrefixLog(logPrefix, log),
-		quit:            make(chan struct{}),
+	l := &channelLink{
+		cfg:                cfg,
+		channel:            channel,
+		shortChanID:        channel.ShortChanID(),
+		shutdownRequest:    make(chan *shutdownReq),
+		quiescenceRequests: make(chan *quiescenceReq),
+		resumeRequests:     make(chan *resumeReq),
+		upgradeRequests:    make(chan *upgradeReq),
+		hodlMap:            make(map[models.CircuitKey]hodlHtlc),
+		hodlQueue:          queue.NewConcurrentQueue(10),
+		log:                build.NewPrefixLog(logPrefix, log),
+		quit:               make(chan struct{}),
 	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.ChannelPoint())
+	l.quiescer = newQuiescer(quiescerCfg{
+		chanID:           chanID,
+		channelInitiator: channel.IsInitiator(),
+		sendMsg: func(msg *lnwire.Stfu) error {
+			return cfg.Peer.SendMessage(false, msg)
+		},
+	})
+
+	return l
 }
 
 // A compile time check to ensure channelLink implements the ChannelLink
@@ -490,6 +596,9 @@ func (l *channelLink) Stop() {
 	// As the link is stopping, we are no longer interested in htlc
 	// resolutions coming from the invoice registry.
 	l.cfg.Registry.HodlUnsubscribeAll(l.hodlQueue.ChanIn())
+	if l.cfg.Trampoline != nil {
+		l.cfg.Trampoline.HodlUnsubscribeAll(l.hodlQueue.ChanIn())
+	}
 
 	if l.cfg.ChainEvents.Cancel != nil {
 		l.cfg.ChainEvents.Cancel()
@@ -542,7 +651,7 @@ func (l *channelLink) WaitForShutdown() {
 func (l *channelLink) EligibleToForward() bool {
 	return l.channel.RemoteNextRevocation() != nil &&
 		l.ShortChanID() != hop.Source &&
-		l.isReestablished()
+		l.isReestablished() && !l.addsDisabled.Load()
 }
 
 // isReestablished returns true if the link has successfully completed the
@@ -1131,6 +1240,22 @@ func (l *channelLink) htlcManager() {
 				"PendingLocalUpdateCount")
 		}
 
+		// If we owe the remote peer a stfu, we send it once all our
+		// updates have been irrevocably committed.
+		if !l.sendOwedStfu() {
+			return
+		}
+
+		// Packets from the switch and htlc resolutions result in new
+		// updates, which can't be sent while the channel is being
+		// quiesced. They're processed once the channel resumes.
+		downstream := l.downstream
+		hodlQueue := l.hodlQueue.ChanOut()
+		if !l.quiescer.canSendUpdates() {
+			downstream = nil
+			hodlQueue = nil
+		}
+
 		select {
 		// Our update fee timer has fired, so we'll check the network
 		// fee to see if we should adjust our commitment fee.
@@ -1143,6 +1268,12 @@ func (l *channelLink) htlcManager() {
 				continue
 			}
 
+			// We can't update the fee while the channel is being
+			// quiesced.
+			if !l.quiescer.canSendUpdates() {
+				continue
+			}
+
 			// If we are the initiator, then we'll sample the
 			// current fee rate to get into the chain within 3
 			// blocks.
@@ -1220,7 +1351,7 @@ func (l *channelLink) htlcManager() {
 		// A message from the switch was just received. This indicates
 		// that the link is an intermediate hop in a multi-hop HTLC
 		// circuit.
-		case pkt := <-l.downstream:
+		case pkt := <-downstream:
 			l.handleDownstreamPkt(pkt)
 
 		// A message from the connected peer was just received. This
@@ -1231,7 +1362,7 @@ func (l *channelLink) htlcManager() {
 
 		// A htlc resolution is received. This means that we now have a
 		// resolution for a previously accepted htlc.
-		case hodlItem := <-l.hodlQueue.ChanOut():
+		case hodlItem := <-hodlQueue:
 			htlcResolution := hodlItem.(invoices.HtlcResolution)
 			err := l.processHodlQueue(htlcResolution)
 			switch err {
@@ -1275,12 +1406,174 @@ func (l *channelLink) htlcManager() {
 			// an error and continue.
 			req.err <- ErrLinkFailedShutdown
 
+		case req := <-l.quiescenceRequests:
+			l.handleQuiescenceReq(req)
+
+		case req := <-l.resumeRequests:
+			if !l.handleResumeReq(req) {
+				return
+			}
+
+		case req := <-l.upgradeRequests:
+			l.handleUpgradeReq(req)
+
 		case <-l.quit:
 			return
 		}
 	}
 }
 
+// handleQuiescenceReq starts the quiescence of the channel. The request is
+// served once the channel is quiescent.
+func (l *channelLink) handleQuiescenceReq(req *quiescenceReq) {
+	if l.quiescer.isQuiescent() {
+		req.initiator <- l.quiescer.isInitiator()
+		return
+	}
+
+	l.log.Infof("Quiescing channel")
+
+	l.quiescer.initStfu()
+	l.quiescing.Store(true)
+
+	l.pendingQuiescenceReqs = append(l.pendingQuiescenceReqs, req)
+}
+
+// handleResumeReq resumes a quiescent channel, after which the updates that
+// were held back are processed. It returns false if the link failed.
+func (l *channelLink) handleResumeReq(req *resumeReq) bool {
+	// The channel is resumed by the upgrade itself if it's being
+	// upgraded.
+	if l.pendingUpgrade != nil {
+		req.err <- ErrUpgradeInProgress
+		return true
+	}
+
+	if err := l.resume(); err != nil {
+		req.err <- err
+		return true
+	}
+	req.err <- nil
+
+	return l.processHeldUpdates()
+}
+
+// resume ends the quiescence of the channel, after which new updates can be
+// sent by both sides again.
+func (l *channelLink) resume() error {
+	if err := l.quiescer.resume(); err != nil {
+		return err
+	}
+
+	l.log.Infof("Resuming quiescent channel")
+
+	l.quiescing.Store(false)
+	l.quiescent.Store(false)
+
+	return nil
+}
+
+// processHeldUpdates processes the updates that were held back while the
+// channel was quiescent, once it has resumed. It returns false if the link
+// failed.
+func (l *channelLink) processHeldUpdates() bool {
+	// Process the remote adds that were locked in while the channel was
+	// being quiesced.
+	deferred := l.deferredAdds
+	l.deferredAdds = nil
+	for _, d := range deferred {
+		l.processRemoteAdds(d.fwdPkg, d.adds)
+		if l.failed {
+			return false
+		}
+	}
+
+	if l.channel.OweCommitment() {
+		return l.updateCommitTxOrFail()
+	}
+
+	return true
+}
+
+// sendOwedStfu sends our stfu to the remote peer if we owe it and none of our
+// updates are pending. It returns false if the link failed.
+func (l *channelLink) sendOwedStfu() bool {
+	sent, err := l.quiescer.sendOwedStfu(l.channel.HasPendingUpdates(true))
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to send stfu: %v", err)
+		return false
+	}
+
+	if sent && l.quiescer.isQuiescent() {
+		l.onQuiescent()
+	}
+
+	return true
+}
+
+// handleStfu processes a stfu message from the remote peer. If we've already
+// sent our stfu, the channel is now quiescent. Otherwise, we'll reply with our
+// stfu once none of our updates are pending.
+func (l *channelLink) handleStfu(msg *lnwire.Stfu) {
+	if l.cfg.DisallowQuiescence {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"received stfu, but quiescence wasn't negotiated",
+		)
+		return
+	}
+
+	err := l.quiescer.recvStfu(msg, l.channel.HasPendingUpdates(false))
+	if err != nil {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"unable to handle stfu: %v", err,
+		)
+		return
+	}
+
+	l.quiescing.Store(true)
+
+	if l.quiescer.isQuiescent() {
+		l.onQuiescent()
+	}
+}
+
+// onQuiescent is called once both sides have sent stfu. It serves the pending
+// quiescence requests and calls the quiescence hook.
+func (l *channelLink) onQuiescent() {
+	initiator := l.quiescer.isInitiator()
+
+	l.log.Infof("Channel is quiescent, initiator=%v", initiator)
+
+	l.quiescent.Store(true)
+
+	for _, req := range l.pendingQuiescenceReqs {
+		req.initiator <- initiator
+	}
+	l.pendingQuiescenceReqs = nil
+
+	// If we've quiesced the channel to upgrade it, the upgrade can now be
+	// proposed.
+	if l.pendingUpgrade != nil {
+		l.proposeUpgrade(initiator)
+		return
+	}
+
+	if l.cfg.QuiescenceHook != nil {
+		l.cfg.QuiescenceHook(l.ChanID(), initiator)
+	}
+}
+
 // processHodlQueue processes a received htlc resolution and continues reading
 // from the hodl queue until no more resolutions remain. When this function
 // returns without an error, the commit tx should be updated.
@@ -1378,6 +1671,18 @@ func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 		)
 	}
 
+	// Failures of trampoline payments aren't related to an invoice of
+	// ours, so we signal a temporary failure of our node to the payer.
+	switch resolution.Outcome {
+	case invoices.ResultTrampolineFeeInsufficient,
+		invoices.ResultTrampolineExpiryTooSoon,
+		invoices.ResultTrampolineFailed:
+
+		return NewDetailedLinkError(
+			&lnwire.FailTemporaryNodeFailure{}, resolution.Outcome,
+		)
+	}
+
 	// If the htlc is not a MPP timeout, we fail it with
 	// FailIncorrectDetails. This error is sent for invoice payment
 	// failures such as underpayment/ expiry too soon and hodl invoices
@@ -1612,8 +1917,18 @@ func (l *channelLink) handleDownstreamPkt(pkt *htlcPacket) {
 		htlc.ID = pkt.incomingHTLCID
 
 		// We send the HTLC message to the peer which initially created
-		// the HTLC.
-		l.cfg.Peer.SendMessage(false, htlc)
+		// the HTLC. If we relayed the HTLC within a blinded route, the
+		// failure is replaced with a malformed invalid_onion_blinding
+		// failure.
+		var failMsg lnwire.Message = htlc
+		if pkt.isBlindedRelay() {
+			failMsg = &lnwire.UpdateFailMalformedHTLC{
+				ChanID:      htlc.ChanID,
+				ID:          htlc.ID,
+				FailureCode: lnwire.CodeInvalidBlinding,
+			}
+		}
+		l.cfg.Peer.SendMessage(false, failMsg)
 
 		// If the packet does not have a link failure set, it failed
 		// further down the route so we notify a forwarding failure.
@@ -1716,6 +2031,20 @@ func (l *channelLink) cleanupSpuriousResponse(pkt *htlcPacket) {
 // updates from the upstream peer. The upstream peer is the peer whom we have a
 // direct channel with, updating our respective commitment chains.
 func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
+	// The remote peer isn't allowed to send any new updates once it has
+	// sent stfu.
+	if isUpdateMsg(msg) && !l.quiescer.canRecvUpdates() {
+		l.fail(
+			LinkFailureError{
+				code:          ErrInvalidUpdate,
+				FailureAction: LinkFailureDisconnect,
+				Warning:       true,
+			},
+			"received %v after stfu", msg.MsgType(),
+		)
+		return
+	}
+
 	switch msg := msg.(type) {
 
 	case *lnwire.UpdateAddHTLC:
@@ -1914,6 +2243,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			CommitSig:  msg.CommitSig,
 			HtlcSigs:   msg.HtlcSigs,
 			PartialSig: msg.PartialSig,
+			SpliceSig:  msg.SpliceSig,
 		})
 		if err != nil {
 			// If we were unable to reconstruct their proposed
@@ -2078,7 +2408,20 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		}
 
 		l.processRemoteSettleFails(fwdPkg, settleFails)
-		l.processRemoteAdds(fwdPkg, adds)
+
+		// Processing the adds may result in new updates, e.g. if we
+		// fail or settle them, which can't be sent while the channel
+		// is being quiesced. We defer them until the channel resumes.
+		// If the link restarts in the meantime, they're processed
+		// when the forwarding packages are resolved.
+		if !l.quiescer.canSendUpdates() {
+			l.deferredAdds = append(l.deferredAdds, &deferredAdds{
+				fwdPkg: fwdPkg,
+				adds:   adds,
+			})
+		} else {
+			l.processRemoteAdds(fwdPkg, adds)
+		}
 
 		// If the link failed during processing the adds, we must
 		// return to ensure we won't attempted to update the state
@@ -2113,6 +2456,18 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// Update the mailbox's feerate as well.
 		l.mailBox.SetFeeRate(fee)
 
+	case *lnwire.Stfu:
+		l.handleStfu(msg)
+
+	case *lnwire.DynPropose:
+		l.handleDynPropose(msg)
+
+	case *lnwire.DynAck:
+		l.handleDynAck(msg)
+
+	case *lnwire.DynReject:
+		l.handleDynReject(msg)
+
 	// In the case where we receive a warning message from our peer, just
 	// log it and move on. We choose not to disconnect from our peer,
 	// although we "MAY" do so according to the specification.
@@ -2145,6 +2500,23 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 
 }
 
+// isUpdateMsg returns true if the message is an update to the channel state,
+// which may not be sent once the sender has sent stfu.
+func isUpdateMsg(msg lnwire.Message) bool {
+	switch msg.(type) {
+	case *lnwire.UpdateAddHTLC,
+		*lnwire.UpdateFulfillHTLC,
+		*lnwire.UpdateFailHTLC,
+		*lnwire.UpdateFailMalformedHTLC,
+		*lnwire.UpdateFee:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
 // ackDownStreamPackets is responsible for removing htlcs from a link's mailbox
 // for packets delivered from server, and cleaning up any circuits closed by
 // signing a previous commitment txn. This method ensures that the circuits are
@@ -2305,6 +2677,7 @@ func (l *channelLink) updateCommitTx() error {
 		CommitSig:  newCommit.CommitSig,
 		HtlcSigs:   newCommit.HtlcSigs,
 		PartialSig: newCommit.PartialSig,
+		SpliceSig:  newCommit.SpliceSig,
 	}
 	l.cfg.Peer.SendMessage(false, commitSig)
 
@@ -2385,6 +2758,14 @@ func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
 // forwards or other payments may use the available slot, so it should be
 // considered best-effort.
 func (l *channelLink) MayAddOutgoingHtlc(amt lnwire.MilliSatoshi) error {
+	if l.quiescing.Load() {
+		return errChannelQuiescing
+	}
+
+	if l.addsDisabled.Load() {
+		return errLinkAddsDisabled
+	}
+
 	return l.channel.MayAddOutgoingHtlc(amt)
 }
 
@@ -2543,7 +2924,8 @@ func (l *channelLink) UpdateForwardingPolicy(
 func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliSatoshi,
 	incomingTimeout, outgoingTimeout uint32,
-	heightNow uint32, originalScid lnwire.ShortChannelID) *LinkError {
+	inboundFee models.InboundFee, heightNow uint32,
+	originalScid lnwire.ShortChannelID) *LinkError {
 
 	l.RLock()
 	policy := l.cfg.FwrdingPolicy
@@ -2551,8 +2933,13 @@ func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 
 	// Using the amount of the incoming HTLC, we'll calculate the expected
 	// fee this incoming HTLC must carry in order to satisfy the
-	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	// constraints of the outgoing link. The inbound fee of the incoming
+	// link is added on top, based on the amount to forward plus the
+	// outbound fee. Both fees are computed separately, as that's how the
+	// sender computes them too, and rounding would differ otherwise.
+	expectedFee := inboundFee.TotalFee(
+		ExpectedFee(policy, amtToForward), amtToForward,
+	)
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
@@ -2635,6 +3022,21 @@ func (l *channelLink) canSendHtlc(policy models.ForwardingPolicy,
 	payHash [32]byte, amt lnwire.MilliSatoshi, timeout uint32,
 	heightNow uint32, originalScid lnwire.ShortChannelID) *LinkError {
 
+	// No htlcs can be added while the channel is being quiesced or
+	// drained.
+	if l.quiescing.Load() || l.addsDisabled.Load() {
+		l.log.Warnf("outgoing htlc(%x) refused, channel is being "+
+			"quiesced or drained", payHash[:])
+
+		cb := func(upd *lnwire.ChannelUpdate) lnwire.FailureMessage {
+			return lnwire.NewTemporaryChannelFailure(upd)
+		}
+		failure := l.createFailureWithUpdate(false, originalScid, cb)
+		return NewDetailedLinkError(
+			failure, OutgoingFailureLinkNotEligible,
+		)
+	}
+
 	// As our first sanity check, we'll ensure that the passed HTLC isn't
 	// too small for the next hop. If so, then we'll cancel the HTLC
 	// directly.
@@ -2777,6 +3179,72 @@ func (l *channelLink) ShutdownIfChannelClean() error {
 	}
 }
 
+// Quiesce requests that the channel becomes quiescent and blocks until it is.
+// It returns whether we're the initiator of the quiescence.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Quiesce() (bool, error) {
+	if l.cfg.DisallowQuiescence {
+		return false, ErrQuiescenceNotSupported
+	}
+
+	req := &quiescenceReq{
+		initiator: make(chan bool, 1),
+	}
+
+	select {
+	case l.quiescenceRequests <- req:
+	case <-l.quit:
+		return false, ErrLinkShuttingDown
+	}
+
+	select {
+	case initiator := <-req.initiator:
+		return initiator, nil
+	case <-l.quit:
+		return false, ErrLinkShuttingDown
+	}
+}
+
+// Resume resumes the updates of a quiescent channel.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Resume() error {
+	errChan := make(chan error, 1)
+
+	select {
+	case l.resumeRequests <- &resumeReq{
+		err: errChan,
+	}:
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+}
+
+// IsQuiescent returns true if both sides have sent stfu and the channel
+// hasn't been resumed yet.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) IsQuiescent() bool {
+	return l.quiescent.Load()
+}
+
+// DisableAdds prevents any new htlcs from being added to the channel.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) DisableAdds() {
+	if !l.addsDisabled.Swap(true) {
+		l.log.Infof("Disabled htlc adds, draining channel")
+	}
+}
+
 // updateChannelFee updates the commitment fee-per-kw on this channel by
 // committing to an update_fee message.
 func (l *channelLink) updateChannelFee(feePerKw chainfee.SatPerKWeight) error {
@@ -2928,6 +3396,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 	l.log.Tracef("processing %d remote adds for height %d",
 		len(lockedInHtlcs), fwdPkg.Height)
 
+	// The inbound fee of our channel is charged on top of the outbound
+	// fee of the channel that the htlcs are forwarded over.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	decodeReqs := make(
 		[]hop.DecodeHopIteratorRequest, 0, len(lockedInHtlcs),
 	)
@@ -2943,9 +3417,13 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			onionReader := bytes.NewReader(pd.OnionBlob)
 
 			req := hop.DecodeHopIteratorRequest{
-				OnionReader:  onionReader,
-				RHash:        pd.RHash[:],
-				IncomingCltv: pd.Timeout,
+				OnionReader:    onionReader,
+				RHash:          pd.RHash[:],
+				IncomingCltv:   pd.Timeout,
+				IncomingAmount: pd.Amount,
+				BlindingPoint: (*btcec.PublicKey)(
+					pd.BlindingPoint,
+				),
 			}
 
 			decodeReqs = append(decodeReqs, req)
@@ -3026,10 +3504,51 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			continue
 		}
 
+		// While the channel is drained, new htlcs of the remote peer
+		// are failed back. Htlcs of packages that were already
+		// processed may have been forwarded, so they're left as is.
+		if l.addsDisabled.Load() &&
+			fwdPkg.State == channeldb.FwdStateLockedIn {
+
+			l.log.Debugf("failing htlc %v, adds are disabled",
+				pd.HtlcIndex)
+
+			failure := NewLinkError(
+				lnwire.NewTemporaryChannelFailure(nil),
+			)
+			l.sendHTLCError(pd, failure, obfuscator, false)
+			continue
+		}
+
 		heightNow := l.cfg.BestHeight()
 
 		pld, err := chanIterator.HopPayload()
-		if err != nil {
+		switch {
+		// If the htlc was received within a blinded route, we fail it
+		// with a malformed error so that no information about the
+		// route is revealed.
+		case err != nil && pd.BlindingPoint != nil:
+			l.failBlindedHTLC(pd)
+
+			l.log.Errorf("unable to decode blinded forwarding "+
+				"instructions: %v", err)
+			continue
+
+		// If we're the introduction node of a blinded route that we
+		// can't process, we return an invalid_onion_blinding error to
+		// the sender.
+		case hop.IsBlindingError(err):
+
+			failure := lnwire.NewInvalidBlinding(onionBlob[:])
+			l.sendHTLCError(
+				pd, NewLinkError(failure), obfuscator, false,
+			)
+
+			l.log.Errorf("unable to process blinded forwarding "+
+				"instructions: %v", err)
+			continue
+
+		case err != nil:
 			// If we're unable to process the onion payload, or we
 			// received invalid onion payload failure, then we
 			// should send an error back to the caller so the HTLC
@@ -3055,6 +3574,11 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			continue
 		}
 
+		// If the htlc is part of a blinded route, failures must not
+		// reveal anything about the route to the sender, so we use an
+		// error encrypter that is specific to our role in the route.
+		obfuscator = blindedErrorEncrypter(obfuscator, pd, pld)
+
 		fwdInfo := pld.ForwardingInfo()
 
 		switch fwdInfo.NextHop {
@@ -3099,6 +3623,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					Expiry:      fwdInfo.OutgoingCTLV,
 					Amount:      fwdInfo.AmountToForward,
 					PaymentHash: pd.RHash,
+					BlindingPoint: (*lnwire.BlindingPoint)(
+						fwdInfo.NextBlinding,
+					),
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -3123,6 +3650,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
 					customRecords:   pld.CustomRecords(),
+					inboundFee:      inboundFee,
 				}
 				switchPackets = append(
 					switchPackets, updatePacket,
@@ -3141,6 +3669,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				Expiry:      fwdInfo.OutgoingCTLV,
 				Amount:      fwdInfo.AmountToForward,
 				PaymentHash: pd.RHash,
+				BlindingPoint: (*lnwire.BlindingPoint)(
+					fwdInfo.NextBlinding,
+				),
 			}
 
 			// Finally, we'll encode the onion packet for the
@@ -3187,6 +3718,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
 					customRecords:   pld.CustomRecords(),
+					inboundFee:      inboundFee,
 				}
 
 				fwdPkg.FwdFilter.Set(idx)
@@ -3228,7 +3760,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	obfuscator hop.ErrorEncrypter, fwdInfo hop.ForwardingInfo,
-	heightNow uint32, payload invoices.Payload) error {
+	heightNow uint32, payload *hop.Payload) error {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -3239,6 +3771,20 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		return nil
 	}
 
+	// Htlcs that arrive through one of our blinded paths must satisfy
+	// the constraints that we encrypted for ourselves. The blinded error
+	// encrypter makes sure that the reason isn't revealed to the sender.
+	routeData := payload.BlindedRouteData()
+	if routeData != nil && !blindedConstraintsMet(pd, fwdInfo, routeData) {
+		l.log.Errorf("incoming blinded htlc(%x) does not satisfy "+
+			"payment constraints", pd.RHash[:])
+
+		failure := NewLinkError(lnwire.NewInvalidBlinding(pd.OnionBlob))
+		l.sendHTLCError(pd, failure, obfuscator, true)
+
+		return nil
+	}
+
 	// As we're the exit hop, we'll double check the hop-payload included in
 	// the HTLC to ensure that it was crafted correctly by the sender and
 	// is compatible with the HTLC we were extended.
@@ -3280,10 +3826,37 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		HtlcID: pd.HtlcIndex,
 	}
 
-	event, err := l.cfg.Registry.NotifyExitHopHtlc(
-		invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
-		circuitKey, l.hodlQueue.ChanIn(), payload,
+	var (
+		event invoices.HtlcResolution
+		err   error
 	)
+	switch {
+	// Htlcs that carry a trampoline onion aren't paid to one of our
+	// invoices, we pay their recipient on behalf of the sender instead.
+	case payload.TrampolineOnion() != nil && l.cfg.Trampoline == nil:
+		l.log.Errorf("rejecting trampoline htlc(%x), trampoline "+
+			"routing disabled", pd.RHash[:])
+
+		failure := lnwire.NewInvalidOnionPayload(
+			uint64(record.TrampolineOnionType), 0,
+		)
+		l.sendHTLCError(pd, NewLinkError(failure), obfuscator, true)
+
+		return nil
+
+	case payload.TrampolineOnion() != nil:
+		event, err = l.cfg.Trampoline.NotifyTrampolineHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
+			circuitKey, l.hodlQueue.ChanIn(), payload.MultiPath(),
+			payload.TrampolineOnion(),
+		)
+
+	default:
+		event, err = l.cfg.Registry.NotifyExitHopHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
+			circuitKey, l.hodlQueue.ChanIn(), payload,
+		)
+	}
 	if err != nil {
 		return err
 	}
@@ -3379,23 +3952,31 @@ func (l *channelLink) forwardBatch(replay bool, packets ...*htlcPacket) {
 func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
 	failure *LinkError, e hop.ErrorEncrypter, isReceive bool) {
 
-	reason, err := e.EncryptFirstHop(failure.WireMessage())
-	if err != nil {
-		l.log.Errorf("unable to obfuscate error: %v", err)
-		return
-	}
+	// If we're relaying the htlc within a blinded route, we don't reveal
+	// the failure to the previous hop and fail it as malformed instead.
+	if e.Type() == hop.EncrypterTypeRelaying {
+		l.failBlindedHTLC(pd)
+	} else {
+		reason, err := e.EncryptFirstHop(failure.WireMessage())
+		if err != nil {
+			l.log.Errorf("unable to obfuscate error: %v", err)
+			return
+		}
 
-	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
-	if err != nil {
-		l.log.Errorf("unable cancel htlc: %v", err)
-		return
-	}
+		err = l.channel.FailHTLC(
+			pd.HtlcIndex, reason, pd.SourceRef, nil, nil,
+		)
+		if err != nil {
+			l.log.Errorf("unable cancel htlc: %v", err)
+			return
+		}
 
-	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
-		ChanID: l.ChanID(),
-		ID:     pd.HtlcIndex,
-		Reason: reason,
-	})
+		l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
+			ChanID: l.ChanID(),
+			ID:     pd.HtlcIndex,
+			Reason: reason,
+		})
+	}
 
 	// Notify a link failure on our incoming link. Outgoing htlc information
 	// is not available at this point, because we have not decrypted the
@@ -3424,6 +4005,69 @@ func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
 	)
 }
 
+// blindedConstraintsMet returns true if an htlc received through one of our
+// blinded paths satisfies the payment constraints that we included in the
+// encrypted data of the final hop, and the amount and expiry in the onion.
+func blindedConstraintsMet(pd *lnwallet.PaymentDescriptor,
+	fwdInfo hop.ForwardingInfo, routeData *record.BlindedRouteData) bool {
+
+	if pd.Amount < fwdInfo.AmountToForward ||
+		pd.Timeout < fwdInfo.OutgoingCTLV {
+
+		return false
+	}
+
+	constraints := routeData.Constraints
+	if constraints == nil {
+		return true
+	}
+
+	return pd.Timeout <= constraints.MaxCltvExpiry &&
+		pd.Amount >= constraints.HtlcMinimumMsat
+}
+
+// blindedErrorEncrypter wraps the error encrypter of an htlc that was received
+// within a blinded route in the encrypter for our role in the route. The
+// encrypter of htlcs that aren't part of a blinded route is returned as is.
+func blindedErrorEncrypter(e hop.ErrorEncrypter,
+	pd *lnwallet.PaymentDescriptor, pld *hop.Payload) hop.ErrorEncrypter {
+
+	sphinxEncrypter, ok := e.(*hop.SphinxErrorEncrypter)
+	if !ok {
+		return e
+	}
+
+	switch {
+	// If the previous hop provided a blinding point, we're relaying the
+	// htlc within the blinded route.
+	case pd.BlindingPoint != nil:
+		return &hop.RelayingErrorEncrypter{
+			SphinxErrorEncrypter: sphinxEncrypter,
+		}
+
+	// Otherwise, if our payload contained encrypted data, we're the
+	// introduction node of the blinded route.
+	case pld.EncryptedData() != nil:
+		return &hop.IntroductionErrorEncrypter{
+			SphinxErrorEncrypter: sphinxEncrypter,
+		}
+
+	default:
+		return e
+	}
+}
+
+// failBlindedHTLC fails an htlc that was received from the previous hop within
+// a blinded route. Such htlcs are always failed with a malformed
+// invalid_onion_blinding error, so that the sender can't use failures to
+// probe the blinded route.
+func (l *channelLink) failBlindedHTLC(pd *lnwallet.PaymentDescriptor) {
+	l.sendMalformedHTLCError(
+		pd.HtlcIndex, lnwire.CodeInvalidBlinding, pd.OnionBlob,
+		pd.SourceRef,
+	)
+}
+
 // sendMalformedHTLCError helper function which sends the malformed HTLC update
 // to the payment sender.
 func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
@@ -504,6 +504,101 @@ func TestChannelLinkSingleHopPayment(t *testing.T) {
 	}
 }
 
+// TestChannelLinkQuiescence tests that a channel becomes quiescent once both
+// links have sent stfu, that no htlcs are added while it's quiescent, and that
+// payments succeed again once the channel has been resumed.
+func TestChannelLinkQuiescence(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, err := createTwoClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newTwoHopNetwork(t, alice.channel, bob.channel, testStartingHeight)
+
+	// Alice initiates the quiescence, after which both links are
+	// quiescent.
+	initiator, err := n.aliceChannelLink.Quiesce()
+	require.NoError(t, err)
+	require.True(t, initiator)
+	require.True(t, n.aliceChannelLink.IsQuiescent())
+
+	require.Eventually(t, n.bobChannelLink.IsQuiescent, 5*time.Second,
+		10*time.Millisecond)
+
+	initiator, err = n.bobChannelLink.Quiesce()
+	require.NoError(t, err)
+	require.False(t, initiator)
+
+	// No payments can be made while the channel is quiescent.
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.bobChannelLink,
+	)
+	firstHop := n.bobChannelLink.ShortChanID()
+
+	require.Error(t, n.aliceChannelLink.MayAddOutgoingHtlc(amount))
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, firstHop, hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	require.Error(t, err)
+
+	// Once both sides have resumed the channel, the payment succeeds.
+	require.NoError(t, n.aliceChannelLink.Resume())
+	require.NoError(t, n.bobChannelLink.Resume())
+	require.False(t, n.aliceChannelLink.IsQuiescent())
+	require.ErrorIs(
+		t, n.aliceChannelLink.Resume(), ErrChannelNotQuiescent,
+	)
+
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, firstHop, hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	require.NoError(t, err)
+}
+
+// TestChannelLinkDisableAdds tests that htlcs that the remote peer adds to a
+// channel whose adds are disabled are failed back, and that no htlcs are
+// forwarded over it.
+func TestChannelLinkDisableAdds(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, err := createTwoClusterChannels(
+		t, btcutil.SatoshiPerBitcoin*3, btcutil.SatoshiPerBitcoin*5,
+	)
+	require.NoError(t, err, "unable to create channel")
+
+	n := newTwoHopNetwork(t, alice.channel, bob.channel, testStartingHeight)
+
+	amount := lnwire.NewMSatFromSatoshis(10_000)
+	htlcAmt, totalTimelock, hops := generateHops(
+		amount, testStartingHeight, n.bobChannelLink,
+	)
+	firstHop := n.bobChannelLink.ShortChanID()
+
+	// Bob fails the payment back once his adds are disabled.
+	n.bobChannelLink.DisableAdds()
+	_, err = makePayment(
+		n.aliceServer, n.bobServer, firstHop, hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	rtErr, ok := err.(ClearTextError)
+	require.True(t, ok, "expected a ClearTextError, got: %T", err)
+	require.IsType(
+		t, &lnwire.FailTemporaryChannelFailure{}, rtErr.WireMessage(),
+	)
+
+	// Once Alice disables her adds as well, the link isn't eligible to
+	// forward anymore.
+	require.True(t, n.aliceChannelLink.EligibleToForward())
+	n.aliceChannelLink.DisableAdds()
+	require.False(t, n.aliceChannelLink.EligibleToForward())
+	require.Error(t, n.aliceChannelLink.MayAddOutgoingHtlc(amount))
+}
+
 // TestChannelLinkMultiHopPayment checks the ability to send payment over two
 // hops. In this test we send the payment from Carol to Alice over Bob peer.
 // (Carol -> Bob -> Alice) and checking that HTLC was settled properly and
@@ -5771,7 +5866,8 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("satisfied", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 0, lnwire.ShortChannelID{})
+			200, 150, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
 		}
@@ -5779,7 +5875,8 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("below minhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 100, 50,
-			200, 150, 0, lnwire.ShortChannelID{})
+			200, 150, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
@@ -5787,7 +5884,8 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("above maxhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1200,
-			200, 150, 0, lnwire.ShortChannelID{})
+			200, 150, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailTemporaryChannelFailure); !ok {
 			t.Fatalf("expected FailTemporaryChannelFailure failure code")
 		}
@@ -5795,7 +5893,8 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1005, 1000,
-			200, 150, 0, lnwire.ShortChannelID{})
+			200, 150, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailFeeInsufficient); !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
 		}
@@ -5807,16 +5906,48 @@ func TestCheckHtlcForward(t *testing.T) {
 		t.Parallel()
 
 		result := link.CheckHtlcForward(
-			hash, 100005, 100000, 200,
-			150, 0, lnwire.ShortChannelID{},
+			hash, 100005, 100000, 200, 150, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{},
 		)
 		_, ok := result.WireMessage().(*lnwire.FailFeeInsufficient)
 		require.True(t, ok, "expected FailFeeInsufficient failure code")
 	})
 
+	t.Run("inbound fee discount", func(t *testing.T) {
+		// A discount of 5 msat on the incoming channel is subtracted
+		// from the outbound fee of 10 msat.
+		inboundFee := models.InboundFee{Base: -5}
+
+		result := link.CheckHtlcForward(hash, 1005, 1000,
+			200, 150, inboundFee, 0, lnwire.ShortChannelID{})
+		require.Nil(t, result)
+
+		result = link.CheckHtlcForward(hash, 1004, 1000,
+			200, 150, inboundFee, 0, lnwire.ShortChannelID{})
+		_, ok := result.WireMessage().(*lnwire.FailFeeInsufficient)
+		require.True(t, ok, "expected FailFeeInsufficient failure code")
+	})
+
+	t.Run("inbound fee surcharge", func(t *testing.T) {
+		// A surcharge of 1000ppm on the incoming channel is added to
+		// the outbound fee, based on the outgoing amount plus the
+		// outbound fee.
+		inboundFee := models.InboundFee{Rate: 1000}
+
+		result := link.CheckHtlcForward(hash, 1010, 1000,
+			200, 150, inboundFee, 0, lnwire.ShortChannelID{})
+		_, ok := result.WireMessage().(*lnwire.FailFeeInsufficient)
+		require.True(t, ok, "expected FailFeeInsufficient failure code")
+
+		result = link.CheckHtlcForward(hash, 1011, 1000,
+			200, 150, inboundFee, 0, lnwire.ShortChannelID{})
+		require.Nil(t, result)
+	})
+
 	t.Run("expiry too soon", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 190, lnwire.ShortChannelID{})
+			200, 150, models.InboundFee{}, 190,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
 		}
@@ -5824,7 +5955,8 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 190, 0, lnwire.ShortChannelID{})
+			200, 190, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
 		}
@@ -5834,7 +5966,8 @@ func TestCheckHtlcForward(t *testing.T) {
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			10200, 10100, 0, lnwire.ShortChannelID{})
+			10200, 10100, models.InboundFee{}, 0,
+			lnwire.ShortChannelID{})
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
 		}
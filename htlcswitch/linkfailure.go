@@ -8,6 +8,10 @@ var (
 
 	// ErrLinkFailedShutdown signals that a requested shutdown failed.
 	ErrLinkFailedShutdown = errors.New("link failed to shutdown")
+
+	// errLinkAddsDisabled signals that no htlcs can be added to the
+	// channel of the link anymore.
+	errLinkAddsDisabled = errors.New("htlc adds are disabled")
 )
 
 // errorCode encodes the possible types of errors that will make us fail the
@@ -2,6 +2,7 @@ package htlcswitch
 
 import (
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
@@ -43,6 +44,10 @@ type htlcPacket struct {
 	// amount is the value of the HTLC that is being created or modified.
 	amount lnwire.MilliSatoshi
 
+	// inboundFee is the inbound fee of the incoming link, which is charged
+	// on top of the fee of the outgoing link.
+	inboundFee models.InboundFee
+
 	// htlc lnwire message type of which depends on switch request type.
 	htlc lnwire.Message
 
@@ -128,3 +133,24 @@ func (p *htlcPacket) keystone() Keystone {
 		OutKey: p.outKey(),
 	}
 }
+
+// isBlindedRelay returns true if the incoming htlc of the packet was relayed by
+// us within a blinded route, in which case failures are sent back to the
+// incoming peer as malformed invalid_onion_blinding failures.
+func (p *htlcPacket) isBlindedRelay() bool {
+	encrypter := p.obfuscator
+	if encrypter == nil && p.circuit != nil {
+		encrypter = p.circuit.ErrorEncrypter
+	}
+
+	return encrypter != nil &&
+		encrypter.Type() == hop.EncrypterTypeRelaying
+}
+
+// isBlindedForward returns true if the packet carries an htlc add that is
+// forwarded within a blinded route.
+func (p *htlcPacket) isBlindedForward() bool {
+	add, ok := p.htlc.(*lnwire.UpdateAddHTLC)
+
+	return ok && add.BlindingPoint != nil
+}
@@ -0,0 +1,186 @@
+package htlcswitch
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrQuiescenceNotSupported is returned when a channel is quiesced
+	// while the quiescence feature wasn't negotiated with the peer.
+	ErrQuiescenceNotSupported = errors.New("quiescence not supported by " +
+		"channel peer")
+
+	// ErrChannelNotQuiescent is returned when a channel is resumed that
+	// isn't quiescent.
+	ErrChannelNotQuiescent = errors.New("channel is not quiescent")
+
+	// errChannelQuiescing is returned when an htlc is added to a channel
+	// that is being quiesced.
+	errChannelQuiescing = errors.New("channel is being quiesced")
+
+	// errStfuAlreadyReceived is returned when the remote peer sends stfu
+	// more than once.
+	errStfuAlreadyReceived = errors.New("stfu already received")
+
+	// errUnexpectedStfuReply is returned when the remote peer replies to
+	// a stfu that we didn't send.
+	errUnexpectedStfuReply = errors.New("received stfu reply, but no " +
+		"stfu was sent")
+
+	// errRemotePendingUpdates is returned when the remote peer sends stfu
+	// while some of its updates are pending.
+	errRemotePendingUpdates = errors.New("received stfu while updates " +
+		"of the remote peer are pending")
+)
+
+// quiescerCfg holds the parameters of the quiescence protocol for a channel.
+type quiescerCfg struct {
+	// chanID is the channel that is quiesced.
+	chanID lnwire.ChannelID
+
+	// channelInitiator is true if we opened the channel. The opener of the
+	// channel becomes the quiescence initiator if both sides request the
+	// quiescence at once.
+	channelInitiator bool
+
+	// sendMsg sends a stfu message to the remote peer.
+	sendMsg func(*lnwire.Stfu) error
+}
+
+// quiescer implements the state machine of the quiescence protocol, through
+// which both sides of a channel agree to stop sending updates. Once we've
+// been requested to quiesce the channel, or once we've received a stfu from
+// the remote peer, no new updates are sent. Our stfu is sent once all our
+// updates have been irrevocably committed, and the channel is quiescent when
+// both sides have sent stfu.
+//
+// NOTE: The quiescer isn't safe for concurrent use, it's only used by the
+// htlcManager goroutine of the link.
+type quiescer struct {
+	cfg quiescerCfg
+
+	// localInit is true if we've been requested to quiesce the channel.
+	localInit bool
+
+	// sent is true if we've sent stfu to the remote peer, with the value
+	// of the initiator field in sentInitiator.
+	sent          bool
+	sentInitiator bool
+
+	// received is true if we've received stfu from the remote peer, with
+	// the value of the initiator field in receivedInitiator.
+	received          bool
+	receivedInitiator bool
+}
+
+// newQuiescer creates a quiescer for a channel that isn't quiescent.
+func newQuiescer(cfg quiescerCfg) *quiescer {
+	return &quiescer{
+		cfg: cfg,
+	}
+}
+
+// initStfu requests that the channel becomes quiescent. Our stfu is sent by
+// sendOwedStfu once none of our updates are pending.
+func (q *quiescer) initStfu() {
+	q.localInit = true
+}
+
+// recvStfu processes a stfu message from the remote peer. It's a protocol
+// violation if any of the updates of the remote peer are still pending.
+func (q *quiescer) recvStfu(msg *lnwire.Stfu,
+	remotePendingUpdates bool) error {
+
+	switch {
+	case q.received:
+		return errStfuAlreadyReceived
+
+	case !msg.Initiator && !q.sent:
+		return errUnexpectedStfuReply
+
+	case remotePendingUpdates:
+		return errRemotePendingUpdates
+	}
+
+	q.received = true
+	q.receivedInitiator = msg.Initiator
+
+	return nil
+}
+
+// oweStfu returns true if we need to send stfu, either because we've been
+// requested to quiesce the channel or because the remote peer has sent stfu.
+func (q *quiescer) oweStfu() bool {
+	return (q.localInit || q.received) && !q.sent
+}
+
+// sendOwedStfu sends our stfu if we owe it and none of our updates are
+// pending. It returns true if the stfu was sent.
+func (q *quiescer) sendOwedStfu(localPendingUpdates bool) (bool, error) {
+	if !q.oweStfu() || localPendingUpdates {
+		return false, nil
+	}
+
+	// We're the initiator unless we're replying to the stfu of the remote
+	// peer.
+	initiator := !q.received
+	err := q.cfg.sendMsg(&lnwire.Stfu{
+		ChanID:    q.cfg.chanID,
+		Initiator: initiator,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	q.sent = true
+	q.sentInitiator = initiator
+
+	return true, nil
+}
+
+// canSendUpdates returns true if we're allowed to send new updates, which
+// isn't the case once the quiescence of the channel has been initiated by
+// either side.
+func (q *quiescer) canSendUpdates() bool {
+	return !q.localInit && !q.sent && !q.received
+}
+
+// canRecvUpdates returns true if the remote peer is allowed to send new
+// updates, which isn't the case once it has sent stfu.
+func (q *quiescer) canRecvUpdates() bool {
+	return !q.received
+}
+
+// isQuiescent returns true if both sides have sent stfu.
+func (q *quiescer) isQuiescent() bool {
+	return q.sent && q.received
+}
+
+// isInitiator returns true if we're the initiator of the quiescence. If both
+// sides requested the quiescence at once, the opener of the channel is the
+// initiator.
+func (q *quiescer) isInitiator() bool {
+	if q.sentInitiator && q.receivedInitiator {
+		return q.cfg.channelInitiator
+	}
+
+	return q.sentInitiator
+}
+
+// resume ends the quiescence of the channel, after which updates may be sent
+// by both sides again.
+func (q *quiescer) resume() error {
+	if !q.isQuiescent() {
+		return ErrChannelNotQuiescent
+	}
+
+	q.localInit = false
+	q.sent = false
+	q.sentInitiator = false
+	q.received = false
+	q.receivedInitiator = false
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestQuiescer creates a quiescer that records the stfu messages it sends.
+func newTestQuiescer(channelInitiator bool,
+	sent *[]*lnwire.Stfu) *quiescer {
+
+	return newQuiescer(quiescerCfg{
+		chanID:           lnwire.ChannelID{1},
+		channelInitiator: channelInitiator,
+		sendMsg: func(msg *lnwire.Stfu) error {
+			*sent = append(*sent, msg)
+			return nil
+		},
+	})
+}
+
+// TestQuiescerLocalInit tests that the quiescer sends stfu once our updates
+// are committed, and that the channel is quiescent once the remote peer
+// replies.
+func TestQuiescerLocalInit(t *testing.T) {
+	t.Parallel()
+
+	var sent []*lnwire.Stfu
+	q := newTestQuiescer(false, &sent)
+
+	require.True(t, q.canSendUpdates())
+	require.False(t, q.oweStfu())
+
+	// No updates are sent once the quiescence is requested, but our stfu
+	// is held back while our updates are pending.
+	q.initStfu()
+	require.False(t, q.canSendUpdates())
+	require.True(t, q.oweStfu())
+
+	ok, err := q.sendOwedStfu(true)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, sent)
+
+	ok, err = q.sendOwedStfu(false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, sent, 1)
+	require.True(t, sent[0].Initiator)
+	require.False(t, q.isQuiescent())
+
+	// The remote peer may send updates until it replies.
+	require.True(t, q.canRecvUpdates())
+	err = q.recvStfu(&lnwire.Stfu{ChanID: lnwire.ChannelID{1}}, false)
+	require.NoError(t, err)
+	require.False(t, q.canRecvUpdates())
+
+	require.True(t, q.isQuiescent())
+	require.True(t, q.isInitiator())
+
+	// Once resumed, updates can be sent again.
+	require.NoError(t, q.resume())
+	require.True(t, q.canSendUpdates())
+	require.True(t, q.canRecvUpdates())
+	require.ErrorIs(t, q.resume(), ErrChannelNotQuiescent)
+}
+
+// TestQuiescerRemoteInit tests that the quiescer replies to the stfu of the
+// remote peer.
+func TestQuiescerRemoteInit(t *testing.T) {
+	t.Parallel()
+
+	var sent []*lnwire.Stfu
+	q := newTestQuiescer(true, &sent)
+
+	// The remote peer can't send stfu while its updates are pending.
+	stfu := &lnwire.Stfu{ChanID: lnwire.ChannelID{1}, Initiator: true}
+	require.ErrorIs(
+		t, q.recvStfu(stfu, true), errRemotePendingUpdates,
+	)
+
+	require.NoError(t, q.recvStfu(stfu, false))
+	require.False(t, q.canSendUpdates())
+	require.ErrorIs(t, q.recvStfu(stfu, false), errStfuAlreadyReceived)
+
+	ok, err := q.sendOwedStfu(false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, sent, 1)
+	require.False(t, sent[0].Initiator)
+
+	require.True(t, q.isQuiescent())
+	require.False(t, q.isInitiator())
+}
+
+// TestQuiescerUnexpectedReply tests that a stfu reply is refused if we haven't
+// sent stfu.
+func TestQuiescerUnexpectedReply(t *testing.T) {
+	t.Parallel()
+
+	var sent []*lnwire.Stfu
+	q := newTestQuiescer(false, &sent)
+
+	err := q.recvStfu(&lnwire.Stfu{ChanID: lnwire.ChannelID{1}}, false)
+	require.ErrorIs(t, err, errUnexpectedStfuReply)
+}
+
+// TestQuiescerTieBreak tests that the opener of the channel becomes the
+// initiator if both sides request the quiescence at once.
+func TestQuiescerTieBreak(t *testing.T) {
+	t.Parallel()
+
+	for _, channelInitiator := range []bool{true, false} {
+		var sent []*lnwire.Stfu
+		q := newTestQuiescer(channelInitiator, &sent)
+
+		q.initStfu()
+		ok, err := q.sendOwedStfu(false)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		err = q.recvStfu(&lnwire.Stfu{
+			ChanID:    lnwire.ChannelID{1},
+			Initiator: true,
+		}, false)
+		require.NoError(t, err)
+
+		require.True(t, q.isQuiescent())
+		require.Equal(t, channelInitiator, q.isInitiator())
+	}
+}
@@ -116,6 +116,10 @@ type ChanClose struct {
 	// DeliveryScript is an optional delivery script to pay funds out to.
 	DeliveryScript lnwire.DeliveryAddress
 
+	// BumpFee is true if the request replaces the closing transaction
+	// that has already been broadcast with one that pays TargetFeePerKw.
+	BumpFee bool
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan interface{}
@@ -1172,11 +1176,8 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				// current forwarding conditions of this target
 				// link.
 				currentHeight := atomic.LoadUint32(&s.bestHeight)
-				failure = link.CheckHtlcForward(
-					htlc.PaymentHash, packet.incomingAmount,
-					packet.amount, packet.incomingTimeout,
-					packet.outgoingTimeout, currentHeight,
-					packet.originalOutgoingChanID,
+				failure = s.checkHtlcForward(
+					link, packet, currentHeight,
 				)
 			}
 
@@ -1449,6 +1450,30 @@ func (s *Switch) checkCircularForward(incoming, outgoing lnwire.ShortChannelID,
 	)
 }
 
+// checkHtlcForward checks whether the given link can forward the htlc add
+// packet. The fee and time lock of htlcs that are forwarded within a blinded
+// route are derived from the relay parameters that the recipient encrypted
+// for us rather than the policy of the outgoing channel, so we only check
+// that the outgoing htlc itself can be sent over the link.
+func (s *Switch) checkHtlcForward(link ChannelLink, packet *htlcPacket,
+	heightNow uint32) *LinkError {
+
+	htlc := packet.htlc.(*lnwire.UpdateAddHTLC)
+
+	if packet.isBlindedForward() {
+		return link.CheckHtlcTransit(
+			htlc.PaymentHash, packet.amount,
+			packet.outgoingTimeout, heightNow,
+		)
+	}
+
+	return link.CheckHtlcForward(
+		htlc.PaymentHash, packet.incomingAmount, packet.amount,
+		packet.incomingTimeout, packet.outgoingTimeout,
+		packet.inboundFee, heightNow, packet.originalOutgoingChanID,
+	)
+}
+
 // failAddPacket encrypts a fail packet back to an add packet's source.
 // The ciphertext will be derived from the failure message proivded by context.
 // This method returns the failErr if all other steps complete successfully.
@@ -1480,6 +1505,7 @@ func (s *Switch) failAddPacket(packet *htlcPacket, failure *LinkError) error {
 		incomingTimeout: packet.incomingTimeout,
 		outgoingTimeout: packet.outgoingTimeout,
 		circuit:         packet.circuit,
+		obfuscator:      packet.obfuscator,
 		linkFailure:     failure,
 		htlc: &lnwire.UpdateFailHTLC{
 			Reason: reason,
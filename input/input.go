@@ -69,6 +69,11 @@ type TxInfo struct {
 
 	// Weight is the weight of the tx.
 	Weight int64
+
+	// Tx is the tx itself, if it's known. It allows the tx to be submitted
+	// along with its child as a package, so that it's accepted into the
+	// mempool even if its fee rate is below the minimum of the mempool.
+	Tx *wire.MsgTx
 }
 
 // String returns a human readable version of the tx info.
@@ -120,6 +120,23 @@ const (
 	// ResultAmpReconstruction is returned when the derived child
 	// hash/preimage pairs were invalid for at least one HTLC in the set.
 	ResultAmpReconstruction
+
+	// ResultTrampolineFeeInsufficient is returned when the htlcs of a
+	// trampoline payment don't pay the fee of the trampoline node.
+	ResultTrampolineFeeInsufficient
+
+	// ResultTrampolineExpiryTooSoon is returned when the htlcs of a
+	// trampoline payment don't leave enough time to pay the recipient.
+	ResultTrampolineExpiryTooSoon
+
+	// ResultTrampolineFailed is returned when the trampoline node failed
+	// to pay the recipient of a trampoline payment.
+	ResultTrampolineFailed
+
+	// ResultTrampolineOnionInvalid is returned when the trampoline onion
+	// of an htlc can't be peeled, or doesn't hold the instructions to pay
+	// the recipient.
+	ResultTrampolineOnionInvalid
 )
 
 // String returns a string representation of the result.
@@ -189,6 +206,18 @@ func (f FailResolutionResult) FailureString() string {
 	case ResultAmpReconstruction:
 		return "amp reconstruction failed"
 
+	case ResultTrampolineFeeInsufficient:
+		return "trampoline fee insufficient"
+
+	case ResultTrampolineExpiryTooSoon:
+		return "trampoline expiry too soon"
+
+	case ResultTrampolineFailed:
+		return "trampoline payment failed"
+
+	case ResultTrampolineOnionInvalid:
+		return "invalid trampoline onion"
+
 	default:
 		return "unknown failure resolution result"
 	}
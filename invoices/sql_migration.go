@@ -0,0 +1,178 @@
+package invoices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// addIndexSequence is the name of the invoice sequence that assigns
+	// the add index of new invoices.
+	addIndexSequence = "add_index"
+
+	// settleIndexSequence is the name of the invoice sequence that assigns
+	// the settle index of settled invoices and AMP sub invoices.
+	settleIndexSequence = "settle_index"
+)
+
+// MigrateInvoicesToSQL copies all invoices of the passed key-value invoice
+// store into the native SQL store, along with their htlcs and AMP sub
+// invoices. The add and settle indexes of the invoices are preserved, so
+// clients that track them don't notice the switch. The migration is executed
+// within a single SQL transaction, so either all invoices are migrated or
+// none is. Any invoices the SQL store already holds are replaced, so the
+// migration can be repeated until it is marked as done in the key-value store,
+// for example after a crash right after the SQL transaction was committed.
+func MigrateInvoicesToSQL(ctx context.Context, kvStore InvoiceDB,
+	sqlStore *SQLStore) error {
+
+	log.Infof("Migrating invoices to the native SQL store")
+
+	writeTxOpts := NewSQLInvoiceQueryWriteTx()
+
+	var numInvoices int
+	err := sqlStore.db.ExecTx(ctx, &writeTxOpts, func(
+		db SQLInvoiceQueries) error {
+
+		numInvoices = 0
+
+		// The SQL store isn't used before the migration is marked as
+		// done, so whatever it holds is left over from an earlier
+		// attempt and is migrated again. Deleting the invoices also
+		// deletes their htlcs and AMP sub invoices.
+		err := db.DeleteAllInvoices(ctx)
+		if err != nil {
+			return err
+		}
+
+		var maxAddIndex, maxSettleIndex uint64
+		err = kvStore.ScanInvoices(ctx, func(hash lntypes.Hash,
+			invoice *Invoice) error {
+
+			err := migrateInvoice(ctx, db, hash, invoice)
+			if err != nil {
+				return fmt.Errorf("unable to migrate "+
+					"invoice %v: %w", hash, err)
+			}
+			numInvoices++
+
+			if invoice.AddIndex > maxAddIndex {
+				maxAddIndex = invoice.AddIndex
+			}
+			if invoice.SettleIndex > maxSettleIndex {
+				maxSettleIndex = invoice.SettleIndex
+			}
+			for _, ampState := range invoice.AMPState {
+				if ampState.SettleIndex > maxSettleIndex {
+					maxSettleIndex = ampState.SettleIndex
+				}
+			}
+
+			return nil
+		}, func() {})
+		if err != nil && !errors.Is(err, ErrNoInvoicesCreated) {
+			return err
+		}
+
+		// New invoices continue after the indexes of the migrated
+		// ones.
+		err = db.SetInvoiceSequence(ctx, sqlc.SetInvoiceSequenceParams{
+			Name:         addIndexSequence,
+			CurrentValue: int64(maxAddIndex),
+		})
+		if err != nil {
+			return err
+		}
+
+		return db.SetInvoiceSequence(ctx, sqlc.SetInvoiceSequenceParams{
+			Name:         settleIndexSequence,
+			CurrentValue: int64(maxSettleIndex),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Migrated %d invoices to the native SQL store", numInvoices)
+
+	return nil
+}
+
+// migrateInvoice inserts a single invoice of the key-value store, keeping its
+// add index, settle index and the state of its htlcs and AMP sub invoices.
+func migrateInvoice(ctx context.Context, db SQLInvoiceQueries,
+	hash lntypes.Hash, invoice *Invoice) error {
+
+	invoiceID := int64(invoice.AddIndex)
+	err := insertInvoice(ctx, db, invoiceID, invoice, hash)
+	if err != nil {
+		return err
+	}
+
+	if invoice.SettleIndex != 0 {
+		params := sqlc.UpdateInvoiceStateParams{
+			ID:          invoiceID,
+			State:       int16(invoice.State),
+			SettleIndex: sqldb.SQLInt64(invoice.SettleIndex),
+			SettledAt:   sqldb.SQLTime(invoice.SettleDate.UTC()),
+		}
+		if invoice.Terms.PaymentPreimage != nil {
+			params.Preimage = invoice.Terms.PaymentPreimage[:]
+		}
+
+		if _, err := db.UpdateInvoiceState(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	// The htlcs are added the same way as when they are accepted, which
+	// also creates the AMP sub invoices they belong to.
+	updater := &sqlInvoiceUpdater{
+		db:         db,
+		ctx:        ctx,
+		invoice:    invoice,
+		invoiceID:  invoiceID,
+		updateTime: invoice.CreationDate,
+	}
+	for key, htlc := range invoice.Htlcs {
+		if err := updater.AddHtlc(key, htlc); err != nil {
+			return err
+		}
+
+		if htlc.State == HtlcStateAccepted {
+			continue
+		}
+
+		err := updater.ResolveHtlc(key, htlc.State, htlc.ResolveTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	for setID, ampState := range invoice.AMPState {
+		params := sqlc.UpdateAMPSubInvoiceStateParams{
+			SetID: setID[:],
+			State: int16(ampState.State),
+		}
+		if ampState.SettleIndex != 0 {
+			params.SettleIndex = sqldb.SQLInt64(
+				ampState.SettleIndex,
+			)
+			params.SettledAt = sqldb.SQLTime(
+				ampState.SettleDate.UTC(),
+			)
+		}
+
+		err := db.UpdateAMPSubInvoiceState(ctx, params)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
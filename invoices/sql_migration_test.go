@@ -0,0 +1,229 @@
+package invoices_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/stretchr/testify/require"
+)
+
+// ampSettleUpdate returns an update callback that accepts a single AMP htlc
+// for the given set, or settles the set if settle is true.
+func ampSettleUpdate(setID invpkg.SetID, amt lnwire.MilliSatoshi,
+	preimage lntypes.Preimage, settle bool) invpkg.InvoiceUpdateCallback {
+
+	key := models.CircuitKey{HtlcID: 2}
+
+	return func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+		if settle {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.AddHTLCsUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractSettled,
+					SetID:    (*[32]byte)(&setID),
+					HTLCPreimages: map[models.CircuitKey]lntypes.Preimage{ //nolint:lll
+						key: preimage,
+					},
+				},
+			}, nil
+		}
+
+		return &invpkg.InvoiceUpdateDesc{
+			UpdateType: invpkg.AddHTLCsUpdate,
+			State: &invpkg.InvoiceStateUpdateDesc{
+				NewState: invpkg.ContractAccepted,
+				SetID:    (*[32]byte)(&setID),
+			},
+			AddHtlcs: map[models.CircuitKey]*invpkg.HtlcAcceptDesc{
+				key: {
+					Amt: amt,
+					CustomRecords: record.CustomSet{
+						65537: []byte{1},
+					},
+					AMP: &invpkg.InvoiceHtlcAMPData{
+						Record: *record.NewAMP(
+							[32]byte{}, setID, 0,
+						),
+						Hash:     preimage.Hash(),
+						Preimage: &preimage,
+					},
+				},
+			},
+		}, nil
+	}
+}
+
+// TestMigrateInvoicesToSQL tests that the invoices of the key-value store are
+// migrated to the native SQL store with their indexes, htlcs and AMP sub
+// invoices, and that new invoices continue after the migrated indexes.
+func TestMigrateInvoicesToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+
+	kvStore, err := channeldb.MakeTestInvoiceDB(t)
+	require.NoError(t, err)
+
+	addInvoice := func(invoice *invpkg.Invoice) lntypes.Hash {
+		t.Helper()
+
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		_, err := kvStore.AddInvoice(ctxb, invoice, hash)
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	// A settled invoice.
+	settledHash := addInvoice(randSQLInvoice(t, 1_000))
+	_, err = kvStore.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(settledHash), nil,
+		settleUpdate(1_000),
+	)
+	require.NoError(t, err)
+
+	// An invoice that is deleted again leaves a gap in the add index.
+	deleted := randSQLInvoice(t, 1_000)
+	deletedHash := addInvoice(deleted)
+	err = kvStore.DeleteInvoice(ctxb, []invpkg.InvoiceDeleteRef{{
+		PayHash:  deletedHash,
+		PayAddr:  &deleted.Terms.PaymentAddr,
+		AddIndex: deleted.AddIndex,
+	}})
+	require.NoError(t, err)
+
+	// A canceled invoice.
+	canceledHash := addInvoice(randSQLInvoice(t, 1_000))
+	_, err = kvStore.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(canceledHash), nil,
+		func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.CancelInvoiceUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractCanceled,
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// An AMP invoice with a settled set.
+	amp := randSQLInvoice(t, 1_000)
+	amp.PaymentRequest = []byte{}
+	amp.Terms.Features = ampTestFeatures
+	ampPreimage := *amp.Terms.PaymentPreimage
+	ampHash := addInvoice(amp)
+
+	setID := invpkg.SetID{1}
+	for _, settle := range []bool{false, true} {
+		_, err = kvStore.UpdateInvoice(
+			ctxb, invpkg.InvoiceRefByHash(ampHash), &setID,
+			ampSettleUpdate(setID, 1_000, ampPreimage, settle),
+		)
+		require.NoError(t, err)
+	}
+
+	sqlStore := newTestSQLStore(t)
+	err = invpkg.MigrateInvoicesToSQL(ctxb, kvStore, sqlStore)
+	require.NoError(t, err)
+
+	hashes := []lntypes.Hash{settledHash, canceledHash, ampHash}
+	for _, hash := range hashes {
+		ref := invpkg.InvoiceRefByHash(hash)
+
+		kvInvoice, err := kvStore.LookupInvoice(ctxb, ref)
+		require.NoError(t, err)
+		sqlInvoice, err := sqlStore.LookupInvoice(ctxb, ref)
+		require.NoError(t, err)
+
+		require.Equal(t, kvInvoice.AddIndex, sqlInvoice.AddIndex)
+		require.Equal(
+			t, kvInvoice.SettleIndex, sqlInvoice.SettleIndex,
+		)
+		require.Equal(t, kvInvoice.State, sqlInvoice.State)
+		require.Equal(t, kvInvoice.AmtPaid, sqlInvoice.AmtPaid)
+		require.Equal(t, kvInvoice.Terms.PaymentPreimage,
+			sqlInvoice.Terms.PaymentPreimage)
+		require.Equal(t, kvInvoice.PaymentRequest,
+			sqlInvoice.PaymentRequest)
+
+		require.Len(t, sqlInvoice.Htlcs, len(kvInvoice.Htlcs))
+		for key, htlc := range kvInvoice.Htlcs {
+			sqlHtlc := sqlInvoice.Htlcs[key]
+			require.NotNil(t, sqlHtlc)
+			require.Equal(t, htlc.State, sqlHtlc.State)
+			require.Equal(t, htlc.Amt, sqlHtlc.Amt)
+			require.Equal(t, htlc.CustomRecords,
+				sqlHtlc.CustomRecords)
+			require.Equal(t, htlc.AMP, sqlHtlc.AMP)
+		}
+
+		require.Len(t, sqlInvoice.AMPState, len(kvInvoice.AMPState))
+		for setID, state := range kvInvoice.AMPState {
+			sqlState := sqlInvoice.AMPState[setID]
+			require.Equal(t, state.State, sqlState.State)
+			require.Equal(t, state.SettleIndex,
+				sqlState.SettleIndex)
+			require.Equal(t, state.AmtPaid, sqlState.AmtPaid)
+		}
+	}
+
+	_, err = sqlStore.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByHash(deletedHash),
+	)
+	require.ErrorIs(t, err, invpkg.ErrInvoiceNotFound)
+
+	// The AMP set that was settled after the first invoice continues the
+	// settle time series.
+	settled, err := sqlStore.InvoicesSettledSince(ctxb, 1)
+	require.NoError(t, err)
+	require.Len(t, settled, 1)
+	require.Contains(t, settled[0].AMPState, setID)
+
+	// Migrating again, as it happens if the node stops before the
+	// migration is marked as done, results in the same store.
+	err = invpkg.MigrateInvoicesToSQL(ctxb, kvStore, sqlStore)
+	require.NoError(t, err)
+
+	settled, err = sqlStore.InvoicesSettledSince(ctxb, 1)
+	require.NoError(t, err)
+	require.Len(t, settled, 1)
+	require.Contains(t, settled[0].AMPState, setID)
+
+	// New invoices continue after the migrated add and settle indexes.
+	invoice := randSQLInvoice(t, 1_000)
+	hash := invoice.Terms.PaymentPreimage.Hash()
+	addIndex, err := sqlStore.AddInvoice(ctxb, invoice, hash)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, addIndex)
+
+	settledInvoice, err := sqlStore.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(hash), nil,
+		func(invoice *invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+			error) {
+
+			desc, err := settleUpdate(1_000)(invoice)
+			if err != nil {
+				return nil, err
+			}
+
+			// The htlc of the migrated settled invoice uses the
+			// default circuit key already.
+			desc.AddHtlcs = map[models.CircuitKey]*invpkg.HtlcAcceptDesc{ //nolint:lll
+				{HtlcID: 3}: desc.AddHtlcs[models.CircuitKey{
+					HtlcID: 1,
+				}],
+			}
+
+			return desc, nil
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, settledInvoice.SettleIndex)
+}
@@ -0,0 +1,1626 @@
+package invoices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+const (
+	// queryPaginationLimit is used in the LIMIT clause of the SQL queries
+	// to limit the number of rows returned when paginating through all
+	// invoices.
+	queryPaginationLimit = 100
+)
+
+// InvoiceEventType is the type of an invoice event that is stored in the
+// invoice_events table of the native SQL invoice store.
+type InvoiceEventType int32
+
+const (
+	// InvoiceCreated is the event emitted when an invoice is created.
+	InvoiceCreated InvoiceEventType = 0
+
+	// InvoiceCanceled is the event emitted when an invoice is canceled.
+	InvoiceCanceled InvoiceEventType = 1
+
+	// InvoiceSettled is the event emitted when an invoice is settled.
+	InvoiceSettled InvoiceEventType = 2
+
+	// SetIDCreated is the event emitted when the first htlc for the set_id
+	// is received.
+	SetIDCreated InvoiceEventType = 3
+
+	// SetIDCanceled is the event emitted when the set_id is canceled.
+	SetIDCanceled InvoiceEventType = 4
+
+	// SetIDSettled is the event emitted when the set_id is settled.
+	SetIDSettled InvoiceEventType = 5
+)
+
+// SQLInvoiceQueries is an interface that defines the set of operations that
+// can be executed against the invoice SQL database.
+type SQLInvoiceQueries interface { //nolint:interfacebloat
+	InsertInvoice(ctx context.Context, arg sqlc.InsertInvoiceParams) error
+
+	InsertInvoiceFeature(ctx context.Context,
+		arg sqlc.InsertInvoiceFeatureParams) error
+
+	InsertInvoiceHTLC(ctx context.Context,
+		arg sqlc.InsertInvoiceHTLCParams) (int64, error)
+
+	InsertInvoiceHTLCCustomRecord(ctx context.Context,
+		arg sqlc.InsertInvoiceHTLCCustomRecordParams) error
+
+	FilterInvoices(ctx context.Context,
+		arg sqlc.FilterInvoicesParams) ([]sqlc.Invoice, error)
+
+	GetInvoice(ctx context.Context,
+		arg sqlc.GetInvoiceParams) ([]sqlc.Invoice, error)
+
+	GetInvoiceBySetID(ctx context.Context, setID []byte) ([]sqlc.Invoice,
+		error)
+
+	GetInvoiceFeatures(ctx context.Context,
+		invoiceID int64) ([]sqlc.InvoiceFeature, error)
+
+	GetInvoiceHTLCCustomRecords(ctx context.Context,
+		invoiceID int64) ([]sqlc.GetInvoiceHTLCCustomRecordsRow, error)
+
+	GetInvoiceHTLCs(ctx context.Context,
+		invoiceID int64) ([]sqlc.InvoiceHtlc, error)
+
+	UpdateInvoiceState(ctx context.Context,
+		arg sqlc.UpdateInvoiceStateParams) (sql.Result, error)
+
+	UpdateInvoiceAmountPaid(ctx context.Context,
+		arg sqlc.UpdateInvoiceAmountPaidParams) (sql.Result, error)
+
+	NextInvoiceAddIndex(ctx context.Context) (int64, error)
+
+	NextInvoiceSettleIndex(ctx context.Context) (int64, error)
+
+	SetInvoiceSequence(ctx context.Context,
+		arg sqlc.SetInvoiceSequenceParams) error
+
+	UpdateInvoiceHTLC(ctx context.Context,
+		arg sqlc.UpdateInvoiceHTLCParams) error
+
+	DeleteInvoice(ctx context.Context, arg sqlc.DeleteInvoiceParams) (
+		sql.Result, error)
+
+	DeleteAllInvoices(ctx context.Context) error
+
+	// AMP sub invoice specific methods.
+	UpsertAMPSubInvoice(ctx context.Context,
+		arg sqlc.UpsertAMPSubInvoiceParams) (sql.Result, error)
+
+	UpdateAMPSubInvoiceState(ctx context.Context,
+		arg sqlc.UpdateAMPSubInvoiceStateParams) error
+
+	InsertAMPSubInvoiceHTLC(ctx context.Context,
+		arg sqlc.InsertAMPSubInvoiceHTLCParams) error
+
+	SelectAMPSubInvoices(ctx context.Context,
+		invoiceID int64) ([]sqlc.AmpSubInvoice, error)
+
+	SelectAMPSubInvoiceHTLCs(ctx context.Context,
+		invoiceID int64) ([]sqlc.SelectAMPSubInvoiceHTLCsRow, error)
+
+	UpdateAMPSubInvoiceHTLCPreimage(ctx context.Context,
+		arg sqlc.UpdateAMPSubInvoiceHTLCPreimageParams) (sql.Result,
+		error)
+
+	FetchSettledAMPSubInvoices(ctx context.Context,
+		settleIndex sql.NullInt64) ([]sqlc.FetchSettledAMPSubInvoicesRow,
+		error)
+
+	// Invoice events.
+	InsertInvoiceEvent(ctx context.Context,
+		arg sqlc.InsertInvoiceEventParams) error
+}
+
+var _ InvoiceDB = (*SQLStore)(nil)
+
+// SQLInvoiceQueriesTxOptions defines the set of db txn options the
+// SQLInvoiceQueries understands.
+type SQLInvoiceQueriesTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions.
+func (a *SQLInvoiceQueriesTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewSQLInvoiceQueryReadTx creates a new read transaction option set.
+func NewSQLInvoiceQueryReadTx() SQLInvoiceQueriesTxOptions {
+	return SQLInvoiceQueriesTxOptions{
+		readOnly: true,
+	}
+}
+
+// NewSQLInvoiceQueryWriteTx creates a new write transaction option set.
+func NewSQLInvoiceQueryWriteTx() SQLInvoiceQueriesTxOptions {
+	return SQLInvoiceQueriesTxOptions{
+		readOnly: false,
+	}
+}
+
+// BatchedSQLInvoiceQueries is a version of the SQLInvoiceQueries that's
+// capable of batched database operations.
+type BatchedSQLInvoiceQueries interface {
+	SQLInvoiceQueries
+
+	sqldb.BatchedTx[SQLInvoiceQueries]
+}
+
+// SQLStore represents a storage backend that uses a native SQL database to
+// store the invoices.
+type SQLStore struct {
+	db    BatchedSQLInvoiceQueries
+	clock clock.Clock
+}
+
+// NewSQLStore creates a new SQLStore instance given a open
+// BatchedSQLInvoiceQueries storage backend.
+func NewSQLStore(db BatchedSQLInvoiceQueries,
+	clock clock.Clock) *SQLStore {
+
+	return &SQLStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// AddInvoice inserts the targeted invoice into the database. If the invoice
+// has *any* payment hashes which already exists within the database, then the
+// insertion will be aborted and rejected due to the strict policy banning any
+// duplicate payment hashes.
+//
+// NOTE: A side effect of this function is that it sets AddIndex on
+// newInvoice.
+func (i *SQLStore) AddInvoice(ctx context.Context,
+	newInvoice *Invoice, paymentHash lntypes.Hash) (uint64, error) {
+
+	// Make sure this is a valid invoice before trying to store it in our
+	// DB.
+	if err := ValidateInvoice(newInvoice, paymentHash); err != nil {
+		return 0, err
+	}
+
+	var (
+		writeTxOpts = NewSQLInvoiceQueryWriteTx()
+		invoiceID   int64
+	)
+
+	var paymentAddr []byte
+	if newInvoice.Terms.PaymentAddr != BlankPayAddr {
+		paymentAddr = newInvoice.Terms.PaymentAddr[:]
+	}
+
+	err := i.db.ExecTx(ctx, &writeTxOpts, func(db SQLInvoiceQueries) error {
+		// Ensure that an invoice an identical payment hash doesn't
+		// already exist within the database.
+		rows, err := db.GetInvoice(ctx, sqlc.GetInvoiceParams{
+			Hash: paymentHash[:],
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			return ErrDuplicateInvoice
+		}
+
+		// Check that we aren't inserting an invoice with a duplicate
+		// payment address. The all-zeros payment address is
+		// special-cased to support legacy keysend invoices which
+		// don't assign one.
+		if paymentAddr != nil {
+			rows, err := db.GetInvoice(ctx, sqlc.GetInvoiceParams{
+				PaymentAddr: paymentAddr,
+			})
+			if err != nil {
+				return err
+			}
+			if len(rows) > 0 {
+				return ErrDuplicatePayAddr
+			}
+		}
+
+		invoiceID, err = db.NextInvoiceAddIndex(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get next add index: %w",
+				err)
+		}
+
+		err = insertInvoice(ctx, db, invoiceID, newInvoice, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		return insertInvoiceEvent(
+			ctx, db, i.clock.Now(), invoiceID, InvoiceCreated,
+			nil,
+		)
+	})
+	if err != nil {
+		mappedSQLErr := sqldb.MapSQLError(err)
+		var uniqueConstraintErr *sqldb.ErrSQLUniqueConstraintViolation
+		if errors.As(mappedSQLErr, &uniqueConstraintErr) {
+			// Add context to unique constraint errors.
+			return 0, fmt.Errorf("invoice with hash %v or payment "+
+				"address %x already exists: %w", paymentHash,
+				paymentAddr, ErrDuplicateInvoice)
+		}
+
+		return 0, fmt.Errorf("unable to add invoice(%v): %w",
+			paymentHash, err)
+	}
+
+	newInvoice.AddIndex = uint64(invoiceID)
+
+	return newInvoice.AddIndex, nil
+}
+
+// insertInvoice inserts the invoice with the given id, which is its add index,
+// along with its features.
+func insertInvoice(ctx context.Context, db SQLInvoiceQueries, invoiceID int64,
+	invoice *Invoice, paymentHash lntypes.Hash) error {
+
+	var paymentRequest sql.NullString
+	if len(invoice.PaymentRequest) > 0 {
+		paymentRequest = sql.NullString{
+			String: string(invoice.PaymentRequest),
+			Valid:  true,
+		}
+	}
+
+	var paymentAddr []byte
+	if invoice.Terms.PaymentAddr != BlankPayAddr {
+		paymentAddr = invoice.Terms.PaymentAddr[:]
+	}
+
+	params := sqlc.InsertInvoiceParams{
+		ID:         invoiceID,
+		Hash:       paymentHash[:],
+		Memo:       sqldb.SQLStr(string(invoice.Memo)),
+		AmountMsat: int64(invoice.Terms.Value),
+		CltvDelta: sql.NullInt32{
+			Int32: invoice.Terms.FinalCltvDelta,
+			Valid: true,
+		},
+		Expiry:         int32(invoice.Terms.Expiry.Seconds()),
+		PaymentAddr:    paymentAddr,
+		PaymentRequest: paymentRequest,
+		State:          int16(invoice.State),
+		AmountPaidMsat: int64(invoice.AmtPaid),
+		IsAmp:          invoice.IsAMP(),
+		IsHodl:         invoice.HodlInvoice,
+		IsKeysend:      invoice.IsKeysend(),
+		CreatedAt:      invoice.CreationDate.UTC(),
+	}
+
+	if invoice.Terms.PaymentPreimage != nil {
+		params.Preimage = invoice.Terms.PaymentPreimage[:]
+	}
+
+	err := db.InsertInvoice(ctx, params)
+	if err != nil {
+		return fmt.Errorf("unable to insert invoice: %w", err)
+	}
+
+	// TODO(positiveblue): if invocies do not have custom features maybe
+	// just store the "invoice type" and populate the features based on
+	// that.
+	for feature := range invoice.Terms.Features.Features() {
+		params := sqlc.InsertInvoiceFeatureParams{
+			InvoiceID: invoiceID,
+			Feature:   int32(feature),
+		}
+
+		err := db.InsertInvoiceFeature(ctx, params)
+		if err != nil {
+			return fmt.Errorf("unable to insert invoice "+
+				"feature(%v): %w", feature, err)
+		}
+	}
+
+	return nil
+}
+
+// fetchInvoice fetches the common invoice data and the AMP state for the
+// invoice with the given reference. The setIDFilter parameter controls which
+// AMP htlcs are loaded: a nil filter loads the htlcs of all sets, while the
+// zero set ID doesn't load any AMP htlc at all.
+func (i *SQLStore) fetchInvoice(ctx context.Context,
+	db SQLInvoiceQueries, ref InvoiceRef, setIDFilter *SetID) (*Invoice,
+	error) {
+
+	sqlInvoice, err := fetchSQLInvoiceByRef(ctx, db, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	_, invoice, err := fetchInvoiceData(ctx, db, sqlInvoice, setIDFilter)
+
+	return invoice, err
+}
+
+// fetchSQLInvoiceByRef fetches the raw invoice row for the given invoice
+// reference. The payment address will be treated as the primary key, falling
+// back to the payment hash if nothing is found for the payment address. An
+// error is returned if the invoice is not found.
+func fetchSQLInvoiceByRef(ctx context.Context, db SQLInvoiceQueries,
+	ref InvoiceRef) (*sqlc.Invoice, error) {
+
+	// If the set id is present, we only consult the set id index for this
+	// invoice. This type of query is only used to facilitate user-facing
+	// requests to lookup, settle or cancel an AMP invoice.
+	if setID := ref.SetID(); setID != nil {
+		rows, err := db.GetInvoiceBySetID(ctx, setID[:])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			return nil, ErrInvoiceNotFound
+		}
+
+		return &rows[0], nil
+	}
+
+	fetchOne := func(params sqlc.GetInvoiceParams) (*sqlc.Invoice,
+		error) {
+
+		rows, err := db.GetInvoice(ctx, params)
+		switch {
+		case err != nil:
+			return nil, err
+
+		case len(rows) == 0:
+			return nil, nil
+
+		case len(rows) > 1:
+			// In case the reference is ambiguous, meaning it
+			// matches more than one invoice, we'll return an
+			// error.
+			return nil, ErrInvRefEquivocation
+		}
+
+		return &rows[0], nil
+	}
+
+	var invoiceByHash, invoiceByAddr *sqlc.Invoice
+
+	payHash := ref.PayHash()
+	if payHash != nil {
+		invoice, err := fetchOne(sqlc.GetInvoiceParams{
+			Hash: payHash[:],
+		})
+		if err != nil {
+			return nil, err
+		}
+		invoiceByHash = invoice
+	}
+
+	// Only allow lookups for payment address if it is not a blank payment
+	// address, which is a special-cased value for legacy keysend invoices.
+	payAddr := ref.PayAddr()
+	if payAddr != nil && *payAddr != BlankPayAddr {
+		invoice, err := fetchOne(sqlc.GetInvoiceParams{
+			PaymentAddr: payAddr[:],
+		})
+		if err != nil {
+			return nil, err
+		}
+		invoiceByAddr = invoice
+	}
+
+	switch {
+	// If payment address and payment hash both reference an existing
+	// invoice, ensure they reference the _same_ invoice.
+	case invoiceByAddr != nil && invoiceByHash != nil:
+		if invoiceByAddr.ID != invoiceByHash.ID {
+			return nil, ErrInvRefEquivocation
+		}
+
+		return invoiceByAddr, nil
+
+	// Return invoices by payment addr only.
+	//
+	// NOTE: We constrain this lookup to only apply if the invoice ref does
+	// not contain a payment hash. Legacy and MPP payments depend on the
+	// payment hash index to enforce that the HTLCs payment hash matches the
+	// payment hash for the invoice, without this check we would
+	// inadvertently assume the invoice contains the correct preimage for
+	// the HTLC, which we only enforce via the lookup by the invoice index.
+	case invoiceByAddr != nil && payHash == nil:
+		return invoiceByAddr, nil
+
+	// If we were only able to reference the invoice by hash, return the
+	// corresponding invoice. This can happen when no payment address was
+	// provided, or if it didn't match anything in our records.
+	case invoiceByHash != nil:
+		return invoiceByHash, nil
+
+	// Otherwise we don't know of the target invoice.
+	default:
+		return nil, ErrInvoiceNotFound
+	}
+}
+
+// LookupInvoice attempts to look up an invoice according to its 32 byte
+// payment hash. If an invoice which can settle the HTLC identified by the
+// passed payment hash isn't found, then an error is returned. Otherwise, the
+// full invoice is returned. Before setting the incoming HTLC, the values SHOULD
+// be checked to ensure the payer meets the agreed upon contractual terms of the
+// payment.
+func (i *SQLStore) LookupInvoice(ctx context.Context,
+	ref InvoiceRef) (Invoice, error) {
+
+	var (
+		invoice *Invoice
+		err     error
+	)
+
+	var setID *SetID
+	switch {
+	// If this is a payment address ref, and the blank modified was
+	// specified, then we'll use the zero set ID to indicate that we won't
+	// want any HTLCs returned.
+	case ref.PayAddr() != nil && ref.Modifier() == HtlcSetBlankModifier:
+		var zeroSetID SetID
+		setID = &zeroSetID
+
+	// If this is a set ID ref, and the htlc set only modified was
+	// specified, then we'll pass through the specified setID so only that
+	// will be returned.
+	case ref.SetID() != nil && ref.Modifier() == HtlcSetOnlyModifier:
+		setID = (*SetID)(ref.SetID())
+	}
+
+	readTxOpt := NewSQLInvoiceQueryReadTx()
+	txErr := i.db.ExecTx(ctx, &readTxOpt, func(db SQLInvoiceQueries) error {
+		invoice, err = i.fetchInvoice(ctx, db, ref, setID)
+
+		return err
+	})
+	if txErr != nil {
+		return Invoice{}, txErr
+	}
+
+	return *invoice, nil
+}
+
+// InvoicesAddedSince can be used by callers to seek into the event time series
+// of all the invoices added in the database. This method will return all
+// invoices with an add index greater than the specified idx.
+//
+// NOTE: The index starts from 1. As a result we enforce that specifying a
+// value below the starting index value is a noop.
+func (i *SQLStore) InvoicesAddedSince(ctx context.Context, idx uint64) (
+	[]Invoice, error) {
+
+	var result []Invoice
+
+	// If an index of zero was specified, then in order to maintain
+	// backwards compat, we won't send out any new invoices.
+	if idx == 0 {
+		return result, nil
+	}
+
+	readTxOpt := NewSQLInvoiceQueryReadTx()
+	err := i.db.ExecTx(ctx, &readTxOpt, func(db SQLInvoiceQueries) error {
+		result = nil
+
+		return forEachInvoicePage(
+			ctx, db, sqlc.FilterInvoicesParams{
+				AddIndexGet: sqldb.SQLInt64(idx + 1),
+			},
+			func(_ lntypes.Hash, invoice *Invoice) error {
+				result = append(result, *invoice)
+
+				return nil
+			},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get invoices added since "+
+			"index %d: %w", idx, err)
+	}
+
+	return result, nil
+}
+
+// ScanInvoices scans through all invoices and calls the passed scanFunc for
+// for each invoice with its respective payment hash. Additionally a reset()
+// closure is passed which is used to reset/initialize partial results and also
+// to signal if the transaction has been retried.
+func (i *SQLStore) ScanInvoices(ctx context.Context,
+	scanFunc InvScanFunc, reset func()) error {
+
+	readTxOpt := NewSQLInvoiceQueryReadTx()
+	err := i.db.ExecTx(ctx, &readTxOpt, func(db SQLInvoiceQueries) error {
+		// Make sure we reset the caller's accumulator before (re)trying
+		// the transaction.
+		reset()
+
+		return forEachInvoicePage(
+			ctx, db, sqlc.FilterInvoicesParams{},
+			func(hash lntypes.Hash, invoice *Invoice) error {
+				return scanFunc(hash, invoice)
+			},
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to scan invoices: %w", err)
+	}
+
+	return nil
+}
+
+// QueryInvoices allows a caller to query the invoice database for invoices
+// within the specified add index range.
+func (i *SQLStore) QueryInvoices(ctx context.Context,
+	q InvoiceQuery) (InvoiceSlice, error) {
+
+	var invoices []Invoice
+
+	if q.NumMaxInvoices == 0 {
+		return InvoiceSlice{}, fmt.Errorf("max invoices must " +
+			"be non-zero")
+	}
+
+	readTxOpt := NewSQLInvoiceQueryReadTx()
+	err := i.db.ExecTx(ctx, &readTxOpt, func(db SQLInvoiceQueries) error {
+		invoices = nil
+
+		limit := q.NumMaxInvoices
+		if limit > math.MaxInt32 {
+			limit = math.MaxInt32
+		}
+
+		params := sqlc.FilterInvoicesParams{
+			NumOffset:   0,
+			NumLimit:    int32(limit),
+			PendingOnly: q.PendingOnly,
+			Reverse:     q.Reversed,
+		}
+
+		if !q.CreationDateStart.IsZero() {
+			params.CreatedAfter = sqldb.SQLTime(
+				q.CreationDateStart.UTC(),
+			)
+		}
+
+		if !q.CreationDateEnd.IsZero() {
+			params.CreatedBefore = sqldb.SQLTime(
+				q.CreationDateEnd.UTC(),
+			)
+		}
+
+		switch {
+		// When paginating backwards, the offset is the upper bound
+		// (exclusive) of the add index range.
+		case q.Reversed && q.IndexOffset != 0:
+			// If the offset is the very first invoice, there's
+			// nothing before it.
+			if q.IndexOffset == 1 {
+				return nil
+			}
+
+			params.AddIndexLet = sqldb.SQLInt64(q.IndexOffset - 1)
+
+		// When paginating forward, the offset is the lower bound
+		// (exclusive) of the add index range.
+		case !q.Reversed:
+			params.AddIndexGet = sqldb.SQLInt64(q.IndexOffset + 1)
+		}
+
+		rows, err := db.FilterInvoices(ctx, params)
+		if err != nil {
+			return fmt.Errorf("unable to get invoices from db: %w",
+				err)
+		}
+
+		for _, row := range rows {
+			row := row
+			_, invoice, err := fetchInvoiceData(ctx, db, &row, nil)
+			if err != nil {
+				return err
+			}
+
+			invoices = append(invoices, *invoice)
+		}
+
+		// If we iterated through the add index in reverse order, then
+		// we'll need to reverse the slice of invoices to return them in
+		// forward order.
+		if q.Reversed {
+			numInvoices := len(invoices)
+			for i := 0; i < numInvoices/2; i++ {
+				reverse := numInvoices - i - 1
+				invoices[i], invoices[reverse] =
+					invoices[reverse], invoices[i]
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return InvoiceSlice{}, fmt.Errorf("unable to query "+
+			"invoices: %w", err)
+	}
+
+	res := InvoiceSlice{
+		InvoiceQuery: q,
+		Invoices:     invoices,
+	}
+
+	// Finally, record the indexes of the first and last invoices returned
+	// so that the caller can resume from this point later on.
+	if len(invoices) > 0 {
+		res.FirstIndexOffset = invoices[0].AddIndex
+		res.LastIndexOffset = invoices[len(invoices)-1].AddIndex
+	}
+
+	return res, nil
+}
+
+// UpdateInvoice attempts to update an invoice corresponding to the passed
+// reference. If an invoice matching the passed reference doesn't exist within
+// the database, then the action will fail with  ErrInvoiceNotFound error.
+//
+// The update is performed inside the same database transaction that fetches
+// the invoice and is therefore atomic. The fields to update are controlled by
+// the supplied callback.
+func (i *SQLStore) UpdateInvoice(ctx context.Context, ref InvoiceRef,
+	setIDHint *SetID, callback InvoiceUpdateCallback) (*Invoice, error) {
+
+	var updatedInvoice *Invoice
+
+	txOpt := NewSQLInvoiceQueryWriteTx()
+	txErr := i.db.ExecTx(ctx, &txOpt, func(db SQLInvoiceQueries) error {
+		// If the set ID hint is non-nil, then we'll use that to filter
+		// out the HTLCs for AMP invoice so we don't need to read them
+		// all out to satisfy the invoice callback below. If it's nil,
+		// then we pass in the zero set ID which means no HTLCs will be
+		// read out.
+		var invSetID SetID
+		if setIDHint != nil {
+			invSetID = *setIDHint
+		}
+
+		sqlInvoice, err := fetchSQLInvoiceByRef(ctx, db, ref)
+		if err != nil {
+			return err
+		}
+
+		_, invoice, err := fetchInvoiceData(
+			ctx, db, sqlInvoice, &invSetID,
+		)
+		if err != nil {
+			return err
+		}
+
+		updateTime := i.clock.Now()
+		updater := &sqlInvoiceUpdater{
+			db:         db,
+			ctx:        ctx,
+			invoice:    invoice,
+			invoiceID:  sqlInvoice.ID,
+			updateTime: updateTime,
+		}
+
+		payHash := ref.PayHash()
+		updatedInvoice, err = UpdateInvoice(
+			payHash, invoice, updateTime, callback, updater,
+		)
+
+		return err
+	})
+	if txErr != nil {
+		// If the invoice was found but the callback returned an error
+		// we'll return the invoice along with the error, just like the
+		// kv implementation does.
+		return updatedInvoice, txErr
+	}
+
+	return updatedInvoice, nil
+}
+
+// InvoicesSettledSince can be used by callers to catch up any settled invoices
+// they missed within the settled invoice time series. We'll return all known
+// settled invoice that have a settle index higher than the passed idx.
+//
+// NOTE: The index starts from 1. As a result we enforce that specifying a
+// value below the starting index value is a noop.
+func (i *SQLStore) InvoicesSettledSince(ctx context.Context, idx uint64) (
+	[]Invoice, error) {
+
+	var invoices []Invoice
+
+	// If an index of zero was specified, then in order to maintain
+	// backwards compat, we won't send out any new invoices.
+	if idx == 0 {
+		return invoices, nil
+	}
+
+	readTxOpt := NewSQLInvoiceQueryReadTx()
+	err := i.db.ExecTx(ctx, &readTxOpt, func(db SQLInvoiceQueries) error {
+		// Since the settle index is shared between regular invoices and
+		// AMP sub invoices, we'll collect both and then sort them by
+		// their settle index.
+		type settledInvoice struct {
+			settleIndex uint64
+			invoice     Invoice
+		}
+		var settled []settledInvoice
+
+		err := forEachInvoicePage(
+			ctx, db, sqlc.FilterInvoicesParams{
+				SettleIndexGet: sqldb.SQLInt64(idx + 1),
+			},
+			func(_ lntypes.Hash, invoice *Invoice) error {
+				settled = append(settled, settledInvoice{
+					settleIndex: invoice.SettleIndex,
+					invoice:     *invoice,
+				})
+
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		ampRows, err := db.FetchSettledAMPSubInvoices(
+			ctx, sqldb.SQLInt64(idx+1),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch settled AMP sub "+
+				"invoices: %w", err)
+		}
+
+		for _, row := range ampRows {
+			var setID SetID
+			copy(setID[:], row.SetID)
+
+			ref := InvoiceRefBySetID(setID)
+			invoice, err := i.fetchInvoice(ctx, db, ref, &setID)
+			if err != nil {
+				return err
+			}
+
+			settled = append(settled, settledInvoice{
+				settleIndex: uint64(row.SettleIndex.Int64),
+				invoice:     *invoice,
+			})
+		}
+
+		sort.Slice(settled, func(a, b int) bool {
+			return settled[a].settleIndex < settled[b].settleIndex
+		})
+
+		invoices = make([]Invoice, 0, len(settled))
+		for _, s := range settled {
+			invoices = append(invoices, s.invoice)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get invoices settled since "+
+			"index %d: %w", idx, err)
+	}
+
+	return invoices, nil
+}
+
+// DeleteInvoice attempts to delete the passed invoices from the database in
+// one transaction. The passed delete references hold all keys required to
+// delete the invoices without also needing to deserialize them.
+func (i *SQLStore) DeleteInvoice(ctx context.Context,
+	invoicesToDelete []InvoiceDeleteRef) error {
+
+	// All the InvoiceDeleteRef instances include the add index of the
+	// invoice. The rest was added to ensure that the invoices were deleted
+	// properly in the kv database. When we have fully migrated we can
+	// remove the rest of the fields.
+	for _, ref := range invoicesToDelete {
+		if ref.AddIndex == 0 {
+			return fmt.Errorf("unable to delete invoice using a "+
+				"ref without AddIndex set: %v", ref)
+		}
+	}
+
+	writeTxOpt := NewSQLInvoiceQueryWriteTx()
+	err := i.db.ExecTx(ctx, &writeTxOpt, func(db SQLInvoiceQueries) error {
+		for _, ref := range invoicesToDelete {
+			params := sqlc.DeleteInvoiceParams{
+				AddIndex: sqldb.SQLInt64(ref.AddIndex),
+			}
+
+			if ref.SettleIndex != 0 {
+				params.SettleIndex = sqldb.SQLInt64(
+					ref.SettleIndex,
+				)
+			}
+
+			if ref.PayHash != lntypes.ZeroHash {
+				params.Hash = ref.PayHash[:]
+			}
+
+			result, err := db.DeleteInvoice(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to delete "+
+					"invoice(%v): %w", ref.AddIndex, err)
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("unable to get rows "+
+					"affected: %w", err)
+			}
+			if rowsAffected == 0 {
+				return fmt.Errorf("%w: unable to delete "+
+					"invoice(%v)", ErrInvoiceNotFound,
+					ref.AddIndex)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete invoices: %w", err)
+	}
+
+	return nil
+}
+
+// forEachInvoicePage pages through all the invoices matching the given filter
+// in ascending add index order and calls the passed callback for each of them.
+// All the AMP htlcs are loaded for AMP invoices.
+func forEachInvoicePage(ctx context.Context, db SQLInvoiceQueries,
+	filter sqlc.FilterInvoicesParams,
+	cb func(lntypes.Hash, *Invoice) error) error {
+
+	filter.NumLimit = queryPaginationLimit
+
+	for {
+		rows, err := db.FilterInvoices(ctx, filter)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("unable to get invoices from db: %w",
+				err)
+		}
+
+		for _, row := range rows {
+			row := row
+			hash, invoice, err := fetchInvoiceData(
+				ctx, db, &row, nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := cb(*hash, invoice); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < queryPaginationLimit {
+			return nil
+		}
+
+		filter.NumOffset += queryPaginationLimit
+	}
+}
+
+// fetchInvoiceData fetches the common invoice data for the given invoice row,
+// along with its features, htlcs and AMP state. The setIDFilter controls which
+// AMP htlcs are loaded: a nil filter loads all of them, a zero set ID none and
+// any other set ID only the htlcs belonging to that set.
+func fetchInvoiceData(ctx context.Context, db SQLInvoiceQueries,
+	row *sqlc.Invoice, setIDFilter *SetID) (*lntypes.Hash, *Invoice,
+	error) {
+
+	// Unmarshal the common data.
+	hash, invoice, err := unmarshalInvoice(*row)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to unmarshal "+
+			"invoice(id=%d) from db: %w", row.ID, err)
+	}
+
+	// Fetch the invoice features.
+	features, err := getInvoiceFeatures(ctx, db, row.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice.Terms.Features = features
+
+	// Fetch the invoice htlcs.
+	htlcs, ampData, err := getInvoiceHtlcs(ctx, db, row.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice.Htlcs = make(map[CircuitKey]*InvoiceHTLC)
+	for key, htlc := range htlcs {
+		amp, isAMP := ampData[key]
+		if !isAMP {
+			invoice.Htlcs[key] = htlc
+			continue
+		}
+
+		htlc.AMP = amp
+
+		// Only include the AMP htlcs that were requested.
+		if setIDFilter != nil &&
+			*setIDFilter != amp.Record.SetID() {
+
+			continue
+		}
+
+		invoice.Htlcs[key] = htlc
+	}
+
+	// If this is an AMP invoice, we'll also need to reconstruct the AMP
+	// state of all its sub invoices.
+	if invoice.IsAMP() {
+		invoice.AMPState, err = getAMPState(ctx, db, row.ID, htlcs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return hash, invoice, nil
+}
+
+// getInvoiceFeatures fetches the invoice features for the given invoice id.
+func getInvoiceFeatures(ctx context.Context, db SQLInvoiceQueries,
+	invoiceID int64) (*lnwire.FeatureVector, error) {
+
+	rows, err := db.GetInvoiceFeatures(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get invoice features: %w",
+			err)
+	}
+
+	features := lnwire.EmptyFeatureVector()
+	for _, feature := range rows {
+		features.Set(lnwire.FeatureBit(feature.Feature))
+	}
+
+	return features, nil
+}
+
+// getInvoiceHtlcs fetches the invoice htlcs, and their custom records, for
+// the given invoice id. The AMP specific data of each htlc is returned in a
+// separate map.
+func getInvoiceHtlcs(ctx context.Context, db SQLInvoiceQueries,
+	invoiceID int64) (map[CircuitKey]*InvoiceHTLC,
+	map[CircuitKey]*InvoiceHtlcAMPData, error) {
+
+	htlcRows, err := db.GetInvoiceHTLCs(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get invoice htlcs: %w",
+			err)
+	}
+
+	// We have no htlcs to unmarshal.
+	if len(htlcRows) == 0 {
+		return nil, nil, nil
+	}
+
+	crRows, err := db.GetInvoiceHTLCCustomRecords(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get custom records for "+
+			"invoice htlcs: %w", err)
+	}
+
+	cr := make(map[int64]record.CustomSet, len(crRows))
+	for _, row := range crRows {
+		if _, ok := cr[row.HtlcID]; !ok {
+			cr[row.HtlcID] = make(record.CustomSet)
+		}
+
+		value := row.Value
+		if value == nil {
+			value = []byte{}
+		}
+		cr[row.HtlcID][uint64(row.Key)] = value
+	}
+
+	htlcs := make(map[CircuitKey]*InvoiceHTLC, len(htlcRows))
+	keysByID := make(map[int64]CircuitKey, len(htlcRows))
+
+	for _, row := range htlcRows {
+		circuitKey, htlc, err := unmarshalInvoiceHTLC(row)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to unmarshal "+
+				"htlc(%d): %w", row.ID, err)
+		}
+
+		if customRecords, ok := cr[row.ID]; ok {
+			htlc.CustomRecords = customRecords
+		} else {
+			htlc.CustomRecords = make(record.CustomSet)
+		}
+
+		htlcs[circuitKey] = htlc
+		keysByID[row.ID] = circuitKey
+	}
+
+	ampRows, err := db.SelectAMPSubInvoiceHTLCs(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get AMP htlcs: %w", err)
+	}
+
+	ampData := make(map[CircuitKey]*InvoiceHtlcAMPData, len(ampRows))
+	for _, row := range ampRows {
+		key, ok := keysByID[row.ID]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown AMP htlc(%d)",
+				row.ID)
+		}
+
+		var (
+			rootShare, setID [32]byte
+			hash             lntypes.Hash
+		)
+		copy(rootShare[:], row.RootShare)
+		copy(setID[:], row.SetID)
+		copy(hash[:], row.Hash)
+
+		amp := &InvoiceHtlcAMPData{
+			Record: *record.NewAMP(
+				rootShare, setID, uint32(row.ChildIndex),
+			),
+			Hash: hash,
+		}
+
+		if row.Preimage != nil {
+			preimage, err := lntypes.MakePreimage(row.Preimage)
+			if err != nil {
+				return nil, nil, err
+			}
+			amp.Preimage = &preimage
+		}
+
+		ampData[key] = amp
+	}
+
+	return htlcs, ampData, nil
+}
+
+// getAMPState reconstructs the AMP state of all the sub invoices of the AMP
+// invoice with the given id. The passed htlcs must contain all the htlcs of
+// the invoice.
+func getAMPState(ctx context.Context, db SQLInvoiceQueries, invoiceID int64,
+	htlcs map[CircuitKey]*InvoiceHTLC) (AMPInvoiceState, error) {
+
+	rows, err := db.SelectAMPSubInvoices(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get AMP sub invoices: %w",
+			err)
+	}
+
+	ampState := make(AMPInvoiceState, len(rows))
+	for _, row := range rows {
+		var setID SetID
+		copy(setID[:], row.SetID)
+
+		state := InvoiceStateAMP{
+			State:       HtlcState(row.State),
+			SettleIndex: uint64(row.SettleIndex.Int64),
+			InvoiceKeys: make(map[CircuitKey]struct{}),
+		}
+
+		if row.SettledAt.Valid {
+			state.SettleDate = row.SettledAt.Time.Local()
+		}
+
+		ampState[setID] = state
+	}
+
+	// Now tally up the htlcs of each set. The amount paid only includes
+	// the htlcs that weren't canceled.
+	for key, htlc := range htlcs {
+		if htlc.AMP == nil {
+			continue
+		}
+
+		setID := htlc.AMP.Record.SetID()
+		state, ok := ampState[setID]
+		if !ok {
+			return nil, fmt.Errorf("htlc %v belongs to unknown "+
+				"set id %x", key, setID)
+		}
+
+		state.InvoiceKeys[key] = struct{}{}
+		if htlc.State != HtlcStateCanceled {
+			state.AmtPaid += htlc.Amt
+		}
+
+		ampState[setID] = state
+	}
+
+	return ampState, nil
+}
+
+// unmarshalInvoice converts an invoice row from the database into an Invoice
+// along with its payment hash.
+func unmarshalInvoice(row sqlc.Invoice) (*lntypes.Hash, *Invoice, error) {
+	var (
+		settleIndex    uint64
+		settledAt      time.Time
+		memo           []byte
+		paymentRequest []byte
+		preimage       *lntypes.Preimage
+		paymentAddr    [32]byte
+	)
+
+	hash, err := lntypes.MakeHash(row.Hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if row.SettleIndex.Valid {
+		settleIndex = uint64(row.SettleIndex.Int64)
+	}
+
+	if row.SettledAt.Valid {
+		settledAt = row.SettledAt.Time.Local()
+	}
+
+	if row.Memo.Valid {
+		memo = []byte(row.Memo.String)
+	}
+
+	// Keysend payments will have this field empty.
+	if row.PaymentRequest.Valid {
+		paymentRequest = []byte(row.PaymentRequest.String)
+	} else {
+		paymentRequest = []byte{}
+	}
+
+	// We may not have the preimage if this a hodl invoice.
+	if row.Preimage != nil {
+		preimage = &lntypes.Preimage{}
+		copy(preimage[:], row.Preimage)
+	}
+
+	copy(paymentAddr[:], row.PaymentAddr)
+
+	var cltvDelta int32
+	if row.CltvDelta.Valid {
+		cltvDelta = row.CltvDelta.Int32
+	}
+
+	invoice := &Invoice{
+		SettleIndex:    settleIndex,
+		SettleDate:     settledAt,
+		Memo:           memo,
+		PaymentRequest: paymentRequest,
+		CreationDate:   row.CreatedAt.Local(),
+		Terms: ContractTerm{
+			FinalCltvDelta:  cltvDelta,
+			Expiry:          time.Duration(row.Expiry) * time.Second,
+			PaymentPreimage: preimage,
+			Value:           lnwire.MilliSatoshi(row.AmountMsat),
+			PaymentAddr:     paymentAddr,
+		},
+		AddIndex:    uint64(row.ID),
+		State:       ContractState(row.State),
+		AmtPaid:     lnwire.MilliSatoshi(row.AmountPaidMsat),
+		Htlcs:       make(map[models.CircuitKey]*InvoiceHTLC),
+		AMPState:    AMPInvoiceState{},
+		HodlInvoice: row.IsHodl,
+	}
+
+	return &hash, invoice, nil
+}
+
+// unmarshalInvoiceHTLC converts an InvoiceHTLC row from the database into an
+// InvoiceHTLC along with its circuit key. Custom records and AMP data are
+// populated by the caller.
+func unmarshalInvoiceHTLC(row sqlc.InvoiceHtlc) (CircuitKey, *InvoiceHTLC,
+	error) {
+
+	uint64ChanID, err := strconv.ParseUint(row.ChanID, 10, 64)
+	if err != nil {
+		return CircuitKey{}, nil, err
+	}
+
+	chanID := lnwire.NewShortChanIDFromInt(uint64ChanID)
+
+	if row.HtlcID < 0 {
+		return CircuitKey{}, nil, fmt.Errorf("invalid uint64 "+
+			"value: %v", row.HtlcID)
+	}
+
+	htlcID := uint64(row.HtlcID)
+
+	circuitKey := CircuitKey{
+		ChanID: chanID,
+		HtlcID: htlcID,
+	}
+
+	htlc := &InvoiceHTLC{
+		Amt:          lnwire.MilliSatoshi(row.AmountMsat),
+		AcceptHeight: uint32(row.AcceptHeight),
+		AcceptTime:   row.AcceptTime.Local(),
+		Expiry:       uint32(row.ExpiryHeight),
+		State:        HtlcState(row.State),
+	}
+
+	if row.TotalMppMsat.Valid {
+		htlc.MppTotalAmt = lnwire.MilliSatoshi(row.TotalMppMsat.Int64)
+	}
+
+	if row.ResolveTime.Valid {
+		htlc.ResolveTime = row.ResolveTime.Time.Local()
+	}
+
+	return circuitKey, htlc, nil
+}
+
+// insertInvoiceEvent inserts a new invoice event into the database.
+func insertInvoiceEvent(ctx context.Context, db SQLInvoiceQueries,
+	now time.Time, invoiceID int64, eventType InvoiceEventType,
+	setID *[32]byte) error {
+
+	params := sqlc.InsertInvoiceEventParams{
+		CreatedAt: now.UTC(),
+		InvoiceID: invoiceID,
+		EventType: int32(eventType),
+	}
+
+	if setID != nil {
+		params.SetID = setID[:]
+	}
+
+	err := db.InsertInvoiceEvent(ctx, params)
+	if err != nil {
+		return fmt.Errorf("unable to insert invoice event(%v): %w",
+			eventType, err)
+	}
+
+	return nil
+}
+
+// sqlInvoiceUpdater is the deferred invoice updater that is used to execute
+// the invoice update on a SQL database.
+type sqlInvoiceUpdater struct {
+	db  SQLInvoiceQueries
+	ctx context.Context //nolint:containedctx
+
+	// invoice is the invoice that we're updating. As a side effect of the
+	// update this invoice will be mutated.
+	invoice *Invoice
+
+	// invoiceID is the database id of the invoice that we're updating.
+	invoiceID int64
+
+	// updateTime is the timestamp for the update.
+	updateTime time.Time
+}
+
+// A compile-time assertion to make sure sqlInvoiceUpdater implements the
+// InvoiceUpdater interface.
+var _ InvoiceUpdater = (*sqlInvoiceUpdater)(nil)
+
+// AddHtlc adds a new htlc to the invoice.
+func (s *sqlInvoiceUpdater) AddHtlc(circuitKey models.CircuitKey,
+	newHtlc *InvoiceHTLC) error {
+
+	htlcPrimaryKeyID, err := s.db.InsertInvoiceHTLC(
+		s.ctx, sqlc.InsertInvoiceHTLCParams{
+			HtlcID: int64(circuitKey.HtlcID),
+			ChanID: strconv.FormatUint(
+				circuitKey.ChanID.ToUint64(), 10,
+			),
+			AmountMsat: int64(newHtlc.Amt),
+			TotalMppMsat: sql.NullInt64{
+				Int64: int64(newHtlc.MppTotalAmt),
+				Valid: newHtlc.MppTotalAmt != 0,
+			},
+			AcceptHeight: int32(newHtlc.AcceptHeight),
+			AcceptTime:   newHtlc.AcceptTime.UTC(),
+			ExpiryHeight: int32(newHtlc.Expiry),
+			State:        int16(newHtlc.State),
+			InvoiceID:    s.invoiceID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range newHtlc.CustomRecords {
+		// TODO(bhandras): schema might be wrong here, as the key
+		// shouldn't be unique, but the key and htlc_id combination
+		// should be.
+		err = s.db.InsertInvoiceHTLCCustomRecord(
+			s.ctx, sqlc.InsertInvoiceHTLCCustomRecordParams{
+				// TODO(bhandras): store key as uint64 in the
+				// db.
+				Key:    int64(key),
+				Value:  value,
+				HtlcID: htlcPrimaryKeyID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if newHtlc.AMP == nil {
+		return nil
+	}
+
+	setID := newHtlc.AMP.Record.SetID()
+
+	// Create the AMP sub invoice if it doesn't exist yet. If the set ID
+	// already belongs to a different invoice, the insert will fail with a
+	// unique constraint violation.
+	result, err := s.db.UpsertAMPSubInvoice(
+		s.ctx, sqlc.UpsertAMPSubInvoiceParams{
+			SetID:     setID[:],
+			State:     int16(HtlcStateAccepted),
+			CreatedAt: s.updateTime.UTC(),
+			InvoiceID: s.invoiceID,
+		},
+	)
+	if err != nil {
+		mappedSQLErr := sqldb.MapSQLError(err)
+		var uniqueConstraintErr *sqldb.ErrSQLUniqueConstraintViolation
+		if errors.As(mappedSQLErr, &uniqueConstraintErr) {
+			return ErrDuplicateSetID{
+				SetID: setID,
+			}
+		}
+
+		return err
+	}
+
+	// If we've just created the sub invoice, we'll emit an event for it.
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected > 0 {
+		err := insertInvoiceEvent(
+			s.ctx, s.db, s.updateTime, s.invoiceID, SetIDCreated,
+			&setID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	rootShare := newHtlc.AMP.Record.RootShare()
+
+	ampHtlcParams := sqlc.InsertAMPSubInvoiceHTLCParams{
+		InvoiceID: s.invoiceID,
+		SetID:     setID[:],
+		HtlcID:    htlcPrimaryKeyID,
+		RootShare: rootShare[:],
+		ChildIndex: int64(
+			newHtlc.AMP.Record.ChildIndex(),
+		),
+		Hash: newHtlc.AMP.Hash[:],
+	}
+
+	if newHtlc.AMP.Preimage != nil {
+		ampHtlcParams.Preimage = newHtlc.AMP.Preimage[:]
+	}
+
+	return s.db.InsertAMPSubInvoiceHTLC(s.ctx, ampHtlcParams)
+}
+
+// ResolveHtlc marks an htlc as resolved with the given state.
+func (s *sqlInvoiceUpdater) ResolveHtlc(circuitKey models.CircuitKey,
+	state HtlcState, resolveTime time.Time) error {
+
+	return s.db.UpdateInvoiceHTLC(s.ctx, sqlc.UpdateInvoiceHTLCParams{
+		HtlcID: int64(circuitKey.HtlcID),
+		ChanID: strconv.FormatUint(
+			circuitKey.ChanID.ToUint64(), 10,
+		),
+		InvoiceID:   s.invoiceID,
+		State:       int16(state),
+		ResolveTime: sqldb.SQLTime(resolveTime.UTC()),
+	})
+}
+
+// AddAmpHtlcPreimage adds a preimage of an AMP htlc to the AMP sub invoice
+// identified by the setID.
+func (s *sqlInvoiceUpdater) AddAmpHtlcPreimage(setID [32]byte,
+	circuitKey models.CircuitKey, preimage lntypes.Preimage) error {
+
+	result, err := s.db.UpdateAMPSubInvoiceHTLCPreimage(
+		s.ctx, sqlc.UpdateAMPSubInvoiceHTLCPreimageParams{
+			InvoiceID: s.invoiceID,
+			SetID:     setID[:],
+			HtlcID:    int64(circuitKey.HtlcID),
+			ChanID: strconv.FormatUint(
+				circuitKey.ChanID.ToUint64(), 10,
+			),
+			Preimage: preimage[:],
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+
+	return nil
+}
+
+// UpdateInvoiceState updates the invoice state to the new state. If the
+// invoice is settled, a new settle index is assigned to it.
+func (s *sqlInvoiceUpdater) UpdateInvoiceState(
+	newState ContractState, preimage *lntypes.Preimage) error {
+
+	var (
+		settleIndex sql.NullInt64
+		settledAt   sql.NullTime
+		eventType   *InvoiceEventType
+	)
+
+	switch newState {
+	case ContractSettled:
+		nextSettleIndex, err := s.db.NextInvoiceSettleIndex(s.ctx)
+		if err != nil {
+			return err
+		}
+
+		settleIndex = sqldb.SQLInt64(nextSettleIndex)
+		settledAt = sqldb.SQLTime(s.updateTime.UTC())
+
+		s.invoice.SettleIndex = uint64(nextSettleIndex)
+		s.invoice.SettleDate = s.updateTime
+
+		settled := InvoiceSettled
+		eventType = &settled
+
+	case ContractCanceled:
+		canceled := InvoiceCanceled
+		eventType = &canceled
+	}
+
+	params := sqlc.UpdateInvoiceStateParams{
+		ID:          s.invoiceID,
+		State:       int16(newState),
+		SettleIndex: settleIndex,
+		SettledAt:   settledAt,
+	}
+
+	if preimage != nil {
+		params.Preimage = preimage[:]
+	}
+
+	result, err := s.db.UpdateInvoiceState(s.ctx, params)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+
+	if eventType == nil {
+		return nil
+	}
+
+	return insertInvoiceEvent(
+		s.ctx, s.db, s.updateTime, s.invoiceID, *eventType, nil,
+	)
+}
+
+// UpdateInvoiceAmtPaid updates the invoice amount paid to the new amount.
+func (s *sqlInvoiceUpdater) UpdateInvoiceAmtPaid(
+	amtPaid lnwire.MilliSatoshi) error {
+
+	_, err := s.db.UpdateInvoiceAmountPaid(
+		s.ctx, sqlc.UpdateInvoiceAmountPaidParams{
+			ID:             s.invoiceID,
+			AmountPaidMsat: int64(amtPaid),
+		},
+	)
+
+	return err
+}
+
+// UpdateAmpState updates the state of the AMP sub invoice identified by the
+// setID. If the sub invoice is settled for the first time, a new settle index
+// is assigned to it.
+func (s *sqlInvoiceUpdater) UpdateAmpState(setID [32]byte,
+	newState InvoiceStateAMP, _ models.CircuitKey) error {
+
+	var (
+		settleIndex sql.NullInt64
+		settledAt   sql.NullTime
+	)
+
+	// Only assign a settle index the first time the set is settled, as
+	// this method is called for each htlc in the set.
+	firstSettle := newState.State == HtlcStateSettled &&
+		newState.SettleIndex == 0
+
+	if firstSettle {
+		nextSettleIndex, err := s.db.NextInvoiceSettleIndex(s.ctx)
+		if err != nil {
+			return err
+		}
+
+		settleIndex = sqldb.SQLInt64(nextSettleIndex)
+		settledAt = sqldb.SQLTime(s.updateTime.UTC())
+
+		newState.SettleIndex = uint64(nextSettleIndex)
+		newState.SettleDate = s.updateTime
+		s.invoice.AMPState[setID] = newState
+	}
+
+	err := s.db.UpdateAMPSubInvoiceState(
+		s.ctx, sqlc.UpdateAMPSubInvoiceStateParams{
+			SetID:       setID[:],
+			State:       int16(newState.State),
+			SettleIndex: settleIndex,
+			SettledAt:   settledAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case firstSettle:
+		return insertInvoiceEvent(
+			s.ctx, s.db, s.updateTime, s.invoiceID, SetIDSettled,
+			&setID,
+		)
+
+	case newState.State == HtlcStateCanceled:
+		return insertInvoiceEvent(
+			s.ctx, s.db, s.updateTime, s.invoiceID, SetIDCanceled,
+			&setID,
+		)
+	}
+
+	return nil
+}
+
+// Finalize finalizes the update before it is written to the database. Since
+// all the changes are written to the database as they happen, there's nothing
+// left to do here.
+func (s *sqlInvoiceUpdater) Finalize(_ UpdateType) error {
+	return nil
+}
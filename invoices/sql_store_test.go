@@ -0,0 +1,606 @@
+package invoices_test
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// sqlTestNow is the time used by the test clock of the SQL store. We
+	// use second precision as that's what all SQL backends can store.
+	sqlTestNow = time.Unix(1_000_000, 0)
+
+	ampTestFeatures = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.AMPRequired,
+		),
+		lnwire.Features,
+	)
+)
+
+// newTestSQLStore creates a new SQL invoice store backed by a fresh test
+// database.
+func newTestSQLStore(t *testing.T) *invpkg.SQLStore {
+	t.Helper()
+
+	db := sqldb.NewTestDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) invpkg.SQLInvoiceQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	return invpkg.NewSQLStore(executor, clock.NewTestClock(sqlTestNow))
+}
+
+// randSQLInvoice creates a random invoice that can be stored in the SQL
+// invoice store.
+func randSQLInvoice(t *testing.T, value lnwire.MilliSatoshi) *invpkg.Invoice {
+	t.Helper()
+
+	var (
+		pre     lntypes.Preimage
+		payAddr [32]byte
+	)
+	_, err := rand.Read(pre[:])
+	require.NoError(t, err)
+	_, err = rand.Read(payAddr[:])
+	require.NoError(t, err)
+
+	// The payment request is unique for each invoice, so we'll derive a
+	// dummy one from the payment address.
+	payReq := []byte(fmt.Sprintf("lnbc1%x", payAddr[:]))
+
+	return &invpkg.Invoice{
+		Memo:           []byte("memo"),
+		PaymentRequest: payReq,
+		CreationDate:   sqlTestNow,
+		Terms: invpkg.ContractTerm{
+			FinalCltvDelta:  40,
+			Expiry:          time.Hour,
+			PaymentPreimage: &pre,
+			PaymentAddr:     payAddr,
+			Value:           value,
+			Features:        lnwire.EmptyFeatureVector(),
+		},
+		Htlcs:    map[models.CircuitKey]*invpkg.InvoiceHTLC{},
+		AMPState: map[invpkg.SetID]invpkg.InvoiceStateAMP{},
+	}
+}
+
+// settleUpdate returns an update callback that adds a single htlc with the
+// given amount and settles the invoice.
+func settleUpdate(amt lnwire.MilliSatoshi) invpkg.InvoiceUpdateCallback {
+	return func(invoice *invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+		error) {
+
+		if invoice.State == invpkg.ContractSettled {
+			return nil, invpkg.ErrInvoiceAlreadySettled
+		}
+
+		return &invpkg.InvoiceUpdateDesc{
+			UpdateType: invpkg.AddHTLCsUpdate,
+			State: &invpkg.InvoiceStateUpdateDesc{
+				Preimage: invoice.Terms.PaymentPreimage,
+				NewState: invpkg.ContractSettled,
+			},
+			AddHtlcs: map[models.CircuitKey]*invpkg.HtlcAcceptDesc{
+				{HtlcID: 1}: {
+					Amt:           amt,
+					CustomRecords: record.CustomSet{},
+				},
+			},
+		}, nil
+	}
+}
+
+// TestSQLInvoiceWorkflow tests the basic life cycle of an invoice stored in
+// the SQL invoice store.
+func TestSQLInvoiceWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	store := newTestSQLStore(t)
+
+	invoice := randSQLInvoice(t, 10_000)
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+
+	addIndex, err := store.AddInvoice(ctxb, invoice, payHash)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, addIndex)
+	require.EqualValues(t, 1, invoice.AddIndex)
+
+	// Adding the same invoice again must fail, as well as adding a new
+	// invoice reusing the payment address.
+	_, err = store.AddInvoice(ctxb, invoice, payHash)
+	require.ErrorIs(t, err, invpkg.ErrDuplicateInvoice)
+
+	dupAddr := randSQLInvoice(t, 10_000)
+	dupAddr.Terms.PaymentAddr = invoice.Terms.PaymentAddr
+	_, err = store.AddInvoice(
+		ctxb, dupAddr, dupAddr.Terms.PaymentPreimage.Hash(),
+	)
+	require.ErrorIs(t, err, invpkg.ErrDuplicatePayAddr)
+
+	// The invoice can be looked up both by payment hash and payment
+	// address.
+	ref := invpkg.InvoiceRefByHash(payHash)
+	dbInvoice, err := store.LookupInvoice(ctxb, ref)
+	require.NoError(t, err)
+	require.Equal(t, *invoice, dbInvoice)
+
+	dbInvoice, err = store.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByHashAndAddr(
+			payHash, invoice.Terms.PaymentAddr,
+		),
+	)
+	require.NoError(t, err)
+	require.Equal(t, *invoice, dbInvoice)
+
+	// Looking up an unknown invoice should fail.
+	_, err = store.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByHash(lntypes.Hash{1}),
+	)
+	require.ErrorIs(t, err, invpkg.ErrInvoiceNotFound)
+
+	// Now settle the invoice, overpaying it. The settle index should be
+	// assigned.
+	payAmt := invoice.Terms.Value * 2
+	updated, err := store.UpdateInvoice(
+		ctxb, ref, nil, settleUpdate(payAmt),
+	)
+	require.NoError(t, err)
+	require.Equal(t, invpkg.ContractSettled, updated.State)
+	require.EqualValues(t, 1, updated.SettleIndex)
+	require.Equal(t, payAmt, updated.AmtPaid)
+
+	dbInvoice, err = store.LookupInvoice(ctxb, ref)
+	require.NoError(t, err)
+	require.Equal(t, *updated, dbInvoice)
+
+	// Settling the invoice again should fail with the error returned by
+	// the callback.
+	_, err = store.UpdateInvoice(ctxb, ref, nil, settleUpdate(payAmt))
+	require.ErrorIs(t, err, invpkg.ErrInvoiceAlreadySettled)
+
+	// Add a few more invoices and make sure we can query them all.
+	invoices := []invpkg.Invoice{dbInvoice}
+	for i := 0; i < 5; i++ {
+		inv := randSQLInvoice(t, 1000)
+		_, err := store.AddInvoice(
+			ctxb, inv, inv.Terms.PaymentPreimage.Hash(),
+		)
+		require.NoError(t, err)
+
+		invoices = append(invoices, *inv)
+	}
+
+	resp, err := store.QueryInvoices(ctxb, invpkg.InvoiceQuery{
+		NumMaxInvoices: math.MaxUint64,
+	})
+	require.NoError(t, err)
+	require.Equal(t, invoices, resp.Invoices)
+	require.EqualValues(t, 1, resp.FirstIndexOffset)
+	require.EqualValues(t, 6, resp.LastIndexOffset)
+
+	// Only the unsettled invoices are pending.
+	resp, err = store.QueryInvoices(ctxb, invpkg.InvoiceQuery{
+		NumMaxInvoices: math.MaxUint64,
+		PendingOnly:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, invoices[1:], resp.Invoices)
+
+	// The time series queries should return the invoices after the given
+	// index.
+	added, err := store.InvoicesAddedSince(ctxb, 4)
+	require.NoError(t, err)
+	require.Equal(t, invoices[4:], added)
+
+	added, err = store.InvoicesAddedSince(ctxb, 0)
+	require.NoError(t, err)
+	require.Empty(t, added)
+
+	settled, err := store.InvoicesSettledSince(ctxb, 1)
+	require.NoError(t, err)
+	require.Empty(t, settled)
+
+	// Scanning should visit every invoice along with its hash.
+	scanned := make(map[lntypes.Hash]invpkg.Invoice)
+	err = store.ScanInvoices(
+		ctxb, func(hash lntypes.Hash, inv *invpkg.Invoice) error {
+			scanned[hash] = *inv
+			return nil
+		}, func() {
+			scanned = make(map[lntypes.Hash]invpkg.Invoice)
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, scanned, len(invoices))
+	for _, inv := range invoices {
+		require.Equal(t, inv, scanned[inv.Terms.PaymentPreimage.Hash()])
+	}
+
+	// Finally delete the first invoice. Deleting it a second time should
+	// fail.
+	deleteRef := invpkg.InvoiceDeleteRef{
+		PayHash:     payHash,
+		AddIndex:    dbInvoice.AddIndex,
+		SettleIndex: dbInvoice.SettleIndex,
+	}
+	err = store.DeleteInvoice(ctxb, []invpkg.InvoiceDeleteRef{deleteRef})
+	require.NoError(t, err)
+
+	_, err = store.LookupInvoice(ctxb, ref)
+	require.ErrorIs(t, err, invpkg.ErrInvoiceNotFound)
+
+	err = store.DeleteInvoice(ctxb, []invpkg.InvoiceDeleteRef{deleteRef})
+	require.ErrorIs(t, err, invpkg.ErrInvoiceNotFound)
+}
+
+// TestSQLQueryInvoicesPagination tests that the SQL invoice store paginates
+// invoices the same way the kv store does.
+func TestSQLQueryInvoicesPagination(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	store := newTestSQLStore(t)
+
+	const numInvoices = 10
+	invoices := make([]invpkg.Invoice, 0, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		inv := randSQLInvoice(t, 1000)
+		inv.CreationDate = sqlTestNow.Add(time.Duration(i) * time.Hour)
+
+		_, err := store.AddInvoice(
+			ctxb, inv, inv.Terms.PaymentPreimage.Hash(),
+		)
+		require.NoError(t, err)
+
+		invoices = append(invoices, *inv)
+	}
+
+	testCases := []struct {
+		name     string
+		query    invpkg.InvoiceQuery
+		expected []invpkg.Invoice
+	}{
+		{
+			name: "forward with offset",
+			query: invpkg.InvoiceQuery{
+				IndexOffset:    3,
+				NumMaxInvoices: 4,
+			},
+			expected: invoices[3:7],
+		},
+		{
+			name: "reversed without offset",
+			query: invpkg.InvoiceQuery{
+				NumMaxInvoices: 3,
+				Reversed:       true,
+			},
+			expected: invoices[7:],
+		},
+		{
+			name: "reversed with offset",
+			query: invpkg.InvoiceQuery{
+				IndexOffset:    5,
+				NumMaxInvoices: 2,
+				Reversed:       true,
+			},
+			expected: invoices[2:4],
+		},
+		{
+			name: "reversed from first invoice",
+			query: invpkg.InvoiceQuery{
+				IndexOffset:    1,
+				NumMaxInvoices: 2,
+				Reversed:       true,
+			},
+			expected: nil,
+		},
+		{
+			name: "creation date range",
+			query: invpkg.InvoiceQuery{
+				NumMaxInvoices:    math.MaxUint64,
+				CreationDateStart: invoices[2].CreationDate,
+				CreationDateEnd:   invoices[5].CreationDate,
+			},
+			expected: invoices[2:6],
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := store.QueryInvoices(ctxb, tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp.Invoices)
+		})
+	}
+}
+
+// TestSQLHodlInvoice tests that hodl invoices can be settled and canceled in
+// the SQL invoice store.
+func TestSQLHodlInvoice(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	store := newTestSQLStore(t)
+
+	// Add two hodl invoices, without preimage.
+	hashes := make([]lntypes.Hash, 2)
+	preimages := make([]lntypes.Preimage, 2)
+	for i := range hashes {
+		inv := randSQLInvoice(t, 1000)
+		inv.HodlInvoice = true
+		preimages[i] = *inv.Terms.PaymentPreimage
+		hashes[i] = preimages[i].Hash()
+		inv.Terms.PaymentPreimage = nil
+
+		_, err := store.AddInvoice(ctxb, inv, hashes[i])
+		require.NoError(t, err)
+	}
+
+	// Accept both invoices, each with a single htlc.
+	accept := func(htlcID uint64) invpkg.InvoiceUpdateCallback {
+		return func(invoice *invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+			error) {
+
+			key := models.CircuitKey{HtlcID: htlcID}
+
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.AddHTLCsUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractAccepted,
+				},
+				AddHtlcs: map[models.CircuitKey]*invpkg.HtlcAcceptDesc{ //nolint:lll
+					key: {
+						Amt:           invoice.Terms.Value,
+						CustomRecords: record.CustomSet{},
+					},
+				},
+			}, nil
+		}
+	}
+	for i, hash := range hashes {
+		inv, err := store.UpdateInvoice(
+			ctxb, invpkg.InvoiceRefByHash(hash), nil,
+			accept(uint64(i)),
+		)
+		require.NoError(t, err)
+		require.Equal(t, invpkg.ContractAccepted, inv.State)
+	}
+
+	// Settle the first invoice with its preimage.
+	settleRef := invpkg.InvoiceRefByHash(hashes[0])
+	inv, err := store.UpdateInvoice(
+		ctxb, settleRef, nil,
+		func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.SettleHodlInvoiceUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractSettled,
+					Preimage: &preimages[0],
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, invpkg.ContractSettled, inv.State)
+	require.EqualValues(t, 1, inv.SettleIndex)
+
+	dbInvoice, err := store.LookupInvoice(ctxb, settleRef)
+	require.NoError(t, err)
+	require.Equal(t, *inv, dbInvoice)
+	require.Equal(t, preimages[0], *dbInvoice.Terms.PaymentPreimage)
+
+	// Cancel the second one, which also cancels the accepted htlc.
+	cancelRef := invpkg.InvoiceRefByHash(hashes[1])
+	inv, err = store.UpdateInvoice(
+		ctxb, cancelRef, nil,
+		func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.CancelInvoiceUpdate,
+				State: &invpkg.InvoiceStateUpdateDesc{
+					NewState: invpkg.ContractCanceled,
+				},
+			}, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, invpkg.ContractCanceled, inv.State)
+
+	dbInvoice, err = store.LookupInvoice(ctxb, cancelRef)
+	require.NoError(t, err)
+	require.Equal(t, *inv, dbInvoice)
+	require.Equal(
+		t, invpkg.HtlcStateCanceled,
+		dbInvoice.Htlcs[models.CircuitKey{HtlcID: 1}].State,
+	)
+}
+
+// TestSQLSettleIndexAmpPayments tests that each settled AMP set gets its own
+// settle index in the SQL invoice store.
+func TestSQLSettleIndexAmpPayments(t *testing.T) {
+	t.Parallel()
+
+	ctxb := context.Background()
+	store := newTestSQLStore(t)
+
+	amt := lnwire.MilliSatoshi(1000)
+	invoice := randSQLInvoice(t, amt)
+	invoice.PaymentRequest = []byte{}
+	invoice.Terms.Features = ampTestFeatures
+
+	preimage := *invoice.Terms.PaymentPreimage
+	payHash := preimage.Hash()
+	_, err := store.AddInvoice(ctxb, invoice, payHash)
+	require.NoError(t, err)
+
+	ref := invpkg.InvoiceRefByHashAndAddr(
+		payHash, invoice.Terms.PaymentAddr,
+	)
+
+	// Accept one htlc for each of the three sets.
+	setIDs := []invpkg.SetID{{1}, {2}, {3}}
+	for i, setID := range setIDs {
+		setID := setID
+		key := models.CircuitKey{HtlcID: uint64(i + 1)}
+
+		_, err := store.UpdateInvoice(
+			ctxb, ref, &setID,
+			func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+				error) {
+
+				return &invpkg.InvoiceUpdateDesc{
+					UpdateType: invpkg.AddHTLCsUpdate,
+					State: &invpkg.InvoiceStateUpdateDesc{
+						NewState: invpkg.ContractAccepted,
+						SetID:    (*[32]byte)(&setID),
+					},
+					AddHtlcs: map[models.CircuitKey]*invpkg.HtlcAcceptDesc{ //nolint:lll
+						key: {
+							Amt:           amt,
+							CustomRecords: record.CustomSet{}, //nolint:lll
+							AMP: &invpkg.InvoiceHtlcAMPData{ //nolint:lll
+								Record: *record.NewAMP( //nolint:lll
+									[32]byte{}, setID, 0, //nolint:lll
+								),
+								Hash:     payHash,
+								Preimage: &preimage,
+							},
+						},
+					},
+				}, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// A lookup with the blank modifier shouldn't return any htlc, while a
+	// filtered set id lookup returns just the htlc of that set.
+	dbInvoice, err := store.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByAddrBlankHtlc(invoice.Terms.PaymentAddr),
+	)
+	require.NoError(t, err)
+	require.Empty(t, dbInvoice.Htlcs)
+	require.Len(t, dbInvoice.AMPState, len(setIDs))
+
+	for i, setID := range setIDs {
+		dbInvoice, err := store.LookupInvoice(
+			ctxb, invpkg.InvoiceRefBySetIDFiltered(setID),
+		)
+		require.NoError(t, err)
+		require.Len(t, dbInvoice.Htlcs, 1)
+
+		key := models.CircuitKey{HtlcID: uint64(i + 1)}
+		require.Equal(
+			t, invpkg.HtlcStateAccepted,
+			dbInvoice.Htlcs[key].State,
+		)
+		require.Equal(t, amt, dbInvoice.AMPState[setID].AmtPaid)
+	}
+
+	// Now settle all the sets one by one.
+	for i, setID := range setIDs {
+		setID := setID
+		key := models.CircuitKey{HtlcID: uint64(i + 1)}
+
+		_, err := store.UpdateInvoice(
+			ctxb, ref, &setID,
+			func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc,
+				error) {
+
+				return &invpkg.InvoiceUpdateDesc{
+					UpdateType: invpkg.AddHTLCsUpdate,
+					State: &invpkg.InvoiceStateUpdateDesc{
+						NewState: invpkg.ContractSettled,
+						SetID:    (*[32]byte)(&setID),
+						HTLCPreimages: map[models.CircuitKey]lntypes.Preimage{ //nolint:lll
+							key: preimage,
+						},
+					},
+				}, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	// Each set should have its own settle index, and the settled invoices
+	// returned should only contain the htlcs of the respective set.
+	settled, err := store.InvoicesSettledSince(ctxb, 1)
+	require.NoError(t, err)
+	require.Len(t, settled, 2)
+
+	for i, inv := range settled {
+		setID := setIDs[i+1]
+		key := models.CircuitKey{HtlcID: uint64(i + 2)}
+
+		require.Len(t, inv.Htlcs, 1)
+		require.Contains(t, inv.Htlcs, key)
+
+		state := inv.AMPState[setID]
+		require.Equal(t, invpkg.HtlcStateSettled, state.State)
+		require.EqualValues(t, i+2, state.SettleIndex)
+		require.Contains(t, state.InvoiceKeys, key)
+	}
+
+	// The full invoice should contain all three htlcs.
+	dbInvoice, err = store.LookupInvoice(
+		ctxb, invpkg.InvoiceRefByAddr(invoice.Terms.PaymentAddr),
+	)
+	require.NoError(t, err)
+	require.Len(t, dbInvoice.Htlcs, len(setIDs))
+	for _, htlc := range dbInvoice.Htlcs {
+		require.Equal(t, invpkg.HtlcStateSettled, htlc.State)
+		require.Equal(t, preimage, *htlc.AMP.Preimage)
+	}
+
+	// A set ID can't be reused for a different invoice.
+	other := randSQLInvoice(t, amt)
+	other.Terms.Features = ampTestFeatures
+	otherHash := other.Terms.PaymentPreimage.Hash()
+	_, err = store.AddInvoice(ctxb, other, otherHash)
+	require.NoError(t, err)
+
+	setID := setIDs[0]
+	_, err = store.UpdateInvoice(
+		ctxb, invpkg.InvoiceRefByHash(otherHash), &setID,
+		func(*invpkg.Invoice) (*invpkg.InvoiceUpdateDesc, error) {
+			return &invpkg.InvoiceUpdateDesc{
+				UpdateType: invpkg.AddHTLCsUpdate,
+				AddHtlcs: map[models.CircuitKey]*invpkg.HtlcAcceptDesc{ //nolint:lll
+					{HtlcID: 10}: {
+						Amt:           amt,
+						CustomRecords: record.CustomSet{},
+						AMP: &invpkg.InvoiceHtlcAMPData{
+							Record: *record.NewAMP(
+								[32]byte{}, setID, 0,
+							),
+							Hash: otherHash,
+						},
+					},
+				},
+			}, nil
+		},
+	)
+	require.ErrorIs(t, err, invpkg.ErrDuplicateSetID{SetID: setID})
+}
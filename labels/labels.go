@@ -15,7 +15,10 @@
 package labels
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -68,6 +71,13 @@ const (
 
 	// LabelTypeSweepTransaction is used to label sweeps.
 	LabelTypeSweepTransaction LabelType = "sweep"
+
+	// LabelTypeChannelSplice is used to label channel splices.
+	LabelTypeChannelSplice LabelType = "splicechannel"
+
+	// LabelTypeSpliceAbort is used to label transactions that abort a
+	// pending splice.
+	LabelTypeSpliceAbort LabelType = "abortsplice"
 )
 
 // LabelField is used to tag a value within a label.
@@ -90,3 +100,48 @@ func MakeLabel(labelType LabelType, channelID *lnwire.ShortChannelID) string {
 	return fmt.Sprintf("%v:%v:%v-%v", LabelVersionZero, labelType,
 		ShortChanID, channelID.ToUint64())
 }
+
+// ErrUnknownLabel is returned when a label wasn't created by MakeLabel.
+var ErrUnknownLabel = errors.New("label not created by lnd")
+
+// ParseLabel parses a label created by MakeLabel, returning its label type and
+// the short channel id it contains, if any. ErrUnknownLabel is returned for
+// labels that weren't created by lnd, such as user provided labels.
+func ParseLabel(label string) (LabelType, *lnwire.ShortChannelID, error) {
+	parts := strings.Split(label, ":")
+	if len(parts) < 2 || len(parts) > 3 ||
+		parts[0] != strconv.Itoa(int(LabelVersionZero)) {
+
+		return "", nil, ErrUnknownLabel
+	}
+
+	labelType := LabelType(parts[1])
+	switch labelType {
+	case LabelTypeChannelOpen, LabelTypeChannelClose,
+		LabelTypeJusticeTransaction, LabelTypeSweepTransaction,
+		LabelTypeChannelSplice, LabelTypeSpliceAbort:
+
+	default:
+		return "", nil, ErrUnknownLabel
+	}
+
+	if len(parts) == 2 {
+		return labelType, nil, nil
+	}
+
+	prefix := string(ShortChanID) + "-"
+	if !strings.HasPrefix(parts[2], prefix) {
+		return "", nil, ErrUnknownLabel
+	}
+
+	scid, err := strconv.ParseUint(
+		strings.TrimPrefix(parts[2], prefix), 10, 64,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid short channel id: %w", err)
+	}
+
+	channelID := lnwire.NewShortChanIDFromInt(scid)
+
+	return labelType, &channelID, nil
+}
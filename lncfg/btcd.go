@@ -4,10 +4,11 @@ package lncfg
 //
 //nolint:lll
 type Btcd struct {
-	Dir        string `long:"dir" description:"The base directory that contains the node's data, logs, configuration file, etc."`
-	RPCHost    string `long:"rpchost" description:"The daemon's rpc listening address. If a port is omitted, then the default port for the selected chain parameters will be used."`
-	RPCUser    string `long:"rpcuser" description:"Username for RPC connections"`
-	RPCPass    string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
-	RPCCert    string `long:"rpccert" description:"File containing the daemon's certificate file"`
-	RawRPCCert string `long:"rawrpccert" description:"The raw bytes of the daemon's PEM-encoded certificate chain which will be used to authenticate the RPC connection."`
+	Dir                 string `long:"dir" description:"The base directory that contains the node's data, logs, configuration file, etc."`
+	RPCHost             string `long:"rpchost" description:"The daemon's rpc listening address. If a port is omitted, then the default port for the selected chain parameters will be used."`
+	RPCUser             string `long:"rpcuser" description:"Username for RPC connections"`
+	RPCPass             string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
+	RPCCert             string `long:"rpccert" description:"File containing the daemon's certificate file"`
+	RawRPCCert          string `long:"rawrpccert" description:"The raw bytes of the daemon's PEM-encoded certificate chain which will be used to authenticate the RPC connection."`
+	MempoolFeeEstimator bool   `long:"mempoolfeeestimator" description:"Blend the fee estimates of btcd with the fee rates needed to be included in the next blocks, projected from a histogram of its mempool. The whole mempool is fetched with each update, as btcd can't report single mempool entries. Can't be combined with feeurl."`
 }
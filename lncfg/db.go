@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/kvdb/sqlite"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/sqldb"
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 	SqliteChainDBName    = "chain.sqlite"
 	SqliteNeutrinoDBName = "neutrino.sqlite"
 	SqliteTowerDBName    = "watchtower.sqlite"
+	SqliteNativeDBName   = "lnd.sqlite"
 
 	BoltBackend                = "bolt"
 	EtcdBackend                = "etcd"
@@ -64,6 +66,10 @@ const (
 
 	// NSNeutrinoDB is the namespace name that we use for the neutrino DB.
 	NSNeutrinoDB = "neutrinodb"
+
+	// NSNativeSQLDB is the name we use to track the native SQL database
+	// in the close functions map.
+	NSNativeSQLDB = "nativesqldb"
 )
 
 // DB holds database configuration for LND.
@@ -87,6 +93,10 @@ type DB struct {
 	PruneRevocation bool `long:"prune-revocation" description:"Run the optional migration that prunes the revocation logs to save disk space."`
 
 	NoRevLogAmtData bool `long:"no-rev-log-amt-data" description:"If set, the to-local and to-remote output amounts of revoked commitment transactions will not be stored in the revocation log. Note that once this data is lost, a watchtower client will not be able to back up the revoked state."`
+
+	UseNativeSQL bool `long:"use-native-sql" description:"Use native SQL tables instead of the key-value store for the invoices. Can only be used with the postgres or sqlite database backends."`
+
+	UseNativeSQLGraph bool `long:"use-native-sql-graph" description:"Use native SQL tables instead of the key-value store for the channel graph. Can only be used with the postgres or sqlite database backends."`
 }
 
 // DefaultDB creates and returns a new default DB config.
@@ -146,6 +156,15 @@ func (db *DB) Validate() error {
 			"backend '%v'", db.Backend)
 	}
 
+	// Native SQL tables are only available for the SQL based backends.
+	useNativeSQL := db.UseNativeSQL || db.UseNativeSQLGraph
+	if useNativeSQL && db.Backend != PostgresBackend &&
+		db.Backend != SqliteBackend {
+
+		return fmt.Errorf("cannot use native SQL with database "+
+			"backend '%v'", db.Backend)
+	}
+
 	return nil
 }
 
@@ -214,6 +233,11 @@ type DatabaseBackends struct {
 	// the underlying wallet database from.
 	WalletDB btcwallet.LoaderOption
 
+	// NativeSQLStore is a pointer to a native SQL store that can be used
+	// for native SQL queries for tables that already support it. This may
+	// be nil if the use-native-sql flag was not set.
+	NativeSQLStore *sqldb.BaseDB
+
 	// Remote indicates whether the database backends are remote, possibly
 	// replicated instances or local bbolt or sqlite backed databases.
 	Remote bool
@@ -402,6 +426,24 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 		}
 		closeFuncs[NSWalletDB] = postgresWalletBackend.Close
 
+		var nativeSQLStore *sqldb.BaseDB
+		if db.UseNativeSQL || db.UseNativeSQLGraph {
+			nativePostgresStore, err := sqldb.NewPostgresStore(
+				&sqldb.PostgresConfig{
+					Dsn:                db.Postgres.Dsn,
+					Timeout:            db.Postgres.Timeout,
+					MaxOpenConnections: db.Postgres.MaxConnections,
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error opening "+
+					"native postgres store: %v", err)
+			}
+
+			nativeSQLStore = nativePostgresStore.BaseDB
+			closeFuncs[NSNativeSQLDB] = nativePostgresStore.DB.Close
+		}
+
 		// Warn if the user is trying to switch over to a Postgres DB
 		// while there is a wallet or channel bbolt DB still present.
 		warnExistingBoltDBs(
@@ -429,8 +471,9 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 			WalletDB: btcwallet.LoaderWithExternalWalletDB(
 				postgresWalletBackend,
 			),
-			Remote:     true,
-			CloseFuncs: closeFuncs,
+			NativeSQLStore: nativeSQLStore,
+			Remote:         true,
+			CloseFuncs:     closeFuncs,
 		}, nil
 
 	case SqliteBackend:
@@ -504,6 +547,24 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 		}
 		closeFuncs[NSWalletDB] = sqliteWalletBackend.Close
 
+		var nativeSQLStore *sqldb.BaseDB
+		if db.UseNativeSQL || db.UseNativeSQLGraph {
+			nativeSQLiteStore, err := sqldb.NewSqliteStore(
+				&sqldb.SqliteConfig{
+					DatabaseFileName: filepath.Join(
+						chanDBPath, SqliteNativeDBName,
+					),
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error opening "+
+					"native SQLite store: %v", err)
+			}
+
+			nativeSQLStore = nativeSQLiteStore.BaseDB
+			closeFuncs[NSNativeSQLDB] = nativeSQLiteStore.DB.Close
+		}
+
 		// Warn if the user is trying to switch over to a sqlite DB
 		// while there is a wallet or channel bbolt DB still present.
 		warnExistingBoltDBs(
@@ -531,7 +592,8 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 			WalletDB: btcwallet.LoaderWithExternalWalletDB(
 				sqliteWalletBackend,
 			),
-			CloseFuncs: closeFuncs,
+			NativeSQLStore: nativeSQLStore,
+			CloseFuncs:     closeFuncs,
 		}, nil
 	}
 
@@ -0,0 +1,60 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MinFeePolicyInterval is the smallest interval allowed in which the
+	// fee policies of the channels are recomputed.
+	MinFeePolicyInterval = time.Minute
+)
+
+//nolint:lll
+type FeePolicy struct {
+	Active            bool          `long:"active" description:"If true, the forwarding fees and max_htlc of all channels are updated automatically by the fee policy manager."`
+	Strategy          string        `long:"strategy" description:"The strategy that computes the channel policies. The balance strategy raises the fee rate of a channel as its local balance is depleted, the adaptive strategy further adjusts it to the recent forwards and failures of the channel." choice:"balance" choice:"adaptive"`
+	Interval          time.Duration `long:"interval" description:"The interval in which the channel policies are recomputed."`
+	BaseFee           uint64        `long:"basefee" description:"The base fee in millisatoshi that is set on all channels."`
+	MinFeeRate        uint32        `long:"minfeerate" description:"The lowest fee rate in parts per million that is set, for channels whose funds are all on our side."`
+	MaxFeeRate        uint32        `long:"maxfeerate" description:"The highest fee rate in parts per million that is set, for depleted channels."`
+	ForwardingWindow  time.Duration `long:"forwardingwindow" description:"The window of forwards and failed forwards that the adaptive strategy takes into account."`
+	MinUpdateInterval time.Duration `long:"minupdateinterval" description:"The minimum time between two updates of the policy of a channel."`
+	MaxUpdates        int           `long:"maxupdates" description:"The maximum number of channels whose policy is updated at once."`
+	MinChange         float64       `long:"minchange" description:"The minimum relative change of the fee rate or max_htlc of a channel that warrants an update, e.g. 0.1 for 10%."`
+}
+
+// Validate checks the values configured for the fee policy manager.
+func (f *FeePolicy) Validate() error {
+	if !f.Active {
+		return nil
+	}
+
+	if f.Interval < MinFeePolicyInterval {
+		return fmt.Errorf("interval must be at least %v",
+			MinFeePolicyInterval)
+	}
+
+	if f.MinFeeRate > f.MaxFeeRate {
+		return fmt.Errorf("minfeerate must not exceed maxfeerate")
+	}
+
+	if f.ForwardingWindow <= 0 {
+		return fmt.Errorf("forwardingwindow must be positive")
+	}
+
+	if f.MinUpdateInterval < 0 {
+		return fmt.Errorf("minupdateinterval must not be negative")
+	}
+
+	if f.MaxUpdates <= 0 {
+		return fmt.Errorf("maxupdates must be positive")
+	}
+
+	if f.MinChange < 0 {
+		return fmt.Errorf("minchange must not be negative")
+	}
+
+	return nil
+}
@@ -47,6 +47,32 @@ type ProtocolOptions struct {
 	// NoOptionAnySegwit should be set to true if we don't want to use any
 	// Taproot (and beyond) addresses for co-op closing.
 	NoOptionAnySegwit bool `long:"no-any-segwit" description:"disallow using any segiwt witness version as a co-op close address"`
+
+	// OptionOnionMessages should be set if we want to signal the
+	// onion-messages feature bit and relay onion messages for our peers.
+	OptionOnionMessages bool `long:"onion-messages" description:"enable support for sending, receiving and relaying onion messages"`
+
+	// OptionDualFunding should be set if we want to signal the dual-fund
+	// feature bit and open channels with the dual funding protocol.
+	OptionDualFunding bool `long:"dual-funding" description:"enable support for dual-funded channels, which both parties contribute funds to"`
+
+	// OptionSplicing should be set if we want to signal the splice
+	// feature bit and splice funds into and out of existing channels.
+	OptionSplicing bool `long:"splicing" description:"enable support for splicing funds into and out of existing channels"`
+
+	// OptionQuiescence should be set if we want to signal the quiescence
+	// feature bit and quiesce channels with the stfu message.
+	OptionQuiescence bool `long:"quiescence" description:"enable support for quiescing channels, which pauses their updates while protocol changes are made"`
+
+	// OptionDynamicCommitments should be set if we want to signal the
+	// dynamic commitments feature bit and upgrade the commitment type
+	// and constraints of existing channels.
+	OptionDynamicCommitments bool `long:"dynamic-commitments" description:"enable support for upgrading the commitment type and constraints of existing channels without closing them, requires protocol.quiescence"`
+
+	// OptionSimpleClose should be set if we want to signal the simple
+	// close feature bit and negotiate cooperative closes in which either
+	// party can bump the fee of the closing transaction.
+	OptionSimpleClose bool `long:"simple-close" description:"enable support for cooperative closes in which either party pays the fee of its closing transaction from its own output, and can replace it with one that pays a higher fee"`
 }
 
 // Wumbo returns true if lnd should permit the creation and acceptance of wumbo
@@ -82,3 +108,35 @@ func (l *ProtocolOptions) ZeroConf() bool {
 func (l *ProtocolOptions) NoAnySegwit() bool {
 	return l.NoOptionAnySegwit
 }
+
+// OnionMessages returns true if we have enabled the onion-messages feature
+// bit.
+func (l *ProtocolOptions) OnionMessages() bool {
+	return l.OptionOnionMessages
+}
+
+// DualFunding returns true if we have enabled the dual-fund feature bit.
+func (l *ProtocolOptions) DualFunding() bool {
+	return l.OptionDualFunding
+}
+
+// Splicing returns true if we have enabled the splice feature bit.
+func (l *ProtocolOptions) Splicing() bool {
+	return l.OptionSplicing
+}
+
+// Quiescence returns true if we have enabled the quiescence feature bit.
+func (l *ProtocolOptions) Quiescence() bool {
+	return l.OptionQuiescence
+}
+
+// DynamicCommitments returns true if we have enabled the dynamic commitments
+// feature bit.
+func (l *ProtocolOptions) DynamicCommitments() bool {
+	return l.OptionDynamicCommitments
+}
+
+// SimpleClose returns true if we have enabled the simple close feature bit.
+func (l *ProtocolOptions) SimpleClose() bool {
+	return l.OptionSimpleClose
+}
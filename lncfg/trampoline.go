@@ -0,0 +1,37 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+//nolint:lll
+type Trampoline struct {
+	Active     bool          `long:"active" description:"If true, we signal support for trampoline routing and pay the recipients of trampoline payments on behalf of payers that don't know a route to them."`
+	BaseFee    uint64        `long:"basefee" description:"The base fee in millisatoshi that we charge for trampoline payments, on top of the fees of the route to the recipient."`
+	FeeRate    uint32        `long:"feerate" description:"The fee rate in parts per million that we charge for trampoline payments, on top of the fees of the route to the recipient."`
+	CltvDelta  uint16        `long:"cltvdelta" description:"The time lock delta that we keep for ourselves between the incoming htlcs of a trampoline payment and the route to the recipient."`
+	MppTimeout time.Duration `long:"mpptimeout" description:"The time that we wait for all htlcs of a trampoline payment to arrive before failing them back."`
+	MaxParts   uint32        `long:"maxparts" description:"The maximum number of parts that the payment to the recipient of a trampoline payment may be split into."`
+}
+
+// Validate checks the values configured for trampoline routing.
+func (t *Trampoline) Validate() error {
+	if !t.Active {
+		return nil
+	}
+
+	if t.CltvDelta == 0 {
+		return fmt.Errorf("cltvdelta must be positive")
+	}
+
+	if t.MppTimeout <= 0 {
+		return fmt.Errorf("mpptimeout must be positive")
+	}
+
+	if t.MaxParts == 0 {
+		return fmt.Errorf("maxparts must be positive")
+	}
+
+	return nil
+}
@@ -39,6 +39,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/devrpc.Dev/Quiesce": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 )
 
@@ -341,3 +345,34 @@ func (s *Server) ImportGraph(ctx context.Context,
 
 	return &ImportGraphResponse{}, nil
 }
+
+// Quiesce makes a channel quiescent and returns whether we're the initiator of
+// the quiescence.
+//
+// NOTE: Part of the DevServer interface.
+func (s *Server) Quiesce(_ context.Context,
+	in *QuiescenceRequest) (*QuiescenceResponse, error) {
+
+	chanPoint, err := lnrpc.GetChanPointFundingTxid(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	op := wire.NewOutPoint(chanPoint, in.ChanPoint.OutputIndex)
+	chanID := lnwire.NewChanIDFromOutPoint(op)
+
+	link, err := s.cfg.Switch.GetLink(chanID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find link for "+
+			"ChannelPoint(%v): %w", op, err)
+	}
+
+	initiator, err := link.Quiesce()
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuiescenceResponse{
+		Initiator: initiator,
+	}, nil
+}
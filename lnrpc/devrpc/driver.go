@@ -47,6 +47,11 @@ func createNewSubServer(configRegistry lnrpc.SubServerConfigDispatcher) (
 			"DevRPC")
 	}
 
+	if config.Switch == nil {
+		return nil, nil, fmt.Errorf("Switch must be set to create " +
+			"DevRPC")
+	}
+
 	return New(config)
 }
 
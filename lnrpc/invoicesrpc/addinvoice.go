@@ -83,6 +83,14 @@ type AddInvoiceConfig struct {
 	// GetAlias allows the peer's alias SCID to be retrieved for private
 	// option_scid_alias channels.
 	GetAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// NodePubKey is the public key of our node, which is the final hop of
+	// the blinded paths that are created for invoices.
+	NodePubKey *btcec.PublicKey
+
+	// BestHeight returns the current best block height, which is used to
+	// set the expiry of blinded paths.
+	BestHeight func() (uint32, error)
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -136,6 +144,16 @@ type AddInvoiceData struct {
 	// RouteHints are optional route hints that can each be individually
 	// used to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
+
+	// Blind signals that the invoice should include blinded paths to our
+	// node instead of revealing our node's identity.
+	//
+	// NOTE: Blinded invoices can't include route hints.
+	Blind bool
+
+	// BlindedPathCfg holds the options for the blinded paths of a blinded
+	// invoice. If nil, the default options are used.
+	BlindedPathCfg *BlindedPathConfig
 }
 
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
@@ -308,6 +326,23 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		options = append(options, zpay32.FallbackAddr(addr))
 	}
 
+	// Blinded paths hide our node behind an introduction node, so there's
+	// no point in revealing our private channels with route hints.
+	if invoice.Blind {
+		switch {
+		case invoice.Amp:
+			return nil, nil, errors.New("AMP invoices can't be " +
+				"blinded")
+
+		case invoice.Private || len(invoice.RouteHints) > 0:
+			return nil, nil, errors.New("blinded invoices can't " +
+				"include route hints")
+		}
+	}
+
+	// The expiry is also needed to bound the expiry of blinded paths.
+	var expiry time.Duration
+
 	switch {
 	// If expiry is set, specify it. If it is not provided, no expiry time
 	// will be explicitly added to this payment request, which will imply
@@ -326,15 +361,17 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				float64(expSeconds), maxExpiry.Seconds())
 		}
 
-		expiry := time.Duration(invoice.Expiry) * time.Second
+		expiry = time.Duration(invoice.Expiry) * time.Second
 		options = append(options, zpay32.Expiry(expiry))
 
 	// If no custom expiry is provided, use the default MPP expiry.
 	case !invoice.Amp:
+		expiry = DefaultInvoiceExpiry
 		options = append(options, zpay32.Expiry(DefaultInvoiceExpiry))
 
 	// Otherwise, use the default AMP expiry.
 	default:
+		expiry = DefaultAMPInvoiceExpiry
 		defaultExpiry := zpay32.Expiry(DefaultAMPInvoiceExpiry)
 		options = append(options, defaultExpiry)
 	}
@@ -354,6 +391,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	// We'll use our current default CLTV value unless one was specified as
 	// an option on the command line when creating an invoice.
+	finalCltvDelta := uint64(cfg.DefaultCLTVExpiry)
 	switch {
 	case invoice.CltvExpiry > routing.MaxCLTVDelta:
 		return nil, nil, fmt.Errorf("CLTV delta of %v is too large, "+
@@ -369,6 +407,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				routing.MinCLTVDelta, invoice.CltvExpiry)
 		}
 
+		finalCltvDelta = invoice.CltvExpiry
 		options = append(options,
 			zpay32.CLTVExpiry(invoice.CltvExpiry))
 
@@ -438,6 +477,24 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 	options = append(options, zpay32.PaymentAddr(paymentAddr))
 
+	// For blinded invoices, we create paths to our node through some of
+	// our peers. The payment address is used as the path id, so that
+	// htlcs arriving over the paths can be matched to this invoice.
+	if invoice.Blind {
+		paths, err := blindedPathsForInvoice(
+			cfg, invoice, amtMSat, paymentAddr, expiry,
+			uint16(finalCltvDelta),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to build blinded "+
+				"paths: %w", err)
+		}
+
+		for _, path := range paths {
+			options = append(options, zpay32.BlindedPath(path))
+		}
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -486,6 +543,42 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	return &paymentHash, newInvoice, nil
 }
 
+// blindedPathsForInvoice builds the blinded paths for an invoice with the
+// given payment address, expiry and final CLTV delta.
+func blindedPathsForInvoice(cfg *AddInvoiceConfig, invoice *AddInvoiceData,
+	amtMSat lnwire.MilliSatoshi, paymentAddr [32]byte,
+	expiry time.Duration,
+	finalCltvDelta uint16) ([]*zpay32.BlindedPaymentPath, error) {
+
+	if cfg.NodePubKey == nil || cfg.BestHeight == nil {
+		return nil, errors.New("blinded paths are not supported by " +
+			"this invoice config")
+	}
+
+	pathCfg := invoice.BlindedPathCfg
+	if pathCfg == nil {
+		pathCfg = DefaultBlindedPathConfig()
+	}
+
+	height, err := cfg.BestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	params := &blindedPathParams{
+		amt:            amtMSat,
+		pathID:         paymentAddr[:],
+		finalCltvDelta: finalCltvDelta,
+		maxCltvExpiry: blindedPathMaxCltvExpiry(
+			height, expiry, finalCltvDelta,
+		),
+	}
+
+	hopHintsCfg := newSelectHopHintsCfg(cfg, int(pathCfg.MaxNumPaths))
+
+	return buildBlindedPaths(hopHintsCfg, cfg.NodePubKey, pathCfg, params)
+}
+
 // chanCanBeHopHint returns true if the target channel is eligible to be a hop
 // hint.
 func chanCanBeHopHint(channel *HopHintInfo, cfg *SelectHopHintsCfg) (
@@ -526,6 +619,19 @@ func chanCanBeHopHint(channel *HopHintInfo, cfg *SelectHopHintsCfg) (
 		return nil, false
 	}
 
+	remotePolicy := fetchRemotePolicy(channel, cfg)
+	if remotePolicy == nil {
+		return nil, false
+	}
+
+	return remotePolicy, true
+}
+
+// fetchRemotePolicy returns the policy that the remote party of the channel
+// uses to forward HTLCs to us, or nil if it can't be found in the graph.
+func fetchRemotePolicy(channel *HopHintInfo,
+	cfg *SelectHopHintsCfg) *channeldb.ChannelEdgePolicy {
+
 	// Fetch the policies for each end of the channel.
 	info, p1, p2, err := cfg.FetchChannelEdgesByID(channel.ShortChannelID)
 	if err != nil {
@@ -538,20 +644,18 @@ func chanCanBeHopHint(channel *HopHintInfo, cfg *SelectHopHintsCfg) (
 			log.Errorf("Unable to fetch the routing policies for "+
 				"the edges of the channel %v: %v",
 				channel.ShortChannelID, err)
-			return nil, false
+			return nil
 		}
 	}
 
 	// Now, we'll need to determine which is the correct policy for HTLCs
 	// being sent from the remote node.
-	var remotePolicy *channeldb.ChannelEdgePolicy
-	if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
-		remotePolicy = p1
-	} else {
-		remotePolicy = p2
+	remotePub := channel.RemotePubkey.SerializeCompressed()
+	if bytes.Equal(remotePub, info.NodeKey1Bytes[:]) {
+		return p1
 	}
 
-	return remotePolicy, true
+	return p2
 }
 
 // HopHintInfo contains the channel information required to create a hop hint.
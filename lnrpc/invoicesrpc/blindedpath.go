@@ -0,0 +1,462 @@
+package invoicesrpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// DefaultNumBlindedDummyHops is the default number of dummy hops that
+	// we append to each blinded path, so that the payer can't tell how
+	// far the recipient is from the introduction node.
+	DefaultNumBlindedDummyHops = 2
+
+	// DefaultMaxNumBlindedPaths is the default maximum number of blinded
+	// paths that will be included in an invoice.
+	DefaultMaxNumBlindedPaths = 3
+
+	// maxBlindedDummyHops is the maximum number of dummy hops that can be
+	// appended to a blinded path. The introduction node and the final hop
+	// take up the remaining hops that fit into an invoice.
+	maxBlindedDummyHops = 5
+
+	// blockInterval is the expected time between two blocks, used to
+	// convert the invoice expiry into a block height.
+	blockInterval = 10 * time.Minute
+)
+
+var (
+	// ErrNoBlindedPathIntroductions is returned when none of our channels
+	// can be used as the introduction to a blinded path.
+	ErrNoBlindedPathIntroductions = errors.New("no suitable introduction " +
+		"nodes for blinded path")
+)
+
+// BlindedPathConfig holds the options used to build the blinded paths of an
+// invoice.
+type BlindedPathConfig struct {
+	// NumDummyHops is the number of dummy hops that are appended to each
+	// blinded path after our node.
+	NumDummyHops uint8
+
+	// MaxNumPaths is the maximum number of blinded paths that will be
+	// included in the invoice.
+	MaxNumPaths uint8
+
+	// NodeOmissionSet is a set of nodes that must not be used as the
+	// introduction node of a blinded path.
+	NodeOmissionSet map[route.Vertex]struct{}
+}
+
+// DefaultBlindedPathConfig returns the blinded path options that are used if
+// the caller didn't specify any.
+func DefaultBlindedPathConfig() *BlindedPathConfig {
+	return &BlindedPathConfig{
+		NumDummyHops: DefaultNumBlindedDummyHops,
+		MaxNumPaths:  DefaultMaxNumBlindedPaths,
+	}
+}
+
+// validate checks that the blinded path options are sane.
+func (c *BlindedPathConfig) validate() error {
+	if c.MaxNumPaths == 0 {
+		return errors.New("at least one blinded path is required")
+	}
+
+	if c.NumDummyHops > maxBlindedDummyHops {
+		return fmt.Errorf("number of dummy hops %v exceeds maximum "+
+			"of %v", c.NumDummyHops, maxBlindedDummyHops)
+	}
+
+	return nil
+}
+
+// blindedPathParams holds the payment specific parameters of the blinded
+// paths that are built for an invoice.
+type blindedPathParams struct {
+	// amt is the amount of the invoice. If non-zero, only channels with
+	// enough inbound liquidity will be used for blinded paths.
+	amt lnwire.MilliSatoshi
+
+	// pathID is the data that we encrypt for ourselves as the final hop,
+	// which is the payment address of the invoice.
+	pathID []byte
+
+	// finalCltvDelta is the final CLTV delta of the invoice.
+	finalCltvDelta uint16
+
+	// maxCltvExpiry is the highest expiry that an htlc arriving at our
+	// node over one of the blinded paths may have.
+	maxCltvExpiry uint32
+}
+
+// blindedPathMaxCltvExpiry returns the highest expiry that we accept for
+// htlcs arriving over a blinded path of an invoice with the given expiry and
+// final CLTV delta.
+func blindedPathMaxCltvExpiry(height uint32, expiry time.Duration,
+	finalCltvDelta uint16) uint32 {
+
+	expiryBlocks := math.Ceil(
+		float64(expiry) / float64(blockInterval),
+	)
+
+	return height + uint32(expiryBlocks) + uint32(finalCltvDelta)
+}
+
+// blindedIntroduction is a channel with a peer that can serve as the
+// introduction node of a blinded path to our node.
+type blindedIntroduction struct {
+	// info holds the details of the channel with the introduction node.
+	info *HopHintInfo
+
+	// policy is the introduction node's policy for forwarding htlcs to
+	// us over the channel.
+	policy *channeldb.ChannelEdgePolicy
+}
+
+// selectBlindedIntroductions returns the channels that will be used as the
+// introduction of our blinded paths. Only a single channel per peer is used,
+// and channels with more inbound liquidity are preferred.
+func selectBlindedIntroductions(cfg *SelectHopHintsCfg,
+	pathCfg *BlindedPathConfig,
+	amt lnwire.MilliSatoshi) ([]*blindedIntroduction, error) {
+
+	openChannels, err := cfg.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort the channels in descending remote balance.
+	sort.Slice(openChannels, func(i, j int) bool {
+		iBalance := openChannels[i].LocalCommitment.RemoteBalance
+		jBalance := openChannels[j].LocalCommitment.RemoteBalance
+		return iBalance > jBalance
+	})
+
+	var (
+		introductions []*blindedIntroduction
+		usedPeers     = make(map[route.Vertex]struct{})
+	)
+	for _, channel := range openChannels {
+		if len(introductions) >= int(pathCfg.MaxNumPaths) {
+			break
+		}
+
+		peer := route.NewVertex(channel.IdentityPub)
+		if _, ok := usedPeers[peer]; ok {
+			continue
+		}
+		if _, ok := pathCfg.NodeOmissionSet[peer]; ok {
+			continue
+		}
+
+		// Since the channels are sorted, none of the remaining ones
+		// will have enough inbound liquidity either.
+		remoteBalance := channel.LocalCommitment.RemoteBalance
+		if amt != 0 && remoteBalance < amt {
+			break
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		info := newHopHintInfo(channel, cfg.IsChannelActive(chanID))
+
+		policy, ok := chanCanBeIntroduction(info, cfg)
+		if !ok {
+			continue
+		}
+
+		// Private channels that negotiated the scid alias feature
+		// must be referenced by their alias.
+		if !info.IsPublic && info.ScidAliasFeature {
+			alias, err := cfg.GetAlias(chanID)
+			if err != nil || alias.IsDefault() {
+				continue
+			}
+
+			info.ShortChannelID = alias.ToUint64()
+		}
+
+		usedPeers[peer] = struct{}{}
+		introductions = append(introductions, &blindedIntroduction{
+			info:   info,
+			policy: policy,
+		})
+	}
+
+	if len(introductions) == 0 {
+		return nil, ErrNoBlindedPathIntroductions
+	}
+
+	return introductions, nil
+}
+
+// chanCanBeIntroduction returns true if the remote party of the target
+// channel can be the introduction node of a blinded path to our node. Unlike
+// hop hints, both public and private channels can be used, as the channel
+// itself is never revealed.
+func chanCanBeIntroduction(channel *HopHintInfo, cfg *SelectHopHintsCfg) (
+	*channeldb.ChannelEdgePolicy, bool) {
+
+	if !channel.IsActive {
+		log.Debugf("Skipping channel %v due to not being eligible to "+
+			"forward payments", channel.ShortChannelID)
+		return nil, false
+	}
+
+	// The payer must be able to find a route to the introduction node,
+	// so it has to be advertised within the network.
+	var remotePub [33]byte
+	copy(remotePub[:], channel.RemotePubkey.SerializeCompressed())
+	isRemoteNodePublic, err := cfg.IsPublicNode(remotePub)
+	if err != nil {
+		log.Errorf("Unable to determine if node %x is advertised: %v",
+			remotePub, err)
+		return nil, false
+	}
+
+	if !isRemoteNodePublic {
+		log.Debugf("Skipping channel %v due to counterparty %x being "+
+			"unadvertised", channel.ShortChannelID, remotePub)
+		return nil, false
+	}
+
+	policy := fetchRemotePolicy(channel, cfg)
+	if policy == nil {
+		return nil, false
+	}
+
+	return policy, true
+}
+
+// buildBlindedPaths creates a blinded path to our node through each of the
+// selected introduction nodes.
+func buildBlindedPaths(cfg *SelectHopHintsCfg, ourKey *btcec.PublicKey,
+	pathCfg *BlindedPathConfig,
+	params *blindedPathParams) ([]*zpay32.BlindedPaymentPath, error) {
+
+	if err := pathCfg.validate(); err != nil {
+		return nil, err
+	}
+
+	introductions, err := selectBlindedIntroductions(
+		cfg, pathCfg, params.amt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]*zpay32.BlindedPaymentPath, 0, len(introductions))
+	for _, intro := range introductions {
+		path, err := buildBlindedPath(
+			intro, ourKey, int(pathCfg.NumDummyHops), params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// buildBlindedPath creates a single blinded path that starts at the given
+// introduction node, followed by our node, the requested number of dummy
+// hops, and a final hop that carries the path id.
+func buildBlindedPath(intro *blindedIntroduction, ourKey *btcec.PublicKey,
+	numDummyHops int,
+	params *blindedPathParams) (*zpay32.BlindedPaymentPath, error) {
+
+	policy := intro.policy
+
+	// The introduction node forwards to us over our channel, while our
+	// dummy hops point back to our own node and don't charge any fees.
+	scid := lnwire.NewShortChanIDFromInt(intro.info.ShortChannelID)
+	routeData := []*record.BlindedRouteData{{
+		ShortChannelID: &scid,
+		RelayInfo: &record.PaymentRelayInfo{
+			CltvExpiryDelta: policy.TimeLockDelta,
+			FeeRate: uint32(
+				policy.FeeProportionalMillionths,
+			),
+			BaseFee: policy.FeeBaseMSat,
+		},
+	}}
+	for i := 0; i < numDummyHops; i++ {
+		routeData = append(routeData, &record.BlindedRouteData{
+			NextNodeID: ourKey,
+			RelayInfo:  &record.PaymentRelayInfo{},
+		})
+	}
+	routeData = append(routeData, &record.BlindedRouteData{
+		PathID: params.pathID,
+	})
+
+	// The htlc limits of the path are bounded by the policy of the
+	// introduction node. If it doesn't advertise a maximum, we use the
+	// inbound liquidity of the channel.
+	htlcMin := policy.MinHTLC
+	if htlcMin == 0 {
+		htlcMin = 1
+	}
+	htlcMax := intro.info.RemoteBalance
+	if policy.MessageFlags.HasMaxHtlc() && policy.MaxHTLC < htlcMax {
+		htlcMax = policy.MaxHTLC
+	}
+
+	// Each hop may only accept htlcs that leave enough time for the hops
+	// after it, so we work our way back from the final hop.
+	maxCltvExpiry := params.maxCltvExpiry
+	for i := len(routeData) - 1; i >= 0; i-- {
+		data := routeData[i]
+		if data.RelayInfo != nil {
+			maxCltvExpiry += uint32(data.RelayInfo.CltvExpiryDelta)
+		}
+
+		data.Constraints = &record.PaymentConstraints{
+			MaxCltvExpiry:   maxCltvExpiry,
+			HtlcMinimumMsat: htlcMin,
+		}
+	}
+
+	payloads, err := encodePaddedRouteData(routeData)
+	if err != nil {
+		return nil, err
+	}
+
+	hops := make([]*sphinx.HopInfo, len(payloads))
+	for i, payload := range payloads {
+		nodePub := ourKey
+		if i == 0 {
+			nodePub = intro.info.RemotePubkey
+		}
+
+		hops[i] = &sphinx.HopInfo{
+			NodePub:   nodePub,
+			PlainText: payload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	blindedPath, err := sphinx.BuildBlindedPath(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, feeRate, cltvDelta := aggregateRelayInfo(routeData)
+
+	return &zpay32.BlindedPaymentPath{
+		FeeBaseMsat:     baseFee,
+		FeeRate:         feeRate,
+		CltvExpiryDelta: cltvDelta + params.finalCltvDelta,
+		HTLCMinMsat:     uint64(htlcMin),
+		HTLCMaxMsat:     uint64(htlcMax),
+		Features:        lnwire.EmptyFeatureVector(),
+		BlindedPath:     blindedPath,
+	}, nil
+}
+
+// encodePaddedRouteData encodes the route data of each hop, padding them so
+// that all hops have the same length and can't be told apart by the payer.
+func encodePaddedRouteData(routeData []*record.BlindedRouteData) ([][]byte,
+	error) {
+
+	var maxLen int
+	for _, data := range routeData {
+		payload, err := record.EncodeBlindedRouteData(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(payload) > maxLen {
+			maxLen = len(payload)
+		}
+	}
+
+	// A padding record takes up at least three bytes (type, length and
+	// one byte of padding), so we make room for that in every hop.
+	targetLen := maxLen + 3
+
+	payloads := make([][]byte, len(routeData))
+	for i, data := range routeData {
+		payload, err := record.EncodeBlindedRouteData(data)
+		if err != nil {
+			return nil, err
+		}
+
+		// Start with a padding length that assumes a single byte for
+		// the length prefix, and shrink it until the record fits.
+		padding := targetLen - len(payload) - 2
+		for ; padding > 0; padding-- {
+			data.Padding = make([]byte, padding)
+
+			payload, err = record.EncodeBlindedRouteData(data)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(payload) <= targetLen {
+				break
+			}
+		}
+
+		payloads[i] = payload
+	}
+
+	return payloads, nil
+}
+
+// aggregateRelayInfo computes the aggregate fees and CLTV delta of the
+// forwarding hops of a blinded path, as described in BOLT 04. The final hop
+// doesn't carry relay info and is skipped.
+func aggregateRelayInfo(routeData []*record.BlindedRouteData) (uint32,
+	uint32, uint16) {
+
+	const million = 1_000_000
+
+	var (
+		totalBase uint64
+		totalRate uint64
+		totalCltv uint16
+	)
+
+	// The fees are aggregated starting from the hop closest to the
+	// recipient, rounding up at each step so that the payer never pays
+	// too little.
+	for i := len(routeData) - 1; i >= 0; i-- {
+		relay := routeData[i].RelayInfo
+		if relay == nil {
+			continue
+		}
+
+		base := uint64(relay.BaseFee)
+		rate := uint64(relay.FeeRate)
+
+		totalBase = (base*million + totalBase*(million+rate) +
+			million - 1) / million
+
+		totalRate = ((totalRate+rate)*million + totalRate*rate +
+			million - 1) / million
+
+		totalCltv += relay.CltvExpiryDelta
+	}
+
+	return uint32(totalBase), uint32(totalRate), totalCltv
+}
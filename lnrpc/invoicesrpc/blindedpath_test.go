@@ -0,0 +1,246 @@
+package invoicesrpc
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAggregateRelayInfo tests that the relay parameters of the hops in a
+// blinded path are aggregated as described in BOLT 04.
+func TestAggregateRelayInfo(t *testing.T) {
+	t.Parallel()
+
+	routeData := []*record.BlindedRouteData{
+		{
+			RelayInfo: &record.PaymentRelayInfo{
+				CltvExpiryDelta: 40,
+				FeeRate:         100,
+				BaseFee:         1000,
+			},
+		},
+		{
+			RelayInfo: &record.PaymentRelayInfo{
+				CltvExpiryDelta: 144,
+				FeeRate:         500,
+				BaseFee:         2000,
+			},
+		},
+		{
+			RelayInfo: &record.PaymentRelayInfo{},
+		},
+		{
+			PathID: []byte{1},
+		},
+	}
+
+	baseFee, feeRate, cltvDelta := aggregateRelayInfo(routeData)
+	require.EqualValues(t, 3001, baseFee)
+	require.EqualValues(t, 601, feeRate)
+	require.EqualValues(t, 184, cltvDelta)
+}
+
+// TestBuildBlindedPaths tests building a blinded path through one of our
+// peers, and asserts that each hop can decrypt its route data.
+func TestBuildBlindedPaths(t *testing.T) {
+	t.Parallel()
+
+	ourKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	introKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	omittedKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var introPub [33]byte
+	copy(introPub[:], introKey.PubKey().SerializeCompressed())
+
+	// We have a channel with a peer that we omit from blinded paths, which
+	// has the most inbound liquidity, and one that can be used.
+	fundingOutpoint := wire.OutPoint{Index: 1}
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingOutpoint)
+	scid := lnwire.NewShortChanIDFromInt(1)
+	remoteBalance := lnwire.MilliSatoshi(10_000_000)
+
+	allChannels := []*channeldb.OpenChannel{
+		{
+			LocalCommitment: channeldb.ChannelCommitment{
+				RemoteBalance: remoteBalance * 2,
+			},
+			FundingOutpoint: wire.OutPoint{Index: 2},
+			ShortChannelID:  lnwire.NewShortChanIDFromInt(2),
+			IdentityPub:     omittedKey.PubKey(),
+		},
+		{
+			LocalCommitment: channeldb.ChannelCommitment{
+				RemoteBalance: remoteBalance,
+			},
+			FundingOutpoint: fundingOutpoint,
+			ShortChannelID:  scid,
+			IdentityPub:     introKey.PubKey(),
+			ChannelFlags:    lnwire.FFAnnounceChannel,
+		},
+	}
+
+	policy := &channeldb.ChannelEdgePolicy{
+		TimeLockDelta:             40,
+		MinHTLC:                   1000,
+		MaxHTLC:                   5_000_000,
+		MessageFlags:              lnwire.ChanUpdateRequiredMaxHtlc,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 100,
+	}
+
+	mock := &hopHintsConfigMock{}
+	mock.On("FetchAllChannels").Once().Return(allChannels, nil)
+	mock.On("IsChannelActive", chanID).Once().Return(true)
+	mock.On("IsPublicNode", introPub).Once().Return(true, nil)
+	mock.On("FetchChannelEdgesByID", scid.ToUint64()).Once().Return(
+		&channeldb.ChannelEdgeInfo{NodeKey1Bytes: introPub}, policy,
+		&channeldb.ChannelEdgePolicy{}, nil,
+	)
+	defer mock.AssertExpectations(t)
+
+	cfg := &SelectHopHintsCfg{
+		IsPublicNode:          mock.IsPublicNode,
+		IsChannelActive:       mock.IsChannelActive,
+		FetchChannelEdgesByID: mock.FetchChannelEdgesByID,
+		GetAlias:              mock.GetAlias,
+		FetchAllChannels:      mock.FetchAllChannels,
+	}
+
+	pathCfg := DefaultBlindedPathConfig()
+	pathCfg.NodeOmissionSet = map[route.Vertex]struct{}{
+		route.NewVertex(omittedKey.PubKey()): {},
+	}
+
+	pathID := bytes.Repeat([]byte{1}, 32)
+	params := &blindedPathParams{
+		amt:            5000,
+		pathID:         pathID,
+		finalCltvDelta: 80,
+		maxCltvExpiry:  blindedPathMaxCltvExpiry(100, time.Hour, 80),
+	}
+	require.EqualValues(t, 186, params.maxCltvExpiry)
+
+	paths, err := buildBlindedPaths(cfg, ourKey.PubKey(), pathCfg, params)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+
+	path := paths[0]
+	require.True(t, path.IntroductionPoint.IsEqual(introKey.PubKey()))
+	require.EqualValues(t, 1000, path.FeeBaseMsat)
+	require.EqualValues(t, 100, path.FeeRate)
+	require.EqualValues(t, 120, path.CltvExpiryDelta)
+	require.EqualValues(t, 1000, path.HTLCMinMsat)
+	require.EqualValues(t, 5_000_000, path.HTLCMaxMsat)
+
+	// The path consists of the introduction node, our dummy hops and the
+	// final hop, which all have the same length.
+	numHops := 2 + DefaultNumBlindedDummyHops
+	require.Len(t, path.BlindedHops, numHops)
+	for _, hop := range path.BlindedHops {
+		require.Len(
+			t, hop.CipherText,
+			len(path.BlindedHops[0].CipherText),
+		)
+	}
+
+	// Each hop should be able to decrypt its route data using the
+	// blinding point that it receives.
+	newRouter := func(key *btcec.PrivateKey) *sphinx.Router {
+		return sphinx.NewRouter(
+			&keychain.PrivKeyECDH{PrivKey: key},
+			&chaincfg.RegressionNetParams, nil,
+		)
+	}
+	introRouter := newRouter(introKey)
+	ourRouter := newRouter(ourKey)
+
+	blinding := path.BlindingPoint
+	var routeData []*record.BlindedRouteData
+	for i, hop := range path.BlindedHops {
+		router := ourRouter
+		if i == 0 {
+			router = introRouter
+		}
+
+		plainText, err := router.DecryptBlindedHopData(
+			blinding, hop.CipherText,
+		)
+		require.NoError(t, err)
+
+		data, err := record.DecodeBlindedRouteData(
+			bytes.NewReader(plainText),
+		)
+		require.NoError(t, err)
+		require.NoError(t, data.Validate())
+		routeData = append(routeData, data)
+
+		blinding, err = router.NextEphemeral(blinding)
+		require.NoError(t, err)
+	}
+
+	// The introduction node forwards to us over our channel.
+	intro := routeData[0]
+	require.Equal(t, scid, *intro.ShortChannelID)
+	require.Equal(t, &record.PaymentRelayInfo{
+		CltvExpiryDelta: 40,
+		FeeRate:         100,
+		BaseFee:         1000,
+	}, intro.RelayInfo)
+	require.EqualValues(t, 226, intro.Constraints.MaxCltvExpiry)
+
+	// The dummy hops point back to our own node.
+	for _, dummy := range routeData[1 : numHops-1] {
+		require.True(t, dummy.NextNodeID.IsEqual(ourKey.PubKey()))
+		require.EqualValues(t, 186, dummy.Constraints.MaxCltvExpiry)
+	}
+
+	// The final hop carries the path id.
+	final := routeData[numHops-1]
+	require.True(t, final.IsFinal())
+	require.Equal(t, pathID, final.PathID)
+	require.EqualValues(t, 186, final.Constraints.MaxCltvExpiry)
+	require.EqualValues(t, 1000, final.Constraints.HtlcMinimumMsat)
+}
+
+// TestBuildBlindedPathsNoIntroduction tests that building blinded paths fails
+// if none of our channels have enough inbound liquidity.
+func TestBuildBlindedPathsNoIntroduction(t *testing.T) {
+	t.Parallel()
+
+	allChannels := []*channeldb.OpenChannel{{
+		LocalCommitment: channeldb.ChannelCommitment{
+			RemoteBalance: 1000,
+		},
+		IdentityPub: getTestPubKey(),
+	}}
+
+	mock := &hopHintsConfigMock{}
+	mock.On("FetchAllChannels").Once().Return(allChannels, nil)
+	defer mock.AssertExpectations(t)
+
+	cfg := &SelectHopHintsCfg{
+		FetchAllChannels: mock.FetchAllChannels,
+	}
+
+	_, err := buildBlindedPaths(
+		cfg, getTestPubKey(), DefaultBlindedPathConfig(),
+		&blindedPathParams{amt: 5000},
+	)
+	require.ErrorIs(t, err, ErrNoBlindedPathIntroductions)
+}
@@ -3,12 +3,14 @@ package invoicesrpc
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -176,6 +178,7 @@ func CreateRPCInvoice(invoice *invoices.Invoice,
 		IsKeysend:       invoice.IsKeysend(),
 		PaymentAddr:     invoice.Terms.PaymentAddr[:],
 		IsAmp:           invoice.IsAMP(),
+		IsBlinded:       len(decoded.BlindedPaymentPaths) > 0,
 	}
 
 	rpcInvoice.AmpInvoiceState = make(map[string]*lnrpc.AMPInvoiceState)
@@ -266,6 +269,93 @@ func CreateRPCRouteHints(routeHints [][]zpay32.HopHint) []*lnrpc.RouteHint {
 	return res
 }
 
+// CreateRPCBlindedPayments takes in the decoded form of an invoice's blinded
+// paths and converts them into the lnrpc type.
+func CreateRPCBlindedPayments(
+	paths []*zpay32.BlindedPaymentPath) []*lnrpc.BlindedPaymentPath {
+
+	res := make([]*lnrpc.BlindedPaymentPath, 0, len(paths))
+	for _, path := range paths {
+		hops := make([]*lnrpc.BlindedHop, 0, len(path.BlindedHops))
+		for _, hop := range path.BlindedHops {
+			hops = append(hops, &lnrpc.BlindedHop{
+				BlindedNode: hop.BlindedNodePub.
+					SerializeCompressed(),
+				EncryptedData: hop.CipherText,
+			})
+		}
+
+		var features []lnrpc.FeatureBit
+		if path.Features != nil {
+			for bit := range path.Features.Features() {
+				features = append(
+					features, lnrpc.FeatureBit(bit),
+				)
+			}
+		}
+
+		introNode := path.IntroductionPoint.SerializeCompressed()
+		res = append(res, &lnrpc.BlindedPaymentPath{
+			BlindedPath: &lnrpc.BlindedPath{
+				IntroductionNode: introNode,
+				BlindingPoint: path.BlindingPoint.
+					SerializeCompressed(),
+				BlindedHops: hops,
+			},
+			BaseFeeMsat:         uint64(path.FeeBaseMsat),
+			ProportionalFeeMsat: uint64(path.FeeRate),
+			TotalCltvDelta:      uint32(path.CltvExpiryDelta),
+			HtlcMinMsat:         path.HTLCMinMsat,
+			HtlcMaxMsat:         path.HTLCMaxMsat,
+			Features:            features,
+		})
+	}
+
+	return res
+}
+
+// UnmarshalBlindedPathConfig converts the lnrpc form of the blinded path
+// options into the config used to build blinded paths. Options that are not
+// set are filled in with their defaults.
+func UnmarshalBlindedPathConfig(
+	rpcCfg *lnrpc.BlindedPathConfig) (*BlindedPathConfig, error) {
+
+	cfg := DefaultBlindedPathConfig()
+	if rpcCfg == nil {
+		return cfg, nil
+	}
+
+	if rpcCfg.NumDummyHops > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid number of dummy hops: %v",
+			rpcCfg.NumDummyHops)
+	}
+	if rpcCfg.NumDummyHops != 0 {
+		cfg.NumDummyHops = uint8(rpcCfg.NumDummyHops)
+	}
+
+	if rpcCfg.MaxNumPaths > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid number of paths: %v",
+			rpcCfg.MaxNumPaths)
+	}
+	if rpcCfg.MaxNumPaths != 0 {
+		cfg.MaxNumPaths = uint8(rpcCfg.MaxNumPaths)
+	}
+
+	if len(rpcCfg.NodeOmissionList) > 0 {
+		cfg.NodeOmissionSet = make(map[route.Vertex]struct{})
+	}
+	for _, nodeID := range rpcCfg.NodeOmissionList {
+		vertex, err := route.NewVertexFromBytes(nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.NodeOmissionSet[vertex] = struct{}{}
+	}
+
+	return cfg, nil
+}
+
 // CreateZpay32HopHints takes in the lnrpc form of route hints and converts them
 // into an invoice decoded form.
 func CreateZpay32HopHints(routeHints []*lnrpc.RouteHint) ([][]zpay32.HopHint, error) {
@@ -126,6 +126,12 @@ type LightningClient interface {
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(ctx context.Context, in *ClosedChannelsRequest, opts ...grpc.CallOption) (*ClosedChannelsResponse, error)
+	// lncli: `closedchannelreports`
+	// ClosedChannelReports returns a breakdown of the on-chain fees that we paid
+	// and the funds that we recovered when our channels were closed. It is built
+	// from the outcomes of the resolvers of the channels, the records of the
+	// sweeper and the labels of our wallet transactions.
+	ClosedChannelReports(ctx context.Context, in *ClosedChannelReportsRequest, opts ...grpc.CallOption) (*ClosedChannelReportsResponse, error)
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -170,7 +176,9 @@ type LightningClient interface {
 	// inactive peer. If a non-force close (cooperative closure) is requested,
 	// then the user can specify either a target number of blocks until the
 	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
+	// specified, then a default lax, block confirmation target is used. If the
+	// cooperative closing transaction has already been broadcast and it was
+	// negotiated with option_simple_close, a new request bumps its fee.
 	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
 	// lncli: `abandonchannel`
 	// AbandonChannel removes all channel state from the database except for a
@@ -180,6 +188,32 @@ type LightningClient interface {
 	// never broadcast. Only available for non-externally funded channels in dev
 	// build.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// lncli: `splicechannel`
+	// SpliceChannel splices funds into or out of an active channel identified by
+	// its channel outpoint (ChannelPoint). Funds are spliced in from the wallet,
+	// unless an address is set to splice funds out to. The channel remains
+	// usable until the splice transaction has reached a reorg safe depth, after
+	// which it is drained of HTLCs and continues with the new funding output,
+	// and is announced with its new short channel ID. The call returns once the
+	// splice transaction has been signed and broadcast.
+	SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error)
+	// lncli: `abortsplice`
+	// AbortSplice aborts the pending splice of a channel by double spending the
+	// wallet coins that were spliced in with a transaction that pays a higher
+	// fee than the splice transaction. Once that transaction has reached a reorg
+	// safe depth, the channel continues with its current funding output. Only
+	// splices that were initiated by us and spliced funds in can be aborted.
+	AbortSplice(ctx context.Context, in *AbortSpliceRequest, opts ...grpc.CallOption) (*AbortSpliceResponse, error)
+	// lncli: `upgradechannel`
+	// UpgradeChannel upgrades the commitment type or the constraints of an
+	// active channel identified by its channel outpoint (ChannelPoint) with a
+	// dynamic commitment upgrade, without closing the channel. The channel is
+	// quiesced while the upgrade is negotiated with the remote peer, and the
+	// call returns once the upgrade has been applied or rejected. Constraints
+	// that aren't set are left unchanged. An upgrade to SIMPLE_TAPROOT splices
+	// the channel to a new taproot funding output instead, and returns once the
+	// splice transaction has been signed and broadcast.
+	UpgradeChannel(ctx context.Context, in *UpgradeChannelRequest, opts ...grpc.CallOption) (*UpgradeChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	// Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -323,6 +357,12 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// lncli: `exportledger`
+	// ExportLedger streams a normalised ledger of the forwards, payments,
+	// invoices and on-chain transactions of the node within the target time
+	// range, ordered by their timestamp. On-chain fees that were paid from the
+	// funds of closed channels are included as separate entries.
+	ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (Lightning_ExportLedgerClient, error)
 	// lncli: `exportchanbackup`
 	// ExportChannelBackup attempts to return an encrypted static channel backup
 	// for the target channel identified by it channel point. The backup is
@@ -676,6 +716,15 @@ func (c *lightningClient) ClosedChannels(ctx context.Context, in *ClosedChannels
 	return out, nil
 }
 
+func (c *lightningClient) ClosedChannelReports(ctx context.Context, in *ClosedChannelReportsRequest, opts ...grpc.CallOption) (*ClosedChannelReportsResponse, error) {
+	out := new(ClosedChannelReportsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ClosedChannelReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*ChannelPoint, error) {
 	out := new(ChannelPoint)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/OpenChannelSync", in, out, opts...)
@@ -807,6 +856,33 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 	return out, nil
 }
 
+func (c *lightningClient) SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error) {
+	out := new(SpliceChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AbortSplice(ctx context.Context, in *AbortSpliceRequest, opts ...grpc.CallOption) (*AbortSpliceResponse, error) {
+	out := new(AbortSpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AbortSplice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpgradeChannel(ctx context.Context, in *UpgradeChannelRequest, opts ...grpc.CallOption) (*UpgradeChannelResponse, error) {
+	out := new(UpgradeChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpgradeChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[6], "/lnrpc.Lightning/SendPayment", opts...)
@@ -1115,6 +1191,38 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (Lightning_ExportLedgerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[10], "/lnrpc.Lightning/ExportLedger", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningExportLedgerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_ExportLedgerClient interface {
+	Recv() (*LedgerEntry, error)
+	grpc.ClientStream
+}
+
+type lightningExportLedgerClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningExportLedgerClient) Recv() (*LedgerEntry, error) {
+	m := new(LedgerEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
 	out := new(ChannelBackup)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
@@ -1152,7 +1260,7 @@ func (c *lightningClient) RestoreChannelBackups(ctx context.Context, in *Restore
 }
 
 func (c *lightningClient) SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[10], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[11], "/lnrpc.Lightning/SubscribeChannelBackups", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1229,7 +1337,7 @@ func (c *lightningClient) CheckMacaroonPermissions(ctx context.Context, in *Chec
 }
 
 func (c *lightningClient) RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[11], "/lnrpc.Lightning/RegisterRPCMiddleware", opts...)
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[12], "/lnrpc.Lightning/RegisterRPCMiddleware", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1269,7 +1377,7 @@ func (c *lightningClient) SendCustomMessage(ctx context.Context, in *SendCustomM
 }
 
 func (c *lightningClient) SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[12], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[13], "/lnrpc.Lightning/SubscribeCustomMessages", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1430,6 +1538,12 @@ type LightningServer interface {
 	// ClosedChannels returns a description of all the closed channels that
 	// this node was a participant in.
 	ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error)
+	// lncli: `closedchannelreports`
+	// ClosedChannelReports returns a breakdown of the on-chain fees that we paid
+	// and the funds that we recovered when our channels were closed. It is built
+	// from the outcomes of the resolvers of the channels, the records of the
+	// sweeper and the labels of our wallet transactions.
+	ClosedChannelReports(context.Context, *ClosedChannelReportsRequest) (*ClosedChannelReportsResponse, error)
 	// OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 	// call is meant to be consumed by clients to the REST proxy. As with all
 	// other sync calls, all byte slices are intended to be populated as hex
@@ -1474,7 +1588,9 @@ type LightningServer interface {
 	// inactive peer. If a non-force close (cooperative closure) is requested,
 	// then the user can specify either a target number of blocks until the
 	// closure transaction is confirmed, or a manual fee rate. If neither are
-	// specified, then a default lax, block confirmation target is used.
+	// specified, then a default lax, block confirmation target is used. If the
+	// cooperative closing transaction has already been broadcast and it was
+	// negotiated with option_simple_close, a new request bumps its fee.
 	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
 	// lncli: `abandonchannel`
 	// AbandonChannel removes all channel state from the database except for a
@@ -1484,6 +1600,32 @@ type LightningServer interface {
 	// never broadcast. Only available for non-externally funded channels in dev
 	// build.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// lncli: `splicechannel`
+	// SpliceChannel splices funds into or out of an active channel identified by
+	// its channel outpoint (ChannelPoint). Funds are spliced in from the wallet,
+	// unless an address is set to splice funds out to. The channel remains
+	// usable until the splice transaction has reached a reorg safe depth, after
+	// which it is drained of HTLCs and continues with the new funding output,
+	// and is announced with its new short channel ID. The call returns once the
+	// splice transaction has been signed and broadcast.
+	SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error)
+	// lncli: `abortsplice`
+	// AbortSplice aborts the pending splice of a channel by double spending the
+	// wallet coins that were spliced in with a transaction that pays a higher
+	// fee than the splice transaction. Once that transaction has reached a reorg
+	// safe depth, the channel continues with its current funding output. Only
+	// splices that were initiated by us and spliced funds in can be aborted.
+	AbortSplice(context.Context, *AbortSpliceRequest) (*AbortSpliceResponse, error)
+	// lncli: `upgradechannel`
+	// UpgradeChannel upgrades the commitment type or the constraints of an
+	// active channel identified by its channel outpoint (ChannelPoint) with a
+	// dynamic commitment upgrade, without closing the channel. The channel is
+	// quiesced while the upgrade is negotiated with the remote peer, and the
+	// call returns once the upgrade has been applied or rejected. Constraints
+	// that aren't set are left unchanged. An upgrade to SIMPLE_TAPROOT splices
+	// the channel to a new taproot funding output instead, and returns once the
+	// splice transaction has been signed and broadcast.
+	UpgradeChannel(context.Context, *UpgradeChannelRequest) (*UpgradeChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	// Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -1627,6 +1769,12 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// lncli: `exportledger`
+	// ExportLedger streams a normalised ledger of the forwards, payments,
+	// invoices and on-chain transactions of the node within the target time
+	// range, ordered by their timestamp. On-chain fees that were paid from the
+	// funds of closed channels are included as separate entries.
+	ExportLedger(*ExportLedgerRequest, Lightning_ExportLedgerServer) error
 	// lncli: `exportchanbackup`
 	// ExportChannelBackup attempts to return an encrypted static channel backup
 	// for the target channel identified by it channel point. The backup is
@@ -1782,6 +1930,9 @@ func (UnimplementedLightningServer) SubscribeChannelEvents(*ChannelEventSubscrip
 func (UnimplementedLightningServer) ClosedChannels(context.Context, *ClosedChannelsRequest) (*ClosedChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClosedChannels not implemented")
 }
+func (UnimplementedLightningServer) ClosedChannelReports(context.Context, *ClosedChannelReportsRequest) (*ClosedChannelReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosedChannelReports not implemented")
+}
 func (UnimplementedLightningServer) OpenChannelSync(context.Context, *OpenChannelRequest) (*ChannelPoint, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenChannelSync not implemented")
 }
@@ -1803,6 +1954,15 @@ func (UnimplementedLightningServer) CloseChannel(*CloseChannelRequest, Lightning
 func (UnimplementedLightningServer) AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbandonChannel not implemented")
 }
+func (UnimplementedLightningServer) SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceChannel not implemented")
+}
+func (UnimplementedLightningServer) AbortSplice(context.Context, *AbortSpliceRequest) (*AbortSpliceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortSplice not implemented")
+}
+func (UnimplementedLightningServer) UpgradeChannel(context.Context, *UpgradeChannelRequest) (*UpgradeChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeChannel not implemented")
+}
 func (UnimplementedLightningServer) SendPayment(Lightning_SendPaymentServer) error {
 	return status.Errorf(codes.Unimplemented, "method SendPayment not implemented")
 }
@@ -1875,6 +2035,9 @@ func (UnimplementedLightningServer) UpdateChannelPolicy(context.Context, *Policy
 func (UnimplementedLightningServer) ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistory not implemented")
 }
+func (UnimplementedLightningServer) ExportLedger(*ExportLedgerRequest, Lightning_ExportLedgerServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLedger not implemented")
+}
 func (UnimplementedLightningServer) ExportChannelBackup(context.Context, *ExportChannelBackupRequest) (*ChannelBackup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportChannelBackup not implemented")
 }
@@ -2320,6 +2483,24 @@ func _Lightning_ClosedChannels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ClosedChannelReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosedChannelReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ClosedChannelReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ClosedChannelReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ClosedChannelReports(ctx, req.(*ClosedChannelReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_OpenChannelSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenChannelRequest)
 	if err := dec(in); err != nil {
@@ -2460,6 +2641,60 @@ func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SpliceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceChannel(ctx, req.(*SpliceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AbortSplice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortSpliceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AbortSplice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AbortSplice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AbortSplice(ctx, req.(*AbortSpliceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpgradeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpgradeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpgradeChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpgradeChannel(ctx, req.(*UpgradeChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
 }
@@ -2914,6 +3149,27 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ExportLedger_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLedgerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).ExportLedger(m, &lightningExportLedgerServer{stream})
+}
+
+type Lightning_ExportLedgerServer interface {
+	Send(*LedgerEntry) error
+	grpc.ServerStream
+}
+
+type lightningExportLedgerServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningExportLedgerServer) Send(m *LedgerEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
@@ -3277,6 +3533,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClosedChannels",
 			Handler:    _Lightning_ClosedChannels_Handler,
 		},
+		{
+			MethodName: "ClosedChannelReports",
+			Handler:    _Lightning_ClosedChannelReports_Handler,
+		},
 		{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
@@ -3293,6 +3553,18 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
 		},
+		{
+			MethodName: "SpliceChannel",
+			Handler:    _Lightning_SpliceChannel_Handler,
+		},
+		{
+			MethodName: "AbortSplice",
+			Handler:    _Lightning_AbortSplice_Handler,
+		},
+		{
+			MethodName: "UpgradeChannel",
+			Handler:    _Lightning_UpgradeChannel_Handler,
+		},
 		{
 			MethodName: "SendPaymentSync",
 			Handler:    _Lightning_SendPaymentSync_Handler,
@@ -3476,6 +3748,11 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportLedger",
+			Handler:       _Lightning_ExportLedger_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelBackups",
 			Handler:       _Lightning_SubscribeChannelBackups_Handler,
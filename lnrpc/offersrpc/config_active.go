@@ -0,0 +1,19 @@
+//go:build offersrpc
+// +build offersrpc
+
+package offersrpc
+
+import (
+	"github.com/lightningnetwork/lnd/offers"
+)
+
+// Config is the primary configuration struct for the offers RPC subserver.
+// It contains all the items required for the server to carry out its duties.
+// The fields with struct tags are meant to be parsed as normal configuration
+// options, while if able to be populated, the latter fields MUST also be
+// specified.
+type Config struct {
+	// OffersManager is used to create offers and to pay the offers of
+	// other nodes. It is nil if onion messages are disabled.
+	OffersManager *offers.Manager
+}
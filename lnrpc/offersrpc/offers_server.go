@@ -0,0 +1,318 @@
+//go:build offersrpc
+// +build offersrpc
+
+package offersrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/offers"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize it as the name of our
+	// RPC service.
+	subServerName = "OffersRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/offersrpc.Offers/CreateOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/offersrpc.Offers/DecodeOffer": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/offersrpc.Offers/PayOffer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+	}
+
+	// ErrOffersDisabled is returned when the offers RPCs are called while
+	// onion messages are disabled.
+	ErrOffersDisabled = errors.New("offers require onion messages, set " +
+		"protocol.onion-messages to enable them")
+)
+
+// ServerShell is a shell struct holding a reference to the actual sub-server.
+// It is used to register the gRPC sub-server with the root server before we
+// have the necessary dependencies to populate the actual sub-server.
+type ServerShell struct {
+	OffersServer
+}
+
+// Server is a sub-server of the main RPC server: the offers RPC. This sub RPC
+// server allows creating and paying BOLT 12 offers.
+type Server struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	// Required by the grpc-gateway/v2 library for forward compatibility.
+	// Must be after the atomically used variables to not break struct
+	// alignment.
+	UnimplementedOffersServer
+
+	cfg *Config
+}
+
+// A compile time check to ensure that Server fully implements the
+// OffersServer gRPC service.
+var _ OffersServer = (*Server)(nil)
+
+// New returns a new instance of the offersrpc Offers sub-server. We also
+// return the set of permissions for the macaroons that we may create within
+// this method.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	return &Server{cfg: cfg}, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	if atomic.AddInt32(&s.shutdown, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have
+// requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterOffersServer(grpcServer, r)
+
+	log.Debugf("Offers RPC server successfully register with root gRPC " +
+		"server")
+
+	return nil
+}
+
+// RegisterWithRestServer will be called by the root REST mux to direct a sub
+// RPC server to register itself with the main REST mux server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRestServer(ctx context.Context,
+	mux *runtime.ServeMux, dest string, opts []grpc.DialOption) error {
+
+	// We make sure that we register it with the main REST server to ensure
+	// all our methods are routed properly.
+	err := RegisterOffersHandlerFromEndpoint(ctx, mux, dest, opts)
+	if err != nil {
+		log.Errorf("Could not register offers REST server with root "+
+			"REST server: %v", err)
+		return err
+	}
+
+	log.Debugf("Offers REST server successfully registered with root " +
+		"REST server")
+	return nil
+}
+
+// CreateSubServer populates the subserver's dependencies using the passed
+// SubServerConfigDispatcher. This method should fully initialize the
+// sub-server instance, making it ready for action. It returns the macaroon
+// permissions that the sub-server wishes to pass on to the root server for all
+// methods routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) CreateSubServer(
+	configRegistry lnrpc.SubServerConfigDispatcher) (lnrpc.SubServer,
+	lnrpc.MacaroonPerms, error) {
+
+	subServer, macPermissions, err := createNewSubServer(configRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.OffersServer = subServer
+	return subServer, macPermissions, nil
+}
+
+// CreateOffer creates a new offer that is paid to our node.
+func (s *Server) CreateOffer(_ context.Context,
+	req *CreateOfferRequest) (*CreateOfferResponse, error) {
+
+	if s.cfg.OffersManager == nil {
+		return nil, ErrOffersDisabled
+	}
+
+	offer, err := s.cfg.OffersManager.CreateOffer(&offers.OfferParams{
+		Amount:      lnwire.MilliSatoshi(req.AmountMsat),
+		Description: req.Description,
+		Issuer:      req.Issuer,
+		Expiry:      time.Duration(req.Expiry) * time.Second,
+		HasQuantity: req.HasQuantity,
+		QuantityMax: req.QuantityMax,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateOfferResponse{Offer: encoded}, nil
+}
+
+// DecodeOffer decodes a bech32 encoded offer.
+func (s *Server) DecodeOffer(_ context.Context,
+	req *DecodeOfferRequest) (*Offer, error) {
+
+	offer, err := bolt12.DecodeOffer(req.Offer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer: %w", err)
+	}
+
+	return marshalOffer(offer), nil
+}
+
+// PayOffer requests an invoice for an offer from its issuer and pays it.
+func (s *Server) PayOffer(_ context.Context,
+	req *PayOfferRequest) (*PayOfferResponse, error) {
+
+	manager := s.cfg.OffersManager
+	if manager == nil {
+		return nil, ErrOffersDisabled
+	}
+
+	offer, err := bolt12.DecodeOffer(req.Offer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer: %w", err)
+	}
+
+	invoice, err := manager.FetchInvoice(&offers.FetchRequest{
+		Offer:     offer,
+		Amount:    lnwire.MilliSatoshi(req.AmtMsat),
+		Quantity:  req.Quantity,
+		PayerNote: req.PayerNote,
+		Timeout:   time.Duration(req.TimeoutSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch invoice: %w", err)
+	}
+
+	encoded, err := invoice.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Paying invoice for offer: %v", encoded)
+
+	feeLimit := lnrpc.CalculateFeeLimit(req.FeeLimit, invoice.Amount)
+	preimage, err := manager.PayInvoice(invoice, feeLimit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to pay invoice: %w", err)
+	}
+
+	return &PayOfferResponse{
+		Invoice:         encoded,
+		PaymentHash:     invoice.PaymentHash[:],
+		PaymentPreimage: preimage[:],
+		AmtMsat:         uint64(invoice.Amount),
+	}, nil
+}
+
+// marshalOffer converts an offer into its rpc form.
+func marshalOffer(offer *bolt12.Offer) *Offer {
+	rpcOffer := &Offer{
+		Metadata:    offer.Metadata,
+		Currency:    offer.Currency,
+		Amount:      offer.Amount,
+		Description: offer.Description,
+		Issuer:      offer.Issuer,
+		HasQuantity: offer.HasQuantity,
+		QuantityMax: offer.QuantityMax,
+	}
+
+	for _, chain := range offer.Chains {
+		chain := chain
+		rpcOffer.Chains = append(rpcOffer.Chains, chain[:])
+	}
+
+	if offer.Features != nil {
+		features := lnwire.NewFeatureVector(
+			offer.Features, lnwire.Features,
+		)
+		rpcOffer.Features = invoicesrpc.CreateRPCFeatures(features)
+	}
+
+	if !offer.AbsoluteExpiry.IsZero() {
+		rpcOffer.AbsoluteExpiry = offer.AbsoluteExpiry.Unix()
+	}
+
+	for _, path := range offer.Paths {
+		rpcOffer.Paths = append(
+			rpcOffer.Paths, marshalBlindedPath(path),
+		)
+	}
+
+	if offer.IssuerID != nil {
+		rpcOffer.IssuerId = offer.IssuerID.SerializeCompressed()
+	}
+
+	return rpcOffer
+}
+
+// marshalBlindedPath converts a blinded path into its rpc form.
+func marshalBlindedPath(path *sphinx.BlindedPath) *lnrpc.BlindedPath {
+	hops := make([]*lnrpc.BlindedHop, len(path.BlindedHops))
+	for i, hop := range path.BlindedHops {
+		hops[i] = &lnrpc.BlindedHop{
+			BlindedNode:   hop.BlindedNodePub.SerializeCompressed(),
+			EncryptedData: hop.CipherText,
+		}
+	}
+
+	return &lnrpc.BlindedPath{
+		IntroductionNode: path.IntroductionPoint.SerializeCompressed(),
+		BlindingPoint:    path.BlindingPoint.SerializeCompressed(),
+		BlindedHops:      hops,
+	}
+}
@@ -0,0 +1,20 @@
+//go:build onionmsgrpc
+// +build onionmsgrpc
+
+package onionmsgrpc
+
+import (
+	"github.com/lightningnetwork/lnd/onionmessage"
+)
+
+// Config is the primary configuration struct for the onion message RPC
+// subserver. It contains all the items required for the server to carry out
+// its duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields
+// MUST also be specified.
+type Config struct {
+	// OnionMessenger is used to send onion messages and to subscribe to
+	// the ones that are addressed to our node. It is nil if onion
+	// messages are disabled.
+	OnionMessenger *onionmessage.Messenger
+}
@@ -0,0 +1,7 @@
+//go:build !onionmsgrpc
+// +build !onionmsgrpc
+
+package onionmsgrpc
+
+// Config is empty for non-onionmsgrpc builds.
+type Config struct{}
@@ -0,0 +1,375 @@
+//go:build onionmsgrpc
+// +build onionmsgrpc
+
+package onionmsgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/tlv"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize it as the name of our
+	// RPC service.
+	subServerName = "OnionMsgRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/onionmsgrpc.OnionMessenger/SendOnionMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/onionmsgrpc.OnionMessenger/SubscribeOnionMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+	}
+
+	// ErrOnionMessagesDisabled is returned when the onion message RPCs
+	// are called while onion messages are disabled.
+	ErrOnionMessagesDisabled = errors.New("onion messages are disabled, " +
+		"set protocol.onion-messages to enable them")
+
+	// errServerShuttingDown is returned when the server is shutting down.
+	errServerShuttingDown = errors.New("onion message RPC server " +
+		"shutting down")
+)
+
+// ServerShell is a shell struct holding a reference to the actual sub-server.
+// It is used to register the gRPC sub-server with the root server before we
+// have the necessary dependencies to populate the actual sub-server.
+type ServerShell struct {
+	OnionMessengerServer
+}
+
+// Server is a sub-server of the main RPC server: the onion message RPC. This
+// sub RPC server allows sending and receiving onion messages.
+type Server struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	// Required by the grpc-gateway/v2 library for forward compatibility.
+	// Must be after the atomically used variables to not break struct
+	// alignment.
+	UnimplementedOnionMessengerServer
+
+	cfg *Config
+
+	quit chan struct{}
+}
+
+// A compile time check to ensure that Server fully implements the
+// OnionMessengerServer gRPC service.
+var _ OnionMessengerServer = (*Server)(nil)
+
+// New returns a new instance of the onionmsgrpc OnionMessenger sub-server.
+// We also return the set of permissions for the macaroons that we may create
+// within this method.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	server := &Server{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+
+	return server, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	if atomic.AddInt32(&s.shutdown, 1) != 1 {
+		return nil
+	}
+
+	close(s.quit)
+
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have
+// requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterOnionMessengerServer(grpcServer, r)
+
+	log.Debugf("Onion message RPC server successfully register with root " +
+		"gRPC server")
+
+	return nil
+}
+
+// RegisterWithRestServer will be called by the root REST mux to direct a sub
+// RPC server to register itself with the main REST mux server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRestServer(ctx context.Context,
+	mux *runtime.ServeMux, dest string, opts []grpc.DialOption) error {
+
+	// We make sure that we register it with the main REST server to ensure
+	// all our methods are routed properly.
+	err := RegisterOnionMessengerHandlerFromEndpoint(ctx, mux, dest, opts)
+	if err != nil {
+		log.Errorf("Could not register onion message REST server "+
+			"with root REST server: %v", err)
+		return err
+	}
+
+	log.Debugf("Onion message REST server successfully registered with " +
+		"root REST server")
+	return nil
+}
+
+// CreateSubServer populates the subserver's dependencies using the passed
+// SubServerConfigDispatcher. This method should fully initialize the
+// sub-server instance, making it ready for action. It returns the macaroon
+// permissions that the sub-server wishes to pass on to the root server for all
+// methods routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) CreateSubServer(
+	configRegistry lnrpc.SubServerConfigDispatcher) (lnrpc.SubServer,
+	lnrpc.MacaroonPerms, error) {
+
+	subServer, macPermissions, err := createNewSubServer(configRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.OnionMessengerServer = subServer
+	return subServer, macPermissions, nil
+}
+
+// SendOnionMessage sends an onion message along the given path or to a
+// blinded destination.
+func (s *Server) SendOnionMessage(_ context.Context,
+	req *SendOnionMessageRequest) (*SendOnionMessageResponse, error) {
+
+	messenger := s.cfg.OnionMessenger
+	if messenger == nil {
+		return nil, ErrOnionMessagesDisabled
+	}
+
+	path, err := unmarshalPubKeys(req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %w", err)
+	}
+
+	sendReq := &onionmessage.SendRequest{
+		Path:         path,
+		FinalHopTLVs: make(map[tlv.Type][]byte, len(req.FinalHopTlvs)),
+	}
+
+	if req.BlindedDestination != nil {
+		sendReq.BlindedDestination, err = unmarshalBlindedPath(
+			req.BlindedDestination,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded destination: "+
+				"%w", err)
+		}
+	}
+
+	if req.ReplyPath != nil {
+		hops, err := unmarshalPubKeys(req.ReplyPath.Hops)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply path hops: %w",
+				err)
+		}
+
+		sendReq.ReplyPath, err = messenger.BuildReplyPath(
+			hops, req.ReplyPath.PathId,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build reply path: "+
+				"%w", err)
+		}
+	}
+
+	for tlvType, value := range req.FinalHopTlvs {
+		sendReq.FinalHopTLVs[tlv.Type(tlvType)] = value
+	}
+
+	if err := messenger.SendMessage(sendReq); err != nil {
+		return nil, err
+	}
+
+	return &SendOnionMessageResponse{}, nil
+}
+
+// SubscribeOnionMessages delivers the onion messages that are addressed to
+// our node to the client.
+func (s *Server) SubscribeOnionMessages(_ *SubscribeOnionMessagesRequest,
+	stream OnionMessenger_SubscribeOnionMessagesServer) error {
+
+	if s.cfg.OnionMessenger == nil {
+		return ErrOnionMessagesDisabled
+	}
+
+	client, err := s.cfg.OnionMessenger.SubscribeMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*onionmessage.ReceivedMessage)
+			if !ok {
+				return fmt.Errorf("unexpected update type: %T",
+					update)
+			}
+
+			if err := stream.Send(marshalMessage(msg)); err != nil {
+				return err
+			}
+
+		// If the stream's context is cancelled, return an error.
+		case <-stream.Context().Done():
+			log.Debugf("Onion message stream cancelled")
+			return stream.Context().Err()
+
+		// If the subscribe client terminates, exit with an error.
+		case <-client.Quit():
+			return errors.New("onion message subscription " +
+				"terminated")
+
+		// If the server has been signalled to shut down, exit.
+		case <-s.quit:
+			return errServerShuttingDown
+		}
+	}
+}
+
+// marshalMessage converts a received onion message into its rpc form.
+func marshalMessage(msg *onionmessage.ReceivedMessage) *OnionMessageUpdate {
+	update := &OnionMessageUpdate{
+		PathId:       msg.PathID,
+		FinalHopTlvs: make(map[uint64][]byte, len(msg.FinalHopTLVs)),
+	}
+
+	if msg.ReplyPath != nil {
+		update.ReplyPath = marshalBlindedPath(msg.ReplyPath)
+	}
+
+	for tlvType, value := range msg.FinalHopTLVs {
+		update.FinalHopTlvs[uint64(tlvType)] = value
+	}
+
+	return update
+}
+
+// marshalBlindedPath converts a blinded path into its rpc form.
+func marshalBlindedPath(path *sphinx.BlindedPath) *lnrpc.BlindedPath {
+	hops := make([]*lnrpc.BlindedHop, len(path.BlindedHops))
+	for i, hop := range path.BlindedHops {
+		hops[i] = &lnrpc.BlindedHop{
+			BlindedNode:   hop.BlindedNodePub.SerializeCompressed(),
+			EncryptedData: hop.CipherText,
+		}
+	}
+
+	return &lnrpc.BlindedPath{
+		IntroductionNode: path.IntroductionPoint.SerializeCompressed(),
+		BlindingPoint:    path.BlindingPoint.SerializeCompressed(),
+		BlindedHops:      hops,
+	}
+}
+
+// unmarshalBlindedPath converts the rpc form of a blinded path into a
+// blinded path.
+func unmarshalBlindedPath(rpcPath *lnrpc.BlindedPath) (*sphinx.BlindedPath,
+	error) {
+
+	introduction, err := btcec.ParsePubKey(rpcPath.IntroductionNode)
+	if err != nil {
+		return nil, err
+	}
+
+	blinding, err := btcec.ParsePubKey(rpcPath.BlindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rpcPath.BlindedHops) == 0 {
+		return nil, errors.New("at least 1 blinded hop required")
+	}
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: introduction,
+		BlindingPoint:     blinding,
+		BlindedHops: make(
+			[]*sphinx.BlindedHopInfo, len(rpcPath.BlindedHops),
+		),
+	}
+
+	for i, hop := range rpcPath.BlindedHops {
+		nodePub, err := btcec.ParsePubKey(hop.BlindedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops[i] = &sphinx.BlindedHopInfo{
+			BlindedNodePub: nodePub,
+			CipherText:     hop.EncryptedData,
+		}
+	}
+
+	return path, nil
+}
+
+// unmarshalPubKeys parses a list of serialized public keys.
+func unmarshalPubKeys(keys [][]byte) ([]*btcec.PublicKey, error) {
+	pubKeys := make([]*btcec.PublicKey, len(keys))
+	for i, key := range keys {
+		pubKey, err := btcec.ParsePubKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKeys[i] = pubKey
+	}
+
+	return pubKeys, nil
+}
@@ -2,6 +2,7 @@ package routerrpc
 
 import (
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/prober"
 	"github.com/lightningnetwork/lnd/routing"
 )
 
@@ -37,6 +38,9 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// Prober runs the probe campaigns that warm up mission control.
+	Prober *prober.Prober
 }
 
 // DefaultConfig defines the config defaults.
@@ -49,6 +53,10 @@ func DefaultConfig() *Config {
 		AttemptCostPPM:  routing.DefaultAttemptCostPPM,
 		MaxMcHistory:    routing.DefaultMaxMcHistory,
 		McFlushInterval: routing.DefaultMcFlushInterval,
+		Splitter:        routing.DefaultSplitter,
+		TraceRetention:  routing.DefaultPaymentTraceRetention,
+
+		ProbeCampaignRetention: prober.DefaultCampaignRetention,
 		AprioriConfig: &AprioriConfig{
 			HopProbability:   routing.DefaultAprioriHopProbability,
 			Weight:           routing.DefaultAprioriWeight,
@@ -76,6 +84,9 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		AttemptCostPPM:           cfg.AttemptCostPPM,
 		MaxMcHistory:             cfg.MaxMcHistory,
 		McFlushInterval:          cfg.McFlushInterval,
+		Splitter:                 cfg.Splitter,
+		TraceRetention:           cfg.TraceRetention,
+		ProbeCampaignRetention:   cfg.ProbeCampaignRetention,
 		AprioriConfig: &AprioriConfig{
 			HopProbability:   cfg.AprioriConfig.HopProbability,
 			Weight:           cfg.AprioriConfig.Weight,
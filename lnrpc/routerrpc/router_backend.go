@@ -56,6 +56,22 @@ type RouterBackend struct {
 	FetchChannelEndpoints func(chanID uint64) (route.Vertex,
 		route.Vertex, error)
 
+	// FetchNodeFeatures returns the features that the given node announced
+	// in the graph. An empty feature vector is returned for unknown nodes.
+	FetchNodeFeatures func(route.Vertex) (*lnwire.FeatureVector, error)
+
+	// FetchTorOnlyNodes returns the nodes in the graph that only announce
+	// Tor addresses.
+	FetchTorOnlyNodes func() (map[route.Vertex]struct{}, error)
+
+	// FetchASNNodes returns the nodes in the graph that announce an IP
+	// address in any of the given autonomous systems.
+	FetchASNNodes func(asns []uint32) (map[route.Vertex]struct{}, error)
+
+	// FetchPaymentTrace returns the path finding trace of the payment with
+	// the given hash.
+	FetchPaymentTrace func(lntypes.Hash) (*channeldb.PaymentTrace, error)
+
 	// FindRoute is a closure that abstracts away how we locate/query for
 	// routes.
 	FindRoute func(*routing.RouteRequest) (*route.Route, float64, error)
@@ -401,6 +417,21 @@ func (r *RouterBackend) parseQueryRoutesRequest(in *lnrpc.QueryRoutesRequest) (
 		restrictions.LastHop = &lastHop
 	}
 
+	// Pass along the constraints on the nodes that the route may pass
+	// through.
+	restrictions.AvoidNodes, err = r.fetchAddrAvoidedNodes(
+		in.AvoidTorOnly, in.AvoidAsns,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions.PreferredNodes, err = unmarshallNodeSet(in.PreferredNodes)
+	if err != nil {
+		return nil, err
+	}
+	restrictions.MaxHops = in.MaxHops
+
 	// If we have any TLV records destined for the final hop, then we'll
 	// attempt to decode them now into a form that the router can more
 	// easily manipulate.
@@ -768,6 +799,9 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	}
 	payIntent.TimePref = rpcPayReq.TimePref
 
+	// Pass along whether the path finding runs of the payment are traced.
+	payIntent.Trace = rpcPayReq.TracePathfinding
+
 	// Pass along restrictions on the outgoing channels that may be used.
 	payIntent.OutgoingChannelIDs = rpcPayReq.OutgoingChanIds
 
@@ -1090,6 +1124,23 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = features
 	}
 
+	// If a trampoline node is specified, the payment is sent to the
+	// trampoline node, which pays the destination on our behalf.
+	if len(rpcPayReq.TrampolineNode) > 0 {
+		err := r.routeViaTrampoline(payIntent, rpcPayReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Pass along the constraints on the nodes that the routes of the
+	// payment may pass through. They are checked against the trampoline
+	// node if the payment is sent via trampoline.
+	err = r.applyNodeConstraints(payIntent, rpcPayReq)
+	if err != nil {
+		return nil, err
+	}
+
 	// Do bounds checking with the block padding so the router isn't
 	// left with a zombie payment in case the user messes up.
 	err = routing.ValidateCLTVLimit(
@@ -1107,6 +1158,157 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// routeViaTrampoline turns the payment into a payment to the trampoline node
+// of the request. The features of the trampoline node are taken from the graph
+// if it is known.
+func (r *RouterBackend) routeViaTrampoline(payIntent *routing.LightningPayment,
+	rpcPayReq *SendPaymentRequest) error {
+
+	node, err := route.NewVertexFromBytes(rpcPayReq.TrampolineNode)
+	if err != nil {
+		return err
+	}
+
+	var features *lnwire.FeatureVector
+	if r.FetchNodeFeatures != nil {
+		features, err = r.FetchNodeFeatures(node)
+		if err != nil {
+			return err
+		}
+
+		// Without a node announcement we don't know the features of
+		// the trampoline node, so we assume that it supports
+		// trampoline routing.
+		if features != nil && len(features.Features()) == 0 {
+			features = nil
+		}
+	}
+
+	fee := lnwire.MilliSatoshi(rpcPayReq.TrampolineFeeMsat)
+	if fee == 0 {
+		fee = routing.DefaultTrampolineFee(payIntent.Amount)
+	}
+
+	if rpcPayReq.TrampolineCltvDelta > math.MaxUint16 {
+		return errors.New("trampoline_cltv_delta out of range")
+	}
+
+	cltvDelta := uint16(rpcPayReq.TrampolineCltvDelta)
+	if cltvDelta == 0 {
+		cltvDelta = routing.DefaultTrampolineCltvDelta
+	}
+
+	return payIntent.RouteViaTrampoline(node, features, fee, cltvDelta)
+}
+
+// applyNodeConstraints sets the nodes that the routes of the payment must
+// avoid or should prefer, and the maximum number of hops of the routes.
+func (r *RouterBackend) applyNodeConstraints(
+	payIntent *routing.LightningPayment,
+	rpcPayReq *SendPaymentRequest) error {
+
+	avoidNodes, err := unmarshallNodeSet(rpcPayReq.AvoidNodes)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := avoidNodes[payIntent.Target]; ok {
+		return errors.New("cannot avoid the payment target")
+	}
+
+	// The target may be among the nodes that are avoided by their
+	// addresses, which is fine because path finding never avoids it.
+	addrAvoided, err := r.fetchAddrAvoidedNodes(
+		rpcPayReq.AvoidTorOnly, rpcPayReq.AvoidAsns,
+	)
+	if err != nil {
+		return err
+	}
+
+	if avoidNodes == nil {
+		avoidNodes = addrAvoided
+	} else {
+		for node := range addrAvoided {
+			avoidNodes[node] = struct{}{}
+		}
+	}
+
+	preferredNodes, err := unmarshallNodeSet(rpcPayReq.PreferredNodes)
+	if err != nil {
+		return err
+	}
+
+	payIntent.AvoidNodes = avoidNodes
+	payIntent.PreferredNodes = preferredNodes
+	payIntent.MaxHops = rpcPayReq.MaxHops
+
+	return nil
+}
+
+// fetchAddrAvoidedNodes returns the nodes in the graph that are avoided
+// because of the addresses that they announce: Tor-only nodes if avoidTorOnly
+// is set, and nodes that announce an IP address in any of the given ASNs. Nil
+// is returned if no nodes are avoided by their addresses.
+func (r *RouterBackend) fetchAddrAvoidedNodes(avoidTorOnly bool,
+	asns []uint32) (map[route.Vertex]struct{}, error) {
+
+	var nodes map[route.Vertex]struct{}
+	if avoidTorOnly {
+		if r.FetchTorOnlyNodes == nil {
+			return nil, errors.New("avoiding Tor-only nodes is " +
+				"not supported")
+		}
+
+		torOnlyNodes, err := r.FetchTorOnlyNodes()
+		if err != nil {
+			return nil, err
+		}
+		nodes = torOnlyNodes
+	}
+
+	if len(asns) == 0 {
+		return nodes, nil
+	}
+
+	if r.FetchASNNodes == nil {
+		return nil, errors.New("avoiding nodes by ASN is not supported")
+	}
+
+	asnNodes, err := r.FetchASNNodes(asns)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes == nil {
+		return asnNodes, nil
+	}
+
+	for node := range asnNodes {
+		nodes[node] = struct{}{}
+	}
+
+	return nodes, nil
+}
+
+// unmarshallNodeSet parses a list of node public keys into a set. Nil is
+// returned for an empty list.
+func unmarshallNodeSet(pubKeys [][]byte) (map[route.Vertex]struct{}, error) {
+	if len(pubKeys) == 0 {
+		return nil, nil
+	}
+
+	nodes := make(map[route.Vertex]struct{}, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		node, err := route.NewVertexFromBytes(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		nodes[node] = struct{}{}
+	}
+
+	return nodes, nil
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
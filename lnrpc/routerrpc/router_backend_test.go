@@ -66,6 +66,9 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 	var (
 		lastHop      = route.Vertex{64}
 		outgoingChan = uint64(383322)
+		torOnlyNode  = route.Vertex{65}
+		preferred    = route.Vertex{66}
+		asnNode      = route.Vertex{67}
 	)
 
 	hintNode, err := route.NewVertexFromStr(hintNodeKey)
@@ -101,6 +104,10 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 		OutgoingChanId:    outgoingChan,
 		DestFeatures:      []lnrpc.FeatureBit{lnrpc.FeatureBit_MPP_OPT},
 		RouteHints:        rpcRouteHints,
+		AvoidTorOnly:      true,
+		AvoidAsns:         []uint32{24940},
+		PreferredNodes:    [][]byte{preferred[:]},
+		MaxHops:           4,
 	}
 
 	amtSat := int64(100000)
@@ -165,6 +172,11 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 			t.Fatal("unexpected outgoing channel id")
 		}
 
+		require.Contains(t, restrictions.AvoidNodes, torOnlyNode)
+		require.Contains(t, restrictions.AvoidNodes, asnNode)
+		require.Contains(t, restrictions.PreferredNodes, preferred)
+		require.EqualValues(t, 4, restrictions.MaxHops)
+
 		if !restrictions.DestFeatures.HasFeature(lnwire.MPPOptional) {
 			t.Fatal("unexpected dest features")
 		}
@@ -216,6 +228,20 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 			}
 			return route.Vertex{1}, route.Vertex{2}, nil
 		},
+		FetchTorOnlyNodes: func() (map[route.Vertex]struct{}, error) {
+			return map[route.Vertex]struct{}{
+				torOnlyNode: {},
+			}, nil
+		},
+		FetchASNNodes: func(asns []uint32) (map[route.Vertex]struct{},
+			error) {
+
+			require.Equal(t, []uint32{24940}, asns)
+
+			return map[route.Vertex]struct{}{
+				asnNode: {},
+			}, nil
+		},
 	}
 
 	// If this is set, we'll populate MaxTotalTimelock. If this is not set,
@@ -241,6 +267,81 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 	}
 }
 
+// TestApplyNodeConstraints tests that the node constraints of a send request
+// are passed along to the payment.
+func TestApplyNodeConstraints(t *testing.T) {
+	t.Parallel()
+
+	var (
+		target      = route.Vertex{1}
+		avoided     = route.Vertex{2}
+		torOnlyNode = route.Vertex{3}
+		preferred   = route.Vertex{4}
+		asnNode     = route.Vertex{5}
+	)
+
+	backend := &RouterBackend{
+		FetchTorOnlyNodes: func() (map[route.Vertex]struct{}, error) {
+			return map[route.Vertex]struct{}{
+				torOnlyNode: {},
+			}, nil
+		},
+		FetchASNNodes: func(asns []uint32) (map[route.Vertex]struct{},
+			error) {
+
+			return map[route.Vertex]struct{}{
+				asnNode: {},
+			}, nil
+		},
+	}
+
+	payIntent := &routing.LightningPayment{Target: target}
+	err := backend.applyNodeConstraints(payIntent, &SendPaymentRequest{
+		AvoidNodes:     [][]byte{avoided[:]},
+		AvoidTorOnly:   true,
+		AvoidAsns:      []uint32{24940},
+		PreferredNodes: [][]byte{preferred[:]},
+		MaxHops:        3,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, map[route.Vertex]struct{}{
+		avoided:     {},
+		torOnlyNode: {},
+		asnNode:     {},
+	}, payIntent.AvoidNodes)
+	require.Equal(t, map[route.Vertex]struct{}{
+		preferred: {},
+	}, payIntent.PreferredNodes)
+	require.EqualValues(t, 3, payIntent.MaxHops)
+
+	// Without constraints, the payment isn't restricted.
+	payIntent = &routing.LightningPayment{Target: target}
+	err = backend.applyNodeConstraints(payIntent, &SendPaymentRequest{})
+	require.NoError(t, err)
+	require.Nil(t, payIntent.AvoidNodes)
+	require.Nil(t, payIntent.PreferredNodes)
+
+	// The target of the payment can't be avoided.
+	err = backend.applyNodeConstraints(payIntent, &SendPaymentRequest{
+		AvoidNodes: [][]byte{target[:]},
+	})
+	require.Error(t, err)
+
+	// Malformed public keys are rejected.
+	err = backend.applyNodeConstraints(payIntent, &SendPaymentRequest{
+		PreferredNodes: [][]byte{{1, 2, 3}},
+	})
+	require.Error(t, err)
+
+	// Nodes can't be avoided by ASN without an ASN database.
+	backend.FetchASNNodes = nil
+	err = backend.applyNodeConstraints(payIntent, &SendPaymentRequest{
+		AvoidAsns: []uint32{24940},
+	})
+	require.Error(t, err)
+}
+
 type mockMissionControl struct {
 	MissionControl
 }
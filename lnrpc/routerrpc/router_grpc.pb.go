@@ -100,6 +100,27 @@ type RouterClient interface {
 	// channel to stay disabled until a subsequent manual request of either
 	// "enable" or "auto".
 	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	//
+	// StartProbeCampaign starts a campaign that periodically sends probes with a
+	// random payment hash to a set of target nodes, until its budget is spent.
+	// The probes can't be settled, but mission control learns from their
+	// attempts like from real payments.
+	StartProbeCampaign(ctx context.Context, in *StartProbeCampaignRequest, opts ...grpc.CallOption) (*StartProbeCampaignResponse, error)
+	//
+	// StopProbeCampaign stops a probe campaign. A probe that is in flight is
+	// still resolved.
+	StopProbeCampaign(ctx context.Context, in *StopProbeCampaignRequest, opts ...grpc.CallOption) (*StopProbeCampaignResponse, error)
+	//
+	// ListProbeCampaigns returns the probe campaigns that were started since the
+	// node was started, together with the latest probe results.
+	ListProbeCampaigns(ctx context.Context, in *ListProbeCampaignsRequest, opts ...grpc.CallOption) (*ListProbeCampaignsResponse, error)
+	//
+	// GetPaymentTrace returns the path finding trace of a payment that was sent
+	// with trace_pathfinding set. The trace describes every path finding run of
+	// the payment: the edges that were rejected and why, the success
+	// probabilities that were used and the mission control entries that were
+	// consulted. Traces are kept for the configured retention window.
+	GetPaymentTrace(ctx context.Context, in *GetPaymentTraceRequest, opts ...grpc.CallOption) (*PaymentTrace, error)
 }
 
 type routerClient struct {
@@ -435,6 +456,42 @@ func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatu
 	return out, nil
 }
 
+func (c *routerClient) StartProbeCampaign(ctx context.Context, in *StartProbeCampaignRequest, opts ...grpc.CallOption) (*StartProbeCampaignResponse, error) {
+	out := new(StartProbeCampaignResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/StartProbeCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) StopProbeCampaign(ctx context.Context, in *StopProbeCampaignRequest, opts ...grpc.CallOption) (*StopProbeCampaignResponse, error) {
+	out := new(StopProbeCampaignResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/StopProbeCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListProbeCampaigns(ctx context.Context, in *ListProbeCampaignsRequest, opts ...grpc.CallOption) (*ListProbeCampaignsResponse, error) {
+	out := new(ListProbeCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListProbeCampaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) GetPaymentTrace(ctx context.Context, in *GetPaymentTraceRequest, opts ...grpc.CallOption) (*PaymentTrace, error) {
+	out := new(PaymentTrace)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetPaymentTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -520,6 +577,27 @@ type RouterServer interface {
 	// channel to stay disabled until a subsequent manual request of either
 	// "enable" or "auto".
 	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	//
+	// StartProbeCampaign starts a campaign that periodically sends probes with a
+	// random payment hash to a set of target nodes, until its budget is spent.
+	// The probes can't be settled, but mission control learns from their
+	// attempts like from real payments.
+	StartProbeCampaign(context.Context, *StartProbeCampaignRequest) (*StartProbeCampaignResponse, error)
+	//
+	// StopProbeCampaign stops a probe campaign. A probe that is in flight is
+	// still resolved.
+	StopProbeCampaign(context.Context, *StopProbeCampaignRequest) (*StopProbeCampaignResponse, error)
+	//
+	// ListProbeCampaigns returns the probe campaigns that were started since the
+	// node was started, together with the latest probe results.
+	ListProbeCampaigns(context.Context, *ListProbeCampaignsRequest) (*ListProbeCampaignsResponse, error)
+	//
+	// GetPaymentTrace returns the path finding trace of a payment that was sent
+	// with trace_pathfinding set. The trace describes every path finding run of
+	// the payment: the edges that were rejected and why, the success
+	// probabilities that were used and the mission control entries that were
+	// consulted. Traces are kept for the configured retention window.
+	GetPaymentTrace(context.Context, *GetPaymentTraceRequest) (*PaymentTrace, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -581,6 +659,18 @@ func (UnimplementedRouterServer) HtlcInterceptor(Router_HtlcInterceptorServer) e
 func (UnimplementedRouterServer) UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChanStatus not implemented")
 }
+func (UnimplementedRouterServer) StartProbeCampaign(context.Context, *StartProbeCampaignRequest) (*StartProbeCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartProbeCampaign not implemented")
+}
+func (UnimplementedRouterServer) StopProbeCampaign(context.Context, *StopProbeCampaignRequest) (*StopProbeCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopProbeCampaign not implemented")
+}
+func (UnimplementedRouterServer) ListProbeCampaigns(context.Context, *ListProbeCampaignsRequest) (*ListProbeCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProbeCampaigns not implemented")
+}
+func (UnimplementedRouterServer) GetPaymentTrace(context.Context, *GetPaymentTraceRequest) (*PaymentTrace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentTrace not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -944,6 +1034,78 @@ func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_StartProbeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartProbeCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).StartProbeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/StartProbeCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).StartProbeCampaign(ctx, req.(*StartProbeCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_StopProbeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopProbeCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).StopProbeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/StopProbeCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).StopProbeCampaign(ctx, req.(*StopProbeCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListProbeCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProbeCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListProbeCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListProbeCampaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListProbeCampaigns(ctx, req.(*ListProbeCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_GetPaymentTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).GetPaymentTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/GetPaymentTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).GetPaymentTrace(ctx, req.(*GetPaymentTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -995,6 +1157,22 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChanStatus",
 			Handler:    _Router_UpdateChanStatus_Handler,
 		},
+		{
+			MethodName: "StartProbeCampaign",
+			Handler:    _Router_StartProbeCampaign_Handler,
+		},
+		{
+			MethodName: "StopProbeCampaign",
+			Handler:    _Router_StopProbeCampaign_Handler,
+		},
+		{
+			MethodName: "ListProbeCampaigns",
+			Handler:    _Router_ListProbeCampaigns_Handler,
+		},
+		{
+			MethodName: "GetPaymentTrace",
+			Handler:    _Router_GetPaymentTrace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
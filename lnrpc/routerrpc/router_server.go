@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/prober"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/grpc"
@@ -128,6 +130,22 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/StartProbeCampaign": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/StopProbeCampaign": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/ListProbeCampaigns": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/GetPaymentTrace": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -1133,3 +1151,227 @@ func (s *Server) UpdateChanStatus(ctx context.Context,
 	}
 	return &UpdateChanStatusResponse{}, nil
 }
+
+// StartProbeCampaign starts a campaign that periodically probes a set of
+// target nodes to teach mission control about the routes to them.
+func (s *Server) StartProbeCampaign(_ context.Context,
+	req *StartProbeCampaignRequest) (*StartProbeCampaignResponse, error) {
+
+	if s.cfg.Prober == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"prober not available")
+	}
+
+	targets := make([]route.Vertex, 0, len(req.Targets))
+	for _, target := range req.Targets {
+		vertex, err := route.NewVertexFromBytes(target)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, vertex)
+	}
+
+	id, err := s.cfg.Prober.StartCampaign(prober.CampaignConfig{
+		Targets:       targets,
+		OutgoingChans: req.OutgoingChanIds,
+		Amount:        lnwire.MilliSatoshi(req.AmtMsat),
+		FeeLimit:      lnwire.MilliSatoshi(req.FeeLimitMsat),
+		Interval: time.Duration(req.IntervalSeconds) *
+			time.Second,
+		MaxProbes: req.MaxProbes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartProbeCampaignResponse{
+		CampaignId: id,
+	}, nil
+}
+
+// StopProbeCampaign stops a probe campaign.
+func (s *Server) StopProbeCampaign(_ context.Context,
+	req *StopProbeCampaignRequest) (*StopProbeCampaignResponse, error) {
+
+	if s.cfg.Prober == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"prober not available")
+	}
+
+	err := s.cfg.Prober.StopCampaign(req.CampaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StopProbeCampaignResponse{}, nil
+}
+
+// ListProbeCampaigns returns the probe campaigns and their latest results.
+func (s *Server) ListProbeCampaigns(_ context.Context,
+	_ *ListProbeCampaignsRequest) (*ListProbeCampaignsResponse, error) {
+
+	if s.cfg.Prober == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"prober not available")
+	}
+
+	campaigns := s.cfg.Prober.Campaigns()
+	resp := &ListProbeCampaignsResponse{
+		Campaigns: make([]*ProbeCampaign, 0, len(campaigns)),
+	}
+	for _, campaign := range campaigns {
+		resp.Campaigns = append(
+			resp.Campaigns, marshallProbeCampaign(campaign),
+		)
+	}
+
+	return resp, nil
+}
+
+// marshallProbeCampaign converts a probe campaign to its rpc representation.
+func marshallProbeCampaign(campaign *prober.CampaignInfo) *ProbeCampaign {
+	cfg := campaign.Config
+
+	rpcCampaign := &ProbeCampaign{
+		CampaignId:      campaign.ID,
+		Targets:         make([][]byte, 0, len(cfg.Targets)),
+		OutgoingChanIds: cfg.OutgoingChans,
+		AmtMsat:         uint64(cfg.Amount),
+		FeeLimitMsat:    uint64(cfg.FeeLimit),
+		IntervalSeconds: uint32(cfg.Interval / time.Second),
+		MaxProbes:       cfg.MaxProbes,
+		Active:          campaign.Active,
+		ProbesSent:      campaign.ProbesSent,
+		ProbesReached:   campaign.ProbesReached,
+		Results: make(
+			[]*ProbeResult, 0, len(campaign.Results),
+		),
+	}
+
+	for _, target := range cfg.Targets {
+		target := target
+		rpcCampaign.Targets = append(rpcCampaign.Targets, target[:])
+	}
+
+	for _, result := range campaign.Results {
+		target := result.Target
+		rpcCampaign.Results = append(rpcCampaign.Results, &ProbeResult{
+			Target:         target[:],
+			OutgoingChanId: result.OutgoingChan,
+			Reached:        result.Reached,
+			Failure:        result.Failure,
+			Timestamp:      result.Time.Unix(),
+		})
+	}
+
+	return rpcCampaign
+}
+
+// GetPaymentTrace returns the path finding trace of a traced payment.
+func (s *Server) GetPaymentTrace(_ context.Context,
+	req *GetPaymentTraceRequest) (*PaymentTrace, error) {
+
+	if s.cfg.RouterBackend.FetchPaymentTrace == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"payment traces not available")
+	}
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := s.cfg.RouterBackend.FetchPaymentTrace(paymentHash)
+	switch {
+	case errors.Is(err, channeldb.ErrPaymentTraceNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	rpcTrace := &PaymentTrace{
+		PaymentHash:    trace.PaymentIdentifier[:],
+		CreationTimeNs: trace.CreationTime.UnixNano(),
+		PathFindings: make(
+			[]*PathFindingTrace, 0, len(trace.PathFindings),
+		),
+	}
+
+	for _, pathFinding := range trace.PathFindings {
+		rpcTrace.PathFindings = append(
+			rpcTrace.PathFindings,
+			marshallPathFindingTrace(pathFinding),
+		)
+	}
+
+	return rpcTrace, nil
+}
+
+// marshallPathFindingTrace converts a path finding trace to its rpc
+// representation.
+func marshallPathFindingTrace(
+	trace *channeldb.PathFindingTrace) *PathFindingTrace {
+
+	rpcTrace := &PathFindingTrace{
+		TimeNs:          trace.Time.UnixNano(),
+		AmtMsat:         uint64(trace.Amount),
+		FeeLimitMsat:    uint64(trace.FeeLimit),
+		CltvLimit:       trace.CltvLimit,
+		Error:           trace.Error,
+		EdgesConsidered: trace.EdgesConsidered,
+		Rejections: make(
+			[]*EdgeRejectionCount, 0, len(trace.Rejections),
+		),
+		RejectedEdges: marshallTracedEdges(trace.RejectedEdges),
+		Route:         marshallTracedEdges(trace.Route),
+	}
+
+	for reason, count := range trace.Rejections {
+		rpcTrace.Rejections = append(
+			rpcTrace.Rejections, &EdgeRejectionCount{
+				Reason: EdgeRejectReason(reason),
+				Count:  count,
+			},
+		)
+	}
+
+	// Order the rejection counts by reason, so that the response is
+	// deterministic.
+	sort.Slice(rpcTrace.Rejections, func(i, j int) bool {
+		return rpcTrace.Rejections[i].Reason <
+			rpcTrace.Rejections[j].Reason
+	})
+
+	return rpcTrace
+}
+
+// marshallTracedEdges converts traced edges to their rpc representation.
+func marshallTracedEdges(edges []*channeldb.TracedEdge) []*TracedEdge {
+	rpcEdges := make([]*TracedEdge, 0, len(edges))
+	for _, edge := range edges {
+		rpcEdge := &TracedEdge{
+			ChanId:      edge.ChannelID,
+			FromNode:    edge.From[:],
+			ToNode:      edge.To[:],
+			AmtMsat:     uint64(edge.Amount),
+			Probability: edge.Probability,
+			Reason:      EdgeRejectReason(edge.Reason),
+		}
+
+		if edge.History != nil {
+			rpcEdge.History = toRPCPairData(
+				&routing.TimedPairResult{
+					FailTime:    edge.History.FailTime,
+					FailAmt:     edge.History.FailAmt,
+					SuccessTime: edge.History.SuccessTime,
+					SuccessAmt:  edge.History.SuccessAmt,
+				},
+			)
+		}
+
+		rpcEdges = append(rpcEdges, rpcEdge)
+	}
+
+	return rpcEdges
+}
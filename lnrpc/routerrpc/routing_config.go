@@ -36,6 +36,17 @@ type RoutingConfig struct {
 	// control state to the DB.
 	McFlushInterval time.Duration `long:"mcflushinterval" description:"the timer interval to use to flush mission control state to the DB"`
 
+	// Splitter sets the strategy that splits payments into shards.
+	Splitter string `long:"splitter" choice:"halving" choice:"flow" description:"Strategy that splits a payment into shards if no single route can carry it. The halving splitter halves the amount until a route is found, the flow splitter assigns the amount to several paths at once based on the estimated liquidity of their channels."`
+
+	// TraceRetention is the time for which the path finding traces of
+	// payments are kept.
+	TraceRetention time.Duration `long:"traceretention" description:"The time for which the path finding traces of payments that requested them are kept."`
+
+	// ProbeCampaignRetention is the time for which probe campaigns are
+	// kept after they ended.
+	ProbeCampaignRetention time.Duration `long:"probecampaignretention" description:"The time for which probe campaigns and their results are kept after they ended."`
+
 	// AprioriConfig defines parameters for the apriori probability.
 	AprioriConfig *AprioriConfig `group:"apriori" namespace:"apriori" description:"configuration for the apriori pathfinding probability estimator"`
 
@@ -210,6 +210,15 @@ func rpcFailureResolution(invoiceFailure invoices.FailResolutionResult) (
 	case invoices.ResultMppInProgress:
 		return FailureDetail_MPP_IN_PROGRESS, nil
 
+	case invoices.ResultTrampolineFeeInsufficient:
+		return FailureDetail_TRAMPOLINE_FEE_INSUFFICIENT, nil
+
+	case invoices.ResultTrampolineExpiryTooSoon:
+		return FailureDetail_TRAMPOLINE_EXPIRY_TOO_SOON, nil
+
+	case invoices.ResultTrampolineFailed:
+		return FailureDetail_TRAMPOLINE_FAILED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown fail resolution: %v",
 			invoiceFailure.FailureString())
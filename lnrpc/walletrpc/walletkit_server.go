@@ -958,6 +958,12 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 func (w *WalletKit) ListSweeps(ctx context.Context,
 	in *ListSweepsRequest) (*ListSweepsResponse, error) {
 
+	// The history of our sweeps is taken from the sweep records of the
+	// sweeper, which include the sweeps that were replaced-by-fee.
+	if in.History {
+		return w.listSweepHistory()
+	}
+
 	sweeps, err := w.cfg.Sweeper.ListSweeps()
 	if err != nil {
 		return nil, err
@@ -1019,6 +1025,60 @@ func (w *WalletKit) ListSweeps(ctx context.Context,
 	}, nil
 }
 
+// listSweepHistory returns a record of every publish attempt of the sweeps
+// that our node has published.
+func (w *WalletKit) listSweepHistory() (*ListSweepsResponse, error) {
+	records, err := w.cfg.Sweeper.ListSweepRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*SweepAttempt, 0, len(records))
+	for _, record := range records {
+		inputs := make([]*SweepAttemptInput, 0, len(record.Inputs))
+		for _, inp := range record.Inputs {
+			// Witness types that aren't known to the RPC are
+			// reported as unknown, so that the rest of the history
+			// can still be returned.
+			witnessType := allWitnessTypes[inp.WitnessType]
+
+			var chanPoint string
+			if inp.ChanPoint != nil {
+				chanPoint = inp.ChanPoint.String()
+			}
+
+			op := lnrpc.MarshalOutPoint(&inp.OutPoint)
+
+			inputs = append(inputs, &SweepAttemptInput{
+				Outpoint:     op,
+				WitnessType:  witnessType,
+				AmountSat:    uint64(inp.Amount),
+				ChannelPoint: chanPoint,
+			})
+		}
+
+		satPerVbyte := uint64(record.FeeRate.FeePerVByte())
+
+		attempts = append(attempts, &SweepAttempt{
+			Txid:               record.TxHash.String(),
+			Inputs:             inputs,
+			SatPerVbyte:        satPerVbyte,
+			FeeSat:             uint64(record.Fee),
+			Attempt:            record.Attempt,
+			PublishHeight:      uint32(record.PublishHeight),
+			ConfirmationHeight: uint32(record.ConfirmHeight),
+		})
+	}
+
+	return &ListSweepsResponse{
+		Sweeps: &ListSweepsResponse_SweepHistory_{
+			SweepHistory: &ListSweepsResponse_SweepHistory{
+				Attempts: attempts,
+			},
+		},
+	}, nil
+}
+
 // LabelTransaction adds a label to a transaction.
 func (w *WalletKit) LabelTransaction(ctx context.Context,
 	req *LabelTransactionRequest) (*LabelTransactionResponse, error) {
@@ -233,6 +233,16 @@ func (w *WalletController) PublishTransaction(tx *wire.MsgTx, _ string) error {
 	return nil
 }
 
+// SubmitPackage sends the transactions of the package to the
+// PublishedTransactions chan.
+func (w *WalletController) SubmitPackage(txs []*wire.MsgTx, _ string) error {
+	for _, tx := range txs {
+		w.PublishedTransactions <- tx
+	}
+
+	return nil
+}
+
 // LabelTransaction currently does nothing.
 func (w *WalletController) LabelTransaction(chainhash.Hash, string,
 	bool) error {
@@ -1228,6 +1228,31 @@ func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx, label string) error {
 	return nil
 }
 
+// SubmitPackage submits a package of transactions to the mempool of the chain
+// backend, in which a child pays for its unconfirmed parents. The parents come
+// first in the package, and the child last. The label is saved with the
+// child.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) SubmitPackage(txs []*wire.MsgTx, label string) error {
+	if b.cfg.PackageSubmitter == nil {
+		return lnwallet.ErrPackageRelayUnsupported
+	}
+
+	if len(txs) == 0 {
+		return fmt.Errorf("empty package")
+	}
+
+	if err := b.cfg.PackageSubmitter.SubmitPackage(txs); err != nil {
+		return err
+	}
+
+	// Now that the package is in the mempool, publishing the child once
+	// more doesn't relay it again, but adds it to the wallet along with
+	// its label.
+	return b.PublishTransaction(txs[len(txs)-1], label)
+}
+
 // LabelTransaction adds a label to a transaction. If the tx already
 // has a label, this call will fail unless the overwrite parameter
 // is set. Labels must not be empty, and they are limited to 500 chars.
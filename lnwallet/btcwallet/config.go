@@ -82,6 +82,11 @@ type Config struct {
 	// wallet exists and a watch-only one is created directly, or, if the
 	// wallet was previously converted to a watch-only already.
 	MigrateWatchOnly bool
+
+	// PackageSubmitter is used to submit packages of transactions to the
+	// mempool of the chain backend. If it's nil, the chain backend doesn't
+	// support package relay.
+	PackageSubmitter PackageSubmitter
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
@@ -0,0 +1,116 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// PackageSubmitter submits packages of transactions to the mempool of a chain
+// backend.
+type PackageSubmitter interface {
+	// SubmitPackage submits a package of transactions, in which the
+	// parents come first and their child last.
+	SubmitPackage(txs []*wire.MsgTx) error
+}
+
+// bitcoindPackageSubmitter submits packages with the submitpackage RPC of
+// bitcoind.
+type bitcoindPackageSubmitter struct {
+	client *rpcclient.Client
+}
+
+// NewBitcoindPackageSubmitter creates a PackageSubmitter that submits
+// packages to the bitcoind node of the given RPC config. Versions of bitcoind
+// without the submitpackage RPC are reported to not support package relay.
+func NewBitcoindPackageSubmitter(
+	rpcConfig rpcclient.ConnConfig) (PackageSubmitter, error) {
+
+	// The submitpackage RPC is only available over HTTP POST.
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	rpcConfig.HTTPPostMode = true
+
+	client, err := rpcclient.New(&rpcConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bitcoindPackageSubmitter{
+		client: client,
+	}, nil
+}
+
+// submitPackageResult is the result of the submitpackage RPC of bitcoind.
+type submitPackageResult struct {
+	// PackageMsg is "success" if the package was accepted. It's only
+	// returned by recent versions of bitcoind.
+	PackageMsg string `json:"package_msg"`
+
+	// TxResults are the results of the transactions of the package, keyed
+	// by their wtxid.
+	TxResults map[string]struct {
+		TxID  string `json:"txid"`
+		Error string `json:"error"`
+	} `json:"tx-results"`
+}
+
+// SubmitPackage submits a package of transactions, in which the parents come
+// first and their child last.
+//
+// NOTE: Part of the PackageSubmitter interface.
+func (b *bitcoindPackageSubmitter) SubmitPackage(txs []*wire.MsgTx) error {
+	rawTxs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			return err
+		}
+
+		rawTxs = append(rawTxs, hex.EncodeToString(buf.Bytes()))
+	}
+
+	param, err := json.Marshal(rawTxs)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.client.RawRequest(
+		"submitpackage", []json.RawMessage{param},
+	)
+	if err != nil {
+		var rpcErr *btcjson.RPCError
+		if errors.As(err, &rpcErr) &&
+			rpcErr.Code == btcjson.ErrRPCMethodNotFound.Code {
+
+			return lnwallet.ErrPackageRelayUnsupported
+		}
+
+		return err
+	}
+
+	var result submitPackageResult
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return err
+	}
+
+	if result.PackageMsg != "" && result.PackageMsg != "success" {
+		return fmt.Errorf("package rejected: %v", result.PackageMsg)
+	}
+
+	for _, txResult := range result.TxResults {
+		if txResult.Error != "" {
+			return fmt.Errorf("tx %v of package rejected: %v",
+				txResult.TxID, txResult.Error)
+		}
+	}
+
+	return nil
+}
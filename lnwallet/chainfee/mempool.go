@@ -0,0 +1,640 @@
+package chainfee
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+)
+
+const (
+	// DefaultMempoolUpdateInterval is the default interval in which a
+	// MempoolEstimator rebuilds its histogram of the mempool.
+	DefaultMempoolUpdateInterval = 30 * time.Second
+
+	// mempoolBucketSpacing is the ratio between the fee rates of adjacent
+	// buckets of the mempool histogram. Fee rates are therefore rounded
+	// down by at most 10%.
+	mempoolBucketSpacing = 1.1
+
+	// maxMempoolBucketFeeRate is the fee rate of the highest bucket of the
+	// mempool histogram, which holds all transactions that pay at least
+	// this fee rate.
+	maxMempoolBucketFeeRate SatPerKWeight = 2_500_000
+
+	// mempoolBlockWeight is the weight of the transactions that we expect
+	// each block to include, which leaves some room for the coinbase
+	// transaction.
+	mempoolBlockWeight = blockchain.MaxBlockWeight - 4000
+
+	// mempoolWeight is the weight of the mempool projection when it's
+	// blended with a higher estimate of the backend.
+	mempoolWeight = 0.5
+)
+
+var (
+	// errNoMempoolHistogram is returned when the mempool histogram hasn't
+	// been built, or hasn't been rebuilt for too long.
+	errNoMempoolHistogram = errors.New("no recent mempool histogram")
+)
+
+// MempoolEntry is the fee and weight of a transaction in the mempool.
+type MempoolEntry struct {
+	// Fee is the fee paid by the transaction.
+	Fee btcutil.Amount
+
+	// Weight is the weight of the transaction.
+	Weight int64
+}
+
+// MempoolSource is the source of the mempool transactions from which a
+// MempoolEstimator builds its histogram.
+type MempoolSource interface {
+	// MempoolEntries returns the fee and weight of each transaction in the
+	// mempool.
+	MempoolEntries() ([]MempoolEntry, error)
+
+	// Stop releases the resources used by the source, such as its
+	// connection to the node.
+	Stop()
+}
+
+// RPCMempoolSource is a MempoolSource that tracks the mempool of a bitcoind
+// node incrementally. Each update only lists the txids of the mempool with
+// the non-verbose getrawmempool RPC, and looks up the new transactions with
+// the getmempoolentry RPC in a single batch.
+type RPCMempoolSource struct {
+	// client is a batch client, so requests are only sent to the node
+	// once Send is called.
+	client *rpcclient.Client
+
+	// entries holds the entries of the transactions in the mempool as of
+	// the last update, keyed by their txid.
+	entriesMtx sync.Mutex
+	entries    map[chainhash.Hash]MempoolEntry
+}
+
+// NewRPCMempoolSource creates a new RPCMempoolSource that connects to the
+// node with the given RPC config. The connection always uses HTTP POST mode,
+// as requests are sent in batches and no notifications are needed.
+func NewRPCMempoolSource(rpcConfig rpcclient.ConnConfig) (*RPCMempoolSource,
+	error) {
+
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	rpcConfig.HTTPPostMode = true
+
+	client, err := rpcclient.NewBatch(&rpcConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RPCMempoolSource{
+		client:  client,
+		entries: make(map[chainhash.Hash]MempoolEntry),
+	}, nil
+}
+
+// MempoolEntries returns the fee and weight of each transaction in the
+// mempool.
+//
+// NOTE: This method is part of the MempoolSource interface.
+func (r *RPCMempoolSource) MempoolEntries() ([]MempoolEntry, error) {
+	r.entriesMtx.Lock()
+	defer r.entriesMtx.Unlock()
+
+	txidsFuture := r.client.GetRawMempoolAsync()
+	if err := r.client.Send(); err != nil {
+		return nil, err
+	}
+
+	txids, err := txidsFuture.Receive()
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateMempoolEntries(r.entries, txids, r.fetchEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]MempoolEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// fetchEntries looks up the mempool entries of the given transactions in a
+// single batch. Transactions that left the mempool since they were listed
+// are skipped.
+func (r *RPCMempoolSource) fetchEntries(
+	txids []chainhash.Hash) (map[chainhash.Hash]MempoolEntry, error) {
+
+	futures := make(
+		map[chainhash.Hash]rpcclient.FutureGetMempoolEntryResult,
+		len(txids),
+	)
+	for _, txid := range txids {
+		futures[txid] = r.client.GetMempoolEntryAsync(txid.String())
+	}
+
+	if err := r.client.Send(); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[chainhash.Hash]MempoolEntry, len(txids))
+	for txid, future := range futures {
+		result, err := future.Receive()
+		if err != nil {
+			log.Tracef("Unable to fetch mempool entry of %v: %v",
+				txid, err)
+
+			continue
+		}
+
+		entry, err := newRPCMempoolEntry(result)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[txid] = entry
+	}
+
+	return entries, nil
+}
+
+// newRPCMempoolEntry returns the mempool entry of a getmempoolentry response.
+// Recent versions of bitcoind only report the fee in the fees object.
+func newRPCMempoolEntry(
+	result *btcjson.GetMempoolEntryResult) (MempoolEntry, error) {
+
+	feeBTC := result.Fee
+	if result.Fees.Base != 0 {
+		feeBTC = result.Fees.Base
+	}
+
+	fee, err := btcutil.NewAmount(feeBTC)
+	if err != nil {
+		return MempoolEntry{}, err
+	}
+
+	weight := result.Weight
+	if weight == 0 {
+		weight = int64(result.VSize) * blockchain.WitnessScaleFactor
+	}
+
+	return MempoolEntry{
+		Fee:    fee,
+		Weight: weight,
+	}, nil
+}
+
+// updateMempoolEntries updates the cached entries to the transactions with
+// the given txids. The entries of the transactions that left the mempool are
+// dropped, and only the entries of the new transactions are fetched.
+func updateMempoolEntries(entries map[chainhash.Hash]MempoolEntry,
+	txids []*chainhash.Hash, fetch func([]chainhash.Hash) (
+		map[chainhash.Hash]MempoolEntry, error)) error {
+
+	inMempool := make(map[chainhash.Hash]struct{}, len(txids))
+	var newTxids []chainhash.Hash
+	for _, txid := range txids {
+		inMempool[*txid] = struct{}{}
+
+		if _, ok := entries[*txid]; !ok {
+			newTxids = append(newTxids, *txid)
+		}
+	}
+
+	for txid := range entries {
+		if _, ok := inMempool[txid]; !ok {
+			delete(entries, txid)
+		}
+	}
+
+	if len(newTxids) == 0 {
+		return nil
+	}
+
+	newEntries, err := fetch(newTxids)
+	if err != nil {
+		return err
+	}
+
+	for txid, entry := range newEntries {
+		entries[txid] = entry
+	}
+
+	return nil
+}
+
+// Stop shuts down the RPC client of the source and waits for its goroutines
+// to exit.
+//
+// NOTE: This method is part of the MempoolSource interface.
+func (r *RPCMempoolSource) Stop() {
+	r.client.Shutdown()
+	r.client.WaitForShutdown()
+}
+
+// A compile-time assertion to ensure that RPCMempoolSource implements the
+// MempoolSource interface.
+var _ MempoolSource = (*RPCMempoolSource)(nil)
+
+// BtcdMempoolSource is a MempoolSource that queries the mempool of a btcd node
+// with the verbose getrawmempool RPC. btcd doesn't implement the
+// getmempoolentry RPC, so the mempool can't be tracked incrementally, and the
+// fee and weight of every transaction are transferred with each update.
+type BtcdMempoolSource struct {
+	client *rpcclient.Client
+}
+
+// NewBtcdMempoolSource creates a new BtcdMempoolSource that connects to the
+// node with the given RPC config. The connection always uses HTTP POST mode,
+// as no notifications are needed.
+func NewBtcdMempoolSource(rpcConfig rpcclient.ConnConfig) (*BtcdMempoolSource,
+	error) {
+
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	rpcConfig.HTTPPostMode = true
+
+	client, err := rpcclient.New(&rpcConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BtcdMempoolSource{
+		client: client,
+	}, nil
+}
+
+// MempoolEntries returns the fee and weight of each transaction in the
+// mempool.
+//
+// NOTE: This method is part of the MempoolSource interface.
+func (b *BtcdMempoolSource) MempoolEntries() ([]MempoolEntry, error) {
+	results, err := b.client.GetRawMempoolVerbose()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]MempoolEntry, 0, len(results))
+	for _, result := range results {
+		entry, err := newBtcdMempoolEntry(result)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// newBtcdMempoolEntry returns the mempool entry of a transaction in the
+// verbose getrawmempool response of btcd.
+func newBtcdMempoolEntry(
+	result btcjson.GetRawMempoolVerboseResult) (MempoolEntry, error) {
+
+	fee, err := btcutil.NewAmount(result.Fee)
+	if err != nil {
+		return MempoolEntry{}, err
+	}
+
+	weight := int64(result.Weight)
+	if weight == 0 {
+		weight = int64(result.Vsize) * blockchain.WitnessScaleFactor
+	}
+
+	return MempoolEntry{
+		Fee:    fee,
+		Weight: weight,
+	}, nil
+}
+
+// Stop shuts down the RPC client of the source and waits for its goroutines
+// to exit.
+//
+// NOTE: This method is part of the MempoolSource interface.
+func (b *BtcdMempoolSource) Stop() {
+	b.client.Shutdown()
+	b.client.WaitForShutdown()
+}
+
+// A compile-time assertion to ensure that BtcdMempoolSource implements the
+// MempoolSource interface.
+var _ MempoolSource = (*BtcdMempoolSource)(nil)
+
+// mempoolBucket is a bucket of the mempool histogram.
+type mempoolBucket struct {
+	// feeRate is the lowest fee rate of the transactions in the bucket.
+	feeRate SatPerKWeight
+
+	// weight is the total weight of the transactions in the bucket.
+	weight int64
+}
+
+// mempoolHistogram is a histogram of the fee rates of the transactions in the
+// mempool.
+type mempoolHistogram struct {
+	// buckets are the non-empty buckets of the histogram, sorted from the
+	// highest to the lowest fee rate.
+	buckets []mempoolBucket
+
+	// createdAt is the time at which the histogram was built.
+	createdAt time.Time
+}
+
+// mempoolBucketFeeRate returns the fee rate of the bucket of the mempool
+// histogram that holds transactions with the given fee rate.
+func mempoolBucketFeeRate(feeRate SatPerKWeight) SatPerKWeight {
+	switch {
+	case feeRate < FeePerKwFloor:
+		return 0
+
+	case feeRate >= maxMempoolBucketFeeRate:
+		return maxMempoolBucketFeeRate
+	}
+
+	idx := math.Floor(
+		math.Log(float64(feeRate)/float64(FeePerKwFloor)) /
+			math.Log(mempoolBucketSpacing),
+	)
+
+	return SatPerKWeight(
+		float64(FeePerKwFloor) * math.Pow(mempoolBucketSpacing, idx),
+	)
+}
+
+// newMempoolHistogram builds a histogram of the fee rates of the given
+// mempool transactions.
+func newMempoolHistogram(entries []MempoolEntry,
+	now time.Time) *mempoolHistogram {
+
+	weights := make(map[SatPerKWeight]int64)
+	for _, entry := range entries {
+		if entry.Weight <= 0 {
+			continue
+		}
+
+		feeRate := SatPerKWeight(
+			int64(entry.Fee) * 1000 / entry.Weight,
+		)
+		weights[mempoolBucketFeeRate(feeRate)] += entry.Weight
+	}
+
+	buckets := make([]mempoolBucket, 0, len(weights))
+	for feeRate, weight := range weights {
+		buckets = append(buckets, mempoolBucket{
+			feeRate: feeRate,
+			weight:  weight,
+		})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].feeRate > buckets[j].feeRate
+	})
+
+	return &mempoolHistogram{
+		buckets:   buckets,
+		createdAt: now,
+	}
+}
+
+// projectFeeRate returns the fee rate that a transaction needs to pay to be
+// included in the given number of blocks, assuming that blocks include the
+// transactions of the mempool from the highest to the lowest fee rate and no
+// new transactions arrive. If the mempool fits in the blocks, the floor is
+// returned.
+func (h *mempoolHistogram) projectFeeRate(numBlocks uint32,
+	floor SatPerKWeight) SatPerKWeight {
+
+	capacity := int64(numBlocks) * mempoolBlockWeight
+
+	var weight int64
+	for _, bucket := range h.buckets {
+		weight += bucket.weight
+
+		// Once the blocks are filled by the transactions of this
+		// bucket, a transaction needs to pay at least the fee rate of
+		// the bucket to be included.
+		if weight >= capacity {
+			if bucket.feeRate < floor {
+				return floor
+			}
+
+			return bucket.feeRate
+		}
+	}
+
+	return floor
+}
+
+// MempoolEstimatorConfig holds the configuration of a MempoolEstimator.
+type MempoolEstimatorConfig struct {
+	// Source is the source of the mempool transactions.
+	Source MempoolSource
+
+	// Backend is the fee estimator of the chain backend, whose estimates
+	// are blended with the projection of the mempool.
+	Backend Estimator
+
+	// UpdateInterval is the interval in which the histogram of the
+	// mempool is rebuilt. A histogram that hasn't been rebuilt for three
+	// intervals is no longer used.
+	UpdateInterval time.Duration
+}
+
+// MempoolEstimator is an implementation of the Estimator interface that
+// builds a histogram of the fee rates in the mempool of the chain backend,
+// and projects the fee rate needed to be included within a conf target from
+// it. The projection is blended with the estimate of the backend, which lags
+// behind sudden rises of the fee rates in the mempool.
+type MempoolEstimator struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg MempoolEstimatorConfig
+
+	// histogram is the latest histogram of the mempool, or nil if it
+	// hasn't been built yet.
+	histogramMtx sync.RWMutex
+	histogram    *mempoolHistogram
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewMempoolEstimator creates a new MempoolEstimator from the given config.
+func NewMempoolEstimator(cfg MempoolEstimatorConfig) *MempoolEstimator {
+	if cfg.UpdateInterval == 0 {
+		cfg.UpdateInterval = DefaultMempoolUpdateInterval
+	}
+
+	return &MempoolEstimator{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start signals the Estimator to start any processes or goroutines it needs
+// to perform its duty.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MempoolEstimator) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Infof("Starting mempool fee estimator")
+
+		if err = m.cfg.Backend.Start(); err != nil {
+			return
+		}
+
+		m.updateHistogram()
+
+		m.wg.Add(1)
+		go m.histogramUpdater()
+	})
+
+	return err
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used by the
+// fee estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MempoolEstimator) Stop() error {
+	var err error
+	m.stopped.Do(func() {
+		log.Infof("Stopping mempool fee estimator")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		m.cfg.Source.Stop()
+
+		err = m.cfg.Backend.Stop()
+	})
+
+	return err
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw. If the
+// projection of the mempool exceeds the estimate of the backend, then it's
+// returned, as the backend lags behind rising fee rates. Otherwise the two
+// are averaged, as transactions that arrive before the conf target aren't
+// accounted for by the projection. Without a recent histogram of the mempool,
+// the estimate of the backend is returned.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MempoolEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight,
+	error) {
+
+	if numBlocks < minBlockTarget {
+		numBlocks = minBlockTarget
+	}
+
+	backendFeeRate, err := m.cfg.Backend.EstimateFeePerKW(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+
+	projection, err := m.projectFeeRate(numBlocks)
+	if err != nil {
+		log.Debugf("Using backend fee rate of %v sat/kw for conf "+
+			"target of %v: %v", int64(backendFeeRate), numBlocks,
+			err)
+
+		return backendFeeRate, nil
+	}
+
+	feeRate := projection
+	if projection < backendFeeRate {
+		feeRate = SatPerKWeight(
+			mempoolWeight*float64(projection) +
+				(1-mempoolWeight)*float64(backendFeeRate),
+		)
+	}
+
+	log.Debugf("Returning %v sat/kw for conf target of %v "+
+		"(mempool=%v sat/kw, backend=%v sat/kw)", int64(feeRate),
+		numBlocks, int64(projection), int64(backendFeeRate))
+
+	return feeRate, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MempoolEstimator) RelayFeePerKW() SatPerKWeight {
+	return m.cfg.Backend.RelayFeePerKW()
+}
+
+// projectFeeRate projects the fee rate needed to be included within the given
+// number of blocks from the latest histogram of the mempool.
+func (m *MempoolEstimator) projectFeeRate(numBlocks uint32) (SatPerKWeight,
+	error) {
+
+	m.histogramMtx.RLock()
+	histogram := m.histogram
+	m.histogramMtx.RUnlock()
+
+	maxAge := 3 * m.cfg.UpdateInterval
+	if histogram == nil || time.Since(histogram.createdAt) > maxAge {
+		return 0, errNoMempoolHistogram
+	}
+
+	return histogram.projectFeeRate(numBlocks, m.RelayFeePerKW()), nil
+}
+
+// updateHistogram rebuilds the histogram of the mempool.
+func (m *MempoolEstimator) updateHistogram() {
+	entries, err := m.cfg.Source.MempoolEntries()
+	if err != nil {
+		log.Errorf("Unable to fetch mempool entries: %v", err)
+		return
+	}
+
+	histogram := newMempoolHistogram(entries, time.Now())
+
+	m.histogramMtx.Lock()
+	m.histogram = histogram
+	m.histogramMtx.Unlock()
+
+	log.Tracef("Built mempool histogram of %v txns in %v buckets",
+		len(entries), len(histogram.buckets))
+}
+
+// histogramUpdater rebuilds the histogram of the mempool in each update
+// interval.
+func (m *MempoolEstimator) histogramUpdater() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.cfg.UpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.updateHistogram()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// A compile-time assertion to ensure that MempoolEstimator implements the
+// Estimator interface.
+var _ Estimator = (*MempoolEstimator)(nil)
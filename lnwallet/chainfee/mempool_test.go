@@ -0,0 +1,235 @@
+package chainfee
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMempoolSource struct {
+	entries []MempoolEntry
+	stopped bool
+}
+
+func (m *mockMempoolSource) MempoolEntries() ([]MempoolEntry, error) {
+	return m.entries, nil
+}
+
+func (m *mockMempoolSource) Stop() {
+	m.stopped = true
+}
+
+// mempoolEntry returns a mempool entry of the given weight that pays the
+// given fee rate.
+func mempoolEntry(feeRate SatPerKWeight, weight int64) MempoolEntry {
+	return MempoolEntry{
+		Fee:    feeRate.FeeForWeight(weight),
+		Weight: weight,
+	}
+}
+
+// TestMempoolBucketFeeRate tests that fee rates are rounded down to the fee
+// rate of their bucket.
+func TestMempoolBucketFeeRate(t *testing.T) {
+	t.Parallel()
+
+	require.Zero(t, mempoolBucketFeeRate(FeePerKwFloor-1))
+	require.Equal(t, FeePerKwFloor, mempoolBucketFeeRate(FeePerKwFloor))
+	require.Equal(t, FeePerKwFloor, mempoolBucketFeeRate(FeePerKwFloor+20))
+	require.Equal(
+		t, maxMempoolBucketFeeRate,
+		mempoolBucketFeeRate(maxMempoolBucketFeeRate*2),
+	)
+
+	for feeRate := FeePerKwFloor; feeRate < 100_000; feeRate += 97 {
+		bucket := mempoolBucketFeeRate(feeRate)
+		require.LessOrEqual(t, bucket, feeRate)
+		require.Greater(
+			t, float64(bucket)*mempoolBucketSpacing*1.001,
+			float64(feeRate),
+		)
+	}
+}
+
+// TestMempoolHistogramProjection tests that the fee rate needed to be
+// included within a number of blocks is projected from the mempool.
+func TestMempoolHistogramProjection(t *testing.T) {
+	t.Parallel()
+
+	// The mempool fills a block at 10k sat/kw, another one at 5k sat/kw
+	// and half of a block at 1k sat/kw.
+	histogram := newMempoolHistogram([]MempoolEntry{
+		mempoolEntry(10_000, mempoolBlockWeight/2),
+		mempoolEntry(10_000, mempoolBlockWeight/2),
+		mempoolEntry(5_000, mempoolBlockWeight),
+		mempoolEntry(1_000, mempoolBlockWeight/2),
+		{Fee: 1000, Weight: 0},
+	}, time.Now())
+	require.Len(t, histogram.buckets, 3)
+
+	const floor = SatPerKWeight(500)
+	require.Equal(
+		t, mempoolBucketFeeRate(10_000),
+		histogram.projectFeeRate(1, floor),
+	)
+	require.Equal(
+		t, mempoolBucketFeeRate(5_000),
+		histogram.projectFeeRate(2, floor),
+	)
+	require.Equal(t, floor, histogram.projectFeeRate(3, floor))
+
+	// A projection below the floor is raised to it.
+	require.Equal(
+		t, SatPerKWeight(20_000), histogram.projectFeeRate(1, 20_000),
+	)
+}
+
+// TestMempoolEstimator tests that the projection of the mempool is blended
+// with the estimate of the backend.
+func TestMempoolEstimator(t *testing.T) {
+	t.Parallel()
+
+	source := &mockMempoolSource{
+		entries: []MempoolEntry{
+			mempoolEntry(10_000, mempoolBlockWeight),
+		},
+	}
+	estimator := NewMempoolEstimator(MempoolEstimatorConfig{
+		Source:  source,
+		Backend: NewStaticEstimator(2_000, FeePerKwFloor),
+	})
+	require.NoError(t, estimator.Start())
+	t.Cleanup(func() {
+		require.NoError(t, estimator.Stop())
+	})
+
+	// The projection exceeds the estimate of the backend for the next
+	// block, so it's used as is.
+	feeRate, err := estimator.EstimateFeePerKW(1)
+	require.NoError(t, err)
+	require.Equal(t, mempoolBucketFeeRate(10_000), feeRate)
+
+	// Beyond the next block, the mempool projects the fee floor, which is
+	// averaged with the estimate of the backend.
+	feeRate, err = estimator.EstimateFeePerKW(6)
+	require.NoError(t, err)
+	require.Equal(t, (FeePerKwFloor+2_000)/2, feeRate)
+
+	// Without a recent histogram, the estimate of the backend is used.
+	estimator.histogramMtx.Lock()
+	estimator.histogram.createdAt = time.Now().Add(-time.Hour)
+	estimator.histogramMtx.Unlock()
+
+	feeRate, err = estimator.EstimateFeePerKW(1)
+	require.NoError(t, err)
+	require.Equal(t, SatPerKWeight(2_000), feeRate)
+
+	// Stopping the estimator stops its source as well.
+	require.NoError(t, estimator.Stop())
+	require.True(t, source.stopped)
+}
+
+// TestUpdateMempoolEntries tests that the mempool entries are updated
+// incrementally: only the entries of new transactions are fetched, and the
+// entries of transactions that left the mempool are dropped.
+func TestUpdateMempoolEntries(t *testing.T) {
+	t.Parallel()
+
+	txA, txB, txC := chainhash.Hash{1}, chainhash.Hash{2}, chainhash.Hash{3}
+
+	var fetched [][]chainhash.Hash
+	fetch := func(txids []chainhash.Hash) (map[chainhash.Hash]MempoolEntry,
+		error) {
+
+		fetched = append(fetched, txids)
+
+		entries := make(map[chainhash.Hash]MempoolEntry)
+		for _, txid := range txids {
+			// Transaction C leaves the mempool before its entry is
+			// fetched.
+			if txid == txC {
+				continue
+			}
+
+			entries[txid] = mempoolEntry(
+				SatPerKWeight(txid[0])*1000, 1000,
+			)
+		}
+
+		return entries, nil
+	}
+
+	entries := make(map[chainhash.Hash]MempoolEntry)
+	err := updateMempoolEntries(
+		entries, []*chainhash.Hash{&txA, &txB}, fetch,
+	)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// Only the new transaction is fetched, and the one that left the
+	// mempool is dropped.
+	err = updateMempoolEntries(
+		entries, []*chainhash.Hash{&txB, &txC}, fetch,
+	)
+	require.NoError(t, err)
+	require.Equal(t, [][]chainhash.Hash{{txA, txB}, {txC}}, fetched)
+	require.Equal(t, map[chainhash.Hash]MempoolEntry{
+		txB: mempoolEntry(2000, 1000),
+	}, entries)
+
+	// Nothing is fetched if no transactions are new.
+	err = updateMempoolEntries(entries, []*chainhash.Hash{&txB}, fetch)
+	require.NoError(t, err)
+	require.Len(t, fetched, 2)
+}
+
+// TestRPCMempoolEntry tests that the fee and weight of a mempool entry are
+// read from the getmempoolentry responses of bitcoind.
+func TestRPCMempoolEntry(t *testing.T) {
+	t.Parallel()
+
+	// Recent versions of bitcoind only report the fees object and the
+	// vsize of the transaction.
+	entry, err := newRPCMempoolEntry(&btcjson.GetMempoolEntryResult{
+		VSize: 250,
+		Fees: btcjson.MempoolFees{
+			Base: 0.00001,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, MempoolEntry{Fee: 1000, Weight: 1000}, entry)
+
+	// Older versions also report the fee and the weight.
+	entry, err = newRPCMempoolEntry(&btcjson.GetMempoolEntryResult{
+		VSize:  250,
+		Weight: 998,
+		Fee:    0.00002,
+	})
+	require.NoError(t, err)
+	require.Equal(t, MempoolEntry{Fee: 2000, Weight: 998}, entry)
+}
+
+// TestBtcdMempoolEntry tests that the fee and weight of a mempool entry are
+// read from the verbose getrawmempool response of btcd.
+func TestBtcdMempoolEntry(t *testing.T) {
+	t.Parallel()
+
+	entry, err := newBtcdMempoolEntry(btcjson.GetRawMempoolVerboseResult{
+		Vsize:  250,
+		Weight: 998,
+		Fee:    0.00002,
+	})
+	require.NoError(t, err)
+	require.Equal(t, MempoolEntry{Fee: 2000, Weight: 998}, entry)
+
+	// Without a weight, it's derived from the virtual size.
+	entry, err = newBtcdMempoolEntry(btcjson.GetRawMempoolVerboseResult{
+		Vsize: 250,
+		Fee:   0.00001,
+	})
+	require.NoError(t, err)
+	require.Equal(t, MempoolEntry{Fee: 1000, Weight: 1000}, entry)
+}
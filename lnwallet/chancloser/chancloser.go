@@ -48,6 +48,22 @@ var (
 	// ErrInvalidShutdownScript is returned when we receive an address from
 	// a peer that isn't either a p2wsh or p2tr address.
 	ErrInvalidShutdownScript = fmt.Errorf("invalid shutdown script")
+
+	// ErrCloseNotReplaceable is returned when the fee of a closing
+	// transaction is bumped while option_simple_close wasn't negotiated.
+	ErrCloseNotReplaceable = fmt.Errorf("closing transaction can only " +
+		"be replaced with option_simple_close")
+
+	// ErrCloseNotBroadcast is returned when the fee of a closing
+	// transaction is bumped before one has been broadcast.
+	ErrCloseNotBroadcast = fmt.Errorf("no closing transaction has been " +
+		"broadcast yet")
+
+	// ErrCloseFeeTooLow is returned when the fee of a closing transaction
+	// is bumped to a fee that doesn't exceed the fee of the closing
+	// transaction that has been broadcast.
+	ErrCloseFeeTooLow = fmt.Errorf("replacement doesn't pay a higher fee " +
+		"than the closing transaction")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -128,6 +144,18 @@ type ChanCloseCfg struct {
 	// FeeEstimator is used to estimate the absolute starting co-op close
 	// fee.
 	FeeEstimator CoopFeeEstimator
+
+	// SimpleClose is true if both parties support option_simple_close. In
+	// that case, closing transactions are negotiated with closing_complete
+	// and closing_sig rather than closing_signed, each party pays the fee
+	// of the closing transaction it proposes from its own output, and the
+	// closing transaction can be replaced with one that pays a higher fee
+	// after it's been broadcast.
+	//
+	// NOTE: This must not be set for taproot channels, as their closing
+	// transactions are signed with musig2 nonces that are exchanged in
+	// the shutdown messages.
+	SimpleClose bool
 }
 
 // ChanCloser is a state machine that handles the cooperative channel closure
@@ -197,6 +225,19 @@ type ChanCloser struct {
 
 	// locallyInitiated is true if we initiated the channel close.
 	locallyInitiated bool
+
+	// localOffer is the last closing transaction that we've proposed with
+	// option_simple_close, which the remote party hasn't signed yet.
+	localOffer *closingOffer
+
+	// closingFee is the fee of the latest closing transaction that we've
+	// broadcast with option_simple_close. A replacement that we propose
+	// must pay a higher fee.
+	closingFee btcutil.Amount
+
+	// closingFeeRate is the fee rate of the latest closing transaction
+	// that we've proposed and broadcast with option_simple_close.
+	closingFeeRate chainfee.SatPerKWeight
 }
 
 // calcCoopCloseFee computes an "ideal" absolute co-op close fee given the
@@ -267,9 +308,11 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 	}
 }
 
-// initFeeBaseline computes our ideal fee rate, and also the largest fee we'll
-// accept given information about the delivery script of the remote party.
-func (c *ChanCloser) initFeeBaseline() {
+// coopCloseFee returns the absolute fee of a closing transaction at the given
+// fee rate, given the delivery scripts of both parties.
+func (c *ChanCloser) coopCloseFee(
+	feeRate chainfee.SatPerKWeight) btcutil.Amount {
+
 	// Depending on if a balance ends up being dust or not, we'll pass a
 	// nil TxOut into the EstimateFee call which can handle it.
 	var localTxOut, remoteTxOut *wire.TxOut
@@ -286,20 +329,24 @@ func (c *ChanCloser) initFeeBaseline() {
 		}
 	}
 
+	return c.cfg.FeeEstimator.EstimateFee(
+		0, localTxOut, remoteTxOut, feeRate,
+	)
+}
+
+// initFeeBaseline computes our ideal fee rate, and also the largest fee we'll
+// accept given information about the delivery script of the remote party.
+func (c *ChanCloser) initFeeBaseline() {
 	// Given the target fee-per-kw, we'll compute what our ideal _total_
 	// fee will be starting at for this fee negotiation.
-	c.idealFeeSat = c.cfg.FeeEstimator.EstimateFee(
-		0, localTxOut, remoteTxOut, c.idealFeeRate,
-	)
+	c.idealFeeSat = c.coopCloseFee(c.idealFeeRate)
 
 	// When we're the initiator, we'll want to also factor in the highest
 	// fee we want to pay. This'll either be 3x the ideal fee, or the
 	// specified explicit max fee.
 	c.maxFee = c.idealFeeSat * defaultMaxFeeMultiplier
 	if c.cfg.MaxFee > 0 {
-		c.maxFee = c.cfg.FeeEstimator.EstimateFee(
-			0, localTxOut, remoteTxOut, c.cfg.MaxFee,
-		)
+		c.maxFee = c.coopCloseFee(c.cfg.MaxFee)
 	}
 
 	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) "+
@@ -563,6 +610,27 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		// message sent.
 		c.state = closeFeeNegotiation
 
+		// With option_simple_close, the party that sent the first
+		// shutdown proposes the closing transaction. We only propose
+		// one ourselves if its output is dust, as it can't pay the fee
+		// then.
+		if c.cfg.SimpleClose {
+			if !c.cfg.Channel.RemoteBalanceDust() {
+				return msgsToSend, false, nil
+			}
+
+			closingComplete, err := c.proposeClosingComplete(
+				c.idealFeeRate, false,
+			)
+			if err != nil {
+				return nil, false, fmt.Errorf("unable to sign "+
+					"new co op close offer: %w", err)
+			}
+			msgsToSend = append(msgsToSend, closingComplete)
+
+			return msgsToSend, false, nil
+		}
+
 		// We'll also craft our initial close proposal in order to keep the
 		// negotiation moving, but only if we're the negotiator.
 		if chanInitiator {
@@ -630,6 +698,25 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		chancloserLog.Infof("ChannelPoint(%v): shutdown response received, "+
 			"entering fee negotiation", c.chanPoint)
 
+		// With option_simple_close, we propose the closing transaction
+		// at our ideal fee rate, unless our output is dust, in which
+		// case the other party proposes it.
+		if c.cfg.SimpleClose {
+			if c.cfg.Channel.LocalBalanceDust() {
+				return nil, false, nil
+			}
+
+			closingComplete, err := c.proposeClosingComplete(
+				c.idealFeeRate, false,
+			)
+			if err != nil {
+				return nil, false, fmt.Errorf("unable to sign "+
+					"new co op close offer: %w", err)
+			}
+
+			return []lnwire.Message{closingComplete}, false, nil
+		}
+
 		// Starting with our ideal fee rate, we'll create an initial closing
 		// proposal, but only if we're the initiator, as otherwise, the other
 		// party will send their initial proposal first.
@@ -649,6 +736,12 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 	// then this indicates the remote party is responding to a close signed
 	// message we sent, or kicking off the process with their own.
 	case closeFeeNegotiation:
+		// With option_simple_close, the closing transaction is
+		// negotiated with closing_complete and closing_sig instead.
+		if c.cfg.SimpleClose {
+			return c.processSimpleCloseMsg(msg)
+		}
+
 		// First, we'll assert that we're actually getting a ClosingSigned
 		// message, otherwise an invalid state transition was attempted.
 		closeSignedMsg, ok := msg.(*lnwire.ClosingSigned)
@@ -828,6 +921,12 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 	// should only be the remote party echoing the last ClosingSigned message
 	// that we agreed on.
 	case closeFinished:
+		// With option_simple_close, either party can replace the
+		// closing transaction after it's been broadcast.
+		if c.cfg.SimpleClose {
+			return c.processSimpleCloseMsg(msg)
+		}
+
 		if _, ok := msg.(*lnwire.ClosingSigned); !ok {
 			return nil, false, fmt.Errorf("expected lnwire.ClosingSigned, "+
 				"instead have %v", spew.Sdump(msg))
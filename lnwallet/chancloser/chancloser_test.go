@@ -154,6 +154,12 @@ func (m *mockChannel) MarkCoopBroadcasted(*wire.MsgTx, bool) error {
 	return nil
 }
 
+func (m *mockChannel) MarkSimpleCoopBroadcasted(*wire.MsgTx, bool,
+	*channeldb.SimpleCloseState) error {
+
+	return nil
+}
+
 func (m *mockChannel) IsInitiator() bool {
 	return m.initiator
 }
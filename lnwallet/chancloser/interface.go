@@ -35,6 +35,12 @@ type Channel interface { //nolint:interfacebloat
 	// transaction has been broadcast.
 	MarkCoopBroadcasted(*wire.MsgTx, bool) error
 
+	// MarkSimpleCoopBroadcasted persistently marks that the channel close
+	// transaction negotiated with option_simple_close has been broadcast,
+	// along with the state of the negotiation.
+	MarkSimpleCoopBroadcasted(*wire.MsgTx, bool,
+		*channeldb.SimpleCloseState) error
+
 	// IsInitiator returns true we are the initiator of the channel.
 	IsInitiator() bool
 
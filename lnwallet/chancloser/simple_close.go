@@ -0,0 +1,405 @@
+package chancloser
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// closingOffer is a closing transaction that we've proposed with
+// option_simple_close.
+type closingOffer struct {
+	// msg is the closing_complete message that proposes the closing
+	// transaction.
+	msg *lnwire.ClosingComplete
+
+	// localSig is our signature of the closing transaction.
+	localSig input.Signature
+
+	// feeRate is the fee rate that the closing transaction pays.
+	feeRate chainfee.SatPerKWeight
+
+	// bump is true if the closing transaction replaces one that we've
+	// already broadcast, at the request of the local close request.
+	bump bool
+}
+
+// NewSimpleChanCloser restores the closer of a channel whose closing
+// transaction was negotiated with option_simple_close and broadcast, from the
+// closing transaction and the state of the negotiation that were persisted
+// along with it. The closer can then replace the closing transaction after a
+// restart, either at the request of the local close request, or of the
+// remote party.
+func NewSimpleChanCloser(cfg ChanCloseCfg, closeTx *wire.MsgTx,
+	state *channeldb.SimpleCloseState,
+	locallyInitiated bool) *ChanCloser {
+
+	cfg.SimpleClose = true
+
+	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
+	return &ChanCloser{
+		state:                closeFinished,
+		chanPoint:            *cfg.Channel.ChannelPoint(),
+		cid:                  cid,
+		cfg:                  cfg,
+		negotiationHeight:    state.LockTime,
+		closingTx:            closeTx,
+		idealFeeRate:         state.FeeRate,
+		localDeliveryScript:  state.LocalDeliveryScript,
+		remoteDeliveryScript: state.RemoteDeliveryScript,
+		locallyInitiated:     locallyInitiated,
+		closingFee:           state.ClosingFee,
+		closingFeeRate:       state.FeeRate,
+		priorFeeOffers: make(
+			map[btcutil.Amount]*lnwire.ClosingSigned,
+		),
+	}
+}
+
+// SimpleClose returns true if the closing transaction is negotiated with
+// option_simple_close, in which case it can be replaced after it's been
+// broadcast.
+func (c *ChanCloser) SimpleClose() bool {
+	return c.cfg.SimpleClose
+}
+
+// BumpFee proposes a closing transaction that replaces the one that has been
+// broadcast, and pays the given fee rate from our output. The returned
+// closing_complete message must be sent to the remote party, which replies
+// with its signature, after which the replacement is broadcast. The passed
+// request, if any, replaces the one returned by CloseRequest, so that its
+// caller is notified once the replacement has been broadcast.
+func (c *ChanCloser) BumpFee(feeRate chainfee.SatPerKWeight,
+	req *htlcswitch.ChanClose) (*lnwire.ClosingComplete, error) {
+
+	if !c.cfg.SimpleClose {
+		return nil, ErrCloseNotReplaceable
+	}
+
+	if c.state != closeFinished {
+		return nil, ErrCloseNotBroadcast
+	}
+
+	closingComplete, err := c.proposeClosingComplete(feeRate, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req != nil {
+		c.closeReq = req
+	}
+
+	return closingComplete, nil
+}
+
+// closingOutputs returns whether the closing transaction that's proposed by
+// the closer has an output of the closer and of the closee.
+func (c *ChanCloser) closingOutputs(localCloser bool) (bool, bool) {
+	localOutput := !c.cfg.Channel.LocalBalanceDust()
+	remoteOutput := !c.cfg.Channel.RemoteBalanceDust()
+
+	if localCloser {
+		return localOutput, remoteOutput
+	}
+
+	return remoteOutput, localOutput
+}
+
+// proposeClosingComplete signs a closing transaction that pays the given fee
+// rate from our output, and returns the closing_complete message that
+// proposes it to the remote party. If bump is true, the closing transaction
+// must pay a higher fee than the one that has been broadcast.
+func (c *ChanCloser) proposeClosingComplete(feeRate chainfee.SatPerKWeight,
+	bump bool) (*lnwire.ClosingComplete, error) {
+
+	fee := c.coopCloseFee(feeRate)
+	if bump && fee <= c.closingFee {
+		return nil, fmt.Errorf("%w: fee of %v doesn't exceed %v",
+			ErrCloseFeeTooLow, fee, c.closingFee)
+	}
+
+	// The closing transaction pays the fee from our output, and its lock
+	// time is the height at which the negotiation started.
+	lockTime := c.negotiationHeight
+	rawSig, _, _, err := c.cfg.Channel.CreateCloseProposal(
+		fee, c.localDeliveryScript, c.remoteDeliveryScript,
+		lnwallet.WithCustomPayer(true),
+		lnwallet.WithCustomLockTime(lockTime),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return nil, err
+	}
+
+	closerOutput, closeeOutput := c.closingOutputs(true)
+	closingComplete := &lnwire.ClosingComplete{
+		ChannelID:   c.cid,
+		FeeSatoshis: fee,
+		LockTime:    lockTime,
+		ClosingSigs: lnwire.NewClosingSigs(
+			sig, closerOutput, closeeOutput,
+		),
+	}
+
+	// We'll remember our signature, so that we can complete the closing
+	// transaction once the remote party has signed it. A prior offer that
+	// the remote party hasn't signed yet is replaced.
+	c.localOffer = &closingOffer{
+		msg:      closingComplete,
+		localSig: rawSig,
+		feeRate:  feeRate,
+		bump:     bump,
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): proposing closing tx paying "+
+		"fee of %v sat (fee_rate=%v) from our output", c.chanPoint,
+		int64(fee), feeRate)
+
+	return closingComplete, nil
+}
+
+// processSimpleCloseMsg processes a closing_complete or closing_sig message
+// of option_simple_close. It returns the messages to send to the remote party,
+// and true if a closing transaction was broadcast that either is the first
+// one, or replaces a prior one at the request of the local close request.
+func (c *ChanCloser) processSimpleCloseMsg(msg lnwire.Message) (
+	[]lnwire.Message, bool, error) {
+
+	switch msg := msg.(type) {
+	case *lnwire.ClosingComplete:
+		return c.processClosingComplete(msg)
+
+	case *lnwire.ClosingSig:
+		return c.processClosingSig(msg)
+
+	default:
+		return nil, false, fmt.Errorf("expected "+
+			"lnwire.ClosingComplete or lnwire.ClosingSig, "+
+			"instead have %v", spew.Sdump(msg))
+	}
+}
+
+// processClosingComplete signs the closing transaction proposed by the remote
+// party, which pays the fee from its own output, and broadcasts it.
+func (c *ChanCloser) processClosingComplete(msg *lnwire.ClosingComplete) (
+	[]lnwire.Message, bool, error) {
+
+	closerOutput, closeeOutput := c.closingOutputs(false)
+	remoteWireSig := msg.ClosingSigs.Sig(closerOutput, closeeOutput)
+	if remoteWireSig == nil {
+		return nil, false, fmt.Errorf("closing_complete has no "+
+			"signature for closing tx with closer_output=%v, "+
+			"closee_output=%v", closerOutput, closeeOutput)
+	}
+
+	remoteSig, err := remoteWireSig.ToSignature()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The remote party pays the fee of the closing transaction, so we
+	// accept any fee that it can afford.
+	closeOpts := []lnwallet.ChanCloseOpt{
+		lnwallet.WithCustomPayer(false),
+		lnwallet.WithCustomLockTime(msg.LockTime),
+	}
+	rawSig, _, _, err := c.cfg.Channel.CreateCloseProposal(
+		msg.FeeSatoshis, c.localDeliveryScript, c.remoteDeliveryScript,
+		closeOpts...,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	closeTx, _, err := c.cfg.Channel.CompleteCooperativeClose(
+		rawSig, remoteSig, c.localDeliveryScript,
+		c.remoteDeliveryScript, msg.FeeSatoshis, closeOpts...,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	sig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return nil, false, err
+	}
+
+	closingSig := &lnwire.ClosingSig{
+		ChannelID:   c.cid,
+		FeeSatoshis: msg.FeeSatoshis,
+		LockTime:    msg.LockTime,
+		ClosingSigs: lnwire.NewClosingSigs(
+			sig, closerOutput, closeeOutput,
+		),
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): signed closing tx paying fee "+
+		"of %v sat from remote output", c.chanPoint,
+		int64(msg.FeeSatoshis))
+
+	// We send our signature even if the closing transaction can't replace
+	// the one we've broadcast, as the remote party may still broadcast
+	// it itself.
+	replacement := c.state == closeFinished
+	err = c.broadcastClosingTx(closeTx, msg.FeeSatoshis, c.closingFeeRate)
+	switch {
+	case err != nil && replacement:
+		chancloserLog.Warnf("ChannelPoint(%v): unable to broadcast "+
+			"replacement closing tx %v: %v", c.chanPoint,
+			closeTx.TxHash(), err)
+
+	case err != nil:
+		return nil, false, err
+	}
+
+	return []lnwire.Message{closingSig}, !replacement, nil
+}
+
+// processClosingSig completes the closing transaction that we've proposed
+// with the signature of the remote party, and broadcasts it.
+func (c *ChanCloser) processClosingSig(msg *lnwire.ClosingSig) (
+	[]lnwire.Message, bool, error) {
+
+	// The remote party may sign a proposal that we've since replaced, in
+	// which case we'll wait for its signature of the latest one.
+	offer := c.localOffer
+	if offer == nil || msg.FeeSatoshis != offer.msg.FeeSatoshis ||
+		msg.LockTime != offer.msg.LockTime {
+
+		chancloserLog.Debugf("ChannelPoint(%v): ignoring closing_sig "+
+			"for outdated proposal with fee of %v sat",
+			c.chanPoint, int64(msg.FeeSatoshis))
+
+		return nil, false, nil
+	}
+
+	closerOutput, closeeOutput := c.closingOutputs(true)
+	remoteWireSig := msg.ClosingSigs.Sig(closerOutput, closeeOutput)
+	if remoteWireSig == nil {
+		return nil, false, fmt.Errorf("closing_sig has no signature "+
+			"for closing tx with closer_output=%v, "+
+			"closee_output=%v", closerOutput, closeeOutput)
+	}
+
+	remoteSig, err := remoteWireSig.ToSignature()
+	if err != nil {
+		return nil, false, err
+	}
+
+	closeTx, _, err := c.cfg.Channel.CompleteCooperativeClose(
+		offer.localSig, remoteSig, c.localDeliveryScript,
+		c.remoteDeliveryScript, offer.msg.FeeSatoshis,
+		lnwallet.WithCustomPayer(true),
+		lnwallet.WithCustomLockTime(offer.msg.LockTime),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.localOffer = nil
+
+	replacement := c.state == closeFinished
+	err = c.broadcastClosingTx(
+		closeTx, offer.msg.FeeSatoshis, offer.feeRate,
+	)
+	switch {
+	// If the replacement can't be broadcast, e.g. because its fee isn't
+	// high enough to replace the closing transaction in the mempool, the
+	// prior closing transaction remains valid. So we only fail the close
+	// request that bumped the fee.
+	case err != nil && replacement:
+		chancloserLog.Warnf("ChannelPoint(%v): unable to broadcast "+
+			"replacement closing tx %v: %v", c.chanPoint,
+			closeTx.TxHash(), err)
+
+		if offer.bump && c.closeReq != nil {
+			c.closeReq.Err <- fmt.Errorf("unable to broadcast "+
+				"replacement closing tx: %w", err)
+		}
+
+		return nil, false, nil
+
+	case err != nil:
+		return nil, false, err
+	}
+
+	return nil, !replacement || offer.bump, nil
+}
+
+// broadcastClosingTx broadcasts a closing transaction that's been negotiated
+// with option_simple_close, and records it as the closing transaction of the
+// channel, along with the state of the negotiation. The passed fee rate is
+// the one of the latest closing transaction that we've proposed.
+func (c *ChanCloser) broadcastClosingTx(closeTx *wire.MsgTx,
+	fee btcutil.Amount, feeRate chainfee.SatPerKWeight) error {
+
+	chancloserLog.Infof("Broadcasting cooperative close tx: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(closeTx)
+		}),
+	)
+
+	// Create a close channel label.
+	chanID := c.cfg.Channel.ShortChanID()
+	closeLabel := labels.MakeLabel(
+		labels.LabelTypeChannelClose, &chanID,
+	)
+
+	// The state of the negotiation is persisted along with the closing
+	// tx, so that the closer can be restored to replace it after a
+	// restart.
+	state := &channeldb.SimpleCloseState{
+		LocalDeliveryScript:  c.localDeliveryScript,
+		RemoteDeliveryScript: c.remoteDeliveryScript,
+		LockTime:             closeTx.LockTime,
+		ClosingFee:           fee,
+		FeeRate:              feeRate,
+	}
+	markBroadcasted := func() error {
+		return c.cfg.Channel.MarkSimpleCoopBroadcasted(
+			closeTx, c.locallyInitiated, state,
+		)
+	}
+
+	// Before publishing the first closing tx, we persist it to the
+	// database, such that it can be republished if something goes wrong.
+	// A replacement is only persisted once it's been published, as the
+	// prior closing transaction remains valid otherwise.
+	if c.state != closeFinished {
+		if err := markBroadcasted(); err != nil {
+			return err
+		}
+
+		if err := c.cfg.BroadcastTx(closeTx, closeLabel); err != nil {
+			return err
+		}
+	} else {
+		if err := c.cfg.BroadcastTx(closeTx, closeLabel); err != nil {
+			return err
+		}
+
+		if err := markBroadcasted(); err != nil {
+			return err
+		}
+	}
+
+	c.closingTx = closeTx
+	c.closingFee = fee
+	c.closingFeeRate = feeRate
+	c.state = closeFinished
+
+	return nil
+}
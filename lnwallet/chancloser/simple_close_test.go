@@ -0,0 +1,279 @@
+package chancloser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnutils"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newSimpleCloser creates a closer with option_simple_close for the given
+// channel, which sends the closing transactions it broadcasts over the passed
+// channel.
+func newSimpleCloser(t *testing.T, channel *lnwallet.LightningChannel,
+	feeRate chainfee.SatPerKWeight, broadcasts chan *wire.MsgTx,
+	locallyInitiated bool) *ChanCloser {
+
+	deliveryScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).AddData(make([]byte, 20)).Script()
+	require.NoError(t, err)
+
+	return NewChanCloser(
+		ChanCloseCfg{
+			Channel:      channel,
+			FeeEstimator: &SimpleCoopFeeEstimator{},
+			BroadcastTx: func(tx *wire.MsgTx, _ string) error {
+				broadcasts <- tx
+				return nil
+			},
+			DisableChannel: func(wire.OutPoint) error {
+				return nil
+			},
+			Disconnect: func() error {
+				return nil
+			},
+			ChainParams: &chaincfg.RegressionNetParams,
+			SimpleClose: true,
+		}, deliveryScript, feeRate, 100, nil, locallyInitiated,
+	)
+}
+
+// closingFee returns the fee paid by the passed closing transaction of the
+// given channel.
+func closingFee(channel *lnwallet.LightningChannel,
+	closeTx *wire.MsgTx) btcutil.Amount {
+
+	fee := btcutil.Amount(channel.FundingTxOut().Value)
+	for _, txOut := range closeTx.TxOut {
+		fee -= btcutil.Amount(txOut.Value)
+	}
+
+	return fee
+}
+
+// TestSimpleClose tests that a closing transaction negotiated with
+// option_simple_close is broadcast by both parties, and that the closer can
+// replace it with one that pays a higher fee.
+func TestSimpleClose(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	aliceTxns := make(chan *wire.MsgTx, 2)
+	bobTxns := make(chan *wire.MsgTx, 2)
+
+	aliceCloser := newSimpleCloser(t, aliceChannel, 1000, aliceTxns, true)
+	bobCloser := newSimpleCloser(t, bobChannel, 2000, bobTxns, false)
+
+	// Alice kicks off the close, and Bob only replies with his shutdown,
+	// as Alice proposes the closing transaction.
+	msg, err := aliceCloser.ShutdownChan()
+	require.NoError(t, err)
+
+	bobMsgs, closeFin, err := bobCloser.ProcessCloseMsg(msg)
+	require.NoError(t, err)
+	require.False(t, closeFin)
+	require.Len(t, bobMsgs, 1)
+	require.IsType(t, &lnwire.Shutdown{}, bobMsgs[0])
+
+	// Alice proposes a closing transaction at her own fee rate.
+	aliceMsgs, closeFin, err := aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.False(t, closeFin)
+	require.Len(t, aliceMsgs, 1)
+
+	closingComplete := assertType[*lnwire.ClosingComplete](t, aliceMsgs[0])
+	require.Equal(t, uint32(100), closingComplete.LockTime)
+	require.NotNil(t, closingComplete.ClosingSigs.CloserAndClosee)
+
+	// Bob accepts the fee, as it's paid by Alice, and broadcasts the
+	// closing transaction.
+	bobMsgs, closeFin, err = bobCloser.ProcessCloseMsg(closingComplete)
+	require.NoError(t, err)
+	require.True(t, closeFin)
+	require.Len(t, bobMsgs, 1)
+
+	bobTx, err := lnutils.RecvOrTimeout(bobTxns, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), (*bobTx).LockTime)
+	require.Equal(
+		t, closingComplete.FeeSatoshis, closingFee(bobChannel, *bobTx),
+	)
+
+	// Alice completes the closing transaction with Bob's signature and
+	// broadcasts the same transaction.
+	aliceMsgs, closeFin, err = aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.True(t, closeFin)
+	require.Empty(t, aliceMsgs)
+
+	aliceTx, err := lnutils.RecvOrTimeout(aliceTxns, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, (*bobTx).TxHash(), (*aliceTx).TxHash())
+
+	// A replacement that doesn't pay a higher fee is rejected.
+	_, err = aliceCloser.BumpFee(1000, nil)
+	require.ErrorIs(t, err, ErrCloseFeeTooLow)
+
+	// Alice now bumps the fee of the closing transaction.
+	closingComplete, err = aliceCloser.BumpFee(5000, nil)
+	require.NoError(t, err)
+	require.Greater(
+		t, closingComplete.FeeSatoshis, closingFee(bobChannel, *bobTx),
+	)
+
+	// Bob broadcasts the replacement, but doesn't consider it the
+	// conclusion of a close request, as he didn't ask for it.
+	bobMsgs, closeFin, err = bobCloser.ProcessCloseMsg(closingComplete)
+	require.NoError(t, err)
+	require.False(t, closeFin)
+	require.Len(t, bobMsgs, 1)
+
+	bobTx, err = lnutils.RecvOrTimeout(bobTxns, time.Second)
+	require.NoError(t, err)
+	require.Equal(
+		t, closingComplete.FeeSatoshis, closingFee(bobChannel, *bobTx),
+	)
+
+	// Alice broadcasts the replacement as well, which concludes her bump.
+	aliceMsgs, closeFin, err = aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.True(t, closeFin)
+	require.Empty(t, aliceMsgs)
+
+	aliceTx, err = lnutils.RecvOrTimeout(aliceTxns, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, (*bobTx).TxHash(), (*aliceTx).TxHash())
+
+	// A stale signature of a prior proposal is ignored.
+	aliceMsgs, closeFin, err = aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.False(t, closeFin)
+	require.Empty(t, aliceMsgs)
+}
+
+// TestBumpFeeNotReplaceable tests that the fee of a closing transaction can
+// only be bumped with option_simple_close once it's been broadcast.
+func TestBumpFeeNotReplaceable(t *testing.T) {
+	t.Parallel()
+
+	closer := NewChanCloser(
+		ChanCloseCfg{
+			Channel:      &mockChannel{},
+			FeeEstimator: &SimpleCoopFeeEstimator{},
+		}, nil, 1000, 0, nil, true,
+	)
+
+	_, err := closer.BumpFee(2000, nil)
+	require.ErrorIs(t, err, ErrCloseNotReplaceable)
+
+	closer.cfg.SimpleClose = true
+	_, err = closer.BumpFee(2000, nil)
+	require.ErrorIs(t, err, ErrCloseNotBroadcast)
+}
+
+// TestSimpleCloseRestore tests that a closer restored from the persisted state
+// of an option_simple_close negotiation can replace the closing transaction.
+func TestSimpleCloseRestore(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	aliceTxns := make(chan *wire.MsgTx, 2)
+	bobTxns := make(chan *wire.MsgTx, 2)
+
+	aliceCloser := newSimpleCloser(t, aliceChannel, 1000, aliceTxns, true)
+	bobCloser := newSimpleCloser(t, bobChannel, 2000, bobTxns, false)
+
+	// Alice and Bob exchange their shutdown messages, and complete the
+	// closing transaction that Alice proposes.
+	msg, err := aliceCloser.ShutdownChan()
+	require.NoError(t, err)
+
+	bobMsgs, _, err := bobCloser.ProcessCloseMsg(msg)
+	require.NoError(t, err)
+	require.Len(t, bobMsgs, 1)
+
+	aliceMsgs, _, err := aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.Len(t, aliceMsgs, 1)
+
+	bobMsgs, _, err = bobCloser.ProcessCloseMsg(aliceMsgs[0])
+	require.NoError(t, err)
+	require.Len(t, bobMsgs, 1)
+
+	_, closeFin, err := aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.True(t, closeFin)
+
+	closeTx, err := lnutils.RecvOrTimeout(aliceTxns, time.Second)
+	require.NoError(t, err)
+	_, err = lnutils.RecvOrTimeout(bobTxns, time.Second)
+	require.NoError(t, err)
+
+	// Alice's state of the negotiation was persisted along with the
+	// closing transaction.
+	state, err := aliceChannel.SimpleCloseState()
+	require.NoError(t, err)
+	require.Equal(t, aliceCloser.localDeliveryScript,
+		state.LocalDeliveryScript)
+	require.Equal(t, aliceCloser.remoteDeliveryScript,
+		state.RemoteDeliveryScript)
+	require.Equal(t, uint32(100), state.LockTime)
+	require.Equal(t, closingFee(aliceChannel, *closeTx), state.ClosingFee)
+	require.Equal(t, chainfee.SatPerKWeight(1000), state.FeeRate)
+
+	broadcastTx, err := aliceChannel.State().BroadcastedCooperative()
+	require.NoError(t, err)
+	require.Equal(t, (*closeTx).TxHash(), broadcastTx.TxHash())
+
+	// Alice restores her closer from the persisted state, which rejects a
+	// replacement that doesn't pay a higher fee.
+	aliceCloser = NewSimpleChanCloser(
+		aliceCloser.cfg, broadcastTx, state, true,
+	)
+
+	_, err = aliceCloser.BumpFee(1000, nil)
+	require.ErrorIs(t, err, ErrCloseFeeTooLow)
+
+	// Alice bumps the fee, and both broadcast the replacement.
+	closingComplete, err := aliceCloser.BumpFee(5000, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), closingComplete.LockTime)
+
+	bobMsgs, _, err = bobCloser.ProcessCloseMsg(closingComplete)
+	require.NoError(t, err)
+	require.Len(t, bobMsgs, 1)
+
+	bobTx, err := lnutils.RecvOrTimeout(bobTxns, time.Second)
+	require.NoError(t, err)
+
+	_, closeFin, err = aliceCloser.ProcessCloseMsg(bobMsgs[0])
+	require.NoError(t, err)
+	require.True(t, closeFin)
+
+	aliceTx, err := lnutils.RecvOrTimeout(aliceTxns, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, (*bobTx).TxHash(), (*aliceTx).TxHash())
+
+	// The state of the replacement is persisted as well.
+	state, err = aliceChannel.SimpleCloseState()
+	require.NoError(t, err)
+	require.Equal(t, closingComplete.FeeSatoshis, state.ClosingFee)
+	require.Equal(t, chainfee.SatPerKWeight(5000), state.FeeRate)
+}
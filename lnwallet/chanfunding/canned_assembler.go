@@ -113,6 +113,14 @@ func (s *ShimIntent) RemoteFundingAmt() btcutil.Amount {
 	return s.remoteFundingAmt
 }
 
+// SetRemoteFundingAmt sets the amount that the remote party puts into the
+// funding output. This is used when the remote contribution is only known after
+// the intent was created, as is the case for the initiator of a dual funded
+// channel.
+func (s *ShimIntent) SetRemoteFundingAmt(amt btcutil.Amount) {
+	s.remoteFundingAmt = amt
+}
+
 // ChanPoint returns the final outpoint that will create the funding output
 // described above.
 //
@@ -164,6 +164,90 @@ func (f *FullIntent) CompileFundingTx(extraInputs []*wire.TxIn,
 	return fundingTx, nil
 }
 
+// CompileInteractiveFundingTx is the counterpart of CompileFundingTx for a
+// funding transaction that was constructed interactively with the remote
+// party. It locates the funding output within the transaction and signs all
+// inputs that spend our coins, using the fetcher to look up the outputs that
+// are spent by the inputs of both parties. The passed transaction isn't
+// modified, instead a signed copy is returned.
+func (f *FullIntent) CompileInteractiveFundingTx(tx *wire.MsgTx,
+	prevOutFetcher txscript.PrevOutputFetcher) (*wire.MsgTx, error) {
+
+	_, fundingOutput, err := f.FundingOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	fundingTx := tx.Copy()
+	found, multiSigIndex := input.FindScriptOutputIndex(
+		fundingTx, fundingOutput.PkScript,
+	)
+	if !found {
+		return nil, fmt.Errorf("funding output not found")
+	}
+
+	if fundingTx.TxOut[multiSigIndex].Value != fundingOutput.Value {
+		return nil, fmt.Errorf("funding output has value %v, "+
+			"expected %v", fundingTx.TxOut[multiSigIndex].Value,
+			fundingOutput.Value)
+	}
+
+	err = SignCoins(f.signer, fundingTx, f.InputCoins, prevOutFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	f.chanPoint = &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: multiSigIndex,
+	}
+
+	return fundingTx, nil
+}
+
+// SignCoins signs the inputs of the transaction that spend one of the coins,
+// and leaves all other inputs untouched.
+func SignCoins(signer input.Signer, tx *wire.MsgTx, coins []Coin,
+	prevOutFetcher txscript.PrevOutputFetcher) error {
+
+	ourCoins := make(map[wire.OutPoint]Coin, len(coins))
+	for _, coin := range coins {
+		ourCoins[coin.OutPoint] = coin
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+	for i, txIn := range tx.TxIn {
+		coin, ok := ourCoins[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+
+		signDesc := input.SignDescriptor{
+			Output: &wire.TxOut{
+				Value:    coin.Value,
+				PkScript: coin.PkScript,
+			},
+			HashType:          txscript.SigHashAll,
+			SigHashes:         sigHashes,
+			PrevOutputFetcher: prevOutFetcher,
+			InputIndex:        i,
+		}
+		if txscript.IsPayToTaproot(coin.PkScript) {
+			signDesc.HashType = txscript.SigHashDefault
+		}
+
+		inputScript, err := signer.ComputeInputScript(tx, &signDesc)
+		if err != nil {
+			return err
+		}
+
+		txIn.SignatureScript = inputScript.SigScript
+		txIn.Witness = inputScript.Witness
+	}
+
+	return nil
+}
+
 // Inputs returns all inputs to the final funding transaction that we
 // know about. Since this funding transaction is created all from our wallet,
 // it will be all inputs.
@@ -353,6 +353,13 @@ type PaymentDescriptor struct {
 	// NOTE: Populated only on add payment descriptor entry types.
 	OnionBlob []byte
 
+	// BlindingPoint is an optional ephemeral key that is used in route
+	// blinding. It is received in update_add_htlc and is needed to decrypt
+	// the route blinding data of the HTLC's onion payload.
+	//
+	// NOTE: Populated only on add payment descriptor entry types.
+	BlindingPoint *lnwire.BlindingPoint
+
 	// ShaOnionBlob is a sha of the onion blob.
 	//
 	// NOTE: Populated only in payment descriptor with MalformedFail type.
@@ -421,12 +428,13 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 
 		case *lnwire.UpdateAddHTLC:
 			pd = PaymentDescriptor{
-				RHash:     wireMsg.PaymentHash,
-				Timeout:   wireMsg.Expiry,
-				Amount:    wireMsg.Amount,
-				EntryType: Add,
-				HtlcIndex: wireMsg.ID,
-				LogIndex:  logUpdate.LogIndex,
+				RHash:         wireMsg.PaymentHash,
+				Timeout:       wireMsg.Expiry,
+				Amount:        wireMsg.Amount,
+				EntryType:     Add,
+				HtlcIndex:     wireMsg.ID,
+				LogIndex:      logUpdate.LogIndex,
+				BlindingPoint: wireMsg.BlindingPoint,
 				SourceRef: &channeldb.AddRef{
 					Height: height,
 					Index:  uint16(i),
@@ -570,6 +578,11 @@ type commitment struct {
 	// view.
 	outgoingHTLCIndex map[int32]*PaymentDescriptor
 	incomingHTLCIndex map[int32]*PaymentDescriptor
+
+	// splice is the commitment at the same height that spends the funding
+	// output of a pending splice. It's only set while the channel is being
+	// spliced.
+	splice *commitment
 }
 
 // locateOutputIndex is a small helper function to locate the output index of a
@@ -722,7 +735,9 @@ func (c *commitment) populateHtlcIndexes(chanType channeldb.ChannelType,
 
 // toDiskCommit converts the target commitment into a format suitable to be
 // written to disk after an accepted state transition.
-func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
+func (c *commitment) toDiskCommit(
+	ourCommit bool) (*channeldb.ChannelCommitment, error) {
+
 	numHtlcs := len(c.outgoingHTLCs) + len(c.incomingHTLCs)
 
 	commit := &channeldb.ChannelCommitment{
@@ -757,6 +772,12 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 		}
 		copy(h.OnionBlob[:], htlc.OnionBlob)
 
+		extraData, err := htlcExtraData(&htlc)
+		if err != nil {
+			return nil, err
+		}
+		h.ExtraData = extraData
+
 		if ourCommit && htlc.sig != nil {
 			h.Signature = htlc.sig.Serialize()
 		}
@@ -781,6 +802,12 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 		}
 		copy(h.OnionBlob[:], htlc.OnionBlob)
 
+		extraData, err := htlcExtraData(&htlc)
+		if err != nil {
+			return nil, err
+		}
+		h.ExtraData = extraData
+
 		if ourCommit && htlc.sig != nil {
 			h.Signature = htlc.sig.Serialize()
 		}
@@ -788,7 +815,22 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 		commit.Htlcs = append(commit.Htlcs, h)
 	}
 
-	return commit
+	return commit, nil
+}
+
+// htlcExtraData encodes the optional TLV records of an HTLC that need to be
+// persisted along with the commitment it's part of.
+func htlcExtraData(pd *PaymentDescriptor) ([]byte, error) {
+	if pd.BlindingPoint == nil {
+		return nil, nil
+	}
+
+	var extraData lnwire.ExtraOpaqueData
+	if err := extraData.PackRecords(pd.BlindingPoint); err != nil {
+		return nil, err
+	}
+
+	return extraData, nil
 }
 
 // diskHtlcToPayDesc converts an HTLC previously written to disk within a
@@ -863,6 +905,11 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 	// With the scripts reconstructed (depending on if this is our commit
 	// vs theirs or a pending commit for the remote party), we can now
 	// re-create the original payment descriptor.
+	blindingPoint, err := lnwire.ExtractBlindingPoint(htlc.ExtraData)
+	if err != nil {
+		return pd, err
+	}
+
 	pd = PaymentDescriptor{
 		RHash:              htlc.RHash,
 		Timeout:            htlc.RefundTimeout,
@@ -871,6 +918,7 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 		HtlcIndex:          htlc.HtlcIndex,
 		LogIndex:           htlc.LogIndex,
 		OnionBlob:          htlc.OnionBlob[:],
+		BlindingPoint:      blindingPoint,
 		localOutputIndex:   localOutputIndex,
 		remoteOutputIndex:  remoteOutputIndex,
 		ourPkScript:        ourP2WSH,
@@ -1344,6 +1392,16 @@ type LightningChannel struct {
 	// fundingOutput is the funding output (script+value).
 	fundingOutput wire.TxOut
 
+	// pendingSplice is the splice of the channel that hasn't confirmed
+	// yet. While it's set, every new commitment is also created for the
+	// funding output of the splice.
+	pendingSplice *pendingSplice
+
+	// upgradeSplicePending is true if the channel has a pending splice
+	// that upgrades it to a new channel type. No new commitments can be
+	// created until the splice confirms or is aborted.
+	upgradeSplicePending bool
+
 	sync.RWMutex
 }
 
@@ -1467,6 +1525,24 @@ func NewLightningChannel(signer input.Signer,
 		return nil, err
 	}
 
+	// If the channel is being spliced, we'll also need to create the
+	// commitments that spend the funding output of the splice.
+	if state.HasChanStatus(channeldb.ChanStatusPendingSplice) {
+		splice, err := state.PendingSplice()
+		if err != nil {
+			return nil, err
+		}
+
+		if splice.IsUpgrade() {
+			lc.upgradeSplicePending = true
+		} else {
+			lc.pendingSplice = newPendingSplice(
+				state, lc.commitBuilder, splice,
+				lc.fundingOutput.PkScript,
+			)
+		}
+	}
+
 	return lc, nil
 }
 
@@ -1563,6 +1639,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			EntryType:             Add,
 			HtlcIndex:             wireMsg.ID,
 			LogIndex:              logUpdate.LogIndex,
+			BlindingPoint:         wireMsg.BlindingPoint,
 			addCommitHeightRemote: commitHeight,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
@@ -1760,6 +1837,7 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			EntryType:            Add,
 			HtlcIndex:            wireMsg.ID,
 			LogIndex:             logUpdate.LogIndex,
+			BlindingPoint:        wireMsg.BlindingPoint,
 			addCommitHeightLocal: commitHeight,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
@@ -2417,6 +2495,14 @@ type BreachRetribution struct {
 func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 	breachHeight uint32, spendTx *wire.MsgTx) (*BreachRetribution, error) {
 
+	// The breached commitment may predate a dynamic commitment upgrade of
+	// the channel, in which case it's resolved with the parameters that
+	// were in effect at the time.
+	chanState, err := chanState.ChannelAtHeight(false, stateNum)
+	if err != nil {
+		return nil, err
+	}
+
 	// Query the on-disk revocation log for the snapshot which was recorded
 	// at this particular state num. Based on whether a legacy revocation
 	// log is returned or not, we will process them differently.
@@ -3021,31 +3107,11 @@ func (lc *LightningChannel) fetchCommitmentView(remoteChain bool,
 
 	// We'll assert that there hasn't been a mistake during fee calculation
 	// leading to a fee too low.
-	var totalOut btcutil.Amount
-	for _, txOut := range commitTx.txn.TxOut {
-		totalOut += btcutil.Amount(txOut.Value)
-	}
-	fee := lc.channelState.Capacity - totalOut
-
-	var witnessWeight int64
-	if lc.channelState.ChanType.IsTaproot() {
-		witnessWeight = input.TaprootKeyPathWitnessSize
-	} else {
-		witnessWeight = input.WitnessCommitmentTxWeight
-	}
-
-	// Since the transaction is not signed yet, we use the witness weight
-	// used for weight calculation.
-	uTx := btcutil.NewTx(commitTx.txn)
-	weight := blockchain.GetTransactionWeight(uTx) + witnessWeight
-
-	effFeeRate := chainfee.SatPerKWeight(fee) * 1000 /
-		chainfee.SatPerKWeight(weight)
-	if effFeeRate < chainfee.AbsoluteFeePerKwFloor {
-		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
-			"attempts to create commitment with feerate %v: %v",
-			nextHeight, lc.channelState.FundingOutpoint,
-			effFeeRate, spew.Sdump(commitTx))
+	err = lc.validateCommitFeeRate(
+		commitTx, lc.channelState.Capacity, nextHeight,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	// With the commitment view created, store the resulting balances and
@@ -3086,9 +3152,56 @@ func (lc *LightningChannel) fetchCommitmentView(remoteChain bool,
 		return nil, err
 	}
 
+	// If the channel is being spliced, the commitment at this height must
+	// also be created for the funding output of the splice.
+	if lc.pendingSplice != nil {
+		c.splice, err = lc.fetchSpliceCommitmentView(
+			c, ourBalance, theirBalance, filteredHTLCView, keyRing,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
+// validateCommitFeeRate ensures that the effective fee rate of the unsigned
+// commitment transaction, which spends a funding output of the given
+// capacity, isn't below the absolute fee floor.
+func (lc *LightningChannel) validateCommitFeeRate(commitTx *unsignedCommitmentTx,
+	capacity btcutil.Amount, height uint64) error {
+
+	var totalOut btcutil.Amount
+	for _, txOut := range commitTx.txn.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+	}
+	fee := capacity - totalOut
+
+	var witnessWeight int64
+	if lc.channelState.ChanType.IsTaproot() {
+		witnessWeight = input.TaprootKeyPathWitnessSize
+	} else {
+		witnessWeight = input.WitnessCommitmentTxWeight
+	}
+
+	// Since the transaction is not signed yet, we use the witness weight
+	// used for weight calculation.
+	uTx := btcutil.NewTx(commitTx.txn)
+	weight := blockchain.GetTransactionWeight(uTx) + witnessWeight
+
+	effFeeRate := chainfee.SatPerKWeight(fee) * 1000 /
+		chainfee.SatPerKWeight(weight)
+	if effFeeRate < chainfee.AbsoluteFeePerKwFloor {
+		return fmt.Errorf("height=%v, for ChannelPoint(%v) "+
+			"attempts to create commitment with feerate %v: %v",
+			height, lc.channelState.FundingOutpoint,
+			effFeeRate, spew.Sdump(commitTx))
+	}
+
+	return nil
+}
+
 // fundingTxIn returns the funding output as a transaction input. The input
 // returned by this function uses a max sequence number, so it isn't able to be
 // used with RBF by default.
@@ -3569,8 +3682,8 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 // new commitment to the remote party. The commit diff returned contains all
 // information necessary for retransmission.
 func (lc *LightningChannel) createCommitDiff(
-	newCommit *commitment, commitSig lnwire.Sig,
-	htlcSigs []lnwire.Sig) (*channeldb.CommitDiff, error) {
+	newCommit *commitment, commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	spliceSig *lnwire.SpliceSig) (*channeldb.CommitDiff, error) {
 
 	// First, we need to convert the funding outpoint into the ID that's
 	// used on the wire to identify this channel. We'll use this shortly
@@ -3616,11 +3729,12 @@ func (lc *LightningChannel) createCommitDiff(
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3690,7 +3804,18 @@ func (lc *LightningChannel) createCommitDiff(
 	// With the set of log updates mapped into wire messages, we'll now
 	// convert the in-memory commit into a format suitable for writing to
 	// disk.
-	diskCommit := newCommit.toDiskCommit(false)
+	diskCommit, err := newCommit.toDiskCommit(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var spliceCommit *channeldb.ChannelCommitment
+	if newCommit.splice != nil {
+		spliceCommit, err = newCommit.splice.toDiskCommit(false)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &channeldb.CommitDiff{
 		Commitment: *diskCommit,
@@ -3700,7 +3825,9 @@ func (lc *LightningChannel) createCommitDiff(
 			),
 			CommitSig: commitSig,
 			HtlcSigs:  htlcSigs,
+			SpliceSig: spliceSig,
 		},
+		SpliceCommitment:  spliceCommit,
 		LogUpdates:        logUpdates,
 		OpenedCircuitKeys: openCircuitKeys,
 		ClosedCircuitKeys: closedCircuitKeys,
@@ -3753,11 +3880,12 @@ func (lc *LightningChannel) getUnsignedAckedUpdates() []channeldb.LogUpdate {
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3861,6 +3989,20 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 			ErrBelowChanReserve)
 	}
 
+	// The balances must also cover any funds that are spliced out of the
+	// channel by a pending splice.
+	if lc.pendingSplice != nil {
+		switch {
+		case int64(ourBalance)+lc.pendingSplice.localDelta < 0:
+			return fmt.Errorf("%w: negative local balance after "+
+				"splice", ErrBelowChanReserve)
+
+		case int64(theirBalance)+lc.pendingSplice.remoteDelta < 0:
+			return fmt.Errorf("%w: negative remote balance after "+
+				"splice", ErrBelowChanReserve)
+		}
+	}
+
 	// Ensure that the fee being applied is enough to be relayed across the
 	// network in a reasonable time frame.
 	if feePerKw < chainfee.FeePerKwFloor {
@@ -3975,6 +4117,11 @@ type CommitSigs struct {
 	// PartialSig is the musig2 partial signature for taproot commitment
 	// transactions.
 	PartialSig *lnwire.PartialSigWithNonce
+
+	// SpliceSig holds the signatures of the commitment that spends the
+	// funding output of a pending splice. It's only set while the channel
+	// is being spliced.
+	SpliceSig *lnwire.SpliceSig
 }
 
 // NewCommitState wraps the various signatures needed to properly
@@ -4017,6 +4164,12 @@ func (lc *LightningChannel) SignNextCommitment() (*NewCommitState, error) {
 		htlcSigs   []lnwire.Sig
 	)
 
+	// The commitments of a channel with a pending upgrade splice can't be
+	// updated until the splice confirms.
+	if lc.upgradeSplicePending {
+		return nil, ErrUpgradeSplicePending
+	}
+
 	// If we're awaiting for an ACK to a commitment signature, or if we
 	// don't yet have the initial next revocation point of the remote
 	// party, then we're unable to create new states. Each time we create a
@@ -4159,10 +4312,24 @@ func (lc *LightningChannel) SignNextCommitment() (*NewCommitState, error) {
 		htlcSigs = append(htlcSigs, jobResp.Sig)
 	}
 
+	// If the channel is being spliced, we'll also sign their commitment
+	// that spends the funding output of the splice.
+	var spliceSig *lnwire.SpliceSig
+	if newCommitView.splice != nil {
+		spliceSig, err = lc.signSpliceCommitView(
+			newCommitView.splice, keyRing,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// As we're about to proposer a new commitment state for the remote
 	// party, we'll write this pending state to disk before we exit, so we
 	// can retransmit it if necessary.
-	commitDiff, err := lc.createCommitDiff(newCommitView, sig, htlcSigs)
+	commitDiff, err := lc.createCommitDiff(
+		newCommitView, sig, htlcSigs, spliceSig,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -4184,6 +4351,7 @@ func (lc *LightningChannel) SignNextCommitment() (*NewCommitState, error) {
 			CommitSig:  sig,
 			HtlcSigs:   htlcSigs,
 			PartialSig: partialSig,
+			SpliceSig:  spliceSig,
 		},
 		PendingHTLCs: commitDiff.Commitment.Htlcs,
 	}, nil
@@ -4224,6 +4392,12 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 		closedCircuits []models.CircuitKey
 	)
 
+	// Before the commitments are synced, we'll make sure that both of us
+	// use the same channel parameters.
+	if err := lc.syncUpgrades(msg); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// If the remote party included the optional fields, then we'll verify
 	// their correctness first, as it will influence our decisions below.
 	hasRecoveryOptions := msg.LocalUnrevokedCommitPoint != nil
@@ -4376,6 +4550,7 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 					CommitSig:  newCommit.CommitSig,
 					HtlcSigs:   newCommit.HtlcSigs,
 					PartialSig: newCommit.PartialSig,
+					SpliceSig:  newCommit.SpliceSig,
 				}
 
 				updates = append(updates, commitSig)
@@ -4574,6 +4749,16 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 		theirBalance += lnwire.NewMSatFromSatoshis(
 			commitChain.tip().fee)
 	}
+
+	// If the channel has been upgraded to anchors since the last
+	// commitment, the initiator pays for the anchor outputs of the next
+	// one.
+	anchorCost := lc.upgradeAnchorCost(commitChain.tip())
+	if lc.channelState.IsInitiator {
+		ourBalance -= anchorCost
+	} else {
+		theirBalance -= anchorCost
+	}
 	nextHeight := commitChain.tip().height + 1
 
 	// Initiate feePerKw to the last committed fee for this chain as we'll
@@ -4956,6 +5141,12 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSigs *CommitSigs) error {
 		lc.log.Warnf("empty commit sig message received")
 	}
 
+	// The commitments of a channel with a pending upgrade splice can't be
+	// updated until the splice confirms.
+	if lc.upgradeSplicePending {
+		return ErrUpgradeSplicePending
+	}
+
 	// Determine the last update on the local log that has been locked in.
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
 	localHtlcIndex := lc.remoteCommitChain.tail().ourHtlcIndex
@@ -5164,6 +5355,17 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSigs *CommitSigs) error {
 		}
 	}
 
+	// If the channel is being spliced, they must also have signed our
+	// commitment that spends the funding output of the splice.
+	if localCommitmentView.splice != nil {
+		err := lc.verifySpliceCommitView(
+			localCommitmentView.splice, keyRing, commitSigs.SpliceSig,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	// The signature checks out, so we can now add the new commitment to
 	// our local commitment chain. For regular channels, we can just
 	// serialize the ECDSA sig. For taproot channels, we'll serialize the
@@ -5282,17 +5484,51 @@ func (lc *LightningChannel) oweCommitment(local bool) bool {
 			lastLocalCommit.ourMessageIndex
 	}
 
+	// A commitment signature is also owed if the commitment hasn't been
+	// upgraded yet after a dynamic commitment upgrade.
+	localUpgradeHeight, remoteUpgradeHeight :=
+		lc.channelState.UpgradeHeights()
+	upgradePending := lastRemoteCommit.height < remoteUpgradeHeight
+	if !local {
+		upgradePending = lastLocalCommit.height < localUpgradeHeight
+	}
+
 	// If any of the conditions above is true, we owe a commitment
 	// signature.
-	oweCommitment := localUpdatesPending || remoteUpdatesPending
+	oweCommitment := localUpdatesPending || remoteUpdatesPending ||
+		upgradePending
 
 	lc.log.Tracef("%v owes commit: %v (local updates: %v, "+
-		"remote updates %v)", perspective, oweCommitment,
-		localUpdatesPending, remoteUpdatesPending)
+		"remote updates %v, upgrade: %v)", perspective, oweCommitment,
+		localUpdatesPending, remoteUpdatesPending, upgradePending)
 
 	return oweCommitment
 }
 
+// HasPendingUpdates returns true if any updates that were proposed by the
+// given party haven't been irrevocably committed to both commitment
+// transactions yet. The updates are irrevocably committed once both
+// commitments include them and the prior commitments have been revoked.
+func (lc *LightningChannel) HasPendingUpdates(local bool) bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	localTail := lc.localCommitChain.tail()
+	remoteTail := lc.remoteCommitChain.tail()
+
+	if local {
+		logIndex := lc.localUpdateLog.logIndex
+
+		return localTail.ourMessageIndex != logIndex ||
+			remoteTail.ourMessageIndex != logIndex
+	}
+
+	logIndex := lc.remoteUpdateLog.logIndex
+
+	return localTail.theirMessageIndex != logIndex ||
+		remoteTail.theirMessageIndex != logIndex
+}
+
 // PendingLocalUpdateCount returns the number of local updates that still need
 // to be applied to the remote commitment tx.
 func (lc *LightningChannel) PendingLocalUpdateCount() uint64 {
@@ -5334,15 +5570,28 @@ func (lc *LightningChannel) RevokeCurrentCommitment() (*lnwire.RevokeAndAck,
 	// Additionally, generate a channel delta for this state transition for
 	// persistent storage.
 	chainTail := lc.localCommitChain.tail()
-	newCommitment := chainTail.toDiskCommit(true)
+	newCommitment, err := chainTail.toDiskCommit(true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	// Get the unsigned acked remotes updates that are currently in memory.
 	// We need them after a restart to sync our remote commitment with what
 	// is committed locally.
 	unsignedAckedUpdates := lc.getUnsignedAckedUpdates()
 
+	// If the channel is being spliced, we'll also store our new
+	// commitment that spends the funding output of the splice.
+	var spliceCommitment *channeldb.ChannelCommitment
+	if chainTail.splice != nil {
+		spliceCommitment, err = chainTail.splice.toDiskCommit(true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	finalHtlcs, err := lc.channelState.UpdateCommitment(
-		newCommitment, unsignedAckedUpdates,
+		newCommitment, unsignedAckedUpdates, spliceCommitment,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -5520,11 +5769,12 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -5580,12 +5830,23 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	// before the change since the indexes are meant for the current,
 	// revoked remote commitment.
 	ourOutputIndex, theirOutputIndex, err := findOutputIndexesFromRemote(
-		revocation, lc.channelState,
+		revocation, lc.channelState, &lc.channelState.RemoteCommitment,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
+	// If the channel is being spliced, the revoked commitment that spends
+	// the funding output of the splice is saved to the revocation log of
+	// the splice, so we'll need its output indexes as well.
+	var spliceIndexes *channeldb.SpliceOutputIndexes
+	if lc.pendingSplice != nil {
+		spliceIndexes, err = lc.spliceOutputIndexes(revocation)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
 	// Now that we have a new verification nonce from them, we can refresh
 	// our remote musig2 session which allows us to create another state.
 	if lc.channelState.ChanType.IsTaproot() {
@@ -5611,7 +5872,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	// commitment chain.
 	err = lc.channelState.AdvanceCommitChainTail(
 		fwdPkg, localPeerUpdates,
-		ourOutputIndex, theirOutputIndex,
+		ourOutputIndex, theirOutputIndex, spliceIndexes,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -5848,6 +6109,7 @@ func (lc *LightningChannel) htlcAddDescriptor(htlc *lnwire.UpdateAddHTLC,
 		LogIndex:       lc.localUpdateLog.logIndex,
 		HtlcIndex:      lc.localUpdateLog.htlcCounter,
 		OnionBlob:      htlc.OnionBlob[:],
+		BlindingPoint:  htlc.BlindingPoint,
 		OpenCircuitKey: openKey,
 	}
 }
@@ -5897,13 +6159,14 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 	}
 
 	pd := &PaymentDescriptor{
-		EntryType: Add,
-		RHash:     PaymentHash(htlc.PaymentHash),
-		Timeout:   htlc.Expiry,
-		Amount:    htlc.Amount,
-		LogIndex:  lc.remoteUpdateLog.logIndex,
-		HtlcIndex: lc.remoteUpdateLog.htlcCounter,
-		OnionBlob: htlc.OnionBlob[:],
+		EntryType:     Add,
+		RHash:         PaymentHash(htlc.PaymentHash),
+		Timeout:       htlc.Expiry,
+		Amount:        htlc.Amount,
+		LogIndex:      lc.remoteUpdateLog.logIndex,
+		HtlcIndex:     lc.remoteUpdateLog.htlcCounter,
+		OnionBlob:     htlc.OnionBlob[:],
+		BlindingPoint: htlc.BlindingPoint,
 	}
 
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
@@ -6407,6 +6670,16 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	remoteCommit channeldb.ChannelCommitment,
 	commitPoint *btcec.PublicKey) (*UnilateralCloseSummary, error) {
 
+	// The commitment may predate a dynamic commitment upgrade of the
+	// channel, in which case it's resolved with the parameters that were
+	// in effect at the time.
+	chanState, err := chanState.ChannelAtHeight(
+		false, remoteCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// First, we'll generate the commitment point and the revocation point
 	// so we can re-construct the HTLC state and also our payment key.
 	isOurCommit := false
@@ -7271,6 +7544,11 @@ type AnchorResolution struct {
 
 	// CommitWeight is the weight of the commit tx.
 	CommitWeight int64
+
+	// CommitTx is the signed commit tx. It's only set for the anchor of
+	// our local commitment if we've broadcast it, so that the commit tx
+	// can be submitted along with the anchor sweep as a package.
+	CommitTx *wire.MsgTx
 }
 
 // LocalForceCloseSummary describes the final commitment state before the
@@ -7362,6 +7640,14 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 	signer input.Signer, commitTx *wire.MsgTx, stateNum uint64) (
 	*LocalForceCloseSummary, error) {
 
+	// The commitment may predate a dynamic commitment upgrade of the
+	// channel, in which case it's resolved with the parameters that were
+	// in effect at the time.
+	chanState, err := chanState.ChannelAtHeight(true, stateNum)
+	if err != nil {
+		return nil, err
+	}
+
 	// Re-derive the original pkScript for to-self output within the
 	// commitment transaction. We'll need this to find the corresponding
 	// output in the commitment transaction and potentially for creating
@@ -7506,6 +7792,14 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 // close process.
 type chanCloseOpt struct {
 	musigSession *MusigSession
+
+	// customPayer, if set, is true if we pay the fee of the closing
+	// transaction, and false if the remote party pays it. By default, the
+	// initiator of the channel pays the fee.
+	customPayer *bool
+
+	// customLockTime is the lock time of the closing transaction.
+	customLockTime uint32
 }
 
 // ChanCloseOpt is a closure type that cen be used to modify the set of default
@@ -7526,6 +7820,61 @@ func WithCoopCloseMusigSession(session *MusigSession) ChanCloseOpt {
 	}
 }
 
+// WithCustomPayer can be used to pay the fee of the closing transaction from
+// the output of the given party rather than the initiator of the channel, as
+// done by option_simple_close. Such closing transactions signal RBF, and can be
+// created after the channel has been closed, as they can be replaced by one
+// that pays a higher fee.
+func WithCustomPayer(localPays bool) ChanCloseOpt {
+	return func(opts *chanCloseOpt) {
+		opts.customPayer = &localPays
+	}
+}
+
+// WithCustomLockTime can be used to set the lock time of the closing
+// transaction.
+func WithCustomLockTime(lockTime uint32) ChanCloseOpt {
+	return func(opts *chanCloseOpt) {
+		opts.customLockTime = lockTime
+	}
+}
+
+// coopCloseBalance returns the balances of the closing transaction for the
+// given close options.
+func (lc *LightningChannel) coopCloseBalance(fee btcutil.Amount,
+	opts *chanCloseOpt) (btcutil.Amount, btcutil.Amount, error) {
+
+	localPays := lc.channelState.IsInitiator
+	if opts.customPayer != nil {
+		localPays = *opts.customPayer
+	}
+
+	return CoopCloseBalanceWithPayer(
+		lc.channelState.ChanType, lc.channelState.IsInitiator,
+		localPays, fee, lc.channelState.LocalCommitment,
+	)
+}
+
+// closeTxOpts returns the options used to create the closing transaction for
+// the given close options.
+func (lc *LightningChannel) closeTxOpts(opts *chanCloseOpt) []CloseTxOpt {
+	var closeTxOpts []CloseTxOpt
+
+	// If this is a taproot channel, or the closing transaction can be
+	// replaced, then we use an RBF'able funding input.
+	if lc.channelState.ChanType.IsTaproot() || opts.customPayer != nil {
+		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
+	}
+
+	if opts.customLockTime != 0 {
+		closeTxOpts = append(
+			closeTxOpts, WithCloseTxLockTime(opts.customLockTime),
+		)
+	}
+
+	return closeTxOpts
+}
+
 // CreateCloseProposal is used by both parties in a cooperative channel close
 // workflow to generate proposed close transactions and signatures. This method
 // should only be executed once all pending HTLCs (if any) on the channel have
@@ -7544,39 +7893,31 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee btcutil.Amount,
 	lc.Lock()
 	defer lc.Unlock()
 
-	// If we've already closed the channel, then ignore this request.
-	if lc.status == channelClosed {
-		// TODO(roasbeef): check to ensure no pending payments
-		return nil, nil, 0, ErrChanClosing
-	}
-
 	opts := defaultCloseOpts()
 	for _, optFunc := range closeOpts {
 		optFunc(opts)
 	}
 
+	// If we've already closed the channel, then ignore this request,
+	// unless the closing transaction can be replaced.
+	if lc.status == channelClosed && opts.customPayer == nil {
+		// TODO(roasbeef): check to ensure no pending payments
+		return nil, nil, 0, ErrChanClosing
+	}
+
 	// Get the final balances after subtracting the proposed fee, taking
 	// care not to persist the adjusted balance, as the feeRate may change
 	// during the channel closing process.
-	ourBalance, theirBalance, err := CoopCloseBalance(
-		lc.channelState.ChanType, lc.channelState.IsInitiator,
-		proposedFee, lc.channelState.LocalCommitment,
-	)
+	ourBalance, theirBalance, err := lc.coopCloseBalance(proposedFee, opts)
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
-	var closeTxOpts []CloseTxOpt
-
-	// If this is a taproot channel, then we use an RBF'able funding input.
-	if lc.channelState.ChanType.IsTaproot() {
-		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
-	}
-
 	closeTx := CreateCooperativeCloseTx(
 		fundingTxIn(lc.channelState), lc.channelState.LocalChanCfg.DustLimit,
 		lc.channelState.RemoteChanCfg.DustLimit, ourBalance, theirBalance,
-		localDeliveryScript, remoteDeliveryScript, closeTxOpts...,
+		localDeliveryScript, remoteDeliveryScript,
+		lc.closeTxOpts(opts)...,
 	)
 
 	// Ensure that the transaction doesn't explicitly violate any
@@ -7632,40 +7973,33 @@ func (lc *LightningChannel) CompleteCooperativeClose(
 	lc.Lock()
 	defer lc.Unlock()
 
-	// If the channel is already closed, then ignore this request.
-	if lc.status == channelClosed {
-		// TODO(roasbeef): check to ensure no pending payments
-		return nil, 0, ErrChanClosing
-	}
-
 	opts := defaultCloseOpts()
 	for _, optFunc := range closeOpts {
 		optFunc(opts)
 	}
 
+	// If the channel is already closed, then ignore this request, unless
+	// the closing transaction can be replaced.
+	if lc.status == channelClosed && opts.customPayer == nil {
+		// TODO(roasbeef): check to ensure no pending payments
+		return nil, 0, ErrChanClosing
+	}
+
 	// Get the final balances after subtracting the proposed fee.
-	ourBalance, theirBalance, err := CoopCloseBalance(
-		lc.channelState.ChanType, lc.channelState.IsInitiator,
-		proposedFee, lc.channelState.LocalCommitment,
-	)
+	ourBalance, theirBalance, err := lc.coopCloseBalance(proposedFee, opts)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	var closeTxOpts []CloseTxOpt
-
-	// If this is a taproot channel, then we use an RBF'able funding input.
-	if lc.channelState.ChanType.IsTaproot() {
-		closeTxOpts = append(closeTxOpts, WithRBFCloseTx())
-	}
-
 	// Create the transaction used to return the current settled balance
-	// on this active channel back to both parties. In this current model,
-	// the initiator pays full fees for the cooperative close transaction.
+	// on this active channel back to both parties. Unless a custom payer
+	// is set, the initiator pays full fees for the cooperative close
+	// transaction.
 	closeTx := CreateCooperativeCloseTx(
 		fundingTxIn(lc.channelState), lc.channelState.LocalChanCfg.DustLimit,
 		lc.channelState.RemoteChanCfg.DustLimit, ourBalance, theirBalance,
-		localDeliveryScript, remoteDeliveryScript, closeTxOpts...,
+		localDeliveryScript, remoteDeliveryScript,
+		lc.closeTxOpts(opts)...,
 	)
 
 	// Ensure that the transaction doesn't explicitly validate any
@@ -7789,6 +8123,24 @@ func (lc *LightningChannel) NewAnchorResolutions() (*AnchorResolutions,
 	if err != nil {
 		return nil, err
 	}
+
+	// If we've broadcast our local commitment, it's attached to its
+	// anchor resolution, as it may need its anchor sweep to be accepted
+	// into the mempool.
+	if localRes != nil {
+		localCommitTx := lc.channelState.LocalCommitment.CommitTx
+
+		commitTx, err := lc.channelState.BroadcastedCommitment()
+		switch {
+		case err == nil:
+			if commitTx.TxHash() == localCommitTx.TxHash() {
+				localRes.CommitTx = commitTx
+			}
+
+		case !errors.Is(err, channeldb.ErrNoCloseTx):
+			return nil, err
+		}
+	}
 	resolutions.Local = localRes
 
 	// Add anchor for remote commitment tx, if any.
@@ -8001,12 +8353,24 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 		htlcView, true,
 	)
 
-	// Return which ever balance is lowest.
-	if ourRemoteCommitBalance < ourLocalCommitBalance {
-		return ourRemoteCommitBalance, commitWeight
+	// Use which ever balance is lowest.
+	balance := ourLocalCommitBalance
+	if ourRemoteCommitBalance < balance {
+		balance = ourRemoteCommitBalance
+	}
+
+	// Funds that are spliced out of the channel by a pending splice can't
+	// be used for new HTLCs, as they're missing on the commitments that
+	// spend the funding output of the splice.
+	if lc.pendingSplice != nil && lc.pendingSplice.localDelta < 0 {
+		spliceBalance := int64(balance) + lc.pendingSplice.localDelta
+		if spliceBalance < 0 {
+			spliceBalance = 0
+		}
+		balance = lnwire.MilliSatoshi(spliceBalance)
 	}
 
-	return ourLocalCommitBalance, commitWeight
+	return balance, commitWeight
 }
 
 // availableCommitmentBalance attempts to calculate the balance we have
@@ -8288,6 +8652,9 @@ type closeTxOpts struct {
 	// enableRBF indicates whether the cooperative close tx should signal
 	// RBF or not.
 	enableRBF bool
+
+	// lockTime is the lock time of the cooperative close tx.
+	lockTime uint32
 }
 
 // defaultCloseTxOpts returns a closeTxOpts struct with default values.
@@ -8308,6 +8675,13 @@ func WithRBFCloseTx() CloseTxOpt {
 	}
 }
 
+// WithCloseTxLockTime sets the lock time of the cooperative close tx.
+func WithCloseTxLockTime(lockTime uint32) CloseTxOpt {
+	return func(o *closeTxOpts) {
+		o.lockTime = lockTime
+	}
+}
+
 // CreateCooperativeCloseTx creates a transaction which if signed by both
 // parties, then broadcast cooperatively closes an active channel. The creation
 // of the closure transaction is modified by a boolean indicating if the party
@@ -8336,6 +8710,7 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 	// be omitted.
 	closeTx := wire.NewMsgTx(2)
 	closeTx.AddTxIn(&fundingTxIn)
+	closeTx.LockTime = opts.lockTime
 
 	// Create both cooperative closure outputs, properly respecting the
 	// dust limits of both parties.
@@ -8590,6 +8965,33 @@ func (lc *LightningChannel) MarkCoopBroadcasted(tx *wire.MsgTx,
 	return lc.channelState.MarkCoopBroadcasted(tx, localInitiated)
 }
 
+// MarkSimpleCoopBroadcasted marks the channel as a cooperative close
+// transaction that was negotiated with option_simple_close has been broadcast,
+// and persists the passed state of the negotiation along with it, so that the
+// closing transaction can be replaced after a restart.
+func (lc *LightningChannel) MarkSimpleCoopBroadcasted(tx *wire.MsgTx,
+	localInitiated bool, state *channeldb.SimpleCloseState) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.channelState.MarkSimpleCoopBroadcasted(
+		tx, localInitiated, state,
+	)
+}
+
+// SimpleCloseState returns the state of the cooperative close negotiated with
+// option_simple_close that was persisted along with the closing transaction.
+// If there's none, channeldb.ErrNoSimpleCloseState is returned.
+func (lc *LightningChannel) SimpleCloseState() (*channeldb.SimpleCloseState,
+	error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.channelState.SimpleCloseState()
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
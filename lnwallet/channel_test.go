@@ -932,6 +932,24 @@ func testForceClose(t *testing.T, testCase *forceCloseTestCase) {
 		require.Nil(t,
 			res.RemotePending, "expected no anchor resolution",
 		)
+
+		// The commit tx is only attached to the local anchor
+		// resolution once it has been broadcast.
+		require.Nil(t, res.Local.CommitTx)
+
+		err = aliceChannel.channelState.MarkCommitmentBroadcasted(
+			closeSummary.CloseTx, true,
+		)
+		require.NoError(t, err)
+
+		res, err = aliceChannel.NewAnchorResolutions()
+		require.NoError(t, err)
+		require.NotNil(t, res.Local.CommitTx)
+		require.Equal(
+			t, closeSummary.CloseTx.TxHash(),
+			res.Local.CommitTx.TxHash(),
+		)
+		require.Nil(t, res.Remote.CommitTx)
 	}
 
 	// The SelfOutputSignDesc should be non-nil since the output to-self is
@@ -9495,6 +9513,80 @@ func assertCleanOrDirty(clean bool, alice, bob *LightningChannel,
 	require.False(t, bob.IsChannelClean())
 }
 
+// TestHasPendingUpdates tests that the updates of each party are pending until
+// they're irrevocably committed to both commitments.
+func TestHasPendingUpdates(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	assertPending := func(alicePending, bobPending bool) {
+		t.Helper()
+
+		require.Equal(
+			t, alicePending, aliceChannel.HasPendingUpdates(true),
+		)
+		require.Equal(
+			t, alicePending, bobChannel.HasPendingUpdates(false),
+		)
+		require.Equal(
+			t, bobPending, bobChannel.HasPendingUpdates(true),
+		)
+		require.Equal(
+			t, bobPending, aliceChannel.HasPendingUpdates(false),
+		)
+	}
+
+	assertPending(false, false)
+
+	// Alice's update is pending once she adds an htlc.
+	htlc, preimage := createHTLC(0, lnwire.MilliSatoshi(5000000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	assertPending(true, false)
+
+	// Her update remains pending while it's only committed to Bob's
+	// commitment.
+	aliceNewCommit, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = bobChannel.ReceiveNewCommitment(aliceNewCommit.CommitSigs)
+	require.NoError(t, err)
+	bobRevocation, _, _, err := bobChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	require.NoError(t, err)
+	assertPending(true, false)
+
+	// Once Bob's signature for Alice's commitment is revoked, the htlc is
+	// irrevocably committed.
+	bobNewCommit, err := bobChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveNewCommitment(bobNewCommit.CommitSigs)
+	require.NoError(t, err)
+	assertPending(true, false)
+
+	aliceRevocation, _, _, err := aliceChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = bobChannel.ReceiveRevocation(aliceRevocation)
+	require.NoError(t, err)
+	assertPending(false, false)
+
+	// Bob's settle is pending until both commitments include it.
+	err = bobChannel.SettleHTLC(preimage, 0, nil, nil, nil)
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveHTLCSettle(preimage, 0)
+	require.NoError(t, err)
+	assertPending(false, true)
+
+	require.NoError(t, ForceStateTransition(bobChannel, aliceChannel))
+	assertPending(false, false)
+}
+
 // TestChannelGetDustSum tests that we correctly calculate the channel's dust
 // sum for the local and remote commitments.
 func TestChannelGetDustSum(t *testing.T) {
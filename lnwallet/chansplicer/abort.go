@@ -0,0 +1,147 @@
+package chansplicer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+)
+
+var (
+	// ErrNoSpliceInputs is returned when a pending splice is aborted that
+	// doesn't spend any of our wallet coins, so it can't be double spent
+	// by us.
+	ErrNoSpliceInputs = errors.New("splice tx doesn't spend any wallet " +
+		"coins")
+
+	// ErrAbortFeeTooLow is returned when the transaction that aborts a
+	// pending splice doesn't pay a higher fee than the splice transaction,
+	// so it can't replace it.
+	ErrAbortFeeTooLow = errors.New("fee rate too low to replace splice " +
+		"tx")
+)
+
+// AbortConfig holds all the items that are required to create the transaction
+// that aborts a pending splice.
+type AbortConfig struct {
+	// Splice is the pending splice that is aborted.
+	Splice *channeldb.ChannelSplice
+
+	// SharedInputValue is the value of the current funding output of the
+	// channel, which is spent by the splice transaction.
+	SharedInputValue btcutil.Amount
+
+	// FetchCoin returns the wallet coin that is spent by an input of the
+	// splice transaction, or lnwallet.ErrNotMine if it's not ours.
+	FetchCoin func(wire.OutPoint) (*chanfunding.Coin, error)
+
+	// Signer is used to sign the wallet coins.
+	Signer input.Signer
+
+	// FeeRate is the fee rate of the transaction that aborts the splice.
+	FeeRate chainfee.SatPerKWeight
+
+	// PkScript is the script of our wallet that the coins are sent to.
+	PkScript []byte
+}
+
+// AbortTx creates a transaction that double spends our wallet coins which are
+// spent by the pending splice transaction. Only the initiator of a splice
+// contributes wallet coins, so a splice can only be aborted by its initiator,
+// and only if it spliced funds in. The transaction must replace the splice
+// transaction in the mempool, so it has to pay a higher fee than the splice
+// transaction. Once it has confirmed, the splice is aborted.
+func AbortTx(cfg AbortConfig) (*wire.MsgTx, error) {
+	spliceTx := cfg.Splice.FundingTxn
+
+	var (
+		coins       []chanfunding.Coin
+		inputAmt    btcutil.Amount
+		weightEstim input.TxWeightEstimator
+	)
+	abortTx := wire.NewMsgTx(2)
+	for _, txIn := range spliceTx.TxIn {
+		coin, err := cfg.FetchCoin(txIn.PreviousOutPoint)
+		switch {
+		case errors.Is(err, lnwallet.ErrNotMine):
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		switch {
+		case txscript.IsPayToWitnessPubKeyHash(coin.PkScript):
+			weightEstim.AddP2WKHInput()
+
+		case txscript.IsPayToTaproot(coin.PkScript):
+			weightEstim.AddTaprootKeySpendInput(
+				txscript.SigHashDefault,
+			)
+
+		default:
+			return nil, fmt.Errorf("unsupported splice input "+
+				"script %x", coin.PkScript)
+		}
+
+		coins = append(coins, *coin)
+		inputAmt += btcutil.Amount(coin.Value)
+		abortTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: coin.OutPoint,
+			Sequence:         wire.MaxTxInSequenceNum - 2,
+		})
+	}
+
+	if len(coins) == 0 {
+		return nil, ErrNoSpliceInputs
+	}
+
+	if txscript.IsPayToTaproot(cfg.PkScript) {
+		weightEstim.AddP2TROutput()
+	} else {
+		weightEstim.AddP2WKHOutput()
+	}
+
+	// All inputs of the splice transaction other than the shared input are
+	// ours, as only the initiator contributes coins.
+	var outputAmt btcutil.Amount
+	for _, txOut := range spliceTx.TxOut {
+		outputAmt += btcutil.Amount(txOut.Value)
+	}
+	spliceFee := cfg.SharedInputValue + inputAmt - outputAmt
+
+	fee := cfg.FeeRate.FeeForWeight(int64(weightEstim.Weight()))
+	if fee <= spliceFee {
+		return nil, fmt.Errorf("%w: fee %v must be above the fee %v "+
+			"of the splice tx", ErrAbortFeeTooLow, fee, spliceFee)
+	}
+
+	outputValue := inputAmt - fee
+	if outputValue < lnwallet.DustLimitForSize(len(cfg.PkScript)) {
+		return nil, fmt.Errorf("splice inputs of %v don't cover the "+
+			"fee of %v", inputAmt, fee)
+	}
+	abortTx.AddTxOut(&wire.TxOut{
+		Value:    int64(outputValue),
+		PkScript: cfg.PkScript,
+	})
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i := range coins {
+		prevOuts.AddPrevOut(coins[i].OutPoint, &coins[i].TxOut)
+	}
+
+	err := chanfunding.SignCoins(cfg.Signer, abortTx, coins, prevOuts)
+	if err != nil {
+		return nil, err
+	}
+
+	return abortTx, nil
+}
@@ -0,0 +1,1082 @@
+package chansplicer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrChanAlreadySplicing is returned when a splice is initiated for a
+	// channel that is already being spliced.
+	ErrChanAlreadySplicing = errors.New("channel splice already " +
+		"initiated")
+
+	// ErrInvalidState is returned when the splice state machine receives
+	// a message that isn't expected in its current state.
+	ErrInvalidState = errors.New("invalid state")
+
+	// ErrSplicePending is returned when a channel is spliced whose
+	// previous splice hasn't confirmed yet.
+	ErrSplicePending = errors.New("channel has a pending splice")
+
+	// ErrSpliceTooSmall is returned when the amount of a splice is zero
+	// or negative.
+	ErrSpliceTooSmall = errors.New("splice amount must be positive")
+)
+
+// sharedInputWeight is the weight of the input of the splice transaction
+// that spends the current 2-of-2 multisig funding output.
+const sharedInputWeight = input.InputSize*4 + input.MultiSigWitnessSize
+
+// spliceState represents all the possible states the splice state machine can
+// be in. Each message will either advance to the next state, or fail the
+// splice.
+type spliceState uint8
+
+const (
+	// spliceIdle is the initial state. If a state machine receives a
+	// message in this state, we're the responder of the splice.
+	spliceIdle spliceState = iota
+
+	// spliceInitSent is the state of the initiator once it has sent
+	// splice_init, while it waits for splice_ack.
+	spliceInitSent
+
+	// spliceNegotiation is the state in which both sides construct the
+	// splice transaction with the interactive transaction protocol.
+	spliceNegotiation
+
+	// spliceCommitSigs is the state once the splice transaction has been
+	// constructed, in which both sides exchange signatures for the
+	// commitments that spend the new funding output.
+	spliceCommitSigs
+
+	// spliceTxSigs is the state in which we wait for the signatures of
+	// the remote party for the splice transaction.
+	spliceTxSigs
+
+	// spliceFinished is the final state, in which the splice transaction
+	// has been fully signed and broadcast.
+	spliceFinished
+)
+
+// Request is a request to splice funds into or out of a channel.
+type Request struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// Amount is the amount of funds that are spliced. Funds are spliced
+	// into the channel from our wallet, unless PkScript is set.
+	Amount btcutil.Amount
+
+	// PkScript is the script that Amount is spliced out to. If it's empty,
+	// Amount is spliced in from our wallet.
+	PkScript []byte
+
+	// FeeRate is the fee rate of the splice transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// MinConfs is the minimum number of confirmations of the wallet coins
+	// that are spliced in.
+	MinConfs int32
+
+	// CommitmentType is the commitment type that the splice upgrades the
+	// channel to, which moves the channel to a MuSig2 funding output for
+	// taproot channels. An upgrade splice doesn't splice any funds, so
+	// Amount must be zero, and its fee is paid from our balance.
+	CommitmentType *lnwallet.CommitmentType
+
+	// Updates receives the splice transaction once it has been fully
+	// signed and broadcast.
+	Updates chan *wire.MsgTx
+
+	// Err receives an error if the splice failed.
+	Err chan error
+}
+
+// Config holds all the items that a ChanSplicer requires to carry out its
+// duties.
+type Config struct {
+	// Channel is the channel that is spliced.
+	Channel Channel
+
+	// Signer is used to sign the wallet inputs of the splice transaction.
+	Signer input.Signer
+
+	// CoinSource is used to select the wallet coins that are spliced in.
+	CoinSource chanfunding.CoinSource
+
+	// CoinSelectLocker synchronizes the coin selection with other coin
+	// selections of the wallet.
+	CoinSelectLocker chanfunding.CoinSelectionLocker
+
+	// CoinLocker locks the selected coins, so they aren't used by other
+	// transactions while the splice is negotiated.
+	CoinLocker chanfunding.OutpointLocker
+
+	// FetchTx returns the wallet transaction with the given id, which is
+	// sent to the remote party for each of our inputs.
+	FetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
+	// ChangeScript returns a new script of our wallet for change.
+	ChangeScript func() ([]byte, error)
+
+	// BroadcastTx broadcasts the passed transaction to the network.
+	BroadcastTx func(*wire.MsgTx, string) error
+
+	// BestHeight returns the height of the best block.
+	BestHeight func() (uint32, error)
+
+	// MaxChanSize is the largest capacity that the channel may have after
+	// the splice. A zero value doesn't limit the capacity.
+	MaxChanSize btcutil.Amount
+}
+
+// ChanSplicer is a state machine that handles the splice of a channel. This
+// includes the negotiation of the splice transaction with the remote party,
+// the exchange of signatures for the commitments that spend the new funding
+// output, and finally broadcasting the fully signed splice transaction.
+//
+// Only the initiator of a splice contributes to the splice transaction, and it
+// pays all fees of the transaction from its balance or wallet inputs.
+type ChanSplicer struct {
+	// state is the current state of the state machine.
+	state spliceState
+
+	// cfg holds the configuration for this ChanSplicer instance.
+	cfg Config
+
+	// req is the splice request. It's only set if we initiated the
+	// splice.
+	req *Request
+
+	// chanPoint is the funding outpoint of the spliced channel.
+	chanPoint wire.OutPoint
+
+	// cid is the channel ID of the spliced channel.
+	cid lnwire.ChannelID
+
+	// feeRate is the fee rate of the splice transaction.
+	feeRate chainfee.SatPerKWeight
+
+	// lockTime is the lock time of the splice transaction.
+	lockTime uint32
+
+	// contribution is the amount that the initiator adds to its balance,
+	// which is negative for splice outs.
+	contribution btcutil.Amount
+
+	// coins are the wallet coins that we splice in.
+	coins []chanfunding.Coin
+
+	// changeAmt is the amount of the change output for our coins.
+	changeAmt btcutil.Amount
+
+	// session is the construction of the splice transaction.
+	session *interactivetx.Session
+
+	// spliceTx is the splice transaction once it has been constructed.
+	spliceTx *wire.MsgTx
+
+	// upgrade is set if the splice upgrades the channel to a new channel
+	// type.
+	upgrade *lnwallet.SpliceUpgrade
+
+	// localNonce is our verification nonce for our commitment that spends
+	// the new funding output of an upgrade splice.
+	localNonce *musig2.Nonces
+
+	// splice holds the commitments that spend the new funding output.
+	splice *lnwallet.Splice
+
+	// localInputSig is our signature for the shared input.
+	localInputSig input.Signature
+}
+
+// NewChanSplicer creates a new splice state machine for the channel. The
+// request is only set if we initiate the splice.
+func NewChanSplicer(cfg Config, req *Request) *ChanSplicer {
+	chanPoint := *cfg.Channel.ChannelPoint()
+
+	return &ChanSplicer{
+		state:     spliceIdle,
+		cfg:       cfg,
+		req:       req,
+		chanPoint: chanPoint,
+		cid:       lnwire.NewChanIDFromOutPoint(&chanPoint),
+	}
+}
+
+// Request returns the splice request, which is nil if the remote party
+// initiated the splice.
+func (c *ChanSplicer) Request() *Request {
+	return c.req
+}
+
+// IsUpgrade returns true if the splice upgrades the channel to a new channel
+// type.
+func (c *ChanSplicer) IsUpgrade() bool {
+	return c.upgrade != nil
+}
+
+// initiator returns true if we initiated the splice.
+func (c *ChanSplicer) initiator() bool {
+	return c.req != nil
+}
+
+// checkChannel verifies that the channel can be spliced.
+func (c *ChanSplicer) checkChannel() error {
+	if c.cfg.Channel.ChanType().IsTaproot() {
+		return lnwallet.ErrSpliceTaproot
+	}
+
+	if c.cfg.Channel.State().IsZeroConf() {
+		return errors.New("splicing zero-conf channels isn't " +
+			"supported")
+	}
+
+	// As the channel is used while its splice is pending, a new splice
+	// must wait until the pending one has confirmed or been aborted.
+	state := c.cfg.Channel.State()
+	if state.HasChanStatus(channeldb.ChanStatusPendingSplice) {
+		return ErrSplicePending
+	}
+
+	if !c.cfg.Channel.IsChannelClean() {
+		return lnwallet.ErrSpliceNotClean
+	}
+
+	return nil
+}
+
+// InitSplice starts the splice of the channel as the initiator. For splice
+// ins, our wallet coins are selected and locked. The returned splice_init
+// message should be sent to the remote party.
+func (c *ChanSplicer) InitSplice() (*lnwire.SpliceInit, error) {
+	if c.state != spliceIdle || !c.initiator() {
+		return nil, ErrChanAlreadySplicing
+	}
+
+	upgrade := c.req.CommitmentType != nil
+	switch {
+	case upgrade && (c.req.Amount != 0 || len(c.req.PkScript) > 0):
+		return nil, errors.New("upgrade splice can't splice funds")
+
+	case !upgrade && c.req.Amount <= 0:
+		return nil, ErrSpliceTooSmall
+	}
+
+	if err := c.checkChannel(); err != nil {
+		return nil, err
+	}
+
+	height, err := c.cfg.BestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	c.feeRate = c.req.FeeRate
+	c.lockTime = height
+
+	switch {
+	case upgrade:
+		err = c.initUpgrade()
+
+	case len(c.req.PkScript) > 0:
+		err = c.initSpliceOut()
+
+	default:
+		err = c.initSpliceIn()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chansplicerLog.Infof("ChannelPoint(%v): initiating splice with "+
+		"contribution of %v at %v", c.chanPoint, c.contribution,
+		c.feeRate)
+
+	c.state = spliceInitSent
+
+	spliceInit := &lnwire.SpliceInit{
+		ChannelID:            c.cid,
+		FundingContribution:  int64(c.contribution),
+		FundingFeePerKWeight: uint32(c.feeRate),
+		LockTime:             c.lockTime,
+	}
+	if upgrade {
+		chanType := lnwallet.UpgradeChanTypeToWire(c.upgrade.ChanType)
+		localNonce := lnwire.Musig2Nonce(c.localNonce.PubNonce)
+		spliceInit.ChannelType = &chanType
+		spliceInit.LocalNonce = &localNonce
+	}
+
+	return spliceInit, nil
+}
+
+// initUpgrade computes our contribution to a splice that upgrades the channel
+// to the requested commitment type. The splice transaction only moves the
+// funds to the new funding output, and its fee is deducted from our balance.
+func (c *ChanSplicer) initUpgrade() error {
+	chanType, err := c.cfg.Channel.UpgradeSpliceChanType(
+		*c.req.CommitmentType,
+	)
+	if err != nil {
+		return err
+	}
+
+	localNonce, err := c.cfg.Channel.SpliceVerificationNonce()
+	if err != nil {
+		return err
+	}
+
+	var weight input.TxWeightEstimator
+	weight.AddWitnessInput(input.MultiSigWitnessSize)
+	weight.AddP2TROutput()
+
+	fee := c.feeRate.FeeForWeight(int64(weight.Weight()))
+	c.contribution = -fee
+
+	state := c.cfg.Channel.State()
+	balance := state.LocalCommitment.LocalBalance.ToSatoshis()
+	if balance+c.contribution < state.LocalChanCfg.ChanReserve {
+		return fmt.Errorf("upgrade splice fee %v exceeds balance %v",
+			fee, balance)
+	}
+
+	c.upgrade = &lnwallet.SpliceUpgrade{ChanType: chanType}
+	c.localNonce = localNonce
+
+	return nil
+}
+
+// initSpliceOut computes our contribution to splice funds out of the channel.
+// The fee of the splice transaction is deducted from our balance.
+func (c *ChanSplicer) initSpliceOut() error {
+	txOut := wire.NewTxOut(int64(c.req.Amount), c.req.PkScript)
+
+	var weight input.TxWeightEstimator
+	weight.AddWitnessInput(input.MultiSigWitnessSize)
+	weight.AddP2WSHOutput()
+	weight.AddTxOutput(txOut)
+
+	fee := c.feeRate.FeeForWeight(int64(weight.Weight()))
+	c.contribution = -(c.req.Amount + fee)
+
+	state := c.cfg.Channel.State()
+	balance := state.LocalCommitment.LocalBalance.ToSatoshis()
+	if balance+c.contribution < state.LocalChanCfg.ChanReserve {
+		return fmt.Errorf("splice out of %v with fee %v exceeds "+
+			"balance %v", c.req.Amount, fee, balance)
+	}
+
+	return nil
+}
+
+// initSpliceIn selects and locks the wallet coins that are spliced into the
+// channel. The coins also pay for the shared input of the splice transaction.
+func (c *ChanSplicer) initSpliceIn() error {
+	sharedFee := c.feeRate.FeeForWeight(sharedInputWeight)
+
+	return c.cfg.CoinSelectLocker.WithCoinSelectLock(func() error {
+		coins, err := c.cfg.CoinSource.ListCoins(
+			c.req.MinConfs, math.MaxInt32,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Only the types of inputs that the remote party can verify
+		// the fee of may be added to the splice transaction.
+		var eligible []chanfunding.Coin
+		for _, coin := range coins {
+			pkScript := coin.PkScript
+			if txscript.IsPayToWitnessPubKeyHash(pkScript) ||
+				txscript.IsPayToTaproot(pkScript) {
+
+				eligible = append(eligible, coin)
+			}
+		}
+
+		selected, changeAmt, err := chanfunding.CoinSelect(
+			c.feeRate, c.req.Amount+sharedFee,
+			lnwallet.DustLimitForSize(input.P2TRSize), eligible,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range selected {
+			c.cfg.CoinLocker.LockOutpoint(coin.OutPoint)
+		}
+
+		c.coins = selected
+		c.changeAmt = changeAmt
+		c.contribution = c.req.Amount
+
+		return nil
+	})
+}
+
+// Cancel releases the wallet coins that were locked for the splice. It must
+// only be called if the splice failed.
+func (c *ChanSplicer) Cancel() {
+	for _, coin := range c.coins {
+		c.cfg.CoinLocker.UnlockOutpoint(coin.OutPoint)
+	}
+}
+
+// ProcessSpliceMsg processes a splice message of the remote party. It returns
+// the messages that we should send to the remote party in response, and true
+// if the splice is finished.
+func (c *ChanSplicer) ProcessSpliceMsg(msg lnwire.Message) ([]lnwire.Message,
+	bool, error) {
+
+	if abort, ok := msg.(*lnwire.TxAbort); ok {
+		return nil, false, fmt.Errorf("splice aborted: %w", abort)
+	}
+
+	switch c.state {
+	case spliceIdle:
+		spliceInit, ok := msg.(*lnwire.SpliceInit)
+		if !ok {
+			return nil, false, fmt.Errorf("expected splice_init, "+
+				"instead have %v", msg.MsgType())
+		}
+
+		spliceAck, err := c.processSpliceInit(spliceInit)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return []lnwire.Message{spliceAck}, false, nil
+
+	case spliceInitSent:
+		spliceAck, ok := msg.(*lnwire.SpliceAck)
+		if !ok {
+			return nil, false, fmt.Errorf("expected splice_ack, "+
+				"instead have %v", msg.MsgType())
+		}
+
+		firstMsg, err := c.processSpliceAck(spliceAck)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return []lnwire.Message{firstMsg}, false, nil
+
+	case spliceNegotiation:
+		msgs, err := c.processInteractiveTxMsg(msg)
+		return msgs, false, err
+
+	case spliceCommitSigs:
+		commitSig, ok := msg.(*lnwire.CommitSig)
+		if !ok {
+			return nil, false, fmt.Errorf("expected commit_sig, "+
+				"instead have %v", msg.MsgType())
+		}
+
+		msgs, err := c.processCommitSig(commitSig)
+		return msgs, false, err
+
+	case spliceTxSigs:
+		txSigs, ok := msg.(*lnwire.TxSignatures)
+		if !ok {
+			return nil, false, fmt.Errorf("expected "+
+				"tx_signatures, instead have %v",
+				msg.MsgType())
+		}
+
+		msgs, err := c.processTxSignatures(txSigs)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return msgs, true, nil
+
+	default:
+		return nil, false, ErrInvalidState
+	}
+}
+
+// processSpliceInit validates the splice proposed by the remote party, and
+// starts the construction of the splice transaction as the responder.
+func (c *ChanSplicer) processSpliceInit(
+	msg *lnwire.SpliceInit) (*lnwire.SpliceAck, error) {
+
+	if err := c.checkChannel(); err != nil {
+		return nil, err
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FundingFeePerKWeight)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("splice fee rate %v is too low",
+			feeRate)
+	}
+
+	contribution := btcutil.Amount(msg.FundingContribution)
+	if contribution == 0 {
+		return nil, ErrSpliceTooSmall
+	}
+
+	if msg.ChannelType != nil {
+		if err := c.acceptUpgrade(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	state := c.cfg.Channel.State()
+	capacity := state.Capacity + contribution
+	if c.cfg.MaxChanSize != 0 && capacity > c.cfg.MaxChanSize {
+		return nil, fmt.Errorf("splice capacity %v exceeds maximum "+
+			"channel size %v", capacity, c.cfg.MaxChanSize)
+	}
+
+	balance := state.LocalCommitment.RemoteBalance.ToSatoshis()
+	if balance+contribution < state.RemoteChanCfg.ChanReserve {
+		return nil, fmt.Errorf("splice contribution %v exceeds "+
+			"remote balance %v", contribution, balance)
+	}
+
+	chansplicerLog.Infof("ChannelPoint(%v): accepting splice with "+
+		"remote contribution of %v at %v", c.chanPoint, contribution,
+		feeRate)
+
+	c.feeRate = feeRate
+	c.lockTime = msg.LockTime
+	c.contribution = contribution
+	c.session = interactivetx.NewSession(interactivetx.Config{
+		ChanID:      c.cid,
+		LockTime:    c.lockTime,
+		SharedInput: c.sharedInput(),
+	})
+	c.state = spliceNegotiation
+
+	spliceAck := &lnwire.SpliceAck{ChannelID: c.cid}
+	if c.upgrade != nil {
+		localNonce := lnwire.Musig2Nonce(c.localNonce.PubNonce)
+		spliceAck.LocalNonce = &localNonce
+	}
+
+	return spliceAck, nil
+}
+
+// acceptUpgrade validates a splice of the remote party that upgrades the
+// channel to a new channel type. The initiator pays the fee of an upgrade
+// splice, so it must not add any funds to the channel.
+func (c *ChanSplicer) acceptUpgrade(msg *lnwire.SpliceInit) error {
+	chanType, err := c.cfg.Channel.UpgradeSpliceChanTypeFromWire(
+		*msg.ChannelType,
+	)
+	if err != nil {
+		return err
+	}
+
+	if msg.FundingContribution > 0 {
+		return fmt.Errorf("upgrade splice can't add %v to channel",
+			btcutil.Amount(msg.FundingContribution))
+	}
+
+	if msg.LocalNonce == nil {
+		return errors.New("upgrade splice without musig2 nonce")
+	}
+
+	localNonce, err := c.cfg.Channel.SpliceVerificationNonce()
+	if err != nil {
+		return err
+	}
+
+	c.upgrade = &lnwallet.SpliceUpgrade{
+		ChanType: chanType,
+		RemoteNonce: &musig2.Nonces{
+			PubNonce: *msg.LocalNonce,
+		},
+	}
+	c.localNonce = localNonce
+
+	return nil
+}
+
+// spliceFundingOutput returns the new funding output of the channel with the
+// given capacity.
+func (c *ChanSplicer) spliceFundingOutput(
+	capacity btcutil.Amount) (*wire.TxOut, error) {
+
+	if c.upgrade != nil {
+		return c.cfg.Channel.UpgradeSpliceFundingOutput(capacity)
+	}
+
+	return c.cfg.Channel.SpliceFundingOutput(capacity), nil
+}
+
+// sharedInput returns the current funding output of the channel, which is
+// spent by the splice transaction.
+func (c *ChanSplicer) sharedInput() *interactivetx.SharedInput {
+	return &interactivetx.SharedInput{
+		OutPoint: c.chanPoint,
+		TxOut:    c.cfg.Channel.FundingTxOut(),
+	}
+}
+
+// processSpliceAck starts the construction of the splice transaction as the
+// initiator, once the remote party has accepted the splice. The first message
+// of the construction is returned.
+func (c *ChanSplicer) processSpliceAck(
+	msg *lnwire.SpliceAck) (lnwire.Message, error) {
+
+	if msg.FundingContribution != 0 {
+		return nil, fmt.Errorf("remote contribution of %v to splice "+
+			"isn't supported", msg.FundingContribution)
+	}
+
+	if c.upgrade != nil {
+		if msg.LocalNonce == nil {
+			return nil, errors.New("upgrade splice without musig2 " +
+				"nonce")
+		}
+
+		c.upgrade.RemoteNonce = &musig2.Nonces{
+			PubNonce: *msg.LocalNonce,
+		}
+	}
+
+	c.session = interactivetx.NewSession(interactivetx.Config{
+		ChanID:      c.cid,
+		Initiator:   true,
+		LockTime:    c.lockTime,
+		SharedInput: c.sharedInput(),
+	})
+
+	err := c.session.AddSharedInput(interactivetx.MaxSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range c.coins {
+		prevTx, err := c.cfg.FetchTx(coin.OutPoint.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch tx of input "+
+				"%v: %w", coin.OutPoint, err)
+		}
+
+		err = c.session.AddInput(
+			prevTx, coin.OutPoint.Index, interactivetx.MaxSequence,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	capacity := c.cfg.Channel.State().Capacity + c.contribution
+	fundingOut, err := c.spliceFundingOutput(capacity)
+	if err != nil {
+		return nil, err
+	}
+	err = c.session.AddOutput(capacity, fundingOut.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.req.PkScript) > 0 {
+		err := c.session.AddOutput(c.req.Amount, c.req.PkScript)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.changeAmt > 0 {
+		changeScript, err := c.cfg.ChangeScript()
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.session.AddOutput(c.changeAmt, changeScript)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.state = spliceNegotiation
+
+	return c.session.NextMsg()
+}
+
+// processInteractiveTxMsg applies a message of the remote party to the
+// construction of the splice transaction. Both sides take turns, so we
+// respond with our next message until both sides have sent tx_complete, after
+// which we sign the remote commitment that spends the new funding output.
+func (c *ChanSplicer) processInteractiveTxMsg(
+	msg lnwire.Message) ([]lnwire.Message, error) {
+
+	if err := c.session.ReceiveMsg(msg); err != nil {
+		return nil, err
+	}
+
+	var msgs []lnwire.Message
+	if !c.session.IsComplete() {
+		nextMsg, err := c.session.NextMsg()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, nextMsg)
+	}
+
+	// If our or their last tx_complete finished the construction, we sign
+	// the remote commitment.
+	if c.session.IsComplete() {
+		commitSig, err := c.signSplice()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, commitSig)
+	}
+
+	return msgs, nil
+}
+
+// signSplice verifies the constructed splice transaction, and signs the
+// commitment of the remote party that spends the new funding output.
+func (c *ChanSplicer) signSplice() (*lnwire.CommitSig, error) {
+	spliceTx, err := c.session.Tx()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.session.SharedInputIndex() < 0 {
+		return nil, errors.New("splice tx doesn't spend funding output")
+	}
+
+	// The initiator pays the fee for the whole transaction.
+	initiator := c.session.Contribution(c.initiator())
+	fee := initiator.Inputs - initiator.Outputs
+	minFee := c.feeRate.FeeForWeight(
+		initiator.Weight + interactivetx.CommonWeight(),
+	)
+	if fee < minFee {
+		return nil, fmt.Errorf("splice tx pays fee of %v, expected "+
+			"at least %v", fee, minFee)
+	}
+
+	// There must be exactly one new funding output, which carries the
+	// contribution of the initiator.
+	capacity := c.cfg.Channel.State().Capacity + c.contribution
+	fundingOut, err := c.spliceFundingOutput(capacity)
+	if err != nil {
+		return nil, err
+	}
+	fundingIndex := -1
+	for i, txOut := range spliceTx.TxOut {
+		if !bytesEqual(txOut.PkScript, fundingOut.PkScript) {
+			continue
+		}
+
+		if fundingIndex != -1 || txOut.Value != fundingOut.Value {
+			return nil, errors.New("invalid splice funding output")
+		}
+		fundingIndex = i
+	}
+	if fundingIndex == -1 {
+		return nil, errors.New("splice funding output not found")
+	}
+
+	fundingOutpoint := wire.OutPoint{
+		Hash:  spliceTx.TxHash(),
+		Index: uint32(fundingIndex),
+	}
+
+	localDelta, remoteDelta := c.contribution, btcutil.Amount(0)
+	if !c.initiator() {
+		localDelta, remoteDelta = remoteDelta, localDelta
+	}
+
+	var splice *lnwallet.Splice
+	if c.upgrade != nil {
+		splice, err = c.cfg.Channel.NewUpgradeSplice(
+			fundingOutpoint, localDelta, remoteDelta, c.upgrade,
+		)
+	} else {
+		splice, err = c.cfg.Channel.NewSplice(
+			fundingOutpoint, localDelta, remoteDelta,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := c.cfg.Channel.SignSpliceCommitment(splice)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &lnwire.CommitSig{ChanID: c.cid}
+
+	// The commitments of taproot channels are signed with a musig2
+	// partial signature, which is sent along with its nonce.
+	if partialSig, ok := sig.(*lnwallet.MusigPartialSig); ok {
+		msg.PartialSig = partialSig.ToWireSig()
+	} else {
+		msg.CommitSig, err = lnwire.NewSigFromSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chansplicerLog.Infof("ChannelPoint(%v): constructed splice tx with "+
+		"new ChannelPoint(%v)", c.chanPoint, fundingOutpoint)
+
+	c.spliceTx = spliceTx
+	c.splice = splice
+	c.state = spliceCommitSigs
+
+	return msg, nil
+}
+
+// bytesEqual returns true if both scripts are equal.
+func bytesEqual(a, b []byte) bool {
+	return string(a) == string(b)
+}
+
+// processCommitSig verifies the signature of the remote party for our
+// commitment that spends the new funding output. The responder has no inputs
+// other than the shared input, so it sends its tx_signatures first.
+func (c *ChanSplicer) processCommitSig(
+	msg *lnwire.CommitSig) ([]lnwire.Message, error) {
+
+	var sig input.Signature
+	if c.upgrade != nil {
+		if msg.PartialSig == nil {
+			return nil, errors.New("commit_sig of upgrade splice " +
+				"without partial signature")
+		}
+
+		sig = new(lnwallet.MusigPartialSig).FromWireSig(msg.PartialSig)
+	} else {
+		var err error
+		sig, err = msg.CommitSig.ToSignature()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := c.cfg.Channel.VerifySpliceCommitment(c.splice, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.initiator() {
+		c.state = spliceTxSigs
+		return nil, nil
+	}
+
+	// As the initiator may publish the splice transaction once it has our
+	// signature, we store the splice first.
+	if err := c.markSplicePending(); err != nil {
+		return nil, err
+	}
+
+	txSigs, err := c.txSignatures(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.state = spliceTxSigs
+
+	return []lnwire.Message{txSigs}, nil
+}
+
+// markSplicePending stores the splice with the channel. Until the splice
+// transaction confirms, or until one of its inputs is double spent, every new
+// commitment of the channel is also signed for the new funding output.
+func (c *ChanSplicer) markSplicePending() error {
+	height, err := c.cfg.BestHeight()
+	if err != nil {
+		return err
+	}
+
+	splice, err := c.cfg.Channel.ChannelSplice(
+		c.splice, c.spliceTx, height,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The scripts of the inputs are stored, so that the chain watcher can
+	// detect a double spend of any of them.
+	prevOuts := c.session.PrevOutputFetcher()
+	splice.InputScripts = make([][]byte, len(c.spliceTx.TxIn))
+	for i, txIn := range c.spliceTx.TxIn {
+		prevOut := prevOuts.FetchPrevOutput(txIn.PreviousOutPoint)
+		if prevOut == nil {
+			return fmt.Errorf("unknown splice input %v",
+				txIn.PreviousOutPoint)
+		}
+
+		splice.InputScripts[i] = prevOut.PkScript
+	}
+
+	return c.cfg.Channel.MarkSplicePending(splice)
+}
+
+// txSignatures signs the shared input of the splice transaction, and returns
+// the tx_signatures message with the given witnesses of our other inputs.
+func (c *ChanSplicer) txSignatures(
+	witnesses []wire.TxWitness) (*lnwire.TxSignatures, error) {
+
+	sig, err := c.cfg.Channel.SignSpliceInput(
+		c.spliceTx, c.session.SharedInputIndex(),
+		c.session.PrevOutputFetcher(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	c.localInputSig = sig
+
+	return &lnwire.TxSignatures{
+		ChannelID:      c.cid,
+		TxID:           c.spliceTx.TxHash(),
+		Witnesses:      witnesses,
+		SharedInputSig: &sharedSig,
+	}, nil
+}
+
+// processTxSignatures processes the signatures of the remote party for the
+// splice transaction. Once the transaction is fully signed, it's broadcast.
+// The initiator replies with its own signatures.
+func (c *ChanSplicer) processTxSignatures(
+	msg *lnwire.TxSignatures) ([]lnwire.Message, error) {
+
+	if msg.TxID != c.spliceTx.TxHash() {
+		return nil, fmt.Errorf("tx_signatures for unknown tx %v",
+			msg.TxID)
+	}
+
+	if msg.SharedInputSig == nil {
+		return nil, errors.New("tx_signatures without shared input " +
+			"signature")
+	}
+
+	remoteSig, err := msg.SharedInputSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	spliceTx := c.spliceTx.Copy()
+	prevOuts := c.session.PrevOutputFetcher()
+	sharedIndex := c.session.SharedInputIndex()
+
+	var replies []lnwire.Message
+	if c.initiator() {
+		if len(msg.Witnesses) != 0 {
+			return nil, errors.New("unexpected witnesses from " +
+				"responder")
+		}
+
+		// We sign our wallet inputs and the shared input, then send
+		// our signatures once the splice has been stored.
+		err := chanfunding.SignCoins(
+			c.cfg.Signer, spliceTx, c.coins, prevOuts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var witnesses []wire.TxWitness
+		for _, txIn := range spliceTx.TxIn {
+			if len(txIn.Witness) != 0 {
+				witnesses = append(witnesses, txIn.Witness)
+			}
+		}
+
+		txSigs, err := c.txSignatures(witnesses)
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, txSigs)
+	}
+
+	witness, err := c.cfg.Channel.SpliceInputWitness(
+		spliceTx, sharedIndex, prevOuts, c.localInputSig, remoteSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	spliceTx.TxIn[sharedIndex].Witness = witness
+
+	// The responder adds the witnesses of the initiator's wallet inputs,
+	// which are all remaining inputs.
+	if !c.initiator() {
+		err := lnwallet.AddFundingWitnesses(
+			spliceTx, msg.Witnesses, prevOuts,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// We store the splice again with the fully signed transaction, so it
+	// can be rebroadcast.
+	c.spliceTx = spliceTx
+	if err := c.markSplicePending(); err != nil {
+		return nil, err
+	}
+
+	chansplicerLog.Infof("ChannelPoint(%v): broadcasting splice tx: %v",
+		c.chanPoint, newLogClosure(func() string {
+			return spew.Sdump(spliceTx)
+		}),
+	)
+
+	label := labels.MakeLabel(labels.LabelTypeChannelSplice, nil)
+	if err := c.cfg.BroadcastTx(spliceTx, label); err != nil {
+		// The remote party broadcasts the transaction as well, and the
+		// splice can't be undone anymore.
+		chansplicerLog.Errorf("ChannelPoint(%v): unable to broadcast "+
+			"splice tx: %v", c.chanPoint, err)
+	}
+
+	c.state = spliceFinished
+
+	return replies, nil
+}
+
+// SpliceTx returns the fully signed splice transaction once the splice is
+// finished.
+func (c *ChanSplicer) SpliceTx() (*wire.MsgTx, error) {
+	if c.state != spliceFinished {
+		return nil, errors.New("splice not finished")
+	}
+
+	return c.spliceTx, nil
+}
+
+// SigsSent returns true if our signatures for the splice transaction have
+// been sent, after which the splice can no longer be aborted.
+func (c *ChanSplicer) SigsSent() bool {
+	if c.initiator() {
+		return c.state == spliceFinished
+	}
+
+	return c.state >= spliceTxSigs
+}
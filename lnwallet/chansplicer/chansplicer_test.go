@@ -0,0 +1,380 @@
+package chansplicer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSplicer creates a splicer for the channel that records the
+// transactions it broadcasts.
+func newTestSplicer(channel *lnwallet.LightningChannel, req *Request,
+	broadcast *[]*wire.MsgTx) *ChanSplicer {
+
+	return NewChanSplicer(Config{
+		Channel: channel,
+		Signer:  channel.Signer,
+		BroadcastTx: func(tx *wire.MsgTx, _ string) error {
+			*broadcast = append(*broadcast, tx)
+			return nil
+		},
+		BestHeight: func() (uint32, error) {
+			return 100, nil
+		},
+	}, req)
+}
+
+// runSplice passes the splice messages of both splicers back and forth,
+// starting with the splice_init of the initiator, until both are finished.
+func runSplice(t *testing.T, initiator, responder *ChanSplicer,
+	spliceInit *lnwire.SpliceInit) {
+
+	t.Helper()
+
+	var initiatorDone, responderDone bool
+	toResponder := []lnwire.Message{spliceInit}
+	for len(toResponder) > 0 {
+		var toInitiator []lnwire.Message
+		for _, msg := range toResponder {
+			msgs, done, err := responder.ProcessSpliceMsg(msg)
+			require.NoError(t, err)
+			toInitiator = append(toInitiator, msgs...)
+			responderDone = responderDone || done
+		}
+
+		toResponder = nil
+		for _, msg := range toInitiator {
+			msgs, done, err := initiator.ProcessSpliceMsg(msg)
+			require.NoError(t, err)
+			toResponder = append(toResponder, msgs...)
+			initiatorDone = initiatorDone || done
+		}
+	}
+	require.True(t, initiatorDone)
+	require.True(t, responderDone)
+}
+
+// TestSpliceOut tests that two splicers negotiate a splice out of a channel,
+// after which both sides have stored the splice and broadcast the same fully
+// signed splice transaction.
+func TestSpliceOut(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	const spliceAmt = btcutil.Amount(100_000)
+	pkScript := append([]byte{0x00, 0x14}, bytes.Repeat([]byte{1}, 20)...)
+	fundingOut := aliceChannel.FundingTxOut()
+	oldBalance := aliceChannel.State().LocalCommitment.LocalBalance
+
+	var aliceTxns, bobTxns []*wire.MsgTx
+	alice := newTestSplicer(aliceChannel, &Request{
+		ChanPoint: *aliceChannel.ChannelPoint(),
+		Amount:    spliceAmt,
+		PkScript:  pkScript,
+		FeeRate:   chainfee.FeePerKwFloor,
+	}, &aliceTxns)
+	bob := newTestSplicer(bobChannel, nil, &bobTxns)
+
+	spliceInit, err := alice.InitSplice()
+	require.NoError(t, err)
+	require.Less(t, spliceInit.FundingContribution, -int64(spliceAmt))
+
+	runSplice(t, alice, bob, spliceInit)
+
+	require.Len(t, aliceTxns, 1)
+	require.Len(t, bobTxns, 1)
+	spliceTx := aliceTxns[0]
+	require.Equal(t, spliceTx.TxHash(), bobTxns[0].TxHash())
+
+	// The splice transaction spends the funding output with a valid
+	// witness, and pays the spliced out amount.
+	require.Len(t, spliceTx.TxIn, 1)
+	require.Len(t, spliceTx.TxOut, 2)
+
+	prevOuts := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript, fundingOut.Value,
+	)
+	vm, err := txscript.NewEngine(
+		fundingOut.PkScript, spliceTx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(spliceTx, prevOuts),
+		fundingOut.Value, prevOuts,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// Both sides have stored the splice with the new balances.
+	aliceSplice, err := aliceChannel.State().PendingSplice()
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.State().PendingSplice()
+	require.NoError(t, err)
+
+	require.Equal(t, spliceTx.TxHash(), aliceSplice.FundingTxn.TxHash())
+	require.Equal(t, aliceSplice.FundingOutpoint, bobSplice.FundingOutpoint)
+	require.Equal(
+		t, oldBalance+lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(spliceInit.FundingContribution),
+		), aliceSplice.LocalCommitment.LocalBalance,
+	)
+	require.Equal(
+		t, aliceSplice.LocalCommitment.LocalBalance,
+		bobSplice.LocalCommitment.RemoteBalance,
+	)
+	require.True(t, aliceChannel.State().HasChanStatus(
+		channeldb.ChanStatusPendingSplice,
+	))
+
+	// A new splice must wait until the pending one has confirmed.
+	_, err = newTestSplicer(aliceChannel, &Request{
+		ChanPoint: *aliceChannel.ChannelPoint(),
+		Amount:    spliceAmt,
+		PkScript:  pkScript,
+		FeeRate:   chainfee.FeePerKwFloor,
+	}, &aliceTxns).InitSplice()
+	require.ErrorIs(t, err, ErrSplicePending)
+}
+
+// TestUpgradeSplice tests that two splicers negotiate a splice that upgrades a
+// channel to taproot, which moves the funds of the channel to a MuSig2 funding
+// output and pays the fee from the balance of the initiator.
+func TestUpgradeSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	fundingOut := aliceChannel.FundingTxOut()
+	commitType := lnwallet.CommitmentType(
+		lnwallet.CommitmentTypeSimpleTaproot,
+	)
+
+	var aliceTxns, bobTxns []*wire.MsgTx
+	alice := newTestSplicer(aliceChannel, &Request{
+		ChanPoint:      *aliceChannel.ChannelPoint(),
+		FeeRate:        chainfee.FeePerKwFloor,
+		CommitmentType: &commitType,
+	}, &aliceTxns)
+	bob := newTestSplicer(bobChannel, nil, &bobTxns)
+
+	spliceInit, err := alice.InitSplice()
+	require.NoError(t, err)
+	require.Negative(t, spliceInit.FundingContribution)
+	require.NotNil(t, spliceInit.ChannelType)
+	require.NotNil(t, spliceInit.LocalNonce)
+	require.True(t, alice.IsUpgrade())
+
+	runSplice(t, alice, bob, spliceInit)
+	require.True(t, bob.IsUpgrade())
+
+	require.Len(t, aliceTxns, 1)
+	require.Len(t, bobTxns, 1)
+	spliceTx := aliceTxns[0]
+	require.Equal(t, spliceTx.TxHash(), bobTxns[0].TxHash())
+
+	// The splice transaction moves the funds of the channel to a taproot
+	// output, minus the fee that Alice pays.
+	require.Len(t, spliceTx.TxIn, 1)
+	require.Len(t, spliceTx.TxOut, 1)
+	require.True(t, txscript.IsPayToTaproot(spliceTx.TxOut[0].PkScript))
+	require.Equal(
+		t, fundingOut.Value+spliceInit.FundingContribution,
+		spliceTx.TxOut[0].Value,
+	)
+
+	prevOuts := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript, fundingOut.Value,
+	)
+	vm, err := txscript.NewEngine(
+		fundingOut.PkScript, spliceTx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(spliceTx, prevOuts),
+		fundingOut.Value, prevOuts,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// Both sides have stored the splice with the taproot channel type,
+	// and the channels are paused until the splice confirms.
+	for _, channel := range []*lnwallet.LightningChannel{
+		aliceChannel, bobChannel,
+	} {
+		splice, err := channel.State().PendingSplice()
+		require.NoError(t, err)
+		require.True(t, splice.IsUpgrade())
+		require.True(t, splice.NewChanType.IsTaproot())
+		require.True(t, channel.UpgradeSplicePending())
+	}
+
+	// A splice that upgrades a channel can't splice funds at the same
+	// time.
+	_, otherBob, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	_, err = newTestSplicer(otherBob, &Request{
+		ChanPoint:      *otherBob.ChannelPoint(),
+		Amount:         10_000,
+		FeeRate:        chainfee.FeePerKwFloor,
+		CommitmentType: &commitType,
+	}, &bobTxns).InitSplice()
+	require.Error(t, err)
+}
+
+// TestSpliceInitValidation tests that the responder rejects splices that it
+// can't accept.
+func TestSpliceInitValidation(t *testing.T) {
+	t.Parallel()
+
+	_, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	capacity := bobChannel.State().Capacity
+	remoteBalance := bobChannel.State().LocalCommitment.RemoteBalance
+
+	testCases := []struct {
+		name         string
+		contribution btcutil.Amount
+		feeRate      chainfee.SatPerKWeight
+		maxChanSize  btcutil.Amount
+	}{
+		{
+			name:         "zero contribution",
+			contribution: 0,
+			feeRate:      chainfee.FeePerKwFloor,
+		},
+		{
+			name:         "fee rate too low",
+			contribution: 10_000,
+			feeRate:      chainfee.FeePerKwFloor - 1,
+		},
+		{
+			name:         "exceeds max channel size",
+			contribution: 10_000,
+			feeRate:      chainfee.FeePerKwFloor,
+			maxChanSize:  capacity,
+		},
+		{
+			name:         "exceeds remote balance",
+			contribution: -remoteBalance.ToSatoshis(),
+			feeRate:      chainfee.FeePerKwFloor,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var txns []*wire.MsgTx
+			bob := newTestSplicer(bobChannel, nil, &txns)
+			bob.cfg.MaxChanSize = tc.maxChanSize
+
+			_, _, err := bob.ProcessSpliceMsg(&lnwire.SpliceInit{
+				ChannelID: bob.cid,
+				FundingContribution: int64(
+					tc.contribution,
+				),
+				FundingFeePerKWeight: uint32(tc.feeRate),
+			})
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestAbortTx tests that the transaction that aborts a splice double spends
+// our wallet coins of the splice transaction with a valid witness, and that it
+// must pay a higher fee than the splice transaction.
+func TestAbortTx(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pkScript, err := input.WitnessPubKeyHash(
+		privKey.PubKey().SerializeCompressed(),
+	)
+	require.NoError(t, err)
+
+	// The splice transaction spends the shared input and one of our
+	// coins, and pays a fee of 1000 sat.
+	const sharedValue = 1_000_000
+	coin := chanfunding.Coin{
+		TxOut: wire.TxOut{
+			Value:    200_000,
+			PkScript: pkScript,
+		},
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1},
+	}
+	sharedInput := wire.OutPoint{Hash: chainhash.Hash{2}}
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&sharedInput, nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	spliceTx.AddTxOut(&wire.TxOut{
+		Value:    sharedValue + coin.Value - 1000,
+		PkScript: pkScript,
+	})
+
+	cfg := AbortConfig{
+		Splice:           &channeldb.ChannelSplice{FundingTxn: spliceTx},
+		SharedInputValue: sharedValue,
+		FetchCoin: func(op wire.OutPoint) (*chanfunding.Coin, error) {
+			if op != coin.OutPoint {
+				return nil, lnwallet.ErrNotMine
+			}
+
+			return &coin, nil
+		},
+		Signer: input.NewMockSigner(
+			[]*btcec.PrivateKey{privKey},
+			&chaincfg.RegressionNetParams,
+		),
+		FeeRate:  chainfee.FeePerKwFloor,
+		PkScript: pkScript,
+	}
+
+	// The minimum fee rate doesn't replace the splice transaction.
+	_, err = AbortTx(cfg)
+	require.ErrorIs(t, err, ErrAbortFeeTooLow)
+
+	cfg.FeeRate = chainfee.SatPerKWeight(10_000)
+	abortTx, err := AbortTx(cfg)
+	require.NoError(t, err)
+
+	require.Len(t, abortTx.TxIn, 1)
+	require.Equal(t, coin.OutPoint, abortTx.TxIn[0].PreviousOutPoint)
+	require.Len(t, abortTx.TxOut, 1)
+	require.Less(t, abortTx.TxOut[0].Value, coin.Value-1000)
+
+	prevOuts := txscript.NewCannedPrevOutputFetcher(pkScript, coin.Value)
+	vm, err := txscript.NewEngine(
+		pkScript, abortTx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(abortTx, prevOuts), coin.Value,
+		prevOuts,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// A splice without our coins can't be aborted.
+	cfg.FetchCoin = func(wire.OutPoint) (*chanfunding.Coin, error) {
+		return nil, lnwallet.ErrNotMine
+	}
+	_, err = AbortTx(cfg)
+	require.ErrorIs(t, err, ErrNoSpliceInputs)
+}
@@ -0,0 +1,97 @@
+package chansplicer
+
+import (
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Channel abstracts away from the core channel state machine by exposing an
+// interface that requires only the methods we need to carry out the splice
+// of a channel.
+type Channel interface { //nolint:interfacebloat
+	// ChannelPoint returns the channel point of the target channel.
+	ChannelPoint() *wire.OutPoint
+
+	// ChanType returns the channel type of the channel.
+	ChanType() channeldb.ChannelType
+
+	// FundingTxOut returns the funding output of the channel.
+	FundingTxOut() *wire.TxOut
+
+	// IsChannelClean returns true if the channel has no HTLCs and no
+	// pending updates.
+	IsChannelClean() bool
+
+	// State returns the persistent state of the channel.
+	State() *channeldb.OpenChannel
+
+	// SpliceFundingOutput returns the funding output of the channel with
+	// the given capacity.
+	SpliceFundingOutput(capacity btcutil.Amount) *wire.TxOut
+
+	// NewSplice creates the commitment transactions that spend the new
+	// funding output of a splice, which adds the given amounts to the
+	// balances of both sides.
+	NewSplice(fundingOutpoint wire.OutPoint, localDelta,
+		remoteDelta btcutil.Amount) (*lnwallet.Splice, error)
+
+	// UpgradeSpliceChanType returns the channel type that a splice
+	// upgrades the channel to for the given commitment type.
+	UpgradeSpliceChanType(
+		commitType lnwallet.CommitmentType) (channeldb.ChannelType,
+		error)
+
+	// UpgradeSpliceChanTypeFromWire returns the channel type that a
+	// splice upgrades the channel to for the channel type of a
+	// splice_init message.
+	UpgradeSpliceChanTypeFromWire(
+		wireType lnwire.ChannelType) (channeldb.ChannelType, error)
+
+	// SpliceVerificationNonce returns our verification nonce for our
+	// commitment that spends the funding output of an upgrade splice.
+	SpliceVerificationNonce() (*musig2.Nonces, error)
+
+	// UpgradeSpliceFundingOutput returns the funding output with the
+	// given capacity of a splice that upgrades the channel to taproot.
+	UpgradeSpliceFundingOutput(capacity btcutil.Amount) (*wire.TxOut,
+		error)
+
+	// NewUpgradeSplice creates the commitment transactions of a splice
+	// that upgrades the channel to taproot.
+	NewUpgradeSplice(fundingOutpoint wire.OutPoint, localDelta,
+		remoteDelta btcutil.Amount,
+		upgrade *lnwallet.SpliceUpgrade) (*lnwallet.Splice, error)
+
+	// SignSpliceCommitment signs the commitment of the remote party that
+	// spends the new funding output.
+	SignSpliceCommitment(s *lnwallet.Splice) (input.Signature, error)
+
+	// VerifySpliceCommitment verifies the signature of the remote party
+	// for our commitment that spends the new funding output.
+	VerifySpliceCommitment(s *lnwallet.Splice, sig input.Signature) error
+
+	// SignSpliceInput signs the input of the splice transaction that
+	// spends the current funding output.
+	SignSpliceInput(spliceTx *wire.MsgTx, inputIndex int,
+		prevOuts txscript.PrevOutputFetcher) (input.Signature, error)
+
+	// SpliceInputWitness creates and verifies the witness of the input of
+	// the splice transaction that spends the current funding output.
+	SpliceInputWitness(spliceTx *wire.MsgTx, inputIndex int,
+		prevOuts txscript.PrevOutputFetcher, localSig,
+		remoteSig input.Signature) (wire.TxWitness, error)
+
+	// ChannelSplice returns the state of the splice that is stored with
+	// the channel until the splice transaction confirms.
+	ChannelSplice(s *lnwallet.Splice, spliceTx *wire.MsgTx,
+		broadcastHeight uint32) (*channeldb.ChannelSplice, error)
+
+	// MarkSplicePending persistently stores the splice of the channel.
+	MarkSplicePending(splice *channeldb.ChannelSplice) error
+}
@@ -622,6 +622,12 @@ type CommitmentBuilder struct {
 	// obfuscator is a 48-bit state hint that's used to obfuscate the
 	// current state number on the commitment transactions.
 	obfuscator [StateHintSize]byte
+
+	// spliceOutpoint and spliceCapacity replace the funding outpoint and
+	// capacity of the channel if the builder creates the commitments that
+	// spend the funding output of a pending splice.
+	spliceOutpoint *wire.OutPoint
+	spliceCapacity btcutil.Amount
 }
 
 // NewCommitmentBuilder creates a new CommitmentBuilder from chanState.
@@ -637,6 +643,39 @@ func NewCommitmentBuilder(chanState *channeldb.OpenChannel) *CommitmentBuilder {
 	}
 }
 
+// withSpliceFunding returns a copy of the builder that creates commitments
+// spending the passed funding output of a splice.
+func (cb *CommitmentBuilder) withSpliceFunding(outpoint wire.OutPoint,
+	capacity btcutil.Amount) *CommitmentBuilder {
+
+	return &CommitmentBuilder{
+		chanState:      cb.chanState,
+		obfuscator:     cb.obfuscator,
+		spliceOutpoint: &outpoint,
+		spliceCapacity: capacity,
+	}
+}
+
+// fundingTxIn returns the funding output that the commitments of the builder
+// spend as a transaction input.
+func (cb *CommitmentBuilder) fundingTxIn() wire.TxIn {
+	if cb.spliceOutpoint != nil {
+		return *wire.NewTxIn(cb.spliceOutpoint, nil, nil)
+	}
+
+	return fundingTxIn(cb.chanState)
+}
+
+// capacity returns the value of the funding output that the commitments of
+// the builder spend.
+func (cb *CommitmentBuilder) capacity() btcutil.Amount {
+	if cb.spliceOutpoint != nil {
+		return cb.spliceCapacity
+	}
+
+	return cb.chanState.Capacity
+}
+
 // createStateHintObfuscator derives and assigns the state hint obfuscator for
 // the channel, which is used to encode the commitment height in the sequence
 // number of commitment transaction inputs.
@@ -764,14 +803,14 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	}
 	if isOurs {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.LocalChanCfg, &cb.chanState.RemoteChanCfg,
 			ourBalance.ToSatoshis(), theirBalance.ToSatoshis(),
 			numHTLCs, cb.chanState.IsInitiator, leaseExpiry,
 		)
 	} else {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.RemoteChanCfg, &cb.chanState.LocalChanCfg,
 			theirBalance.ToSatoshis(), ourBalance.ToSatoshis(),
 			numHTLCs, !cb.chanState.IsInitiator, leaseExpiry,
@@ -854,11 +893,11 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	for _, txOut := range commitTx.TxOut {
 		totalOut += btcutil.Amount(txOut.Value)
 	}
-	if totalOut+commitFee > cb.chanState.Capacity {
+	if totalOut+commitFee > cb.capacity() {
 		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
 			"attempts to consume %v while channel capacity is %v",
 			height, cb.chanState.FundingOutpoint,
-			totalOut+commitFee, cb.chanState.Capacity)
+			totalOut+commitFee, cb.capacity())
 	}
 
 	return &unsignedCommitmentTx{
@@ -964,6 +1003,21 @@ func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
 	coopCloseFee btcutil.Amount, localCommit channeldb.ChannelCommitment) (
 	btcutil.Amount, btcutil.Amount, error) {
 
+	return CoopCloseBalanceWithPayer(
+		chanType, isInitiator, isInitiator, coopCloseFee, localCommit,
+	)
+}
+
+// CoopCloseBalanceWithPayer returns the final balances that should be used to
+// create the cooperative close tx like CoopCloseBalance, except that the
+// transaction fee is paid by the given party rather than the initiator of the
+// channel. The commitment fee and anchor outputs are still returned to the
+// initiator.
+func CoopCloseBalanceWithPayer(chanType channeldb.ChannelType, isInitiator,
+	localPays bool, coopCloseFee btcutil.Amount,
+	localCommit channeldb.ChannelCommitment) (btcutil.Amount,
+	btcutil.Amount, error) {
+
 	// Get both parties' balances from the latest commitment.
 	ourBalance := localCommit.LocalBalance.ToSatoshis()
 	theirBalance := localCommit.RemoteBalance.ToSatoshis()
@@ -978,22 +1032,26 @@ func CoopCloseBalance(chanType channeldb.ChannelType, isInitiator bool,
 		initiatorDelta += 2 * anchorSize
 	}
 
-	// The initiator will pay the full coop close fee, subtract that value
-	// from their balance.
-	initiatorDelta -= coopCloseFee
-
 	if isInitiator {
 		ourBalance += initiatorDelta
 	} else {
 		theirBalance += initiatorDelta
 	}
 
-	// During fee negotiation it should always be verified that the
-	// initiator can pay the proposed fee, but we do a sanity check just to
-	// be sure here.
+	// The payer will pay the full coop close fee, subtract that value from
+	// their balance.
+	if localPays {
+		ourBalance -= coopCloseFee
+	} else {
+		theirBalance -= coopCloseFee
+	}
+
+	// During fee negotiation it should always be verified that the payer
+	// can pay the proposed fee, but we do a sanity check just to be sure
+	// here.
 	if ourBalance < 0 || theirBalance < 0 {
-		return 0, 0, fmt.Errorf("initiator cannot afford proposed " +
-			"coop close fee")
+		return 0, 0, fmt.Errorf("payer cannot afford proposed coop " +
+			"close fee")
 	}
 
 	return ourBalance, theirBalance, nil
@@ -1194,37 +1252,41 @@ func addHTLC(commitTx *wire.MsgTx, ourCommit bool,
 }
 
 // findOutputIndexesFromRemote finds the index of our and their outputs from
-// the remote commitment transaction. It derives the key ring to compute the
-// output scripts and compares them against the outputs inside the commitment
-// to find the match.
+// the passed remote commitment transaction. It derives the key ring to compute
+// the output scripts and compares them against the outputs inside the
+// commitment to find the match.
 func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
-	chanState *channeldb.OpenChannel) (uint32, uint32, error) {
+	chanState *channeldb.OpenChannel,
+	chanCommit *channeldb.ChannelCommitment) (uint32, uint32, error) {
 
 	// Init the output indexes as empty.
 	ourIndex := uint32(channeldb.OutputIndexEmpty)
 	theirIndex := uint32(channeldb.OutputIndexEmpty)
 
-	chanCommit := chanState.RemoteCommitment
 	_, commitmentPoint := btcec.PrivKeyFromBytes(revocationPreimage[:])
 
+	// The commitment may predate a dynamic commitment upgrade of the
+	// channel, so we'll use the parameters that were in effect for it.
+	params := chanState.ParamsAt(false, chanCommit.CommitHeight)
+
 	// With the commitment point generated, we can now derive the king ring
 	// which will be used to generate the output scripts.
 	keyRing := DeriveCommitmentKeys(
-		commitmentPoint, false, chanState.ChanType,
+		commitmentPoint, false, params.ChanType,
 		&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 	)
 
 	// Since it's remote commitment chain, we'd used the mirrored values.
 	//
 	// We use the remote's channel config for the csv delay.
-	theirDelay := uint32(chanState.RemoteChanCfg.CsvDelay)
+	theirDelay := uint32(params.RemoteConstraints.CsvDelay)
 
 	// If we are the initiator of this channel, then it's be false from the
 	// remote's PoV.
 	isRemoteInitiator := !chanState.IsInitiator
 
 	var leaseExpiry uint32
-	if chanState.ChanType.HasLeaseExpiration() {
+	if params.ChanType.HasLeaseExpiration() {
 		leaseExpiry = chanState.ThawHeight
 	}
 
@@ -1236,7 +1298,7 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 	// Compute the to local script. From our PoV, when facing a remote
 	// commitment, the to local output belongs to them.
 	theirScript, err := CommitScriptToSelf(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToLocalKey,
+		params.ChanType, isRemoteInitiator, keyRing.ToLocalKey,
 		keyRing.RevocationKey, theirDelay, leaseExpiry,
 	)
 	if err != nil {
@@ -1246,7 +1308,7 @@ func findOutputIndexesFromRemote(revocationPreimage *chainhash.Hash,
 	// Compute the to remote script. From our PoV, when facing a remote
 	// commitment, the to remote output belongs to us.
 	ourScript, _, err := CommitScriptToRemote(
-		chanState.ChanType, isRemoteInitiator, keyRing.ToRemoteKey,
+		params.ChanType, isRemoteInitiator, keyRing.ToRemoteKey,
 		leaseExpiry,
 	)
 	if err != nil {
@@ -0,0 +1,453 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrUpgradeTaproot is returned when a dynamic commitment upgrade
+	// changes the commitment type to or from taproot. Taproot channels
+	// are funded with a MuSig2 output, so channels are upgraded to taproot
+	// with a splice to a new funding output instead. The other parameters
+	// of taproot channels can be upgraded.
+	ErrUpgradeTaproot = errors.New("commitment type can't be changed " +
+		"to or from taproot, as that requires a new funding output")
+
+	// ErrUpgradeLease is returned when a channel is upgraded to or from a
+	// script enforced lease commitment.
+	ErrUpgradeLease = errors.New("upgrades from or to script enforced " +
+		"lease commitments aren't supported")
+
+	// ErrUpgradeDowngrade is returned when the commitment type of a
+	// channel would be downgraded by an upgrade.
+	ErrUpgradeDowngrade = errors.New("commitment type can't be " +
+		"downgraded")
+
+	// ErrUpgradeUnknownType is returned when the remote party proposes
+	// an upgrade to a channel type that we don't know.
+	ErrUpgradeUnknownType = errors.New("unknown channel type")
+
+	// ErrUpgradeFeeTooLarge is returned when the initiator of the channel
+	// can't pay the fees of the upgraded commitments.
+	ErrUpgradeFeeTooLarge = errors.New("initiator can't pay the fees of " +
+		"the upgraded commitments")
+
+	// ErrUpgradePendingUpdates is returned when an upgrade is applied to
+	// a channel with pending updates.
+	ErrUpgradePendingUpdates = errors.New("channel must not have pending " +
+		"updates to be upgraded")
+
+	// ErrDynHeightMismatch is returned when the number of upgrades of the
+	// remote party doesn't match ours when the channel is reestablished.
+	ErrDynHeightMismatch = errors.New("number of channel upgrades of " +
+		"remote party doesn't match ours")
+)
+
+// UpgradeProposal holds the parameters of a channel that we want to change
+// with a dynamic commitment upgrade. Parameters with a zero value are left
+// unchanged.
+type UpgradeProposal struct {
+	// CommitmentType is the new commitment type of the channel.
+	CommitmentType *CommitmentType
+
+	// DustLimit is our new dust limit.
+	DustLimit btcutil.Amount
+
+	// ChanReserve is the new amount that the remote party must keep in
+	// its balance.
+	ChanReserve btcutil.Amount
+
+	// MaxPendingAmount is the new maximum value of the htlcs that the
+	// remote party may offer at once.
+	MaxPendingAmount lnwire.MilliSatoshi
+
+	// MaxAcceptedHtlcs is the new maximum number of htlcs that the remote
+	// party may offer at once.
+	MaxAcceptedHtlcs uint16
+
+	// CsvDelay is the new relative time lock of the outputs of the remote
+	// party on its commitment.
+	CsvDelay uint16
+}
+
+// commitmentTypeOf returns the commitment type of a channel type that can be
+// upgraded.
+func commitmentTypeOf(chanType channeldb.ChannelType) CommitmentType {
+	switch {
+	case chanType.IsTaproot():
+		return CommitmentTypeSimpleTaproot
+
+	case chanType.HasAnchors():
+		return CommitmentTypeAnchorsZeroFeeHtlcTx
+
+	case chanType.IsTweakless():
+		return CommitmentTypeTweakless
+
+	default:
+		return CommitmentTypeLegacy
+	}
+}
+
+// upgradeChanType returns the channel type that a channel is upgraded to for
+// the given commitment type. Only the bits of the commitment format are
+// changed, and the commitment type can't be downgraded. The commitment type of
+// a taproot channel is left unchanged.
+func upgradeChanType(chanType channeldb.ChannelType,
+	commitType CommitmentType) (channeldb.ChannelType, error) {
+
+	switch {
+	case chanType.IsTaproot() != commitType.IsTaproot():
+		return 0, ErrUpgradeTaproot
+
+	case chanType.IsTaproot():
+		return chanType, nil
+
+	case chanType.HasLeaseExpiration() ||
+		commitType == CommitmentTypeScriptEnforcedLease:
+
+		return 0, ErrUpgradeLease
+
+	case commitType < commitmentTypeOf(chanType):
+		return 0, ErrUpgradeDowngrade
+	}
+
+	switch commitType {
+	case CommitmentTypeLegacy:
+
+	case CommitmentTypeTweakless:
+		chanType |= channeldb.SingleFunderTweaklessBit
+
+	case CommitmentTypeAnchorsZeroFeeHtlcTx:
+		chanType |= channeldb.SingleFunderTweaklessBit |
+			channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+
+	default:
+		return 0, fmt.Errorf("unknown commitment type %v", commitType)
+	}
+
+	return chanType, nil
+}
+
+// UpgradeChanTypeToWire returns the channel type of a DynPropose or
+// splice_init message that upgrades a channel to the given channel type.
+func UpgradeChanTypeToWire(chanType channeldb.ChannelType) lnwire.ChannelType {
+	features := lnwire.NewRawFeatureVector()
+
+	switch commitmentTypeOf(chanType) {
+	case CommitmentTypeSimpleTaproot:
+		features.Set(lnwire.SimpleTaprootChannelsRequiredStaging)
+
+	case CommitmentTypeTweakless:
+		features.Set(lnwire.StaticRemoteKeyRequired)
+
+	case CommitmentTypeAnchorsZeroFeeHtlcTx:
+		features.Set(lnwire.StaticRemoteKeyRequired)
+		features.Set(lnwire.AnchorsZeroFeeHtlcTxRequired)
+	}
+
+	return lnwire.ChannelType(*features)
+}
+
+// upgradeChanTypeFromWire returns the channel type that a channel is upgraded
+// to by the channel type of a DynPropose message.
+func upgradeChanTypeFromWire(chanType channeldb.ChannelType,
+	wireType lnwire.ChannelType) (channeldb.ChannelType, error) {
+
+	features := lnwire.RawFeatureVector(wireType)
+
+	var commitType CommitmentType
+	switch {
+	case features.OnlyContains(
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	) && features.IsSet(lnwire.SimpleTaprootChannelsRequiredStaging):
+
+		commitType = CommitmentTypeSimpleTaproot
+
+	case features.OnlyContains(
+		lnwire.StaticRemoteKeyRequired,
+		lnwire.AnchorsZeroFeeHtlcTxRequired,
+	) && features.IsSet(lnwire.AnchorsZeroFeeHtlcTxRequired):
+
+		commitType = CommitmentTypeAnchorsZeroFeeHtlcTx
+
+	case features.OnlyContains(lnwire.StaticRemoteKeyRequired) &&
+		features.IsSet(lnwire.StaticRemoteKeyRequired):
+
+		commitType = CommitmentTypeTweakless
+
+	case features.IsEmpty():
+		commitType = CommitmentTypeLegacy
+
+	default:
+		return 0, ErrUpgradeUnknownType
+	}
+
+	return upgradeChanType(chanType, commitType)
+}
+
+// ProposeUpgrade returns the DynPropose message that proposes a dynamic
+// commitment upgrade to the remote party, along with the parameters of the
+// channel after the upgrade.
+func (lc *LightningChannel) ProposeUpgrade(p *UpgradeProposal) (
+	*lnwire.DynPropose, *channeldb.ChannelParams, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if lc.upgradeSplicePending {
+		return nil, nil, ErrUpgradeSplicePending
+	}
+
+	params := lc.channelState.Params()
+
+	// The commitment type is upgraded in the same way as by the remote
+	// party, even if it's left unchanged.
+	commitType := commitmentTypeOf(params.ChanType)
+	if p.CommitmentType != nil {
+		commitType = *p.CommitmentType
+	}
+
+	chanType, err := upgradeChanType(params.ChanType, commitType)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.ChanType = chanType
+
+	if p.DustLimit != 0 {
+		params.LocalConstraints.DustLimit = p.DustLimit
+	}
+	if p.ChanReserve != 0 {
+		params.RemoteConstraints.ChanReserve = p.ChanReserve
+	}
+	if p.MaxPendingAmount != 0 {
+		params.RemoteConstraints.MaxPendingAmount = p.MaxPendingAmount
+	}
+	if p.MaxAcceptedHtlcs != 0 {
+		params.RemoteConstraints.MaxAcceptedHtlcs = p.MaxAcceptedHtlcs
+	}
+	if p.CsvDelay != 0 {
+		params.RemoteConstraints.CsvDelay = p.CsvDelay
+	}
+
+	// The constraints of the remote party are validated in the same way
+	// as during the funding, though the CSV delay isn't bounded as it
+	// doesn't encumber our funds.
+	constraints := params.RemoteConstraints
+	constraints.DustLimit = params.LocalConstraints.DustLimit
+	err = lc.validateUpgrade(&params, &constraints, math.MaxUint16)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &lnwire.DynPropose{
+		ChanID: lnwire.NewChanIDFromOutPoint(
+			&lc.channelState.FundingOutpoint,
+		),
+		DustLimit:        params.LocalConstraints.DustLimit,
+		MaxValueInFlight: params.RemoteConstraints.MaxPendingAmount,
+		ChannelReserve:   params.RemoteConstraints.ChanReserve,
+		CsvDelay:         params.RemoteConstraints.CsvDelay,
+		MaxAcceptedHTLCs: params.RemoteConstraints.MaxAcceptedHtlcs,
+		ChannelType:      UpgradeChanTypeToWire(params.ChanType),
+	}
+
+	return msg, &params, nil
+}
+
+// ProcessUpgrade validates a dynamic commitment upgrade that was proposed by
+// the remote party, and returns the parameters of the channel after the
+// upgrade.
+func (lc *LightningChannel) ProcessUpgrade(msg *lnwire.DynPropose,
+	maxLocalCSVDelay uint16) (*channeldb.ChannelParams, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if lc.upgradeSplicePending {
+		return nil, ErrUpgradeSplicePending
+	}
+
+	params := lc.channelState.Params()
+
+	chanType, err := upgradeChanTypeFromWire(
+		params.ChanType, msg.ChannelType,
+	)
+	if err != nil {
+		return nil, err
+	}
+	params.ChanType = chanType
+
+	params.RemoteConstraints.DustLimit = msg.DustLimit
+	params.LocalConstraints.ChanReserve = msg.ChannelReserve
+	params.LocalConstraints.MaxPendingAmount = msg.MaxValueInFlight
+	params.LocalConstraints.MaxAcceptedHtlcs = msg.MaxAcceptedHTLCs
+	params.LocalConstraints.CsvDelay = msg.CsvDelay
+
+	constraints := params.LocalConstraints
+	constraints.DustLimit = params.RemoteConstraints.DustLimit
+	err = lc.validateUpgrade(&params, &constraints, maxLocalCSVDelay)
+	if err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// validateUpgrade checks the constraints that the proposer of an upgrade puts
+// on the receiver, along with the dust limit of the proposer, and ensures
+// that the initiator of the channel can pay the fees of both upgraded
+// commitments.
+//
+// NOTE: This method requires the channel's lock to be held.
+func (lc *LightningChannel) validateUpgrade(params *channeldb.ChannelParams,
+	constraints *channeldb.ChannelConstraints,
+	maxLocalCSVDelay uint16) error {
+
+	err := VerifyConstraints(
+		constraints, maxLocalCSVDelay, lc.channelState.Capacity,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = lc.validateUpgradeFee(params, lc.localCommitChain.tip())
+	if err != nil {
+		return err
+	}
+
+	return lc.validateUpgradeFee(params, lc.remoteCommitChain.tip())
+}
+
+// validateUpgradeFee ensures that the balance of the initiator on the given
+// commitment covers the fee of the commitment with the upgraded parameters,
+// along with the anchor outputs if the channel is upgraded to anchors.
+//
+// NOTE: This method requires the channel's lock to be held.
+func (lc *LightningChannel) validateUpgradeFee(params *channeldb.ChannelParams,
+	commit *commitment) error {
+
+	prevParams := lc.channelState.ParamsAt(commit.isOurs, commit.height)
+
+	dustLimit := params.RemoteConstraints.DustLimit
+	if commit.isOurs {
+		dustLimit = params.LocalConstraints.DustLimit
+	}
+
+	var numHTLCs int64
+	countHTLCs := func(htlcs []PaymentDescriptor, incoming bool) {
+		for _, htlc := range htlcs {
+			if HtlcIsDust(
+				params.ChanType, incoming, commit.isOurs,
+				commit.feePerKw, htlc.Amount.ToSatoshis(),
+				dustLimit,
+			) {
+
+				continue
+			}
+
+			numHTLCs++
+		}
+	}
+	countHTLCs(commit.incomingHTLCs, true)
+	countHTLCs(commit.outgoingHTLCs, false)
+
+	weight := CommitWeight(params.ChanType) + input.HTLCWeight*numHTLCs
+	cost := commit.feePerKw.FeeForWeight(weight)
+	if params.ChanType.HasAnchors() && !prevParams.ChanType.HasAnchors() {
+		cost += 2 * anchorSize
+	}
+
+	balance := commit.theirBalance.ToSatoshis() + commit.fee
+	if lc.channelState.IsInitiator {
+		balance = commit.ourBalance.ToSatoshis() + commit.fee
+	}
+
+	if balance < cost {
+		return fmt.Errorf("%w: balance %v, cost %v",
+			ErrUpgradeFeeTooLarge, balance, cost)
+	}
+
+	return nil
+}
+
+// upgradeAnchorCost returns the amount that the initiator pays for the anchor
+// outputs of the commitment after the given commitment, which is only the
+// case if the channel has been upgraded to anchors since then.
+func (lc *LightningChannel) upgradeAnchorCost(
+	commit *commitment) lnwire.MilliSatoshi {
+
+	prevParams := lc.channelState.ParamsAt(commit.isOurs, commit.height)
+	if !lc.channelState.ChanType.HasAnchors() ||
+		prevParams.ChanType.HasAnchors() {
+
+		return 0
+	}
+
+	return lnwire.NewMSatFromSatoshis(2 * anchorSize)
+}
+
+// ApplyUpgrade applies the parameters of a dynamic commitment upgrade to the
+// channel, which are used by both commitments from their next height onward.
+// The channel must not have any pending updates.
+func (lc *LightningChannel) ApplyUpgrade(
+	params *channeldb.ChannelParams) error {
+
+	if lc.HasPendingUpdates(true) || lc.HasPendingUpdates(false) {
+		return ErrUpgradePendingUpdates
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	return lc.channelState.ApplyUpgrade(params)
+}
+
+// syncUpgrades compares the number of upgrades of the channel with the one in
+// the ChannelReestablish message of the remote party. If we've proposed an
+// upgrade that the remote party has acknowledged while we were disconnected,
+// the upgrade is applied. Otherwise, the proposed upgrade is removed.
+func (lc *LightningChannel) syncUpgrades(
+	msg *lnwire.ChannelReestablish) error {
+
+	var remoteUpgrades uint64
+	if msg.DynHeight != nil {
+		remoteUpgrades = uint64(*msg.DynHeight)
+	}
+	localUpgrades := lc.channelState.NumUpgrades()
+
+	params, err := lc.channelState.PendingUpgrade()
+	switch {
+	// If we've proposed an upgrade, the remote party has either applied
+	// it or never received it.
+	case err == nil && remoteUpgrades == localUpgrades+1:
+		lc.log.Infof("applying upgrade acknowledged while disconnected")
+
+		return lc.ApplyUpgrade(params)
+
+	case err == nil && remoteUpgrades == localUpgrades:
+		return lc.channelState.ClearPendingUpgrade()
+
+	case err == nil:
+
+	case !errors.Is(err, channeldb.ErrNoPendingUpgrade):
+		return err
+
+	// The remote party is one upgrade behind us if it has proposed an
+	// upgrade whose acknowledgement it never received, which it'll apply
+	// once it has processed our message.
+	case remoteUpgrades == localUpgrades,
+		remoteUpgrades+1 == localUpgrades:
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: remote has %v upgrades, we have %v",
+		ErrDynHeightMismatch, remoteUpgrades, localUpgrades)
+}
@@ -0,0 +1,292 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpgradeChannel tests that a tweakless channel can be upgraded to
+// anchors, that the commitments use the new format from the next state
+// onward, and that commitments from before the upgrade are still resolved
+// with the prior parameters.
+func TestUpgradeChannel(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	// Add an htlc so that the commitments before the upgrade have htlcs
+	// to be resolved.
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(20_000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	oldBobCommit := bobChannel.channelState.LocalCommitment
+	oldAliceCommit := aliceChannel.channelState.LocalCommitment
+	oldBobDelay := bobChannel.channelState.LocalChanCfg.CsvDelay
+
+	// Alice proposes to upgrade the channel to anchors and to increase
+	// the CSV delay of Bob.
+	anchors := CommitmentType(CommitmentTypeAnchorsZeroFeeHtlcTx)
+	msg, aliceParams, err := aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		CommitmentType: &anchors,
+		DustLimit:      500,
+		CsvDelay:       200,
+	})
+	require.NoError(t, err)
+
+	// Bob refuses the upgrade if the CSV delay exceeds his maximum.
+	_, err = bobChannel.ProcessUpgrade(msg, 100)
+	require.Error(t, err)
+
+	bobParams, err := bobChannel.ProcessUpgrade(msg, 1000)
+	require.NoError(t, err)
+
+	require.True(t, aliceParams.ChanType.HasAnchors())
+	require.Equal(t, aliceParams.ChanType, bobParams.ChanType)
+	require.Equal(
+		t, aliceParams.LocalConstraints, bobParams.RemoteConstraints,
+	)
+	require.Equal(
+		t, aliceParams.RemoteConstraints, bobParams.LocalConstraints,
+	)
+
+	require.NoError(t, aliceChannel.ApplyUpgrade(aliceParams))
+	require.NoError(t, bobChannel.ApplyUpgrade(bobParams))
+
+	// Both sides owe a commitment in the new format.
+	require.True(t, aliceChannel.OweCommitment())
+	require.True(t, bobChannel.OweCommitment())
+
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+	require.False(t, aliceChannel.OweCommitment())
+	require.False(t, bobChannel.OweCommitment())
+
+	// The new commitments have anchor outputs, which are paid by Alice as
+	// the initiator of the channel.
+	aliceCommit := aliceChannel.channelState.LocalCommitment
+	numAnchors := 0
+	for _, txOut := range aliceCommit.CommitTx.TxOut {
+		if btcutil.Amount(txOut.Value) == anchorSize {
+			numAnchors++
+		}
+	}
+	require.Equal(t, 2, numAnchors)
+	require.Equal(
+		t, oldAliceCommit.LocalBalance+
+			lnwire.NewMSatFromSatoshis(oldAliceCommit.CommitFee),
+		aliceCommit.LocalBalance+
+			lnwire.NewMSatFromSatoshis(aliceCommit.CommitFee+
+				2*anchorSize),
+	)
+
+	// A force close of the prior commitment of Bob is resolved with the
+	// prior CSV delay and without anchors.
+	summary, err := NewLocalForceCloseSummary(
+		bobChannel.channelState, bobChannel.Signer,
+		oldBobCommit.CommitTx, oldBobCommit.CommitHeight,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, summary.CommitResolution)
+	require.EqualValues(
+		t, oldBobDelay, summary.CommitResolution.MaturityDelay,
+	)
+	require.Nil(t, summary.AnchorResolution)
+
+	bobCommit := bobChannel.channelState.LocalCommitment
+	summary, err = NewLocalForceCloseSummary(
+		bobChannel.channelState, bobChannel.Signer,
+		bobCommit.CommitTx, bobCommit.CommitHeight,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, summary.CommitResolution)
+	require.EqualValues(t, 200, summary.CommitResolution.MaturityDelay)
+	require.NotNil(t, summary.AnchorResolution)
+
+	// If Bob broadcasts his revoked commitment from before the upgrade,
+	// Alice finds her output with the prior parameters.
+	retribution, err := NewBreachRetribution(
+		aliceChannel.channelState, oldBobCommit.CommitHeight, 0,
+		oldBobCommit.CommitTx,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, retribution.LocalOutputSignDesc)
+
+	localOut := oldBobCommit.CommitTx.TxOut[retribution.LocalOutpoint.Index]
+	require.Equal(
+		t, localOut.PkScript,
+		retribution.LocalOutputSignDesc.Output.PkScript,
+	)
+}
+
+// TestUpgradeChannelDowngrade tests that the commitment type of a channel
+// can't be downgraded, and that taproot upgrades are refused.
+func TestUpgradeChannelDowngrade(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit|
+			channeldb.AnchorOutputsBit|channeldb.ZeroHtlcTxFeeBit,
+	)
+	require.NoError(t, err)
+
+	tweakless := CommitmentType(CommitmentTypeTweakless)
+	_, _, err = aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		CommitmentType: &tweakless,
+		DustLimit:      500,
+	})
+	require.ErrorIs(t, err, ErrUpgradeDowngrade)
+
+	taproot := CommitmentType(CommitmentTypeSimpleTaproot)
+	_, _, err = aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		CommitmentType: &taproot,
+		DustLimit:      500,
+	})
+	require.ErrorIs(t, err, ErrUpgradeTaproot)
+
+	// An upgrade to taproot that's proposed by the remote party is
+	// refused as well.
+	msg, _, err := aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		DustLimit: 500,
+	})
+	require.NoError(t, err)
+
+	features := lnwire.NewRawFeatureVector(
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	)
+	msg.ChannelType = lnwire.ChannelType(*features)
+	_, err = bobChannel.ProcessUpgrade(msg, 1000)
+	require.ErrorIs(t, err, ErrUpgradeTaproot)
+}
+
+// TestUpgradeTaprootChannel tests that the parameters of a taproot channel can
+// be upgraded, but not its commitment type.
+func TestUpgradeTaprootChannel(t *testing.T) {
+	t.Parallel()
+
+	taprootBits := channeldb.SimpleTaprootFeatureBit |
+		channeldb.AnchorOutputsBit |
+		channeldb.ZeroHtlcTxFeeBit |
+		channeldb.SingleFunderTweaklessBit
+
+	aliceChannel, bobChannel, err := CreateTestChannels(t, taprootBits)
+	require.NoError(t, err)
+
+	anchors := CommitmentType(CommitmentTypeAnchorsZeroFeeHtlcTx)
+	_, _, err = aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		CommitmentType: &anchors,
+	})
+	require.ErrorIs(t, err, ErrUpgradeTaproot)
+
+	oldBobCommit := bobChannel.channelState.LocalCommitment
+	oldBobDelay := bobChannel.channelState.LocalChanCfg.CsvDelay
+
+	taproot := CommitmentType(CommitmentTypeSimpleTaproot)
+	msg, aliceParams, err := aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		CommitmentType: &taproot,
+		DustLimit:      500,
+		CsvDelay:       200,
+	})
+	require.NoError(t, err)
+	require.Equal(t, taprootBits, aliceParams.ChanType)
+
+	bobParams, err := bobChannel.ProcessUpgrade(msg, 1000)
+	require.NoError(t, err)
+	require.Equal(t, taprootBits, bobParams.ChanType)
+
+	require.NoError(t, aliceChannel.ApplyUpgrade(aliceParams))
+	require.NoError(t, bobChannel.ApplyUpgrade(bobParams))
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	// The commitments before and after the upgrade are resolved with
+	// their own CSV delay.
+	summary, err := NewLocalForceCloseSummary(
+		bobChannel.channelState, bobChannel.Signer,
+		oldBobCommit.CommitTx, oldBobCommit.CommitHeight,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, summary.CommitResolution)
+	require.EqualValues(
+		t, oldBobDelay, summary.CommitResolution.MaturityDelay,
+	)
+
+	bobCommit := bobChannel.channelState.LocalCommitment
+	summary, err = NewLocalForceCloseSummary(
+		bobChannel.channelState, bobChannel.Signer,
+		bobCommit.CommitTx, bobCommit.CommitHeight,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, summary.CommitResolution)
+	require.EqualValues(t, 200, summary.CommitResolution.MaturityDelay)
+}
+
+// TestUpgradeChannelReestablish tests that an upgrade that we've proposed is
+// applied when the channel is reestablished if the remote party has applied
+// it, and removed otherwise.
+func TestUpgradeChannelReestablish(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	msg, aliceParams, err := aliceChannel.ProposeUpgrade(&UpgradeProposal{
+		DustLimit:        500,
+		MaxAcceptedHtlcs: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.State().MarkUpgradePending(aliceParams))
+
+	// Bob never received the proposal, so Alice removes it.
+	bobSync, err := bobChannel.channelState.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Nil(t, bobSync.DynHeight)
+
+	_, _, _, err = aliceChannel.ProcessChanSyncMsg(bobSync)
+	require.NoError(t, err)
+	_, err = aliceChannel.State().PendingUpgrade()
+	require.ErrorIs(t, err, channeldb.ErrNoPendingUpgrade)
+	require.Zero(t, aliceChannel.State().NumUpgrades())
+
+	// This time Bob applies the upgrade, but his acknowledgement is lost.
+	require.NoError(t, aliceChannel.State().MarkUpgradePending(aliceParams))
+	bobParams, err := bobChannel.ProcessUpgrade(msg, 1000)
+	require.NoError(t, err)
+	require.NoError(t, bobChannel.ApplyUpgrade(bobParams))
+
+	// Bob accepts that Alice is one upgrade behind him.
+	aliceSync, err := aliceChannel.channelState.ChanSyncMsg()
+	require.NoError(t, err)
+	_, _, _, err = bobChannel.ProcessChanSyncMsg(aliceSync)
+	require.NoError(t, err)
+
+	bobSync, err = bobChannel.channelState.ChanSyncMsg()
+	require.NoError(t, err)
+	require.NotNil(t, bobSync.DynHeight)
+	require.EqualValues(t, 1, *bobSync.DynHeight)
+
+	_, _, _, err = aliceChannel.ProcessChanSyncMsg(bobSync)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, aliceChannel.State().NumUpgrades())
+	require.Equal(t, *aliceParams, aliceChannel.State().Params())
+
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	// Once both sides have the same upgrades, a mismatch is refused.
+	aliceSync, err = aliceChannel.channelState.ChanSyncMsg()
+	require.NoError(t, err)
+	dynHeight := lnwire.DynHeight(3)
+	aliceSync.DynHeight = &dynHeight
+	_, _, _, err = bobChannel.ProcessChanSyncMsg(aliceSync)
+	require.ErrorIs(t, err, ErrDynHeightMismatch)
+}
@@ -0,0 +1,751 @@
+package interactivetx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// MaxAdds is the maximum number of inputs, and separately outputs,
+	// that we accept from the remote party in a single session.
+	MaxAdds = 4096
+
+	// maxTxInsOuts is the maximum number of inputs, and separately
+	// outputs, of the constructed transaction.
+	maxTxInsOuts = 252
+
+	// MaxSequence is the largest sequence number that an input may use.
+	// Larger values would disable the lock time of the transaction.
+	MaxSequence = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	// ErrSessionComplete is returned when a message is added to or
+	// received by a session that is already complete.
+	ErrSessionComplete = errors.New("interactive tx session is complete")
+
+	// ErrSessionIncomplete is returned when the transaction of a session
+	// is requested before both sides have sent tx_complete.
+	ErrSessionIncomplete = errors.New("interactive tx session is not " +
+		"complete")
+)
+
+// Config holds the parameters of an interactive transaction construction
+// session.
+type Config struct {
+	// ChanID is the id of the channel that the transaction is constructed
+	// for.
+	ChanID lnwire.ChannelID
+
+	// Initiator is true if we initiated the session. The initiator uses
+	// even serial ids, the other side odd ones.
+	Initiator bool
+
+	// LockTime is the lock time of the constructed transaction.
+	LockTime uint32
+
+	// SharedInput is the current funding output of the channel if the
+	// transaction splices it. The initiator adds it to the transaction
+	// with AddSharedInput, without sending the previous transaction.
+	SharedInput *SharedInput
+}
+
+// SharedInput is an output that is owned by both sides and may be spent by the
+// constructed transaction, such as the funding output of a channel.
+type SharedInput struct {
+	// OutPoint is the outpoint of the shared output.
+	OutPoint wire.OutPoint
+
+	// TxOut is the shared output. It must be a P2WSH 2-of-2 multisig
+	// output.
+	TxOut *wire.TxOut
+}
+
+// txInput is an input that was added to the transaction by either side.
+type txInput struct {
+	serialID uint64
+	outPoint wire.OutPoint
+	prevOut  *wire.TxOut
+	sequence uint32
+	local    bool
+
+	// shared is true if the input spends the shared input of the session.
+	shared bool
+}
+
+// txOutput is an output that was added to the transaction by either side.
+type txOutput struct {
+	serialID uint64
+	amount   btcutil.Amount
+	pkScript []byte
+	local    bool
+}
+
+// Session tracks the state of the interactive construction of a transaction
+// with a remote party, as specified in BOLT 2. Both sides take turns to add
+// and remove inputs and outputs, until both have sent tx_complete in a row.
+//
+// Local additions are queued with AddInput and AddOutput and are sent one by
+// one by the caller through NextMsg, while the messages of the remote party
+// are applied with ReceiveMsg. A Session is not safe for concurrent use.
+type Session struct {
+	cfg Config
+
+	inputs  map[uint64]*txInput
+	outputs map[uint64]*txOutput
+
+	// pending holds the local messages that haven't been sent yet.
+	pending []lnwire.Message
+
+	// numRemoteInputs and numRemoteOutputs count the inputs and outputs
+	// that the remote party added, including removed ones.
+	numRemoteInputs  int
+	numRemoteOutputs int
+
+	sentComplete bool
+	recvComplete bool
+}
+
+// NewSession creates a new interactive transaction construction session.
+func NewSession(cfg Config) *Session {
+	return &Session{
+		cfg:     cfg,
+		inputs:  make(map[uint64]*txInput),
+		outputs: make(map[uint64]*txOutput),
+	}
+}
+
+// IsComplete returns true if both sides have sent tx_complete in a row, which
+// means the transaction can no longer be modified.
+func (s *Session) IsComplete() bool {
+	return s.sentComplete && s.recvComplete
+}
+
+// isLocalSerial returns true if the serial id has the parity of the serial
+// ids that we use.
+func (s *Session) isLocalSerial(serialID uint64) bool {
+	isEven := serialID%2 == 0
+	return isEven == s.cfg.Initiator
+}
+
+// newSerialID returns a random serial id that isn't used yet, with the parity
+// of our side.
+func (s *Session) newSerialID() (uint64, error) {
+	for {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return 0, err
+		}
+
+		serialID := binary.BigEndian.Uint64(b[:]) &^ 1
+		if !s.cfg.Initiator {
+			serialID |= 1
+		}
+
+		_, isInput := s.inputs[serialID]
+		_, isOutput := s.outputs[serialID]
+		if !isInput && !isOutput {
+			return serialID, nil
+		}
+	}
+}
+
+// hasOutPoint returns true if one of the inputs of the transaction already
+// spends the outpoint.
+func (s *Session) hasOutPoint(op wire.OutPoint) bool {
+	for _, in := range s.inputs {
+		if in.outPoint == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkInput verifies that an input may be added to the transaction.
+func (s *Session) checkInput(prevTx *wire.MsgTx, vout,
+	sequence uint32) error {
+
+	if prevTx == nil {
+		return errors.New("missing previous transaction")
+	}
+
+	if int(vout) >= len(prevTx.TxOut) {
+		return fmt.Errorf("previous transaction has no output %d",
+			vout)
+	}
+
+	// We only accept the types of witness programs that we're able to
+	// estimate the witness size of, which allows both sides to verify
+	// that the fees are paid.
+	pkScript := prevTx.TxOut[vout].PkScript
+	if !txscript.IsPayToWitnessPubKeyHash(pkScript) &&
+		!txscript.IsPayToTaproot(pkScript) {
+
+		return fmt.Errorf("input doesn't spend a p2wkh or p2tr output")
+	}
+
+	if sequence > MaxSequence {
+		return fmt.Errorf("invalid sequence %d", sequence)
+	}
+
+	op := wire.OutPoint{Hash: prevTx.TxHash(), Index: vout}
+	if s.hasOutPoint(op) {
+		return fmt.Errorf("outpoint %v is already spent by the "+
+			"transaction", op)
+	}
+
+	return nil
+}
+
+// checkOutput verifies that an output may be added to the transaction.
+func checkOutput(amount btcutil.Amount, pkScript []byte) error {
+	if amount > btcutil.MaxSatoshi {
+		return fmt.Errorf("output amount %v is too large", amount)
+	}
+
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy,
+		txscript.WitnessV1TaprootTy:
+
+	default:
+		return errors.New("output script is not standard")
+	}
+
+	txOut := wire.NewTxOut(int64(amount), pkScript)
+	if mempool.IsDust(txOut, mempool.DefaultMinRelayTxFee) {
+		return fmt.Errorf("output amount %v is dust", amount)
+	}
+
+	return nil
+}
+
+// AddInput queues a local input that spends the given output of prevTx.
+func (s *Session) AddInput(prevTx *wire.MsgTx, vout,
+	sequence uint32) error {
+
+	if s.IsComplete() {
+		return ErrSessionComplete
+	}
+
+	if err := s.checkInput(prevTx, vout, sequence); err != nil {
+		return err
+	}
+
+	serialID, err := s.newSerialID()
+	if err != nil {
+		return err
+	}
+
+	s.inputs[serialID] = &txInput{
+		serialID: serialID,
+		outPoint: wire.OutPoint{Hash: prevTx.TxHash(), Index: vout},
+		prevOut:  prevTx.TxOut[vout],
+		sequence: sequence,
+		local:    true,
+	}
+	s.pending = append(s.pending, &lnwire.TxAddInput{
+		ChannelID:  s.cfg.ChanID,
+		SerialID:   serialID,
+		PrevTx:     prevTx,
+		PrevTxVout: vout,
+		Sequence:   sequence,
+	})
+
+	return nil
+}
+
+// AddSharedInput queues the shared input of the session. Only the initiator
+// adds the shared input.
+func (s *Session) AddSharedInput(sequence uint32) error {
+	if s.IsComplete() {
+		return ErrSessionComplete
+	}
+
+	shared := s.cfg.SharedInput
+	switch {
+	case shared == nil:
+		return errors.New("session has no shared input")
+
+	case !s.cfg.Initiator:
+		return errors.New("only the initiator adds the shared input")
+
+	case sequence > MaxSequence:
+		return fmt.Errorf("invalid sequence %d", sequence)
+
+	case s.hasOutPoint(shared.OutPoint):
+		return fmt.Errorf("outpoint %v is already spent by the "+
+			"transaction", shared.OutPoint)
+	}
+
+	serialID, err := s.newSerialID()
+	if err != nil {
+		return err
+	}
+
+	s.inputs[serialID] = &txInput{
+		serialID: serialID,
+		outPoint: shared.OutPoint,
+		prevOut:  shared.TxOut,
+		sequence: sequence,
+		local:    true,
+		shared:   true,
+	}
+
+	txid := shared.OutPoint.Hash
+	s.pending = append(s.pending, &lnwire.TxAddInput{
+		ChannelID:       s.cfg.ChanID,
+		SerialID:        serialID,
+		PrevTxVout:      shared.OutPoint.Index,
+		Sequence:        sequence,
+		SharedInputTxID: &txid,
+	})
+
+	return nil
+}
+
+// AddOutput queues a local output that pays the amount to the script.
+func (s *Session) AddOutput(amount btcutil.Amount, pkScript []byte) error {
+	if s.IsComplete() {
+		return ErrSessionComplete
+	}
+
+	if err := checkOutput(amount, pkScript); err != nil {
+		return err
+	}
+
+	serialID, err := s.newSerialID()
+	if err != nil {
+		return err
+	}
+
+	s.outputs[serialID] = &txOutput{
+		serialID: serialID,
+		amount:   amount,
+		pkScript: pkScript,
+		local:    true,
+	}
+	s.pending = append(s.pending, &lnwire.TxAddOutput{
+		ChannelID: s.cfg.ChanID,
+		SerialID:  serialID,
+		Amount:    amount,
+		PkScript:  pkScript,
+	})
+
+	return nil
+}
+
+// NextMsg returns the next message that we send to the remote party. Once
+// all local additions have been sent, a tx_complete message is returned.
+func (s *Session) NextMsg() (lnwire.Message, error) {
+	if s.IsComplete() {
+		return nil, ErrSessionComplete
+	}
+
+	// Any addition invalidates a tx_complete that the remote party sent
+	// before it.
+	if len(s.pending) > 0 {
+		msg := s.pending[0]
+		s.pending = s.pending[1:]
+		s.recvComplete = false
+		s.sentComplete = false
+
+		return msg, nil
+	}
+
+	s.sentComplete = true
+
+	return &lnwire.TxComplete{ChannelID: s.cfg.ChanID}, nil
+}
+
+// ReceiveMsg validates and applies a message of the remote party. An error
+// means the session must be aborted.
+func (s *Session) ReceiveMsg(msg lnwire.Message) error {
+	if s.IsComplete() {
+		return ErrSessionComplete
+	}
+
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		if msg.ChannelID != s.cfg.ChanID {
+			return errors.New("unknown channel id")
+		}
+
+		if err := s.checkRemoteSerial(msg.SerialID); err != nil {
+			return err
+		}
+
+		s.numRemoteInputs++
+		if s.numRemoteInputs > MaxAdds {
+			return errors.New("too many inputs added")
+		}
+
+		in, err := s.remoteInput(msg)
+		if err != nil {
+			return err
+		}
+
+		s.inputs[msg.SerialID] = in
+		s.sentComplete = false
+
+	case *lnwire.TxAddOutput:
+		if msg.ChannelID != s.cfg.ChanID {
+			return errors.New("unknown channel id")
+		}
+
+		if err := s.checkRemoteSerial(msg.SerialID); err != nil {
+			return err
+		}
+
+		s.numRemoteOutputs++
+		if s.numRemoteOutputs > MaxAdds {
+			return errors.New("too many outputs added")
+		}
+
+		if err := checkOutput(msg.Amount, msg.PkScript); err != nil {
+			return err
+		}
+
+		s.outputs[msg.SerialID] = &txOutput{
+			serialID: msg.SerialID,
+			amount:   msg.Amount,
+			pkScript: msg.PkScript,
+		}
+		s.sentComplete = false
+
+	case *lnwire.TxRemoveInput:
+		if msg.ChannelID != s.cfg.ChanID {
+			return errors.New("unknown channel id")
+		}
+
+		in, ok := s.inputs[msg.SerialID]
+		if !ok || in.local {
+			return fmt.Errorf("unknown input %d", msg.SerialID)
+		}
+
+		delete(s.inputs, msg.SerialID)
+		s.sentComplete = false
+
+	case *lnwire.TxRemoveOutput:
+		if msg.ChannelID != s.cfg.ChanID {
+			return errors.New("unknown channel id")
+		}
+
+		out, ok := s.outputs[msg.SerialID]
+		if !ok || out.local {
+			return fmt.Errorf("unknown output %d", msg.SerialID)
+		}
+
+		delete(s.outputs, msg.SerialID)
+		s.sentComplete = false
+
+	case *lnwire.TxComplete:
+		if msg.ChannelID != s.cfg.ChanID {
+			return errors.New("unknown channel id")
+		}
+
+		s.recvComplete = true
+
+	default:
+		return fmt.Errorf("unexpected message %T", msg)
+	}
+
+	return nil
+}
+
+// remoteInput validates an input that the remote party added and returns it.
+func (s *Session) remoteInput(msg *lnwire.TxAddInput) (*txInput, error) {
+	// The shared input is added without the previous transaction, as
+	// both sides know the output that it spends.
+	if msg.SharedInputTxID != nil {
+		shared := s.cfg.SharedInput
+		op := wire.OutPoint{
+			Hash:  *msg.SharedInputTxID,
+			Index: msg.PrevTxVout,
+		}
+
+		switch {
+		case shared == nil || s.cfg.Initiator:
+			return nil, errors.New("unexpected shared input")
+
+		case op != shared.OutPoint:
+			return nil, fmt.Errorf("unknown shared input %v", op)
+
+		case msg.Sequence > MaxSequence:
+			return nil, fmt.Errorf("invalid sequence %d",
+				msg.Sequence)
+
+		case s.hasOutPoint(op):
+			return nil, fmt.Errorf("outpoint %v is already spent "+
+				"by the transaction", op)
+		}
+
+		return &txInput{
+			serialID: msg.SerialID,
+			outPoint: op,
+			prevOut:  shared.TxOut,
+			sequence: msg.Sequence,
+			shared:   true,
+		}, nil
+	}
+
+	err := s.checkInput(msg.PrevTx, msg.PrevTxVout, msg.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txInput{
+		serialID: msg.SerialID,
+		outPoint: wire.OutPoint{
+			Hash:  msg.PrevTx.TxHash(),
+			Index: msg.PrevTxVout,
+		},
+		prevOut:  msg.PrevTx.TxOut[msg.PrevTxVout],
+		sequence: msg.Sequence,
+	}, nil
+}
+
+// checkRemoteSerial verifies that a serial id of the remote party has the
+// right parity and isn't used yet.
+func (s *Session) checkRemoteSerial(serialID uint64) error {
+	if s.isLocalSerial(serialID) {
+		return fmt.Errorf("serial id %d has wrong parity", serialID)
+	}
+
+	_, isInput := s.inputs[serialID]
+	_, isOutput := s.outputs[serialID]
+	if isInput || isOutput {
+		return fmt.Errorf("serial id %d is already used", serialID)
+	}
+
+	return nil
+}
+
+// sortedInputs returns the inputs of the transaction sorted by serial id.
+func (s *Session) sortedInputs() []*txInput {
+	inputs := make([]*txInput, 0, len(s.inputs))
+	for _, in := range s.inputs {
+		inputs = append(inputs, in)
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].serialID < inputs[j].serialID
+	})
+
+	return inputs
+}
+
+// sortedOutputs returns the outputs of the transaction sorted by serial id.
+func (s *Session) sortedOutputs() []*txOutput {
+	outputs := make([]*txOutput, 0, len(s.outputs))
+	for _, out := range s.outputs {
+		outputs = append(outputs, out)
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].serialID < outputs[j].serialID
+	})
+
+	return outputs
+}
+
+// addInputWeight adds the estimated weight of spending the output to the
+// estimator.
+func addInputWeight(w *input.TxWeightEstimator, prevOut *wire.TxOut) {
+	switch {
+	case txscript.IsPayToTaproot(prevOut.PkScript):
+		w.AddTaprootKeySpendInput(txscript.SigHashDefault)
+
+	// Only the shared input spends a P2WSH output, which is always a
+	// 2-of-2 multisig funding output.
+	case txscript.IsPayToWitnessScriptHash(prevOut.PkScript):
+		w.AddWitnessInput(input.MultiSigWitnessSize)
+
+	default:
+		w.AddP2WKHInput()
+	}
+}
+
+// Tx returns the unsigned transaction that was constructed. It fails if the
+// session isn't complete yet, or if the transaction is invalid.
+func (s *Session) Tx() (*wire.MsgTx, error) {
+	if !s.IsComplete() {
+		return nil, ErrSessionIncomplete
+	}
+
+	if len(s.inputs) >= maxTxInsOuts {
+		return nil, fmt.Errorf("transaction has too many inputs")
+	}
+
+	if len(s.outputs) >= maxTxInsOuts {
+		return nil, fmt.Errorf("transaction has too many outputs")
+	}
+
+	var (
+		tx       = wire.NewMsgTx(2)
+		weight   input.TxWeightEstimator
+		totalIn  btcutil.Amount
+		totalOut btcutil.Amount
+	)
+	tx.LockTime = s.cfg.LockTime
+
+	for _, in := range s.sortedInputs() {
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: in.outPoint,
+			Sequence:         in.sequence,
+		})
+
+		addInputWeight(&weight, in.prevOut)
+		totalIn += btcutil.Amount(in.prevOut.Value)
+	}
+
+	for _, out := range s.sortedOutputs() {
+		tx.AddTxOut(wire.NewTxOut(int64(out.amount), out.pkScript))
+
+		weight.AddTxOutput(tx.TxOut[len(tx.TxOut)-1])
+		totalOut += out.amount
+	}
+
+	if weight.Weight() > blockchain.MaxBlockWeight/10 {
+		return nil, fmt.Errorf("transaction weight %d is too large",
+			weight.Weight())
+	}
+
+	if totalIn < totalOut {
+		return nil, fmt.Errorf("outputs of %v exceed inputs of %v",
+			totalOut, totalIn)
+	}
+
+	return tx, nil
+}
+
+// PrevOutputFetcher returns a fetcher for the outputs that are spent by the
+// inputs of the transaction, which is needed to sign the transaction.
+func (s *Session) PrevOutputFetcher() *txscript.MultiPrevOutFetcher {
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for _, in := range s.inputs {
+		fetcher.AddPrevOut(in.outPoint, in.prevOut)
+	}
+
+	return fetcher
+}
+
+// Inputs returns the outpoints of the local or remote inputs of the
+// transaction, in the order in which they appear in the transaction. The
+// shared input isn't included, as it's signed by both sides.
+func (s *Session) Inputs(local bool) []wire.OutPoint {
+	var ops []wire.OutPoint
+	for _, in := range s.sortedInputs() {
+		if in.local == local && !in.shared {
+			ops = append(ops, in.outPoint)
+		}
+	}
+
+	return ops
+}
+
+// SharedInputIndex returns the index of the shared input within the
+// transaction, or -1 if the transaction doesn't spend the shared input.
+func (s *Session) SharedInputIndex() int {
+	for i, in := range s.sortedInputs() {
+		if in.shared {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Contribution summarizes the inputs and outputs that one side added to the
+// transaction.
+type Contribution struct {
+	// Inputs is the total amount of the inputs.
+	Inputs btcutil.Amount
+
+	// Outputs is the total amount of the outputs.
+	Outputs btcutil.Amount
+
+	// Weight is the estimated weight of the inputs and outputs once the
+	// transaction is signed. It doesn't include the fields that are
+	// common to the whole transaction.
+	Weight int64
+}
+
+// Contribution returns the contribution of the local or remote party to the
+// transaction. The shared input counts as an input of the initiator.
+func (s *Session) Contribution(local bool) Contribution {
+	var (
+		c      Contribution
+		weight int64
+	)
+	for _, in := range s.inputs {
+		if in.local != local {
+			continue
+		}
+
+		var w input.TxWeightEstimator
+		addInputWeight(&w, in.prevOut)
+
+		// The estimator accounts for the segwit marker and flag once
+		// it has a witness input, which we leave to the common fields.
+		weight += int64(w.Weight()) - input.WitnessHeaderSize -
+			baseTxWeight
+		c.Inputs += btcutil.Amount(in.prevOut.Value)
+	}
+
+	for _, out := range s.outputs {
+		if out.local != local {
+			continue
+		}
+
+		var w input.TxWeightEstimator
+		w.AddTxOutput(wire.NewTxOut(int64(out.amount), out.pkScript))
+
+		weight += int64(w.Weight()) - baseTxWeight
+		c.Outputs += out.amount
+	}
+	c.Weight = weight
+
+	return c
+}
+
+// baseTxWeight is the weight of a transaction without any inputs or outputs
+// and witnesses, as computed by an empty input.TxWeightEstimator.
+var baseTxWeight = int64(func() int {
+	var w input.TxWeightEstimator
+	return w.Weight()
+}())
+
+// CommonWeight is the weight of the fields of a transaction that are common
+// to both sides, which is paid for by the initiator.
+func CommonWeight() int64 {
+	return baseTxWeight + input.WitnessHeaderSize
+}
+
+// SendsSignaturesFirst returns true if we have to send our tx_signatures
+// before the remote party. The side that contributed the lower total input
+// amount sends first, if both are equal the side with the lower node id does.
+func SendsSignaturesFirst(local, remote Contribution, localNode,
+	remoteNode *btcec.PublicKey) bool {
+
+	if local.Inputs != remote.Inputs {
+		return local.Inputs < remote.Inputs
+	}
+
+	return bytes.Compare(
+		localNode.SerializeCompressed(),
+		remoteNode.SerializeCompressed(),
+	) < 0
+}
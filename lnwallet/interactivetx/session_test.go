@@ -0,0 +1,338 @@
+package interactivetx
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChanID = lnwire.ChannelID{1}
+
+	p2wkhScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	p2trScript  = append([]byte{0x51, 0x20}, make([]byte, 32)...)
+	p2pkScript  = append(append([]byte{0x21}, make([]byte, 33)...), 0xac)
+)
+
+// newPrevTx returns a transaction with a single output that pays the amount
+// to the script. The lock time makes the transactions unique.
+func newPrevTx(amt btcutil.Amount, pkScript []byte,
+	lockTime uint32) *wire.MsgTx {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(wire.NewTxOut(int64(amt), pkScript))
+	tx.LockTime = lockTime
+
+	return tx
+}
+
+// runSessions exchanges messages between the two sessions until both are
+// complete. The initiator sends the first message.
+func runSessions(t *testing.T, initiator, responder *Session) {
+	t.Helper()
+
+	sender, receiver := initiator, responder
+	for i := 0; !initiator.IsComplete() || !responder.IsComplete(); i++ {
+		require.Less(t, i, 100, "sessions didn't complete")
+
+		msg, err := sender.NextMsg()
+		require.NoError(t, err)
+		require.NoError(t, receiver.ReceiveMsg(msg))
+
+		sender, receiver = receiver, sender
+	}
+}
+
+// TestSession tests that both sides of a session construct the same
+// transaction with inputs and outputs ordered by serial id.
+func TestSession(t *testing.T) {
+	t.Parallel()
+
+	initiator := NewSession(Config{
+		ChanID:    testChanID,
+		Initiator: true,
+		LockTime:  100,
+	})
+	responder := NewSession(Config{
+		ChanID:   testChanID,
+		LockTime: 100,
+	})
+
+	require.NoError(t, initiator.AddInput(
+		newPrevTx(100_000, p2wkhScript, 1), 0, 1,
+	))
+	require.NoError(t, initiator.AddOutput(150_000, p2wkhScript))
+	require.NoError(t, responder.AddInput(
+		newPrevTx(80_000, p2trScript, 2), 0, 1,
+	))
+	require.NoError(t, responder.AddOutput(20_000, p2trScript))
+
+	runSessions(t, initiator, responder)
+
+	initiatorTx, err := initiator.Tx()
+	require.NoError(t, err)
+	responderTx, err := responder.Tx()
+	require.NoError(t, err)
+
+	require.Equal(t, initiatorTx, responderTx)
+	require.Len(t, initiatorTx.TxIn, 2)
+	require.Len(t, initiatorTx.TxOut, 2)
+	require.EqualValues(t, 100, initiatorTx.LockTime)
+
+	// Both sides agree on who added which input.
+	require.Equal(t, initiator.Inputs(true), responder.Inputs(false))
+	require.Equal(t, initiator.Inputs(false), responder.Inputs(true))
+
+	local := initiator.Contribution(true)
+	remote := initiator.Contribution(false)
+	require.EqualValues(t, 100_000, local.Inputs)
+	require.EqualValues(t, 150_000, local.Outputs)
+	require.EqualValues(t, 80_000, remote.Inputs)
+	require.EqualValues(t, 20_000, remote.Outputs)
+	require.Equal(t, local, responder.Contribution(false))
+
+	// Both sides pay for the weight of their inputs and outputs.
+	require.Greater(t, local.Weight, int64(0))
+	require.Greater(t, remote.Weight, int64(0))
+
+	fetcher := initiator.PrevOutputFetcher()
+	for _, txIn := range initiatorTx.TxIn {
+		prevOut := fetcher.FetchPrevOutput(txIn.PreviousOutPoint)
+		require.NotNil(t, prevOut)
+	}
+
+	// Once complete, the sessions can't be modified anymore.
+	require.ErrorIs(
+		t, initiator.AddOutput(1000, p2wkhScript), ErrSessionComplete,
+	)
+}
+
+// TestSessionInvalidMsgs tests that invalid messages of the remote party are
+// rejected.
+func TestSessionInvalidMsgs(t *testing.T) {
+	t.Parallel()
+
+	prevTx := newPrevTx(100_000, p2wkhScript, 1)
+
+	testCases := []struct {
+		name string
+		msgs []lnwire.Message
+	}{{
+		name: "wrong channel id",
+		msgs: []lnwire.Message{&lnwire.TxComplete{}},
+	}, {
+		name: "wrong parity",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChannelID: testChanID,
+			SerialID:  2,
+			PrevTx:    prevTx,
+		}},
+	}, {
+		name: "duplicate serial id",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			PrevTx:    prevTx,
+		}, &lnwire.TxAddOutput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			Amount:    1000,
+			PkScript:  p2wkhScript,
+		}},
+	}, {
+		name: "duplicate outpoint",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			PrevTx:    prevTx,
+		}, &lnwire.TxAddInput{
+			ChannelID: testChanID,
+			SerialID:  3,
+			PrevTx:    prevTx,
+		}},
+	}, {
+		name: "unknown vout",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChannelID:  testChanID,
+			SerialID:   1,
+			PrevTx:     prevTx,
+			PrevTxVout: 1,
+		}},
+	}, {
+		name: "non witness input",
+		msgs: []lnwire.Message{&lnwire.TxAddInput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			PrevTx:    newPrevTx(100_000, p2pkScript, 1),
+		}},
+	}, {
+		name: "dust output",
+		msgs: []lnwire.Message{&lnwire.TxAddOutput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			Amount:    100,
+			PkScript:  p2wkhScript,
+		}},
+	}, {
+		name: "non standard output",
+		msgs: []lnwire.Message{&lnwire.TxAddOutput{
+			ChannelID: testChanID,
+			SerialID:  1,
+			Amount:    1000,
+			PkScript:  []byte{0x01},
+		}},
+	}, {
+		name: "remove unknown input",
+		msgs: []lnwire.Message{&lnwire.TxRemoveInput{
+			ChannelID: testChanID,
+			SerialID:  1,
+		}},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewSession(Config{
+				ChanID:    testChanID,
+				Initiator: true,
+			})
+
+			var err error
+			for _, msg := range tc.msgs {
+				err = s.ReceiveMsg(msg)
+				if err != nil {
+					break
+				}
+			}
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestSessionRemoveLocalInput tests that the remote party can't remove our
+// inputs.
+func TestSessionRemoveLocalInput(t *testing.T) {
+	t.Parallel()
+
+	s := NewSession(Config{ChanID: testChanID, Initiator: true})
+	require.NoError(t, s.AddInput(newPrevTx(100_000, p2wkhScript, 1), 0, 1))
+
+	msg, err := s.NextMsg()
+	require.NoError(t, err)
+
+	addInput, ok := msg.(*lnwire.TxAddInput)
+	require.True(t, ok)
+	require.Zero(t, addInput.SerialID%2)
+
+	err = s.ReceiveMsg(&lnwire.TxRemoveInput{
+		ChannelID: testChanID,
+		SerialID:  addInput.SerialID,
+	})
+	require.Error(t, err)
+}
+
+// TestSessionAdditionResetsComplete tests that the session isn't complete if
+// an input is added after a tx_complete was sent.
+func TestSessionAdditionResetsComplete(t *testing.T) {
+	t.Parallel()
+
+	s := NewSession(Config{ChanID: testChanID, Initiator: true})
+
+	msg, err := s.NextMsg()
+	require.NoError(t, err)
+	require.IsType(t, &lnwire.TxComplete{}, msg)
+
+	err = s.ReceiveMsg(&lnwire.TxAddOutput{
+		ChannelID: testChanID,
+		SerialID:  1,
+		Amount:    1000,
+		PkScript:  p2wkhScript,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.ReceiveMsg(&lnwire.TxComplete{
+		ChannelID: testChanID,
+	}))
+	require.False(t, s.IsComplete())
+
+	msg, err = s.NextMsg()
+	require.NoError(t, err)
+	require.IsType(t, &lnwire.TxComplete{}, msg)
+	require.True(t, s.IsComplete())
+
+	// The transaction is invalid, as its output isn't funded.
+	_, err = s.Tx()
+	require.Error(t, err)
+}
+
+// TestSessionSharedInput tests that the initiator can add the shared input
+// without the previous transaction, and that the responder only accepts the
+// shared input that it expects.
+func TestSessionSharedInput(t *testing.T) {
+	t.Parallel()
+
+	p2wshScript := append([]byte{0x00, 0x20}, make([]byte, 32)...)
+	shared := &SharedInput{
+		OutPoint: wire.OutPoint{Hash: [32]byte{1}, Index: 1},
+		TxOut:    wire.NewTxOut(200_000, p2wshScript),
+	}
+
+	initiator := NewSession(Config{
+		ChanID:      testChanID,
+		Initiator:   true,
+		SharedInput: shared,
+	})
+	responder := NewSession(Config{
+		ChanID:      testChanID,
+		SharedInput: shared,
+	})
+
+	// Only the initiator may add the shared input.
+	require.Error(t, responder.AddSharedInput(1))
+
+	require.NoError(t, initiator.AddSharedInput(1))
+	require.NoError(t, initiator.AddInput(
+		newPrevTx(100_000, p2wkhScript, 1), 0, 1,
+	))
+	require.NoError(t, initiator.AddOutput(290_000, p2wshScript))
+
+	runSessions(t, initiator, responder)
+
+	initiatorTx, err := initiator.Tx()
+	require.NoError(t, err)
+	responderTx, err := responder.Tx()
+	require.NoError(t, err)
+	require.Equal(t, initiatorTx, responderTx)
+
+	// Both sides agree on the index of the shared input, which isn't
+	// part of the inputs that are signed by a single side.
+	idx := initiator.SharedInputIndex()
+	require.Equal(t, idx, responder.SharedInputIndex())
+	require.Equal(
+		t, shared.OutPoint, initiatorTx.TxIn[idx].PreviousOutPoint,
+	)
+	require.Len(t, initiator.Inputs(true), 1)
+	require.Empty(t, responder.Inputs(true))
+
+	// The shared input counts as an input of the initiator.
+	local := initiator.Contribution(true)
+	require.EqualValues(t, 300_000, local.Inputs)
+	require.Equal(t, local, responder.Contribution(false))
+
+	// A shared input that doesn't match the expected one is rejected.
+	s := NewSession(Config{ChanID: testChanID, SharedInput: shared})
+	err = s.ReceiveMsg(&lnwire.TxAddInput{
+		ChannelID:       testChanID,
+		SerialID:        2,
+		PrevTxVout:      0,
+		SharedInputTxID: &shared.OutPoint.Hash,
+	})
+	require.Error(t, err)
+}
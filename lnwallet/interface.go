@@ -75,6 +75,11 @@ var (
 	// requirements of the mempool backend are not met.
 	ErrMempoolFee = errors.New("transaction rejected by the mempool " +
 		"because of low fees")
+
+	// ErrPackageRelayUnsupported is returned from SubmitPackage in case
+	// the chain backend doesn't support the submission of packages.
+	ErrPackageRelayUnsupported = errors.New("package relay not " +
+		"supported by chain backend")
 )
 
 // ErrNoOutputs is returned if we try to create a transaction with no outputs
@@ -431,6 +436,15 @@ type WalletController interface {
 	// published transaction.
 	PublishTransaction(tx *wire.MsgTx, label string) error
 
+	// SubmitPackage submits a package of transactions to the mempool of
+	// the chain backend, in which a child pays for its unconfirmed
+	// parents. This allows parents below the minimum fee rate of the
+	// mempool to be accepted along with their child. The parents come
+	// first in the package, and the child last. The label is saved with
+	// the child. If the chain backend doesn't support package relay,
+	// ErrPackageRelayUnsupported is returned.
+	SubmitPackage(txs []*wire.MsgTx, label string) error
+
 	// LabelTransaction adds a label to a transaction. If the tx already
 	// has a label, this call will fail unless the overwrite parameter
 	// is set. Labels must not be empty, and they are limited to 500 chars.
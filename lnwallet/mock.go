@@ -243,6 +243,18 @@ func (w *mockWalletController) PublishTransaction(tx *wire.MsgTx,
 	return nil
 }
 
+// SubmitPackage sends the transactions of the package to the
+// PublishedTransactions chan.
+func (w *mockWalletController) SubmitPackage(txs []*wire.MsgTx,
+	_ string) error {
+
+	for _, tx := range txs {
+		w.PublishedTransactions <- tx
+	}
+
+	return nil
+}
+
 // LabelTransaction currently does nothing.
 func (w *mockWalletController) LabelTransaction(chainhash.Hash, string,
 	bool) error {
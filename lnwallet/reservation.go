@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
@@ -184,6 +185,11 @@ type ChannelReservation struct {
 	// fundingTx is the funding transaction for this pending channel.
 	fundingTx *wire.MsgTx
 
+	// fundingPrevOutFetcher looks up the outputs that are spent by the
+	// funding transaction of a dual funded channel, which was constructed
+	// together with the remote party.
+	fundingPrevOutFetcher txscript.PrevOutputFetcher
+
 	// In order of sorted inputs. Sorting is done in accordance
 	// to BIP-69: https://github.com/bitcoin/bips/blob/master/bip-0069.mediawiki.
 	ourFundingInputScripts   []*input.Script
@@ -264,10 +270,34 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// Used to cut down on verbosity.
 	defaultDust := wallet.Cfg.DefaultConstraints.DustLimit
 
+	switch {
+	// For a dual funded channel, both sides put their contribution into
+	// the channel, and the initiator pays the fees of the commitment.
+	case req.DualFund:
+		initiator = req.Initiator
+		ourBalance = localFundingMSat
+		theirBalance = capacityMSat - localFundingMSat
+
+		funderBalance := &ourBalance
+		if !initiator {
+			funderBalance = &theirBalance
+		}
+
+		// If the initiator doesn't have enough funds to actually pay
+		// the fees, then we'll bail out early.
+		if *funderBalance < feeMSat {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee),
+				int64(funderBalance.ToSatoshis()),
+				int64(2*defaultDust),
+			)
+		}
+		*funderBalance -= feeMSat
+
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
 	// some funds to us within the first commitment state.
-	if localFundingAmt == 0 {
+	case localFundingAmt == 0:
 		ourBalance = req.PushMSat
 		theirBalance = capacityMSat - feeMSat - req.PushMSat
 		initiator = false
@@ -280,7 +310,8 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 				int64(2*defaultDust),
 			)
 		}
-	} else {
+
+	default:
 		// TODO(roasbeef): need to rework fee structure in general and
 		// also when we "unlock" dual funder within the daemon
 
@@ -340,8 +371,12 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 
 	// If either of the balances are zero at this point, or we have a
 	// non-zero push amt (there's no pushing for dual funder), then this is
-	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || req.PushMSat != 0 {
+	// a single-funder channel. Channels that are opened with the dual
+	// funding protocol are treated the same, as their initiator pays the
+	// fees just like the initiator of a single funder channel.
+	singleFunder := ourBalance == 0 || theirBalance == 0 ||
+		req.PushMSat != 0 || req.DualFund
+	if singleFunder {
 		// Both the tweakless type and the anchor type is tweakless,
 		// hence set the bit.
 		if req.CommitType.HasStaticRemoteKey() {
@@ -582,6 +617,76 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ProcessDualContribution verifies and records the remote party's
+// contribution to a channel that is opened with the dual funding protocol.
+// The remote party puts theirFundingAmt into the channel. If we're the
+// initiator, the capacity of the channel is updated to include it. Once this
+// method returns, the funding output of the channel is known.
+//
+// NOTE: As the channel reserves of a dual funded channel depend on its final
+// capacity, CommitConstraints must be called after this method.
+func (r *ChannelReservation) ProcessDualContribution(
+	theirContribution *ChannelContribution,
+	theirFundingAmt btcutil.Amount) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addDualContributionMsg{
+		pendingFundingID: r.reservationID,
+		contribution:     theirContribution,
+		fundingAmt:       theirFundingAmt,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// ProcessFundingTx continues the funding flow of a dual funded channel once
+// the funding transaction has been constructed together with the remote
+// party. The fetcher must return the outputs spent by all inputs of the
+// transaction. Once this method returns, our signatures for our inputs and
+// for the remote commitment transaction are available via OurSignatures.
+func (r *ChannelReservation) ProcessFundingTx(fundingTx *wire.MsgTx,
+	prevOutFetcher txscript.PrevOutputFetcher) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addDualFundingTxMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		prevOutFetcher:   prevOutFetcher,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// SecondCommitmentPoint returns our commitment point for the second state of
+// the channel, which is sent upfront when opening a dual funded channel.
+func (r *ChannelReservation) SecondCommitmentPoint() (*btcec.PublicKey,
+	error) {
+
+	r.RLock()
+	defer r.RUnlock()
+
+	preimage, err := r.partialState.RevocationProducer.AtIndex(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.ComputeCommitmentPoint(preimage[:]), nil
+}
+
+// FundingOutput returns the funding output of the channel. It's only known
+// once both parties' multi-sig keys and funding amounts are known.
+func (r *ChannelReservation) FundingOutput() (*wire.TxOut, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	_, fundingOutput, err := r.fundingIntent.FundingOutput()
+	return fundingOutput, err
+}
+
 // IsPsbt returns true if there is a PSBT funding intent mapped to this
 // reservation.
 func (r *ChannelReservation) IsPsbt() bool {
@@ -0,0 +1,882 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/txsort"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrSpliceTaproot is returned when a taproot channel is spliced,
+	// which isn't supported yet.
+	ErrSpliceTaproot = errors.New("splicing taproot channels isn't " +
+		"supported")
+
+	// ErrSpliceNotClean is returned when a channel is spliced that has
+	// pending updates or HTLCs.
+	ErrSpliceNotClean = errors.New("channel must be clean to be spliced")
+
+	// ErrMissingSpliceSig is returned when a new commitment of a channel
+	// with a pending splice isn't signed for the funding output of the
+	// splice.
+	ErrMissingSpliceSig = errors.New("commitment of spliced channel " +
+		"lacks splice signature")
+
+	// ErrUpgradeSplicePublic is returned when a public channel is
+	// upgraded to taproot. Taproot channels can't be announced yet, so
+	// only private channels can be upgraded.
+	ErrUpgradeSplicePublic = errors.New("only private channels can be " +
+		"upgraded to taproot")
+
+	// ErrUpgradeSplicePending is returned when a new commitment is
+	// created for a channel whose upgrade splice hasn't confirmed yet.
+	// The commitments of both channel types can't be updated together, so
+	// the channel is paused until the splice confirms or is aborted.
+	ErrUpgradeSplicePending = errors.New("channel is paused until its " +
+		"upgrade splice confirms")
+)
+
+// SpliceUpgrade holds what's needed to create the commitments of a splice
+// that upgrades the channel to a taproot channel.
+type SpliceUpgrade struct {
+	// ChanType is the type of the channel after the splice.
+	ChanType channeldb.ChannelType
+
+	// RemoteNonce is the verification nonce of the remote party for its
+	// commitment that spends the new funding output.
+	RemoteNonce *musig2.Nonces
+}
+
+// Splice holds the commitment transactions of a channel that spend the new
+// funding output of a splice transaction. The commitments are created at the
+// current commitment heights, so they replace the current commitments once
+// the splice transaction confirms.
+type Splice struct {
+	// FundingOutpoint is the new funding output of the channel.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the value of the new funding output.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance after the splice.
+	LocalBalance lnwire.MilliSatoshi
+
+	// RemoteBalance is the balance of the remote party after the splice.
+	RemoteBalance lnwire.MilliSatoshi
+
+	// chanType is the channel type of the commitments.
+	chanType channeldb.ChannelType
+
+	// upgrade is true if the splice upgrades the channel to chanType.
+	upgrade bool
+
+	// commitFee is the fee of both commitments.
+	commitFee btcutil.Amount
+
+	// fundingOutput is the new funding output that the commitments spend.
+	fundingOutput *wire.TxOut
+
+	// musigSessions are the musig2 sessions of the commitments of a
+	// splice that upgrades the channel to taproot.
+	musigSessions *MusigPairSession
+
+	localCommitTx  *wire.MsgTx
+	remoteCommitTx *wire.MsgTx
+
+	// localSig is our signature for the commitment of the remote party.
+	localSig input.Signature
+
+	// remoteSig is the signature of the remote party for our commitment,
+	// which is set once it has been verified.
+	remoteSig input.Signature
+}
+
+// SpliceFundingOutput returns the funding output of the channel with the
+// given capacity. As the funding keys don't change, the output only differs
+// from the current funding output in its value.
+func (lc *LightningChannel) SpliceFundingOutput(
+	capacity btcutil.Amount) *wire.TxOut {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return wire.NewTxOut(int64(capacity), lc.fundingOutput.PkScript)
+}
+
+// UpgradeSpliceChanType returns the channel type that a splice upgrades the
+// channel to for the given commitment type. Only upgrades to taproot require
+// a splice, as they move the channel to a MuSig2 funding output. The other
+// commitment types are upgraded with dynamic commitments.
+func (lc *LightningChannel) UpgradeSpliceChanType(
+	commitType CommitmentType) (channeldb.ChannelType, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	chanType := chanState.ChanType
+
+	switch {
+	case !commitType.IsTaproot():
+		return 0, fmt.Errorf("channels are only upgraded to %v with "+
+			"a splice", CommitmentTypeSimpleTaproot)
+
+	case chanType.IsTaproot():
+		return 0, errors.New("channel is already a taproot channel")
+
+	case chanType.HasLeaseExpiration():
+		return 0, ErrUpgradeLease
+
+	case chanState.ChannelFlags&lnwire.FFAnnounceChannel != 0:
+		return 0, ErrUpgradeSplicePublic
+	}
+
+	// The commitments that spend the new funding output are created at
+	// the current heights, so the parameters of a dynamic commitment
+	// upgrade must already apply to them.
+	localHeight, remoteHeight := chanState.UpgradeHeights()
+	if localHeight > chanState.LocalCommitment.CommitHeight ||
+		remoteHeight > chanState.RemoteCommitment.CommitHeight {
+
+		return 0, fmt.Errorf("%w: dynamic commitment upgrade hasn't "+
+			"been committed yet", ErrUpgradePendingUpdates)
+	}
+
+	return chanType | channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit |
+		channeldb.SimpleTaprootFeatureBit, nil
+}
+
+// UpgradeSpliceChanTypeFromWire returns the channel type that a splice
+// upgrades the channel to for the channel type of a splice_init message of
+// the remote party.
+func (lc *LightningChannel) UpgradeSpliceChanTypeFromWire(
+	wireType lnwire.ChannelType) (channeldb.ChannelType, error) {
+
+	features := lnwire.RawFeatureVector(wireType)
+	if !features.OnlyContains(lnwire.SimpleTaprootChannelsRequiredStaging) ||
+		!features.IsSet(lnwire.SimpleTaprootChannelsRequiredStaging) {
+
+		return 0, ErrUpgradeUnknownType
+	}
+
+	return lc.UpgradeSpliceChanType(CommitmentTypeSimpleTaproot)
+}
+
+// SpliceVerificationNonce returns our verification nonce for our commitment
+// that spends the funding output of a splice which upgrades the channel to
+// taproot. The nonce is derived for the current height of our commitment, so
+// it can be derived again once the commitment is broadcast.
+func (lc *LightningChannel) SpliceVerificationNonce() (*musig2.Nonces, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+
+	return channeldb.NewMusigVerificationNonce(
+		chanState.LocalChanCfg.MultiSigKey.PubKey,
+		chanState.LocalCommitment.CommitHeight,
+		lc.taprootNonceProducer,
+	)
+}
+
+// UpgradeSpliceFundingOutput returns the MuSig2 funding output with the given
+// capacity of a splice that upgrades the channel to taproot.
+func (lc *LightningChannel) UpgradeSpliceFundingOutput(
+	capacity btcutil.Amount) (*wire.TxOut, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	_, txOut, err := input.GenTaprootFundingScript(
+		lc.channelState.LocalChanCfg.MultiSigKey.PubKey,
+		lc.channelState.RemoteChanCfg.MultiSigKey.PubKey,
+		int64(capacity),
+	)
+
+	return txOut, err
+}
+
+// NewSplice creates the commitment transactions that spend the new funding
+// output of a splice, which adds the given amounts to the balances of both
+// sides. The amounts are negative for funds that are spliced out. The channel
+// must not have any HTLCs or pending updates.
+func (lc *LightningChannel) NewSplice(fundingOutpoint wire.OutPoint,
+	localDelta, remoteDelta btcutil.Amount) (*Splice, error) {
+
+	return lc.newSplice(fundingOutpoint, localDelta, remoteDelta, nil)
+}
+
+// NewUpgradeSplice creates the commitment transactions of a splice that
+// upgrades the channel to taproot. The commitments are of the new channel
+// type, and the initiator of the channel pays for the change of the
+// commitment fee and for the anchors that it adds.
+func (lc *LightningChannel) NewUpgradeSplice(fundingOutpoint wire.OutPoint,
+	localDelta, remoteDelta btcutil.Amount,
+	upgrade *SpliceUpgrade) (*Splice, error) {
+
+	if upgrade == nil || !upgrade.ChanType.IsTaproot() {
+		return nil, errors.New("splice must upgrade to taproot")
+	}
+	if upgrade.RemoteNonce == nil {
+		return nil, errors.New("remote musig2 nonce missing")
+	}
+
+	return lc.newSplice(fundingOutpoint, localDelta, remoteDelta, upgrade)
+}
+
+// newSplice creates the commitments of a splice, which are of the channel
+// type of the upgrade if it's set.
+func (lc *LightningChannel) newSplice(fundingOutpoint wire.OutPoint,
+	localDelta, remoteDelta btcutil.Amount,
+	upgrade *SpliceUpgrade) (*Splice, error) {
+
+	if !lc.IsChannelClean() {
+		return nil, ErrSpliceNotClean
+	}
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	if chanState.ChanType.IsTaproot() {
+		return nil, ErrSpliceTaproot
+	}
+
+	localCommit := chanState.LocalCommitment
+	remoteCommit := chanState.RemoteCommitment
+
+	// The commitment fee and any anchors are already deducted from the
+	// balances, so they stay the same and only the balances change.
+	localBalance := int64(localCommit.LocalBalance) +
+		int64(lnwire.NewMSatFromSatoshis(localDelta))
+	remoteBalance := int64(localCommit.RemoteBalance) +
+		int64(lnwire.NewMSatFromSatoshis(remoteDelta))
+	capacity := chanState.Capacity + localDelta + remoteDelta
+
+	chanType := chanState.ChanType
+	commitFee := localCommit.CommitFee
+	fundingOutput := &wire.TxOut{
+		PkScript: lc.fundingOutput.PkScript,
+		Value:    int64(capacity),
+	}
+
+	// If the channel is upgraded, the initiator pays the difference of
+	// the commitment fee and the anchors of the new channel type.
+	if upgrade != nil {
+		chanType = upgrade.ChanType
+
+		feePerKw := chainfee.SatPerKWeight(localCommit.FeePerKw)
+		commitFee = feePerKw.FeeForWeight(CommitWeight(chanType))
+
+		cost := commitFee + 2*anchorSize - localCommit.CommitFee
+		if chanState.ChanType.HasAnchors() {
+			cost -= 2 * anchorSize
+		}
+
+		costMSat := int64(lnwire.NewMSatFromSatoshis(cost))
+		if chanState.IsInitiator {
+			localBalance -= costMSat
+		} else {
+			remoteBalance -= costMSat
+		}
+
+		var err error
+		_, fundingOutput, err = input.GenTaprootFundingScript(
+			chanState.LocalChanCfg.MultiSigKey.PubKey,
+			chanState.RemoteChanCfg.MultiSigKey.PubKey,
+			int64(capacity),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if localBalance < 0 || remoteBalance < 0 {
+		return nil, fmt.Errorf("splice of %v and %v exceeds channel "+
+			"balance", localDelta, remoteDelta)
+	}
+
+	// Both commitments are created at the current heights, so we use the
+	// commitment points that the current commitments use.
+	localSecret, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localPoint := input.ComputeCommitmentPoint(localSecret[:])
+	remotePoint := chanState.RemoteCurrentRevocation
+
+	var leaseExpiry uint32
+	if chanType.HasLeaseExpiration() {
+		leaseExpiry = chanState.ThawHeight
+	}
+
+	localCommitTx, remoteCommitTx, err := CreateCommitmentTxns(
+		lnwire.MilliSatoshi(localBalance).ToSatoshis(),
+		lnwire.MilliSatoshi(remoteBalance).ToSatoshis(),
+		&chanState.LocalChanCfg, &chanState.RemoteChanCfg, localPoint,
+		remotePoint, *wire.NewTxIn(&fundingOutpoint, nil, nil),
+		chanType, chanState.IsInitiator, leaseExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	obfuscator := createStateHintObfuscator(chanState)
+	err = SetStateNumHint(
+		localCommitTx, localCommit.CommitHeight, obfuscator,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = SetStateNumHint(
+		remoteCommitTx, remoteCommit.CommitHeight, obfuscator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without HTLCs, the commitments are sorted like the initial ones.
+	txsort.InPlaceSort(localCommitTx)
+	txsort.InPlaceSort(remoteCommitTx)
+
+	// The commitments of a taproot channel are signed with musig2, for
+	// which our verification nonce is derived for the current height of
+	// our commitment, just like the remote party derives its nonce.
+	var musigSessions *MusigPairSession
+	if upgrade != nil {
+		localNonce, err := channeldb.NewMusigVerificationNonce(
+			chanState.LocalChanCfg.MultiSigKey.PubKey,
+			localCommit.CommitHeight, lc.taprootNonceProducer,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		musigSessions = NewMusigPairSession(&MusigSessionCfg{
+			LocalKey:    chanState.LocalChanCfg.MultiSigKey,
+			RemoteKey:   chanState.RemoteChanCfg.MultiSigKey,
+			LocalNonce:  *localNonce,
+			RemoteNonce: *upgrade.RemoteNonce,
+			Signer:      lc.Signer,
+			InputTxOut:  fundingOutput,
+		})
+	}
+
+	return &Splice{
+		FundingOutpoint: fundingOutpoint,
+		Capacity:        capacity,
+		LocalBalance:    lnwire.MilliSatoshi(localBalance),
+		RemoteBalance:   lnwire.MilliSatoshi(remoteBalance),
+		chanType:        chanType,
+		upgrade:         upgrade != nil,
+		commitFee:       commitFee,
+		fundingOutput:   fundingOutput,
+		musigSessions:   musigSessions,
+		localCommitTx:   localCommitTx,
+		remoteCommitTx:  remoteCommitTx,
+	}, nil
+}
+
+// SignSpliceCommitment signs the commitment of the remote party that spends
+// the new funding output of the splice. The signature of a splice that
+// upgrades the channel to taproot is a musig2 partial signature.
+func (lc *LightningChannel) SignSpliceCommitment(
+	s *Splice) (input.Signature, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if s.musigSessions != nil {
+		sig, err := s.musigSessions.RemoteSession.SignCommit(
+			s.remoteCommitTx,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		s.localSig = sig
+
+		return sig, nil
+	}
+
+	signDesc := *lc.signDesc
+	signDesc.Output = s.fundingOutput
+	signDesc.SigHashes = input.NewTxSigHashesV0Only(s.remoteCommitTx)
+	signDesc.InputIndex = 0
+
+	sig, err := lc.Signer.SignOutputRaw(s.remoteCommitTx, &signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	s.localSig = sig
+
+	return sig, nil
+}
+
+// VerifySpliceCommitment verifies the signature of the remote party for our
+// commitment that spends the new funding output of the splice.
+func (lc *LightningChannel) VerifySpliceCommitment(s *Splice,
+	sig input.Signature) error {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if s.musigSessions != nil {
+		partialSig, ok := sig.(*MusigPartialSig)
+		if !ok {
+			return fmt.Errorf("expected MusigPartialSig, got %T",
+				sig)
+		}
+
+		_, err := s.musigSessions.LocalSession.VerifyCommitSig(
+			s.localCommitTx, partialSig.ToWireSig(),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid splice commitment "+
+				"signature: %w", err)
+		}
+
+		s.remoteSig = partialSig
+
+		return nil
+	}
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript,
+		input.NewTxSigHashesV0Only(s.localCommitTx),
+		txscript.SigHashAll, s.localCommitTx, 0, int64(s.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	remoteKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	if !sig.Verify(sigHash, remoteKey) {
+		return errors.New("invalid splice commitment signature")
+	}
+
+	s.remoteSig = sig
+
+	return nil
+}
+
+// SignSpliceInput signs the input of the splice transaction at the given
+// index, which spends the current funding output of the channel.
+func (lc *LightningChannel) SignSpliceInput(spliceTx *wire.MsgTx,
+	inputIndex int,
+	prevOuts txscript.PrevOutputFetcher) (input.Signature, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	signDesc := *lc.signDesc
+	signDesc.SigHashes = txscript.NewTxSigHashes(spliceTx, prevOuts)
+	signDesc.PrevOutputFetcher = prevOuts
+	signDesc.InputIndex = inputIndex
+
+	return lc.Signer.SignOutputRaw(spliceTx, &signDesc)
+}
+
+// SpliceInputWitness creates the witness of the input of the splice
+// transaction that spends the current funding output from both signatures,
+// and verifies that the witness is valid.
+func (lc *LightningChannel) SpliceInputWitness(spliceTx *wire.MsgTx,
+	inputIndex int, prevOuts txscript.PrevOutputFetcher,
+	localSig, remoteSig input.Signature) (wire.TxWitness, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	ourKey := lc.channelState.LocalChanCfg.MultiSigKey.PubKey.
+		SerializeCompressed()
+	theirKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey.
+		SerializeCompressed()
+	witness := input.SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey, localSig, theirKey,
+		remoteSig,
+	)
+
+	// Validate the witness on a copy of the transaction, to ensure that
+	// the remote party supplied a valid signature.
+	tx := spliceTx.Copy()
+	tx.TxIn[inputIndex].Witness = witness
+
+	prevOut := lc.fundingOutput
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, tx, inputIndex, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(tx, prevOuts), prevOut.Value,
+		prevOuts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.Execute(); err != nil {
+		return nil, fmt.Errorf("invalid splice input witness: %w", err)
+	}
+
+	return witness, nil
+}
+
+// ChannelSplice returns the state of the splice that is stored with the
+// channel until the splice transaction confirms. Both commitments must have
+// been signed before.
+func (lc *LightningChannel) ChannelSplice(s *Splice, spliceTx *wire.MsgTx,
+	broadcastHeight uint32) (*channeldb.ChannelSplice, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if s.localSig == nil || s.remoteSig == nil {
+		return nil, errors.New("splice commitment isn't signed")
+	}
+
+	chanState := lc.channelState
+
+	localCommit := chanState.LocalCommitment
+	localCommit.LocalBalance = s.LocalBalance
+	localCommit.RemoteBalance = s.RemoteBalance
+	localCommit.CommitFee = s.commitFee
+	localCommit.CommitTx = s.localCommitTx
+	localCommit.CommitSig = s.remoteSig.Serialize()
+
+	remoteCommit := chanState.RemoteCommitment
+	remoteCommit.LocalBalance = s.LocalBalance
+	remoteCommit.RemoteBalance = s.RemoteBalance
+	remoteCommit.CommitFee = s.commitFee
+	remoteCommit.CommitTx = s.remoteCommitTx
+	remoteCommit.CommitSig = s.localSig.Serialize()
+
+	channelSplice := &channeldb.ChannelSplice{
+		FundingOutpoint:  s.FundingOutpoint,
+		Capacity:         s.Capacity,
+		FundingTxn:       spliceTx,
+		BroadcastHeight:  broadcastHeight,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}
+	if s.upgrade {
+		chanType := s.chanType
+		channelSplice.NewChanType = &chanType
+	}
+
+	return channelSplice, nil
+}
+
+// MarkSplicePending persistently stores the splice of the channel. From then
+// on, every new commitment is created for both the current funding output and
+// the funding output of the splice, until the splice transaction confirms. A
+// channel with a pending upgrade splice can't create new commitments until
+// the splice confirms or is aborted instead.
+func (lc *LightningChannel) MarkSplicePending(
+	splice *channeldb.ChannelSplice) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	if err := lc.channelState.MarkSplicePending(splice); err != nil {
+		return err
+	}
+
+	if splice.IsUpgrade() {
+		lc.upgradeSplicePending = true
+		return nil
+	}
+
+	lc.pendingSplice = newPendingSplice(
+		lc.channelState, lc.commitBuilder, splice,
+		lc.fundingOutput.PkScript,
+	)
+
+	return nil
+}
+
+// UpgradeSplicePending returns true if the channel has a pending splice that
+// upgrades it to a new channel type. The channel can't be used until the
+// splice confirms or is aborted.
+func (lc *LightningChannel) UpgradeSplicePending() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.upgradeSplicePending
+}
+
+// pendingSplice holds what's needed to create the commitments that spend the
+// funding output of a splice that hasn't confirmed yet.
+type pendingSplice struct {
+	// fundingOutput is the new funding output of the channel.
+	fundingOutput wire.TxOut
+
+	// localDelta and remoteDelta are the amounts in msat that the splice
+	// adds to our and their balance. They're negative for funds that are
+	// spliced out of the channel.
+	localDelta  int64
+	remoteDelta int64
+
+	// commitBuilder creates the commitments that spend the new funding
+	// output.
+	commitBuilder *CommitmentBuilder
+}
+
+// newPendingSplice creates the in-memory state of a pending splice. As the
+// commitments that spend the new funding output are always updated together
+// with the current ones, the difference of their balances stays the same until
+// the splice transaction confirms.
+func newPendingSplice(chanState *channeldb.OpenChannel,
+	commitBuilder *CommitmentBuilder, splice *channeldb.ChannelSplice,
+	pkScript []byte) *pendingSplice {
+
+	localCommit := chanState.LocalCommitment
+	spliceCommit := splice.LocalCommitment
+
+	return &pendingSplice{
+		fundingOutput: wire.TxOut{
+			PkScript: pkScript,
+			Value:    int64(splice.Capacity),
+		},
+		localDelta: int64(spliceCommit.LocalBalance) -
+			int64(localCommit.LocalBalance),
+		remoteDelta: int64(spliceCommit.RemoteBalance) -
+			int64(localCommit.RemoteBalance),
+		commitBuilder: commitBuilder.withSpliceFunding(
+			splice.FundingOutpoint, splice.Capacity,
+		),
+	}
+}
+
+// fetchSpliceCommitmentView creates the commitment that spends the funding
+// output of the pending splice, at the same height and with the same HTLCs as
+// the passed commitment. The balances are the ones of the passed commitment
+// before the commitment fee is deducted.
+func (lc *LightningChannel) fetchSpliceCommitmentView(c *commitment,
+	ourBalance, theirBalance lnwire.MilliSatoshi, view *htlcView,
+	keyRing *CommitmentKeyRing) (*commitment, error) {
+
+	splice := lc.pendingSplice
+
+	ourSpliceBalance := int64(ourBalance) + splice.localDelta
+	theirSpliceBalance := int64(theirBalance) + splice.remoteDelta
+	if ourSpliceBalance < 0 || theirSpliceBalance < 0 {
+		return nil, fmt.Errorf("%w: negative balance after splice",
+			ErrBelowChanReserve)
+	}
+
+	commitTx, err := splice.commitBuilder.createUnsignedCommitmentTx(
+		lnwire.MilliSatoshi(ourSpliceBalance),
+		lnwire.MilliSatoshi(theirSpliceBalance), c.isOurs, c.feePerKw,
+		c.height, view, keyRing,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := btcutil.Amount(splice.fundingOutput.Value)
+	err = lc.validateCommitFeeRate(commitTx, capacity, c.height)
+	if err != nil {
+		return nil, err
+	}
+
+	spliceCommit := &commitment{
+		ourBalance:        commitTx.ourBalance,
+		theirBalance:      commitTx.theirBalance,
+		txn:               commitTx.txn,
+		fee:               commitTx.fee,
+		ourMessageIndex:   c.ourMessageIndex,
+		ourHtlcIndex:      c.ourHtlcIndex,
+		theirMessageIndex: c.theirMessageIndex,
+		theirHtlcIndex:    c.theirHtlcIndex,
+		height:            c.height,
+		feePerKw:          c.feePerKw,
+		dustLimit:         c.dustLimit,
+		isOurs:            c.isOurs,
+	}
+
+	// The HTLCs are copied once more, as their output indexes on this
+	// commitment differ from the ones of the passed commitment.
+	spliceCommit.outgoingHTLCs = make(
+		[]PaymentDescriptor, len(view.ourUpdates),
+	)
+	for i, htlc := range view.ourUpdates {
+		spliceCommit.outgoingHTLCs[i] = *htlc
+	}
+	spliceCommit.incomingHTLCs = make(
+		[]PaymentDescriptor, len(view.theirUpdates),
+	)
+	for i, htlc := range view.theirUpdates {
+		spliceCommit.incomingHTLCs[i] = *htlc
+	}
+
+	err = spliceCommit.populateHtlcIndexes(
+		lc.channelState.ChanType, commitTx.cltvs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return spliceCommit, nil
+}
+
+// signSpliceCommitView signs the commitment of the remote party that spends
+// the funding output of the pending splice, along with its HTLCs.
+func (lc *LightningChannel) signSpliceCommitView(view *commitment,
+	keyRing *CommitmentKeyRing) (*lnwire.SpliceSig, error) {
+
+	chanState := lc.channelState
+
+	var leaseExpiry uint32
+	if chanState.ChanType.HasLeaseExpiration() {
+		leaseExpiry = chanState.ThawHeight
+	}
+	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+		keyRing, chanState.ChanType, !chanState.IsInitiator,
+		leaseExpiry, &chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+		view,
+	)
+	if err != nil {
+		return nil, err
+	}
+	lc.sigPool.SubmitSignBatch(sigBatch)
+
+	signDesc := *lc.signDesc
+	signDesc.Output = &lc.pendingSplice.fundingOutput
+	signDesc.SigHashes = input.NewTxSigHashesV0Only(view.txn)
+	signDesc.InputIndex = 0
+
+	rawSig, err := lc.Signer.SignOutputRaw(view.txn, &signDesc)
+	if err != nil {
+		close(cancelChan)
+		return nil, err
+	}
+	commitSig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		close(cancelChan)
+		return nil, err
+	}
+
+	// The HTLC signatures are sent in the order of the HTLC outputs on the
+	// commitment, just like the ones of the current commitment.
+	sort.Slice(sigBatch, func(i, j int) bool {
+		return sigBatch[i].OutputIndex < sigBatch[j].OutputIndex
+	})
+
+	var htlcSigs []lnwire.Sig
+	for _, htlcSigJob := range sigBatch {
+		jobResp := <-htlcSigJob.Resp
+		if jobResp.Err != nil {
+			close(cancelChan)
+			return nil, jobResp.Err
+		}
+
+		htlcSigs = append(htlcSigs, jobResp.Sig)
+	}
+
+	return &lnwire.SpliceSig{
+		CommitSig: commitSig,
+		HtlcSigs:  htlcSigs,
+	}, nil
+}
+
+// verifySpliceCommitView verifies the signatures of the remote party for our
+// commitment that spends the funding output of the pending splice, and stores
+// them with the commitment.
+func (lc *LightningChannel) verifySpliceCommitView(view *commitment,
+	keyRing *CommitmentKeyRing, spliceSig *lnwire.SpliceSig) error {
+
+	if spliceSig == nil {
+		return ErrMissingSpliceSig
+	}
+
+	chanState := lc.channelState
+
+	var leaseExpiry uint32
+	if chanState.ChanType.HasLeaseExpiration() {
+		leaseExpiry = chanState.ThawHeight
+	}
+	verifyJobs, err := genHtlcSigValidationJobs(
+		view, keyRing, spliceSig.HtlcSigs, chanState.ChanType,
+		chanState.IsInitiator, leaseExpiry, &chanState.LocalChanCfg,
+		&chanState.RemoteChanCfg,
+	)
+	if err != nil {
+		return err
+	}
+
+	cancelChan := make(chan struct{})
+	verifyResps := lc.sigPool.SubmitVerifyBatch(verifyJobs, cancelChan)
+
+	capacity := lc.pendingSplice.fundingOutput.Value
+	multiSigScript := lc.signDesc.WitnessScript
+	prevFetcher := txscript.NewCannedPrevOutputFetcher(
+		multiSigScript, capacity,
+	)
+	sigHash, err := txscript.CalcWitnessSigHash(
+		multiSigScript, txscript.NewTxSigHashes(view.txn, prevFetcher),
+		txscript.SigHashAll, view.txn, 0, capacity,
+	)
+	if err != nil {
+		close(cancelChan)
+		return err
+	}
+
+	cSig, err := spliceSig.CommitSig.ToSignature()
+	if err != nil {
+		close(cancelChan)
+		return err
+	}
+	verifyKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	if !cSig.Verify(sigHash, verifyKey) {
+		close(cancelChan)
+		return errors.New("invalid splice commitment signature")
+	}
+
+	for i := 0; i < len(verifyJobs); i++ {
+		htlcErr := <-verifyResps
+		if htlcErr != nil {
+			close(cancelChan)
+			return fmt.Errorf("invalid splice htlc signature for "+
+				"htlc index %v", htlcErr.HtlcIndex)
+		}
+	}
+
+	view.sig = spliceSig.CommitSig.ToSignatureBytes()
+
+	return nil
+}
+
+// spliceOutputIndexes returns the indexes of our and their output on the
+// current commitment of the remote party that spends the funding output of the
+// pending splice, which is revoked with the passed revocation preimage.
+func (lc *LightningChannel) spliceOutputIndexes(
+	revocation *chainhash.Hash) (*channeldb.SpliceOutputIndexes, error) {
+
+	splice, err := lc.channelState.PendingSplice()
+	if err != nil {
+		return nil, err
+	}
+
+	ourIndex, theirIndex, err := findOutputIndexesFromRemote(
+		revocation, lc.channelState, &splice.RemoteCommitment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeldb.SpliceOutputIndexes{
+		OurOutputIndex:   ourIndex,
+		TheirOutputIndex: theirIndex,
+	}, nil
+}
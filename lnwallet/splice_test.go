@@ -0,0 +1,452 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplice tests that both sides of a channel create the same commitments
+// for the new funding output of a splice, and that they can sign both the
+// commitments and the input of the splice transaction that spends the
+// current funding output.
+func TestSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	const spliceAmt = btcutil.Amount(100_000)
+
+	// Alice splices funds out of the channel to an external address.
+	fundingOut := aliceChannel.FundingTxOut()
+	capacity := aliceChannel.channelState.Capacity - spliceAmt
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(capacity))
+	spliceTx.AddTxOut(wire.NewTxOut(
+		int64(spliceAmt-1_000),
+		append([]byte{0x00, 0x14}, make([]byte, 20)...),
+	))
+
+	fundingOutpoint := wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+
+	aliceSplice, err := aliceChannel.NewSplice(
+		fundingOutpoint, -spliceAmt, 0,
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSplice(fundingOutpoint, 0, -spliceAmt)
+	require.NoError(t, err)
+
+	require.Equal(t, capacity, aliceSplice.Capacity)
+	require.Equal(t, capacity, bobSplice.Capacity)
+	require.Equal(
+		t, aliceSplice.localCommitTx.TxHash(),
+		bobSplice.remoteCommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceSplice.remoteCommitTx.TxHash(),
+		bobSplice.localCommitTx.TxHash(),
+	)
+
+	// The commitment of the remote party must be signed before the splice
+	// can be stored.
+	_, err = aliceChannel.ChannelSplice(aliceSplice, spliceTx, 100)
+	require.Error(t, err)
+
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+
+	require.Error(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, aliceSig,
+	))
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobSplice, aliceSig,
+	))
+
+	// Both sides sign the shared input, and the combined witness is valid.
+	prevOuts := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript, fundingOut.Value,
+	)
+	aliceInputSig, err := aliceChannel.SignSpliceInput(
+		spliceTx, 0, prevOuts,
+	)
+	require.NoError(t, err)
+	bobInputSig, err := bobChannel.SignSpliceInput(spliceTx, 0, prevOuts)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.SpliceInputWitness(
+		spliceTx, 0, prevOuts, aliceInputSig, aliceInputSig,
+	)
+	require.Error(t, err)
+
+	witness, err := aliceChannel.SpliceInputWitness(
+		spliceTx, 0, prevOuts, aliceInputSig, bobInputSig,
+	)
+	require.NoError(t, err)
+	spliceTx.TxIn[0].Witness = witness
+
+	vm, err := txscript.NewEngine(
+		fundingOut.PkScript, spliceTx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(spliceTx, prevOuts),
+		fundingOut.Value, prevOuts,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// The stored splice carries the new balances and the signature of
+	// the remote party for our commitment, which is valid for the new
+	// funding output.
+	splice, err := aliceChannel.ChannelSplice(aliceSplice, spliceTx, 100)
+	require.NoError(t, err)
+
+	oldLocal := aliceChannel.channelState.LocalCommitment
+	require.Equal(t, fundingOutpoint, splice.FundingOutpoint)
+	require.Equal(
+		t, oldLocal.LocalBalance-lnwire.NewMSatFromSatoshis(spliceAmt),
+		splice.LocalCommitment.LocalBalance,
+	)
+	require.Equal(
+		t, oldLocal.RemoteBalance, splice.LocalCommitment.RemoteBalance,
+	)
+	require.Equal(
+		t, fundingOutpoint,
+		splice.LocalCommitment.CommitTx.TxIn[0].PreviousOutPoint,
+	)
+
+	commitSig, err := input.ParseSignature(splice.LocalCommitment.CommitSig)
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, commitSig,
+	))
+}
+
+// TestSpliceNotClean tests that a channel with HTLCs can't be spliced.
+func TestSpliceNotClean(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(10_000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.NewSplice(wire.OutPoint{}, -10_000, 0)
+	require.ErrorIs(t, err, ErrSpliceNotClean)
+}
+
+// TestSplicePendingCommitments tests that the commitments of a channel with a
+// pending splice are signed for both the current funding output and the
+// funding output of the splice, so the channel can be used until the splice
+// transaction confirms.
+func TestSplicePendingCommitments(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	const spliceAmt = btcutil.Amount(100_000)
+
+	// Alice splices funds out of the channel, and both sides store the
+	// signed splice.
+	capacity := aliceChannel.channelState.Capacity - spliceAmt
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(aliceChannel.SpliceFundingOutput(capacity))
+	fundingOutpoint := wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+
+	aliceSplice, err := aliceChannel.NewSplice(
+		fundingOutpoint, -spliceAmt, 0,
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSplice(fundingOutpoint, 0, -spliceAmt)
+	require.NoError(t, err)
+
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobSplice, aliceSig,
+	))
+
+	availableBefore := aliceChannel.AvailableBalance()
+
+	for _, c := range []struct {
+		channel *LightningChannel
+		splice  *Splice
+	}{
+		{aliceChannel, aliceSplice},
+		{bobChannel, bobSplice},
+	} {
+		chanSplice, err := c.channel.ChannelSplice(c.splice, spliceTx, 100)
+		require.NoError(t, err)
+		require.NoError(t, c.channel.MarkSplicePending(chanSplice))
+	}
+
+	// The funds that are spliced out can't be used for new HTLCs anymore.
+	require.Equal(
+		t, availableBefore-lnwire.NewMSatFromSatoshis(spliceAmt),
+		aliceChannel.AvailableBalance(),
+	)
+
+	// Alice sends an HTLC to Bob, which must be added to the commitments
+	// of both funding outputs.
+	htlcAmt := lnwire.NewMSatFromSatoshis(50_000)
+	htlc, _ := createHTLC(0, htlcAmt)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		chanState := channel.channelState
+		splice, err := chanState.PendingSplice()
+		require.NoError(t, err)
+
+		localCommit := chanState.LocalCommitment
+		spliceCommit := splice.LocalCommitment
+		require.Equal(
+			t, localCommit.CommitHeight, spliceCommit.CommitHeight,
+		)
+		require.Len(t, spliceCommit.Htlcs, 1)
+		require.Equal(
+			t, fundingOutpoint,
+			spliceCommit.CommitTx.TxIn[0].PreviousOutPoint,
+		)
+		require.Equal(
+			t, chanState.RemoteCommitment.CommitHeight,
+			splice.RemoteCommitment.CommitHeight,
+		)
+
+		// The signature of the remote party for our splice commitment
+		// must be valid for the new funding output.
+		multiSigScript := channel.signDesc.WitnessScript
+		prevOuts := txscript.NewCannedPrevOutputFetcher(
+			multiSigScript, int64(capacity),
+		)
+		sigHash, err := txscript.CalcWitnessSigHash(
+			multiSigScript, txscript.NewTxSigHashes(
+				spliceCommit.CommitTx, prevOuts,
+			), txscript.SigHashAll, spliceCommit.CommitTx, 0,
+			int64(capacity),
+		)
+		require.NoError(t, err)
+
+		sig, err := input.ParseSignature(spliceCommit.CommitSig)
+		require.NoError(t, err)
+		remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+		require.True(t, sig.Verify(sigHash, remoteKey))
+	}
+
+	aliceSpliceCommit, err := aliceChannel.channelState.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(
+		t, aliceChannel.channelState.LocalCommitment.LocalBalance-
+			lnwire.NewMSatFromSatoshis(spliceAmt),
+		aliceSpliceCommit.LocalCommitment.LocalBalance,
+	)
+
+	// The splice is restored when the channel is loaded again.
+	restored, err := NewLightningChannel(
+		aliceChannel.Signer, aliceChannel.channelState,
+		aliceChannel.sigPool,
+	)
+	require.NoError(t, err)
+	require.Equal(t, aliceChannel.pendingSplice, restored.pendingSplice)
+
+	// A new commitment that isn't signed for the funding output of the
+	// splice is rejected.
+	htlc, _ = createHTLC(1, htlcAmt)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	aliceNewCommit, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	require.NotNil(t, aliceNewCommit.SpliceSig)
+
+	commitSigs := *aliceNewCommit.CommitSigs
+	commitSigs.SpliceSig = nil
+	err = bobChannel.ReceiveNewCommitment(&commitSigs)
+	require.ErrorIs(t, err, ErrMissingSpliceSig)
+}
+
+// TestUpgradeSplice tests that a splice upgrades a channel to taproot. Both
+// sides create the same taproot commitments for the new MuSig2 funding
+// output, the channel is paused until the splice confirms, and afterwards our
+// commitment can be broadcast with a valid witness.
+func TestUpgradeSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.UpgradeSpliceChanType(
+		CommitmentTypeAnchorsZeroFeeHtlcTx,
+	)
+	require.Error(t, err)
+
+	chanType, err := aliceChannel.UpgradeSpliceChanType(
+		CommitmentTypeSimpleTaproot,
+	)
+	require.NoError(t, err)
+	require.True(t, chanType.IsTaproot())
+	require.True(t, chanType.HasAnchors())
+
+	// Bob derives the same channel type from the wire type that Alice
+	// sends along with her splice_init.
+	bobChanType, err := bobChannel.UpgradeSpliceChanTypeFromWire(
+		UpgradeChanTypeToWire(chanType),
+	)
+	require.NoError(t, err)
+	require.Equal(t, chanType, bobChanType)
+
+	// Alice pays the fee of the splice transaction from her balance.
+	const spliceFee = btcutil.Amount(1_000)
+	capacity := aliceChannel.channelState.Capacity - spliceFee
+	fundingOut, err := aliceChannel.UpgradeSpliceFundingOutput(capacity)
+	require.NoError(t, err)
+	require.True(t, txscript.IsPayToTaproot(fundingOut.PkScript))
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(aliceChannel.ChannelPoint(), nil, nil))
+	spliceTx.AddTxOut(fundingOut)
+	fundingOutpoint := wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+
+	aliceNonce, err := aliceChannel.SpliceVerificationNonce()
+	require.NoError(t, err)
+	bobNonce, err := bobChannel.SpliceVerificationNonce()
+	require.NoError(t, err)
+
+	aliceSplice, err := aliceChannel.NewUpgradeSplice(
+		fundingOutpoint, -spliceFee, 0, &SpliceUpgrade{
+			ChanType:    chanType,
+			RemoteNonce: bobNonce,
+		},
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewUpgradeSplice(
+		fundingOutpoint, 0, -spliceFee, &SpliceUpgrade{
+			ChanType:    chanType,
+			RemoteNonce: aliceNonce,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, aliceSplice.localCommitTx.TxHash(),
+		bobSplice.remoteCommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceSplice.remoteCommitTx.TxHash(),
+		bobSplice.localCommitTx.TxHash(),
+	)
+
+	// Alice is the initiator of the channel, so she also pays for the
+	// anchors and the larger commitment fee.
+	oldLocal := aliceChannel.channelState.LocalCommitment
+	feePerKw := chainfee.SatPerKWeight(oldLocal.FeePerKw)
+	newFee := feePerKw.FeeForWeight(CommitWeight(chanType))
+	upgradeCost := newFee + 2*anchorSize - oldLocal.CommitFee
+	require.Equal(
+		t, oldLocal.LocalBalance-lnwire.NewMSatFromSatoshis(
+			spliceFee+upgradeCost,
+		), aliceSplice.LocalBalance,
+	)
+	require.Equal(t, oldLocal.RemoteBalance, aliceSplice.RemoteBalance)
+
+	// Both sides exchange musig2 partial signatures for the commitments.
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+	require.IsType(t, &MusigPartialSig{}, bobSig)
+
+	require.Error(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, aliceSig,
+	))
+	require.NoError(t, aliceChannel.VerifySpliceCommitment(
+		aliceSplice, bobSig,
+	))
+	require.NoError(t, bobChannel.VerifySpliceCommitment(
+		bobSplice, aliceSig,
+	))
+
+	splice, err := aliceChannel.ChannelSplice(aliceSplice, spliceTx, 100)
+	require.NoError(t, err)
+	require.True(t, splice.IsUpgrade())
+	require.Equal(t, chanType, *splice.NewChanType)
+	require.Equal(t, newFee, splice.LocalCommitment.CommitFee)
+
+	// Once the splice is stored, the channel is paused until the splice
+	// confirms, also after it's loaded again.
+	require.NoError(t, aliceChannel.MarkSplicePending(splice))
+	require.True(t, aliceChannel.UpgradeSplicePending())
+	require.Nil(t, aliceChannel.pendingSplice)
+
+	_, err = aliceChannel.SignNextCommitment()
+	require.ErrorIs(t, err, ErrUpgradeSplicePending)
+
+	restored, err := NewLightningChannel(
+		aliceChannel.Signer, aliceChannel.channelState,
+		aliceChannel.sigPool,
+	)
+	require.NoError(t, err)
+	require.True(t, restored.UpgradeSplicePending())
+
+	// Once the splice is completed, the channel is a taproot channel whose
+	// commitment can be broadcast with a valid key spend of the new
+	// funding output.
+	newChan, err := aliceChannel.channelState.CompleteSplice()
+	require.NoError(t, err)
+	require.Equal(t, chanType, newChan.ChanType)
+
+	upgraded, err := NewLightningChannel(
+		aliceChannel.Signer, newChan, aliceChannel.sigPool,
+	)
+	require.NoError(t, err)
+	require.False(t, upgraded.UpgradeSplicePending())
+
+	commitTx, err := upgraded.getSignedCommitTx()
+	require.NoError(t, err)
+
+	prevOuts := txscript.NewCannedPrevOutputFetcher(
+		fundingOut.PkScript, fundingOut.Value,
+	)
+	vm, err := txscript.NewEngine(
+		fundingOut.PkScript, commitTx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(commitTx, prevOuts),
+		fundingOut.Value, prevOuts,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+}
@@ -199,6 +199,17 @@ type InitFundingReserveMsg struct {
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// DualFund is true if the channel is opened with the dual funding
+	// protocol, which constructs the funding transaction interactively
+	// with the remote party. In contrast to the legacy dual funder flow,
+	// the initiator pays the commitment fees, and RemoteFundingAmt may be
+	// zero for the initiator until the remote contribution is known.
+	DualFund bool
+
+	// Initiator is true if we initiated the channel opening. It's only
+	// used for dual funded channels, as both sides may contribute funds.
+	Initiator bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -265,6 +276,40 @@ type addSingleContributionMsg struct {
 	err chan error
 }
 
+// addDualContributionMsg carries the remote party's contribution to a channel
+// that is opened with the dual funding protocol. Once it's processed, the
+// funding output of the channel is known, so the funding transaction can be
+// constructed interactively.
+type addDualContributionMsg struct {
+	pendingFundingID uint64
+
+	contribution *ChannelContribution
+
+	// fundingAmt is the amount that the remote party puts into the
+	// channel.
+	fundingAmt btcutil.Amount
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// addDualFundingTxMsg carries the funding transaction of a dual funded channel
+// once both parties have finished constructing it. When it's processed, we
+// sign our inputs to the funding transaction and the remote party's version of
+// the commitment transaction.
+type addDualFundingTxMsg struct {
+	pendingFundingID uint64
+
+	fundingTx *wire.MsgTx
+
+	// prevOutFetcher returns the outputs spent by all inputs of the
+	// funding transaction.
+	prevOutFetcher txscript.PrevOutputFetcher
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addCounterPartySigsMsg represents the final message required to complete,
 // and 'open' a payment channel. This message carries the counterparty's
 // signatures for each of their inputs to the funding transaction, and also a
@@ -627,6 +672,10 @@ out:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
 				l.handleFundingCounterPartySigs(msg)
+			case *addDualContributionMsg:
+				l.handleDualContribution(msg)
+			case *addDualFundingTxMsg:
+				l.handleDualFundingTx(msg)
 			}
 		case <-l.quit:
 			// TODO: do some clean up
@@ -1964,6 +2013,144 @@ func (l *LightningWallet) handleSingleContribution(req *addSingleContributionMsg
 	req.err <- nil
 }
 
+// handleDualContribution is called once the remote party's contribution to a
+// dual funded channel is known. If we're the initiator, the remote funding
+// amount is added to the channel, and both multi-sig keys are bound to the
+// funding intent so the funding output can be derived.
+func (l *LightningWallet) handleDualContribution(req *addDualContributionMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent " +
+			"funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety.
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	// Validate that the remote's UpfrontShutdownScript is a valid script
+	// if it's set.
+	shutdown := req.contribution.UpfrontShutdown
+	if len(shutdown) > 0 {
+		if !ValidateUpfrontShutdown(shutdown, &l.Cfg.NetParams) {
+			req.err <- fmt.Errorf("invalid shutdown script")
+			return
+		}
+	}
+
+	intent := pendingReservation.fundingIntent
+	fundingIntent, ok := intent.(*chanfunding.FullIntent)
+	if !ok {
+		req.err <- fmt.Errorf("dual funding requires a wallet "+
+			"funding intent, got %T", intent)
+		return
+	}
+
+	chanState := pendingReservation.partialState
+
+	// As the initiator, we only learn the amount that the remote party
+	// contributes now, so we'll add it to the channel.
+	if chanState.IsInitiator {
+		theirBalance := lnwire.NewMSatFromSatoshis(req.fundingAmt)
+
+		chanState.Capacity += req.fundingAmt
+		chanState.LocalCommitment.RemoteBalance = theirBalance
+		chanState.RemoteCommitment.RemoteBalance = theirBalance
+		chanState.InitialRemoteBalance = theirBalance
+
+		fundingIntent.SetRemoteFundingAmt(req.fundingAmt)
+	}
+
+	pendingReservation.theirContribution = req.contribution
+	theirContribution := req.contribution
+	theirContribution.FundingAmount =
+		chanState.LocalCommitment.RemoteBalance.ToSatoshis()
+
+	fundingIntent.BindKeys(
+		&pendingReservation.ourContribution.MultiSigKey,
+		theirContribution.MultiSigKey.PubKey,
+	)
+
+	// Initialize an empty sha-chain for them, tracking the current pending
+	// revocation hash (we don't yet know the preimage so we can't add it
+	// to the chain).
+	chanState.RevocationStore = shachain.NewRevocationStore()
+	chanState.RemoteCurrentRevocation = theirContribution.FirstCommitmentPoint
+
+	req.err <- nil
+}
+
+// handleDualFundingTx is called once the funding transaction of a dual funded
+// channel has been constructed. We'll sign our inputs, and continue with the
+// creation of both commitment transactions just like the single funder flow.
+func (l *LightningWallet) handleDualFundingTx(req *addDualFundingTxMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent " +
+			"funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety.
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	intent := pendingReservation.fundingIntent
+	fundingIntent, ok := intent.(*chanfunding.FullIntent)
+	if !ok {
+		req.err <- fmt.Errorf("dual funding requires a wallet "+
+			"funding intent, got %T", intent)
+		return
+	}
+
+	fundingTx, err := fundingIntent.CompileInteractiveFundingTx(
+		req.fundingTx, req.prevOutFetcher,
+	)
+	if err != nil {
+		req.err <- fmt.Errorf("unable to sign funding tx: %w", err)
+		return
+	}
+	chanPoint, err := fundingIntent.ChanPoint()
+	if err != nil {
+		req.err <- fmt.Errorf("unable to obtain chan point: %w", err)
+		return
+	}
+
+	pendingReservation.fundingTx = fundingTx
+	pendingReservation.fundingPrevOutFetcher = req.prevOutFetcher
+	pendingReservation.partialState.FundingOutpoint = *chanPoint
+
+	// Our inputs are the ones that have been signed, which are ordered as
+	// within the funding transaction.
+	pendingReservation.ourFundingInputScripts = nil
+	for _, txIn := range fundingTx.TxIn {
+		if len(txIn.Witness) == 0 {
+			continue
+		}
+
+		pendingReservation.ourFundingInputScripts = append(
+			pendingReservation.ourFundingInputScripts,
+			&input.Script{
+				Witness:   txIn.Witness,
+				SigScript: txIn.SignatureScript,
+			},
+		)
+	}
+
+	walletLog.Tracef("Dual funding tx for ChannelPoint(%v) "+
+		"generated: %v", chanPoint, spew.Sdump(fundingTx))
+
+	l.handleChanPointReady(&continueContributionMsg{
+		pendingFundingID: req.pendingFundingID,
+		err:              req.err,
+	})
+}
+
 // verifyFundingInputs attempts to verify all remote inputs to the funding
 // transaction.
 func (l *LightningWallet) verifyFundingInputs(fundingTx *wire.MsgTx,
@@ -2027,6 +2214,59 @@ func (l *LightningWallet) verifyFundingInputs(fundingTx *wire.MsgTx,
 	return nil
 }
 
+// AddFundingWitnesses attaches the remote party's witnesses to the unsigned
+// inputs of the funding transaction of a dual funded channel, in order, and
+// verifies them. The fetcher must return the outputs spent by all inputs of
+// the transaction.
+func AddFundingWitnesses(fundingTx *wire.MsgTx, witnesses []wire.TxWitness,
+	prevOutFetcher txscript.PrevOutputFetcher) error {
+
+	var remoteInputs []int
+	for i, txin := range fundingTx.TxIn {
+		if len(txin.Witness) == 0 {
+			remoteInputs = append(remoteInputs, i)
+		}
+	}
+
+	if len(remoteInputs) != len(witnesses) {
+		return fmt.Errorf("expected %d witnesses, got %d",
+			len(remoteInputs), len(witnesses))
+	}
+
+	// Attach the witnesses first, as the signature hashes of taproot
+	// inputs commit to the outputs spent by all inputs.
+	for i, idx := range remoteInputs {
+		fundingTx.TxIn[idx].Witness = witnesses[i]
+	}
+
+	hashCache := txscript.NewTxSigHashes(fundingTx, prevOutFetcher)
+	for _, i := range remoteInputs {
+		txin := fundingTx.TxIn[i]
+		output := prevOutFetcher.FetchPrevOutput(txin.PreviousOutPoint)
+		if output == nil {
+			return fmt.Errorf("unknown input to funding tx: %v",
+				txin.PreviousOutPoint)
+		}
+
+		// Ensure that the witness is valid.
+		vm, err := txscript.NewEngine(
+			output.PkScript, fundingTx, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			output.Value, prevOutFetcher,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot create script engine: %w",
+				err)
+		}
+		if err = vm.Execute(); err != nil {
+			return fmt.Errorf("cannot validate transaction: %w",
+				err)
+		}
+	}
+
+	return nil
+}
+
 // verifyCommitSig verifies an incoming signature for our version of the
 // commitment transaction. For normal channels, this will verify that the ECDSA
 // signature is valid. For taproot channels, we'll verify that their partial
@@ -2137,9 +2377,13 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 
 	// Only if we have the final funding transaction do we need to verify
 	// the final set of inputs. Otherwise, it may be the case that the
-	// channel was funded via an external wallet.
+	// channel was funded via an external wallet. The remote inputs of a
+	// dual funded channel are only signed once the commitment signatures
+	// have been exchanged, so they're verified with AddFundingWitnesses
+	// instead.
 	fundingTx := res.fundingTx
-	if res.partialState.ChanType.HasFundingTx() {
+	dualFunded := res.fundingPrevOutFetcher != nil
+	if res.partialState.ChanType.HasFundingTx() && !dualFunded {
 		err := l.verifyFundingInputs(fundingTx, inputScripts)
 		if err != nil {
 			msg.err <- err
@@ -0,0 +1,78 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// BlindingPointRecordType is the type of the TLV record used to
+	// transmit the ephemeral blinding point of a blinded route in
+	// update_add_htlc.
+	BlindingPointRecordType tlv.Type = 0
+)
+
+// BlindingPoint is the ephemeral public key that a node inside a blinded route
+// uses to derive the shared secret needed to decrypt its route data. It is
+// passed along by the previous hop in the update_add_htlc message.
+type BlindingPoint btcec.PublicKey
+
+// Record returns a TLV record that can be used to encode/decode the blinding
+// point from a given TLV stream.
+func (b *BlindingPoint) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		BlindingPointRecordType, b, btcec.PubKeyBytesLenCompressed,
+		blindingPointEncoder, blindingPointDecoder,
+	)
+}
+
+// blindingPointEncoder is a custom TLV encoder for the BlindingPoint record.
+func blindingPointEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*BlindingPoint); ok {
+		key := (*btcec.PublicKey)(v)
+		return tlv.EPubKey(w, &key, buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.BlindingPoint")
+}
+
+// blindingPointDecoder is a custom TLV decoder for the BlindingPoint record.
+func blindingPointDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*BlindingPoint); ok {
+		var key *btcec.PublicKey
+		if err := tlv.DPubKey(r, &key, buf, l); err != nil {
+			return err
+		}
+		*v = BlindingPoint(*key)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(
+		val, "lnwire.BlindingPoint", l, btcec.PubKeyBytesLenCompressed,
+	)
+}
+
+// ExtractBlindingPoint parses the blinding point from the passed TLV extra
+// data. A nil blinding point is returned if the record isn't present.
+func ExtractBlindingPoint(extraData ExtraOpaqueData) (*BlindingPoint, error) {
+	if len(extraData) == 0 {
+		return nil, nil
+	}
+
+	var blindingPoint BlindingPoint
+	typeMap, err := extraData.ExtractRecords(&blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if val, ok := typeMap[BlindingPointRecordType]; !ok || val != nil {
+		return nil, nil
+	}
+
+	return &blindingPoint, nil
+}
@@ -1,10 +1,13 @@
 package lnwire
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"math"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
@@ -52,6 +55,32 @@ func NewChanIDFromOutPoint(op *wire.OutPoint) ChannelID {
 	return cid
 }
 
+// NewChanIDFromRevocationBasepoints derives the ID of a channel that is opened
+// with the dual funding protocol. As the funding transaction isn't known when
+// the channel is negotiated, the ID is computed as the SHA256 of the two
+// revocation basepoints of the channel, the lesser of the two serialized keys
+// coming first.
+func NewChanIDFromRevocationBasepoints(a, b *btcec.PublicKey) ChannelID {
+	keyA := a.SerializeCompressed()
+	keyB := b.SerializeCompressed()
+	if bytes.Compare(keyA, keyB) > 0 {
+		keyA, keyB = keyB, keyA
+	}
+
+	return sha256.Sum256(append(keyA, keyB...))
+}
+
+// NewTempChanIDFromRevocationBasepoint derives the temporary channel ID that
+// the opener of a dual funded channel uses in its OpenChannel2 message, before
+// the revocation basepoint of the other side is known. It's the SHA256 of 33
+// zero bytes followed by the revocation basepoint of the opener.
+func NewTempChanIDFromRevocationBasepoint(opener *btcec.PublicKey) ChannelID {
+	var zeroKey [btcec.PubKeyBytesLenCompressed]byte
+	return sha256.Sum256(
+		append(zeroKey[:], opener.SerializeCompressed()...),
+	)
+}
+
 // xorTxid performs the transformation needed to transform an OutPoint into a
 // ChannelID. To do this, we expect the cid parameter to contain the txid
 // unaltered and the outputIndex to be the output index
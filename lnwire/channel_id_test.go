@@ -1,6 +1,10 @@
 package lnwire
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 // TestChannelIDOutPointConversion ensures that the IsChanPoint always
 // recognizes its seed OutPoint for all possible values of an output index.
@@ -70,3 +74,26 @@ func TestGenPossibleOutPoints(t *testing.T) {
 		t.Fatalf("possible outpoints did not contain the root outpoint")
 	}
 }
+
+// TestChanIDFromRevocationBasepoints asserts that both sides of a dual funded
+// channel derive the same channel ID regardless of the order of the keys, and
+// that it differs from the temporary channel ID of the opener.
+func TestChanIDFromRevocationBasepoints(t *testing.T) {
+	t.Parallel()
+
+	opener, err := randPubKey()
+	require.NoError(t, err)
+	acceptor, err := randPubKey()
+	require.NoError(t, err)
+
+	chanID := NewChanIDFromRevocationBasepoints(opener, acceptor)
+	require.Equal(
+		t, chanID, NewChanIDFromRevocationBasepoints(acceptor, opener),
+	)
+
+	tempID := NewTempChanIDFromRevocationBasepoint(opener)
+	require.NotEqual(t, chanID, tempID)
+	require.NotEqual(
+		t, tempID, NewTempChanIDFromRevocationBasepoint(acceptor),
+	)
+}
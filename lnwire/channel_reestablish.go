@@ -70,6 +70,11 @@ type ChannelReestablish struct {
 	// TODO(roasbeef): rename to verification nonce
 	LocalNonce *Musig2Nonce
 
+	// DynHeight is an optional field that stores the number of dynamic
+	// commitment upgrades that the sender has applied to the channel. It's
+	// only populated if the channel has been upgraded.
+	DynHeight *DynHeight
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -117,10 +122,13 @@ func (a *ChannelReestablish) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
-	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	recordProducers := make([]tlv.RecordProducer, 0, 2)
 	if a.LocalNonce != nil {
 		recordProducers = append(recordProducers, a.LocalNonce)
 	}
+	if a.DynHeight != nil {
+		recordProducers = append(recordProducers, a.DynHeight)
+	}
 	err := EncodeMessageExtraData(&a.ExtraData, recordProducers...)
 	if err != nil {
 		return err
@@ -179,9 +187,12 @@ func (a *ChannelReestablish) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	var localNonce Musig2Nonce
+	var (
+		localNonce Musig2Nonce
+		dynHeight  DynHeight
+	)
 	typeMap, err := tlvRecords.ExtractRecords(
-		&localNonce,
+		&localNonce, &dynHeight,
 	)
 	if err != nil {
 		return err
@@ -190,6 +201,9 @@ func (a *ChannelReestablish) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[NonceRecordType]; ok && val == nil {
 		a.LocalNonce = &localNonce
 	}
+	if val, ok := typeMap[DynHeightRecordType]; ok && val == nil {
+		a.DynHeight = &dynHeight
+	}
 
 	if len(tlvRecords) != 0 {
 		a.ExtraData = tlvRecords
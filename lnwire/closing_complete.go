@@ -0,0 +1,101 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// ClosingComplete is sent by either party to a channel with
+// option_simple_close to propose a closing transaction once both parties have
+// sent shutdown. The sender, the closer, pays the fee of the transaction from
+// its own output, and signs it. The closee replies with ClosingSig. A closing
+// transaction can be replaced by sending a new ClosingComplete that pays a
+// higher fee.
+type ClosingComplete struct {
+	// ChannelID serves to identify which channel is to be closed.
+	ChannelID ChannelID
+
+	// FeeSatoshis is the total fee in satoshis of the closing transaction,
+	// which is paid by the closer.
+	FeeSatoshis btcutil.Amount
+
+	// LockTime is the lock time of the closing transaction.
+	LockTime uint32
+
+	// ClosingSigs holds the signature of the closer for the closing
+	// transaction.
+	ClosingSigs ClosingSigs
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure ClosingComplete implements the
+// lnwire.Message interface.
+var _ Message = (*ClosingComplete)(nil)
+
+// Decode deserializes a serialized ClosingComplete message stored in the
+// passed io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r, &c.ChannelID, &c.FeeSatoshis, &c.LockTime)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	c.ClosingSigs, err = decodeClosingSigs(tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	if len(tlvRecords) != 0 {
+		c.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// Encode serializes the target ClosingComplete into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) Encode(w *bytes.Buffer, pver uint32) error {
+	err := EncodeMessageExtraData(
+		&c.ExtraData, c.ClosingSigs.recordProducers()...,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteChannelID(w, c.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, c.FeeSatoshis); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, c.LockTime); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, c.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) MsgType() MessageType {
+	return MsgClosingComplete
+}
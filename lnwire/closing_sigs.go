@@ -0,0 +1,166 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// CloserNoCloseeRecordType is the type of the signature of a closing
+	// transaction that only has an output of the closer.
+	CloserNoCloseeRecordType tlv.Type = 1
+
+	// NoCloserCloseeRecordType is the type of the signature of a closing
+	// transaction that only has an output of the closee.
+	NoCloserCloseeRecordType tlv.Type = 2
+
+	// CloserAndCloseeRecordType is the type of the signature of a closing
+	// transaction that has an output of both the closer and the closee.
+	CloserAndCloseeRecordType tlv.Type = 3
+)
+
+// ClosingSigs holds the signature of a closing transaction that's negotiated
+// with option_simple_close. The closing transaction omits the output of a
+// party if it's dust, so the signature is sent in the record that matches the
+// outputs of the transaction. Only one of the signatures is set.
+type ClosingSigs struct {
+	// CloserNoClosee is the signature of a closing transaction that only
+	// has an output of the closer.
+	CloserNoClosee *Sig
+
+	// NoCloserClosee is the signature of a closing transaction that only
+	// has an output of the closee.
+	NoCloserClosee *Sig
+
+	// CloserAndClosee is the signature of a closing transaction that has
+	// an output of both the closer and the closee.
+	CloserAndClosee *Sig
+}
+
+// NewClosingSigs returns the ClosingSigs that carry the signature of a closing
+// transaction with the given outputs.
+func NewClosingSigs(sig Sig, closerOutput, closeeOutput bool) ClosingSigs {
+	var sigs ClosingSigs
+	switch {
+	case closerOutput && closeeOutput:
+		sigs.CloserAndClosee = &sig
+
+	case closerOutput:
+		sigs.CloserNoClosee = &sig
+
+	default:
+		sigs.NoCloserClosee = &sig
+	}
+
+	return sigs
+}
+
+// Sig returns the signature of a closing transaction with the given outputs,
+// or nil if it isn't set.
+func (c *ClosingSigs) Sig(closerOutput, closeeOutput bool) *Sig {
+	switch {
+	case closerOutput && closeeOutput:
+		return c.CloserAndClosee
+
+	case closerOutput:
+		return c.CloserNoClosee
+
+	default:
+		return c.NoCloserClosee
+	}
+}
+
+// recordProducers returns the record producers of the signatures that are
+// set.
+func (c *ClosingSigs) recordProducers() []tlv.RecordProducer {
+	producers := make([]tlv.RecordProducer, 0, 1)
+	if c.CloserNoClosee != nil {
+		producers = append(producers, &closingSigRecord{
+			typ: CloserNoCloseeRecordType, sig: c.CloserNoClosee,
+		})
+	}
+	if c.NoCloserClosee != nil {
+		producers = append(producers, &closingSigRecord{
+			typ: NoCloserCloseeRecordType, sig: c.NoCloserClosee,
+		})
+	}
+	if c.CloserAndClosee != nil {
+		producers = append(producers, &closingSigRecord{
+			typ: CloserAndCloseeRecordType, sig: c.CloserAndClosee,
+		})
+	}
+
+	return producers
+}
+
+// decodeClosingSigs extracts the closing signatures from the TLV records of a
+// message.
+func decodeClosingSigs(tlvRecords ExtraOpaqueData) (ClosingSigs, error) {
+	var closerNoClosee, noCloserClosee, closerAndClosee Sig
+	typeMap, err := tlvRecords.ExtractRecords(
+		&closingSigRecord{
+			typ: CloserNoCloseeRecordType, sig: &closerNoClosee,
+		},
+		&closingSigRecord{
+			typ: NoCloserCloseeRecordType, sig: &noCloserClosee,
+		},
+		&closingSigRecord{
+			typ: CloserAndCloseeRecordType, sig: &closerAndClosee,
+		},
+	)
+	if err != nil {
+		return ClosingSigs{}, err
+	}
+
+	// Set the corresponding TLV types if they were included in the stream.
+	var sigs ClosingSigs
+	if val, ok := typeMap[CloserNoCloseeRecordType]; ok && val == nil {
+		sigs.CloserNoClosee = &closerNoClosee
+	}
+	if val, ok := typeMap[NoCloserCloseeRecordType]; ok && val == nil {
+		sigs.NoCloserClosee = &noCloserClosee
+	}
+	if val, ok := typeMap[CloserAndCloseeRecordType]; ok && val == nil {
+		sigs.CloserAndClosee = &closerAndClosee
+	}
+
+	return sigs, nil
+}
+
+// closingSigRecord produces the TLV record of one of the signatures of
+// ClosingSigs.
+type closingSigRecord struct {
+	typ tlv.Type
+	sig *Sig
+}
+
+// Record returns a TLV record that can be used to encode/decode the signature
+// from a given TLV stream.
+func (c *closingSigRecord) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		c.typ, c.sig, 64, closingSigEncoder, closingSigDecoder,
+	)
+}
+
+// closingSigEncoder is a custom TLV encoder for the closing signatures.
+func closingSigEncoder(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*Sig); ok {
+		_, err := w.Write(v.bytes[:])
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.Sig")
+}
+
+// closingSigDecoder is a custom TLV decoder for the closing signatures.
+func closingSigDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*Sig); ok && l == 64 {
+		_, err := io.ReadFull(r, v.bytes[:])
+		return err
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.Sig", l, 64)
+}
@@ -45,6 +45,13 @@ type CommitSig struct {
 	// being signed for. In this case, the above Sig type MUST be blank.
 	PartialSig *PartialSigWithNonce
 
+	// SpliceSig holds the signatures of the commitment that spends the
+	// funding output of a pending splice.
+	//
+	// NOTE: This field is only populated while the channel is being
+	// spliced.
+	SpliceSig *SpliceSig
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -83,8 +90,9 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 
 	var (
 		partialSig PartialSigWithNonce
+		spliceSig  SpliceSig
 	)
-	typeMap, err := tlvRecords.ExtractRecords(&partialSig)
+	typeMap, err := tlvRecords.ExtractRecords(&partialSig, &spliceSig)
 	if err != nil {
 		return err
 	}
@@ -93,6 +101,9 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 	if val, ok := typeMap[PartialSigWithNonceRecordType]; ok && val == nil {
 		c.PartialSig = &partialSig
 	}
+	if val, ok := typeMap[SpliceSigRecordType]; ok && val == nil {
+		c.SpliceSig = &spliceSig
+	}
 
 	if len(tlvRecords) != 0 {
 		c.ExtraData = tlvRecords
@@ -106,10 +117,13 @@ func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *CommitSig) Encode(w *bytes.Buffer, pver uint32) error {
-	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	recordProducers := make([]tlv.RecordProducer, 0, 2)
 	if c.PartialSig != nil {
 		recordProducers = append(recordProducers, c.PartialSig)
 	}
+	if c.SpliceSig != nil {
+		recordProducers = append(recordProducers, c.SpliceSig)
+	}
 	err := EncodeMessageExtraData(&c.ExtraData, recordProducers...)
 	if err != nil {
 		return err
@@ -0,0 +1,62 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// DynAck is sent in reply to DynPropose to accept the proposed dynamic
+// commitment upgrade. The new parameters of the channel are in effect for
+// both parties once it has been sent.
+type DynAck struct {
+	// ChanID is the channel that is upgraded.
+	ChanID ChannelID
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure DynAck implements the lnwire.Message
+// interface.
+var _ Message = (*DynAck)(nil)
+
+// Encode serializes the target DynAck into the passed io.Writer observing the
+// protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, d.ChanID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, d.ExtraData)
+}
+
+// Decode deserializes a serialized DynAck message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&d.ChanID,
+		&d.ExtraData,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynAck) MsgType() MessageType {
+	return MsgDynAck
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (d *DynAck) TargetChanID() ChannelID {
+	return d.ChanID
+}
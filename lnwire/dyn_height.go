@@ -0,0 +1,55 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// DynHeightRecordType is the type of the experimental record used to
+	// communicate the number of dynamic commitment upgrades of a channel
+	// when the channel is reestablished.
+	//
+	// TODO: Decide on actual TLV type.
+	DynHeightRecordType tlv.Type = 7
+)
+
+// DynHeight is the number of dynamic commitment upgrades that have been
+// applied to a channel. It's sent in channel_reestablish so that an upgrade
+// whose acknowledgement got lost can be completed.
+type DynHeight uint64
+
+// Record returns a TLV record that can be used to encode/decode the DynHeight
+// type from a given TLV stream.
+func (d *DynHeight) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		DynHeightRecordType, d, 8, dynHeightEncoder, dynHeightDecoder,
+	)
+}
+
+// dynHeightEncoder is a custom TLV encoder for the DynHeight record.
+func dynHeightEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*DynHeight); ok {
+		return tlv.EUint64T(w, uint64(*v), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.DynHeight")
+}
+
+// dynHeightDecoder is a custom TLV decoder for the DynHeight record.
+func dynHeightDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*DynHeight); ok {
+		var height uint64
+		if err := tlv.DUint64(r, &height, buf, l); err != nil {
+			return err
+		}
+		*v = DynHeight(height)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.DynHeight", l, 8)
+}
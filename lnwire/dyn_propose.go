@@ -0,0 +1,129 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// DynPropose is sent by the initiator of a quiescent channel to propose a
+// dynamic commitment upgrade, which changes the parameters of the channel
+// without closing it. The constraints in the message apply to the receiver,
+// with the exception of the dust limit, which is the dust limit of the
+// sender. The new parameters are used by both commitments from their next
+// height onward once the receiver has replied with DynAck.
+type DynPropose struct {
+	// ChanID is the channel that is upgraded.
+	ChanID ChannelID
+
+	// DustLimit is the new dust limit of the sender.
+	DustLimit btcutil.Amount
+
+	// MaxValueInFlight is the maximum value of the htlcs that the receiver
+	// may offer at once.
+	MaxValueInFlight MilliSatoshi
+
+	// ChannelReserve is the amount that the receiver must keep in its
+	// balance.
+	ChannelReserve btcutil.Amount
+
+	// CsvDelay is the relative time lock that the outputs of the receiver
+	// on its commitment are encumbered with.
+	CsvDelay uint16
+
+	// MaxAcceptedHTLCs is the maximum number of htlcs that the receiver
+	// may offer at once.
+	MaxAcceptedHTLCs uint16
+
+	// ChannelType is the channel type that determines the format of the
+	// commitments after the upgrade.
+	ChannelType ChannelType
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure DynPropose implements the lnwire.Message
+// interface.
+var _ Message = (*DynPropose)(nil)
+
+// Encode serializes the target DynPropose into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, d.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, d.DustLimit); err != nil {
+		return err
+	}
+
+	if err := WriteMilliSatoshi(w, d.MaxValueInFlight); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, d.ChannelReserve); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, d.CsvDelay); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, d.MaxAcceptedHTLCs); err != nil {
+		return err
+	}
+
+	chanType := RawFeatureVector(d.ChannelType)
+	if err := WriteRawFeatureVector(w, &chanType); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, d.ExtraData)
+}
+
+// Decode deserializes a serialized DynPropose message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) Decode(r io.Reader, pver uint32) error {
+	var chanType *RawFeatureVector
+	err := ReadElements(r,
+		&d.ChanID,
+		&d.DustLimit,
+		&d.MaxValueInFlight,
+		&d.ChannelReserve,
+		&d.CsvDelay,
+		&d.MaxAcceptedHTLCs,
+		&chanType,
+		&d.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.ChannelType = ChannelType(*chanType)
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynPropose) MsgType() MessageType {
+	return MsgDynPropose
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (d *DynPropose) TargetChanID() ChannelID {
+	return d.ChanID
+}
@@ -0,0 +1,70 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// DynReject is sent in reply to DynPropose to reject the proposed dynamic
+// commitment upgrade, in which case the parameters of the channel remain
+// unchanged.
+type DynReject struct {
+	// ChanID is the channel whose upgrade is rejected.
+	ChanID ChannelID
+
+	// Reason is a human readable reason for the rejection.
+	Reason ErrorData
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure DynReject implements the lnwire.Message
+// interface.
+var _ Message = (*DynReject)(nil)
+
+// Encode serializes the target DynReject into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, d.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteErrorData(w, d.Reason); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, d.ExtraData)
+}
+
+// Decode deserializes a serialized DynReject message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&d.ChanID,
+		&d.Reason,
+		&d.ExtraData,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (d *DynReject) MsgType() MessageType {
+	return MsgDynReject
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (d *DynReject) TargetChanID() ChannelID {
+	return d.ChanID
+}
@@ -151,6 +151,17 @@ const (
 	// addresses for cooperative closure addresses.
 	ShutdownAnySegwitOptional FeatureBit = 27
 
+	// DualFundRequired is a required feature bit that signals that the
+	// sender requires channels to be opened with the dual funding
+	// protocol, in which both parties may contribute to the funding
+	// transaction.
+	DualFundRequired FeatureBit = 28
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sender supports opening channels with the dual funding protocol, in
+	// which both parties may contribute to the funding transaction.
+	DualFundOptional FeatureBit = 29
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -161,6 +172,37 @@ const (
 	// sender-generated preimages according to BOLT XX.
 	AMPOptional FeatureBit = 31
 
+	// QuiescenceRequired is a required feature bit that signals that the
+	// sender requires support for quiescing channels with the stfu
+	// message.
+	QuiescenceRequired FeatureBit = 34
+
+	// QuiescenceOptional is an optional feature bit that signals that the
+	// sender supports quiescing channels with the stfu message.
+	QuiescenceOptional FeatureBit = 35
+
+	// DynamicCommitmentsRequired is a required feature bit that signals
+	// that the sender requires support for upgrading the commitment type
+	// and constraints of existing channels.
+	//
+	// TODO: Decide on actual feature bit value.
+	DynamicCommitmentsRequired FeatureBit = 36
+
+	// DynamicCommitmentsOptional is an optional feature bit that signals
+	// that the sender supports upgrading the commitment type and
+	// constraints of existing channels.
+	//
+	// TODO: Decide on actual feature bit value.
+	DynamicCommitmentsOptional FeatureBit = 37
+
+	// OnionMessagesRequired is a required feature bit that signals that
+	// the node requires its peers to relay onion messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that signals that
+	// the node is able to send, receive and relay onion messages.
+	OnionMessagesOptional FeatureBit = 39
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -213,6 +255,27 @@ const (
 	// able and willing to accept keysend payments.
 	KeysendOptional = 55
 
+	// SimpleCloseRequired is a required feature bit that signals that the
+	// sender requires support for option_simple_close, with which either
+	// party can propose a closing transaction that pays the fee from its
+	// own output, and replace it with one that pays a higher fee.
+	SimpleCloseRequired FeatureBit = 60
+
+	// SimpleCloseOptional is an optional feature bit that signals that the
+	// sender supports option_simple_close, with which either party can
+	// propose a closing transaction that pays the fee from its own output,
+	// and replace it with one that pays a higher fee.
+	SimpleCloseOptional FeatureBit = 61
+
+	// SpliceRequired is a required feature bit that signals that the
+	// sender requires support for splicing funds into and out of existing
+	// channels.
+	SpliceRequired FeatureBit = 62
+
+	// SpliceOptional is an optional feature bit that signals that the
+	// sender supports splicing funds into and out of existing channels.
+	SpliceOptional FeatureBit = 63
+
 	// ScriptEnforcedLeaseOptional is an optional feature bit that signals
 	// that the node requires channels having zero-fee second-level HTLC
 	// transactions, which also imply anchor commitments, along with an
@@ -231,6 +294,20 @@ const (
 	// TODO: Decide on actual feature bit value.
 	ScriptEnforcedLeaseOptional FeatureBit = 2023
 
+	// TrampolineRoutingRequired is a required feature bit that signals
+	// that the node finds routes and forwards payments on behalf of payers
+	// that nest trampoline instructions in its onion payload.
+	//
+	// TODO: Decide on actual feature bit value.
+	TrampolineRoutingRequired FeatureBit = 2024
+
+	// TrampolineRoutingOptional is an optional feature bit that signals
+	// that the node finds routes and forwards payments on behalf of payers
+	// that nest trampoline instructions in its onion payload.
+	//
+	// TODO: Decide on actual feature bit value.
+	TrampolineRoutingOptional FeatureBit = 2025
+
 	// SimpleTaprootChannelsRequredFinal is a required bit that indicates
 	// the node is able to create taproot-native channels. This is the
 	// final feature bit to be used once the channel type is finalized.
@@ -299,8 +376,20 @@ var Features = map[FeatureBit]string{
 	AnchorsZeroFeeHtlcTxOptional:         "anchors-zero-fee-htlc-tx",
 	WumboChannelsRequired:                "wumbo-channels",
 	WumboChannelsOptional:                "wumbo-channels",
+	DualFundRequired:                     "dual-fund",
+	DualFundOptional:                     "dual-fund",
+	SpliceRequired:                       "splice",
+	SpliceOptional:                       "splice",
+	SimpleCloseRequired:                  "simple-close",
+	SimpleCloseOptional:                  "simple-close",
+	QuiescenceRequired:                   "quiescence",
+	QuiescenceOptional:                   "quiescence",
+	DynamicCommitmentsRequired:           "dynamic-commitments",
+	DynamicCommitmentsOptional:           "dynamic-commitments",
 	AMPRequired:                          "amp",
 	AMPOptional:                          "amp",
+	OnionMessagesRequired:                "onion-messages",
+	OnionMessagesOptional:                "onion-messages",
 	PaymentMetadataOptional:              "payment-metadata",
 	PaymentMetadataRequired:              "payment-metadata",
 	ExplicitChannelTypeOptional:          "explicit-commitment-type",
@@ -309,6 +398,8 @@ var Features = map[FeatureBit]string{
 	KeysendRequired:                      "keysend",
 	ScriptEnforcedLeaseRequired:          "script-enforced-lease",
 	ScriptEnforcedLeaseOptional:          "script-enforced-lease",
+	TrampolineRoutingRequired:            "trampoline-routing",
+	TrampolineRoutingOptional:            "trampoline-routing",
 	ScidAliasRequired:                    "scid-alias",
 	ScidAliasOptional:                    "scid-alias",
 	ZeroConfRequired:                     "zero-conf",
@@ -0,0 +1,69 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// FeeRecordType is the type of the record used in the extra data of
+	// channel_update to signal the inbound fee of a channel. It's the type
+	// that nodes announcing inbound fees on the network already use, so
+	// their channel updates are read without a translation. The type is
+	// odd, so that nodes that don't know about inbound fees can ignore the
+	// record. It's persisted with the channel updates in the graph, so it
+	// must never change.
+	FeeRecordType tlv.Type = 55555
+)
+
+// Fee is a fee schedule made of a base fee and a proportional fee rate. Both
+// components are signed, so that the inbound fee of a channel can be a
+// discount as well as a surcharge.
+type Fee struct {
+	// BaseFee is the fixed fee in msat.
+	BaseFee int32
+
+	// FeeRate is the proportional fee in parts per million.
+	FeeRate int32
+}
+
+// Record returns a TLV record that can be used to encode/decode the Fee type
+// from a given TLV stream.
+func (f *Fee) Record() tlv.Record {
+	return tlv.MakeStaticRecord(FeeRecordType, f, 8, feeEncoder, feeDecoder)
+}
+
+// feeEncoder is a custom TLV encoder for the Fee record.
+func feeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*Fee); ok {
+		err := tlv.EUint32T(w, uint32(v.BaseFee), buf)
+		if err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, uint32(v.FeeRate), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.Fee")
+}
+
+// feeDecoder is a custom TLV decoder for the Fee record.
+func feeDecoder(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*Fee); ok && l == 8 {
+		var baseFee, feeRate uint32
+		if err := tlv.DUint32(r, &baseFee, buf, 4); err != nil {
+			return err
+		}
+		if err := tlv.DUint32(r, &feeRate, buf, 4); err != nil {
+			return err
+		}
+
+		v.BaseFee = int32(baseFee)
+		v.FeeRate = int32(feeRate)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.Fee", l, 8)
+}
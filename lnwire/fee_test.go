@@ -0,0 +1,57 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeEncodeDecode tests that we're able to properly encode and decode
+// negative and positive fees within TLV streams.
+func TestFeeEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	fee := Fee{
+		BaseFee: -1000,
+		FeeRate: 250,
+	}
+
+	var extraData ExtraOpaqueData
+	require.NoError(t, extraData.PackRecords(&fee))
+
+	var fee2 Fee
+	tlvs, err := extraData.ExtractRecords(&fee2)
+	require.NoError(t, err)
+
+	require.Contains(t, tlvs, FeeRecordType)
+	require.Equal(t, fee, fee2)
+}
+
+// TestFeeWireFormat tests the encoding of the inbound fee record, which is
+// stored in the extra data of channel updates and must therefore not change.
+func TestFeeWireFormat(t *testing.T) {
+	t.Parallel()
+
+	fee := Fee{
+		BaseFee: -1000,
+		FeeRate: 250,
+	}
+
+	var extraData ExtraOpaqueData
+	require.NoError(t, extraData.PackRecords(&fee))
+
+	expected := []byte{
+		// Type 55555 as BigSize.
+		0xfd, 0xd9, 0x03,
+
+		// Length.
+		0x08,
+
+		// Base fee of -1000 msat.
+		0xff, 0xff, 0xfc, 0x18,
+
+		// Fee rate of 250 ppm.
+		0x00, 0x00, 0x00, 0xfa,
+	}
+	require.Equal(t, expected, []byte(extraData))
+}
@@ -651,6 +651,43 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(*req)
 		},
+		MsgUpdateAddHTLC: func(v []reflect.Value, r *rand.Rand) {
+			req := &UpdateAddHTLC{
+				ID:        r.Uint64(),
+				Amount:    MilliSatoshi(r.Int63()),
+				Expiry:    r.Uint32(),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PaymentHash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.OnionBlob[:]); err != nil {
+				t.Fatalf("unable to generate onion: %v", err)
+				return
+			}
+
+			if r.Int31()%2 == 0 {
+				pubKey, err := randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+					return
+				}
+
+				blindingPoint := BlindingPoint(*pubKey)
+				req.BlindingPoint = &blindingPoint
+			}
+
+			v[0] = reflect.ValueOf(*req)
+		},
 		MsgShutdown: func(v []reflect.Value, r *rand.Rand) {
 			var c [32]byte
 			_, err := r.Read(c[:])
@@ -708,6 +745,52 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgClosingComplete: func(v []reflect.Value, r *rand.Rand) {
+			req := ClosingComplete{
+				FeeSatoshis: btcutil.Amount(r.Int63()),
+				LockTime:    r.Uint32(),
+				ExtraData:   make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			sig, err := NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+			req.ClosingSigs = NewClosingSigs(
+				sig, r.Intn(2) == 1, r.Intn(2) == 1,
+			)
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgClosingSig: func(v []reflect.Value, r *rand.Rand) {
+			req := ClosingSig{
+				FeeSatoshis: btcutil.Amount(r.Int63()),
+				LockTime:    r.Uint32(),
+				ExtraData:   make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			sig, err := NewSigFromSignature(testSig)
+			if err != nil {
+				t.Fatalf("unable to parse sig: %v", err)
+				return
+			}
+			req.ClosingSigs = NewClosingSigs(
+				sig, r.Intn(2) == 1, r.Intn(2) == 1,
+			)
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgCommitSig: func(v []reflect.Value, r *rand.Rand) {
 			req := NewCommitSig()
 			if _, err := r.Read(req.ChanID[:]); err != nil {
@@ -747,6 +830,15 @@ func TestLightningWireProtocol(t *testing.T) {
 				}
 			}
 
+			// 50/50 chance to attach splice sigs, as long as the
+			// message stays within the maximum message size.
+			if numSigs <= 400 && r.Int31()%2 == 0 {
+				req.SpliceSig = &SpliceSig{
+					CommitSig: req.CommitSig,
+					HtlcSigs:  req.HtlcSigs,
+				}
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgRevokeAndAck: func(v []reflect.Value, r *rand.Rand) {
@@ -993,6 +1085,11 @@ func TestLightningWireProtocol(t *testing.T) {
 				}
 
 				req.LocalNonce = randLocalNonce(r)
+
+				if r.Int()%2 == 0 {
+					dynHeight := DynHeight(r.Uint64())
+					req.DynHeight = &dynHeight
+				}
 			}
 
 			v[0] = reflect.ValueOf(req)
@@ -1053,6 +1150,373 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgOnionMessage: func(v []reflect.Value, r *rand.Rand) {
+			pathKey, err := randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate key: %v", err)
+				return
+			}
+
+			onionBlob := make([]byte, r.Intn(MaxMsgBody-100))
+			if _, err := r.Read(onionBlob); err != nil {
+				t.Fatalf("unable to generate onion: %v", err)
+				return
+			}
+
+			req := OnionMessage{
+				PathKey:   pathKey,
+				OnionBlob: onionBlob,
+				ExtraData: make([]byte, 0),
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgOpenChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := OpenChannel2{
+				FundingFeePerKWeight: uint32(r.Int63()),
+				CommitFeePerKWeight:  uint32(r.Int63()),
+				FundingAmount:        btcutil.Amount(r.Int63()),
+				DustLimit:            btcutil.Amount(r.Int63()),
+				MaxValueInFlight:     MilliSatoshi(r.Int63()),
+				HtlcMinimum:          MilliSatoshi(r.Int31()),
+				CsvDelay:             uint16(r.Int31()),
+				MaxAcceptedHTLCs:     uint16(r.Int31()),
+				LockTime:             uint32(r.Int63()),
+			}
+			req.ChannelFlags = FundingFlag(uint8(r.Int31()))
+
+			_, err := r.Read(req.ChainHash[:])
+			if err != nil {
+				t.Fatalf("unable to generate chain hash: %v",
+					err)
+				return
+			}
+
+			_, err = r.Read(req.PendingChannelID[:])
+			if err != nil {
+				t.Fatalf("unable to generate pending chan "+
+					"id: %v", err)
+				return
+			}
+
+			keys := []**btcec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			}
+			for _, key := range keys {
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+					return
+				}
+			}
+
+			// 1/2 chance empty TLV records.
+			if r.Intn(2) == 0 {
+				addr, err := randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery "+
+						"address: %v", err)
+					return
+				}
+				req.UpfrontShutdownScript = addr
+
+				req.ChannelType = new(ChannelType)
+				*req.ChannelType = ChannelType(
+					*randRawFeatureVector(r),
+				)
+			} else {
+				req.UpfrontShutdownScript = []byte{}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgAcceptChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := AcceptChannel2{
+				FundingAmount:    btcutil.Amount(r.Int63()),
+				DustLimit:        btcutil.Amount(r.Int63()),
+				MaxValueInFlight: MilliSatoshi(r.Int63()),
+				HtlcMinimum:      MilliSatoshi(r.Int31()),
+				MinAcceptDepth:   uint32(r.Int31()),
+				CsvDelay:         uint16(r.Int31()),
+				MaxAcceptedHTLCs: uint16(r.Int31()),
+			}
+
+			_, err := r.Read(req.PendingChannelID[:])
+			if err != nil {
+				t.Fatalf("unable to generate pending chan "+
+					"id: %v", err)
+				return
+			}
+
+			keys := []**btcec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			}
+			for _, key := range keys {
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+					return
+				}
+			}
+
+			// 1/2 chance empty TLV records.
+			if r.Intn(2) == 0 {
+				addr, err := randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery "+
+						"address: %v", err)
+					return
+				}
+				req.UpfrontShutdownScript = addr
+
+				req.ChannelType = new(ChannelType)
+				*req.ChannelType = ChannelType(
+					*randRawFeatureVector(r),
+				)
+			} else {
+				req.UpfrontShutdownScript = []byte{}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			prevTx := wire.NewMsgTx(2)
+			prevTx.AddTxIn(&wire.TxIn{
+				PreviousOutPoint: *outpoint1,
+				SignatureScript:  []byte{},
+				Witness:          wire.TxWitness{[]byte{0x01}},
+			})
+
+			numOutputs := r.Intn(10) + 1
+			for i := 0; i < numOutputs; i++ {
+				pkScript := make([]byte, 34)
+				if _, err := r.Read(pkScript); err != nil {
+					t.Fatalf("unable to generate "+
+						"script: %v", err)
+					return
+				}
+
+				txOut := wire.NewTxOut(r.Int63(), pkScript)
+				prevTx.AddTxOut(txOut)
+			}
+
+			req := TxAddInput{
+				SerialID:   r.Uint64(),
+				PrevTx:     prevTx,
+				PrevTxVout: uint32(r.Intn(numOutputs)),
+				Sequence:   r.Uint32(),
+				ExtraData:  make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if r.Int31()%2 == 0 {
+				var txid chainhash.Hash
+				if _, err := r.Read(txid[:]); err != nil {
+					t.Fatalf("unable to generate txid: %v",
+						err)
+					return
+				}
+				req.SharedInputTxID = &txid
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				SerialID:  r.Uint64(),
+				Amount:    btcutil.Amount(r.Int63()),
+				PkScript:  make([]byte, r.Intn(100)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			req := TxSignatures{
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.TxID[:]); err != nil {
+				t.Fatalf("unable to generate txid: %v", err)
+				return
+			}
+
+			numWitnesses := r.Intn(10)
+			for i := 0; i < numWitnesses; i++ {
+				witness := make(wire.TxWitness, r.Intn(5))
+				for j := range witness {
+					witness[j] = make([]byte, r.Intn(100))
+					_, err := r.Read(witness[j])
+					if err != nil {
+						t.Fatalf("unable to generate "+
+							"witness: %v", err)
+						return
+					}
+				}
+
+				req.Witnesses = append(req.Witnesses, witness)
+			}
+
+			if r.Int31()%2 == 0 {
+				var sig Sig
+				if _, err := r.Read(sig.bytes[:]); err != nil {
+					t.Fatalf("unable to generate sig: %v",
+						err)
+					return
+				}
+				req.SharedInputSig = &sig
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAbort: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAbort{
+				Data:      make([]byte, r.Intn(1000)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.Data); err != nil {
+				t.Fatalf("unable to generate data: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceInit: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceInit{
+				FundingContribution:  r.Int63() - r.Int63(),
+				FundingFeePerKWeight: r.Uint32(),
+				LockTime:             r.Uint32(),
+				ExtraData:            make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			// 1/2 chance of an upgrade splice.
+			if r.Intn(2) == 0 {
+				req.ChannelType = new(ChannelType)
+				*req.ChannelType = ChannelType(
+					*randRawFeatureVector(r),
+				)
+				req.LocalNonce = randLocalNonce(r)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgStfu: func(v []reflect.Value, r *rand.Rand) {
+			req := Stfu{
+				Initiator: r.Intn(2) == 1,
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgDynPropose: func(v []reflect.Value, r *rand.Rand) {
+			req := DynPropose{
+				DustLimit:        btcutil.Amount(r.Int63()),
+				MaxValueInFlight: MilliSatoshi(r.Int63()),
+				ChannelReserve:   btcutil.Amount(r.Int63()),
+				CsvDelay:         uint16(r.Int31()),
+				MaxAcceptedHTLCs: uint16(r.Int31()),
+				ExtraData:        make([]byte, 0),
+			}
+			req.ChannelType = ChannelType(*randRawFeatureVector(r))
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgDynAck: func(v []reflect.Value, r *rand.Rand) {
+			req := DynAck{
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgDynReject: func(v []reflect.Value, r *rand.Rand) {
+			req := DynReject{
+				Reason:    make([]byte, r.Intn(1000)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.Reason); err != nil {
+				t.Fatalf("unable to generate reason: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceAck: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceAck{
+				FundingContribution: r.Int63() - r.Int63(),
+				ExtraData:           make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			// 1/2 chance of an upgrade splice.
+			if r.Intn(2) == 0 {
+				req.LocalNonce = randLocalNonce(r)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgPing: func(v []reflect.Value, r *rand.Rand) {
 			// We use a special message generator here to ensure we
 			// don't generate ping messages that are too large,
@@ -1153,6 +1617,18 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgClosingComplete,
+			scenario: func(m ClosingComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgClosingSig,
+			scenario: func(m ClosingSig) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgUpdateAddHTLC,
 			scenario: func(m UpdateAddHTLC) bool {
@@ -1232,6 +1708,102 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgOnionMessage,
+			scenario: func(m OnionMessage) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgOpenChannel2,
+			scenario: func(m OpenChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgAcceptChannel2,
+			scenario: func(m AcceptChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveInput,
+			scenario: func(m TxRemoveInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveOutput,
+			scenario: func(m TxRemoveOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAbort,
+			scenario: func(m TxAbort) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStfu,
+			scenario: func(m Stfu) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgDynPropose,
+			scenario: func(m DynPropose) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgDynAck,
+			scenario: func(m DynAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgDynReject,
+			scenario: func(m DynReject) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgQueryShortChanIDs,
 			scenario: func(m QueryShortChanIDs) bool {
@@ -288,6 +288,7 @@ func makeAllMessages(t testing.TB, r *rand.Rand) []lnwire.Message {
 	msgAll = append(msgAll, newMsgQueryChannelRange(t, r))
 	msgAll = append(msgAll, newMsgReplyChannelRange(t, r))
 	msgAll = append(msgAll, newMsgGossipTimestampRange(t, r))
+	msgAll = append(msgAll, newMsgOnionMessage(t, r))
 	msgAll = append(msgAll, newMsgQueryShortChanIDsZlib(t, r))
 	msgAll = append(msgAll, newMsgReplyChannelRangeZlib(t, r))
 
@@ -883,6 +884,19 @@ func newMsgGossipTimestampRange(t testing.TB,
 	return msg
 }
 
+func newMsgOnionMessage(t testing.TB, r *rand.Rand) *lnwire.OnionMessage {
+	t.Helper()
+
+	onionBlob := make([]byte, 1366)
+	_, err := r.Read(onionBlob)
+	require.NoError(t, err, "unable to generate onion blob")
+
+	msg := lnwire.NewOnionMessage(randPubKey(t), onionBlob)
+	msg.ExtraData = createExtraData(t, r)
+
+	return msg
+}
+
 func randRawKey(t testing.TB) [33]byte {
 	t.Helper()
 
@@ -0,0 +1,94 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// OnionMessage is a message that carries an onion encrypted payload which is
+// routed through the network along a blinded path, without being attached to
+// a payment or a channel. Each node along the path peels off a layer of the
+// onion and relays the remaining packet to the next node.
+type OnionMessage struct {
+	// PathKey is the blinding point that the receiving node uses to
+	// decrypt the route data contained in its layer of the onion.
+	PathKey *btcec.PublicKey
+
+	// OnionBlob is the serialized onion message packet. Unlike the onion
+	// of an HTLC, the size of this packet is not fixed by the protocol.
+	OnionBlob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// NewOnionMessage creates a new onion message with the path key and onion
+// packet provided.
+func NewOnionMessage(pathKey *btcec.PublicKey,
+	onionBlob []byte) *OnionMessage {
+
+	return &OnionMessage{
+		PathKey:   pathKey,
+		OnionBlob: onionBlob,
+	}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Decode deserializes a serialized OnionMessage message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, pver uint32) error {
+	var blobLen uint16
+	if err := ReadElements(r, &o.PathKey, &blobLen); err != nil {
+		return err
+	}
+
+	o.OnionBlob = make([]byte, blobLen)
+	if _, err := io.ReadFull(r, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return ReadElement(r, &o.ExtraData)
+}
+
+// Encode serializes the target OnionMessage into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WritePublicKey(w, o.PathKey); err != nil {
+		return err
+	}
+
+	if len(o.OnionBlob) > MaxMsgBody {
+		return fmt.Errorf("onion message packet of %d bytes is too "+
+			"large", len(o.OnionBlob))
+	}
+
+	if err := WriteUint16(w, uint16(len(o.OnionBlob))); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
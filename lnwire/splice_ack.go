@@ -0,0 +1,102 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// SpliceAck is sent in reply to SpliceInit to accept the splice of a channel.
+// Once it's received, the initiator starts the interactive construction of
+// the splice transaction.
+type SpliceAck struct {
+	// ChannelID is the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount of satoshis that the sender adds
+	// to its balance in the channel. It's negative if funds are spliced
+	// out of the channel.
+	FundingContribution int64
+
+	// LocalNonce is the verification nonce of the sender for its
+	// commitment that spends the new funding output. It's only set if the
+	// splice upgrades the channel to a taproot channel.
+	LocalNonce *Musig2Nonce
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceAck implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAck)(nil)
+
+// Encode serializes the target SpliceAck into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Encode(w *bytes.Buffer, pver uint32) error {
+	var recordProducers []tlv.RecordProducer
+	if s.LocalNonce != nil {
+		recordProducers = append(recordProducers, s.LocalNonce)
+	}
+	err := EncodeMessageExtraData(&s.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, uint64(s.FundingContribution)); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceAck message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Decode(r io.Reader, pver uint32) error {
+	var (
+		contribution uint64
+		tlvRecords   ExtraOpaqueData
+	)
+	err := ReadElements(r, &s.ChannelID, &contribution, &tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	s.FundingContribution = int64(contribution)
+
+	var localNonce Musig2Nonce
+	typeMap, err := tlvRecords.ExtractRecords(&localNonce)
+	if err != nil {
+		return err
+	}
+
+	// Set the nonce if it was included in the stream.
+	if val, ok := typeMap[NonceRecordType]; ok && val == nil {
+		s.LocalNonce = &localNonce
+	}
+
+	if len(tlvRecords) != 0 {
+		s.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MsgType() MessageType {
+	return MsgSpliceAck
+}
@@ -0,0 +1,139 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// SpliceInit is sent by the initiator of a splice to propose replacing the
+// funding output of an existing channel with a new one. The splice
+// transaction spends the current funding output and is constructed with the
+// interactive transaction construction protocol once the remote party has
+// replied with SpliceAck.
+type SpliceInit struct {
+	// ChannelID is the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount of satoshis that the initiator
+	// adds to its balance in the channel. It's negative if funds are
+	// spliced out of the channel.
+	FundingContribution int64
+
+	// FundingFeePerKWeight is the fee rate in sat per kilo-weight that is
+	// paid for the splice transaction.
+	FundingFeePerKWeight uint32
+
+	// LockTime is the lock time of the splice transaction.
+	LockTime uint32
+
+	// ChannelType is the channel type that the splice upgrades the
+	// channel to. It's only set if the splice moves the channel to a
+	// funding output of a new commitment type.
+	ChannelType *ChannelType
+
+	// LocalNonce is the verification nonce of the initiator for its
+	// commitment that spends the new funding output. It's only set if the
+	// splice upgrades the channel to a taproot channel.
+	LocalNonce *Musig2Nonce
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w *bytes.Buffer, pver uint32) error {
+	var recordProducers []tlv.RecordProducer
+	if s.ChannelType != nil {
+		recordProducers = append(recordProducers, s.ChannelType)
+	}
+	if s.LocalNonce != nil {
+		recordProducers = append(recordProducers, s.LocalNonce)
+	}
+	err := EncodeMessageExtraData(&s.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, uint64(s.FundingContribution)); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.FundingFeePerKWeight); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.LockTime); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceInit message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	var (
+		contribution uint64
+		tlvRecords   ExtraOpaqueData
+	)
+	err := ReadElements(r,
+		&s.ChannelID,
+		&contribution,
+		&s.FundingFeePerKWeight,
+		&s.LockTime,
+		&tlvRecords,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.FundingContribution = int64(contribution)
+
+	var (
+		chanType   ChannelType
+		localNonce Musig2Nonce
+	)
+	typeMap, err := tlvRecords.ExtractRecords(&chanType, &localNonce)
+	if err != nil {
+		return err
+	}
+
+	// Set the corresponding TLV types if they were included in the stream.
+	if val, ok := typeMap[ChannelTypeRecordType]; ok && val == nil {
+		s.ChannelType = &chanType
+	}
+	if val, ok := typeMap[NonceRecordType]; ok && val == nil {
+		s.LocalNonce = &localNonce
+	}
+
+	if len(tlvRecords) != 0 {
+		s.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
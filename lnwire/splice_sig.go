@@ -0,0 +1,94 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// SpliceSigRecordType is the type of the tlv record that carries the
+	// signatures of the commitment that spends the funding output of a
+	// pending splice. This is an _even_ type, which means it's required if
+	// included.
+	SpliceSigRecordType tlv.Type = 8
+)
+
+// SpliceSig holds the signatures of the commitment transaction that spends
+// the new funding output of a splice that hasn't confirmed yet. While a splice
+// is pending, every new commitment is signed for both the current and the new
+// funding output, so the channel can be used until the splice transaction
+// confirms.
+type SpliceSig struct {
+	// CommitSig is the signature of the commitment transaction that spends
+	// the new funding output.
+	CommitSig Sig
+
+	// HtlcSigs are the signatures of the second level HTLC transactions of
+	// the commitment, in the same order as the HtlcSigs of CommitSig.
+	HtlcSigs []Sig
+}
+
+// Record returns a TLV record that can be used to encode/decode the splice
+// signatures from a given TLV stream.
+func (s *SpliceSig) Record() tlv.Record {
+	size := func() uint64 {
+		return uint64(64 * (1 + len(s.HtlcSigs)))
+	}
+
+	return tlv.MakeDynamicRecord(
+		SpliceSigRecordType, s, size, spliceSigEncoder,
+		spliceSigDecoder,
+	)
+}
+
+// spliceSigEncoder encodes the splice signatures as the commitment signature
+// followed by the HTLC signatures.
+func spliceSigEncoder(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*SpliceSig); ok {
+		if _, err := w.Write(v.CommitSig.bytes[:]); err != nil {
+			return err
+		}
+		for _, sig := range v.HtlcSigs {
+			if _, err := w.Write(sig.bytes[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.SpliceSig")
+}
+
+// spliceSigDecoder decodes splice signatures that were encoded with
+// spliceSigEncoder.
+func spliceSigDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*SpliceSig); ok && l >= 64 && l%64 == 0 {
+		if _, err := io.ReadFull(r, v.CommitSig.bytes[:]); err != nil {
+			return err
+		}
+
+		// Only create the slice if there are HTLC signatures, so a
+		// decoded message equals the encoded one.
+		numSigs := l/64 - 1
+		if numSigs == 0 {
+			v.HtlcSigs = nil
+			return nil
+		}
+
+		v.HtlcSigs = make([]Sig, numSigs)
+		for i := range v.HtlcSigs {
+			_, err := io.ReadFull(r, v.HtlcSigs[i].bytes[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.SpliceSig", l, 64)
+}
@@ -0,0 +1,85 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// TxAbort is sent by either side to abort the interactive construction of a
+// transaction, or the exchange of signatures for it.
+type TxAbort struct {
+	// ChannelID is the channel that the transaction is constructed for.
+	ChannelID ChannelID
+
+	// Data is an optional explanation of why the construction was
+	// aborted.
+	Data []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAbort implements the lnwire.Message
+// interface.
+var _ Message = (*TxAbort)(nil)
+
+// Error returns the string representation of the abort reason.
+//
+// Satisfies the error interface.
+func (t *TxAbort) Error() string {
+	return fmt.Sprintf("tx_abort: %s", t.Data)
+}
+
+// Encode serializes the target TxAbort into the passed io.Writer observing the
+// protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if len(t.Data) > MaxMsgBody {
+		return fmt.Errorf("abort data of %d bytes is too large",
+			len(t.Data))
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Data))); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.Data); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAbort message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Decode(r io.Reader, pver uint32) error {
+	var dataLen uint16
+	if err := ReadElements(r, &t.ChannelID, &dataLen); err != nil {
+		return err
+	}
+
+	t.Data = make([]byte, dataLen)
+	if _, err := io.ReadFull(r, t.Data); err != nil {
+		return err
+	}
+
+	return ReadElement(r, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) MsgType() MessageType {
+	return MsgTxAbort
+}
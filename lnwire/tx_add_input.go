@@ -0,0 +1,187 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// SharedInputTxIDRecordType is the type of the TLV record that
+	// carries the txid of the shared input of a splice transaction.
+	SharedInputTxIDRecordType tlv.Type = 0
+)
+
+// sharedInputTxID is the txid of the shared input of a splice transaction,
+// which is encoded as an optional TLV record of TxAddInput.
+type sharedInputTxID chainhash.Hash
+
+// Record returns a TLV record that can be used to encode/decode the txid.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (s *sharedInputTxID) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(
+		SharedInputTxIDRecordType, (*[32]byte)(s),
+	)
+}
+
+// TxAddInput is sent by either side during the interactive construction of a
+// transaction to add an input to it. Rather than the outpoint alone, the
+// whole previous transaction is sent, which allows the receiver to verify the
+// amount of the input and that it spends a segwit output.
+type TxAddInput struct {
+	// ChannelID is the channel that the transaction is constructed for.
+	ChannelID ChannelID
+
+	// SerialID identifies the input within the construction session, and
+	// defines the position of the input within the final transaction. The
+	// initiator of the session uses even serial ids, the other side uses
+	// odd ones.
+	SerialID uint64
+
+	// PrevTx is the transaction that contains the output spent by the
+	// input.
+	PrevTx *wire.MsgTx
+
+	// PrevTxVout is the index of the spent output within PrevTx.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// SharedInputTxID is set if the input spends the current funding
+	// output of a channel that is spliced. As both parties know the
+	// output, PrevTx is omitted and the input spends the output
+	// PrevTxVout of the transaction with this id instead.
+	SharedInputTxID *chainhash.Hash
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	var prevTx bytes.Buffer
+	if t.PrevTx != nil {
+		if err := t.PrevTx.Serialize(&prevTx); err != nil {
+			return err
+		}
+	}
+
+	if prevTx.Len() > MaxMsgBody {
+		return fmt.Errorf("previous transaction of %d bytes is too "+
+			"large", prevTx.Len())
+	}
+
+	if err := WriteUint16(w, uint16(prevTx.Len())); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, prevTx.Bytes()); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxVout); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	// We'll only encode the SharedInputTxID in a TLV segment if it
+	// exists.
+	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	if t.SharedInputTxID != nil {
+		recordProducers = append(
+			recordProducers, (*sharedInputTxID)(t.SharedInputTxID),
+		)
+	}
+	err := EncodeMessageExtraData(&t.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddInput message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	var prevTxLen uint16
+	err := ReadElements(r, &t.ChannelID, &t.SerialID, &prevTxLen)
+	if err != nil {
+		return err
+	}
+
+	prevTx := make([]byte, prevTxLen)
+	if _, err := io.ReadFull(r, prevTx); err != nil {
+		return err
+	}
+
+	t.PrevTx = nil
+	if prevTxLen > 0 {
+		t.PrevTx = &wire.MsgTx{}
+		err := t.PrevTx.Deserialize(bytes.NewReader(prevTx))
+		if err != nil {
+			return err
+		}
+	}
+
+	var tlvRecords ExtraOpaqueData
+	err = ReadElements(r, &t.PrevTxVout, &t.Sequence, &tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	var sharedTxID sharedInputTxID
+	typeMap, err := tlvRecords.ExtractRecords(&sharedTxID)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputTxID if the corresponding TLV type was
+	// included in the stream.
+	t.SharedInputTxID = nil
+	if val, ok := typeMap[SharedInputTxIDRecordType]; ok && val == nil {
+		t.SharedInputTxID = (*chainhash.Hash)(&sharedTxID)
+	}
+
+	t.ExtraData = nil
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
@@ -0,0 +1,99 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// TxAddOutput is sent by either side during the interactive construction of a
+// transaction to add an output to it.
+type TxAddOutput struct {
+	// ChannelID is the channel that the transaction is constructed for.
+	ChannelID ChannelID
+
+	// SerialID identifies the output within the construction session, and
+	// defines the position of the output within the final transaction.
+	// The initiator of the session uses even serial ids, the other side
+	// uses odd ones.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// PkScript is the script that the output pays to.
+	PkScript []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, t.Amount); err != nil {
+		return err
+	}
+
+	if len(t.PkScript) > MaxMsgBody {
+		return fmt.Errorf("script of %d bytes is too large",
+			len(t.PkScript))
+	}
+
+	if err := WriteUint16(w, uint16(len(t.PkScript))); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.PkScript); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddOutput message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, pver uint32) error {
+	var scriptLen uint16
+	err := ReadElements(
+		r, &t.ChannelID, &t.SerialID, &t.Amount, &scriptLen,
+	)
+	if err != nil {
+		return err
+	}
+
+	t.PkScript = make([]byte, scriptLen)
+	if _, err := io.ReadFull(r, t.PkScript); err != nil {
+		return err
+	}
+
+	return ReadElement(r, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
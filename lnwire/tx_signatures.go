@@ -0,0 +1,246 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// maxWitnessItems is the maximum number of items that we accept
+	// within a single witness of a TxSignatures message.
+	maxWitnessItems = 500
+
+	// SharedInputSigRecordType is the type of the TLV record that carries
+	// the signature of the sender for the shared input of a splice
+	// transaction.
+	SharedInputSigRecordType tlv.Type = 0
+)
+
+// sharedInputSig is the signature for the shared input of a splice
+// transaction, which is encoded as an optional TLV record of TxSignatures.
+type sharedInputSig Sig
+
+// Record returns a TLV record that can be used to encode/decode the
+// signature.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (s *sharedInputSig) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		SharedInputSigRecordType, s, 64, sharedInputSigEncoder,
+		sharedInputSigDecoder,
+	)
+}
+
+// sharedInputSigEncoder is a custom TLV encoder for the sharedInputSig type.
+func sharedInputSigEncoder(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*sharedInputSig); ok {
+		_, err := w.Write(v.bytes[:])
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.sharedInputSig")
+}
+
+// sharedInputSigDecoder is a custom TLV decoder for the sharedInputSig type.
+func sharedInputSigDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*sharedInputSig); ok && l == 64 {
+		_, err := io.ReadFull(r, v.bytes[:])
+		return err
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.sharedInputSig", l, 64)
+}
+
+// TxSignatures is sent by both sides once the interactive construction of a
+// transaction has finished and the commitment signatures have been exchanged.
+// It carries the witnesses of the inputs that the sender added, in the order
+// of the serial ids of those inputs.
+type TxSignatures struct {
+	// ChannelID is the channel that the transaction was constructed for.
+	ChannelID ChannelID
+
+	// TxID is the id of the constructed transaction.
+	TxID chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs of the sender.
+	Witnesses []wire.TxWitness
+
+	// SharedInputSig is the signature of the sender for the shared input
+	// of a splice transaction, which spends the current funding output of
+	// the channel. It's only set for splices.
+	SharedInputSig *Sig
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxID[:]); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Witnesses))); err != nil {
+		return err
+	}
+
+	// Each witness is serialized as a stack in the same way as within a
+	// segwit transaction, prefixed with the length of the serialization.
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		err := wire.WriteVarInt(&b, 0, uint64(len(witness)))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range witness {
+			if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+				return err
+			}
+		}
+
+		if b.Len() > MaxMsgBody {
+			return fmt.Errorf("witness of %d bytes is too large",
+				b.Len())
+		}
+
+		if err := WriteUint16(w, uint16(b.Len())); err != nil {
+			return err
+		}
+
+		if err := WriteBytes(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	// We'll only encode the SharedInputSig in a TLV segment if it
+	// exists.
+	recordProducers := make([]tlv.RecordProducer, 0, 1)
+	if t.SharedInputSig != nil {
+		recordProducers = append(
+			recordProducers, (*sharedInputSig)(t.SharedInputSig),
+		)
+	}
+	err := EncodeMessageExtraData(&t.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxSignatures message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	var numWitnesses uint16
+	err := ReadElements(r, &t.ChannelID, t.TxID[:], &numWitnesses)
+	if err != nil {
+		return err
+	}
+
+	t.Witnesses = nil
+	for i := 0; i < int(numWitnesses); i++ {
+		var witnessLen uint16
+		if err := ReadElement(r, &witnessLen); err != nil {
+			return err
+		}
+
+		b := make([]byte, witnessLen)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+
+		witness, err := decodeWitness(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+
+		t.Witnesses = append(t.Witnesses, witness)
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElement(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	var sig sharedInputSig
+	typeMap, err := tlvRecords.ExtractRecords(&sig)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputSig if the corresponding TLV type was
+	// included in the stream.
+	t.SharedInputSig = nil
+	if val, ok := typeMap[SharedInputSigRecordType]; ok && val == nil {
+		t.SharedInputSig = (*Sig)(&sig)
+	}
+
+	t.ExtraData = nil
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// decodeWitness decodes a witness stack that was serialized as within a segwit
+// transaction.
+func decodeWitness(r *bytes.Reader) (wire.TxWitness, error) {
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if numItems > maxWitnessItems {
+		return nil, fmt.Errorf("witness with %d items is too large",
+			numItems)
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, MaxMsgBody, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after witness",
+			r.Len())
+	}
+
+	return witness, nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
@@ -3,6 +3,8 @@ package lnwire
 import (
 	"bytes"
 	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // OnionPacketSize is the size of the serialized Sphinx onion packet included
@@ -54,6 +56,12 @@ type UpdateAddHTLC struct {
 	// used in the subsequent UpdateAddHTLC message.
 	OnionBlob [OnionPacketSize]byte
 
+	// BlindingPoint is the ephemeral blinding point of a blinded route
+	// that the receiving node is part of. It is only set if the HTLC is
+	// being forwarded within a blinded route and the receiver isn't the
+	// introduction node.
+	BlindingPoint *BlindingPoint
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -74,15 +82,38 @@ var _ Message = (*UpdateAddHTLC)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.ID,
 		&c.Amount,
 		c.PaymentHash[:],
 		&c.Expiry,
 		c.OnionBlob[:],
-		&c.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	var blindingPoint BlindingPoint
+	typeMap, err := tlvRecords.ExtractRecords(&blindingPoint)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set the blinding point if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[BlindingPointRecordType]; ok && val == nil {
+		c.BlindingPoint = &blindingPoint
+	}
+
+	c.ExtraData = tlvRecords
+
+	return nil
 }
 
 // Encode serializes the target UpdateAddHTLC into the passed io.Writer
@@ -114,6 +145,16 @@ func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// We'll only encode the blinding point in a TLV segment if it exists,
+	// otherwise any extra data is written as is.
+	if c.BlindingPoint != nil {
+		recordProducers := []tlv.RecordProducer{c.BlindingPoint}
+		err := EncodeMessageExtraData(&c.ExtraData, recordProducers...)
+		if err != nil {
+			return err
+		}
+	}
+
 	return WriteBytes(w, c.ExtraData)
 }
 
@@ -0,0 +1,172 @@
+package lnd
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/asn"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// nodeAddrFilterMaxAge is the time after which the classification of the
+// nodes of the graph by their addresses is recomputed.
+const nodeAddrFilterMaxAge = 10 * time.Minute
+
+// errNoASNDB is returned when nodes are avoided by ASN without an IP to ASN
+// database.
+var errNoASNDB = errors.New("avoiding nodes by ASN requires routing.asndb")
+
+// nodeAddrFilter classifies the nodes of the graph by the addresses that they
+// announce, so that payments can avoid Tor-only nodes or nodes that are
+// hosted in certain autonomous systems. The classification is computed in a
+// single pass over the graph, and is reused until it's older than
+// nodeAddrFilterMaxAge.
+type nodeAddrFilter struct {
+	// forEachNode iterates over all nodes of the graph.
+	forEachNode func(func(kvdb.RTx, *channeldb.LightningNode) error) error
+
+	// asnDB maps IP addresses to their ASN. It's nil if no database was
+	// configured.
+	asnDB *asn.DB
+
+	// clock is used to determine the age of the classification.
+	clock clock.Clock
+
+	mu sync.Mutex
+
+	// refreshed is the time at which the classification was computed.
+	refreshed time.Time
+
+	// torOnly is the set of nodes that only announce Tor addresses.
+	torOnly map[route.Vertex]struct{}
+
+	// nodeASNs maps the nodes that announce IP addresses to the ASNs of
+	// those addresses.
+	nodeASNs map[route.Vertex][]uint32
+}
+
+// newNodeAddrFilter returns a filter over the nodes of the given graph. The
+// ASN database is optional.
+func newNodeAddrFilter(graph *channeldb.ChannelGraph, asnDB *asn.DB,
+	clock clock.Clock) *nodeAddrFilter {
+
+	return &nodeAddrFilter{
+		forEachNode: graph.ForEachNode,
+		asnDB:       asnDB,
+		clock:       clock,
+	}
+}
+
+// refresh recomputes the classification of the nodes if it's outdated.
+//
+// NOTE: The mutex must be held.
+func (f *nodeAddrFilter) refresh() error {
+	now := f.clock.Now()
+	if f.torOnly != nil && now.Sub(f.refreshed) < nodeAddrFilterMaxAge {
+		return nil
+	}
+
+	torOnly := make(map[route.Vertex]struct{})
+	nodeASNs := make(map[route.Vertex][]uint32)
+	err := f.forEachNode(func(_ kvdb.RTx,
+		node *channeldb.LightningNode) error {
+
+		isTorOnly := len(node.Addresses) > 0
+		for _, addr := range node.Addresses {
+			switch addr := addr.(type) {
+			case *tor.OnionAddr:
+				continue
+
+			case *net.TCPAddr:
+				isTorOnly = false
+
+				if f.asnDB == nil {
+					continue
+				}
+
+				asNum, ok := f.asnDB.Lookup(addr.IP)
+				if ok {
+					nodeASNs[node.PubKeyBytes] = append(
+						nodeASNs[node.PubKeyBytes], asNum,
+					)
+				}
+
+			default:
+				isTorOnly = false
+			}
+		}
+
+		if isTorOnly {
+			torOnly[node.PubKeyBytes] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	f.refreshed = now
+	f.torOnly = torOnly
+	f.nodeASNs = nodeASNs
+
+	return nil
+}
+
+// TorOnlyNodes returns the nodes that announce at least one address, all of
+// which are Tor addresses.
+func (f *nodeAddrFilter) TorOnlyNodes() (map[route.Vertex]struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.refresh(); err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[route.Vertex]struct{}, len(f.torOnly))
+	for node := range f.torOnly {
+		nodes[node] = struct{}{}
+	}
+
+	return nodes, nil
+}
+
+// ASNNodes returns the nodes that announce at least one IP address that
+// belongs to one of the given autonomous systems.
+func (f *nodeAddrFilter) ASNNodes(
+	asns []uint32) (map[route.Vertex]struct{}, error) {
+
+	if f.asnDB == nil {
+		return nil, errNoASNDB
+	}
+
+	avoided := make(map[uint32]struct{}, len(asns))
+	for _, asNum := range asns {
+		avoided[asNum] = struct{}{}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.refresh(); err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[route.Vertex]struct{})
+	for node, nodeASNs := range f.nodeASNs {
+		for _, asNum := range nodeASNs {
+			if _, ok := avoided[asNum]; ok {
+				nodes[node] = struct{}{}
+				break
+			}
+		}
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,115 @@
+package lnd
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/asn"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tor"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeAddrFilter tests that nodes are classified by their addresses, and
+// that the classification is only recomputed once it's outdated.
+func TestNodeAddrFilter(t *testing.T) {
+	t.Parallel()
+
+	asnDB, err := asn.Read(strings.NewReader(
+		"5.9.0.0\t5.9.255.255\t24940\tDE\tHETZNER-AS\n" +
+			"1.0.0.0\t1.0.0.255\t13335\tUS\tCLOUDFLARENET\n",
+	))
+	require.NoError(t, err)
+
+	var (
+		onion = &tor.OnionAddr{
+			OnionService: "3g2upl4pq6kufc4m.onion", Port: 9735,
+		}
+		hetzner = &net.TCPAddr{IP: net.ParseIP("5.9.1.2"), Port: 9735}
+		unknown = &net.TCPAddr{IP: net.ParseIP("8.8.8.8"), Port: 9735}
+
+		torOnlyNode = route.Vertex{1}
+		hybridNode  = route.Vertex{2}
+		hetznerNode = route.Vertex{3}
+		noAddrNode  = route.Vertex{4}
+	)
+
+	nodes := []*channeldb.LightningNode{{
+		PubKeyBytes: torOnlyNode,
+		Addresses:   []net.Addr{onion},
+	}, {
+		PubKeyBytes: hybridNode,
+		Addresses:   []net.Addr{onion, unknown},
+	}, {
+		PubKeyBytes: hetznerNode,
+		Addresses:   []net.Addr{unknown, hetzner},
+	}, {
+		PubKeyBytes: noAddrNode,
+	}}
+
+	var scans int
+	testClock := clock.NewTestClock(time.Unix(1, 0))
+	filter := &nodeAddrFilter{
+		forEachNode: func(cb func(kvdb.RTx,
+			*channeldb.LightningNode) error) error {
+
+			scans++
+			for _, node := range nodes {
+				if err := cb(nil, node); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		asnDB: asnDB,
+		clock: testClock,
+	}
+
+	torOnly, err := filter.TorOnlyNodes()
+	require.NoError(t, err)
+	require.Equal(t, map[route.Vertex]struct{}{
+		torOnlyNode: {},
+	}, torOnly)
+
+	asnNodes, err := filter.ASNNodes([]uint32{13335, 24940})
+	require.NoError(t, err)
+	require.Equal(t, map[route.Vertex]struct{}{
+		hetznerNode: {},
+	}, asnNodes)
+
+	asnNodes, err = filter.ASNNodes([]uint32{13335})
+	require.NoError(t, err)
+	require.Empty(t, asnNodes)
+
+	// The graph was only scanned once.
+	require.Equal(t, 1, scans)
+
+	// Modifying the returned set doesn't affect the filter.
+	delete(torOnly, torOnlyNode)
+	torOnly, err = filter.TorOnlyNodes()
+	require.NoError(t, err)
+	require.Contains(t, torOnly, torOnlyNode)
+
+	// Once the classification is outdated, new nodes are picked up.
+	nodes = append(nodes, &channeldb.LightningNode{
+		PubKeyBytes: route.Vertex{5},
+		Addresses:   []net.Addr{onion},
+	})
+	testClock.SetTime(time.Unix(1, 0).Add(nodeAddrFilterMaxAge))
+
+	torOnly, err = filter.TorOnlyNodes()
+	require.NoError(t, err)
+	require.Len(t, torOnly, 2)
+	require.Equal(t, 2, scans)
+
+	// Without an ASN database, nodes can't be avoided by ASN.
+	filter.asnDB = nil
+	_, err = filter.ASNNodes([]uint32{24940})
+	require.ErrorIs(t, err, errNoASNDB)
+}
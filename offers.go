@@ -0,0 +1,221 @@
+package lnd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// onionMessageGraph is a view of the channel graph that is used to find
+// paths for onion messages.
+type onionMessageGraph struct {
+	graph *channeldb.ChannelGraph
+}
+
+// A compile time check to ensure onionMessageGraph implements the
+// onionmessage.Graph interface.
+var _ onionmessage.Graph = (*onionMessageGraph)(nil)
+
+// ForEachNeighbor calls the callback for every node that the given node has
+// a channel with.
+//
+// NOTE: This is part of the onionmessage.Graph interface.
+func (g *onionMessageGraph) ForEachNeighbor(node route.Vertex,
+	cb func(route.Vertex) error) error {
+
+	return g.graph.ForEachNodeChannel(nil, node,
+		func(channel *channeldb.DirectedChannel) error {
+			return cb(channel.OtherNode)
+		},
+	)
+}
+
+// SupportsOnionMessages returns true if the node advertises support for
+// relaying onion messages.
+//
+// NOTE: This is part of the onionmessage.Graph interface.
+func (g *onionMessageGraph) SupportsOnionMessages(node route.Vertex) (bool,
+	error) {
+
+	features, err := g.graph.FetchNodeFeatures(node)
+	if err != nil {
+		return false, err
+	}
+
+	return features.HasFeature(lnwire.OnionMessagesOptional), nil
+}
+
+// findOnionMessagePath returns a path of nodes that an onion message can be
+// sent along to reach the target node. If the target is one of our peers,
+// the message is sent to it directly.
+func (s *server) findOnionMessagePath(
+	target route.Vertex) ([]*btcec.PublicKey, error) {
+
+	if _, err := s.FindPeerByPubStr(string(target[:])); err == nil {
+		targetKey, err := btcec.ParsePubKey(target[:])
+		if err != nil {
+			return nil, err
+		}
+
+		return []*btcec.PublicKey{targetKey}, nil
+	}
+
+	source := route.NewVertex(s.identityECDH.PubKey())
+	path, err := onionmessage.FindPath(
+		&onionMessageGraph{graph: s.graphDB}, source, target,
+		onionmessage.DefaultMaxPathLength,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*btcec.PublicKey, len(path))
+	for i, node := range path {
+		keys[i], err = btcec.ParsePubKey(node[:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+// newAddInvoiceConfig returns the config that is used to add invoices to
+// the invoice registry.
+func (s *server) newAddInvoiceConfig() *invoicesrpc.AddInvoiceConfig {
+	return &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       s.cfg.ActiveNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		DefaultCLTVExpiry: s.cfg.Bitcoin.TimeLockDelta,
+		ChanDB:            s.chanStateDB,
+		Graph:             s.graphDB,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoiceAmp)
+		},
+		GetAlias:   s.aliasMgr.GetPeerAlias,
+		NodePubKey: s.identityECDH.PubKey(),
+		BestHeight: func() (uint32, error) {
+			_, height, err := s.cc.ChainIO.GetBestBlock()
+			return uint32(height), err
+		},
+	}
+}
+
+// addOfferInvoice adds a blinded invoice to the invoice registry for an
+// invoice request that we received for one of our offers. It returns the
+// payment hash and the blinded paths that the invoice can be paid along.
+func (s *server) addOfferInvoice(amt lnwire.MilliSatoshi, memo string,
+	expiry time.Duration) (lntypes.Hash, []*zpay32.BlindedPaymentPath,
+	error) {
+
+	hash, dbInvoice, err := invoicesrpc.AddInvoice(
+		context.Background(), s.newAddInvoiceConfig(),
+		&invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry / time.Second),
+			Blind:  true,
+		},
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	payReq, err := zpay32.Decode(
+		string(dbInvoice.PaymentRequest), s.cfg.ActiveNetParams.Params,
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	return *hash, payReq.BlindedPaymentPaths, nil
+}
+
+// isOfferInvoicePending returns true if the invoice that we created for one
+// of our offers was neither settled nor canceled.
+func (s *server) isOfferInvoicePending(hash lntypes.Hash) (bool, error) {
+	invoice, err := s.invoices.LookupInvoice(context.Background(), hash)
+	if err != nil {
+		return false, err
+	}
+
+	switch invoice.State {
+	case invoices.ContractSettled, invoices.ContractCanceled:
+		return false, nil
+
+	default:
+		return true, nil
+	}
+}
+
+// payBlindedPaths pays the amount to the first of the blinded paths that a
+// route is found to, spending at most the fee limit on routing fees.
+func (s *server) payBlindedPaths(hash lntypes.Hash, amt lnwire.MilliSatoshi,
+	paths []*routing.BlindedPayment,
+	feeLimit lnwire.MilliSatoshi) (lntypes.Preimage, error) {
+
+	source := route.NewVertex(s.identityECDH.PubKey())
+	restrictions := &routing.RestrictParams{
+		FeeLimit: feeLimit,
+		ProbabilitySource: func(fromNode, toNode route.Vertex,
+			amt lnwire.MilliSatoshi,
+			capacity btcutil.Amount) float64 {
+
+			return s.missionControl.GetProbability(
+				fromNode, toNode, amt, capacity,
+			)
+		},
+		CltvLimit: s.cfg.MaxOutgoingCltvExpiry,
+	}
+
+	lastErr := errors.New("no blinded paths to pay")
+	for _, path := range paths {
+		req, err := routing.NewRouteRequest(
+			source, nil, amt, 0, restrictions, nil, nil, path, 0,
+		)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		rt, _, err := s.chanRouter.FindRoute(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		attempt, err := s.chanRouter.SendToRoute(hash, rt)
+		switch {
+		case err != nil:
+			lastErr = err
+
+		case attempt.Failure != nil:
+			lastErr = fmt.Errorf("payment failed: %v",
+				attempt.Failure.Reason)
+
+		default:
+			return attempt.Settle.Preimage, nil
+		}
+	}
+
+	return lntypes.Preimage{}, lastErr
+}
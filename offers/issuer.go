@@ -0,0 +1,506 @@
+package offers
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// offerNonceSize is the size of the random nonce that is included in
+	// the metadata of our offers.
+	offerNonceSize = 16
+)
+
+var (
+	// offerKeyPoint is a point with unknown discrete logarithm, which our
+	// node key is multiplied with to derive the secret that authenticates
+	// our offers.
+	offerKeyPoint = "0250929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547" +
+		"bfee9ace803ac0"
+
+	// errUnknownOffer is returned for invoice requests that were not made
+	// for one of our offers.
+	errUnknownOffer = errors.New("unknown offer")
+
+	// errTooManyInvoices is returned for invoice requests for an offer
+	// that already has the maximum number of unpaid invoices.
+	errTooManyInvoices = errors.New("too many pending invoices for " +
+		"offer, try again later")
+)
+
+// pendingInvoice is an invoice that we created for one of our offers, which
+// may not be paid yet.
+type pendingInvoice struct {
+	// hash is the payment hash of the invoice. It is unset while the
+	// invoice is being added.
+	hash *lntypes.Hash
+
+	// expiry is the time at which the invoice expires.
+	expiry time.Time
+}
+
+// OfferParams describes an offer that we create.
+type OfferParams struct {
+	// Amount is the amount per item in millisatoshis. If zero, the payer
+	// chooses the amount.
+	Amount lnwire.MilliSatoshi
+
+	// Description is a description of the purpose of the payment.
+	Description string
+
+	// Issuer is an optional description of the issuer of the offer.
+	Issuer string
+
+	// Expiry is the time after its creation that the offer expires. If
+	// zero, the offer doesn't expire.
+	Expiry time.Duration
+
+	// HasQuantity signals that the payer must choose a quantity of items
+	// to pay for.
+	HasQuantity bool
+
+	// QuantityMax is the maximum quantity of items that can be paid for.
+	// If zero, any quantity can be paid for. It is only used if
+	// HasQuantity is true.
+	QuantityMax uint64
+}
+
+// deriveOfferKey derives the secret that authenticates our offers from our
+// node key.
+func deriveOfferKey(nodeKey keychain.SingleKeyECDH) ([32]byte, error) {
+	pointBytes, err := hex.DecodeString(offerKeyPoint)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	point, err := btcec.ParsePubKey(pointBytes)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return nodeKey.ECDH(point)
+}
+
+// offerMAC returns the authentication code of an offer that carries the
+// given nonce in its metadata.
+func (m *Manager) offerMAC(offer *bolt12.Offer, nonce []byte) ([]byte,
+	error) {
+
+	// The code covers all other fields of the offer, so copy the offer
+	// without its metadata.
+	unauthenticated := *offer
+	unauthenticated.Metadata = nil
+
+	b, err := unauthenticated.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, m.offerKey[:])
+	mac.Write(nonce)
+	mac.Write(b)
+
+	return mac.Sum(nil), nil
+}
+
+// CreateOffer creates a new offer that is paid to our node. Offers aren't
+// stored: the offer's metadata authenticates it when it is sent back to us
+// in an invoice request, so that any number of offers can be created.
+func (m *Manager) CreateOffer(params *OfferParams) (*bolt12.Offer, error) {
+	if params.Amount != 0 && params.Description == "" {
+		return nil, errors.New("offers with an amount must have a " +
+			"description")
+	}
+
+	offer := &bolt12.Offer{
+		Amount:      uint64(params.Amount),
+		Description: params.Description,
+		Issuer:      params.Issuer,
+		HasQuantity: params.HasQuantity,
+		QuantityMax: params.QuantityMax,
+		IssuerID:    m.nodeID(),
+	}
+
+	// Offers without chains are only valid on mainnet.
+	genesis := *m.cfg.ChainParams.GenesisHash
+	if genesis != *chaincfg.MainNetParams.GenesisHash {
+		offer.Chains = []chainhash.Hash{genesis}
+	}
+
+	if params.Expiry != 0 {
+		offer.AbsoluteExpiry = m.cfg.Clock.Now().Add(params.Expiry)
+	}
+
+	nonce := make([]byte, offerNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	mac, err := m.offerMAC(offer, nonce)
+	if err != nil {
+		return nil, err
+	}
+	offer.Metadata = append(nonce, mac...)
+
+	return offer, nil
+}
+
+// isOurOffer returns true if the offer was created by our node.
+func (m *Manager) isOurOffer(offer *bolt12.Offer) bool {
+	if !m.isOurNode(offer.IssuerID) {
+		return false
+	}
+
+	if len(offer.Metadata) != offerNonceSize+sha256.Size {
+		return false
+	}
+
+	nonce := offer.Metadata[:offerNonceSize]
+	mac, err := m.offerMAC(offer, nonce)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(mac, offer.Metadata[offerNonceSize:])
+}
+
+// handleInvoiceRequest replies to an invoice request for one of our offers
+// with an invoice, or with an invoice error if we can't create one.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) handleInvoiceRequest(b []byte,
+	msg *onionmessage.ReceivedMessage) {
+
+	defer m.wg.Done()
+
+	// Without a reply path we have no way to send the invoice.
+	if msg.ReplyPath == nil {
+		log.Debugf("Ignoring invoice request without reply path")
+		return
+	}
+
+	req, err := bolt12.ParseInvoiceRequest(b)
+	if err != nil {
+		log.Debugf("Unable to parse invoice request: %v", err)
+		m.replyError(msg.ReplyPath, &bolt12.InvoiceError{
+			Message: fmt.Sprintf("invalid invoice request: %v",
+				err),
+		})
+
+		return
+	}
+
+	if err := m.replyInvoice(req, msg.ReplyPath); err != nil {
+		log.Debugf("Unable to reply to invoice request: %v", err)
+
+		// We don't reveal anything about requests for offers that we
+		// didn't create.
+		if errors.Is(err, errUnknownOffer) {
+			return
+		}
+
+		m.replyError(msg.ReplyPath, &bolt12.InvoiceError{
+			Message: err.Error(),
+		})
+	}
+}
+
+// checkInvoiceRequest checks that we can create an invoice for the request
+// and returns the amount that the invoice must be paid for.
+func (m *Manager) checkInvoiceRequest(
+	req *bolt12.InvoiceRequest) (lnwire.MilliSatoshi, error) {
+
+	offer := req.Offer
+	if !m.isOurOffer(offer) {
+		return 0, errUnknownOffer
+	}
+
+	if req.ChainHash() != *m.cfg.ChainParams.GenesisHash {
+		return 0, errors.New("unsupported chain")
+	}
+
+	if offer.IsExpired(m.cfg.Clock.Now()) {
+		return 0, bolt12.ErrOfferExpired
+	}
+
+	switch {
+	case offer.HasQuantity && req.Quantity == 0:
+		return 0, errors.New("quantity is required")
+
+	case offer.HasQuantity && offer.QuantityMax != 0 &&
+		req.Quantity > offer.QuantityMax:
+
+		return 0, fmt.Errorf("quantity exceeds maximum of %d",
+			offer.QuantityMax)
+
+	case !offer.HasQuantity && req.Quantity != 0:
+		return 0, errors.New("quantity is not supported")
+	}
+
+	amt, err := req.AmountToPay()
+	if err != nil {
+		return 0, err
+	}
+
+	// The payer may pay more than the offer asks for, but not less.
+	if req.Amount != 0 && offer.Amount != 0 {
+		minRequest := *req
+		minRequest.Amount = 0
+
+		minAmt, err := minRequest.AmountToPay()
+		if err != nil {
+			return 0, err
+		}
+
+		if amt < minAmt {
+			return 0, fmt.Errorf("amount %v is below offer amount "+
+				"%v", amt, minAmt)
+		}
+	}
+
+	return amt, nil
+}
+
+// replyInvoice creates an invoice for the request and sends it along the
+// reply path.
+func (m *Manager) replyInvoice(req *bolt12.InvoiceRequest,
+	replyPath *sphinx.BlindedPath) error {
+
+	amt, err := m.checkInvoiceRequest(req)
+	if err != nil {
+		return err
+	}
+
+	pending, err := m.reserveInvoice(req.Offer)
+	if err != nil {
+		return err
+	}
+
+	hash, blindedPaths, err := m.cfg.AddInvoice(
+		amt, req.Offer.Description, bolt12.DefaultInvoiceExpiry,
+	)
+	if err != nil {
+		m.releaseInvoice(req.Offer, pending)
+
+		return fmt.Errorf("unable to add invoice: %w", err)
+	}
+
+	m.invoicesMtx.Lock()
+	pending.hash = &hash
+	m.invoicesMtx.Unlock()
+
+	paths := make([]*bolt12.PaymentPath, len(blindedPaths))
+	for i, path := range blindedPaths {
+		features := lnwire.NewRawFeatureVector()
+		if path.Features != nil {
+			features = path.Features.RawFeatureVector
+		}
+
+		paths[i] = &bolt12.PaymentPath{
+			Path: path.BlindedPath,
+			PayInfo: &bolt12.BlindedPayInfo{
+				FeeBaseMsat:               path.FeeBaseMsat,
+				FeeProportionalMillionths: path.FeeRate,
+				CltvExpiryDelta:           path.CltvExpiryDelta,
+				HtlcMinimumMsat:           path.HTLCMinMsat,
+				HtlcMaximumMsat:           path.HTLCMaxMsat,
+				Features:                  features,
+			},
+		}
+	}
+
+	signer := func(msg []byte) (*schnorr.Signature, error) {
+		return m.cfg.Signer.SignMessageSchnorr(
+			m.cfg.NodeKeyLoc, msg, false, nil,
+		)
+	}
+
+	// An onion message can only carry a limited amount of data, so we
+	// drop payment paths until the invoice fits.
+	for len(paths) > 0 {
+		invoice := &bolt12.Invoice{
+			Request:        req,
+			Paths:          paths,
+			CreatedAt:      m.cfg.Clock.Now(),
+			RelativeExpiry: bolt12.DefaultInvoiceExpiry,
+			PaymentHash:    hash,
+			Amount:         amt,
+			NodeID:         m.nodeID(),
+		}
+		if err := invoice.Sign(signer); err != nil {
+			return err
+		}
+
+		b, err := invoice.Serialize()
+		if err != nil {
+			return err
+		}
+
+		err = m.sendToBlindedPath(replyPath, nil, map[tlv.Type][]byte{
+			InvoiceType: b,
+		})
+		if !errors.Is(err, sphinx.ErrMaxRoutingInfoSizeExceeded) {
+			return err
+		}
+
+		paths = paths[:len(paths)-1]
+	}
+
+	return errors.New("invoice is too large for onion message")
+}
+
+// reserveInvoice reserves a slot for a new invoice of the offer. If all slots
+// of the offer are taken, the invoices of the offer that were paid or
+// canceled are pruned first.
+func (m *Manager) reserveInvoice(offer *bolt12.Offer) (*pendingInvoice,
+	error) {
+
+	m.invoicesMtx.Lock()
+	defer m.invoicesMtx.Unlock()
+
+	now := m.cfg.Clock.Now()
+	m.pruneExpiredInvoices(now)
+
+	key := string(offer.Metadata)
+	maxInvoices := m.cfg.MaxPendingInvoices
+	invoices := m.pendingInvoices[key]
+	if maxInvoices != 0 && len(invoices) >= maxInvoices {
+		invoices = m.pruneResolvedInvoices(invoices)
+		m.pendingInvoices[key] = invoices
+	}
+
+	if maxInvoices != 0 && len(invoices) >= maxInvoices {
+		return nil, errTooManyInvoices
+	}
+
+	pending := &pendingInvoice{
+		expiry: now.Add(bolt12.DefaultInvoiceExpiry),
+	}
+	m.pendingInvoices[key] = append(invoices, pending)
+
+	return pending, nil
+}
+
+// releaseInvoice releases the slot of an invoice that couldn't be added.
+func (m *Manager) releaseInvoice(offer *bolt12.Offer,
+	pending *pendingInvoice) {
+
+	m.invoicesMtx.Lock()
+	defer m.invoicesMtx.Unlock()
+
+	key := string(offer.Metadata)
+	invoices := m.pendingInvoices[key]
+	for i, invoice := range invoices {
+		if invoice == pending {
+			invoices = append(invoices[:i], invoices[i+1:]...)
+			break
+		}
+	}
+
+	if len(invoices) == 0 {
+		delete(m.pendingInvoices, key)
+		return
+	}
+
+	m.pendingInvoices[key] = invoices
+}
+
+// pruneExpiredInvoices removes the invoices of all offers that expired.
+//
+// NOTE: This MUST be called with the invoicesMtx held.
+func (m *Manager) pruneExpiredInvoices(now time.Time) {
+	for key, invoices := range m.pendingInvoices {
+		pruned := invoices[:0]
+		for _, invoice := range invoices {
+			if now.Before(invoice.expiry) {
+				pruned = append(pruned, invoice)
+			}
+		}
+
+		if len(pruned) == 0 {
+			delete(m.pendingInvoices, key)
+			continue
+		}
+
+		m.pendingInvoices[key] = pruned
+	}
+}
+
+// pruneResolvedInvoices returns the invoices that were neither paid nor
+// canceled. Invoices that are still being added are kept.
+//
+// NOTE: This MUST be called with the invoicesMtx held.
+func (m *Manager) pruneResolvedInvoices(
+	invoices []*pendingInvoice) []*pendingInvoice {
+
+	pruned := invoices[:0]
+	for _, invoice := range invoices {
+		if invoice.hash == nil {
+			pruned = append(pruned, invoice)
+			continue
+		}
+
+		// If we can't look up the invoice, we keep counting it.
+		isPending, err := m.cfg.IsInvoicePending(*invoice.hash)
+		if err != nil {
+			log.Debugf("Unable to look up invoice %v: %v",
+				invoice.hash, err)
+		}
+		if err != nil || isPending {
+			pruned = append(pruned, invoice)
+		}
+	}
+
+	return pruned
+}
+
+// replyError sends an invoice error along the reply path.
+func (m *Manager) replyError(replyPath *sphinx.BlindedPath,
+	invoiceErr *bolt12.InvoiceError) {
+
+	err := m.sendToBlindedPath(replyPath, nil, map[tlv.Type][]byte{
+		InvoiceErrorType: invoiceErr.Serialize(),
+	})
+	if err != nil {
+		log.Debugf("Unable to send invoice error: %v", err)
+	}
+}
+
+// sendToBlindedPath sends an onion message with the given records along the
+// blinded path, travelling along a path through the graph to reach its
+// introduction node if it isn't one of our peers.
+func (m *Manager) sendToBlindedPath(dest *sphinx.BlindedPath,
+	replyPath *sphinx.BlindedPath, tlvs map[tlv.Type][]byte) error {
+
+	path, err := m.cfg.FindPath(route.NewVertex(dest.IntroductionPoint))
+	if err != nil {
+		return err
+	}
+
+	// The path ends with the introduction node, which is reached through
+	// the blinded path itself.
+	return m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+		Path:               path[:len(path)-1],
+		BlindedDestination: dest,
+		ReplyPath:          replyPath,
+		FinalHopTLVs:       tlvs,
+	})
+}
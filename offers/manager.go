@@ -0,0 +1,272 @@
+package offers
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// InvoiceRequestType is the type of the onion message record that
+	// holds an invoice request.
+	InvoiceRequestType tlv.Type = 64
+
+	// InvoiceType is the type of the onion message record that holds an
+	// invoice.
+	InvoiceType tlv.Type = 66
+
+	// InvoiceErrorType is the type of the onion message record that holds
+	// an invoice error.
+	InvoiceErrorType tlv.Type = 68
+
+	// DefaultFetchTimeout is the default time that we wait for the issuer
+	// of an offer to reply to an invoice request.
+	DefaultFetchTimeout = time.Minute
+
+	// DefaultMaxPendingInvoices is the default number of unpaid invoices
+	// that we keep for each of our offers.
+	DefaultMaxPendingInvoices = 50
+)
+
+var (
+	// ErrManagerShuttingDown is returned when the manager is stopped while
+	// an operation is in progress.
+	ErrManagerShuttingDown = errors.New("offers manager shutting down")
+)
+
+// Config houses the functionality that the Manager needs to create and pay
+// offers, and to reply to the invoice requests for our offers.
+type Config struct {
+	// NodeKey is the private key of our node. Its public key is the issuer
+	// id of our offers, and the key is also used to derive the secret that
+	// authenticates our offers.
+	NodeKey keychain.SingleKeyECDH
+
+	// Signer is used to sign the invoices that we create for our offers.
+	Signer keychain.MessageSignerRing
+
+	// NodeKeyLoc is the locator of our node key within the signer.
+	NodeKeyLoc keychain.KeyLocator
+
+	// ChainParams are the parameters of the chain that we're running on.
+	ChainParams *chaincfg.Params
+
+	// Messenger is used to exchange invoice requests and invoices over
+	// onion messages.
+	Messenger *onionmessage.Messenger
+
+	// FindPath returns a path of nodes that support onion messages to the
+	// target node. The path starts at one of our peers and ends with the
+	// target.
+	FindPath func(target route.Vertex) ([]*btcec.PublicKey, error)
+
+	// AddInvoice adds an invoice for the given amount and description to
+	// the invoice registry, which can be paid along the returned blinded
+	// paths to our node.
+	AddInvoice func(amt lnwire.MilliSatoshi, memo string,
+		expiry time.Duration) (lntypes.Hash,
+		[]*zpay32.BlindedPaymentPath, error)
+
+	// IsInvoicePending returns true if the invoice with the given payment
+	// hash can still be paid, i.e. it was neither settled nor canceled.
+	IsInvoicePending func(hash lntypes.Hash) (bool, error)
+
+	// MaxPendingInvoices is the maximum number of unpaid invoices that we
+	// keep for each of our offers. Further invoice requests for the offer
+	// are refused until one of its invoices is paid, canceled or expires.
+	// If zero, the number of invoices is unlimited.
+	MaxPendingInvoices int
+
+	// SendPayment pays the given amount to one of the blinded paths,
+	// spending at most the fee limit on routing fees. It returns the
+	// preimage of the payment hash once the payment succeeds.
+	SendPayment func(hash lntypes.Hash, amt lnwire.MilliSatoshi,
+		paths []*routing.BlindedPayment,
+		feeLimit lnwire.MilliSatoshi) (lntypes.Preimage, error)
+
+	// Clock is the clock that is used to check and set expiry times.
+	Clock clock.Clock
+}
+
+// Manager creates offers, replies to the invoice requests for our offers
+// and fetches invoices for the offers that we pay.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// offerKey is the secret that we use to authenticate our offers.
+	offerKey [32]byte
+
+	// pendingMtx guards pendingRequests.
+	pendingMtx sync.Mutex
+
+	// pendingRequests holds the invoice requests that wait for a reply,
+	// keyed by the path id of the reply path that we included.
+	pendingRequests map[string]chan *onionmessage.ReceivedMessage
+
+	// invoicesMtx guards pendingInvoices.
+	invoicesMtx sync.Mutex
+
+	// pendingInvoices holds the unpaid invoices that we created for each
+	// of our offers, keyed by the metadata of the offer.
+	pendingInvoices map[string][]*pendingInvoice
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new offers manager from the given config.
+func New(cfg *Config) (*Manager, error) {
+	offerKey, err := deriveOfferKey(cfg.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manager{
+		cfg:      cfg,
+		offerKey: offerKey,
+		pendingRequests: make(
+			map[string]chan *onionmessage.ReceivedMessage,
+		),
+		pendingInvoices: make(map[string][]*pendingInvoice),
+		quit:            make(chan struct{}),
+	}, nil
+}
+
+// Start starts the manager, which begins to handle the onion messages that
+// are addressed to us.
+func (m *Manager) Start() error {
+	var startErr error
+	m.started.Do(func() {
+		log.Info("Offers manager starting")
+
+		sub, err := m.cfg.Messenger.SubscribeMessages()
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		m.wg.Add(1)
+		go m.messageHandler(sub.Updates(), sub.Cancel)
+	})
+
+	return startErr
+}
+
+// Stop stops the manager.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Offers manager shutting down...")
+		defer log.Debug("Offers manager shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+
+	return nil
+}
+
+// messageHandler dispatches the onion messages that relate to offers.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) messageHandler(updates <-chan interface{},
+	cancel func()) {
+
+	defer m.wg.Done()
+	defer cancel()
+
+	for {
+		select {
+		case update := <-updates:
+			msg, ok := update.(*onionmessage.ReceivedMessage)
+			if !ok {
+				continue
+			}
+
+			m.handleMessage(msg)
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleMessage handles a single onion message. Invoice requests are
+// answered, while invoices and errors are delivered to the request that is
+// waiting for them.
+func (m *Manager) handleMessage(msg *onionmessage.ReceivedMessage) {
+	if req, ok := msg.FinalHopTLVs[InvoiceRequestType]; ok {
+		m.wg.Add(1)
+		go m.handleInvoiceRequest(req, msg)
+
+		return
+	}
+
+	_, isInvoice := msg.FinalHopTLVs[InvoiceType]
+	_, isError := msg.FinalHopTLVs[InvoiceErrorType]
+	if !isInvoice && !isError {
+		return
+	}
+
+	m.pendingMtx.Lock()
+	reply, ok := m.pendingRequests[string(msg.PathID)]
+	if ok {
+		delete(m.pendingRequests, string(msg.PathID))
+	}
+	m.pendingMtx.Unlock()
+
+	if !ok {
+		log.Debugf("Ignoring invoice reply with unknown path id %v",
+			hex.EncodeToString(msg.PathID))
+		return
+	}
+
+	// The channel is buffered, so delivering the reply never blocks.
+	reply <- msg
+}
+
+// addPendingRequest registers a request that waits for a reply sent along
+// the reply path with the given path id.
+func (m *Manager) addPendingRequest(
+	pathID []byte) chan *onionmessage.ReceivedMessage {
+
+	reply := make(chan *onionmessage.ReceivedMessage, 1)
+
+	m.pendingMtx.Lock()
+	m.pendingRequests[string(pathID)] = reply
+	m.pendingMtx.Unlock()
+
+	return reply
+}
+
+// removePendingRequest removes a request that no longer waits for a reply.
+func (m *Manager) removePendingRequest(pathID []byte) {
+	m.pendingMtx.Lock()
+	delete(m.pendingRequests, string(pathID))
+	m.pendingMtx.Unlock()
+}
+
+// nodeID returns the public key of our node.
+func (m *Manager) nodeID() *btcec.PublicKey {
+	return m.cfg.NodeKey.PubKey()
+}
+
+// isOurNode returns true if the key is the key of our node.
+func (m *Manager) isOurNode(key *btcec.PublicKey) bool {
+	return key != nil && key.IsEqual(m.nodeID())
+}
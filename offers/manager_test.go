@@ -0,0 +1,382 @@
+package offers
+
+import (
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// testMaxInvoices is the number of unpaid invoices that the test nodes keep
+// for each of their offers.
+const testMaxInvoices = 2
+
+// testNode is a node of the test network, which creates and pays offers.
+type testNode struct {
+	key       *btcec.PrivateKey
+	messenger *onionmessage.Messenger
+	manager   *Manager
+
+	// paths holds the paths that are returned to the manager when it
+	// looks for a path to a node. If no path is set, the node is assumed
+	// to be our peer.
+	paths map[route.Vertex][]*btcec.PublicKey
+
+	// invoices holds the amount of the first invoice that was added.
+	invoices chan lnwire.MilliSatoshi
+
+	// payments holds the blinded paths of the payments that were sent.
+	payments chan []*routing.BlindedPayment
+
+	// clock is the clock of the manager.
+	clock *clock.TestClock
+
+	// resolvedMtx guards resolved.
+	resolvedMtx sync.Mutex
+
+	// resolved holds the payment hashes of the invoices that were paid
+	// or canceled.
+	resolved map[lntypes.Hash]bool
+}
+
+// resolveInvoice marks the invoice with the given payment hash as paid.
+func (n *testNode) resolveInvoice(hash lntypes.Hash) {
+	n.resolvedMtx.Lock()
+	defer n.resolvedMtx.Unlock()
+
+	n.resolved[hash] = true
+}
+
+// testNetwork is a set of nodes that deliver onion messages directly to each
+// other.
+type testNetwork struct {
+	nodes map[route.Vertex]*testNode
+}
+
+// newTestNode creates a node with a fresh node key and adds it to the
+// network.
+func (n *testNetwork) newTestNode(t *testing.T) *testNode {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	node := &testNode{
+		key:      key,
+		paths:    make(map[route.Vertex][]*btcec.PublicKey),
+		invoices: make(chan lnwire.MilliSatoshi, 1),
+		payments: make(chan []*routing.BlindedPayment, 1),
+		clock:    clock.NewTestClock(time.Now()),
+		resolved: make(map[lntypes.Hash]bool),
+	}
+
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: key}
+	node.messenger = onionmessage.New(&onionmessage.Config{
+		NodeKey:     nodeKey,
+		ChainParams: &chaincfg.RegressionNetParams,
+		SendMessage: func(peer route.Vertex,
+			msg *lnwire.OnionMessage) error {
+
+			n.nodes[peer].messenger.HandleMessage(
+				route.NewVertex(key.PubKey()), msg,
+			)
+
+			return nil
+		},
+		MessageRate:  onionmessage.DefaultMessageRate,
+		MessageBurst: onionmessage.DefaultMessageBurst,
+	})
+	require.NoError(t, node.messenger.Start())
+	t.Cleanup(func() {
+		require.NoError(t, node.messenger.Stop())
+	})
+
+	node.manager, err = New(&Config{
+		NodeKey:     nodeKey,
+		Signer:      &mock.SecretKeyRing{RootKey: key},
+		ChainParams: &chaincfg.RegressionNetParams,
+		Messenger:   node.messenger,
+		FindPath: func(target route.Vertex) ([]*btcec.PublicKey,
+			error) {
+
+			if path, ok := node.paths[target]; ok {
+				return path, nil
+			}
+
+			return []*btcec.PublicKey{
+				n.nodes[target].key.PubKey(),
+			}, nil
+		},
+		AddInvoice: func(amt lnwire.MilliSatoshi, _ string,
+			_ time.Duration) (lntypes.Hash,
+			[]*zpay32.BlindedPaymentPath, error) {
+
+			// Only the first invoice is reported, later ones
+			// are dropped once nobody reads them.
+			select {
+			case node.invoices <- amt:
+			default:
+			}
+
+			var hash lntypes.Hash
+			_, err := rand.Read(hash[:])
+			require.NoError(t, err)
+
+			return hash, []*zpay32.BlindedPaymentPath{
+				newTestPaymentPath(t, key.PubKey()),
+			}, nil
+		},
+		IsInvoicePending: func(hash lntypes.Hash) (bool, error) {
+			node.resolvedMtx.Lock()
+			defer node.resolvedMtx.Unlock()
+
+			return !node.resolved[hash], nil
+		},
+		MaxPendingInvoices: testMaxInvoices,
+		SendPayment: func(_ lntypes.Hash, _ lnwire.MilliSatoshi,
+			paths []*routing.BlindedPayment,
+			_ lnwire.MilliSatoshi) (lntypes.Preimage, error) {
+
+			node.payments <- paths
+
+			return lntypes.Preimage{1}, nil
+		},
+		Clock: node.clock,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, node.manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, node.manager.Stop())
+	})
+
+	n.nodes[route.NewVertex(key.PubKey())] = node
+
+	return node
+}
+
+// newTestPaymentPath creates a blinded payment path that ends at the given
+// node.
+func newTestPaymentPath(t *testing.T,
+	node *btcec.PublicKey) *zpay32.BlindedPaymentPath {
+
+	t.Helper()
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := sphinx.BuildBlindedPath(sessionKey, []*sphinx.HopInfo{
+		{
+			NodePub:   node,
+			PlainText: []byte{1, 2, 3},
+		},
+	})
+	require.NoError(t, err)
+
+	return &zpay32.BlindedPaymentPath{
+		FeeBaseMsat:     1000,
+		FeeRate:         10,
+		CltvExpiryDelta: 80,
+		HTLCMinMsat:     1,
+		HTLCMaxMsat:     1_000_000_000,
+		Features:        lnwire.EmptyFeatureVector(),
+		BlindedPath:     path,
+	}
+}
+
+// TestFetchAndPayInvoice tests that an invoice is fetched for an offer over
+// onion messages and paid along the invoice's blinded paths.
+func TestFetchAndPayInvoice(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{nodes: make(map[route.Vertex]*testNode)}
+	alice := network.newTestNode(t)
+	bob := network.newTestNode(t)
+	carol := network.newTestNode(t)
+
+	offer, err := bob.manager.CreateOffer(&OfferParams{
+		Amount:      10_000,
+		Description: "coffee",
+		Expiry:      time.Hour,
+		HasQuantity: true,
+		QuantityMax: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte(chaincfg.RegressionNetParams.GenesisHash[:]),
+		offer.Chains[0][:])
+
+	// The offer is sent to Alice in its encoded form.
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+	offer, err = bolt12.DecodeOffer(encoded)
+	require.NoError(t, err)
+
+	// Alice isn't connected to Bob, so she sends her request through
+	// Carol.
+	bobVertex := route.NewVertex(bob.key.PubKey())
+	alice.paths[bobVertex] = []*btcec.PublicKey{
+		carol.key.PubKey(), bob.key.PubKey(),
+	}
+
+	invoice, err := alice.manager.FetchInvoice(&FetchRequest{
+		Offer:     offer,
+		Quantity:  2,
+		PayerNote: "no sugar",
+	})
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(20_000), invoice.Amount)
+	require.Equal(t, "no sugar", invoice.Request.PayerNote)
+	require.True(t, invoice.NodeID.IsEqual(bob.key.PubKey()))
+	require.Len(t, invoice.Paths, 1)
+
+	select {
+	case amt := <-bob.invoices:
+		require.Equal(t, lnwire.MilliSatoshi(20_000), amt)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("invoice not added")
+	}
+
+	preimage, err := alice.manager.PayInvoice(invoice, 1000)
+	require.NoError(t, err)
+	require.Equal(t, lntypes.Preimage{1}, preimage)
+
+	paths := <-alice.payments
+	require.Len(t, paths, 1)
+	require.Equal(t, uint32(1000), paths[0].BaseFee)
+	require.Equal(t, uint16(80), paths[0].CltvExpiryDelta)
+	require.Equal(
+		t, invoice.Paths[0].Path.BlindingPoint,
+		paths[0].BlindedPath.BlindingPoint,
+	)
+}
+
+// TestInvoiceRequestErrors tests that invalid invoice requests are answered
+// with an invoice error, and that requests for offers that we didn't create
+// are ignored.
+func TestInvoiceRequestErrors(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{nodes: make(map[route.Vertex]*testNode)}
+	alice := network.newTestNode(t)
+	bob := network.newTestNode(t)
+
+	offer, err := bob.manager.CreateOffer(&OfferParams{
+		Amount:      10_000,
+		Description: "coffee",
+		HasQuantity: true,
+		QuantityMax: 5,
+	})
+	require.NoError(t, err)
+
+	// Alice checks the quantity before she sends her request, so we
+	// remove the maximum from her copy of the offer. Bob still refuses
+	// the request, as the offer no longer matches its metadata.
+	forged := *offer
+	forged.QuantityMax = 0
+	_, err = alice.manager.FetchInvoice(&FetchRequest{
+		Offer:    &forged,
+		Quantity: 10,
+		Timeout:  500 * time.Millisecond,
+	})
+	require.ErrorIs(t, err, ErrFetchTimeout)
+
+	// An amount that is below the offer's amount is answered with an
+	// invoice error.
+	_, err = alice.manager.FetchInvoice(&FetchRequest{
+		Offer:    offer,
+		Amount:   15_000,
+		Quantity: 2,
+	})
+	var invoiceErr *bolt12.InvoiceError
+	require.ErrorAs(t, err, &invoiceErr)
+	require.Contains(t, invoiceErr.Message, "below offer amount")
+
+	// Requests that we can't pay are refused before they are sent.
+	_, err = alice.manager.FetchInvoice(&FetchRequest{
+		Offer:    offer,
+		Quantity: 6,
+	})
+	require.ErrorContains(t, err, "quantity exceeds maximum")
+}
+
+// TestPendingInvoiceLimit tests that the number of unpaid invoices of an
+// offer is limited, and that invoices that were paid or expired no longer
+// count.
+func TestPendingInvoiceLimit(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{nodes: make(map[route.Vertex]*testNode)}
+	alice := network.newTestNode(t)
+	bob := network.newTestNode(t)
+
+	offer, err := bob.manager.CreateOffer(&OfferParams{
+		Amount:      10_000,
+		Description: "coffee",
+	})
+	require.NoError(t, err)
+
+	fetch := func() (*bolt12.Invoice, error) {
+		return alice.manager.FetchInvoice(&FetchRequest{
+			Offer: offer,
+		})
+	}
+
+	var invoices []*bolt12.Invoice
+	for i := 0; i < testMaxInvoices; i++ {
+		invoice, err := fetch()
+		require.NoError(t, err)
+
+		invoices = append(invoices, invoice)
+	}
+
+	// All slots of the offer are taken, so the request is refused.
+	_, err = fetch()
+	var invoiceErr *bolt12.InvoiceError
+	require.ErrorAs(t, err, &invoiceErr)
+	require.Contains(t, invoiceErr.Message, "too many pending invoices")
+
+	// Other offers are not affected.
+	other, err := bob.manager.CreateOffer(&OfferParams{
+		Amount:      10_000,
+		Description: "tea",
+	})
+	require.NoError(t, err)
+
+	_, err = alice.manager.FetchInvoice(&FetchRequest{Offer: other})
+	require.NoError(t, err)
+
+	// Once an invoice is paid, its slot is free again.
+	bob.resolveInvoice(invoices[0].PaymentHash)
+
+	_, err = fetch()
+	require.NoError(t, err)
+
+	_, err = fetch()
+	require.ErrorAs(t, err, &invoiceErr)
+
+	// Once the invoices expire, all slots are free again.
+	bob.clock.SetTime(
+		bob.clock.Now().Add(bolt12.DefaultInvoiceExpiry),
+	)
+
+	for i := 0; i < testMaxInvoices; i++ {
+		_, err = fetch()
+		require.NoError(t, err)
+	}
+}
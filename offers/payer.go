@@ -0,0 +1,307 @@
+package offers
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// payerMetadataSize is the size of the random metadata that we
+	// include in our invoice requests.
+	payerMetadataSize = 32
+
+	// replyPathIDSize is the size of the random path id of the reply
+	// paths of our invoice requests.
+	replyPathIDSize = 32
+)
+
+var (
+	// ErrFetchTimeout is returned when the issuer of an offer doesn't
+	// reply to our invoice request in time.
+	ErrFetchTimeout = errors.New("timed out waiting for invoice")
+)
+
+// FetchRequest describes an invoice that we request for an offer.
+type FetchRequest struct {
+	// Offer is the offer that we request an invoice for.
+	Offer *bolt12.Offer
+
+	// Amount is the amount that we want to pay. It must be set for offers
+	// without an amount, and may be larger than the offer's amount.
+	Amount lnwire.MilliSatoshi
+
+	// Quantity is the number of items that we want to pay for. It must be
+	// set for offers that have a quantity.
+	Quantity uint64
+
+	// PayerNote is an optional note to the issuer of the offer.
+	PayerNote string
+
+	// Timeout is the time that we wait for the issuer to reply. If zero,
+	// DefaultFetchTimeout is used.
+	Timeout time.Duration
+}
+
+// checkOffer checks that we're able to pay the offer.
+func (m *Manager) checkOffer(req *FetchRequest) error {
+	offer := req.Offer
+
+	switch {
+	case !offer.SupportsChain(m.cfg.ChainParams):
+		return errors.New("offer is not valid on our chain")
+
+	case offer.IsExpired(m.cfg.Clock.Now()):
+		return bolt12.ErrOfferExpired
+
+	case offer.Currency != "":
+		return bolt12.ErrUnsupportedCurrency
+
+	case offer.Amount == 0 && req.Amount == 0:
+		return errors.New("amount is required for offers without " +
+			"an amount")
+
+	case offer.HasQuantity && req.Quantity == 0:
+		return errors.New("quantity is required")
+
+	case offer.HasQuantity && offer.QuantityMax != 0 &&
+		req.Quantity > offer.QuantityMax:
+
+		return fmt.Errorf("quantity exceeds maximum of %d",
+			offer.QuantityMax)
+
+	case !offer.HasQuantity && req.Quantity != 0:
+		return errors.New("offer doesn't support a quantity")
+	}
+
+	return nil
+}
+
+// FetchInvoice requests an invoice for an offer from its issuer over onion
+// messages, and waits for the issuer to reply.
+func (m *Manager) FetchInvoice(req *FetchRequest) (*bolt12.Invoice, error) {
+	if err := m.checkOffer(req); err != nil {
+		return nil, err
+	}
+
+	// We sign the request with a fresh key, so that our payments can't be
+	// linked to each other or to our node.
+	payerKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]byte, payerMetadataSize)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	invReq := &bolt12.InvoiceRequest{
+		Offer:     req.Offer,
+		Metadata:  metadata,
+		Amount:    req.Amount,
+		Features:  lnwire.NewRawFeatureVector(),
+		Quantity:  req.Quantity,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: req.PayerNote,
+	}
+
+	genesis := *m.cfg.ChainParams.GenesisHash
+	if genesis != *chaincfg.MainNetParams.GenesisHash {
+		invReq.Chain = &genesis
+	}
+
+	if err := invReq.Sign(bolt12.PrivKeySigner(payerKey)); err != nil {
+		return nil, err
+	}
+
+	b, err := invReq.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	pathID := make([]byte, replyPathIDSize)
+	if _, err := rand.Read(pathID); err != nil {
+		return nil, err
+	}
+
+	replies := m.addPendingRequest(pathID)
+	defer m.removePendingRequest(pathID)
+
+	signingKey, err := m.sendInvoiceRequest(req.Offer, pathID, b)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := req.Timeout
+	if timeout == 0 {
+		timeout = DefaultFetchTimeout
+	}
+
+	var reply *onionmessage.ReceivedMessage
+	select {
+	case reply = <-replies:
+
+	case <-time.After(timeout):
+		return nil, ErrFetchTimeout
+
+	case <-m.quit:
+		return nil, ErrManagerShuttingDown
+	}
+
+	if b, ok := reply.FinalHopTLVs[InvoiceErrorType]; ok {
+		invoiceErr, err := bolt12.ParseInvoiceError(b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice error: %w", err)
+		}
+
+		return nil, invoiceErr
+	}
+
+	invoice, err := bolt12.ParseInvoice(reply.FinalHopTLVs[InvoiceType])
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice: %w", err)
+	}
+
+	if err := m.checkInvoice(invoice, invReq, signingKey); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// sendInvoiceRequest sends the invoice request to the issuer of the offer,
+// along with a reply path to our node that ends with the given path id. It
+// returns the key that the issuer must sign the invoice with.
+func (m *Manager) sendInvoiceRequest(offer *bolt12.Offer, pathID,
+	invReq []byte) (*btcec.PublicKey, error) {
+
+	var (
+		dest       *sphinx.BlindedPath
+		target     *btcec.PublicKey
+		signingKey = offer.IssuerID
+	)
+	if len(offer.Paths) > 0 {
+		dest = offer.Paths[0]
+		target = dest.IntroductionPoint
+
+		// Without an issuer id, the invoice is signed with the key of
+		// the final hop of the path that the request was sent along.
+		if signingKey == nil {
+			finalHop := dest.BlindedHops[len(dest.BlindedHops)-1]
+			signingKey = finalHop.BlindedNodePub
+		}
+	} else {
+		target = offer.IssuerID
+	}
+
+	path, err := m.cfg.FindPath(route.NewVertex(target))
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuer replies along the path that the request took, with the
+	// nodes in reverse order. The last node of the path is the target
+	// itself, which doesn't need to be part of the reply path.
+	replyHops := make([]*btcec.PublicKey, 0, len(path))
+	for i := len(path) - 2; i >= 0; i-- {
+		replyHops = append(replyHops, path[i])
+	}
+
+	replyPath, err := m.cfg.Messenger.BuildReplyPath(replyHops, pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we send to a blinded path, its introduction node is reached
+	// through the blinded path itself.
+	if dest != nil {
+		path = path[:len(path)-1]
+	}
+
+	err = m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+		Path:               path,
+		BlindedDestination: dest,
+		ReplyPath:          replyPath,
+		FinalHopTLVs: map[tlv.Type][]byte{
+			InvoiceRequestType: invReq,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signingKey, nil
+}
+
+// checkInvoice checks that the invoice was created for our request by the
+// issuer of the offer.
+func (m *Manager) checkInvoice(invoice *bolt12.Invoice,
+	invReq *bolt12.InvoiceRequest, signingKey *btcec.PublicKey) error {
+
+	if err := invoice.MatchesRequest(invReq); err != nil {
+		return err
+	}
+
+	if !invoice.NodeID.IsEqual(signingKey) {
+		return errors.New("invoice is not signed by offer issuer")
+	}
+
+	if invoice.IsExpired(m.cfg.Clock.Now()) {
+		return bolt12.ErrInvoiceExpired
+	}
+
+	amt, err := invReq.AmountToPay()
+	if err != nil {
+		return err
+	}
+
+	if invoice.Amount != amt {
+		return fmt.Errorf("invoice amount %v doesn't match requested "+
+			"amount %v", invoice.Amount, amt)
+	}
+
+	return nil
+}
+
+// PayInvoice pays an invoice that was fetched for an offer, spending at most
+// the fee limit on routing fees. It returns the preimage of the payment.
+func (m *Manager) PayInvoice(invoice *bolt12.Invoice,
+	feeLimit lnwire.MilliSatoshi) (lntypes.Preimage, error) {
+
+	if invoice.IsExpired(m.cfg.Clock.Now()) {
+		return lntypes.Preimage{}, bolt12.ErrInvoiceExpired
+	}
+
+	paths := make([]*routing.BlindedPayment, len(invoice.Paths))
+	for i, path := range invoice.Paths {
+		info := path.PayInfo
+		paths[i] = &routing.BlindedPayment{
+			BlindedPath:     path.Path,
+			BaseFee:         info.FeeBaseMsat,
+			ProportionalFee: info.FeeProportionalMillionths,
+			CltvExpiryDelta: info.CltvExpiryDelta,
+			HtlcMinimum:     info.HtlcMinimumMsat,
+			HtlcMaximum:     info.HtlcMaximumMsat,
+			Features: lnwire.NewFeatureVector(
+				info.Features, lnwire.Features,
+			),
+		}
+	}
+
+	return m.cfg.SendPayment(
+		invoice.PaymentHash, invoice.Amount, paths, feeLimit,
+	)
+}
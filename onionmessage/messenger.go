@@ -0,0 +1,602 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultMessageRate is the default number of onion messages per
+	// second that we accept from a single peer.
+	DefaultMessageRate = rate.Limit(10)
+
+	// DefaultMessageBurst is the default number of onion messages that a
+	// single peer may send us in a burst before being rate limited.
+	DefaultMessageBurst = 50
+
+	// onionPacketSize is the size of the onion packets that we create and
+	// accept. The protocol allows larger packets, but we only support
+	// those that have the same size as the onion of an HTLC.
+	onionPacketSize = 1 + btcec.PubKeyBytesLenCompressed +
+		sphinx.MaxPayloadSize + sha256HMACSize
+
+	// sha256HMACSize is the size of the HMAC at the end of an onion
+	// packet.
+	sha256HMACSize = 32
+
+	// incomingQueueSize is the number of incoming onion messages that can
+	// be waiting to be processed. Messages that arrive while the queue is
+	// full are dropped.
+	incomingQueueSize = 100
+)
+
+var (
+	// ErrNoPath is returned when an onion message is sent without any
+	// hops to send it along.
+	ErrNoPath = errors.New("onion message requires a path or a blinded " +
+		"destination")
+
+	// ErrPathTooLong is returned when an onion message is sent along a
+	// path that has more hops than fit into an onion packet.
+	ErrPathTooLong = fmt.Errorf("onion message path exceeds %d hops",
+		sphinx.NumMaxHops)
+
+	// ErrFirstHopSelf is returned when the first hop of an onion message
+	// is our own node.
+	ErrFirstHopSelf = errors.New("first hop of onion message can't be " +
+		"our own node")
+)
+
+// Config houses the functionality that the Messenger needs to relay, send and
+// receive onion messages.
+type Config struct {
+	// NodeKey is the private key of our node, which is used to decrypt
+	// the onion messages that are sent to us.
+	NodeKey keychain.SingleKeyECDH
+
+	// ChainParams are the parameters of the chain that we're running on.
+	ChainParams *chaincfg.Params
+
+	// SendMessage delivers an onion message to one of our peers. An error
+	// is returned if we aren't connected to the peer, or the peer doesn't
+	// support onion messages.
+	SendMessage func(peer route.Vertex, msg *lnwire.OnionMessage) error
+
+	// FetchChannelPeer returns the node on the other end of the channel
+	// with the given short channel id.
+	FetchChannelPeer func(scid lnwire.ShortChannelID) (route.Vertex,
+		error)
+
+	// MessageRate is the number of onion messages per second that we
+	// accept from a single peer.
+	MessageRate rate.Limit
+
+	// MessageBurst is the number of onion messages that a peer may send
+	// in a burst before being rate limited.
+	MessageBurst int
+}
+
+// SendRequest describes an onion message that we send.
+type SendRequest struct {
+	// Path is the list of nodes that the message travels through. The
+	// first node must be one of our peers. If no blinded destination is
+	// set, the last node is the recipient of the message.
+	Path []*btcec.PublicKey
+
+	// BlindedDestination is an optional blinded path to the recipient of
+	// the message. If set, the message travels through the nodes in Path
+	// to the introduction node of the blinded path. Path may be empty if
+	// the introduction node is one of our peers.
+	BlindedDestination *sphinx.BlindedPath
+
+	// ReplyPath is an optional blinded path that the recipient can use to
+	// reply to the message.
+	ReplyPath *sphinx.BlindedPath
+
+	// FinalHopTLVs is the set of application level records that are
+	// delivered to the recipient.
+	FinalHopTLVs map[tlv.Type][]byte
+}
+
+// ReceivedMessage is an onion message that was addressed to our node.
+type ReceivedMessage struct {
+	// PathID is the path id that we included for ourselves in the blinded
+	// path that the message was sent along, if any.
+	PathID []byte
+
+	// ReplyPath is the blinded path that the sender included to let us
+	// reply to the message, if any.
+	ReplyPath *sphinx.BlindedPath
+
+	// FinalHopTLVs is the set of application level records that were
+	// included for us.
+	FinalHopTLVs map[tlv.Type][]byte
+}
+
+// incomingMessage is an onion message that is waiting to be processed.
+type incomingMessage struct {
+	peer route.Vertex
+	msg  *lnwire.OnionMessage
+}
+
+// Messenger is a subsystem that relays onion messages on behalf of our peers,
+// sends onion messages on behalf of the user and delivers the ones addressed
+// to us to its subscribers.
+type Messenger struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// router is used to peel our layer off the onion of incoming
+	// messages.
+	router *sphinx.Router
+
+	// ourPubKey is the public key of our node.
+	ourPubKey route.Vertex
+
+	// limiters holds a rate limiter for each of the peers that has sent
+	// us onion messages.
+	limiters   map[route.Vertex]*rate.Limiter
+	limiterMtx sync.Mutex
+
+	// incoming is the queue of onion messages that are waiting to be
+	// processed.
+	incoming chan *incomingMessage
+
+	ntfnServer *subscribe.Server
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new Messenger from the passed config.
+func New(cfg *Config) *Messenger {
+	return &Messenger{
+		cfg: cfg,
+		// Onion messages aren't tied to any payment, so we don't need
+		// to keep a replay log for them.
+		router: sphinx.NewRouter(
+			cfg.NodeKey, cfg.ChainParams, nil,
+		),
+		ourPubKey:  route.NewVertex(cfg.NodeKey.PubKey()),
+		limiters:   make(map[route.Vertex]*rate.Limiter),
+		incoming:   make(chan *incomingMessage, incomingQueueSize),
+		ntfnServer: subscribe.NewServer(),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start starts the Messenger's subscription server and the goroutine that
+// processes incoming onion messages.
+func (m *Messenger) Start() error {
+	var err error
+
+	m.started.Do(func() {
+		log.Info("Onion messenger starting")
+
+		err = m.ntfnServer.Start()
+		if err != nil {
+			return
+		}
+
+		m.wg.Add(1)
+		go m.messageHandler()
+	})
+
+	return err
+}
+
+// Stop signals the Messenger for a graceful shutdown.
+func (m *Messenger) Stop() error {
+	var err error
+
+	m.stopped.Do(func() {
+		log.Info("Onion messenger shutting down...")
+		defer log.Debug("Onion messenger shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		err = m.ntfnServer.Stop()
+	})
+
+	return err
+}
+
+// SubscribeMessages returns a subscribe.Client that will receive a
+// ReceivedMessage for every onion message that is addressed to our node.
+func (m *Messenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// HandleMessage queues an onion message that was received from one of our
+// peers for processing. Messages are dropped if the peer exceeds its rate
+// limit, or if too many messages are waiting to be processed.
+func (m *Messenger) HandleMessage(peer route.Vertex,
+	msg *lnwire.OnionMessage) {
+
+	if !m.peerLimiter(peer).Allow() {
+		log.Debugf("Dropping onion message from peer %v: rate limit "+
+			"exceeded", peer)
+
+		return
+	}
+
+	select {
+	case m.incoming <- &incomingMessage{peer: peer, msg: msg}:
+
+	case <-m.quit:
+
+	default:
+		log.Debugf("Dropping onion message from peer %v: queue full",
+			peer)
+	}
+}
+
+// RemovePeer removes the rate limiter of a peer that disconnected from us.
+func (m *Messenger) RemovePeer(peer route.Vertex) {
+	m.limiterMtx.Lock()
+	defer m.limiterMtx.Unlock()
+
+	delete(m.limiters, peer)
+}
+
+// peerLimiter returns the rate limiter of a peer, creating it if the peer
+// hasn't sent us any onion messages yet.
+func (m *Messenger) peerLimiter(peer route.Vertex) *rate.Limiter {
+	m.limiterMtx.Lock()
+	defer m.limiterMtx.Unlock()
+
+	limiter, ok := m.limiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(m.cfg.MessageRate, m.cfg.MessageBurst)
+		m.limiters[peer] = limiter
+	}
+
+	return limiter
+}
+
+// messageHandler processes the incoming onion messages.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Messenger) messageHandler() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case incoming := <-m.incoming:
+			err := m.processMessage(incoming.msg)
+			if err != nil {
+				log.Debugf("Unable to process onion message "+
+					"from peer %v: %v", incoming.peer, err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// processMessage peels our layer off an incoming onion message and either
+// relays it to the next hop, or delivers it to our subscribers if we're the
+// final hop.
+func (m *Messenger) processMessage(msg *lnwire.OnionMessage) error {
+	if len(msg.OnionBlob) != onionPacketSize {
+		return fmt.Errorf("unsupported onion packet size: %d",
+			len(msg.OnionBlob))
+	}
+
+	var onionPkt sphinx.OnionPacket
+	err := onionPkt.Decode(bytes.NewReader(msg.OnionBlob))
+	if err != nil {
+		return fmt.Errorf("unable to decode onion packet: %w", err)
+	}
+
+	pathKey := msg.PathKey
+
+	// A blinded path may route the message through our own node several
+	// times, for example to add dummy hops at the end of a path to us, so
+	// we keep peeling layers off the onion until the next hop is another
+	// node or we reach the final hop.
+	for {
+		// We don't keep a replay log for onion messages, so we process
+		// the packet without consulting one.
+		packet, err := m.router.ReconstructOnionPacket(
+			&onionPkt, nil, sphinx.WithBlindingPoint(pathKey),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to process onion packet: %w",
+				err)
+		}
+
+		payload, err := DecodePayload(
+			bytes.NewReader(packet.Payload.Payload),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode payload: %w", err)
+		}
+
+		if len(payload.EncryptedData) == 0 {
+			return ErrNoEncryptedData
+		}
+
+		plainText, err := m.router.DecryptBlindedHopData(
+			pathKey, payload.EncryptedData,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt route data: %w",
+				err)
+		}
+
+		routeData, err := record.DecodeBlindedRouteData(
+			bytes.NewReader(plainText),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode route data: %w",
+				err)
+		}
+
+		if packet.Action == sphinx.ExitNode {
+			if !routeData.IsFinal() {
+				return errors.New("route data of final hop " +
+					"has a next hop")
+			}
+
+			return m.ntfnServer.SendUpdate(&ReceivedMessage{
+				PathID:       routeData.PathID,
+				ReplyPath:    payload.ReplyPath,
+				FinalHopTLVs: payload.FinalHopTLVs,
+			})
+		}
+
+		if routeData.IsFinal() {
+			return errors.New("route data of relaying hop has no " +
+				"next hop")
+		}
+
+		if !payload.IsEmpty() {
+			return errors.New("payload of relaying hop has final " +
+				"hop records")
+		}
+
+		nextPathKey := routeData.NextBlindingOverride
+		if nextPathKey == nil {
+			nextPathKey, err = m.router.NextEphemeral(pathKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		nextPeer, err := m.nextPeer(routeData)
+		if err != nil {
+			return err
+		}
+
+		if nextPeer != m.ourPubKey {
+			var b bytes.Buffer
+			if err := packet.NextPacket.Encode(&b); err != nil {
+				return err
+			}
+
+			log.Tracef("Relaying onion message to peer %v",
+				nextPeer)
+
+			return m.cfg.SendMessage(
+				nextPeer, lnwire.NewOnionMessage(
+					nextPathKey, b.Bytes(),
+				),
+			)
+		}
+
+		onionPkt = *packet.NextPacket
+		pathKey = nextPathKey
+	}
+}
+
+// nextPeer returns the node that the route data of a relaying hop points to.
+func (m *Messenger) nextPeer(
+	routeData *record.BlindedRouteData) (route.Vertex, error) {
+
+	if routeData.NextNodeID != nil {
+		return route.NewVertex(routeData.NextNodeID), nil
+	}
+
+	peer, err := m.cfg.FetchChannelPeer(*routeData.ShortChannelID)
+	if err != nil {
+		return route.Vertex{}, fmt.Errorf("unable to find peer for "+
+			"channel %v: %w", routeData.ShortChannelID, err)
+	}
+
+	return peer, nil
+}
+
+// SendMessage creates an onion message as described by the request and sends
+// it to the first hop.
+func (m *Messenger) SendMessage(req *SendRequest) error {
+	firstHop, msg, err := m.createMessage(req)
+	if err != nil {
+		return err
+	}
+
+	if firstHop == m.ourPubKey {
+		return ErrFirstHopSelf
+	}
+
+	return m.cfg.SendMessage(firstHop, msg)
+}
+
+// createMessage creates the onion message that is described by the request
+// and returns it along with the node that it must be sent to.
+func (m *Messenger) createMessage(req *SendRequest) (route.Vertex,
+	*lnwire.OnionMessage, error) {
+
+	dest := req.BlindedDestination
+	if len(req.Path) == 0 && dest == nil {
+		return route.Vertex{}, nil, ErrNoPath
+	}
+
+	var (
+		firstHop    *btcec.PublicKey
+		pathKey     *btcec.PublicKey
+		blindedHops []*sphinx.BlindedHopInfo
+	)
+
+	// The nodes in the path don't know each other's identity from a
+	// blinded path, so we blind them ourselves and join the result with
+	// the blinded destination, if any.
+	if len(req.Path) > 0 {
+		hops := make([]*sphinx.HopInfo, len(req.Path))
+		for i, node := range req.Path {
+			var routeData record.BlindedRouteData
+			switch {
+			case i < len(req.Path)-1:
+				routeData.NextNodeID = req.Path[i+1]
+
+			case dest != nil:
+				routeData.NextNodeID = dest.IntroductionPoint
+				routeData.NextBlindingOverride =
+					dest.BlindingPoint
+			}
+
+			plainText, err := record.EncodeBlindedRouteData(
+				&routeData,
+			)
+			if err != nil {
+				return route.Vertex{}, nil, err
+			}
+
+			hops[i] = &sphinx.HopInfo{
+				NodePub:   node,
+				PlainText: plainText,
+			}
+		}
+
+		path, err := buildBlindedPath(hops)
+		if err != nil {
+			return route.Vertex{}, nil, err
+		}
+
+		firstHop = req.Path[0]
+		pathKey = path.BlindingPoint
+		blindedHops = path.BlindedHops
+	} else {
+		firstHop = dest.IntroductionPoint
+		pathKey = dest.BlindingPoint
+	}
+
+	if dest != nil {
+		blindedHops = append(blindedHops, dest.BlindedHops...)
+	}
+
+	if len(blindedHops) > sphinx.NumMaxHops {
+		return route.Vertex{}, nil, ErrPathTooLong
+	}
+
+	var paymentPath sphinx.PaymentPath
+	for i, hop := range blindedHops {
+		payload := &Payload{
+			EncryptedData: hop.CipherText,
+		}
+		if i == len(blindedHops)-1 {
+			payload.ReplyPath = req.ReplyPath
+			payload.FinalHopTLVs = req.FinalHopTLVs
+		}
+
+		var b bytes.Buffer
+		if err := payload.Encode(&b); err != nil {
+			return route.Vertex{}, nil, err
+		}
+
+		hopPayload, err := sphinx.NewTLVHopPayload(b.Bytes())
+		if err != nil {
+			return route.Vertex{}, nil, err
+		}
+
+		paymentPath[i] = sphinx.OnionHop{
+			NodePub:    *hop.BlindedNodePub,
+			HopPayload: hopPayload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return route.Vertex{}, nil, err
+	}
+
+	onionPkt, err := sphinx.NewOnionPacket(
+		&paymentPath, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	if err != nil {
+		return route.Vertex{}, nil, err
+	}
+
+	var b bytes.Buffer
+	if err := onionPkt.Encode(&b); err != nil {
+		return route.Vertex{}, nil, err
+	}
+
+	return route.NewVertex(firstHop), lnwire.NewOnionMessage(
+		pathKey, b.Bytes(),
+	), nil
+}
+
+// BuildReplyPath creates a blinded path to our own node that travels through
+// the given nodes, the first of which is the introduction node of the path.
+// If no nodes are given, our own node is the introduction node. The path id
+// is included for ourselves, so that we can recognize messages that are sent
+// along the path.
+func (m *Messenger) BuildReplyPath(hops []*btcec.PublicKey,
+	pathID []byte) (*sphinx.BlindedPath, error) {
+
+	ourKey := m.cfg.NodeKey.PubKey()
+	nodes := append(append([]*btcec.PublicKey{}, hops...), ourKey)
+
+	hopInfo := make([]*sphinx.HopInfo, len(nodes))
+	for i, node := range nodes {
+		routeData := &record.BlindedRouteData{
+			PathID: pathID,
+		}
+		if i < len(nodes)-1 {
+			routeData = &record.BlindedRouteData{
+				NextNodeID: nodes[i+1],
+			}
+		}
+
+		plainText, err := record.EncodeBlindedRouteData(routeData)
+		if err != nil {
+			return nil, err
+		}
+
+		hopInfo[i] = &sphinx.HopInfo{
+			NodePub:   node,
+			PlainText: plainText,
+		}
+	}
+
+	return buildBlindedPath(hopInfo)
+}
+
+// buildBlindedPath creates a blinded path along the given hops using a fresh
+// session key.
+func buildBlindedPath(hops []*sphinx.HopInfo) (*sphinx.BlindedPath, error) {
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sphinx.BuildBlindedPath(sessionKey, hops)
+}
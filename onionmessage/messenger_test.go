@@ -0,0 +1,261 @@
+package onionmessage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// testNetwork is a set of messengers that deliver onion messages directly to
+// each other.
+type testNetwork struct {
+	messengers map[route.Vertex]*Messenger
+}
+
+// newTestMessenger creates a messenger with a fresh node key and adds it to
+// the network.
+func (n *testNetwork) newTestMessenger(t *testing.T, messageRate rate.Limit,
+	burst int) *Messenger {
+
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	m := New(&Config{
+		NodeKey:     &keychain.PrivKeyECDH{PrivKey: key},
+		ChainParams: &chaincfg.RegressionNetParams,
+		SendMessage: func(peer route.Vertex,
+			msg *lnwire.OnionMessage) error {
+
+			n.messengers[peer].HandleMessage(
+				route.NewVertex(key.PubKey()), msg,
+			)
+
+			return nil
+		},
+		MessageRate:  messageRate,
+		MessageBurst: burst,
+	})
+
+	require.NoError(t, m.Start())
+	t.Cleanup(func() {
+		require.NoError(t, m.Stop())
+	})
+
+	n.messengers[m.ourPubKey] = m
+
+	return m
+}
+
+// receiveMessage waits for the next message that is delivered to the
+// subscriber.
+func receiveMessage(t *testing.T,
+	updates <-chan interface{}) *ReceivedMessage {
+
+	t.Helper()
+
+	select {
+	case update := <-updates:
+		msg, ok := update.(*ReceivedMessage)
+		require.True(t, ok)
+
+		return msg
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("message not received")
+	}
+
+	return nil
+}
+
+// assertNoMessage asserts that no message is delivered to the subscriber.
+func assertNoMessage(t *testing.T, updates <-chan interface{}) {
+	t.Helper()
+
+	select {
+	case <-updates:
+		t.Fatalf("unexpected message received")
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestSendOnionMessage tests sending onion messages along a path of nodes
+// and to a blinded destination, and replying to them.
+func TestSendOnionMessage(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{
+		messengers: make(map[route.Vertex]*Messenger),
+	}
+	alice := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+	bob := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+	carol := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+
+	aliceSub, err := alice.SubscribeMessages()
+	require.NoError(t, err)
+	defer aliceSub.Cancel()
+
+	bobSub, err := bob.SubscribeMessages()
+	require.NoError(t, err)
+	defer bobSub.Cancel()
+
+	carolSub, err := carol.SubscribeMessages()
+	require.NoError(t, err)
+	defer carolSub.Cancel()
+
+	// Alice includes a reply path to herself through Bob.
+	pathID := bytes.Repeat([]byte{1}, 32)
+	replyPath, err := alice.BuildReplyPath(
+		[]*btcec.PublicKey{bob.cfg.NodeKey.PubKey()}, pathID,
+	)
+	require.NoError(t, err)
+
+	finalHopTLVs := map[tlv.Type][]byte{
+		64: {1, 2, 3},
+		67: {4},
+	}
+	err = alice.SendMessage(&SendRequest{
+		Path: []*btcec.PublicKey{
+			bob.cfg.NodeKey.PubKey(),
+			carol.cfg.NodeKey.PubKey(),
+		},
+		ReplyPath:    replyPath,
+		FinalHopTLVs: finalHopTLVs,
+	})
+	require.NoError(t, err)
+
+	// Only Carol receives the message, Bob just relays it.
+	received := receiveMessage(t, carolSub.Updates())
+	require.Empty(t, received.PathID)
+	require.Equal(t, finalHopTLVs, received.FinalHopTLVs)
+	require.NotNil(t, received.ReplyPath)
+	assertNoMessage(t, bobSub.Updates())
+
+	// Carol replies to Alice, who is able to recognize her path id.
+	reply := map[tlv.Type][]byte{
+		66: {5},
+	}
+	err = carol.SendMessage(&SendRequest{
+		BlindedDestination: received.ReplyPath,
+		FinalHopTLVs:       reply,
+	})
+	require.NoError(t, err)
+
+	received = receiveMessage(t, aliceSub.Updates())
+	require.Equal(t, pathID, received.PathID)
+	require.Equal(t, reply, received.FinalHopTLVs)
+	require.Nil(t, received.ReplyPath)
+
+	// Carol can also send to a blinded destination through nodes of her
+	// choosing, which she adds in front of the blinded path.
+	replyPath, err = alice.BuildReplyPath(nil, pathID)
+	require.NoError(t, err)
+
+	err = carol.SendMessage(&SendRequest{
+		Path: []*btcec.PublicKey{
+			bob.cfg.NodeKey.PubKey(),
+		},
+		BlindedDestination: replyPath,
+		FinalHopTLVs:       reply,
+	})
+	require.NoError(t, err)
+
+	received = receiveMessage(t, aliceSub.Updates())
+	require.Equal(t, pathID, received.PathID)
+	require.Equal(t, reply, received.FinalHopTLVs)
+	assertNoMessage(t, bobSub.Updates())
+}
+
+// TestSendOnionMessageErrors tests that invalid send requests are rejected.
+func TestSendOnionMessageErrors(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{
+		messengers: make(map[route.Vertex]*Messenger),
+	}
+	alice := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+	bob := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+
+	err := alice.SendMessage(&SendRequest{})
+	require.ErrorIs(t, err, ErrNoPath)
+
+	err = alice.SendMessage(&SendRequest{
+		Path: []*btcec.PublicKey{alice.cfg.NodeKey.PubKey()},
+	})
+	require.ErrorIs(t, err, ErrFirstHopSelf)
+
+	err = alice.SendMessage(&SendRequest{
+		Path: []*btcec.PublicKey{bob.cfg.NodeKey.PubKey()},
+		FinalHopTLVs: map[tlv.Type][]byte{
+			EncryptedDataType: {1},
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidFinalHopTLV)
+
+	path := make([]*btcec.PublicKey, 28)
+	for i := range path {
+		path[i] = bob.cfg.NodeKey.PubKey()
+	}
+	err = alice.SendMessage(&SendRequest{
+		Path: path,
+	})
+	require.ErrorIs(t, err, ErrPathTooLong)
+}
+
+// TestOnionMessageRateLimit tests that onion messages from a peer are dropped
+// once the peer exceeds its rate limit.
+func TestOnionMessageRateLimit(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{
+		messengers: make(map[route.Vertex]*Messenger),
+	}
+	alice := network.newTestMessenger(
+		t, DefaultMessageRate, DefaultMessageBurst,
+	)
+
+	// Bob's limiter is never refilled, so he only accepts a burst of two
+	// messages from each peer.
+	bob := network.newTestMessenger(t, 0, 2)
+
+	bobSub, err := bob.SubscribeMessages()
+	require.NoError(t, err)
+	defer bobSub.Cancel()
+
+	req := &SendRequest{
+		Path: []*btcec.PublicKey{bob.cfg.NodeKey.PubKey()},
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, alice.SendMessage(req))
+	}
+
+	receiveMessage(t, bobSub.Updates())
+	receiveMessage(t, bobSub.Updates())
+	assertNoMessage(t, bobSub.Updates())
+
+	// Once Alice disconnects, her rate limit is reset.
+	bob.RemovePeer(alice.ourPubKey)
+	require.NoError(t, alice.SendMessage(req))
+	receiveMessage(t, bobSub.Updates())
+}
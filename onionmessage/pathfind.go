@@ -0,0 +1,103 @@
+package onionmessage
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// DefaultMaxPathLength is the default maximum number of hops of the paths
+// that are found for onion messages.
+const DefaultMaxPathLength = 8
+
+// ErrNoPathFound is returned when there is no path of nodes that support
+// onion messages to the target node.
+var ErrNoPathFound = errors.New("unable to find a path for onion message")
+
+// Graph is the view of the channel graph that is used to find paths for
+// onion messages.
+type Graph interface {
+	// ForEachNeighbor calls the callback for every node that the given
+	// node has a channel with.
+	ForEachNeighbor(node route.Vertex,
+		cb func(neighbor route.Vertex) error) error
+
+	// SupportsOnionMessages returns true if the node advertises support
+	// for relaying onion messages.
+	SupportsOnionMessages(node route.Vertex) (bool, error)
+}
+
+// FindPath finds the shortest path of nodes that support onion messages from
+// the source to the target node. Unlike payments, onion messages don't pay
+// any fees, so all channels are considered equal. The returned path doesn't
+// include the source, but does include the target.
+func FindPath(graph Graph, source, target route.Vertex,
+	maxHops int) ([]route.Vertex, error) {
+
+	if source == target {
+		return nil, ErrNoPathFound
+	}
+
+	var (
+		parents = map[route.Vertex]route.Vertex{
+			source: source,
+		}
+		queue = []route.Vertex{source}
+		depth = 0
+	)
+	for len(queue) > 0 && depth < maxHops {
+		var next []route.Vertex
+		for _, node := range queue {
+			node := node
+
+			visit := func(neighbor route.Vertex) error {
+				if _, ok := parents[neighbor]; ok {
+					return nil
+				}
+
+				// The target doesn't need to relay the message,
+				// so we don't check its features.
+				if neighbor != target {
+					ok, err := graph.SupportsOnionMessages(
+						neighbor,
+					)
+					if err != nil || !ok {
+						return err
+					}
+				}
+
+				parents[neighbor] = node
+				next = append(next, neighbor)
+
+				return nil
+			}
+
+			err := graph.ForEachNeighbor(node, visit)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := parents[target]; ok {
+				return buildPath(parents, source, target), nil
+			}
+		}
+
+		queue = next
+		depth++
+	}
+
+	return nil, ErrNoPathFound
+}
+
+// buildPath walks back from the target to the source to build the path that
+// was found.
+func buildPath(parents map[route.Vertex]route.Vertex, source,
+	target route.Vertex) []route.Vertex {
+
+	var path []route.Vertex
+	for node := target; node != source; node = parents[node] {
+		path = append([]route.Vertex{node}, path...)
+	}
+
+	return path
+}
@@ -0,0 +1,88 @@
+package onionmessage
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// testGraph is a graph of nodes that are identified by a single byte.
+type testGraph struct {
+	edges       map[route.Vertex][]route.Vertex
+	unsupported map[route.Vertex]bool
+}
+
+// ForEachNeighbor calls the callback for every neighbor of the node.
+func (g *testGraph) ForEachNeighbor(node route.Vertex,
+	cb func(route.Vertex) error) error {
+
+	for _, neighbor := range g.edges[node] {
+		if err := cb(neighbor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SupportsOnionMessages returns true unless the node is marked as not
+// supporting onion messages.
+func (g *testGraph) SupportsOnionMessages(node route.Vertex) (bool, error) {
+	return !g.unsupported[node], nil
+}
+
+// addEdge adds a channel between the two nodes.
+func (g *testGraph) addEdge(a, b route.Vertex) {
+	g.edges[a] = append(g.edges[a], b)
+	g.edges[b] = append(g.edges[b], a)
+}
+
+// TestFindPath tests finding paths of nodes that support onion messages.
+func TestFindPath(t *testing.T) {
+	t.Parallel()
+
+	var (
+		alice = route.Vertex{1}
+		bob   = route.Vertex{2}
+		carol = route.Vertex{3}
+		dave  = route.Vertex{4}
+		eve   = route.Vertex{5}
+	)
+
+	// Alice can reach Eve through Bob and Dave, or through Carol. Carol
+	// doesn't support onion messages.
+	graph := &testGraph{
+		edges: make(map[route.Vertex][]route.Vertex),
+		unsupported: map[route.Vertex]bool{
+			carol: true,
+			eve:   true,
+		},
+	}
+	graph.addEdge(alice, bob)
+	graph.addEdge(alice, carol)
+	graph.addEdge(bob, dave)
+	graph.addEdge(carol, eve)
+	graph.addEdge(dave, eve)
+
+	path, err := FindPath(graph, alice, eve, DefaultMaxPathLength)
+	require.NoError(t, err)
+	require.Equal(t, []route.Vertex{bob, dave, eve}, path)
+
+	// Carol is a direct peer, so she is reachable even though she can't
+	// relay messages.
+	path, err = FindPath(graph, alice, carol, DefaultMaxPathLength)
+	require.NoError(t, err)
+	require.Equal(t, []route.Vertex{carol}, path)
+
+	// The path to Eve is too long for a limit of two hops.
+	_, err = FindPath(graph, alice, eve, 2)
+	require.ErrorIs(t, err, ErrNoPathFound)
+
+	// There is no path to ourselves or to unknown nodes.
+	_, err = FindPath(graph, alice, alice, DefaultMaxPathLength)
+	require.ErrorIs(t, err, ErrNoPathFound)
+
+	_, err = FindPath(graph, alice, route.Vertex{6}, DefaultMaxPathLength)
+	require.ErrorIs(t, err, ErrNoPathFound)
+}
@@ -0,0 +1,274 @@
+package onionmessage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// ReplyPathType is the type of the record that holds a blinded path
+	// that the recipient can use to reply to a message.
+	ReplyPathType tlv.Type = 2
+
+	// EncryptedDataType is the type of the record that holds the route
+	// data that the creator of the blinded path encrypted to a hop.
+	EncryptedDataType tlv.Type = 4
+
+	// FinalHopTLVMinType is the smallest type that can be used for the
+	// application level records that are delivered to the final hop of
+	// an onion message. Types below this value are reserved for the
+	// onion message protocol itself.
+	FinalHopTLVMinType tlv.Type = 64
+)
+
+var (
+	// ErrNoEncryptedData is returned when an onion message payload doesn't
+	// contain any encrypted route data.
+	ErrNoEncryptedData = errors.New("onion message payload has no " +
+		"encrypted data")
+
+	// ErrInvalidFinalHopTLV is returned when a final hop record uses a
+	// type that is reserved for the onion message protocol.
+	ErrInvalidFinalHopTLV = fmt.Errorf("final hop tlv types must be at "+
+		"least %d", FinalHopTLVMinType)
+)
+
+// Payload is the payload that the sender of an onion message includes for
+// each hop in the onion.
+type Payload struct {
+	// EncryptedData is the route data that the creator of the blinded
+	// path encrypted to this hop.
+	EncryptedData []byte
+
+	// ReplyPath is an optional blinded path that the final hop can use to
+	// reply to the message.
+	ReplyPath *sphinx.BlindedPath
+
+	// FinalHopTLVs is the set of application level records that are
+	// delivered to the final hop, keyed by their type.
+	FinalHopTLVs map[tlv.Type][]byte
+}
+
+// IsEmpty returns true if the payload carries nothing besides the encrypted
+// route data, which is the only content allowed for intermediate hops.
+func (p *Payload) IsEmpty() bool {
+	return p.ReplyPath == nil && len(p.FinalHopTLVs) == 0
+}
+
+// Encode serializes the payload as a TLV stream.
+func (p *Payload) Encode(w io.Writer) error {
+	var records []tlv.Record
+
+	if p.ReplyPath != nil {
+		var b bytes.Buffer
+		if err := EncodeBlindedPath(&b, p.ReplyPath); err != nil {
+			return err
+		}
+		replyPath := b.Bytes()
+
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				ReplyPathType, &replyPath,
+			),
+		)
+	}
+
+	if len(p.EncryptedData) > 0 {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				EncryptedDataType, &p.EncryptedData,
+			),
+		)
+	}
+
+	for tlvType, value := range p.FinalHopTLVs {
+		if tlvType < FinalHopTLVMinType {
+			return ErrInvalidFinalHopTLV
+		}
+
+		value := value
+		records = append(
+			records, tlv.MakePrimitiveRecord(tlvType, &value),
+		)
+	}
+
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// DecodePayload parses the TLV encoded payload of an onion message hop.
+// Records with types that are reserved for the onion message protocol but
+// unknown to us are ignored.
+func DecodePayload(r io.Reader) (*Payload, error) {
+	var (
+		payload       Payload
+		replyPath     []byte
+		encryptedData []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(ReplyPathType, &replyPath),
+		tlv.MakePrimitiveRecord(EncryptedDataType, &encryptedData),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[ReplyPathType]; ok {
+		payload.ReplyPath, err = DecodeBlindedPath(
+			bytes.NewReader(replyPath),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply path: %w", err)
+		}
+	}
+
+	if _, ok := parsedTypes[EncryptedDataType]; ok {
+		payload.EncryptedData = encryptedData
+	}
+
+	for tlvType, value := range parsedTypes {
+		if tlvType < FinalHopTLVMinType {
+			continue
+		}
+
+		if payload.FinalHopTLVs == nil {
+			payload.FinalHopTLVs = make(map[tlv.Type][]byte)
+		}
+		payload.FinalHopTLVs[tlvType] = value
+	}
+
+	return &payload, nil
+}
+
+// EncodeBlindedPath serializes a blinded path in the format that is used to
+// transmit blinded paths within the protocol, e.g. as the reply path of an
+// onion message.
+func EncodeBlindedPath(w io.Writer, path *sphinx.BlindedPath) error {
+	if len(path.BlindedHops) == 0 {
+		return errors.New("blinded path has no hops")
+	}
+
+	if len(path.BlindedHops) > int(^uint8(0)) {
+		return fmt.Errorf("blinded path has too many hops: %d",
+			len(path.BlindedHops))
+	}
+
+	_, err := w.Write(path.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(path.BlindingPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte{uint8(len(path.BlindedHops))}); err != nil {
+		return err
+	}
+
+	for _, hop := range path.BlindedHops {
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		if len(hop.CipherText) > int(^uint16(0)) {
+			return fmt.Errorf("blinded hop data of %d bytes is "+
+				"too large", len(hop.CipherText))
+		}
+
+		var l [2]byte
+		binary.BigEndian.PutUint16(l[:], uint16(len(hop.CipherText)))
+		if _, err := w.Write(l[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeBlindedPath parses a blinded path that was serialized with
+// EncodeBlindedPath.
+func DecodeBlindedPath(r io.Reader) (*sphinx.BlindedPath, error) {
+	introductionPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	blindingPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, errors.New("blinded path has no hops")
+	}
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: introductionPoint,
+		BlindingPoint:     blindingPoint,
+		BlindedHops:       make([]*sphinx.BlindedHopInfo, numHops[0]),
+	}
+
+	for i := range path.BlindedHops {
+		nodePub, err := readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var l [2]byte
+		if _, err := io.ReadFull(r, l[:]); err != nil {
+			return nil, err
+		}
+
+		cipherText := make([]byte, binary.BigEndian.Uint16(l[:]))
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops[i] = &sphinx.BlindedHopInfo{
+			BlindedNodePub: nodePub,
+			CipherText:     cipherText,
+		}
+	}
+
+	return path, nil
+}
+
+// readPubKey reads a 33-byte compressed public key from the passed reader.
+func readPubKey(r io.Reader) (*btcec.PublicKey, error) {
+	var keyBytes [btcec.PubKeyBytesLenCompressed]byte
+	if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(keyBytes[:])
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
 	"github.com/btcsuite/btcd/txscript"
@@ -37,6 +38,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
+	"github.com/lightningnetwork/lnd/lnwallet/chansplicer"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/pool"
@@ -74,6 +76,13 @@ var (
 	// either the Brontide doesn't know of it, or the channel in question
 	// is pending.
 	ErrChannelNotFound = fmt.Errorf("channel not found")
+
+	// ErrCoopCloseNotBumpable is returned when the fee of a closing
+	// transaction is bumped, but it wasn't negotiated with
+	// option_simple_close, or the funding output has already been spent.
+	ErrCoopCloseNotBumpable = fmt.Errorf("closing tx can only be bumped " +
+		"if it was negotiated with option_simple_close, until it " +
+		"confirms")
 )
 
 // outgoingMsg packages an lnwire.Message to be sent out on the wire, along with
@@ -111,6 +120,14 @@ type closeMsg struct {
 	msg lnwire.Message
 }
 
+// spliceMsg is a wrapper struct around any wire messages that deal with the
+// splice of a channel. This struct includes the raw channel ID targeted along
+// with the original message.
+type spliceMsg struct {
+	cid lnwire.ChannelID
+	msg lnwire.Message
+}
+
 // PendingUpdate describes the pending state of a closing channel.
 type PendingUpdate struct {
 	Txid        []byte
@@ -249,6 +266,11 @@ type Config struct {
 	// invoice-related logic.
 	Invoices *invoices.InvoiceRegistry
 
+	// Trampoline is passed to the ChannelLink on creation and pays the
+	// recipients of trampoline payments. It is nil if trampoline routing
+	// is disabled.
+	Trampoline htlcswitch.TrampolineHandler
+
 	// ChannelNotifier is used by the link to notify other sub-systems about
 	// channel-related events and by the Brontide to subscribe to
 	// ActiveLinkEvents.
@@ -338,6 +360,10 @@ type Config struct {
 	// from the peer.
 	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
 
+	// HandleOnionMessage is called whenever an onion message is received
+	// from the peer. It is nil if we don't support onion messages.
+	HandleOnionMessage func(peer [33]byte, msg *lnwire.OnionMessage)
+
 	// GetAliases is passed to created links so the Switch and link can be
 	// aware of the channel's aliases.
 	GetAliases func(base lnwire.ShortChannelID) []lnwire.ShortChannelID
@@ -356,6 +382,14 @@ type Config struct {
 	// this across multiple Peer struct instances.
 	PongBuf []byte
 
+	// MaxChanSize is the largest capacity that a channel may have after
+	// it has been spliced.
+	MaxChanSize btcutil.Amount
+
+	// MaxLocalCSVDelay is the maximum CSV delay that we accept for our
+	// own outputs when the remote peer upgrades a channel.
+	MaxLocalCSVDelay uint16
+
 	// Quit is the server's quit channel. If this is closed, we halt operation.
 	Quit chan struct{}
 }
@@ -464,6 +498,29 @@ type Brontide struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// coopCloseSpends receives the closers whose closing transaction can
+	// be replaced once the funding output of their channel is spent, so
+	// that the channelManager removes them from activeChanCloses.
+	coopCloseSpends chan *chancloser.ChanCloser
+
+	// activeChanSplices is a map that keeps track of all the active
+	// channel splices. Any splice messages are directed to one of these
+	// state machines until the splice transaction has been signed.
+	activeChanSplices map[lnwire.ChannelID]*chansplicer.ChanSplicer
+
+	// splicingChans is the set of channels in activeChanSplices. It's
+	// used by the readHandler to direct the interactive transaction and
+	// commitment messages of a splice to the channelManager.
+	splicingChans *lnutils.SyncMap[lnwire.ChannelID, struct{}]
+
+	// localSpliceReqs is a channel in which any local requests to splice
+	// a particular channel are sent over.
+	localSpliceReqs chan *chansplicer.Request
+
+	// chanSpliceMsgs is a channel that any message related to channel
+	// splices are sent over.
+	chanSpliceMsgs chan *spliceMsg
+
 	// remoteFeatures is the feature vector received from the peer during
 	// the connection handshake.
 	remoteFeatures *lnwire.FeatureVector
@@ -515,10 +572,20 @@ func NewBrontide(cfg Config) *Brontide {
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		linkFailures:       make(chan linkFailureReport),
 		chanCloseMsgs:      make(chan *closeMsg),
+		coopCloseSpends:    make(chan *chancloser.ChanCloser),
 		resentChanSyncMsg:  make(map[lnwire.ChannelID]struct{}),
 		startReady:         make(chan struct{}),
 		quit:               make(chan struct{}),
 		log:                build.NewPrefixLog(logPrefix, peerLog),
+
+		activeChanSplices: make(
+			map[lnwire.ChannelID]*chansplicer.ChanSplicer,
+		),
+		splicingChans: &lnutils.SyncMap[
+			lnwire.ChannelID, struct{},
+		]{},
+		localSpliceReqs: make(chan *chansplicer.Request),
+		chanSpliceMsgs:  make(chan *spliceMsg),
 	}
 
 	return p
@@ -800,9 +867,12 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 			chanPoint, lnChan.IsPending())
 
 		// Skip adding any permanently irreconcilable channels to the
-		// htlcswitch.
-		if !dbChan.HasChanStatus(channeldb.ChanStatusDefault) &&
-			!dbChan.HasChanStatus(channeldb.ChanStatusRestored) {
+		// htlcswitch. A channel with a pending splice is used as
+		// normal until the splice transaction confirms.
+		chanStatus := dbChan.ChanStatus() &^
+			channeldb.ChanStatusPendingSplice
+		if chanStatus != channeldb.ChanStatusDefault &&
+			chanStatus != channeldb.ChanStatusRestored {
 
 			p.log.Warnf("ChannelPoint(%v) has status %v, won't "+
 				"start.", chanPoint, dbChan.ChanStatus())
@@ -853,47 +923,11 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// Before we register this new link with the HTLC Switch, we'll
 		// need to fetch its current link-layer forwarding policy from
 		// the database.
-		graph := p.cfg.ChannelGraph
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
-		if err != nil && err != channeldb.ErrEdgeNotFound {
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
 			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *channeldb.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.cfg.ServerPubKey[:]) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
-		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *models.ForwardingPolicy
-		if selfPolicy != nil {
-			forwardingPolicy = &models.ForwardingPolicy{
-				MinHTLCOut:    selfPolicy.MinHTLC,
-				MaxHTLC:       selfPolicy.MaxHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-			}
-		} else {
-			p.log.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.cfg.RoutingPolicy
-		}
-
 		p.log.Tracef("Using link policy of: %v",
 			spew.Sdump(forwardingPolicy))
 
@@ -909,6 +943,17 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 			continue
 		}
 
+		// A channel with a pending upgrade splice stays paused until
+		// the splice transaction confirms, so its link isn't added.
+		if lnChan.UpgradeSplicePending() {
+			p.log.Infof("ChannelPoint(%v) is paused until its "+
+				"upgrade splice confirms", chanPoint)
+
+			p.activeChannels.Store(chanID, lnChan)
+
+			continue
+		}
+
 		// Subscribe to the set of on-chain events for this channel.
 		chainEvents, err := p.cfg.ChainArb.SubscribeChannelEvents(
 			*chanPoint,
@@ -926,12 +971,73 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 				"switch: %v", chanPoint, err)
 		}
 
+		// If the splice transaction of the channel has reached a reorg
+		// safe depth, the channel is drained so that the splice can
+		// complete.
+		chanLink := p.fetchLinkFromKeyAndCid(chanID)
+		if chanLink != nil && p.cfg.ChainArb.IsSpliceLocked(*chanPoint) {
+			chanLink.DisableAdds()
+		}
+
 		p.activeChannels.Store(chanID, lnChan)
 	}
 
 	return msgs, nil
 }
 
+// fetchForwardingPolicy returns the current link-layer forwarding policy of
+// the channel, which is our advertised routing policy, or the default policy
+// if we haven't advertised one yet.
+func (p *Brontide) fetchForwardingPolicy(
+	chanPoint *wire.OutPoint) (*models.ForwardingPolicy, error) {
+
+	graph := p.cfg.ChannelGraph
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel edges
+	// based whom the edge connects to. If it doesn't connect to us, then
+	// we know that we were the one that advertised the policy.
+	//
+	// TODO(roasbeef): can add helper method to get policy for particular
+	// channel.
+	var selfPolicy *channeldb.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.cfg.ServerPubKey[:]) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	// If we don't yet have an advertised routing policy, then we'll use
+	// the current default, otherwise we'll translate the routing policy
+	// into a forwarding policy.
+	if selfPolicy == nil {
+		p.log.Warnf("Unable to find our forwarding policy for "+
+			"channel %v, using default values", chanPoint)
+
+		return &p.cfg.RoutingPolicy, nil
+	}
+
+	inboundFee, err := selfPolicy.InboundFee()
+	if err != nil {
+		p.log.Warnf("Unable to parse inbound fee of channel %v: %v",
+			chanPoint, err)
+	}
+
+	return &models.ForwardingPolicy{
+		MinHTLCOut:    selfPolicy.MinHTLC,
+		MaxHTLC:       selfPolicy.MaxHTLC,
+		BaseFee:       selfPolicy.FeeBaseMSat,
+		FeeRate:       selfPolicy.FeeProportionalMillionths,
+		TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+		InboundFee:    inboundFee,
+	}, nil
+}
+
 // addLink creates and adds a new ChannelLink from the specified channel.
 func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	lnChan *lnwallet.LightningChannel,
@@ -994,6 +1100,17 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	}
 
 	//nolint:lll
+	// The channel can only be quiesced if both sides support quiescence.
+	disallowQuiescence := !p.cfg.Features.HasFeature(
+		lnwire.QuiescenceOptional,
+	) || !p.remoteFeatures.HasFeature(lnwire.QuiescenceOptional)
+
+	// Likewise, the channel can only be upgraded if both sides support
+	// dynamic commitments, which in turn requires quiescence.
+	disallowDynCommit := disallowQuiescence || !p.cfg.Features.HasFeature(
+		lnwire.DynamicCommitmentsOptional,
+	) || !p.remoteFeatures.HasFeature(lnwire.DynamicCommitmentsOptional)
+
 	linkCfg := htlcswitch.ChannelLinkConfig{
 		Peer:                   p,
 		DecodeHopIterators:     p.cfg.Sphinx.DecodeHopIterators,
@@ -1001,6 +1118,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		FetchLastChannelUpdate: p.cfg.FetchLastChanUpdate,
 		HodlMask:               p.cfg.Hodl.Mask(),
 		Registry:               p.cfg.Invoices,
+		Trampoline:             p.cfg.Trampoline,
 		BestHeight:             p.cfg.Switch.BestHeight,
 		Circuits:               p.cfg.Switch.CircuitModifier(),
 		ForwardPackets:         p.cfg.InterceptSwitch.ForwardPackets,
@@ -1032,6 +1150,9 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		NotifyInactiveLinkEvent: p.cfg.ChannelNotifier.NotifyInactiveLinkEvent,
 		HtlcNotifier:            p.cfg.HtlcNotifier,
 		GetAliases:              p.cfg.GetAliases,
+		DisallowQuiescence:      disallowQuiescence,
+		DisallowDynCommit:       disallowDynCommit,
+		MaxLocalCSVDelay:        p.cfg.MaxLocalCSVDelay,
 	}
 
 	// Before adding our new link, purge the switch of any pending or live
@@ -1595,10 +1716,47 @@ out:
 			*lnwire.AcceptChannel,
 			*lnwire.FundingCreated,
 			*lnwire.FundingSigned,
-			*lnwire.ChannelReady:
+			*lnwire.ChannelReady,
+			*lnwire.OpenChannel2,
+			*lnwire.AcceptChannel2,
+			*lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxAbort:
+
+			// The interactive transaction messages of a channel
+			// that is being spliced are handled by its splice
+			// state machine.
+			cid, ok := interactiveTxChanID(msg)
+			if ok && p.isSplicing(cid) {
+				select {
+				case p.chanSpliceMsgs <- &spliceMsg{cid, msg}:
+				case <-p.quit:
+					break out
+				}
+
+				break
+			}
 
 			p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 
+		case *lnwire.SpliceInit:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		case *lnwire.SpliceAck:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -1612,6 +1770,20 @@ out:
 				break out
 			}
 
+		case *lnwire.ClosingComplete:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		case *lnwire.ClosingSig:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
 		case *lnwire.Warning:
 			targetChan = msg.ChanID
 			isLinkUpdate = p.handleWarningOrError(targetChan, msg)
@@ -1645,6 +1817,35 @@ out:
 		// if the channel is not active yet.
 		case LinkUpdater:
 			targetChan = msg.TargetChanID()
+
+			// The initial commitment signature of a dual funded
+			// channel is exchanged during the funding flow, so
+			// it's handled by the funding manager.
+			_, isCommitSig := msg.(*lnwire.CommitSig)
+
+			// Likewise, the commitment signatures for the new
+			// funding output of a splice are exchanged during the
+			// splice.
+			if isCommitSig && p.isSplicing(targetChan) {
+				select {
+				case p.chanSpliceMsgs <- &spliceMsg{
+					targetChan, nextMsg,
+				}:
+				case <-p.quit:
+					break out
+				}
+
+				break
+			}
+
+			fundingMgr := p.cfg.FundingManager
+			if isCommitSig &&
+				fundingMgr.IsPendingChannel(targetChan, p) {
+
+				fundingMgr.ProcessFundingMsg(nextMsg, p)
+				break
+			}
+
 			isLinkUpdate = p.hasChannel(targetChan)
 
 			// Log an error if we don't have this channel. This
@@ -1675,6 +1876,18 @@ out:
 				p.log.Errorf("%v", err)
 			}
 
+		case *lnwire.OnionMessage:
+			// If we don't support onion messages, we didn't signal
+			// the feature to the peer, so we just ignore them.
+			if p.cfg.HandleOnionMessage == nil {
+				p.log.Debugf("Ignoring onion message, onion " +
+					"messages not supported")
+
+				break
+			}
+
+			p.cfg.HandleOnionMessage(p.PubKey(), msg)
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -1875,6 +2088,14 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, fee_sat=%v", msg.ChannelID,
 			msg.FeeSatoshis)
 
+	case *lnwire.ClosingComplete:
+		return fmt.Sprintf("chan_id=%v, fee_sat=%v, locktime=%v",
+			msg.ChannelID, msg.FeeSatoshis, msg.LockTime)
+
+	case *lnwire.ClosingSig:
+		return fmt.Sprintf("chan_id=%v, fee_sat=%v, locktime=%v",
+			msg.ChannelID, msg.FeeSatoshis, msg.LockTime)
+
 	case *lnwire.UpdateAddHTLC:
 		return fmt.Sprintf("chan_id=%v, id=%v, amt=%v, expiry=%v, hash=%x",
 			msg.ChanID, msg.ID, msg.Amount, msg.Expiry, msg.PaymentHash[:])
@@ -1965,6 +2186,10 @@ func messageSummary(msg lnwire.Message) string {
 
 	case *lnwire.Custom:
 		return fmt.Sprintf("type=%d", msg.Type)
+
+	case *lnwire.OnionMessage:
+		return fmt.Sprintf("path_key=%x, onion_len=%d",
+			msg.PathKey.SerializeCompressed(), len(msg.OnionBlob))
 	}
 
 	return fmt.Sprintf("unknown msg type=%T", msg)
@@ -2455,6 +2680,27 @@ out:
 		case closeMsg := <-p.chanCloseMsgs:
 			p.handleCloseMsg(closeMsg)
 
+		// The funding output of a channel whose closing transaction
+		// could be replaced has been spent, so its closer is no longer
+		// needed.
+		case chanCloser := <-p.coopCloseSpends:
+			chanPoint := chanCloser.Channel().ChannelPoint()
+			cid := lnwire.NewChanIDFromOutPoint(chanPoint)
+			if p.activeChanCloses[cid] == chanCloser {
+				delete(p.activeChanCloses, cid)
+			}
+
+		// We've just received a local request to splice an active
+		// channel, which kicks off the splice negotiation.
+		case req := <-p.localSpliceReqs:
+			p.handleLocalSpliceReq(req)
+
+		// We've received a new splice related message from the remote
+		// peer, we'll use this message to advance the chan splicer
+		// state machine.
+		case spliceMsg := <-p.chanSpliceMsgs:
+			p.handleSpliceMsg(spliceMsg)
+
 		// The channel reannounce delay has elapsed, broadcast the
 		// reenabled channel updates to the network. This should only
 		// fire once, so we set the reenableTimeout channel to nil to
@@ -2484,6 +2730,12 @@ out:
 			}
 
 		case <-p.quit:
+			// Any coins that were locked for unfinished splices
+			// are released.
+			for _, splicer := range p.activeChanSplices {
+				splicer.Cancel()
+			}
+
 			// As, we've been signalled to exit, we'll reset all
 			// our active channel back to their default state.
 			p.activeChannels.ForEach(func(_ lnwire.ChannelID,
@@ -2819,14 +3071,16 @@ func (p *Brontide) restartCoopClose(lnChan *lnwallet.LightningChannel) (
 	// provided close script. Instead use the LocalUpfrontShutdownScript
 	// or generate a script.
 	c := lnChan.State()
-	_, err := c.BroadcastedCooperative()
+	closeTx, err := c.BroadcastedCooperative()
 	if err != nil && err != channeldb.ErrNoCloseTx {
 		// An error other than ErrNoCloseTx was encountered.
 		return nil, err
 	} else if err == nil {
 		// This channel has already completed the coop close
-		// negotiation.
-		return nil, nil
+		// negotiation. If it was negotiated with option_simple_close,
+		// we'll restore its closer, so that the closing transaction
+		// can still be replaced.
+		return nil, p.restoreSimpleClose(lnChan, closeTx)
 	}
 
 	// As mentioned above, we don't re-create the delivery script.
@@ -2881,6 +3135,85 @@ func (p *Brontide) restartCoopClose(lnChan *lnwallet.LightningChannel) (
 	return shutdownMsg, nil
 }
 
+// restoreSimpleClose restores the closer of a channel whose closing
+// transaction was negotiated with option_simple_close, from the state of the
+// negotiation that was persisted along with it. The closer is kept in
+// activeChanCloses until the funding output is spent, so that either party
+// can replace the closing transaction by restarting the closing_complete and
+// closing_sig exchange.
+func (p *Brontide) restoreSimpleClose(lnChan *lnwallet.LightningChannel,
+	closeTx *wire.MsgTx) error {
+
+	state, err := lnChan.SimpleCloseState()
+	switch {
+	// The closing transaction was negotiated with closing_signed, so it
+	// can't be replaced.
+	case errors.Is(err, channeldb.ErrNoSimpleCloseState):
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	c := lnChan.State()
+	locallyInitiated := c.HasChanStatus(
+		channeldb.ChanStatusLocalCloseInitiator,
+	)
+
+	chanCloser := chancloser.NewSimpleChanCloser(
+		p.chanCloseCfg(lnChan, 0, true), closeTx, state,
+		locallyInitiated,
+	)
+
+	// This does not need a mutex even though it is in a different
+	// goroutine since this is done before the channelManager goroutine is
+	// created.
+	chanID := lnwire.NewChanIDFromOutPoint(&c.FundingOutpoint)
+	p.activeChanCloses[chanID] = chanCloser
+
+	p.log.Infof("Restored closer of ChannelPoint(%v) negotiated with "+
+		"option_simple_close", c.FundingOutpoint)
+
+	go waitForCoopCloseSpend(
+		chanCloser.NegotiationHeight(), p.cfg.ChainNotifier, nil,
+		&c.FundingOutpoint, lnChan.FundingTxOut().PkScript,
+		func(*chainhash.Hash) {
+			select {
+			case p.coopCloseSpends <- chanCloser:
+			case <-p.quit:
+			}
+		},
+	)
+
+	return nil
+}
+
+// chanCloseCfg returns the config of the closer of the passed channel. The
+// maxFee is only set if we initiated the co-op closing flow.
+func (p *Brontide) chanCloseCfg(channel *lnwallet.LightningChannel,
+	maxFee chainfee.SatPerKWeight,
+	simpleClose bool) chancloser.ChanCloseCfg {
+
+	return chancloser.ChanCloseCfg{
+		Channel:      channel,
+		MusigSession: NewMusigChanCloser(channel),
+		FeeEstimator: &chancloser.SimpleCoopFeeEstimator{},
+		BroadcastTx:  p.cfg.Wallet.PublishTransaction,
+		DisableChannel: func(op wire.OutPoint) error {
+			return p.cfg.ChanStatusMgr.RequestDisable(
+				op, false,
+			)
+		},
+		MaxFee: maxFee,
+		Disconnect: func() error {
+			return p.cfg.DisconnectPeer(p.IdentityKey())
+		},
+		ChainParams: &p.cfg.Wallet.Cfg.NetParams,
+		SimpleClose: simpleClose,
+		Quit:        p.quit,
+	}
+}
+
 // createChanCloser constructs a ChanCloser from the passed parameters and is
 // used to de-duplicate code.
 func (p *Brontide) createChanCloser(channel *lnwallet.LightningChannel,
@@ -2900,24 +3233,15 @@ func (p *Brontide) createChanCloser(channel *lnwallet.LightningChannel,
 		maxFee = req.MaxFee
 	}
 
+	// The closing transaction can be replaced if both parties signal
+	// option_simple_close. Taproot channels still use the legacy
+	// negotiation, as the MuSig2 closing nonces are single-use.
+	simpleClose := !channel.ChanType().IsTaproot() &&
+		p.cfg.Features.HasFeature(lnwire.SimpleCloseOptional) &&
+		p.remoteFeatures.HasFeature(lnwire.SimpleCloseOptional)
+
 	chanCloser := chancloser.NewChanCloser(
-		chancloser.ChanCloseCfg{
-			Channel:      channel,
-			MusigSession: NewMusigChanCloser(channel),
-			FeeEstimator: &chancloser.SimpleCoopFeeEstimator{},
-			BroadcastTx:  p.cfg.Wallet.PublishTransaction,
-			DisableChannel: func(op wire.OutPoint) error {
-				return p.cfg.ChanStatusMgr.RequestDisable(
-					op, false,
-				)
-			},
-			MaxFee: maxFee,
-			Disconnect: func() error {
-				return p.cfg.DisconnectPeer(p.IdentityKey())
-			},
-			ChainParams: &p.cfg.Wallet.Cfg.NetParams,
-			Quit:        p.quit,
-		},
+		p.chanCloseCfg(channel, maxFee, simpleClose),
 		deliveryScript,
 		fee,
 		uint32(startingHeight),
@@ -2933,6 +3257,25 @@ func (p *Brontide) createChanCloser(channel *lnwallet.LightningChannel,
 func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	chanID := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
 
+	// If the closing transaction of the channel has already been
+	// negotiated, then a cooperative close request bumps its fee.
+	chanCloser, ok := p.activeChanCloses[chanID]
+	switch {
+	case ok && req.CloseType == contractcourt.CloseRegular:
+		p.bumpCoopClose(chanCloser, req)
+		return
+
+	// Only the closer of a closing transaction that was negotiated with
+	// option_simple_close is kept, until the funding output is spent.
+	case req.BumpFee:
+		err := fmt.Errorf("cannot bump fee of closing tx for "+
+			"ChannelPoint(%v): %w", req.ChanPoint,
+			ErrCoopCloseNotBumpable)
+		p.log.Errorf(err.Error())
+		req.Err <- err
+		return
+	}
+
 	channel, ok := p.activeChannels.Load(chanID)
 
 	// Though this function can't be called for pending channels, we still
@@ -3027,6 +3370,23 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	}
 }
 
+// bumpCoopClose proposes a closing transaction that pays the fee rate of the
+// passed request, and replaces the closing transaction that has been
+// broadcast.
+func (p *Brontide) bumpCoopClose(chanCloser *chancloser.ChanCloser,
+	req *htlcswitch.ChanClose) {
+
+	closingComplete, err := chanCloser.BumpFee(req.TargetFeePerKw, req)
+	if err != nil {
+		p.log.Errorf("cannot bump fee of closing tx for "+
+			"ChannelPoint(%v): %v", req.ChanPoint, err)
+		req.Err <- err
+		return
+	}
+
+	p.queueMsg(closingComplete, nil)
+}
+
 // linkFailureReport is sent to the channelManager whenever a link reports a
 // link failure, and is forced to exit. The report houses the necessary
 // information to clean up the channel state, send back the error message, and
@@ -3186,9 +3546,14 @@ func (p *Brontide) finalizeChanClosure(chanCloser *chancloser.ChanCloser) {
 	chanPoint := chanCloser.Channel().ChannelPoint()
 	p.WipeChannel(chanPoint)
 
-	// Also clear the activeChanCloses map of this channel.
-	cid := lnwire.NewChanIDFromOutPoint(chanPoint)
-	delete(p.activeChanCloses, cid)
+	// Also clear the activeChanCloses map of this channel, unless the
+	// closing transaction can still be replaced, in which case the closer
+	// is kept around to negotiate the replacement until the funding output
+	// is spent.
+	if !chanCloser.SimpleClose() {
+		cid := lnwire.NewChanIDFromOutPoint(chanPoint)
+		delete(p.activeChanCloses, cid)
+	}
 
 	// Next, we'll launch a goroutine which will request to be notified by
 	// the ChainNotifier once the closure transaction obtains a single
@@ -3221,6 +3586,31 @@ func (p *Brontide) finalizeChanClosure(chanCloser *chancloser.ChanCloser) {
 		}
 	}
 
+	// As the closing transaction may be replaced, we'll wait for the
+	// funding output to be spent, and report the transaction that spent
+	// it.
+	if chanCloser.SimpleClose() {
+		fundingScript := chanCloser.Channel().FundingTxOut().PkScript
+		go waitForCoopCloseSpend(
+			chanCloser.NegotiationHeight(), notifier, errChan,
+			chanPoint, fundingScript, func(txid *chainhash.Hash) {
+				select {
+				case p.coopCloseSpends <- chanCloser:
+				case <-p.quit:
+				}
+
+				if closeReq != nil {
+					closeReq.Updates <- &ChannelCloseUpdate{
+						ClosingTxid: txid[:],
+						Success:     true,
+					}
+				}
+			},
+		)
+
+		return
+	}
+
 	go WaitForChanToClose(chanCloser.NegotiationHeight(), notifier, errChan,
 		chanPoint, &closingTxid, closingTx.TxOut[0].PkScript, func() {
 			// Respond to the local subsystem which requested the
@@ -3274,6 +3664,43 @@ func WaitForChanToClose(bestHeight uint32, notifier chainntnfs.ChainNotifier,
 	cb()
 }
 
+// waitForCoopCloseSpend uses the passed notifier to wait until the funding
+// output of a channel whose closing transaction can be replaced is spent, and
+// then executes the callback with the txid of the spending transaction. If any
+// error is encountered, then it will be sent over the errChan.
+func waitForCoopCloseSpend(bestHeight uint32,
+	notifier chainntnfs.ChainNotifier, errChan chan error,
+	chanPoint *wire.OutPoint, fundingScript []byte,
+	cb func(*chainhash.Hash)) {
+
+	peerLog.Infof("Waiting for spend of ChannelPoint(%v) by one of its "+
+		"closing txns", chanPoint)
+
+	spendNtfn, err := notifier.RegisterSpendNtfn(
+		chanPoint, fundingScript, bestHeight,
+	)
+	if err != nil {
+		if errChan != nil {
+			errChan <- err
+		}
+		return
+	}
+	defer spendNtfn.Cancel()
+
+	// In the case that the ChainNotifier is shutting down, all subscriber
+	// notification channels will be closed, generating a nil receive.
+	spend, ok := <-spendNtfn.Spend
+	if !ok {
+		return
+	}
+
+	peerLog.Infof("ChannelPoint(%v) is now closed by txid %v at "+
+		"height %v", chanPoint, spend.SpenderTxHash,
+		spend.SpendingHeight)
+
+	cb(spend.SpenderTxHash)
+}
+
 // WipeChannel removes the passed channel point from all indexes associated with
 // the peer and the switch.
 func (p *Brontide) WipeChannel(chanPoint *wire.OutPoint) {
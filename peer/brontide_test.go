@@ -8,6 +8,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -1343,3 +1344,109 @@ func TestHandleRemovePendingChannel(t *testing.T) {
 		})
 	}
 }
+
+// TestBumpCoopCloseWithoutCloser checks that bumping the fee of a closing
+// transaction whose closer is no longer known fails with a clear error.
+func TestBumpCoopCloseWithoutCloser(t *testing.T) {
+	t.Parallel()
+
+	peer := NewBrontide(Config{})
+
+	errChan := make(chan error, 1)
+	peer.handleLocalCloseReq(&htlcswitch.ChanClose{
+		CloseType:      contractcourt.CloseRegular,
+		ChanPoint:      &wire.OutPoint{Index: 1},
+		TargetFeePerKw: 1000,
+		BumpFee:        true,
+		Err:            errChan,
+	})
+
+	select {
+	case err := <-errChan:
+		require.ErrorIs(t, err, ErrCoopCloseNotBumpable)
+
+	default:
+		t.Fatal("expected error")
+	}
+}
+
+// TestRestoreSimpleClose checks that the closer of a closing transaction that
+// was negotiated with option_simple_close is restored when the channel is
+// loaded, and dropped once the funding output is spent.
+func TestRestoreSimpleClose(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+
+	notifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	peer := NewBrontide(Config{
+		Wallet: &lnwallet.LightningWallet{
+			WalletController: &mock.WalletController{},
+		},
+		ChainNotifier: notifier,
+	})
+
+	// Alice's closing transaction was negotiated with option_simple_close,
+	// so her closer is restored.
+	closeTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: *aliceChannel.ChannelPoint(),
+		}},
+		TxOut:    []*wire.TxOut{{Value: 1000}},
+		LockTime: 100,
+	}
+	state := &channeldb.SimpleCloseState{
+		LocalDeliveryScript:  genScript(t, p2wshAddress),
+		RemoteDeliveryScript: genScript(t, p2SHAddress),
+		LockTime:             100,
+		ClosingFee:           1000,
+		FeeRate:              2000,
+	}
+	err = aliceChannel.MarkSimpleCoopBroadcasted(closeTx, true, state)
+	require.NoError(t, err)
+
+	shutdown, err := peer.restartCoopClose(aliceChannel)
+	require.NoError(t, err)
+	require.Nil(t, shutdown)
+
+	aliceID := lnwire.NewChanIDFromOutPoint(aliceChannel.ChannelPoint())
+	chanCloser, ok := peer.activeChanCloses[aliceID]
+	require.True(t, ok)
+	require.True(t, chanCloser.SimpleClose())
+	require.Equal(t, uint32(100), chanCloser.NegotiationHeight())
+
+	restoredTx, err := chanCloser.ClosingTx()
+	require.NoError(t, err)
+	require.Equal(t, closeTx.TxHash(), restoredTx.TxHash())
+
+	// Once the funding output is spent, the closer is handed back to the
+	// channelManager to be dropped.
+	notifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &chainhash.Hash{},
+	}
+
+	select {
+	case spent := <-peer.coopCloseSpends:
+		require.Equal(t, chanCloser, spent)
+
+	case <-time.After(timeout):
+		t.Fatal("closer not handed back after spend")
+	}
+	delete(peer.activeChanCloses, aliceID)
+
+	// Bob's closing transaction was negotiated with closing_signed, so no
+	// closer is restored.
+	err = bobChannel.MarkCoopBroadcasted(closeTx, false)
+	require.NoError(t, err)
+
+	shutdown, err = peer.restartCoopClose(bobChannel)
+	require.NoError(t, err)
+	require.Nil(t, shutdown)
+
+	require.Empty(t, peer.activeChanCloses)
+}
@@ -0,0 +1,395 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chansplicer"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ErrSpliceNotSupported is returned when a channel is spliced with a peer
+// that doesn't support splicing.
+var ErrSpliceNotSupported = errors.New("peer doesn't support splicing")
+
+// ErrUpgradeSpliceNotSupported is returned when a channel is upgraded to
+// taproot with a peer that doesn't support taproot channels.
+var ErrUpgradeSpliceNotSupported = errors.New("peer doesn't support " +
+	"taproot channels")
+
+// interactiveTxChanID returns the channel ID of an interactive transaction
+// construction message.
+func interactiveTxChanID(msg lnwire.Message) (lnwire.ChannelID, bool) {
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		return msg.ChannelID, true
+
+	case *lnwire.TxAddOutput:
+		return msg.ChannelID, true
+
+	case *lnwire.TxRemoveInput:
+		return msg.ChannelID, true
+
+	case *lnwire.TxRemoveOutput:
+		return msg.ChannelID, true
+
+	case *lnwire.TxComplete:
+		return msg.ChannelID, true
+
+	case *lnwire.TxSignatures:
+		return msg.ChannelID, true
+
+	case *lnwire.TxAbort:
+		return msg.ChannelID, true
+
+	default:
+		return lnwire.ChannelID{}, false
+	}
+}
+
+// isSplicing returns true if the channel is currently being spliced.
+func (p *Brontide) isSplicing(chanID lnwire.ChannelID) bool {
+	_, ok := p.splicingChans.Load(chanID)
+	return ok
+}
+
+// HandleLocalSpliceReq accepts a request to splice a channel and passes it
+// onto the channelManager goroutine, which will pause the channel and start
+// the splice negotiation.
+func (p *Brontide) HandleLocalSpliceReq(req *chansplicer.Request) {
+	select {
+	case p.localSpliceReqs <- req:
+		p.log.Info("Local splice request is going to be delivered " +
+			"to the peer")
+	case <-p.quit:
+		req.Err <- lnpeer.ErrPeerExiting
+	}
+}
+
+// createChanSplicer creates a splice state machine for the channel. The
+// request is only set if we initiate the splice.
+func (p *Brontide) createChanSplicer(channel *lnwallet.LightningChannel,
+	req *chansplicer.Request) *chansplicer.ChanSplicer {
+
+	wallet := p.cfg.Wallet
+
+	return chansplicer.NewChanSplicer(chansplicer.Config{
+		Channel:          channel,
+		Signer:           p.cfg.Signer,
+		CoinSource:       lnwallet.NewCoinSource(wallet),
+		CoinSelectLocker: wallet,
+		CoinLocker:       wallet,
+		FetchTx:          wallet.FetchTx,
+		ChangeScript: func() ([]byte, error) {
+			addr, err := wallet.NewAddress(
+				lnwallet.TaprootPubkey, true,
+				lnwallet.DefaultAccountName,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return txscript.PayToAddrScript(addr)
+		},
+		BroadcastTx: wallet.PublishTransaction,
+		BestHeight: func() (uint32, error) {
+			_, height, err := p.cfg.ChainIO.GetBestBlock()
+			return uint32(height), err
+		},
+		MaxChanSize: p.cfg.MaxChanSize,
+	}, req)
+}
+
+// handleLocalSpliceReq kicks off the splice of a channel that was requested
+// by a local subsystem. The channel is paused while the splice is negotiated.
+func (p *Brontide) handleLocalSpliceReq(req *chansplicer.Request) {
+	chanID := lnwire.NewChanIDFromOutPoint(&req.ChanPoint)
+
+	channel, ok := p.activeChannels.Load(chanID)
+	if !ok || channel == nil {
+		req.Err <- fmt.Errorf("unable to splice channel, "+
+			"ChannelID(%v) is unknown", chanID)
+		return
+	}
+
+	if !p.remoteFeatures.HasFeature(lnwire.SpliceOptional) {
+		req.Err <- ErrSpliceNotSupported
+		return
+	}
+
+	if req.CommitmentType != nil && !p.remoteFeatures.HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	) {
+
+		req.Err <- ErrUpgradeSpliceNotSupported
+		return
+	}
+
+	if _, ok := p.activeChanSplices[chanID]; ok {
+		req.Err <- chansplicer.ErrChanAlreadySplicing
+		return
+	}
+
+	if _, ok := p.activeChanCloses[chanID]; ok {
+		req.Err <- fmt.Errorf("unable to splice channel, "+
+			"ChannelID(%v) is closing", chanID)
+		return
+	}
+
+	chanSplicer := p.createChanSplicer(channel, req)
+	spliceInit, err := chanSplicer.InitSplice()
+	if err != nil {
+		p.log.Errorf("Unable to initiate splice of ChannelPoint(%v): "+
+			"%v", req.ChanPoint, err)
+		req.Err <- err
+		return
+	}
+
+	// Once the splice has been initiated, we pause the channel, which
+	// fails if any updates are pending.
+	if err := p.tryLinkShutdown(chanID); err != nil {
+		p.log.Errorf("failed link shutdown: %v", err)
+		chanSplicer.Cancel()
+		req.Err <- fmt.Errorf("unable to pause channel for "+
+			"splice: %w", err)
+		return
+	}
+
+	p.activeChanSplices[chanID] = chanSplicer
+	p.splicingChans.Store(chanID, struct{}{})
+
+	p.queueMsg(spliceInit, nil)
+}
+
+// handleSpliceMsg is called when a new splice related message is received
+// from the remote peer. We'll use this message to advance the chan splicer
+// state machine.
+func (p *Brontide) handleSpliceMsg(msg *spliceMsg) {
+	chanSplicer, found := p.activeChanSplices[msg.cid]
+	if !found {
+		// The remote party can only start a new splice, any other
+		// message for an unknown splice is ignored.
+		spliceInit, ok := msg.msg.(*lnwire.SpliceInit)
+		if !ok {
+			p.log.Warnf("Ignoring %v for ChannelID(%v) that isn't "+
+				"being spliced", msg.msg.MsgType(), msg.cid)
+			return
+		}
+
+		var err error
+		chanSplicer, err = p.acceptChanSplice(msg.cid, spliceInit)
+		if err != nil {
+			p.log.Errorf("Unable to accept splice of "+
+				"ChannelID(%v): %v", msg.cid, err)
+
+			p.queueMsg(&lnwire.TxAbort{
+				ChannelID: msg.cid,
+				Data:      lnwire.ErrorData(err.Error()),
+			}, nil)
+			return
+		}
+	}
+
+	msgs, spliceFin, err := chanSplicer.ProcessSpliceMsg(msg.msg)
+	if err != nil {
+		p.failChanSplice(chanSplicer, msg.cid, err)
+		return
+	}
+
+	for _, msg := range msgs {
+		p.queueMsg(msg, nil)
+	}
+
+	if !spliceFin {
+		return
+	}
+
+	p.finalizeChanSplice(chanSplicer, msg.cid)
+}
+
+// acceptChanSplice creates the splice state machine for a splice that was
+// initiated by the remote party, and pauses the channel.
+func (p *Brontide) acceptChanSplice(chanID lnwire.ChannelID,
+	msg *lnwire.SpliceInit) (*chansplicer.ChanSplicer, error) {
+
+	if !p.cfg.Features.HasFeature(lnwire.SpliceOptional) {
+		return nil, ErrSpliceNotSupported
+	}
+
+	if msg.ChannelType != nil && !p.cfg.Features.HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	) {
+
+		return nil, ErrUpgradeSpliceNotSupported
+	}
+
+	channel, ok := p.activeChannels.Load(chanID)
+	if !ok || channel == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	if _, ok := p.activeChanCloses[chanID]; ok {
+		return nil, errors.New("channel is closing")
+	}
+
+	if err := p.tryLinkShutdown(chanID); err != nil {
+		return nil, err
+	}
+
+	chanSplicer := p.createChanSplicer(channel, nil)
+
+	p.activeChanSplices[chanID] = chanSplicer
+	p.splicingChans.Store(chanID, struct{}{})
+
+	return chanSplicer, nil
+}
+
+// failChanSplice cleans up after a failed splice. If we haven't sent our
+// signatures for the splice transaction yet, nothing has been broadcast and
+// we disconnect, so that the channel is restored with its link when the peer
+// reconnects. Otherwise, the channel stays paused until the splice
+// transaction confirms, or until one of its inputs is double spent.
+func (p *Brontide) failChanSplice(chanSplicer *chansplicer.ChanSplicer,
+	chanID lnwire.ChannelID, err error) {
+
+	err = fmt.Errorf("unable to process splice msg: %w", err)
+	p.log.Error(err)
+
+	chanSplicer.Cancel()
+	if req := chanSplicer.Request(); req != nil {
+		req.Err <- err
+	}
+
+	delete(p.activeChanSplices, chanID)
+	p.splicingChans.Delete(chanID)
+
+	if chanSplicer.SigsSent() {
+		p.watchSplice(chanID)
+		return
+	}
+
+	p.Disconnect(err)
+}
+
+// watchSplice lets the chain arbitrator watch the inputs of the pending splice
+// of the channel, so that the splice is aborted and the channel resumed if
+// the splice transaction is double spent.
+func (p *Brontide) watchSplice(chanID lnwire.ChannelID) {
+	channel, ok := p.activeChannels.Load(chanID)
+	if !ok || channel == nil {
+		return
+	}
+
+	err := p.cfg.ChainArb.WatchSplice(*channel.ChannelPoint())
+	if err != nil {
+		p.log.Errorf("Unable to watch splice of ChannelID(%v): %v",
+			chanID, err)
+	}
+}
+
+// finalizeChanSplice performs the final clean up steps once the splice
+// transaction has been fully signed and broadcast. The channel is resumed, and
+// every new commitment is signed for both the current and the new funding
+// output until the splice transaction confirms, after which the channel is
+// replaced by the channel with the new funding output. If an input of the
+// splice transaction is double spent instead, the splice is aborted. A splice
+// that upgrades the channel type leaves the channel paused until the splice
+// transaction confirms, as the new commitments can't be signed in sync with
+// the commitments of the current channel type.
+func (p *Brontide) finalizeChanSplice(chanSplicer *chansplicer.ChanSplicer,
+	chanID lnwire.ChannelID) {
+
+	delete(p.activeChanSplices, chanID)
+	p.splicingChans.Delete(chanID)
+
+	spliceTx, err := chanSplicer.SpliceTx()
+	if err != nil {
+		p.log.Errorf("Unable to fetch splice tx: %v", err)
+		return
+	}
+
+	p.log.Infof("Splice of ChannelID(%v) negotiated with txid %v",
+		chanID, spliceTx.TxHash())
+
+	p.watchSplice(chanID)
+
+	if chanSplicer.IsUpgrade() {
+		p.log.Infof("ChannelID(%v) is paused until its upgrade splice "+
+			"confirms", chanID)
+	} else if err := p.resumeSplicedChannel(chanID); err != nil {
+		p.log.Errorf("Unable to resume ChannelID(%v) after splice: %v",
+			chanID, err)
+	}
+
+	if req := chanSplicer.Request(); req != nil {
+		req.Updates <- spliceTx
+	}
+}
+
+// resumeSplicedChannel adds the link of a channel with a pending splice back
+// to the switch, which was removed while the splice was negotiated.
+func (p *Brontide) resumeSplicedChannel(chanID lnwire.ChannelID) error {
+	channel, ok := p.activeChannels.Load(chanID)
+	if !ok || channel == nil {
+		return ErrChannelNotFound
+	}
+
+	chanPoint := channel.ChannelPoint()
+	forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	chainEvents, err := p.cfg.ChainArb.SubscribeChannelEvents(*chanPoint)
+	if err != nil {
+		return err
+	}
+
+	// Both sides are in sync, as the channel was clean when the splice
+	// was negotiated, so the states don't need to be synced.
+	return p.addLink(
+		chanPoint, channel, forwardingPolicy, chainEvents, false,
+	)
+}
+
+// DrainSplicedChannel stops new HTLCs from being added to a channel whose
+// splice transaction has reached a reorg safe depth. Once the channel has no
+// HTLCs or pending updates left, its link is removed from the switch so that
+// the splice can be completed. Until then, ErrSpliceNotDrained is returned.
+func (p *Brontide) DrainSplicedChannel(chanPoint wire.OutPoint) error {
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+	channel, ok := p.activeChannels.Load(chanID)
+	if !ok || channel == nil {
+		return ErrChannelNotFound
+	}
+
+	// The link may already have been removed by an earlier attempt.
+	chanLink := p.fetchLinkFromKeyAndCid(chanID)
+	if chanLink != nil {
+		chanLink.DisableAdds()
+	}
+
+	if !channel.IsChannelClean() {
+		return contractcourt.ErrSpliceNotDrained
+	}
+
+	if chanLink == nil {
+		return nil
+	}
+
+	if err := p.tryLinkShutdown(chanID); err != nil {
+		return fmt.Errorf("%w: %v", contractcourt.ErrSpliceNotDrained,
+			err)
+	}
+
+	return nil
+}
+
+// A compile-time check to ensure that the chan splicer is able to splice
+// LightningChannels.
+var _ chansplicer.Channel = (*lnwallet.LightningChannel)(nil)
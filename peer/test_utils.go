@@ -484,6 +484,23 @@ func (m *mockUpdateHandler) MayAddOutgoingHtlc(lnwire.MilliSatoshi) error { retu
 // ShutdownIfChannelClean currently returns nil.
 func (m *mockUpdateHandler) ShutdownIfChannelClean() error { return nil }
 
+// Quiesce currently returns false and nil.
+func (m *mockUpdateHandler) Quiesce() (bool, error) { return false, nil }
+
+// Resume currently returns nil.
+func (m *mockUpdateHandler) Resume() error { return nil }
+
+// IsQuiescent currently returns false.
+func (m *mockUpdateHandler) IsQuiescent() bool { return false }
+
+// DisableAdds currently does nothing.
+func (m *mockUpdateHandler) DisableAdds() {}
+
+// UpgradeChannel currently returns nil.
+func (m *mockUpdateHandler) UpgradeChannel(*lnwallet.UpgradeProposal) error {
+	return nil
+}
+
 type mockMessageConn struct {
 	t *testing.T
 
@@ -0,0 +1,673 @@
+// Package prober warms up mission control for destinations that we haven't
+// paid before. A probe campaign periodically sends payments with a random
+// hash to a set of target nodes. The payments can't be settled, but every
+// attempt teaches mission control about the liquidity of the channels it
+// tried, just like a real payment would. A probe that reaches its target
+// fails with an unknown payment hash, which shows that the route can carry
+// the probed amount. Campaigns and their results are persisted, and active
+// campaigns are resumed when the prober is started.
+package prober
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// MinInterval is the minimum time between two probe rounds of a
+	// campaign.
+	MinInterval = time.Minute
+
+	// MaxActiveCampaigns is the maximum number of campaigns that may be
+	// active at the same time.
+	MaxActiveCampaigns = 16
+
+	// DefaultCampaignRetention is the default time for which campaigns
+	// are kept after they ended.
+	DefaultCampaignRetention = 7 * 24 * time.Hour
+
+	// pruneInterval is the time between two deletions of the campaigns
+	// that ended longer than the retention ago.
+	pruneInterval = time.Hour
+)
+
+var (
+	// ErrCampaignNotFound is returned when a campaign is requested that
+	// doesn't exist.
+	ErrCampaignNotFound = errors.New("probe campaign not found")
+
+	// ErrTooManyCampaigns is returned when a campaign is started while
+	// the maximum number of campaigns is active.
+	ErrTooManyCampaigns = fmt.Errorf("at most %v probe campaigns may be "+
+		"active", MaxActiveCampaigns)
+
+	// errShuttingDown is returned when the prober is shutting down.
+	errShuttingDown = errors.New("prober shutting down")
+)
+
+// ProbeRequest describes a single probe.
+type ProbeRequest struct {
+	// Target is the node that the probe is sent to.
+	Target route.Vertex
+
+	// OutgoingChan is the channel that the probe must leave our node
+	// through. If zero, any channel may be used.
+	OutgoingChan uint64
+
+	// Amount is the amount that the probe delivers to the target.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee of the routes that the probe may try.
+	FeeLimit lnwire.MilliSatoshi
+}
+
+// CampaignStore persists probe campaigns.
+type CampaignStore interface {
+	// PutProbeCampaign stores a probe campaign, replacing a previously
+	// stored state of the campaign.
+	PutProbeCampaign(*channeldb.ProbeCampaign) error
+
+	// FetchProbeCampaigns returns all stored probe campaigns, ordered by
+	// id.
+	FetchProbeCampaigns() ([]*channeldb.ProbeCampaign, error)
+
+	// DeleteProbeCampaigns deletes the campaigns that ended before the
+	// given time, and returns their ids.
+	DeleteProbeCampaigns(endedBefore time.Time) ([]uint64, error)
+}
+
+// Config holds the dependencies of the prober.
+type Config struct {
+	// SendProbe sends a probe and blocks until it is resolved. It returns
+	// nil if the probe reached its target, and the reason why it didn't
+	// otherwise. The attempts of the probe are reported to mission
+	// control.
+	SendProbe func(*ProbeRequest) error
+
+	// Clock is the time source of the prober.
+	Clock clock.Clock
+
+	// Store persists the campaigns.
+	Store CampaignStore
+
+	// Retention is the time for which campaigns are kept after they
+	// ended. If zero, ended campaigns are kept forever.
+	Retention time.Duration
+}
+
+// CampaignConfig describes the probes of a campaign.
+type CampaignConfig struct {
+	// Targets are the nodes that are probed.
+	Targets []route.Vertex
+
+	// OutgoingChans are the channels that the probes must leave our node
+	// through. Every target is probed through each of the channels. If
+	// empty, every target is probed once per round through any channel.
+	OutgoingChans []uint64
+
+	// Amount is the amount that every probe delivers to its target.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee of the routes that the probes may try.
+	// Probes are never settled, so the fee limit only restricts the
+	// routes that are probed. If zero, the routes aren't restricted by
+	// their fee.
+	FeeLimit lnwire.MilliSatoshi
+
+	// Interval is the time between the starts of two probe rounds.
+	Interval time.Duration
+
+	// MaxProbes is the budget of the campaign. The campaign ends once it
+	// sent this number of probes. The budget is a number of probes rather
+	// than an amount, because probes carry a random payment hash and are
+	// never settled, so they don't spend any funds. What a campaign does
+	// cost is the fee-free use of the htlc slots and liquidity of the
+	// channels along the probed routes, which grows with the number of
+	// probes.
+	MaxProbes uint32
+}
+
+// validate checks that the campaign config is sane.
+func (c *CampaignConfig) validate() error {
+	switch {
+	case len(c.Targets) == 0:
+		return errors.New("no probe targets")
+
+	case c.Amount == 0:
+		return errors.New("probe amount must be positive")
+
+	case c.Interval < MinInterval:
+		return fmt.Errorf("probe interval must be at least %v",
+			MinInterval)
+
+	case c.MaxProbes == 0:
+		return errors.New("max probes must be positive")
+	}
+
+	return nil
+}
+
+// ProbeResult is the outcome of the latest probe to a target through an
+// outgoing channel.
+type ProbeResult struct {
+	// Target is the node that the probe was sent to.
+	Target route.Vertex
+
+	// OutgoingChan is the channel that the probe had to leave our node
+	// through, or zero if any channel could be used.
+	OutgoingChan uint64
+
+	// Reached is true if the probe reached its target.
+	Reached bool
+
+	// Failure is the reason why the probe didn't reach its target.
+	Failure string
+
+	// Time is the time at which the probe was resolved.
+	Time time.Time
+}
+
+// CampaignInfo is a snapshot of the state of a campaign.
+type CampaignInfo struct {
+	// ID identifies the campaign.
+	ID uint64
+
+	// Config describes the probes of the campaign.
+	Config CampaignConfig
+
+	// Active is true while the campaign sends probes.
+	Active bool
+
+	// ProbesSent is the number of probes that the campaign sent.
+	ProbesSent uint32
+
+	// ProbesReached is the number of probes that reached their target.
+	ProbesReached uint32
+
+	// EndTime is the time at which the campaign ended. It is zero while
+	// the campaign is active.
+	EndTime time.Time
+
+	// Results holds the outcome of the latest probe to each target
+	// through each outgoing channel, in the order of the config.
+	Results []ProbeResult
+}
+
+// probeKey identifies the probes to a target through an outgoing channel.
+type probeKey struct {
+	target       route.Vertex
+	outgoingChan uint64
+}
+
+// campaign is a probe campaign that was started.
+type campaign struct {
+	id  uint64
+	cfg CampaignConfig
+
+	active        bool
+	probesSent    uint32
+	probesReached uint32
+	endTime       time.Time
+	results       map[probeKey]ProbeResult
+
+	// stop is closed when the campaign is stopped.
+	stop chan struct{}
+}
+
+// end marks the campaign as ended at the given time.
+func (c *campaign) end(now time.Time) {
+	c.active = false
+	c.endTime = now
+	close(c.stop)
+}
+
+// dbCampaign returns the persisted form of the campaign.
+func (c *campaign) dbCampaign() *channeldb.ProbeCampaign {
+	dbCampaign := &channeldb.ProbeCampaign{
+		ID:            c.id,
+		Targets:       c.cfg.Targets,
+		OutgoingChans: c.cfg.OutgoingChans,
+		Amount:        c.cfg.Amount,
+		FeeLimit:      c.cfg.FeeLimit,
+		Interval:      c.cfg.Interval,
+		MaxProbes:     c.cfg.MaxProbes,
+		Active:        c.active,
+		ProbesSent:    c.probesSent,
+		ProbesReached: c.probesReached,
+		EndTime:       c.endTime,
+	}
+	for _, key := range c.probeKeys() {
+		result, ok := c.results[key]
+		if !ok {
+			continue
+		}
+
+		dbCampaign.Results = append(
+			dbCampaign.Results, &channeldb.ProbeCampaignResult{
+				Target:       result.Target,
+				OutgoingChan: result.OutgoingChan,
+				Reached:      result.Reached,
+				Failure:      result.Failure,
+				Time:         result.Time,
+			},
+		)
+	}
+
+	return dbCampaign
+}
+
+// campaignFromDB restores a campaign from its persisted form.
+func campaignFromDB(dbCampaign *channeldb.ProbeCampaign) *campaign {
+	c := &campaign{
+		id: dbCampaign.ID,
+		cfg: CampaignConfig{
+			Targets:       dbCampaign.Targets,
+			OutgoingChans: dbCampaign.OutgoingChans,
+			Amount:        dbCampaign.Amount,
+			FeeLimit:      dbCampaign.FeeLimit,
+			Interval:      dbCampaign.Interval,
+			MaxProbes:     dbCampaign.MaxProbes,
+		},
+		active:        dbCampaign.Active,
+		probesSent:    dbCampaign.ProbesSent,
+		probesReached: dbCampaign.ProbesReached,
+		endTime:       dbCampaign.EndTime,
+		results:       make(map[probeKey]ProbeResult),
+		stop:          make(chan struct{}),
+	}
+	if len(c.cfg.OutgoingChans) == 0 {
+		c.cfg.OutgoingChans = nil
+	}
+	for _, result := range dbCampaign.Results {
+		key := probeKey{
+			target:       result.Target,
+			outgoingChan: result.OutgoingChan,
+		}
+		c.results[key] = ProbeResult{
+			Target:       result.Target,
+			OutgoingChan: result.OutgoingChan,
+			Reached:      result.Reached,
+			Failure:      result.Failure,
+			Time:         result.Time,
+		}
+	}
+	if !c.active {
+		close(c.stop)
+	}
+
+	return c
+}
+
+// probeKeys returns the probes of a round of the campaign.
+func (c *campaign) probeKeys() []probeKey {
+	outgoingChans := c.cfg.OutgoingChans
+	if len(outgoingChans) == 0 {
+		outgoingChans = []uint64{0}
+	}
+
+	keys := make([]probeKey, 0, len(c.cfg.Targets)*len(outgoingChans))
+	for _, target := range c.cfg.Targets {
+		for _, outgoingChan := range outgoingChans {
+			keys = append(keys, probeKey{
+				target:       target,
+				outgoingChan: outgoingChan,
+			})
+		}
+	}
+
+	return keys
+}
+
+// Prober runs probe campaigns.
+type Prober struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// campaigns holds all campaigns that weren't pruned yet, by id.
+	campaigns map[uint64]*campaign
+	nextID    uint64
+	mu        sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new prober from the given config.
+func New(cfg *Config) *Prober {
+	return &Prober{
+		cfg:       cfg,
+		campaigns: make(map[uint64]*campaign),
+		nextID:    1,
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start starts the prober. It loads the persisted campaigns, deletes the
+// ones that ended longer than the retention ago and resumes the active ones.
+func (p *Prober) Start() error {
+	var err error
+	p.started.Do(func() {
+		log.Info("Prober starting")
+		err = p.start()
+	})
+
+	return err
+}
+
+// start loads and resumes the persisted campaigns.
+func (p *Prober) start() error {
+	if err := p.prune(); err != nil {
+		return err
+	}
+
+	dbCampaigns, err := p.cfg.Store.FetchProbeCampaigns()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, dbCampaign := range dbCampaigns {
+		c := campaignFromDB(dbCampaign)
+		p.campaigns[c.id] = c
+		if c.id >= p.nextID {
+			p.nextID = c.id + 1
+		}
+
+		if !c.active {
+			continue
+		}
+
+		log.Infof("Resuming probe campaign %v: probes_sent=%v, "+
+			"max_probes=%v", c.id, c.probesSent, c.cfg.MaxProbes)
+
+		p.wg.Add(1)
+		go p.runCampaign(c)
+	}
+
+	if p.cfg.Retention > 0 {
+		p.wg.Add(1)
+		go p.pruneCampaigns()
+	}
+
+	return nil
+}
+
+// Stop stops the prober and all of its campaigns. Active campaigns are
+// resumed when the prober is started again.
+func (p *Prober) Stop() error {
+	p.stopped.Do(func() {
+		log.Info("Prober shutting down...")
+		defer log.Debug("Prober shutdown complete")
+
+		close(p.quit)
+		p.wg.Wait()
+	})
+
+	return nil
+}
+
+// StartCampaign starts a campaign that sends the described probes, and
+// returns its id.
+func (p *Prober) StartCampaign(cfg CampaignConfig) (uint64, error) {
+	if err := cfg.validate(); err != nil {
+		return 0, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	select {
+	case <-p.quit:
+		return 0, errShuttingDown
+	default:
+	}
+
+	var active int
+	for _, c := range p.campaigns {
+		if c.active {
+			active++
+		}
+	}
+	if active >= MaxActiveCampaigns {
+		return 0, ErrTooManyCampaigns
+	}
+
+	c := &campaign{
+		id:      p.nextID,
+		cfg:     cfg,
+		active:  true,
+		results: make(map[probeKey]ProbeResult),
+		stop:    make(chan struct{}),
+	}
+	if err := p.cfg.Store.PutProbeCampaign(c.dbCampaign()); err != nil {
+		return 0, err
+	}
+	p.campaigns[c.id] = c
+	p.nextID++
+
+	log.Infof("Starting probe campaign %v: targets=%v, amt=%v, "+
+		"interval=%v, max_probes=%v", c.id, len(cfg.Targets),
+		cfg.Amount, cfg.Interval, cfg.MaxProbes)
+
+	p.wg.Add(1)
+	go p.runCampaign(c)
+
+	return c.id, nil
+}
+
+// StopCampaign stops the campaign with the given id. A probe that is in
+// flight is still resolved.
+func (p *Prober) StopCampaign(id uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	c, ok := p.campaigns[id]
+	if !ok {
+		return ErrCampaignNotFound
+	}
+
+	if !c.active {
+		return nil
+	}
+
+	log.Infof("Stopping probe campaign %v", id)
+
+	c.end(p.cfg.Clock.Now())
+
+	return p.cfg.Store.PutProbeCampaign(c.dbCampaign())
+}
+
+// Campaigns returns a snapshot of all campaigns, ordered by id.
+func (p *Prober) Campaigns() []*CampaignInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	infos := make([]*CampaignInfo, 0, len(p.campaigns))
+	for id := uint64(1); id < p.nextID; id++ {
+		c, ok := p.campaigns[id]
+		if !ok {
+			continue
+		}
+
+		info := &CampaignInfo{
+			ID:            c.id,
+			Config:        c.cfg,
+			Active:        c.active,
+			ProbesSent:    c.probesSent,
+			ProbesReached: c.probesReached,
+			EndTime:       c.endTime,
+		}
+		for _, key := range c.probeKeys() {
+			result, ok := c.results[key]
+			if ok {
+				info.Results = append(info.Results, result)
+			}
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// runCampaign sends the probes of the campaign in rounds until its budget is
+// spent or it is stopped. The probes of a round are sent one after the other,
+// so that a campaign never locks more than the probe amount in htlcs.
+func (p *Prober) runCampaign(c *campaign) {
+	defer p.wg.Done()
+
+	keys := c.probeKeys()
+	for {
+		roundStart := p.cfg.Clock.Now()
+
+		for _, key := range keys {
+			if !p.probe(c, key) {
+				return
+			}
+		}
+
+		wait := c.cfg.Interval - p.cfg.Clock.Now().Sub(roundStart)
+		if wait < 0 {
+			wait = 0
+		}
+
+		select {
+		case <-p.cfg.Clock.TickAfter(wait):
+
+		case <-c.stop:
+			return
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// probe sends a probe of the campaign and records its result. It returns
+// false if the campaign must not send further probes.
+func (p *Prober) probe(c *campaign, key probeKey) bool {
+	p.mu.Lock()
+	if !c.active {
+		p.mu.Unlock()
+		return false
+	}
+
+	select {
+	case <-p.quit:
+		p.mu.Unlock()
+		return false
+	default:
+	}
+
+	c.probesSent++
+	p.mu.Unlock()
+
+	feeLimit := c.cfg.FeeLimit
+	if feeLimit == 0 {
+		feeLimit = lnwire.MaxMilliSatoshi
+	}
+
+	err := p.cfg.SendProbe(&ProbeRequest{
+		Target:       key.target,
+		OutgoingChan: key.outgoingChan,
+		Amount:       c.cfg.Amount,
+		FeeLimit:     feeLimit,
+	})
+
+	result := ProbeResult{
+		Target:       key.target,
+		OutgoingChan: key.outgoingChan,
+		Reached:      err == nil,
+		Time:         p.cfg.Clock.Now(),
+	}
+	if err != nil {
+		result.Failure = err.Error()
+	}
+
+	log.Debugf("Probe of campaign %v to %v via channel %v: reached=%v, "+
+		"failure=%v", c.id, key.target, key.outgoingChan,
+		result.Reached, result.Failure)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	c.results[key] = result
+	if result.Reached {
+		c.probesReached++
+	}
+
+	// The campaign ends once its budget is spent.
+	if c.probesSent >= c.cfg.MaxProbes && c.active {
+		log.Infof("Probe campaign %v sent all %v probes", c.id,
+			c.probesSent)
+
+		c.end(result.Time)
+	}
+
+	// A failure to persist the result doesn't end the campaign, the
+	// result is persisted with the next one.
+	if err := p.cfg.Store.PutProbeCampaign(c.dbCampaign()); err != nil {
+		log.Errorf("Unable to store probe campaign %v: %v", c.id, err)
+	}
+
+	return c.active
+}
+
+// pruneCampaigns periodically deletes the campaigns that ended longer than
+// the retention ago.
+func (p *Prober) pruneCampaigns() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.cfg.Clock.TickAfter(pruneInterval):
+			if err := p.prune(); err != nil {
+				log.Errorf("Unable to prune probe campaigns: %v",
+					err)
+			}
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// prune deletes the campaigns that ended longer than the retention ago.
+func (p *Prober) prune() error {
+	if p.cfg.Retention == 0 {
+		return nil
+	}
+
+	ids, err := p.cfg.Store.DeleteProbeCampaigns(
+		p.cfg.Clock.Now().Add(-p.cfg.Retention),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	log.Debugf("Deleted %v ended probe campaigns", len(ids))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, id := range ids {
+		delete(p.campaigns, id)
+	}
+
+	return nil
+}
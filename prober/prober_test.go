@@ -0,0 +1,433 @@
+package prober
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTimeout = 5 * time.Second
+
+	testInterval = 10 * time.Minute
+
+	reachableNode   = route.Vertex{1}
+	unreachableNode = route.Vertex{2}
+
+	errNoRoute = errors.New("no route")
+)
+
+// mockStore is an in-memory CampaignStore.
+type mockStore struct {
+	campaigns map[uint64]*channeldb.ProbeCampaign
+	mu        sync.Mutex
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		campaigns: make(map[uint64]*channeldb.ProbeCampaign),
+	}
+}
+
+func (m *mockStore) PutProbeCampaign(c *channeldb.ProbeCampaign) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.campaigns[c.ID] = c
+
+	return nil
+}
+
+func (m *mockStore) FetchProbeCampaigns() ([]*channeldb.ProbeCampaign,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	campaigns := make([]*channeldb.ProbeCampaign, 0, len(m.campaigns))
+	for _, c := range m.campaigns {
+		campaigns = append(campaigns, c)
+	}
+	sort.Slice(campaigns, func(i, j int) bool {
+		return campaigns[i].ID < campaigns[j].ID
+	})
+
+	return campaigns, nil
+}
+
+func (m *mockStore) DeleteProbeCampaigns(endedBefore time.Time) ([]uint64,
+	error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var deleted []uint64
+	for id, c := range m.campaigns {
+		if !c.Active && c.EndTime.Before(endedBefore) {
+			delete(m.campaigns, id)
+			deleted = append(deleted, id)
+		}
+	}
+
+	return deleted, nil
+}
+
+// campaign returns the stored campaign with the given id.
+func (m *mockStore) campaign(id uint64) *channeldb.ProbeCampaign {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.campaigns[id]
+}
+
+// proberTestContext holds a prober whose probes are recorded instead of sent.
+type proberTestContext struct {
+	t          *testing.T
+	prober     *Prober
+	store      *mockStore
+	clock      *clock.TestClock
+	tickSignal chan time.Duration
+	probes     chan *ProbeRequest
+}
+
+func newProberTestContext(t *testing.T) *proberTestContext {
+	t.Helper()
+
+	return newProberTestContextWithStore(t, newMockStore())
+}
+
+// newProberTestContextWithStore creates a prober that resumes the campaigns
+// of the given store.
+func newProberTestContextWithStore(t *testing.T,
+	store *mockStore) *proberTestContext {
+
+	t.Helper()
+
+	tickSignal := make(chan time.Duration, 1)
+	testClock := clock.NewTestClockWithTickSignal(time.Unix(1, 0),
+		tickSignal)
+
+	probes := make(chan *ProbeRequest, 10)
+	prober := New(&Config{
+		SendProbe: func(req *ProbeRequest) error {
+			probes <- req
+
+			if req.Target == unreachableNode {
+				return errNoRoute
+			}
+
+			return nil
+		},
+		Clock: testClock,
+		Store: store,
+	})
+	require.NoError(t, prober.Start())
+	t.Cleanup(func() {
+		require.NoError(t, prober.Stop())
+	})
+
+	return &proberTestContext{
+		t:          t,
+		prober:     prober,
+		store:      store,
+		clock:      testClock,
+		tickSignal: tickSignal,
+		probes:     probes,
+	}
+}
+
+// expectProbe asserts that a probe to the given target through the given
+// channel was sent.
+func (c *proberTestContext) expectProbe(target route.Vertex,
+	outgoingChan uint64) {
+
+	c.t.Helper()
+
+	select {
+	case req := <-c.probes:
+		require.Equal(c.t, target, req.Target)
+		require.Equal(c.t, outgoingChan, req.OutgoingChan)
+		require.EqualValues(c.t, 1000, req.Amount)
+
+	case <-time.After(testTimeout):
+		c.t.Fatal("no probe sent")
+	}
+}
+
+// expectRoundEnd asserts that the campaign waits for its next round.
+func (c *proberTestContext) expectRoundEnd() {
+	c.t.Helper()
+
+	select {
+	case wait := <-c.tickSignal:
+		require.Equal(c.t, testInterval, wait)
+
+	case <-time.After(testTimeout):
+		c.t.Fatal("round didn't end")
+	}
+}
+
+// campaign returns the snapshot of the only campaign.
+func (c *proberTestContext) campaign() *CampaignInfo {
+	c.t.Helper()
+
+	campaigns := c.prober.Campaigns()
+	require.Len(c.t, campaigns, 1)
+
+	return campaigns[0]
+}
+
+// TestCampaignBudget tests that a campaign probes every target through every
+// outgoing channel in each round, until its budget is spent.
+func TestCampaignBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := newProberTestContext(t)
+
+	id, err := ctx.prober.StartCampaign(CampaignConfig{
+		Targets:       []route.Vertex{reachableNode, unreachableNode},
+		OutgoingChans: []uint64{5, 6},
+		Amount:        1000,
+		Interval:      testInterval,
+		MaxProbes:     6,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, id)
+
+	ctx.expectProbe(reachableNode, 5)
+	ctx.expectProbe(reachableNode, 6)
+	ctx.expectProbe(unreachableNode, 5)
+	ctx.expectProbe(unreachableNode, 6)
+	ctx.expectRoundEnd()
+
+	info := ctx.campaign()
+	require.True(t, info.Active)
+	require.EqualValues(t, 4, info.ProbesSent)
+	require.EqualValues(t, 2, info.ProbesReached)
+	require.Len(t, info.Results, 4)
+	require.True(t, info.Results[0].Reached)
+	require.Equal(t, uint64(6), info.Results[1].OutgoingChan)
+	require.False(t, info.Results[2].Reached)
+	require.Equal(t, errNoRoute.Error(), info.Results[2].Failure)
+
+	// The second round is cut short by the budget.
+	ctx.clock.SetTime(ctx.clock.Now().Add(testInterval))
+	ctx.expectProbe(reachableNode, 5)
+	ctx.expectProbe(reachableNode, 6)
+
+	require.Eventually(t, func() bool {
+		return !ctx.campaign().Active
+	}, testTimeout, 10*time.Millisecond)
+
+	info = ctx.campaign()
+	require.EqualValues(t, 6, info.ProbesSent)
+	require.EqualValues(t, 4, info.ProbesReached)
+	require.Empty(t, ctx.probes)
+}
+
+// TestCampaignStop tests that a stopped campaign doesn't send further probes.
+func TestCampaignStop(t *testing.T) {
+	t.Parallel()
+
+	ctx := newProberTestContext(t)
+
+	id, err := ctx.prober.StartCampaign(CampaignConfig{
+		Targets:   []route.Vertex{reachableNode},
+		Amount:    1000,
+		Interval:  testInterval,
+		MaxProbes: 100,
+	})
+	require.NoError(t, err)
+
+	ctx.expectProbe(reachableNode, 0)
+	ctx.expectRoundEnd()
+
+	require.NoError(t, ctx.prober.StopCampaign(id))
+	require.False(t, ctx.campaign().Active)
+
+	// Stopping a campaign twice is allowed, but unknown campaigns can't be
+	// stopped.
+	require.NoError(t, ctx.prober.StopCampaign(id))
+	require.ErrorIs(t, ctx.prober.StopCampaign(id+1), ErrCampaignNotFound)
+
+	ctx.clock.SetTime(ctx.clock.Now().Add(testInterval))
+	require.Never(t, func() bool {
+		return len(ctx.probes) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+// TestCampaignValidation tests that campaigns with an invalid config are
+// rejected, and that the number of active campaigns is limited.
+func TestCampaignValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := newProberTestContext(t)
+
+	valid := CampaignConfig{
+		Targets:   []route.Vertex{reachableNode},
+		Amount:    1000,
+		Interval:  testInterval,
+		MaxProbes: 1,
+	}
+
+	cfg := valid
+	cfg.Targets = nil
+	_, err := ctx.prober.StartCampaign(cfg)
+	require.Error(t, err)
+
+	cfg = valid
+	cfg.Amount = 0
+	_, err = ctx.prober.StartCampaign(cfg)
+	require.Error(t, err)
+
+	cfg = valid
+	cfg.Interval = time.Second
+	_, err = ctx.prober.StartCampaign(cfg)
+	require.Error(t, err)
+
+	cfg = valid
+	cfg.MaxProbes = 0
+	_, err = ctx.prober.StartCampaign(cfg)
+	require.Error(t, err)
+
+	// Campaigns that never get to send their probe stay active.
+	ctx.prober.mu.Lock()
+	for i := 0; i < MaxActiveCampaigns; i++ {
+		ctx.prober.campaigns[ctx.prober.nextID] = &campaign{
+			id:     ctx.prober.nextID,
+			active: true,
+		}
+		ctx.prober.nextID++
+	}
+	ctx.prober.mu.Unlock()
+
+	_, err = ctx.prober.StartCampaign(valid)
+	require.ErrorIs(t, err, ErrTooManyCampaigns)
+}
+
+// TestCampaignResume tests that campaigns are persisted with their results,
+// and that active campaigns are resumed when the prober is started again.
+func TestCampaignResume(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	ctx := newProberTestContextWithStore(t, store)
+
+	cfg := CampaignConfig{
+		Targets:   []route.Vertex{reachableNode, unreachableNode},
+		Amount:    1000,
+		Interval:  testInterval,
+		MaxProbes: 3,
+	}
+	stoppedID, err := ctx.prober.StartCampaign(cfg)
+	require.NoError(t, err)
+
+	ctx.expectProbe(reachableNode, 0)
+	ctx.expectProbe(unreachableNode, 0)
+	ctx.expectRoundEnd()
+	require.NoError(t, ctx.prober.StopCampaign(stoppedID))
+
+	activeID, err := ctx.prober.StartCampaign(cfg)
+	require.NoError(t, err)
+
+	ctx.expectProbe(reachableNode, 0)
+	ctx.expectProbe(unreachableNode, 0)
+	ctx.expectRoundEnd()
+
+	stopped := store.campaign(stoppedID)
+	require.False(t, stopped.Active)
+	require.Equal(t, ctx.clock.Now(), stopped.EndTime)
+
+	active := store.campaign(activeID)
+	require.True(t, active.Active)
+	require.EqualValues(t, 2, active.ProbesSent)
+	require.EqualValues(t, 1, active.ProbesReached)
+	require.Len(t, active.Results, 2)
+	require.Equal(t, errNoRoute.Error(), active.Results[1].Failure)
+
+	require.NoError(t, ctx.prober.Stop())
+
+	// After a restart, only the active campaign sends its remaining
+	// probe, and new campaigns get a fresh id.
+	ctx = newProberTestContextWithStore(t, store)
+	ctx.expectProbe(reachableNode, 0)
+
+	require.Eventually(t, func() bool {
+		return !store.campaign(activeID).Active
+	}, testTimeout, 10*time.Millisecond)
+
+	campaigns := ctx.prober.Campaigns()
+	require.Len(t, campaigns, 2)
+	require.False(t, campaigns[1].Active)
+	require.EqualValues(t, 3, campaigns[1].ProbesSent)
+	require.EqualValues(t, 2, campaigns[1].ProbesReached)
+	require.Len(t, campaigns[1].Results, 2)
+	require.Empty(t, ctx.probes)
+
+	cfg.MaxProbes = 1
+	id, err := ctx.prober.StartCampaign(cfg)
+	require.NoError(t, err)
+	require.Equal(t, activeID+1, id)
+}
+
+// TestCampaignRetention tests that campaigns are deleted once they ended
+// longer than the retention ago.
+func TestCampaignRetention(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	retention := time.Hour
+
+	store := newMockStore()
+	for _, c := range []*channeldb.ProbeCampaign{{
+		ID:      1,
+		EndTime: now.Add(-retention - time.Second),
+	}, {
+		ID:      2,
+		EndTime: now.Add(-retention + pruneInterval/2),
+	}} {
+		require.NoError(t, store.PutProbeCampaign(c))
+	}
+
+	tickSignal := make(chan time.Duration, 1)
+	testClock := clock.NewTestClockWithTickSignal(now, tickSignal)
+	prober := New(&Config{
+		SendProbe: func(*ProbeRequest) error {
+			return nil
+		},
+		Clock:     testClock,
+		Store:     store,
+		Retention: retention,
+	})
+	require.NoError(t, prober.Start())
+	t.Cleanup(func() {
+		require.NoError(t, prober.Stop())
+	})
+
+	// The first campaign is deleted when the prober starts.
+	campaigns := prober.Campaigns()
+	require.Len(t, campaigns, 1)
+	require.EqualValues(t, 2, campaigns[0].ID)
+	require.Nil(t, store.campaign(1))
+
+	// The second one is deleted by the next periodic prune.
+	select {
+	case wait := <-tickSignal:
+		require.Equal(t, pruneInterval, wait)
+
+	case <-time.After(testTimeout):
+		t.Fatal("prune not scheduled")
+	}
+	testClock.SetTime(now.Add(pruneInterval))
+	require.Eventually(t, func() bool {
+		return len(prober.Campaigns()) == 0
+	}, testTimeout, 10*time.Millisecond)
+	require.Nil(t, store.campaign(2))
+}
@@ -0,0 +1,430 @@
+package record
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// PaddingType is the type of the padding record within the encrypted
+	// data of a blinded hop. It is used to make all the encrypted payloads
+	// of a blinded path the same size.
+	PaddingType tlv.Type = 1
+
+	// ShortChannelIDType is the type of the record that holds the short
+	// channel id of the outgoing channel that the HTLC should be
+	// forwarded over.
+	ShortChannelIDType tlv.Type = 2
+
+	// NextNodeIDType is the type of the record that holds the node id of
+	// the next node in the blinded path.
+	NextNodeIDType tlv.Type = 4
+
+	// PathIDType is the type of the record that holds the path id that the
+	// creator of a blinded path included for itself in the final hop.
+	PathIDType tlv.Type = 6
+
+	// NextBlindingOverrideType is the type of the record that holds a
+	// blinding point that replaces the one derived for the next hop.
+	NextBlindingOverrideType tlv.Type = 8
+
+	// PaymentRelayType is the type of the record that holds the forwarding
+	// parameters that a relaying node must apply.
+	PaymentRelayType tlv.Type = 10
+
+	// PaymentConstraintsType is the type of the record that holds the
+	// constraints that a blinded HTLC must satisfy.
+	PaymentConstraintsType tlv.Type = 12
+
+	// AllowedFeaturesType is the type of the record that holds the
+	// features that may be used by a payment through the blinded hop.
+	AllowedFeaturesType tlv.Type = 14
+)
+
+// ErrBlindedDataMissingRelay is returned when a forwarding hop's encrypted
+// data doesn't include payment relay information.
+var ErrBlindedDataMissingRelay = errors.New("blinded forwarding data missing " +
+	"payment relay")
+
+// PaymentRelayInfo describes the forwarding parameters that a node in a
+// blinded path must apply when relaying an HTLC to the next hop.
+type PaymentRelayInfo struct {
+	// CltvExpiryDelta is the expiry delta that the relaying node must
+	// subtract from the incoming HTLC's expiry.
+	CltvExpiryDelta uint16
+
+	// FeeRate is the proportional fee, in millionths, that the relaying
+	// node charges.
+	FeeRate uint32
+
+	// BaseFee is the base fee that the relaying node charges.
+	BaseFee lnwire.MilliSatoshi
+}
+
+// PaymentConstraints describes the restrictions that the creator of a blinded
+// path places on HTLCs flowing through one of its hops.
+type PaymentConstraints struct {
+	// MaxCltvExpiry is the maximum absolute expiry that an HTLC arriving
+	// at the hop may have. It limits the lifetime of the blinded path.
+	MaxCltvExpiry uint32
+
+	// HtlcMinimumMsat is the minimum amount that an HTLC arriving at the
+	// hop must carry.
+	HtlcMinimumMsat lnwire.MilliSatoshi
+}
+
+// BlindedRouteData is the data that the creator of a blinded path encrypts to
+// each of the hops in the path. Forwarding hops receive the next hop along
+// with their relay parameters, while the final hop receives the path id that
+// lets the recipient recognize its own path.
+type BlindedRouteData struct {
+	// Padding is an optional set of bytes that is used to pad the
+	// encrypted data of all hops to the same length.
+	Padding []byte
+
+	// ShortChannelID is the channel that the HTLC should be forwarded
+	// over.
+	ShortChannelID *lnwire.ShortChannelID
+
+	// NextNodeID is the node that the HTLC should be forwarded to. It may
+	// be set instead of ShortChannelID.
+	NextNodeID *btcec.PublicKey
+
+	// PathID is the identifier that the recipient included for itself.
+	// It is only set for the final hop of a blinded path.
+	PathID []byte
+
+	// NextBlindingOverride is an optional blinding point that should be
+	// sent to the next hop instead of the one derived from the current
+	// blinding point.
+	NextBlindingOverride *btcec.PublicKey
+
+	// RelayInfo holds the forwarding parameters of a relaying hop.
+	RelayInfo *PaymentRelayInfo
+
+	// Constraints holds the restrictions that the incoming HTLC must
+	// satisfy.
+	Constraints *PaymentConstraints
+
+	// Features is the set of features that may be used by a payment
+	// through this hop.
+	Features *lnwire.FeatureVector
+}
+
+// IsFinal returns true if the data is destined for the final hop of a blinded
+// path, which is the case when no next hop is specified.
+func (b *BlindedRouteData) IsFinal() bool {
+	return b.ShortChannelID == nil && b.NextNodeID == nil
+}
+
+// Validate checks that a forwarding hop's data carries everything that is
+// required to relay an HTLC.
+func (b *BlindedRouteData) Validate() error {
+	if b.IsFinal() {
+		return nil
+	}
+
+	if b.RelayInfo == nil {
+		return ErrBlindedDataMissingRelay
+	}
+
+	return nil
+}
+
+// EncodeBlindedRouteData encodes the passed route data as a TLV stream that
+// can be encrypted to a hop of a blinded path.
+func EncodeBlindedRouteData(data *BlindedRouteData) ([]byte, error) {
+	var records []tlv.Record
+
+	if len(data.Padding) > 0 {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				PaddingType, &data.Padding,
+			),
+		)
+	}
+
+	if data.ShortChannelID != nil {
+		scid := data.ShortChannelID.ToUint64()
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				ShortChannelIDType, &scid,
+			),
+		)
+	}
+
+	if data.NextNodeID != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				NextNodeIDType, &data.NextNodeID,
+			),
+		)
+	}
+
+	if len(data.PathID) > 0 {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				PathIDType, &data.PathID,
+			),
+		)
+	}
+
+	if data.NextBlindingOverride != nil {
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				NextBlindingOverrideType,
+				&data.NextBlindingOverride,
+			),
+		)
+	}
+
+	if data.RelayInfo != nil {
+		records = append(records, newPaymentRelayRecord(data.RelayInfo))
+	}
+
+	if data.Constraints != nil {
+		records = append(
+			records, newPaymentConstraintsRecord(data.Constraints),
+		)
+	}
+
+	if data.Features != nil {
+		var b bytes.Buffer
+		err := data.Features.RawFeatureVector.EncodeBase256(&b)
+		if err != nil {
+			return nil, err
+		}
+		features := b.Bytes()
+
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				AllowedFeaturesType, &features,
+			),
+		)
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeBlindedRouteData decodes the TLV encoded route data of a blinded hop
+// from the passed reader.
+func DecodeBlindedRouteData(r io.Reader) (*BlindedRouteData, error) {
+	var (
+		data BlindedRouteData
+
+		padding          []byte
+		scid             uint64
+		nextNodeID       *btcec.PublicKey
+		pathID           []byte
+		blindingOverride *btcec.PublicKey
+		relayInfo        PaymentRelayInfo
+		constraints      PaymentConstraints
+		features         []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(PaddingType, &padding),
+		tlv.MakePrimitiveRecord(ShortChannelIDType, &scid),
+		tlv.MakePrimitiveRecord(NextNodeIDType, &nextNodeID),
+		tlv.MakePrimitiveRecord(PathIDType, &pathID),
+		tlv.MakePrimitiveRecord(
+			NextBlindingOverrideType, &blindingOverride,
+		),
+		newPaymentRelayRecord(&relayInfo),
+		newPaymentConstraintsRecord(&constraints),
+		tlv.MakePrimitiveRecord(AllowedFeaturesType, &features),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[PaddingType]; ok {
+		data.Padding = padding
+	}
+
+	if _, ok := parsedTypes[ShortChannelIDType]; ok {
+		chanID := lnwire.NewShortChanIDFromInt(scid)
+		data.ShortChannelID = &chanID
+	}
+
+	if _, ok := parsedTypes[NextNodeIDType]; ok {
+		data.NextNodeID = nextNodeID
+	}
+
+	if _, ok := parsedTypes[PathIDType]; ok {
+		data.PathID = pathID
+	}
+
+	if _, ok := parsedTypes[NextBlindingOverrideType]; ok {
+		data.NextBlindingOverride = blindingOverride
+	}
+
+	if _, ok := parsedTypes[PaymentRelayType]; ok {
+		data.RelayInfo = &relayInfo
+	}
+
+	if _, ok := parsedTypes[PaymentConstraintsType]; ok {
+		data.Constraints = &constraints
+	}
+
+	if _, ok := parsedTypes[AllowedFeaturesType]; ok {
+		rawFeatures := lnwire.NewRawFeatureVector()
+		err := rawFeatures.DecodeBase256(
+			bytes.NewReader(features), len(features),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		data.Features = lnwire.NewFeatureVector(
+			rawFeatures, lnwire.Features,
+		)
+	}
+
+	return &data, nil
+}
+
+// newPaymentRelayRecord creates a tlv.Record that encodes the payment_relay
+// (type 10) record of a blinded hop's route data.
+func newPaymentRelayRecord(info *PaymentRelayInfo) tlv.Record {
+	size := func() uint64 {
+		// 2 byte cltv delta, 4 byte fee rate and the truncated base
+		// fee.
+		return 2 + 4 + tlv.SizeTUint32(uint32(info.BaseFee))
+	}
+
+	return tlv.MakeDynamicRecord(
+		PaymentRelayType, info, size, encodePaymentRelay,
+		decodePaymentRelay,
+	)
+}
+
+// encodePaymentRelay is a custom TLV encoder for the PaymentRelayInfo record.
+func encodePaymentRelay(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*PaymentRelayInfo); ok {
+		err := tlv.EUint16T(w, v.CltvExpiryDelta, buf)
+		if err != nil {
+			return err
+		}
+
+		if err := tlv.EUint32T(w, v.FeeRate, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint32T(w, uint32(v.BaseFee), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentRelayInfo")
+}
+
+// decodePaymentRelay is a custom TLV decoder for the PaymentRelayInfo record.
+func decodePaymentRelay(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentRelayInfo); ok && 6 <= l && l <= 10 {
+		err := tlv.DUint16(r, &v.CltvExpiryDelta, buf, 2)
+		if err != nil {
+			return err
+		}
+
+		if err := tlv.DUint32(r, &v.FeeRate, buf, 4); err != nil {
+			return err
+		}
+
+		var baseFee uint32
+		if err := tlv.DTUint32(r, &baseFee, buf, l-6); err != nil {
+			return err
+		}
+		v.BaseFee = lnwire.MilliSatoshi(baseFee)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentRelayInfo", l, 10)
+}
+
+// newPaymentConstraintsRecord creates a tlv.Record that encodes the
+// payment_constraints (type 12) record of a blinded hop's route data.
+func newPaymentConstraintsRecord(constraints *PaymentConstraints) tlv.Record {
+	size := func() uint64 {
+		// 4 byte max cltv expiry and the truncated htlc minimum.
+		return 4 + tlv.SizeTUint64(
+			uint64(constraints.HtlcMinimumMsat),
+		)
+	}
+
+	return tlv.MakeDynamicRecord(
+		PaymentConstraintsType, constraints, size,
+		encodePaymentConstraints, decodePaymentConstraints,
+	)
+}
+
+// encodePaymentConstraints is a custom TLV encoder for the PaymentConstraints
+// record.
+func encodePaymentConstraints(w io.Writer, val interface{},
+	buf *[8]byte) error {
+
+	if v, ok := val.(*PaymentConstraints); ok {
+		if err := tlv.EUint32T(w, v.MaxCltvExpiry, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint64T(w, uint64(v.HtlcMinimumMsat), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentConstraints")
+}
+
+// decodePaymentConstraints is a custom TLV decoder for the
+// PaymentConstraints record.
+func decodePaymentConstraints(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentConstraints); ok && 4 <= l && l <= 12 {
+		if err := tlv.DUint32(r, &v.MaxCltvExpiry, buf, 4); err != nil {
+			return err
+		}
+
+		var htlcMin uint64
+		if err := tlv.DTUint64(r, &htlcMin, buf, l-4); err != nil {
+			return err
+		}
+		v.HtlcMinimumMsat = lnwire.MilliSatoshi(htlcMin)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentConstraints", l, 12)
+}
+
+// String returns a human-readable representation of the route data.
+func (b *BlindedRouteData) String() string {
+	if b == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("scid=%v, next_node=%v, final=%v, relay=%v, "+
+		"constraints=%v", b.ShortChannelID, b.NextNodeID != nil,
+		b.IsFinal(), b.RelayInfo, b.Constraints)
+}
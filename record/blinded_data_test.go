@@ -0,0 +1,121 @@
+package record
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// pubKey parses a hex encoded public key, failing the test if the key is
+// invalid.
+func pubKey(t *testing.T, s string) *btcec.PublicKey {
+	t.Helper()
+
+	h, err := hex.DecodeString(s)
+	require.NoError(t, err)
+
+	key, err := btcec.ParsePubKey(h)
+	require.NoError(t, err)
+
+	return key
+}
+
+// TestBlindedRouteDataEncodeDecode tests that blinded route data for both
+// forwarding and final hops survives an encoding round trip.
+func TestBlindedRouteDataEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	nodeID := pubKey(t, "032c0b7cf95324a07d05398b240174dc0c2be444d96b159aa"+
+		"6c7f7b1e668680991")
+	scid := lnwire.NewShortChanIDFromInt(0x0102030405060708)
+
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.AMPOptional),
+		lnwire.Features,
+	)
+
+	tests := []struct {
+		name string
+		data *BlindedRouteData
+	}{
+		{
+			name: "forwarding hop",
+			data: &BlindedRouteData{
+				ShortChannelID: &scid,
+				RelayInfo: &PaymentRelayInfo{
+					CltvExpiryDelta: 144,
+					FeeRate:         500,
+					BaseFee:         1000,
+				},
+				Constraints: &PaymentConstraints{
+					MaxCltvExpiry:   1_000_000,
+					HtlcMinimumMsat: 1,
+				},
+				Features: features,
+			},
+		},
+		{
+			name: "dummy hop",
+			data: &BlindedRouteData{
+				Padding:    []byte{0, 0, 0},
+				NextNodeID: nodeID,
+				RelayInfo:  &PaymentRelayInfo{},
+				Constraints: &PaymentConstraints{
+					MaxCltvExpiry: 500,
+				},
+				NextBlindingOverride: nodeID,
+			},
+		},
+		{
+			name: "final hop",
+			data: &BlindedRouteData{
+				PathID: bytes.Repeat([]byte{1}, 32),
+				Constraints: &PaymentConstraints{
+					MaxCltvExpiry:   800_000,
+					HtlcMinimumMsat: 1000,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := EncodeBlindedRouteData(test.data)
+			require.NoError(t, err)
+
+			decoded, err := DecodeBlindedRouteData(
+				bytes.NewReader(encoded),
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.data, decoded)
+			require.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+// TestBlindedRouteDataValidate tests that forwarding data without relay
+// information is rejected.
+func TestBlindedRouteDataValidate(t *testing.T) {
+	t.Parallel()
+
+	scid := lnwire.NewShortChanIDFromInt(1)
+	data := &BlindedRouteData{
+		ShortChannelID: &scid,
+	}
+	require.False(t, data.IsFinal())
+	require.ErrorIs(t, data.Validate(), ErrBlindedDataMissingRelay)
+
+	final := &BlindedRouteData{
+		PathID: []byte{1, 2, 3},
+	}
+	require.True(t, final.IsFinal())
+	require.NoError(t, final.Validate())
+}
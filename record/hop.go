@@ -33,6 +33,10 @@ const (
 	// TotalAmtMsatBlindedType is the type used in the onion for the total
 	// amount field that is included in the final hop for blinded payments.
 	TotalAmtMsatBlindedType tlv.Type = 18
+
+	// TrampolineOnionType is the type used in the onion of a trampoline
+	// node to hold the instructions to reach the final recipient.
+	TrampolineOnionType tlv.Type = 20
 )
 
 // NewAmtToFwdRecord creates a tlv.Record that encodes the amount_to_forward
@@ -97,3 +101,9 @@ func NewTotalAmtMsatBlinded(amt *uint64) tlv.Record {
 		tlv.ETUint64, tlv.DTUint64,
 	)
 }
+
+// NewTrampolineOnionRecord creates a tlv.Record that encodes the
+// trampoline_onion (type 20) record for an onion payload.
+func NewTrampolineOnionRecord(onion *[]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(TrampolineOnionType, onion)
+}
@@ -0,0 +1,428 @@
+package record
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// TrampolineAmtType is the type of the record that holds the amount
+	// that the trampoline node must deliver to the recipient.
+	TrampolineAmtType tlv.Type = 2
+
+	// TrampolineLockTimeType is the type of the record that holds the
+	// final cltv expiry that the recipient must receive.
+	TrampolineLockTimeType tlv.Type = 4
+
+	// TrampolineNextNodeType is the type of the record that holds the node
+	// id of the recipient.
+	TrampolineNextNodeType tlv.Type = 6
+
+	// TrampolineMPPType is the type of the record that holds the payment
+	// address and total amount of the payment to the recipient. It uses
+	// the same encoding as the MPP record of an onion payload.
+	TrampolineMPPType tlv.Type = 8
+
+	// TrampolineFeaturesType is the type of the record that holds the
+	// invoice features of the recipient.
+	TrampolineFeaturesType tlv.Type = 10
+
+	// TrampolineRouteHintsType is the type of the record that holds the
+	// route hints of the recipient's invoice.
+	TrampolineRouteHintsType tlv.Type = 12
+)
+
+const (
+	// trampolineHopHintSize is the size of a serialized hop hint: a 33
+	// byte node id, an 8 byte channel id, a 4 byte base fee, a 4 byte fee
+	// rate and a 2 byte cltv delta.
+	trampolineHopHintSize = 33 + 8 + 4 + 4 + 2
+)
+
+var (
+	// ErrTrampolineMissingAmt is returned when a trampoline payload
+	// doesn't include the amount to deliver to the recipient.
+	ErrTrampolineMissingAmt = errors.New("trampoline payload missing " +
+		"amount")
+
+	// ErrTrampolineMissingLockTime is returned when a trampoline payload
+	// doesn't include the final cltv expiry of the recipient.
+	ErrTrampolineMissingLockTime = errors.New("trampoline payload " +
+		"missing lock time")
+
+	// ErrTrampolineMissingNextNode is returned when a trampoline payload
+	// doesn't include the recipient.
+	ErrTrampolineMissingNextNode = errors.New("trampoline payload " +
+		"missing next node")
+
+	// ErrTrampolineMissingMPP is returned when a trampoline payload
+	// doesn't include the payment address of the recipient.
+	ErrTrampolineMissingMPP = errors.New("trampoline payload missing " +
+		"payment address")
+)
+
+// TrampolineHopHint is a hop of a private route to the recipient of a
+// trampoline payment, as it was included in the recipient's invoice.
+type TrampolineHopHint struct {
+	// NodeID is the node at the start of the channel.
+	NodeID *btcec.PublicKey
+
+	// ChannelID is the short channel id of the channel.
+	ChannelID uint64
+
+	// FeeBaseMSat is the base fee of the channel in millisatoshi.
+	FeeBaseMSat uint32
+
+	// FeeProportionalMillionths is the proportional fee of the channel.
+	FeeProportionalMillionths uint32
+
+	// CLTVExpiryDelta is the time lock delta of the channel.
+	CLTVExpiryDelta uint16
+}
+
+// TrampolinePayload holds the instructions that the payer of a trampoline
+// payment nests in the onion payload of the trampoline node. With it, the
+// trampoline node finds a route to the recipient on behalf of the payer.
+type TrampolinePayload struct {
+	// AmtToForward is the amount that must be delivered to the recipient.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingCltv is the final cltv expiry that the recipient must
+	// receive.
+	OutgoingCltv uint32
+
+	// NextNode is the recipient of the payment.
+	NextNode *btcec.PublicKey
+
+	// MPP holds the payment address and the total amount of the payment
+	// to the recipient.
+	MPP *MPP
+
+	// Features is the set of features of the recipient's invoice.
+	Features *lnwire.FeatureVector
+
+	// RouteHints are the private routes to the recipient that were
+	// included in its invoice.
+	RouteHints [][]TrampolineHopHint
+}
+
+// Validate checks that the payload carries everything that is required to
+// pay the recipient.
+func (t *TrampolinePayload) Validate() error {
+	switch {
+	case t.AmtToForward == 0:
+		return ErrTrampolineMissingAmt
+
+	case t.OutgoingCltv == 0:
+		return ErrTrampolineMissingLockTime
+
+	case t.NextNode == nil:
+		return ErrTrampolineMissingNextNode
+
+	case t.MPP == nil:
+		return ErrTrampolineMissingMPP
+	}
+
+	return nil
+}
+
+// EncodeTrampolinePayload encodes the passed trampoline payload as a TLV
+// stream that is nested in the onion payload of the trampoline node.
+func EncodeTrampolinePayload(payload *TrampolinePayload) ([]byte, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	amt := uint64(payload.AmtToForward)
+	records := []tlv.Record{
+		newTrampolineAmtRecord(&amt),
+		newTrampolineLockTimeRecord(&payload.OutgoingCltv),
+		tlv.MakePrimitiveRecord(
+			TrampolineNextNodeType, &payload.NextNode,
+		),
+		newTrampolineMPPRecord(payload.MPP),
+	}
+
+	if payload.Features != nil {
+		var b bytes.Buffer
+		err := payload.Features.RawFeatureVector.EncodeBase256(&b)
+		if err != nil {
+			return nil, err
+		}
+		features := b.Bytes()
+
+		records = append(
+			records, tlv.MakePrimitiveRecord(
+				TrampolineFeaturesType, &features,
+			),
+		)
+	}
+
+	if len(payload.RouteHints) > 0 {
+		records = append(
+			records, newTrampolineRouteHintsRecord(
+				&payload.RouteHints,
+			),
+		)
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeTrampolinePayload decodes the TLV encoded trampoline payload from the
+// passed reader.
+func DecodeTrampolinePayload(r io.Reader) (*TrampolinePayload, error) {
+	var (
+		payload TrampolinePayload
+
+		amt        uint64
+		mpp        MPP
+		features   []byte
+		routeHints [][]TrampolineHopHint
+	)
+
+	stream, err := tlv.NewStream(
+		newTrampolineAmtRecord(&amt),
+		newTrampolineLockTimeRecord(&payload.OutgoingCltv),
+		tlv.MakePrimitiveRecord(
+			TrampolineNextNodeType, &payload.NextNode,
+		),
+		newTrampolineMPPRecord(&mpp),
+		tlv.MakePrimitiveRecord(TrampolineFeaturesType, &features),
+		newTrampolineRouteHintsRecord(&routeHints),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.AmtToForward = lnwire.MilliSatoshi(amt)
+
+	if _, ok := parsedTypes[TrampolineMPPType]; ok {
+		payload.MPP = &mpp
+	}
+
+	if _, ok := parsedTypes[TrampolineFeaturesType]; ok {
+		rawFeatures := lnwire.NewRawFeatureVector()
+		err := rawFeatures.DecodeBase256(
+			bytes.NewReader(features), len(features),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payload.Features = lnwire.NewFeatureVector(
+			rawFeatures, lnwire.Features,
+		)
+	}
+
+	if _, ok := parsedTypes[TrampolineRouteHintsType]; ok {
+		payload.RouteHints = routeHints
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+// newTrampolineAmtRecord creates a tlv.Record that encodes the amount of a
+// trampoline payload.
+func newTrampolineAmtRecord(amt *uint64) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TrampolineAmtType, amt, func() uint64 {
+			return tlv.SizeTUint64(*amt)
+		},
+		tlv.ETUint64, tlv.DTUint64,
+	)
+}
+
+// newTrampolineLockTimeRecord creates a tlv.Record that encodes the final cltv
+// expiry of a trampoline payload.
+func newTrampolineLockTimeRecord(lockTime *uint32) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TrampolineLockTimeType, lockTime, func() uint64 {
+			return tlv.SizeTUint32(*lockTime)
+		},
+		tlv.ETUint32, tlv.DTUint32,
+	)
+}
+
+// newTrampolineMPPRecord creates a tlv.Record that encodes the payment
+// address and total amount of a trampoline payload with the MPP encoding.
+func newTrampolineMPPRecord(mpp *MPP) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TrampolineMPPType, mpp, mpp.PayloadSize, MPPEncoder,
+		MPPDecoder,
+	)
+}
+
+// newTrampolineRouteHintsRecord creates a tlv.Record that encodes the route
+// hints of a trampoline payload. Each route hint is prefixed with its number
+// of hops.
+func newTrampolineRouteHintsRecord(hints *[][]TrampolineHopHint) tlv.Record {
+	size := func() uint64 {
+		var size uint64
+		for _, hint := range *hints {
+			size += 1 + uint64(len(hint))*trampolineHopHintSize
+		}
+
+		return size
+	}
+
+	return tlv.MakeDynamicRecord(
+		TrampolineRouteHintsType, hints, size,
+		encodeTrampolineRouteHints, decodeTrampolineRouteHints,
+	)
+}
+
+// encodeTrampolineRouteHints is a custom TLV encoder for the route hints of a
+// trampoline payload.
+func encodeTrampolineRouteHints(w io.Writer, val interface{},
+	buf *[8]byte) error {
+
+	v, ok := val.(*[][]TrampolineHopHint)
+	if !ok {
+		return tlv.NewTypeForEncodingErr(val, "[][]TrampolineHopHint")
+	}
+
+	for _, hint := range *v {
+		if len(hint) == 0 || len(hint) > 255 {
+			return fmt.Errorf("invalid number of hops in route "+
+				"hint: %v", len(hint))
+		}
+
+		numHops := uint8(len(hint))
+		if err := tlv.EUint8(w, &numHops, buf); err != nil {
+			return err
+		}
+
+		for i := range hint {
+			err := encodeTrampolineHopHint(w, &hint[i], buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeTrampolineHopHint writes a single hop hint to the passed writer.
+func encodeTrampolineHopHint(w io.Writer, hop *TrampolineHopHint,
+	buf *[8]byte) error {
+
+	if hop.NodeID == nil {
+		return fmt.Errorf("route hint hop of channel %v misses node id",
+			hop.ChannelID)
+	}
+
+	if err := tlv.EPubKey(w, &hop.NodeID, buf); err != nil {
+		return err
+	}
+
+	if err := tlv.EUint64(w, &hop.ChannelID, buf); err != nil {
+		return err
+	}
+
+	if err := tlv.EUint32(w, &hop.FeeBaseMSat, buf); err != nil {
+		return err
+	}
+
+	err := tlv.EUint32(w, &hop.FeeProportionalMillionths, buf)
+	if err != nil {
+		return err
+	}
+
+	return tlv.EUint16(w, &hop.CLTVExpiryDelta, buf)
+}
+
+// decodeTrampolineRouteHints is a custom TLV decoder for the route hints of a
+// trampoline payload.
+func decodeTrampolineRouteHints(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	v, ok := val.(*[][]TrampolineHopHint)
+	if !ok {
+		return tlv.NewTypeForDecodingErr(
+			val, "[][]TrampolineHopHint", l, l,
+		)
+	}
+
+	var hints [][]TrampolineHopHint
+	for l > 0 {
+		var numHops uint8
+		if err := tlv.DUint8(r, &numHops, buf, 1); err != nil {
+			return err
+		}
+		l--
+
+		hintSize := uint64(numHops) * trampolineHopHintSize
+		if numHops == 0 || hintSize > l {
+			return tlv.NewTypeForDecodingErr(
+				val, "[][]TrampolineHopHint", l, hintSize,
+			)
+		}
+
+		hint := make([]TrampolineHopHint, numHops)
+		for i := range hint {
+			err := decodeTrampolineHopHint(r, &hint[i], buf)
+			if err != nil {
+				return err
+			}
+		}
+		l -= hintSize
+
+		hints = append(hints, hint)
+	}
+
+	*v = hints
+
+	return nil
+}
+
+// decodeTrampolineHopHint reads a single hop hint from the passed reader.
+func decodeTrampolineHopHint(r io.Reader, hop *TrampolineHopHint,
+	buf *[8]byte) error {
+
+	err := tlv.DPubKey(r, &hop.NodeID, buf, btcec.PubKeyBytesLenCompressed)
+	if err != nil {
+		return err
+	}
+
+	if err := tlv.DUint64(r, &hop.ChannelID, buf, 8); err != nil {
+		return err
+	}
+
+	if err := tlv.DUint32(r, &hop.FeeBaseMSat, buf, 4); err != nil {
+		return err
+	}
+
+	err = tlv.DUint32(r, &hop.FeeProportionalMillionths, buf, 4)
+	if err != nil {
+		return err
+	}
+
+	return tlv.DUint16(r, &hop.CLTVExpiryDelta, buf, 2)
+}
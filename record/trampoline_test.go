@@ -0,0 +1,108 @@
+package record
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolinePayloadEncodeDecode tests that trampoline payloads survive an
+// encoding round trip, and that incomplete payloads are rejected.
+func TestTrampolinePayloadEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	nodeID := pubKey(t, "032c0b7cf95324a07d05398b240174dc0c2be444d96b159aa"+
+		"6c7f7b1e668680991")
+
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.PaymentAddrRequired),
+		lnwire.Features,
+	)
+
+	hopHint := TrampolineHopHint{
+		NodeID:                    nodeID,
+		ChannelID:                 0x0102030405060708,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 10,
+		CLTVExpiryDelta:           40,
+	}
+
+	tests := []struct {
+		name    string
+		payload *TrampolinePayload
+		err     error
+	}{
+		{
+			name: "minimal payload",
+			payload: &TrampolinePayload{
+				AmtToForward: 100_000,
+				OutgoingCltv: 800_000,
+				NextNode:     nodeID,
+				MPP:          NewMPP(100_000, [32]byte{1}),
+			},
+		},
+		{
+			name: "features and route hints",
+			payload: &TrampolinePayload{
+				AmtToForward: 100_000,
+				OutgoingCltv: 800_000,
+				NextNode:     nodeID,
+				MPP:          NewMPP(200_000, [32]byte{1}),
+				Features:     features,
+				RouteHints: [][]TrampolineHopHint{
+					{hopHint},
+					{hopHint, hopHint},
+				},
+			},
+		},
+		{
+			name: "missing amount",
+			payload: &TrampolinePayload{
+				OutgoingCltv: 800_000,
+				NextNode:     nodeID,
+				MPP:          NewMPP(100_000, [32]byte{1}),
+			},
+			err: ErrTrampolineMissingAmt,
+		},
+		{
+			name: "missing next node",
+			payload: &TrampolinePayload{
+				AmtToForward: 100_000,
+				OutgoingCltv: 800_000,
+				MPP:          NewMPP(100_000, [32]byte{1}),
+			},
+			err: ErrTrampolineMissingNextNode,
+		},
+		{
+			name: "missing payment address",
+			payload: &TrampolinePayload{
+				AmtToForward: 100_000,
+				OutgoingCltv: 800_000,
+				NextNode:     nodeID,
+			},
+			err: ErrTrampolineMissingMPP,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := EncodeTrampolinePayload(test.payload)
+			require.ErrorIs(t, err, test.err)
+			if test.err != nil {
+				return
+			}
+
+			decoded, err := DecodeTrampolinePayload(
+				bytes.NewReader(encoded),
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.payload, decoded)
+		})
+	}
+}
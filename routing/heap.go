@@ -24,6 +24,12 @@ type nodeWithDist struct {
 	// amount that includes also the fees for subsequent hops.
 	amountToReceive lnwire.MilliSatoshi
 
+	// outboundFee is the fee that this node charges for the channel of the
+	// next hop, which is included in amountToReceive. The inbound fee of
+	// the channel that this node receives the payment over is only added
+	// once that channel is known.
+	outboundFee lnwire.MilliSatoshi
+
 	// incomingCltv is the expected absolute expiry height for the incoming
 	// htlc of this node. This value should already include the final cltv
 	// delta.
@@ -44,6 +50,9 @@ type nodeWithDist struct {
 	// routingInfoSize is the total size requirement for the payloads field
 	// in the onion packet from this hop towards the final destination.
 	routingInfoSize uint64
+
+	// hops is the number of hops from this node to the final destination.
+	hops uint32
 }
 
 // distanceHeap is a min-distance heap that's used within our path finding
@@ -225,69 +225,102 @@ func (c *integratedRoutingContext) testPayment(maxParts uint32,
 		}
 
 		// Find a route.
-		route, err := session.RequestRoute(
+		rt, err := session.RequestRoute(
 			amtRemaining, lnwire.MaxMilliSatoshi, inFlightHtlcs, 0,
 		)
 		if err != nil {
 			return attempts, err
 		}
 
-		// Send out the htlc on the mock graph.
-		pid := nextPid
-		nextPid++
-		htlcResult, err := c.graph.sendHtlc(route)
-		if err != nil {
-			c.t.Fatal(err)
+		// Like the payment lifecycle, send out the shards that were
+		// planned together with the route at once.
+		routes := []*route.Route{rt}
+		if rt.ReceiverAmt() < amtRemaining {
+			routes = append(routes, session.RequestPlannedRoutes(
+				amtRemaining-rt.ReceiverAmt(),
+				lnwire.MaxMilliSatoshi, 0,
+			)...)
 		}
 
-		success := htlcResult.failure == nil
-		attempts = append(attempts, htlcAttempt{
-			route:   route,
-			success: success,
-		})
-
-		// Process the result. In normal Lightning operations, the
-		// sender doesn't get an acknowledgement from the recipient that
-		// the htlc arrived. In integrated routing tests, this
-		// acknowledgement is available. It is a simplification of
-		// reality that still allows certain classes of tests to be
-		// performed.
-		if success {
-			inFlightHtlcs++
-
-			err := mc.ReportPaymentSuccess(pid, route)
-			if err != nil {
-				c.t.Fatal(err)
-			}
+		for _, rt := range routes {
+			outcome := c.sendAttempt(mc, rt, nextPid)
+			nextPid++
 
-			amtRemaining -= route.ReceiverAmt()
+			attempts = append(attempts, outcome.attempt)
+			if outcome.final {
+				return attempts, nil
+			}
 
-			// If the full amount has been paid, the payment is
-			// successful and the control loop can be terminated.
-			if amtRemaining == 0 {
-				break
+			if outcome.attempt.success {
+				inFlightHtlcs++
+				amtRemaining -= rt.ReceiverAmt()
 			}
+		}
 
-			// Otherwise try to send the remaining amount.
-			continue
+		// If the full amount has been paid, the payment is successful
+		// and the control loop can be terminated. Otherwise try to
+		// send the remaining amount.
+		if amtRemaining == 0 {
+			break
 		}
+	}
 
-		// Failure, update mission control and retry.
-		finalResult, err := mc.ReportPaymentFail(
-			pid, route,
-			getNodeIndex(route, htlcResult.failureSource),
-			htlcResult.failure,
-		)
+	return attempts, nil
+}
+
+// attemptOutcome is the outcome of an htlc attempt of an integrated routing
+// test.
+type attemptOutcome struct {
+	attempt htlcAttempt
+
+	// final is true if the failure of the attempt is final for the
+	// payment.
+	final bool
+}
+
+// sendAttempt sends out an htlc along the route on the mock graph and reports
+// its result to mission control.
+func (c *integratedRoutingContext) sendAttempt(mc *MissionControl,
+	route *route.Route, pid uint64) *attemptOutcome {
+
+	htlcResult, err := c.graph.sendHtlc(route)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	success := htlcResult.failure == nil
+	outcome := &attemptOutcome{
+		attempt: htlcAttempt{
+			route:   route,
+			success: success,
+		},
+	}
+
+	// Process the result. In normal Lightning operations, the sender
+	// doesn't get an acknowledgement from the recipient that the htlc
+	// arrived. In integrated routing tests, this acknowledgement is
+	// available. It is a simplification of reality that still allows
+	// certain classes of tests to be performed.
+	if success {
+		err := mc.ReportPaymentSuccess(pid, route)
 		if err != nil {
 			c.t.Fatal(err)
 		}
 
-		if finalResult != nil {
-			break
-		}
+		return outcome
 	}
 
-	return attempts, nil
+	// Failure, update mission control.
+	finalResult, err := mc.ReportPaymentFail(
+		pid, route, getNodeIndex(route, htlcResult.failureSource),
+		htlcResult.failure,
+	)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	outcome.final = finalResult != nil
+
+	return outcome
 }
 
 // getNodeIndex returns the zero-based index of the given node in the route.
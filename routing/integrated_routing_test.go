@@ -3,6 +3,7 @@ package routing
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -406,3 +407,69 @@ func TestPaymentAddrOnlyNoSplit(t *testing.T) {
 	// MPP.
 	require.Equal(t, err.Error(), errNoPathFound.Error())
 }
+
+// TestFlowSplit tests that the flow splitter divides a payment between two
+// paths according to their estimated liquidity. Once the full amount failed
+// over both paths, the liquidity of both is known to be below 70k sat. The
+// flow splitter assigns half of the amount to each path right away, whereas
+// halving first tries to send both halves over the same path.
+func TestFlowSplit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		splitter         string
+		expectedAttempts int
+	}{
+		{
+			splitter:         SplitterHalving,
+			expectedAttempts: 5,
+		},
+		{
+			splitter:         SplitterFlow,
+			expectedAttempts: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.splitter, func(t *testing.T) {
+			ctx := newIntegratedRoutingContext(t)
+
+			// The bimodal estimator takes the amounts that a
+			// channel is known to carry or fail into account.
+			estimator, err := NewBimodalEstimator(BimodalConfig{
+				BimodalScaleMsat:  300_000_000,
+				BimodalNodeWeight: 0.2,
+				BimodalDecayTime:  time.Hour,
+			})
+			require.NoError(t, err)
+
+			ctx.mcCfg.Estimator = estimator
+			ctx.pathFindingCfg.Splitter = testCase.splitter
+
+			twoPathGraph(ctx.graph, 200000, 100000)
+			ctx.amt = lnwire.NewMSatFromSatoshis(70000)
+
+			attempts, err := ctx.testPayment(1000)
+			require.NoError(t, err)
+			require.Len(t, attempts, testCase.expectedAttempts)
+
+			expected := []expectedHtlcSuccess{
+				{
+					amt: 35000,
+					chans: []uint64{
+						chanSourceIm1, chanIm1Target,
+					},
+				},
+				{
+					amt: 35000,
+					chans: []uint64{
+						chanSourceIm2, chanIm2Target,
+					},
+				},
+			}
+			assertSuccessAttempts(t, attempts, expected)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package localchans
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // Manager manages the node's local channels. The only operation that is
@@ -106,12 +108,18 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 		})
 
 		// Add updated policy to list of policies to send to switch.
+		inboundFee, err := edge.InboundFee()
+		if err != nil {
+			return err
+		}
+
 		policiesToUpdate[info.ChannelPoint] = models.ForwardingPolicy{
 			BaseFee:       edge.FeeBaseMSat,
 			FeeRate:       edge.FeeProportionalMillionths,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLCOut:    edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
+			InboundFee:    inboundFee,
 		}
 
 		return nil
@@ -170,6 +178,54 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 	return failedUpdates, nil
 }
 
+// updateInboundFee replaces the inbound fee record in the extra data of the
+// edge, while all other records are left untouched. A zero inbound fee isn't
+// advertised at all.
+func updateInboundFee(edge *channeldb.ChannelEdgePolicy,
+	inboundFee models.InboundFee) error {
+
+	extraData := lnwire.ExtraOpaqueData(edge.ExtraOpaqueData)
+	typeMap, err := extraData.ExtractRecords()
+	if err != nil {
+		return fmt.Errorf("unable to decode extra data of channel "+
+			"%v: %w", edge.ChannelID, err)
+	}
+
+	tlvMap := make(map[uint64][]byte, len(typeMap))
+	for typ, value := range typeMap {
+		if typ == lnwire.FeeRecordType {
+			continue
+		}
+
+		tlvMap[uint64(typ)] = value
+	}
+
+	records := tlv.MapToRecords(tlvMap)
+	if !inboundFee.IsZero() {
+		wireFee := inboundFee.ToWire()
+		records = append(records, wireFee.Record())
+		tlv.SortRecords(records)
+	}
+
+	if len(records) == 0 {
+		edge.ExtraOpaqueData = nil
+		return nil
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return err
+	}
+	edge.ExtraOpaqueData = b.Bytes()
+
+	return nil
+}
+
 // updateEdge updates the given edge with the new schema.
 func (r *Manager) updateEdge(tx kvdb.RTx, chanPoint wire.OutPoint,
 	edge *channeldb.ChannelEdgePolicy,
@@ -182,6 +238,15 @@ func (r *Manager) updateEdge(tx kvdb.RTx, chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// If a new inbound fee is specified, it replaces the inbound fee
+	// record of the edge.
+	if newSchema.InboundFee != nil {
+		err := updateInboundFee(edge, *newSchema.InboundFee)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(tx, chanPoint)
 	if err != nil {
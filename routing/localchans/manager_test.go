@@ -1,6 +1,7 @@
 package localchans
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -13,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -258,3 +260,59 @@ func TestManager(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdateInboundFee tests that updating the inbound fee of an edge only
+// replaces the inbound fee record in its extra data.
+func TestUpdateInboundFee(t *testing.T) {
+	t.Parallel()
+
+	const otherType tlv.Type = 101
+	otherValue := []byte{1, 2, 3}
+
+	oldFee := lnwire.Fee{BaseFee: -100, FeeRate: -200}
+	otherRecords := tlv.MapToRecords(map[uint64][]byte{
+		uint64(otherType): otherValue,
+	})
+	records := append(otherRecords, oldFee.Record())
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, stream.Encode(&b))
+
+	edge := &channeldb.ChannelEdgePolicy{
+		ExtraOpaqueData: b.Bytes(),
+	}
+
+	// The new inbound fee replaces the old one, and the other record is
+	// kept.
+	newFee := models.InboundFee{Base: 10, Rate: 20}
+	require.NoError(t, updateInboundFee(edge, newFee))
+
+	inboundFee, err := edge.InboundFee()
+	require.NoError(t, err)
+	require.Equal(t, newFee, inboundFee)
+
+	extraData := lnwire.ExtraOpaqueData(edge.ExtraOpaqueData)
+	typeMap, err := extraData.ExtractRecords()
+	require.NoError(t, err)
+	require.Len(t, typeMap, 2)
+	require.Equal(t, otherValue, typeMap[otherType])
+
+	// A zero inbound fee removes the inbound fee record.
+	require.NoError(t, updateInboundFee(edge, models.InboundFee{}))
+
+	extraData = lnwire.ExtraOpaqueData(edge.ExtraOpaqueData)
+	typeMap, err = extraData.ExtractRecords()
+	require.NoError(t, err)
+	require.Len(t, typeMap, 1)
+	require.Equal(t, otherValue, typeMap[otherType])
+
+	// Without any other records, the extra data is cleared.
+	edge.ExtraOpaqueData = nil
+	require.NoError(t, updateInboundFee(edge, newFee))
+	require.NoError(t, updateInboundFee(edge, models.InboundFee{}))
+	require.Nil(t, edge.ExtraOpaqueData)
+}
@@ -145,6 +145,12 @@ func (m *mockMissionControlOld) GetProbability(fromNode, toNode route.Vertex,
 	return 0
 }
 
+func (m *mockMissionControlOld) GetPairHistorySnapshot(fromNode,
+	toNode route.Vertex) TimedPairResult {
+
+	return TimedPairResult{}
+}
+
 type mockPaymentSessionOld struct {
 	routes []*route.Route
 
@@ -185,6 +191,12 @@ func (m *mockPaymentSessionOld) GetAdditionalEdgePolicy(_ *btcec.PublicKey,
 	return nil
 }
 
+func (m *mockPaymentSessionOld) RequestPlannedRoutes(_, _ lnwire.MilliSatoshi,
+	_ uint32) []*route.Route {
+
+	return nil
+}
+
 type mockPayerOld struct {
 	sendResult    chan error
 	paymentResult chan *htlcswitch.PaymentResult
@@ -662,6 +674,13 @@ func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
 	return args.Get(0).(float64)
 }
 
+func (m *mockMissionControl) GetPairHistorySnapshot(fromNode,
+	toNode route.Vertex) TimedPairResult {
+
+	args := m.Called(fromNode, toNode)
+	return args.Get(0).(TimedPairResult)
+}
+
 type mockPaymentSession struct {
 	mock.Mock
 }
@@ -689,6 +708,13 @@ func (m *mockPaymentSession) GetAdditionalEdgePolicy(pubKey *btcec.PublicKey,
 	return args.Get(0).(*channeldb.CachedEdgePolicy)
 }
 
+func (m *mockPaymentSession) RequestPlannedRoutes(maxAmt,
+	feeLimit lnwire.MilliSatoshi, height uint32) []*route.Route {
+
+	args := m.Called(maxAmt, feeLimit, height)
+	return args.Get(0).([]*route.Route)
+}
+
 type mockControlTower struct {
 	mock.Mock
 	sync.Mutex
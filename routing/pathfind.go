@@ -104,6 +104,10 @@ type finalHopParams struct {
 	// metadata is additional data that is sent along with the payment to
 	// the payee.
 	metadata []byte
+
+	// trampoline is set if the final hop is a trampoline node that pays
+	// the recipient on our behalf.
+	trampoline *TrampolineParams
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -164,6 +168,7 @@ func newRoute(sourceVertex route.Vertex,
 			customRecords       record.CustomSet
 			mpp                 *record.MPP
 			metadata            []byte
+			trampolineOnion     []byte
 		)
 
 		// Define a helper function that checks this edge's feature
@@ -231,6 +236,24 @@ func newRoute(sourceVertex route.Vertex,
 
 			metadata = finalHop.metadata
 
+			// If the final hop is a trampoline node, we nest the
+			// instructions to reach the recipient in its payload.
+			trampoline := finalHop.trampoline
+			if trampoline != nil {
+				feature := lnwire.TrampolineRoutingOptional
+				if !supports(feature) {
+					return nil, ErrTrampolineUnsupported
+				}
+
+				var err error
+				trampolineOnion, err = trampoline.onion(
+					outgoingTimeLock,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			if blindedPath != nil {
 				totalAmtMsatBlinded = finalHop.totalAmt
 			}
@@ -243,8 +266,12 @@ func newRoute(sourceVertex route.Vertex,
 			// based on the amount that this hop needs to forward
 			// and its policy for the outgoing channel. This policy
 			// is stored as part of the incoming channel of
-			// the next hop.
-			fee = pathEdges[i+1].ComputeFee(amtToForward)
+			// the next hop. The inbound fee that the current hop
+			// charges for its incoming channel is added to it.
+			outboundFee := pathEdges[i+1].ComputeFee(amtToForward)
+			fee = edge.InboundFee.TotalFee(
+				outboundFee, amtToForward,
+			)
 
 			// We'll take the total timelock of the preceding hop as
 			// the outgoing timelock or this hop. Then we'll
@@ -266,6 +293,7 @@ func newRoute(sourceVertex route.Vertex,
 			MPP:              mpp,
 			Metadata:         metadata,
 			TotalAmtMsat:     totalAmtMsatBlinded,
+			TrampolineOnion:  trampolineOnion,
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -409,6 +437,29 @@ type RestrictParams struct {
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// Trampoline is set if the target is a trampoline node that pays the
+	// recipient on our behalf. It is used to account for the trampoline
+	// onion in the payload of the final hop.
+	Trampoline *TrampolineParams
+
+	// AvoidNodes is the set of nodes that the route may not pass through.
+	// The source and the target of the route can't be avoided.
+	AvoidNodes map[route.Vertex]struct{}
+
+	// PreferredNodes is the set of nodes that the route should pass
+	// through. If it isn't empty, every other node that the route passes
+	// through adds the attempt cost to the weight of the route, as if it
+	// made the route fail once more.
+	PreferredNodes map[route.Vertex]struct{}
+
+	// MaxHops is the maximum number of hops of the route. If zero, the
+	// number of hops is only limited by the size of the onion.
+	MaxHops uint32
+
+	// tracer records the decisions of path finding if the payment is
+	// traced.
+	tracer *pathFindingTracer
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -428,6 +479,10 @@ type PathFindingConfig struct {
 	// MinProbability defines the minimum success probability of the
 	// returned route.
 	MinProbability float64
+
+	// Splitter is the name of the strategy that splits a payment into
+	// shards if no single route can carry it.
+	Splitter string
 }
 
 // getOutgoingBalance returns the maximum available balance in any of the
@@ -646,6 +701,18 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		Metadata: r.Metadata,
 	}
 
+	// The trampoline onion is included in the payload of the final hop, so
+	// it must be accounted for as well.
+	if r.Trampoline != nil {
+		trampolineOnion, err := r.Trampoline.onion(
+			uint32(finalHtlcExpiry),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		finalHop.TrampolineOnion = trampolineOnion
+	}
+
 	// We can't always assume that the end destination is publicly
 	// advertised to the network so we'll manually include the target node.
 	// The target node charges no fee. Distance is set to 0, because this is
@@ -700,8 +767,20 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		edgesExpanded++
 
 		// Calculate amount that the candidate node would have to send
-		// out.
+		// out. Unless the to node is the target, it also charges an
+		// inbound fee for this channel, which is added to its outbound
+		// fee. A discount can lower the total fee of the to node to
+		// zero at most.
 		amountToSend := toNodeDist.amountToReceive
+		if toNodeDist.node != target {
+			outboundFee := toNodeDist.outboundFee
+			amtToForward := amountToSend - outboundFee
+
+			amountToSend = amtToForward +
+				edge.policy.InboundFee.TotalFee(
+					outboundFee, amtToForward,
+				)
+		}
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
@@ -716,8 +795,26 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				edge.capacity, edgeProbability)
 		}))
 
+		// rejectEdge records that the edge is rejected for the given
+		// reason.
+		rejectEdge := func(reason channeldb.TraceRejectReason) {
+			r.tracer.rejectEdge(
+				fromVertex, toNodeDist.node,
+				edge.policy.ChannelID, amountToSend,
+				edgeProbability, reason,
+			)
+		}
+
 		// If the probability is zero, there is no point in trying.
 		if edgeProbability == 0 {
+			rejectEdge(channeldb.TraceRejectZeroProbability)
+			return
+		}
+
+		// Skip paths that would exceed the maximum number of hops.
+		hops := toNodeDist.hops + 1
+		if r.MaxHops != 0 && hops > r.MaxHops {
+			rejectEdge(channeldb.TraceRejectHopLimit)
 			return
 		}
 
@@ -742,6 +839,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Check that we are within our CLTV limit.
 		if uint64(incomingCltv) > absoluteCltvLimit {
+			rejectEdge(channeldb.TraceRejectCltvLimit)
 			return
 		}
 
@@ -752,10 +850,21 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// charges.
 		amountToReceive := amountToSend + fee
 
+		// The weight of this edge accounts for the inbound fee of the
+		// to node too, which changes the fee that was accounted for
+		// the to node before. The weight of an edge can't be negative
+		// though.
+		weightFee := int64(amountToReceive) -
+			int64(toNodeDist.amountToReceive)
+		if weightFee < 0 {
+			weightFee = 0
+		}
+
 		// Check if accumulated fees would exceed fee limit when this
 		// node would be added to the path.
 		totalFee := amountToReceive - amt
 		if totalFee > r.FeeLimit {
+			rejectEdge(channeldb.TraceRejectFeeLimit)
 			return
 		}
 
@@ -768,6 +877,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// abandon this direction. Adding further nodes can only lower
 		// the probability more.
 		if probability < cfg.MinProbability {
+			rejectEdge(channeldb.TraceRejectMinProbability)
 			return
 		}
 
@@ -775,13 +885,25 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
 		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		weight := edgeWeight(
+			amountToReceive, lnwire.MilliSatoshi(weightFee),
+			timeLockDelta,
+		)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
 		// plus the weight of this edge.
 		tempWeight := toNodeDist.weight + weight
 
+		// Passing through a node that isn't preferred costs as much as
+		// a failed attempt.
+		if len(r.PreferredNodes) > 0 && fromVertex != source {
+			_, ok := r.PreferredNodes[fromVertex]
+			if !ok {
+				tempWeight += int64(absoluteAttemptCost)
+			}
+		}
+
 		// Add an extra factor to the weight to take into account the
 		// probability.
 		tempDist := getProbabilityBasedDist(
@@ -835,6 +957,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Skip paths that would exceed the maximum routing info size.
 		if routingInfoSize > sphinx.MaxPayloadSize {
+			rejectEdge(channeldb.TraceRejectPayloadSize)
 			return
 		}
 
@@ -847,10 +970,12 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			weight:          tempWeight,
 			node:            fromVertex,
 			amountToReceive: amountToReceive,
+			outboundFee:     fee,
 			incomingCltv:    incomingCltv,
 			probability:     probability,
 			nextHop:         edge.policy,
 			routingInfoSize: routingInfoSize,
+			hops:            hops,
 		}
 		distance[fromVertex] = withDist
 
@@ -945,10 +1070,29 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				continue
 			}
 
+			r.tracer.considerEdge()
+
+			// rejectNode records that the connection from fromNode
+			// is rejected before one of its channels is selected.
+			rejectNode := func(reason channeldb.TraceRejectReason) {
+				r.tracer.rejectEdge(
+					fromNode, pivot, 0, amtToSend, 0,
+					reason,
+				)
+			}
+
 			// Apply last hop restriction if set.
 			if r.LastHop != nil &&
 				pivot == target && fromNode != *r.LastHop {
 
+				rejectNode(channeldb.TraceRejectLastHop)
+				continue
+			}
+
+			// Don't pass through nodes that are avoided.
+			_, avoid := r.AvoidNodes[fromNode]
+			if avoid && fromNode != source {
+				rejectNode(channeldb.TraceRejectAvoidedNode)
 				continue
 			}
 
@@ -957,6 +1101,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			)
 
 			if edge == nil {
+				rejectNode(channeldb.TraceRejectBandwidth)
 				continue
 			}
 
@@ -968,6 +1113,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 			// If there are no valid features, skip this node.
 			if fromFeatures == nil {
+				rejectNode(channeldb.TraceRejectFeatures)
 				continue
 			}
 
@@ -1032,6 +1178,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// findPath, and avoid using ChannelEdgePolicy altogether.
 	pathEdges[len(pathEdges)-1].ToNodeFeatures = features
 
+	r.tracer.recordPath(source, target, amt, distance)
+
 	log.Debugf("Found route: probability=%v, hops=%v, fee=%v",
 		distance[source].probability, len(pathEdges),
 		distance[source].amountToReceive-amt)
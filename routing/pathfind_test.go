@@ -23,6 +23,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -437,6 +438,23 @@ type testChannelPolicy struct {
 	LastUpdate  time.Time
 	Disabled    bool
 	Features    *lnwire.FeatureVector
+	InboundFee  models.InboundFee
+}
+
+// extraData returns the extra opaque data of the policy, which carries its
+// inbound fee if it has one.
+func (p *testChannelPolicy) extraData() ([]byte, error) {
+	if p.InboundFee.IsZero() {
+		return nil, nil
+	}
+
+	var extraData lnwire.ExtraOpaqueData
+	inboundFee := p.InboundFee.ToWire()
+	if err := extraData.PackRecords(&inboundFee); err != nil {
+		return nil, err
+	}
+
+	return extraData, nil
 }
 
 type testChannelEnd struct {
@@ -689,6 +707,11 @@ func createTestGraphFromChannels(t *testing.T, useCache bool,
 				node2Features = node2.Features
 			}
 
+			extraData, err := node1.extraData()
+			if err != nil {
+				return nil, err
+			}
+
 			edgePolicy := &channeldb.ChannelEdgePolicy{
 				SigBytes:                  testSig.Serialize(),
 				MessageFlags:              msgFlags,
@@ -705,6 +728,7 @@ func createTestGraphFromChannels(t *testing.T, useCache bool,
 					PubKeyBytes: node2Vertex,
 					Features:    node2Features,
 				},
+				ExtraOpaqueData: extraData,
 			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
@@ -727,6 +751,11 @@ func createTestGraphFromChannels(t *testing.T, useCache bool,
 				node1Features = node1.Features
 			}
 
+			extraData, err := node2.extraData()
+			if err != nil {
+				return nil, err
+			}
+
 			edgePolicy := &channeldb.ChannelEdgePolicy{
 				SigBytes:                  testSig.Serialize(),
 				MessageFlags:              msgFlags,
@@ -743,6 +772,7 @@ func createTestGraphFromChannels(t *testing.T, useCache bool,
 					PubKeyBytes: node1Vertex,
 					Features:    node1Features,
 				},
+				ExtraOpaqueData: extraData,
 			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
@@ -773,6 +803,9 @@ func TestPathFinding(t *testing.T) {
 	}, {
 		name: "basic graph path finding",
 		fn:   runBasicGraphPathFinding,
+	}, {
+		name: "inbound fees",
+		fn:   runInboundFees,
 	}, {
 		name: "path finding with additional edges",
 		fn:   runPathFindingWithAdditionalEdges,
@@ -818,6 +851,12 @@ func TestPathFinding(t *testing.T) {
 	}, {
 		name: "restrict last hop",
 		fn:   runRestrictLastHop,
+	}, {
+		name: "route constraints",
+		fn:   runRouteConstraints,
+	}, {
+		name: "path finding trace",
+		fn:   runPathFindingTrace,
 	}, {
 		name: "CLTV limit",
 		fn:   runCltvLimit,
@@ -2571,6 +2610,208 @@ func runRestrictLastHop(t *testing.T, useCache bool) {
 	}
 }
 
+// runRouteConstraints asserts that avoided nodes, preferred nodes and the
+// maximum number of hops are obeyed by the path finding algorithm.
+func runRouteConstraints(t *testing.T, useCache bool) {
+	// Set up a test graph with three possible paths from source to target.
+	// The path via b and c is the lowest cost path, but it takes three
+	// hops. The path via a is cheaper than the path via d.
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+		}, 2),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 3),
+		symmetricTestChannel("b", "c", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 4),
+		symmetricTestChannel("c", "target", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 5),
+		symmetricTestChannel("source", "d", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 6),
+		symmetricTestChannel("d", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+		}, 7),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+	ctx.pathFindingConfig.AttemptCost = 1000
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+
+	path, err := ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{3, 4, 5})
+
+	// A limit of two hops excludes the path via b and c.
+	ctx.restrictParams.MaxHops = 2
+	path, err = ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{1, 2})
+
+	// Avoiding a leaves the path via d.
+	ctx.restrictParams.AvoidNodes = map[route.Vertex]struct{}{
+		ctx.keyFromAlias("a"): {},
+	}
+	path, err = ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{6, 7})
+
+	// Preferring d makes the path via a worse than the path via d.
+	ctx.restrictParams.AvoidNodes = nil
+	ctx.restrictParams.PreferredNodes = map[route.Vertex]struct{}{
+		ctx.keyFromAlias("d"): {},
+	}
+	path, err = ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{6, 7})
+
+	// If all paths within the hop limit pass through avoided nodes, no
+	// path is found.
+	ctx.restrictParams.AvoidNodes = map[route.Vertex]struct{}{
+		ctx.keyFromAlias("a"): {},
+		ctx.keyFromAlias("d"): {},
+	}
+	_, err = ctx.findPath(target, paymentAmt)
+	require.ErrorIs(t, err, errNoPathFound)
+}
+
+// runPathFindingTrace asserts that path finding traces the edges that it
+// rejects and the path that it finds.
+func runPathFindingTrace(t *testing.T, useCache bool) {
+	// Set up a test graph with three paths from source to target. The path
+	// via a is too expensive, b is avoided and the path via c has a low
+	// success probability.
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+		}, 2),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 4),
+		symmetricTestChannel("source", "c", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 5),
+		symmetricTestChannel("c", "target", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 6),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+	nodeC := ctx.keyFromAlias("c")
+
+	ctx.restrictParams.FeeLimit = 10
+	ctx.restrictParams.AvoidNodes = map[route.Vertex]struct{}{
+		ctx.keyFromAlias("b"): {},
+	}
+	ctx.restrictParams.ProbabilitySource = func(fromNode,
+		toNode route.Vertex, amt lnwire.MilliSatoshi,
+		capacity btcutil.Amount) float64 {
+
+		if fromNode == nodeC {
+			return 0.1
+		}
+
+		return 1
+	}
+
+	// With the minimum probability above the probability of the path via
+	// c, no path is left.
+	ctx.pathFindingConfig.MinProbability = 0.5
+	tracer := newPathFindingTracer(
+		time.Now(), paymentAmt, 10, math.MaxUint32,
+	)
+	ctx.restrictParams.tracer = tracer
+
+	_, err := ctx.findPath(target, paymentAmt)
+	require.ErrorIs(t, err, errNoPathFound)
+
+	trace := tracer.trace
+	require.EqualValues(t, 3, trace.EdgesConsidered)
+	require.Equal(t, map[channeldb.TraceRejectReason]uint32{
+		channeldb.TraceRejectFeeLimit:       1,
+		channeldb.TraceRejectAvoidedNode:    1,
+		channeldb.TraceRejectMinProbability: 1,
+	}, trace.Rejections)
+	require.Len(t, trace.RejectedEdges, 3)
+	require.Empty(t, trace.Route)
+
+	for _, edge := range trace.RejectedEdges {
+		require.Equal(t, target, edge.To)
+		require.Equal(t, paymentAmt, edge.Amount)
+
+		switch edge.From {
+		case ctx.keyFromAlias("a"):
+			require.Equal(
+				t, channeldb.TraceRejectFeeLimit, edge.Reason,
+			)
+			require.EqualValues(t, 2, edge.ChannelID)
+			require.Equal(t, 1.0, edge.Probability)
+
+		case ctx.keyFromAlias("b"):
+			require.Equal(
+				t, channeldb.TraceRejectAvoidedNode,
+				edge.Reason,
+			)
+			require.Zero(t, edge.ChannelID)
+
+		case nodeC:
+			require.Equal(
+				t, channeldb.TraceRejectMinProbability,
+				edge.Reason,
+			)
+			require.EqualValues(t, 6, edge.ChannelID)
+			require.Equal(t, 0.1, edge.Probability)
+
+		default:
+			t.Fatalf("unexpected rejected edge from %v", edge.From)
+		}
+	}
+
+	// Without the minimum probability, the path via c is found and its
+	// probabilities are traced.
+	ctx.pathFindingConfig.MinProbability = 0
+	tracer = newPathFindingTracer(
+		time.Now(), paymentAmt, 10, math.MaxUint32,
+	)
+	ctx.restrictParams.tracer = tracer
+
+	path, err := ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{5, 6})
+
+	trace = tracer.trace
+	require.Len(t, trace.Route, 2)
+	require.EqualValues(t, 5, trace.Route[0].ChannelID)
+	require.Equal(t, ctx.source, trace.Route[0].From)
+	require.Equal(t, nodeC, trace.Route[0].To)
+	require.Equal(t, paymentAmt, trace.Route[0].Amount)
+	require.InDelta(t, 1.0, trace.Route[0].Probability, 1e-9)
+	require.EqualValues(t, 6, trace.Route[1].ChannelID)
+	require.Equal(t, nodeC, trace.Route[1].From)
+	require.Equal(t, target, trace.Route[1].To)
+	require.InDelta(t, 0.1, trace.Route[1].Probability, 1e-9)
+}
+
 // runCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func runCltvLimit(t *testing.T, useCache bool) {
@@ -2985,6 +3226,95 @@ func runNoCycle(t *testing.T, useCache bool) {
 	}
 }
 
+// runInboundFees tests that inbound fees are taken into account when finding
+// a path and building a route, and that the target doesn't charge any.
+func runInboundFees(t *testing.T, useCache bool) {
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "x", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("x", "a", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+		}, 2),
+		// Node b gives a discount for payments from x.
+		symmetricTestChannel("x", "b", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+			InboundFee:  models.InboundFee{Base: -800},
+		}, 3),
+		// The target charges inbound fees that aren't paid, as it
+		// doesn't forward the payment.
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1000,
+			InboundFee:  models.InboundFee{Base: 500},
+		}, 4),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 1500,
+			InboundFee:  models.InboundFee{Base: 500},
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+
+	// The path via b is cheaper, as its discount lowers its fee to 700
+	// msat.
+	path, err := ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{1, 3, 5})
+
+	route, err := newRoute(
+		ctx.source, path, startingHeight,
+		finalHopParams{
+			amt:       paymentAmt,
+			cltvDelta: finalHopCLTV,
+		}, nil,
+	)
+	require.NoError(t, err, "unable to create route")
+	require.EqualValues(t, 1700, route.TotalFees())
+	require.EqualValues(t, 1000, route.HopFee(0))
+	require.EqualValues(t, 700, route.HopFee(1))
+
+	// A discount can't make the fee of a node negative.
+	var extraData lnwire.ExtraOpaqueData
+	inboundFee := lnwire.Fee{BaseFee: -2000}
+	require.NoError(t, extraData.PackRecords(&inboundFee))
+
+	_, policy1, policy2, err := ctx.graph.FetchChannelEdgesByID(3)
+	require.NoError(t, err)
+	for _, policy := range []*channeldb.ChannelEdgePolicy{
+		policy1, policy2,
+	} {
+		policy.ExtraOpaqueData = extraData
+		require.NoError(t, ctx.graph.UpdateEdgePolicy(policy))
+	}
+
+	path, err = ctx.findPath(target, paymentAmt)
+	require.NoError(t, err, "unable to find path")
+	ctx.assertPath(path, []uint64{1, 3, 5})
+
+	route, err = newRoute(
+		ctx.source, path, startingHeight,
+		finalHopParams{
+			amt:       paymentAmt,
+			cltvDelta: finalHopCLTV,
+		}, nil,
+	)
+	require.NoError(t, err, "unable to create route")
+	require.EqualValues(t, 1000, route.TotalFees())
+	require.EqualValues(t, 0, route.HopFee(1))
+}
+
 // runRouteToSelf tests that it is possible to find a route to the self node.
 func runRouteToSelf(t *testing.T, useCache bool) {
 	testChannels := []*testChannel{
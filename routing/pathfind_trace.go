@@ -0,0 +1,174 @@
+package routing
+
+import (
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// maxTracedEdges is the maximum number of rejected edges that a path
+	// finding trace records individually. Further rejections are only
+	// counted.
+	maxTracedEdges = 100
+
+	// DefaultPaymentTraceRetention is the default time for which the path
+	// finding traces of payments are kept.
+	DefaultPaymentTraceRetention = 24 * time.Hour
+
+	// tracePruneInterval is the interval at which the router prunes the
+	// expired path finding traces.
+	tracePruneInterval = time.Hour
+)
+
+// PathFindingTraceStore persists the path finding traces of payments.
+type PathFindingTraceStore interface {
+	// AddPathFindingTrace appends a path finding trace to the trace of the
+	// payment with the given hash.
+	AddPathFindingTrace(paymentHash lntypes.Hash,
+		trace *channeldb.PathFindingTrace) error
+
+	// DeletePaymentTraces deletes the traces of all payments that were
+	// created before the given time, and returns the number of deleted
+	// traces.
+	DeletePaymentTraces(createdBefore time.Time) (int, error)
+}
+
+// pathFindingTracer records the decisions of a path finding run. All of its
+// methods are no-ops on a nil tracer, so that path finding doesn't need to
+// check whether it is traced.
+type pathFindingTracer struct {
+	trace *channeldb.PathFindingTrace
+}
+
+// newPathFindingTracer creates a tracer for a path finding run that starts at
+// the given time.
+func newPathFindingTracer(now time.Time, amt, feeLimit lnwire.MilliSatoshi,
+	cltvLimit uint32) *pathFindingTracer {
+
+	rejections := make(map[channeldb.TraceRejectReason]uint32)
+
+	return &pathFindingTracer{
+		trace: &channeldb.PathFindingTrace{
+			Time:       now,
+			Amount:     amt,
+			FeeLimit:   feeLimit,
+			CltvLimit:  cltvLimit,
+			Rejections: rejections,
+		},
+	}
+}
+
+// considerEdge records that path finding considered an edge.
+func (t *pathFindingTracer) considerEdge() {
+	if t == nil {
+		return
+	}
+
+	t.trace.EdgesConsidered++
+}
+
+// rejectEdge records that path finding rejected an edge for the given reason.
+func (t *pathFindingTracer) rejectEdge(from, to route.Vertex, chanID uint64,
+	amt lnwire.MilliSatoshi, probability float64,
+	reason channeldb.TraceRejectReason) {
+
+	if t == nil {
+		return
+	}
+
+	t.trace.Rejections[reason]++
+
+	if len(t.trace.RejectedEdges) >= maxTracedEdges {
+		return
+	}
+
+	t.trace.RejectedEdges = append(
+		t.trace.RejectedEdges, &channeldb.TracedEdge{
+			ChannelID:   chanID,
+			From:        from,
+			To:          to,
+			Amount:      amt,
+			Probability: probability,
+			Reason:      reason,
+		},
+	)
+}
+
+// recordPath records the path that path finding found, using the distance
+// map to obtain the amount and probability of each edge.
+func (t *pathFindingTracer) recordPath(source, target route.Vertex,
+	amt lnwire.MilliSatoshi, distance map[route.Vertex]*nodeWithDist) {
+
+	if t == nil {
+		return
+	}
+
+	for node := source; node != target || len(t.trace.Route) == 0; {
+		nodeDist := distance[node]
+		next := nodeDist.nextHop.ToNodePubKey()
+
+		// The probability of the path from a node is the product of
+		// the probabilities of its edges, so the probability of an
+		// edge is the quotient of the path probabilities of its nodes.
+		// The target node isn't recorded in the distance map.
+		nextAmt, nextProbability := amt, 1.0
+		if nextDist, ok := distance[next]; ok && next != target {
+			nextAmt = nextDist.amountToReceive
+			nextProbability = nextDist.probability
+		}
+
+		t.trace.Route = append(t.trace.Route, &channeldb.TracedEdge{
+			ChannelID:   nodeDist.nextHop.ChannelID,
+			From:        node,
+			To:          next,
+			Amount:      nextAmt,
+			Probability: nodeDist.probability / nextProbability,
+		})
+
+		node = next
+	}
+}
+
+// finish records the result of the path finding run.
+func (t *pathFindingTracer) finish(err error) {
+	if t == nil || err == nil {
+		return
+	}
+
+	t.trace.Error = err.Error()
+}
+
+// addPairHistory adds the mission control entries of the traced edges, using
+// the given function to look them up.
+func (t *pathFindingTracer) addPairHistory(
+	getPairHistory func(fromNode, toNode route.Vertex) TimedPairResult) {
+
+	if t == nil {
+		return
+	}
+
+	addHistory := func(edges []*channeldb.TracedEdge) {
+		for _, edge := range edges {
+			result := getPairHistory(edge.From, edge.To)
+			if result.FailTime.IsZero() &&
+				result.SuccessTime.IsZero() {
+
+				continue
+			}
+
+			edge.History = &channeldb.TracedPairHistory{
+				FailTime:    result.FailTime,
+				FailAmt:     result.FailAmt,
+				SuccessTime: result.SuccessTime,
+				SuccessAmt:  result.SuccessAmt,
+			}
+		}
+	}
+
+	addHistory(t.trace.RejectedEdges)
+	addHistory(t.trace.Route)
+}
@@ -266,30 +266,44 @@ lifecycle:
 
 		log.Tracef("Found route: %s", spew.Sdump(rt.Hops))
 
-		// If this route will consume the last remaining amount to send
-		// to the receiver, this will be our last shard (for now).
-		lastShard := rt.ReceiverAmt() == ps.RemainingAmt
-
-		// We found a route to try, launch a new shard.
-		attempt, outcome, err := shardHandler.launchShard(rt, lastShard)
-		switch {
-		// We may get a terminal error if we've processed a shard with
-		// a terminal state (settled or permanent failure), while we
-		// were pathfinding. We know we're in a terminal state here,
-		// so we can continue and wait for our last shards to return.
-		case err == channeldb.ErrPaymentTerminal:
-			log.Infof("Payment %v in terminal state, abandoning "+
-				"shard", p.identifier)
+		// The flow splitter plans the shards of the remaining amount
+		// together, so they are all launched at once instead of one
+		// per iteration.
+		routes := []*route.Route{rt}
+		if rt.ReceiverAmt() < ps.RemainingAmt &&
+			rt.TotalFees() <= remainingFees {
+
+			planned := p.paySession.RequestPlannedRoutes(
+				ps.RemainingAmt-rt.ReceiverAmt(),
+				remainingFees-rt.TotalFees(),
+				uint32(p.currentHeight),
+			)
+			routes = append(routes, planned...)
+		}
 
-			continue lifecycle
+		// We found routes to try, launch the new shards.
+		launches, err := shardHandler.launchShards(
+			routes, ps.RemainingAmt,
+		)
 
-		case err != nil:
-			return exitWithErr(err)
+		// Now that the shards were sent, launch the go routines that
+		// will handle their results when they are back. This is done
+		// before handling any error, so that none of the shards in
+		// flight is left without one.
+		for _, launch := range launches {
+			if launch.outcome.err == nil {
+				shardHandler.collectResultAsync(launch.attempt)
+			}
 		}
 
-		// If we encountered a non-critical error when launching the
+		// If we encountered a non-critical error when launching a
 		// shard, handle it.
-		if outcome.err != nil {
+		for _, launch := range launches {
+			attempt, outcome := launch.attempt, launch.outcome
+			if outcome.err == nil {
+				continue
+			}
+
 			log.Warnf("Failed to launch shard %v for "+
 				"payment %v: %v", attempt.AttemptID,
 				p.identifier, outcome.err)
@@ -303,15 +317,22 @@ lifecycle:
 			if err != nil {
 				return exitWithErr(err)
 			}
+		}
+
+		switch {
+		// We may get a terminal error if we've processed a shard with
+		// a terminal state (settled or permanent failure), while we
+		// were pathfinding. We know we're in a terminal state here,
+		// so we can continue and wait for our last shards to return.
+		case err == channeldb.ErrPaymentTerminal:
+			log.Infof("Payment %v in terminal state, abandoning "+
+				"shard", p.identifier)
 
-			// Error was handled successfully, continue to make a
-			// new attempt.
 			continue lifecycle
-		}
 
-		// Now that the shard was successfully sent, launch a go
-		// routine that will handle its result when its back.
-		shardHandler.collectResultAsync(attempt)
+		case err != nil:
+			return exitWithErr(err)
+		}
 	}
 }
 
@@ -402,11 +423,98 @@ type launchOutcome struct {
 func (p *shardHandler) launchShard(rt *route.Route,
 	lastShard bool) (*channeldb.HTLCAttempt, *launchOutcome, error) {
 
+	attempt, err := p.registerShard(rt, lastShard)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outcome, err := p.sendShard(attempt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attempt, outcome, nil
+}
+
+// shardLaunch holds an attempt that was sent by launchShards, along with the
+// outcome of sending it.
+type shardLaunch struct {
+	attempt *channeldb.HTLCAttempt
+	outcome *launchOutcome
+}
+
+// launchShards creates and sends HTLC attempts along all the given routes. The
+// attempts are registered with the control tower one after the other, as the
+// shard of an AMP payment that consumes the remaining amount must be created
+// last, and are then sent concurrently. The attempts that were sent are
+// returned along with their outcomes, also if a critical error is returned.
+func (p *shardHandler) launchShards(routes []*route.Route,
+	remainingAmt lnwire.MilliSatoshi) ([]*shardLaunch, error) {
+
+	var (
+		launches    []*shardLaunch
+		registerErr error
+	)
+	for _, rt := range routes {
+		// If this route will consume the last remaining amount to
+		// send to the receiver, this will be our last shard (for
+		// now).
+		remainingAmt -= rt.ReceiverAmt()
+		lastShard := remainingAmt == 0
+
+		attempt, err := p.registerShard(rt, lastShard)
+		if err != nil {
+			// The attempts that were registered already still
+			// need to be sent.
+			registerErr = err
+			break
+		}
+
+		launches = append(launches, &shardLaunch{attempt: attempt})
+	}
+
+	sendErrs := make([]error, len(launches))
+
+	var wg sync.WaitGroup
+	for i, launch := range launches {
+		i, launch := i, launch
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			launch.outcome, sendErrs[i] = p.sendShard(
+				launch.attempt,
+			)
+		}()
+	}
+	wg.Wait()
+
+	// Only the attempts whose outcome is known are returned, a critical
+	// error while sending the others ends the payment lifecycle anyway.
+	sent := make([]*shardLaunch, 0, len(launches))
+	for i, launch := range launches {
+		if sendErrs[i] != nil {
+			return sent, sendErrs[i]
+		}
+
+		sent = append(sent, launch)
+	}
+
+	return sent, registerErr
+}
+
+// registerShard creates an HTLC attempt along the given route and registers it
+// with the control tower. The lastShard argument should be true if this shard
+// will consume the remainder of the amount to send.
+func (p *shardHandler) registerShard(rt *route.Route,
+	lastShard bool) (*channeldb.HTLCAttempt, error) {
+
 	// Using the route received from the payment session, create a new
 	// shard to send.
 	attempt, err := p.createNewPaymentAttempt(rt, lastShard)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Before sending this HTLC to the switch, we checkpoint the fresh
@@ -418,9 +526,18 @@ func (p *shardHandler) launchShard(rt *route.Route,
 		p.identifier, &attempt.HTLCAttemptInfo,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
+	return attempt, nil
+}
+
+// sendShard sends an attempt that was registered with the control tower. The
+// returned launchOutcome wraps a non-nil error if the attempt couldn't be
+// sent, in which case it was failed with the control tower.
+func (p *shardHandler) sendShard(
+	attempt *channeldb.HTLCAttempt) (*launchOutcome, error) {
+
 	// Now that the attempt is created and checkpointed to the DB, we send
 	// it.
 	sendErr := p.sendAttempt(attempt)
@@ -429,17 +546,17 @@ func (p *shardHandler) launchShard(rt *route.Route,
 		// from real send errors.
 		htlcAttempt, err := p.failAttempt(attempt.AttemptID, sendErr)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		// Return a launchOutcome indicating the shard failed.
-		return attempt, &launchOutcome{
+		return &launchOutcome{
 			attempt: htlcAttempt,
 			err:     sendErr,
 		}, nil
 	}
 
-	return attempt, &launchOutcome{}, nil
+	return &launchOutcome{}, nil
 }
 
 // shardResult holds the resulting outcome of a shard sent.
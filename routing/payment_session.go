@@ -2,12 +2,14 @@ package routing
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btclog"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
@@ -151,6 +153,15 @@ type PaymentSession interface {
 	// if nothing found.
 	GetAdditionalEdgePolicy(pubKey *btcec.PublicKey,
 		channelID uint64) *channeldb.CachedEdgePolicy
+
+	// RequestPlannedRoutes returns the routes of the shards that were
+	// planned together with the route that the last call to RequestRoute
+	// returned, so that all of them can be launched at once. The routes
+	// are only returned if they don't exceed the given amount and fee
+	// limit in total. The plan is discarded in any case, the next call to
+	// RequestRoute plans the remaining amount again.
+	RequestPlannedRoutes(maxAmt, feeLimit lnwire.MilliSatoshi,
+		height uint32) []*route.Route
 }
 
 // paymentSession is used during an HTLC routings session to prune the local
@@ -186,6 +197,20 @@ type paymentSession struct {
 	// will happen and this value remains unused.
 	minShardAmt lnwire.MilliSatoshi
 
+	// plannedRoutes holds the routes of the shards that the flow
+	// splitter planned together with the route that RequestRoute returned
+	// last. They are only valid at planHeight.
+	plannedRoutes []*route.Route
+	planHeight    uint32
+	planMtx       sync.Mutex
+
+	// traceStore stores the path finding traces of the payment if it is
+	// traced.
+	traceStore PathFindingTraceStore
+
+	// clock is used to timestamp the path finding traces.
+	clock clock.Clock
+
 	// log is a payment session-specific logger.
 	log btclog.Logger
 }
@@ -213,6 +238,7 @@ func newPaymentSession(p *LightningPayment,
 		pathFindingConfig: pathFindingConfig,
 		missionControl:    missionControl,
 		minShardAmt:       DefaultShardMinAmt,
+		clock:             clock.NewDefaultClock(),
 		log:               build.NewPrefixLog(logPrefix, log),
 	}, nil
 }
@@ -233,6 +259,12 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		return nil, errEmptyPaySession
 	}
 
+	// A plan is only valid along with the route it was made with, so a
+	// plan that wasn't requested is discarded.
+	p.planMtx.Lock()
+	p.plannedRoutes = nil
+	p.planMtx.Unlock()
+
 	// Add BlockPadding to the finalCltvDelta so that the receiving node
 	// does not reject the HTLC if some blocks are mined while it's in-flight.
 	finalCltvDelta := p.payment.FinalCLTVDelta
@@ -258,6 +290,10 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		DestFeatures:       p.payment.DestFeatures,
 		PaymentAddr:        p.payment.PaymentAddr,
 		Metadata:           p.payment.Metadata,
+		Trampoline:         p.payment.Trampoline,
+		AvoidNodes:         p.payment.AvoidNodes,
+		PreferredNodes:     p.payment.PreferredNodes,
+		MaxHops:            p.payment.MaxHops,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
@@ -274,6 +310,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		maxAmt = *p.payment.MaxShardAmt
 	}
 
+	var triedFlow bool
 	for {
 		// Get a routing graph.
 		routingGraph, cleanup, err := p.getRoutingGraph()
@@ -296,6 +333,15 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 
 		sourceVertex := routingGraph.sourceNode()
 
+		// Trace the path finding run if the payment requested it.
+		var tracer *pathFindingTracer
+		if p.payment.Trace && p.traceStore != nil {
+			tracer = newPathFindingTracer(
+				p.clock.Now(), maxAmt, feeLimit, cltvLimit,
+			)
+		}
+		restrictions.tracer = tracer
+
 		// Find a route for the current amount.
 		path, _, err := p.pathFinder(
 			&graphParams{
@@ -311,6 +357,8 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		// Close routing graph.
 		cleanup()
 
+		p.storeTrace(tracer, err)
+
 		switch {
 		case err == errNoPathFound:
 			// Don't split if this is a legacy payment without mpp
@@ -348,6 +396,28 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 				return nil, errNoPathFound
 			}
 
+			// The flow splitter assigns the amount to several
+			// paths at once, taking the liquidity that the shards
+			// use on each of them into account. As it runs path
+			// finding for every unit, it is only tried once.
+			if p.pathFindingConfig.Splitter == SplitterFlow &&
+				!triedFlow {
+
+				triedFlow = true
+
+				rt, err := p.planShards(
+					maxAmt, feeLimit,
+					p.payment.MaxParts-activeShards, height,
+					restrictions, finalCltvDelta,
+				)
+				if err == nil {
+					return rt, nil
+				}
+
+				p.log.Debugf("Unable to split amt=%v by flow, "+
+					"halving: %v", maxAmt, err)
+			}
+
 			// This is where the magic happens. If we can't find a
 			// route, try it for half the amount.
 			maxAmt /= 2
@@ -383,14 +453,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		// requirements.
 		route, err := newRoute(
 			sourceVertex, path, height,
-			finalHopParams{
-				amt:         maxAmt,
-				totalAmt:    p.payment.Amount,
-				cltvDelta:   finalCltvDelta,
-				records:     p.payment.DestCustomRecords,
-				paymentAddr: p.payment.PaymentAddr,
-				metadata:    p.payment.Metadata,
-			}, nil,
+			p.finalHopParams(maxAmt, finalCltvDelta), nil,
 		)
 		if err != nil {
 			return nil, err
@@ -400,6 +463,97 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 	}
 }
 
+// storeTrace stores the trace of a path finding run that ended with the given
+// error. Failing to store the trace doesn't fail the payment.
+func (p *paymentSession) storeTrace(tracer *pathFindingTracer, err error) {
+	if tracer == nil {
+		return
+	}
+
+	tracer.finish(err)
+	tracer.addPairHistory(p.missionControl.GetPairHistorySnapshot)
+
+	err = p.traceStore.AddPathFindingTrace(
+		p.payment.Identifier(), tracer.trace,
+	)
+	if err != nil {
+		p.log.Warnf("Unable to store path finding trace: %v", err)
+	}
+}
+
+// finalHopParams returns the parameters of the final hop of a route that
+// delivers the given amount to the target of the payment.
+func (p *paymentSession) finalHopParams(amt lnwire.MilliSatoshi,
+	cltvDelta uint16) finalHopParams {
+
+	return finalHopParams{
+		amt:         amt,
+		totalAmt:    p.payment.Amount,
+		cltvDelta:   cltvDelta,
+		records:     p.payment.DestCustomRecords,
+		paymentAddr: p.payment.PaymentAddr,
+		metadata:    p.payment.Metadata,
+		trampoline:  p.payment.Trampoline,
+	}
+}
+
+// planShards splits the amount with the flow splitter. The route of the first
+// shard is returned, the routes of the other shards are handed out by
+// RequestPlannedRoutes.
+func (p *paymentSession) planShards(amt, feeLimit lnwire.MilliSatoshi,
+	maxShards, height uint32, restrictions *RestrictParams,
+	finalCltvDelta uint16) (*route.Route, error) {
+
+	routes, err := p.splitFlow(
+		amt, feeLimit, maxShards, height, restrictions, finalCltvDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.planMtx.Lock()
+	defer p.planMtx.Unlock()
+
+	p.plannedRoutes = routes[1:]
+	p.planHeight = height
+
+	return routes[0], nil
+}
+
+// RequestPlannedRoutes returns the routes of the shards that the flow
+// splitter planned together with the route that RequestRoute returned last.
+// If the plan no longer fits the amount or fee that remains, or a block was
+// mined since, nil is returned. The plan is discarded in any case.
+//
+// NOTE: Part of the PaymentSession interface.
+func (p *paymentSession) RequestPlannedRoutes(maxAmt,
+	feeLimit lnwire.MilliSatoshi, height uint32) []*route.Route {
+
+	p.planMtx.Lock()
+	defer p.planMtx.Unlock()
+
+	routes := p.plannedRoutes
+	p.plannedRoutes = nil
+
+	if len(routes) == 0 {
+		return nil
+	}
+
+	var amt, fees lnwire.MilliSatoshi
+	for _, rt := range routes {
+		amt += rt.ReceiverAmt()
+		fees += rt.TotalFees()
+	}
+
+	if height != p.planHeight || amt > maxAmt || fees > feeLimit {
+		p.log.Debugf("Discarding %v planned shards", len(routes))
+
+		return nil
+	}
+
+	return routes
+}
+
 // UpdateAdditionalEdge updates the channel edge policy for a private edge. It
 // validates the message signature and checks it's up to date, then applies the
 // updates to the supplied policy. It returns a boolean to indicate whether
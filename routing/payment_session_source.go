@@ -3,6 +3,7 @@ package routing
 import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -41,6 +42,13 @@ type SessionSource struct {
 	// PathFindingConfig defines global parameters that control the
 	// trade-off in path finding between fees and probability.
 	PathFindingConfig PathFindingConfig
+
+	// PathFindingTraces stores the path finding traces of payments that
+	// request them. If nil, payments aren't traced.
+	PathFindingTraces PathFindingTraceStore
+
+	// Clock is used to timestamp the path finding traces.
+	Clock clock.Clock
 }
 
 // getRoutingGraph returns a routing graph and a clean-up function for
@@ -79,6 +87,9 @@ func (m *SessionSource) NewPaymentSession(p *LightningPayment) (
 		return nil, err
 	}
 
+	session.traceStore = m.PathFindingTraces
+	session.clock = m.Clock
+
 	return session, nil
 }
 
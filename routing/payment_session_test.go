@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -249,6 +250,61 @@ func TestRequestRoute(t *testing.T) {
 	}
 }
 
+// TestRequestPlannedRoutes tests that the shards that were planned together
+// are handed out at once if they fit the remaining amount and fee limit, and
+// that the plan is discarded afterwards.
+func TestRequestPlannedRoutes(t *testing.T) {
+	t.Parallel()
+
+	const height = 10
+
+	payment := &LightningPayment{
+		Amount:   1000,
+		FeeLimit: 1000,
+	}
+	require.NoError(t, payment.SetPaymentHash(lntypes.Hash{}))
+
+	session, err := newPaymentSession(
+		payment, nil, nil, &MissionControl{}, PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	newRoute := func(amt lnwire.MilliSatoshi) *route.Route {
+		return &route.Route{
+			TotalAmount: amt + 10,
+			Hops: []*route.Hop{
+				{AmtToForward: amt},
+			},
+		}
+	}
+
+	plan := func() {
+		session.plannedRoutes = []*route.Route{
+			newRoute(300), newRoute(400),
+		}
+		session.planHeight = height
+	}
+
+	// All planned routes are handed out at once, after which the plan is
+	// discarded.
+	plan()
+	routes := session.RequestPlannedRoutes(700, 20, height)
+	require.Len(t, routes, 2)
+	require.Nil(t, session.RequestPlannedRoutes(700, 20, height))
+
+	// A plan that exceeds the remaining amount, the fee limit in total or
+	// that was made at another height isn't handed out.
+	plan()
+	require.Nil(t, session.RequestPlannedRoutes(699, 20, height))
+	require.Empty(t, session.plannedRoutes)
+
+	plan()
+	require.Nil(t, session.RequestPlannedRoutes(700, 19, height))
+
+	plan()
+	require.Nil(t, session.RequestPlannedRoutes(700, 20, height+1))
+}
+
 type sessionGraph struct {
 	routingGraph
 }
@@ -256,3 +312,110 @@ type sessionGraph struct {
 func (g *sessionGraph) sourceNode() route.Vertex {
 	return route.Vertex{}
 }
+
+// mockTraceStore records the path finding traces that are stored, and the
+// times before which traces are pruned.
+type mockTraceStore struct {
+	traces map[lntypes.Hash][]*channeldb.PathFindingTrace
+	pruned chan time.Time
+}
+
+func (m *mockTraceStore) AddPathFindingTrace(paymentHash lntypes.Hash,
+	trace *channeldb.PathFindingTrace) error {
+
+	m.traces[paymentHash] = append(m.traces[paymentHash], trace)
+
+	return nil
+}
+
+func (m *mockTraceStore) DeletePaymentTraces(createdBefore time.Time) (int,
+	error) {
+
+	m.pruned <- createdBefore
+
+	return 0, nil
+}
+
+// TestRequestRouteTrace tests that the path finding runs of a traced payment
+// are stored along with the mission control entries of their edges.
+func TestRequestRouteTrace(t *testing.T) {
+	t.Parallel()
+
+	payment := &LightningPayment{
+		CltvLimit:      30,
+		FinalCLTVDelta: 8,
+		Amount:         1000,
+		FeeLimit:       1000,
+		Trace:          true,
+	}
+
+	paymentHash := lntypes.Hash{1}
+	require.NoError(t, payment.SetPaymentHash(paymentHash))
+
+	missionControl := &mockMissionControl{}
+	history := TimedPairResult{
+		FailTime: time.Unix(100, 0),
+		FailAmt:  500,
+	}
+	missionControl.On(
+		"GetPairHistorySnapshot", route.Vertex{1}, route.Vertex{2},
+	).Return(history)
+
+	session, err := newPaymentSession(
+		payment,
+		func(routingGraph) (bandwidthHints, error) {
+			return &mockBandwidthHints{}, nil
+		},
+		func() (routingGraph, func(), error) {
+			return &sessionGraph{}, func() {}, nil
+		},
+		missionControl,
+		PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	store := &mockTraceStore{
+		traces: make(map[lntypes.Hash][]*channeldb.PathFindingTrace),
+	}
+	session.traceStore = store
+
+	testTime := time.Unix(1000, 0)
+	session.clock = clock.NewTestClock(testTime)
+
+	// Reject an edge for its fee in every path finding run.
+	session.pathFinder = func(_ *graphParams, r *RestrictParams,
+		_ *PathFindingConfig, _, _ route.Vertex,
+		amt lnwire.MilliSatoshi, _ float64, _ int32) (
+		[]*channeldb.CachedEdgePolicy, float64, error) {
+
+		r.tracer.considerEdge()
+		r.tracer.rejectEdge(
+			route.Vertex{1}, route.Vertex{2}, 5, amt, 0.5,
+			channeldb.TraceRejectFeeLimit,
+		)
+
+		return nil, 0, errNoPathFound
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = session.RequestRoute(
+			payment.Amount, payment.FeeLimit, 0, 10,
+		)
+		require.ErrorIs(t, err, errNoPathFound)
+	}
+
+	traces := store.traces[paymentHash]
+	require.Len(t, traces, 2)
+
+	trace := traces[0]
+	require.Equal(t, testTime, trace.Time)
+	require.Equal(t, payment.Amount, trace.Amount)
+	require.Equal(t, payment.FeeLimit, trace.FeeLimit)
+	require.Equal(t, errNoPathFound.Error(), trace.Error)
+	require.EqualValues(t, 1, trace.EdgesConsidered)
+	require.Len(t, trace.RejectedEdges, 1)
+	require.Equal(t, &channeldb.TracedPairHistory{
+		FailTime: history.FailTime,
+		FailAmt:  history.FailAmt,
+	}, trace.RejectedEdges[0].History)
+}
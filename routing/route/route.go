@@ -38,6 +38,12 @@ var (
 	// ErrAMPMissingMPP is returned when the caller tries to attach an AMP
 	// record but no MPP record is presented for the final hop.
 	ErrAMPMissingMPP = errors.New("cannot send AMP without MPP record")
+
+	// ErrIntermediateTrampolineHop is returned when a hop tries to deliver
+	// a trampoline onion to an intermediate hop, only the final hop of a
+	// route can be a trampoline node.
+	ErrIntermediateTrampolineHop = errors.New("cannot send trampoline " +
+		"onion to intermediate")
 )
 
 // Vertex is a simple alias for the serialization of a compressed Bitcoin
@@ -145,6 +151,11 @@ type Hop struct {
 	// spread over more than one HTLC. This field should only be set for
 	// the final hop in a blinded path.
 	TotalAmtMsat lnwire.MilliSatoshi
+
+	// TrampolineOnion is the encoded trampoline payload that instructs
+	// the trampoline node to pay the final recipient on our behalf. This
+	// field should only be set for the final hop.
+	TrampolineOnion []byte
 }
 
 // Copy returns a deep copy of the Hop.
@@ -264,6 +275,18 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 		)
 	}
 
+	// A trampoline onion can only be delivered to the final hop, which is
+	// the trampoline node.
+	if h.TrampolineOnion != nil {
+		if nextChanID != 0 {
+			return ErrIntermediateTrampolineHop
+		}
+
+		records = append(records, record.NewTrampolineOnionRecord(
+			&h.TrampolineOnion,
+		))
+	}
+
 	// Append any custom types destined for this hop.
 	tlvRecords := tlv.MapToRecords(h.CustomRecords)
 	records = append(records, tlvRecords...)
@@ -350,6 +373,14 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 		)
 	}
 
+	// Add trampoline onion if present.
+	if h.TrampolineOnion != nil {
+		addRecord(
+			record.TrampolineOnionType,
+			uint64(len(h.TrampolineOnion)),
+		)
+	}
+
 	// Add custom records.
 	for k, v := range h.CustomRecords {
 		addRecord(tlv.Type(k), uint64(len(v)))
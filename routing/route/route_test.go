@@ -160,6 +160,33 @@ func TestAMPHop(t *testing.T) {
 	}
 }
 
+// TestTrampolineHop asserts that a Hop will only encode a trampoline onion to
+// the final hop of a route.
+func TestTrampolineHop(t *testing.T) {
+	t.Parallel()
+
+	hop := Hop{
+		ChannelID:        1,
+		OutgoingTimeLock: 44,
+		AmtToForward:     testAmt,
+		MPP:              record.NewMPP(testAmt, testAddr),
+		TrampolineOnion:  []byte{1, 2, 3},
+	}
+
+	// Encoding a trampoline onion to an intermediate hop should result in
+	// a failure. The MPP record is removed so that it doesn't fail first.
+	intermediate := hop
+	intermediate.MPP = nil
+
+	var b bytes.Buffer
+	err := intermediate.PackHopPayload(&b, 2)
+	require.ErrorIs(t, err, ErrIntermediateTrampolineHop)
+
+	// Encoding a trampoline onion to a final hop should be successful.
+	b.Reset()
+	require.NoError(t, hop.PackHopPayload(&b, 0))
+}
+
 // TestNoForwardingParams tests packing of a hop payload without an amount or
 // expiry height.
 func TestNoForwardingParams(t *testing.T) {
@@ -247,6 +274,24 @@ func TestPayloadSize(t *testing.T) {
 		},
 	}
 
+	trampolineHops := []*Hop{
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1200,
+			OutgoingTimeLock: 700000,
+			ChannelID:        63584534844,
+		},
+		{
+			// The trampoline node receives the trampoline onion
+			// along with the total amount of the payment.
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1200,
+			OutgoingTimeLock: 700000,
+			MPP:              record.NewMPP(1200, [32]byte{}),
+			TrampolineOnion:  bytes.Repeat([]byte{1}, 300),
+		},
+	}
+
 	testCases := []struct {
 		name string
 		hops []*Hop
@@ -259,6 +304,10 @@ func TestPayloadSize(t *testing.T) {
 			name: "blinded route",
 			hops: blindedHops,
 		},
+		{
+			name: "trampoline route",
+			hops: trampolineHops,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -21,6 +21,7 @@ import (
 	"github.com/lightningnetwork/lnd/batch"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
@@ -267,6 +268,10 @@ type MissionController interface {
 	// payment from fromNode along edge.
 	GetProbability(fromNode, toNode route.Vertex,
 		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64
+
+	// GetPairHistorySnapshot returns the stored history for a given node
+	// pair.
+	GetPairHistorySnapshot(fromNode, toNode route.Vertex) TimedPairResult
 }
 
 // FeeSchema is the set fee configuration for a Lightning Node on the network.
@@ -283,6 +288,11 @@ type FeeSchema struct {
 	// the effective fee rate charged per mSAT will be: (amount *
 	// FeeRate/1,000,000).
 	FeeRate uint32
+
+	// InboundFee is the optional fee that is charged for payments that
+	// arrive over the channel, on top of the fee of the outgoing channel.
+	// If it's nil, the current inbound fee of the channel is kept.
+	InboundFee *models.InboundFee
 }
 
 // ChannelPolicy holds the parameters that determine the policy we enforce
@@ -401,6 +411,15 @@ type Config struct {
 	// IsAlias returns whether a passed ShortChannelID is an alias. This is
 	// only used for our local channels.
 	IsAlias func(scid lnwire.ShortChannelID) bool
+
+	// PathFindingTraces stores the path finding traces of payments that
+	// request them. The router prunes the expired traces at startup and
+	// periodically thereafter. If nil, no traces are pruned.
+	PathFindingTraces PathFindingTraceStore
+
+	// PathFindingTraceRetention is the time for which the path finding
+	// traces of payments are kept. If zero, no traces are pruned.
+	PathFindingTraceRetention time.Duration
 }
 
 // EdgeLocator is a struct used to identify a specific edge.
@@ -718,12 +737,57 @@ func (r *ChannelRouter) Start() error {
 		}(payment)
 	}
 
+	// Prune the expired path finding traces now and periodically
+	// thereafter, so that traces don't pile up.
+	if r.cfg.PathFindingTraces != nil &&
+		r.cfg.PathFindingTraceRetention > 0 {
+
+		r.pruneTraces()
+
+		r.wg.Add(1)
+		go r.tracePruner()
+	}
+
 	r.wg.Add(1)
 	go r.networkHandler()
 
 	return nil
 }
 
+// tracePruner prunes the expired path finding traces at a regular interval.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *ChannelRouter) tracePruner() {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-r.cfg.Clock.TickAfter(tracePruneInterval):
+			r.pruneTraces()
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// pruneTraces deletes the path finding traces of the payments that were
+// created longer than the retention ago. Failing to prune the traces doesn't
+// fail the router.
+func (r *ChannelRouter) pruneTraces() {
+	pruned, err := r.cfg.PathFindingTraces.DeletePaymentTraces(
+		r.cfg.Clock.Now().Add(-r.cfg.PathFindingTraceRetention),
+	)
+	if err != nil {
+		log.Warnf("Unable to prune path finding traces: %v", err)
+		return
+	}
+
+	if pruned > 0 {
+		log.Debugf("Pruned %v expired path finding traces", pruned)
+	}
+}
+
 // Stop signals the ChannelRouter to gracefully halt all routines. This method
 // will *block* until all goroutines have excited. If the channel router has
 // already stopped then this method will return immediately.
@@ -2247,6 +2311,29 @@ type LightningPayment struct {
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// Trampoline is set if the payment is delivered to its recipient by a
+	// trampoline node. In that case the target of the payment is the
+	// trampoline node.
+	Trampoline *TrampolineParams
+
+	// AvoidNodes is the set of nodes that the payment may not be routed
+	// through. It applies to every shard of the payment.
+	AvoidNodes map[route.Vertex]struct{}
+
+	// PreferredNodes is the set of nodes that the payment should be routed
+	// through. Routes through other nodes are only used if they are worth
+	// an additional failed attempt.
+	PreferredNodes map[route.Vertex]struct{}
+
+	// MaxHops is the maximum number of hops of the routes of the payment.
+	// If zero, the number of hops isn't restricted.
+	MaxHops uint32
+
+	// Trace indicates whether the path finding runs of the payment are
+	// traced. The traces are stored by payment hash and can be used to
+	// find out why no route was found.
+	Trace bool
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -3056,6 +3143,10 @@ func getRouteUnifiers(source route.Vertex, hops []route.Vertex,
 	// Allocate a list that will contain the edge unifiers for this route.
 	unifiers := make([]*edgeUnifier, len(hops))
 
+	// outboundFee is the outbound fee of the hop that was added last, to
+	// which its inbound fee is added once its incoming channel is known.
+	var outboundFee lnwire.MilliSatoshi
+
 	// Traverse hops backwards to accumulate fees in the running amounts.
 	for i := len(hops) - 1; i >= 0; i-- {
 		toNode := hops[i]
@@ -3108,9 +3199,21 @@ func getRouteUnifiers(source route.Vertex, hops []route.Vertex,
 			}
 		}
 
+		// Add the inbound fee that the to node charges for this
+		// channel, unless it's the final hop.
+		if i < len(hops)-1 {
+			inboundFee := edge.policy.InboundFee
+			amtToForward := runningAmt - outboundFee
+			runningAmt = amtToForward + inboundFee.TotalFee(
+				outboundFee, amtToForward,
+			)
+		}
+
 		// Add fee for this hop.
+		outboundFee = 0
 		if !localChan {
-			runningAmt += edge.policy.ComputeFee(runningAmt)
+			outboundFee = edge.policy.ComputeFee(runningAmt)
+			runningAmt += outboundFee
 		}
 
 		log.Tracef("Select channel %v at position %v",
@@ -3150,8 +3253,9 @@ func getPathEdges(source route.Vertex, receiverAmt lnwire.MilliSatoshi,
 
 		if i > 0 {
 			// Decrease the amount to send while going forward.
-			receiverAmt -= edge.policy.ComputeFeeFromIncoming(
-				receiverAmt,
+			receiverAmt = outgoingFromIncoming(
+				receiverAmt, edge.policy,
+				pathEdges[i-1].InboundFee,
 			)
 		}
 
@@ -3160,3 +3264,34 @@ func getPathEdges(source route.Vertex, receiverAmt lnwire.MilliSatoshi,
 
 	return pathEdges, receiverAmt, nil
 }
+
+// outgoingFromIncoming returns the largest amount that a node forwards for the
+// given incoming amount, given its policy of the outgoing channel and the
+// inbound fee that it charges for the incoming channel.
+func outgoingFromIncoming(incomingAmt lnwire.MilliSatoshi,
+	policy *channeldb.CachedEdgePolicy,
+	inboundFee models.InboundFee) lnwire.MilliSatoshi {
+
+	// Without an inbound fee, the amount can be computed directly.
+	if inboundFee.IsZero() {
+		return incomingAmt - policy.ComputeFeeFromIncoming(incomingAmt)
+	}
+
+	// Otherwise we search for the largest outgoing amount whose incoming
+	// amount doesn't exceed the given one. The incoming amount never
+	// decreases as the outgoing amount grows, as a discount can lower the
+	// total fee of a node to zero at most.
+	low, high := lnwire.MilliSatoshi(0), incomingAmt
+	for low < high {
+		mid := low + (high-low+1)/2
+
+		fee := inboundFee.TotalFee(policy.ComputeFee(mid), mid)
+		if mid+fee <= incomingAmt {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return low
+}
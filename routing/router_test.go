@@ -144,6 +144,7 @@ func createTestCtxFromGraphInstanceAssumeValid(t *testing.T,
 		GetLink:           graphInstance.getLink,
 		PathFindingConfig: pathFindingConfig,
 		MissionControl:    mc,
+		Clock:             clock.NewTestClock(time.Unix(1, 0)),
 	}
 
 	notifier := &lnmock.ChainNotifier{
@@ -3475,6 +3476,10 @@ func TestSendMPPaymentSucceed(t *testing.T) {
 	)
 	identifier := lntypes.Hash(req.Identifier())
 	session := &mockPaymentSession{}
+	session.On(
+		"RequestPlannedRoutes", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return([]*route.Route(nil)).Maybe()
 	sessionSource.On("NewPaymentSession", req).Return(session, nil)
 	controlTower.On("InitPayment", identifier, mock.Anything).Return(nil)
 
@@ -3662,6 +3667,10 @@ func TestSendMPPaymentSucceedOnExtraShards(t *testing.T) {
 	)
 	identifier := lntypes.Hash(req.Identifier())
 	session := &mockPaymentSession{}
+	session.On(
+		"RequestPlannedRoutes", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return([]*route.Route(nil)).Maybe()
 	sessionSource.On("NewPaymentSession", req).Return(session, nil)
 	controlTower.On("InitPayment", identifier, mock.Anything).Return(nil)
 
@@ -3877,6 +3886,10 @@ func TestSendMPPaymentFailed(t *testing.T) {
 	)
 	identifier := lntypes.Hash(req.Identifier())
 	session := &mockPaymentSession{}
+	session.On(
+		"RequestPlannedRoutes", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return([]*route.Route(nil)).Maybe()
 	sessionSource.On("NewPaymentSession", req).Return(session, nil)
 	controlTower.On("InitPayment", identifier, mock.Anything).Return(nil)
 
@@ -4068,6 +4081,10 @@ func TestSendMPPaymentFailedWithShardsInFlight(t *testing.T) {
 	)
 	identifier := lntypes.Hash(req.Identifier())
 	session := &mockPaymentSession{}
+	session.On(
+		"RequestPlannedRoutes", mock.Anything, mock.Anything,
+		mock.Anything,
+	).Return([]*route.Route(nil)).Maybe()
 	sessionSource.On("NewPaymentSession", req).Return(session, nil)
 	controlTower.On("InitPayment", identifier, mock.Anything).Return(nil)
 
@@ -4765,3 +4782,52 @@ func TestNewRouteRequest(t *testing.T) {
 		})
 	}
 }
+
+// TestPruneTraces tests that the router prunes the expired path finding
+// traces at startup and periodically thereafter, using its clock.
+func TestPruneTraces(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Unix(10_000, 0)
+	// The signal is buffered, so that the pruner can register its next
+	// ticker after the test no longer reads the signals.
+	tickSignal := make(chan time.Duration, 2)
+	testClock := clock.NewTestClockWithTickSignal(startTime, tickSignal)
+
+	store := &mockTraceStore{
+		pruned: make(chan time.Time, 1),
+	}
+	router := &ChannelRouter{
+		cfg: &Config{
+			Clock:                     testClock,
+			PathFindingTraces:         store,
+			PathFindingTraceRetention: time.Hour,
+		},
+		quit: make(chan struct{}),
+	}
+
+	// The traces are pruned right away at startup.
+	router.pruneTraces()
+	require.Equal(t, startTime.Add(-time.Hour), <-store.pruned)
+
+	router.wg.Add(1)
+	go router.tracePruner()
+	t.Cleanup(func() {
+		close(router.quit)
+		router.wg.Wait()
+	})
+
+	// Once the prune interval passed, the traces are pruned again.
+	require.Equal(t, tracePruneInterval, <-tickSignal)
+
+	now := startTime.Add(tracePruneInterval)
+	testClock.SetTime(now)
+
+	select {
+	case prunedBefore := <-store.pruned:
+		require.Equal(t, now.Add(-time.Hour), prunedBefore)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("traces not pruned")
+	}
+}
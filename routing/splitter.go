@@ -0,0 +1,289 @@
+package routing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// SplitterHalving is the name of the splitter that halves the amount
+	// of a shard until a route is found for it.
+	SplitterHalving = "halving"
+
+	// SplitterFlow is the name of the splitter that divides a payment
+	// into units, and assigns each unit to the path that is most likely
+	// to carry it on top of the units that were assigned before.
+	SplitterFlow = "flow"
+
+	// DefaultSplitter is the splitter that is used if none is configured.
+	DefaultSplitter = SplitterHalving
+
+	// maxFlowUnits is the maximum number of units that the flow splitter
+	// divides an amount into. Every unit takes one path finding run.
+	maxFlowUnits = 16
+)
+
+var (
+	// errTooManyShards is returned when the flow splitter would need more
+	// shards than the payment may use.
+	errTooManyShards = errors.New("split requires too many shards")
+)
+
+// flowState holds the liquidity that the units which were assigned by the
+// flow splitter so far use. It provides the probability source and bandwidth
+// hints for path finding that take this liquidity into account.
+type flowState struct {
+	// probabilitySource returns the success probability of a channel
+	// that carries no other units.
+	probabilitySource func(route.Vertex, route.Vertex,
+		lnwire.MilliSatoshi, btcutil.Amount) float64
+
+	// bandwidthHints holds the bandwidth of our own channels.
+	bandwidthHints bandwidthHints
+
+	// pairFlows holds the amount that is assigned to each node pair.
+	pairFlows map[DirectedNodePair]lnwire.MilliSatoshi
+
+	// localFlows holds the amount that is assigned to each of our own
+	// channels.
+	localFlows map[uint64]lnwire.MilliSatoshi
+}
+
+// newFlowState creates a flow state without any assigned units.
+func newFlowState(probabilitySource func(route.Vertex, route.Vertex,
+	lnwire.MilliSatoshi, btcutil.Amount) float64,
+	bandwidthHints bandwidthHints) *flowState {
+
+	return &flowState{
+		probabilitySource: probabilitySource,
+		bandwidthHints:    bandwidthHints,
+		pairFlows: make(
+			map[DirectedNodePair]lnwire.MilliSatoshi,
+		),
+		localFlows: make(map[uint64]lnwire.MilliSatoshi),
+	}
+}
+
+// probability returns the probability that the node pair can carry the given
+// amount on top of the units that were assigned to it, given that it can
+// carry those units.
+func (f *flowState) probability(from, to route.Vertex,
+	amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64 {
+
+	flow := f.pairFlows[NewDirectedNodePair(from, to)]
+	if flow == 0 {
+		return f.probabilitySource(from, to, amt, capacity)
+	}
+
+	prior := f.probabilitySource(from, to, flow, capacity)
+	if prior == 0 {
+		return 0
+	}
+
+	return math.Min(
+		f.probabilitySource(from, to, flow+amt, capacity)/prior, 1,
+	)
+}
+
+// availableChanBandwidth returns the bandwidth of one of our own channels
+// that isn't used by the assigned units.
+//
+// NOTE: Part of the bandwidthHints interface.
+func (f *flowState) availableChanBandwidth(channelID uint64,
+	amount lnwire.MilliSatoshi) (lnwire.MilliSatoshi, bool) {
+
+	bandwidth, ok := f.bandwidthHints.availableChanBandwidth(
+		channelID, amount,
+	)
+	if !ok {
+		return bandwidth, ok
+	}
+
+	flow := f.localFlows[channelID]
+	if flow >= bandwidth {
+		return 0, true
+	}
+
+	return bandwidth - flow, true
+}
+
+// addRoute assigns the amounts that the route carries over each of its
+// channels.
+func (f *flowState) addRoute(rt *route.Route) {
+	from := rt.SourcePubKey
+	amt := rt.TotalAmount
+	for i, hop := range rt.Hops {
+		if i == 0 {
+			f.localFlows[hop.ChannelID] += amt
+		}
+
+		f.pairFlows[NewDirectedNodePair(from, hop.PubKeyBytes)] += amt
+
+		from = hop.PubKeyBytes
+		amt = hop.AmtToForward
+	}
+}
+
+// flowShard is a shard of a payment that carries all units that the flow
+// splitter assigned to the same path.
+type flowShard struct {
+	path []*channeldb.CachedEdgePolicy
+	amt  lnwire.MilliSatoshi
+
+	// route is the route that carries amt along the path.
+	route *route.Route
+}
+
+// pathKey returns a key that identifies the channels of a path.
+func pathKey(path []*channeldb.CachedEdgePolicy) string {
+	key := make([]byte, 0, 8*len(path))
+	for _, edge := range path {
+		key = binary.BigEndian.AppendUint64(key, edge.ChannelID)
+	}
+
+	return string(key)
+}
+
+// splitFlow splits the amount into units and assigns each unit to the path
+// that is most likely to carry it, on top of the units that were assigned
+// before. The success probability of a channel that already carries units is
+// conditioned on it carrying those units, so that the joint success
+// probability of the shards is maximized one unit at a time. This is the
+// successive shortest path approach of min-cost flow solvers, with the
+// negative log probability of the channels as convex cost. Units that were
+// assigned to the same path are sent as a single shard.
+//
+// Routes are returned for all shards. Their total fee doesn't exceed the fee
+// limit, and their number doesn't exceed maxShards.
+func (p *paymentSession) splitFlow(amt, feeLimit lnwire.MilliSatoshi,
+	maxShards, height uint32, restrictions *RestrictParams,
+	finalCltvDelta uint16) ([]*route.Route, error) {
+
+	// Units below the minimum shard amount aren't worth a path of their
+	// own.
+	units := amt / p.minShardAmt
+	if units > maxFlowUnits {
+		units = maxFlowUnits
+	}
+	if units < 2 {
+		return nil, errNoPathFound
+	}
+
+	routingGraph, cleanup, err := p.getRoutingGraph()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	bandwidthHints, err := p.getBandwidthHints(routingGraph)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := newFlowState(restrictions.ProbabilitySource, bandwidthHints)
+
+	unitRestrictions := *restrictions
+	unitRestrictions.ProbabilitySource = flows.probability
+
+	// The path finding runs for the units aren't traced, they would bury
+	// the traces of the regular runs.
+	unitRestrictions.tracer = nil
+
+	source := routingGraph.sourceNode()
+	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
+
+	var (
+		shards      []*flowShard
+		shardIndex  = make(map[string]*flowShard)
+		fees        lnwire.MilliSatoshi
+		probability = 1.0
+		unitAmt     = amt / units
+	)
+	for i := lnwire.MilliSatoshi(0); i < units; i++ {
+		// The last unit carries the remainder of the division.
+		if i == units-1 {
+			unitAmt = amt - unitAmt*(units-1)
+		}
+
+		// A unit may use what's left of the fee limit after the
+		// fees of the shards that the units so far were assigned to.
+		unitRestrictions.FeeLimit = feeLimit - fees
+
+		path, unitProbability, err := p.pathFinder(
+			&graphParams{
+				additionalEdges: p.additionalEdges,
+				bandwidthHints:  flows,
+				graph:           routingGraph,
+			},
+			&unitRestrictions, &p.pathFindingConfig,
+			source, p.payment.Target,
+			unitAmt, p.payment.TimePref, finalHtlcExpiry,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rt, err := newRoute(
+			source, path, height,
+			p.finalHopParams(unitAmt, finalCltvDelta), nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		flows.addRoute(rt)
+		probability *= unitProbability
+
+		key := pathKey(path)
+		shard, ok := shardIndex[key]
+		if !ok {
+			if uint32(len(shards)) >= maxShards {
+				return nil, errTooManyShards
+			}
+
+			shard = &flowShard{path: path}
+			shardIndex[key] = shard
+			shards = append(shards, shard)
+		}
+		shard.amt += unitAmt
+
+		// The fees of the shard's route replace the ones it had
+		// before carrying the unit, as the fees of a path don't grow
+		// linearly with its amount.
+		if shard.route != nil {
+			fees -= shard.route.TotalFees()
+		}
+
+		shard.route, err = newRoute(
+			source, shard.path, height,
+			p.finalHopParams(shard.amt, finalCltvDelta), nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The fee limit applies to all shards together.
+		fees += shard.route.TotalFees()
+		if fees > feeLimit {
+			return nil, fmt.Errorf("split fees %v exceed fee "+
+				"limit %v", fees, feeLimit)
+		}
+	}
+
+	routes := make([]*route.Route, 0, len(shards))
+	for _, shard := range shards {
+		routes = append(routes, shard.route)
+	}
+
+	p.log.Debugf("Split amt=%v into %v shards with joint success "+
+		"probability %v", amt, len(routes), probability)
+
+	return routes, nil
+}
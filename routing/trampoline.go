@@ -0,0 +1,234 @@
+package routing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	htlcswitchhop "github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultTrampolineCltvDelta is the time lock delta that is reserved
+	// for a trampoline node if the payer doesn't specify one. It covers
+	// the route from the trampoline node to the recipient as well.
+	DefaultTrampolineCltvDelta = 576
+
+	// DefaultTrampolineBaseFee is the base fee in millisatoshi that is
+	// paid to a trampoline node if the payer doesn't specify a fee. The
+	// fee covers the route from the trampoline node to the recipient as
+	// well.
+	DefaultTrampolineBaseFee = lnwire.MilliSatoshi(1000)
+
+	// DefaultTrampolineFeeRate is the proportional fee in parts per
+	// million that is paid to a trampoline node if the payer doesn't
+	// specify a fee.
+	DefaultTrampolineFeeRate = 5000
+)
+
+var (
+	// ErrTrampolineUnsupported is returned when a route is built to a
+	// trampoline node that doesn't signal support for trampoline routing.
+	ErrTrampolineUnsupported = errors.New("node doesn't support " +
+		"trampoline routing")
+)
+
+// DefaultTrampolineFee returns the fee that is paid to a trampoline node for
+// delivering the given amount if the payer doesn't specify a fee.
+func DefaultTrampolineFee(amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+	return DefaultTrampolineBaseFee + amt*DefaultTrampolineFeeRate/1e6
+}
+
+// TrampolineParams describes how a payment is delivered to its recipient by a
+// trampoline node.
+type TrampolineParams struct {
+	// Node is the trampoline node that pays the recipient on our behalf.
+	Node route.Vertex
+
+	// PaymentHash is the hash of the payment, which the trampoline onion
+	// is bound to.
+	PaymentHash lntypes.Hash
+
+	// CltvDelta is the time lock delta that is reserved for the
+	// trampoline node.
+	CltvDelta uint16
+
+	// Payload holds the instructions for the trampoline node. Its
+	// outgoing cltv is filled in when the route to the trampoline node is
+	// built.
+	Payload record.TrampolinePayload
+}
+
+// onion creates the trampoline onion for a route to the trampoline node whose
+// final hop expires at the given height. The trampoline payload is encrypted
+// to the trampoline node, so that only it can read the instructions to reach
+// the recipient.
+func (t *TrampolineParams) onion(outgoingTimeLock uint32) ([]byte, error) {
+	if outgoingTimeLock <= uint32(t.CltvDelta) {
+		return nil, fmt.Errorf("time lock %v doesn't cover trampoline "+
+			"cltv delta %v", outgoingTimeLock, t.CltvDelta)
+	}
+
+	payload := t.Payload
+	payload.OutgoingCltv = outgoingTimeLock - uint32(t.CltvDelta)
+
+	payloadBytes, err := record.EncodeTrampolinePayload(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeKey, err := btcec.ParsePubKey(t.Node[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	hops := []htlcswitchhop.TrampolineHop{{
+		NodeKey: nodeKey,
+		Payload: payloadBytes,
+	}}
+	packet, err := htlcswitchhop.NewTrampolineOnion(
+		hops, sessionKey, t.PaymentHash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := packet.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// RouteViaTrampoline turns the payment into a payment to the given trampoline
+// node, which pays the original recipient on our behalf. The trampoline node
+// receives the instructions to reach the recipient, including the route hints
+// of the recipient, in its onion payload. This way the payment can be sent
+// without knowing a route to the recipient.
+//
+// The trampoline fee is added to the amount that is paid to the trampoline
+// node and subtracted from the fee limit of the payment. The cltv delta of
+// the trampoline node is added to the final cltv delta.
+func (l *LightningPayment) RouteViaTrampoline(node route.Vertex,
+	features *lnwire.FeatureVector, fee lnwire.MilliSatoshi,
+	cltvDelta uint16) error {
+
+	switch {
+	case l.amp != nil:
+		return errors.New("cannot send AMP payment via trampoline")
+
+	case l.paymentHash == nil:
+		return errors.New("cannot send payment via trampoline " +
+			"without payment hash")
+
+	case l.PaymentAddr == nil:
+		return errors.New("cannot send payment via trampoline " +
+			"without payment address")
+
+	case len(l.DestCustomRecords) > 0:
+		return errors.New("cannot send custom records via trampoline")
+
+	case l.Metadata != nil:
+		return errors.New("cannot send payment metadata via " +
+			"trampoline")
+
+	case l.LastHop != nil:
+		return errors.New("cannot restrict last hop of payment via " +
+			"trampoline")
+
+	case node == l.Target:
+		return errors.New("trampoline node is the payment target")
+
+	case fee > l.FeeLimit:
+		return fmt.Errorf("trampoline fee %v exceeds fee limit %v",
+			fee, l.FeeLimit)
+	}
+
+	// If the features of the trampoline node aren't known, we assume that
+	// it supports everything a trampoline node requires.
+	if features == nil {
+		features = lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+				lnwire.TrampolineRoutingOptional,
+			), lnwire.Features,
+		)
+	}
+
+	if !features.HasFeature(lnwire.TrampolineRoutingOptional) {
+		return ErrTrampolineUnsupported
+	}
+
+	nextNode, err := btcec.ParsePubKey(l.Target[:])
+	if err != nil {
+		return err
+	}
+
+	// The trampoline onion is encrypted to the trampoline node, so its
+	// key must be valid.
+	if _, err := btcec.ParsePubKey(node[:]); err != nil {
+		return fmt.Errorf("invalid trampoline node: %w", err)
+	}
+
+	routeHints := make([][]record.TrampolineHopHint, 0, len(l.RouteHints))
+	for _, routeHint := range l.RouteHints {
+		hint := make([]record.TrampolineHopHint, 0, len(routeHint))
+		for _, hop := range routeHint {
+			hint = append(hint, record.TrampolineHopHint{
+				NodeID:          hop.NodeID,
+				ChannelID:       hop.ChannelID,
+				FeeBaseMSat:     hop.FeeBaseMSat,
+				CLTVExpiryDelta: hop.CLTVExpiryDelta,
+				FeeProportionalMillionths: hop.
+					FeeProportionalMillionths,
+			})
+		}
+
+		routeHints = append(routeHints, hint)
+	}
+
+	// The payment address of the recipient is only revealed to the
+	// trampoline node. The htlcs to the trampoline node carry a fresh
+	// address instead.
+	var paymentAddr [32]byte
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		return err
+	}
+
+	l.Trampoline = &TrampolineParams{
+		Node:        node,
+		PaymentHash: *l.paymentHash,
+		CltvDelta:   cltvDelta,
+		Payload: record.TrampolinePayload{
+			AmtToForward: l.Amount,
+			NextNode:     nextNode,
+			MPP:          record.NewMPP(l.Amount, *l.PaymentAddr),
+			Features:     l.DestFeatures,
+			RouteHints:   routeHints,
+		},
+	}
+
+	l.Target = node
+	l.Amount += fee
+	l.FeeLimit -= fee
+	l.FinalCLTVDelta += cltvDelta
+	l.DestFeatures = features
+	l.PaymentAddr = &paymentAddr
+	l.RouteHints = nil
+
+	return nil
+}
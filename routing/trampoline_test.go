@@ -0,0 +1,171 @@
+package routing
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	htlcswitchhop "github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRouteViaTrampoline tests that a payment is retargeted to a trampoline
+// node, and that the route to the trampoline node carries the instructions to
+// reach the recipient.
+func TestRouteViaTrampoline(t *testing.T) {
+	t.Parallel()
+
+	recipientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	hintKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	trampolineKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var (
+		recipient   = route.NewVertex(recipientKey.PubKey())
+		trampoline  = route.NewVertex(trampolineKey.PubKey())
+		paymentAddr = [32]byte{1}
+		fee         = lnwire.MilliSatoshi(1_500)
+	)
+
+	hopHint := zpay32.HopHint{
+		NodeID:                    hintKey.PubKey(),
+		ChannelID:                 12345,
+		FeeBaseMSat:               1,
+		FeeProportionalMillionths: 2,
+		CLTVExpiryDelta:           3,
+	}
+
+	payment := &LightningPayment{
+		Target:         recipient,
+		Amount:         100_000,
+		FeeLimit:       10_000,
+		FinalCLTVDelta: 40,
+		PaymentAddr:    &paymentAddr,
+		RouteHints:     [][]zpay32.HopHint{{hopHint}},
+	}
+	require.NoError(t, payment.SetPaymentHash(lntypes.Hash{1}))
+
+	// Custom records can't be delivered via a trampoline node.
+	invalid := *payment
+	invalid.DestCustomRecords = record.CustomSet{65536: []byte{1}}
+	err = invalid.RouteViaTrampoline(trampoline, nil, fee, 100)
+	require.Error(t, err)
+
+	// Neither can a payment be sent via a node that doesn't support
+	// trampoline routing.
+	err = payment.RouteViaTrampoline(
+		trampoline, lnwire.EmptyFeatureVector(), fee, 100,
+	)
+	require.ErrorIs(t, err, ErrTrampolineUnsupported)
+
+	err = payment.RouteViaTrampoline(trampoline, nil, fee, 100)
+	require.NoError(t, err)
+
+	require.Equal(t, trampoline, payment.Target)
+	require.EqualValues(t, 101_500, payment.Amount)
+	require.EqualValues(t, 8_500, payment.FeeLimit)
+	require.EqualValues(t, 140, payment.FinalCLTVDelta)
+	require.Nil(t, payment.RouteHints)
+	require.NotEqual(t, paymentAddr, *payment.PaymentAddr)
+	require.True(t, payment.DestFeatures.HasFeature(
+		lnwire.TrampolineRoutingOptional,
+	))
+
+	// The trampoline payload holds the original recipient, amount and
+	// payment address.
+	payload := payment.Trampoline.Payload
+	require.EqualValues(t, 100_000, payload.AmtToForward)
+	require.Equal(t, recipientKey.PubKey(), payload.NextNode)
+	require.Equal(t, paymentAddr, payload.MPP.PaymentAddr())
+	require.EqualValues(t, 100_000, payload.MPP.TotalMsat())
+	require.Equal(t, [][]record.TrampolineHopHint{{{
+		NodeID:                    hintKey.PubKey(),
+		ChannelID:                 12345,
+		FeeBaseMSat:               1,
+		FeeProportionalMillionths: 2,
+		CLTVExpiryDelta:           3,
+	}}}, payload.RouteHints)
+
+	// Build a route with a single hop to the trampoline node, which
+	// carries the trampoline onion.
+	edge := &channeldb.CachedEdgePolicy{
+		ChannelID: 1,
+		ToNodePubKey: func() route.Vertex {
+			return trampoline
+		},
+		ToNodeFeatures: payment.DestFeatures,
+	}
+
+	rt, err := newRoute(
+		route.Vertex{1}, []*channeldb.CachedEdgePolicy{edge}, 1_000,
+		finalHopParams{
+			amt:         payment.Amount,
+			totalAmt:    payment.Amount,
+			cltvDelta:   payment.FinalCLTVDelta,
+			paymentAddr: payment.PaymentAddr,
+			trampoline:  payment.Trampoline,
+		}, nil,
+	)
+	require.NoError(t, err)
+
+	finalHop := rt.FinalHop()
+	require.EqualValues(t, 1_140, finalHop.OutgoingTimeLock)
+	require.Equal(t, *payment.PaymentAddr, finalHop.MPP.PaymentAddr())
+
+	// Only the trampoline node can peel the trampoline onion, which is
+	// bound to the payment hash.
+	packet, err := htlcswitchhop.DecodeTrampolineOnion(
+		finalHop.TrampolineOnion,
+	)
+	require.NoError(t, err)
+
+	paymentHash := lntypes.Hash{1}
+	_, _, err = packet.Peel(
+		&keychain.PrivKeyECDH{PrivKey: recipientKey}, paymentHash[:],
+	)
+	require.ErrorIs(t, err, htlcswitchhop.ErrTrampolineOnionHMAC)
+
+	trampolinePayload, next, err := packet.Peel(
+		&keychain.PrivKeyECDH{PrivKey: trampolineKey}, paymentHash[:],
+	)
+	require.NoError(t, err)
+	require.Nil(t, next)
+
+	decoded, err := record.DecodeTrampolinePayload(
+		bytes.NewReader(trampolinePayload),
+	)
+	require.NoError(t, err)
+
+	// The recipient receives the final cltv delta of the original payment.
+	expected := payload
+	expected.OutgoingCltv = 1_040
+	require.Equal(t, &expected, decoded)
+
+	// A route to a node that doesn't support trampoline routing can't be
+	// built.
+	edge.ToNodeFeatures = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional),
+		lnwire.Features,
+	)
+	_, err = newRoute(
+		route.Vertex{1}, []*channeldb.CachedEdgePolicy{edge}, 1_000,
+		finalHopParams{
+			amt:         payment.Amount,
+			totalAmt:    payment.Amount,
+			cltvDelta:   payment.FinalCLTVDelta,
+			paymentAddr: payment.PaymentAddr,
+			trampoline:  payment.Trampoline,
+		}, nil,
+	)
+	require.ErrorIs(t, err, ErrTrampolineUnsupported)
+}
@@ -3,6 +3,7 @@ package routing
 import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -233,10 +234,11 @@ func (u *edgeUnifier) getEdgeLocal(amt lnwire.MilliSatoshi,
 // forwarding context.
 func (u *edgeUnifier) getEdgeNetwork(amt lnwire.MilliSatoshi) *unifiedEdge {
 	var (
-		bestPolicy  *channeldb.CachedEdgePolicy
-		maxFee      lnwire.MilliSatoshi
-		maxTimelock uint16
-		maxCapMsat  lnwire.MilliSatoshi
+		bestPolicy    *channeldb.CachedEdgePolicy
+		maxFee        lnwire.MilliSatoshi
+		maxTimelock   uint16
+		maxCapMsat    lnwire.MilliSatoshi
+		maxInboundFee *models.InboundFee
 	)
 
 	for _, edge := range u.edges {
@@ -274,6 +276,16 @@ func (u *edgeUnifier) getEdgeNetwork(amt lnwire.MilliSatoshi) *unifiedEdge {
 			maxTimelock, edge.policy.TimeLockDelta,
 		)
 
+		// Track the highest inbound fee that the to node charges for
+		// any of the candidate channels, as it's up to the from node
+		// which one it forwards over.
+		inboundFee := edge.policy.InboundFee
+		if maxInboundFee == nil ||
+			inboundFee.CalcFee(amt) > maxInboundFee.CalcFee(amt) {
+
+			maxInboundFee = &inboundFee
+		}
+
 		// Use the policy that results in the highest fee for this
 		// specific amount.
 		fee := edge.policy.ComputeFee(amt)
@@ -296,8 +308,9 @@ func (u *edgeUnifier) getEdgeNetwork(amt lnwire.MilliSatoshi) *unifiedEdge {
 	// We have already picked the highest fee that could be required for
 	// non-strict forwarding. To also cover the case where a lower fee
 	// channel requires a longer time lock, we modify the policy by setting
-	// the maximum encountered time lock. Note that this results in a
-	// synthetic policy that is not actually present on the routing node.
+	// the maximum encountered time lock and inbound fee. Note that this
+	// results in a synthetic policy that is not actually present on the
+	// routing node.
 	//
 	// The reason we do this, is that we try to maximize the chance that we
 	// get forwarded. Because we penalize pair-wise, there won't be a second
@@ -306,6 +319,7 @@ func (u *edgeUnifier) getEdgeNetwork(amt lnwire.MilliSatoshi) *unifiedEdge {
 	policyCopy := *bestPolicy
 	modifiedEdge := unifiedEdge{policy: &policyCopy}
 	modifiedEdge.policy.TimeLockDelta = maxTimelock
+	modifiedEdge.policy.InboundFee = *maxInboundFee
 	modifiedEdge.capacity = maxCapMsat.ToSatoshis()
 
 	return &modifiedEdge
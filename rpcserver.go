@@ -28,9 +28,12 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet"
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightningnetwork/lnd/accounting"
+	"github.com/lightningnetwork/lnd/asn"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainreg"
@@ -38,7 +41,10 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/chanfitness"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/closereport"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/feature"
@@ -61,6 +67,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/chansplicer"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/peer"
@@ -325,6 +332,24 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/AbortSplice": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/UpgradeChannel": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -365,6 +390,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ClosedChannelReports": {{
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -469,6 +501,16 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ExportLedger": {{
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "onchain",
+			Action: "read",
+		}, {
+			Entity: "invoices",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/RestoreChannelBackups": {{
 			Entity: "offchain",
 			Action: "write",
@@ -652,6 +694,15 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 	}
 	graph := s.graphDB
 
+	var asnDB *asn.DB
+	if r.cfg.Routing.ASNDB != "" {
+		asnDB, err = asn.Open(r.cfg.Routing.ASNDB)
+		if err != nil {
+			return fmt.Errorf("unable to read ASN database: %w", err)
+		}
+	}
+	nodeAddrs := newNodeAddrFilter(graph, asnDB, clock.NewDefaultClock())
+
 	routerBackend := &routerrpc.RouterBackend{
 		SelfNode: selfNode.PubKeyBytes,
 		FetchChannelCapacity: func(chanID uint64) (btcutil.Amount,
@@ -700,6 +751,10 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 
 			return info.NodeKey1Bytes, info.NodeKey2Bytes, nil
 		},
+		FetchTorOnlyNodes:      nodeAddrs.TorOnlyNodes,
+		FetchASNNodes:          nodeAddrs.ASNNodes,
+		FetchPaymentTrace:      s.miscDB.FetchPaymentTrace,
+		FetchNodeFeatures:      graph.FetchNodeFeatures,
 		FindRoute:              s.chanRouter.FindRoute,
 		MissionControl:         s.missionControl,
 		ActiveNetParams:        r.cfg.ActiveNetParams.Params,
@@ -746,7 +801,8 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 		r.cfg.net.ResolveTCPAddr, genInvoiceFeatures,
 		genAmpInvoiceFeatures, s.getNodeAnnouncement,
 		s.updateAndBrodcastSelfNode, parseAddr, rpcsLog,
-		s.aliasMgr.GetPeerAlias,
+		s.aliasMgr.GetPeerAlias, s.onionMessenger, s.offersManager,
+		s.prober,
 	)
 	if err != nil {
 		return err
@@ -2586,6 +2642,50 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 					Success:     true,
 				}
 			})
+	} else if channel.HasChanStatus(channeldb.ChanStatusCoopBroadcasted) {
+		// The closing transaction of the channel has already been
+		// broadcast, so the request bumps its fee. This is only
+		// possible if the closing transaction was negotiated with
+		// option_simple_close, which taproot channels don't support.
+		if channel.ChanType.IsTaproot() {
+			return fmt.Errorf("cannot bump fee of closing tx of "+
+				"taproot ChannelPoint(%v)", chanPoint)
+		}
+
+		_, err := channel.SimpleCloseState()
+		switch {
+		case errors.Is(err, channeldb.ErrNoSimpleCloseState):
+			return fmt.Errorf("cannot bump fee of closing tx of "+
+				"ChannelPoint(%v), as it wasn't negotiated "+
+				"with option_simple_close", chanPoint)
+
+		case err != nil:
+			return err
+		}
+
+		if len(in.DeliveryAddress) > 0 {
+			return fmt.Errorf("cannot change delivery address of " +
+				"broadcast closing tx")
+		}
+
+		feeRate, err := lnrpc.CalculateFeeRate(
+			uint64(in.SatPerByte), in.SatPerVbyte, // nolint:staticcheck
+			uint32(in.TargetConf), r.server.cc.FeeEstimator,
+		)
+		if err != nil {
+			return err
+		}
+
+		rpcsLog.Debugf("Bumping closing transaction of "+
+			"ChannelPoint(%v) to %v sat/kw", chanPoint,
+			int64(feeRate))
+
+		updateChan, errChan, err = r.bumpCoopClose(
+			channel, chanPoint, feeRate,
+		)
+		if err != nil {
+			return err
+		}
 	} else {
 		// If this is a frozen channel, then we only allow the co-op
 		// close to proceed if we were the responder to this channel if
@@ -2714,6 +2814,33 @@ out:
 	return nil
 }
 
+// bumpCoopClose requests the peer of the channel to replace its broadcast
+// closing transaction with one that pays the given fee rate. It returns the
+// channels over which the updates and errors of the request are delivered.
+func (r *rpcServer) bumpCoopClose(channel *channeldb.OpenChannel,
+	chanPoint *wire.OutPoint, feeRate chainfee.SatPerKWeight) (
+	chan interface{}, chan error, error) {
+
+	remotePeer, err := r.server.FindPeer(channel.IdentityPub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to bump fee of closing "+
+			"tx while peer is offline: %w", err)
+	}
+
+	updateChan := make(chan interface{}, 2)
+	errChan := make(chan error, 1)
+	remotePeer.HandleLocalCloseChanReqs(&htlcswitch.ChanClose{
+		CloseType:      contractcourt.CloseRegular,
+		ChanPoint:      chanPoint,
+		Updates:        updateChan,
+		TargetFeePerKw: feeRate,
+		BumpFee:        true,
+		Err:            errChan,
+	})
+
+	return updateChan, errChan, nil
+}
+
 func createRPCCloseUpdate(update interface{}) (
 	*lnrpc.CloseStatusUpdate, error) {
 
@@ -2810,6 +2937,443 @@ func (r *rpcServer) abandonChan(chanPoint *wire.OutPoint,
 	return nil
 }
 
+// SpliceChannel splices funds into or out of an active channel. Funds are
+// spliced in from the wallet, unless an address is set to splice funds out to.
+// The call returns once the splice transaction has been signed and broadcast.
+func (r *rpcServer) SpliceChannel(ctx context.Context,
+	in *lnrpc.SpliceChannelRequest) (*lnrpc.SpliceChannelResponse, error) {
+
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	if !r.cfg.ProtocolOptions.Splicing() {
+		return nil, fmt.Errorf("splicing is not enabled, set " +
+			"protocol.splicing to enable it")
+	}
+
+	if in.GetChannelPoint() == nil {
+		return nil, fmt.Errorf("must specify channel point in splice " +
+			"channel")
+	}
+
+	if in.Amount <= 0 {
+		return nil, fmt.Errorf("splice amount must be positive")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	channel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	minConfs, err := lnrpc.ExtractMinConfs(
+		in.GetMinConfs(), in.GetSpendUnconfirmed(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := lnrpc.CalculateFeeRate(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an address is set, the amount is spliced out to it.
+	var pkScript []byte
+	if len(in.Addr) > 0 {
+		addr, err := btcutil.DecodeAddress(
+			in.Addr, r.cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %w", err)
+		}
+
+		if !addr.IsForNet(r.cfg.ActiveNetParams.Params) {
+			return nil, fmt.Errorf("address is not for %s",
+				r.cfg.ActiveNetParams.Params.Name)
+		}
+
+		pkScript, err = txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	peer, err := r.server.FindPeer(channel.IdentityPub)
+	if err != nil {
+		return nil, fmt.Errorf("unable to splice channel while peer "+
+			"is offline: %w", err)
+	}
+
+	rpcsLog.Debugf("[splicechannel] request for ChannelPoint(%v), "+
+		"amount=%v, splice_out=%v, sat/kw=%v", chanPoint, in.Amount,
+		len(pkScript) > 0, int64(feeRate))
+
+	req := &chansplicer.Request{
+		ChanPoint: *chanPoint,
+		Amount:    btcutil.Amount(in.Amount),
+		PkScript:  pkScript,
+		FeeRate:   feeRate,
+		MinConfs:  minConfs,
+		Updates:   make(chan *wire.MsgTx, 1),
+		Err:       make(chan error, 1),
+	}
+	peer.HandleLocalSpliceReq(req)
+
+	var spliceTx *wire.MsgTx
+	select {
+	case spliceTx = <-req.Updates:
+	case err := <-req.Err:
+		rpcsLog.Errorf("[splicechannel] unable to splice "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	// The splice has been stored with the channel, which includes the new
+	// funding outpoint.
+	splice, err := channel.PendingSplice()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[splicechannel] splice of ChannelPoint(%v) "+
+		"broadcast: txid(%v)", chanPoint, spliceTx.TxHash())
+
+	newChanPoint := splice.FundingOutpoint
+
+	return &lnrpc.SpliceChannelResponse{
+		Txid: spliceTx.TxHash().String(),
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: newChanPoint.Hash[:],
+			},
+			OutputIndex: newChanPoint.Index,
+		},
+	}, nil
+}
+
+// AbortSplice aborts the pending splice of a channel by double spending the
+// wallet coins that we spliced in. The splice is aborted once the transaction
+// has reached a reorg safe depth.
+func (r *rpcServer) AbortSplice(_ context.Context,
+	in *lnrpc.AbortSpliceRequest) (*lnrpc.AbortSpliceResponse, error) {
+
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	if in.GetChannelPoint() == nil {
+		return nil, fmt.Errorf("must specify channel point in abort " +
+			"splice")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	channel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	splice, err := channel.PendingSplice()
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := lnrpc.CalculateFeeRate(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pkScript, err := newSweepPkScriptGen(r.server.cc.Wallet)()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[abortsplice] request for ChannelPoint(%v), "+
+		"splice_txid=%v, sat/kw=%v", chanPoint,
+		splice.FundingTxn.TxHash(), int64(feeRate))
+
+	abortTx, err := chansplicer.AbortTx(chansplicer.AbortConfig{
+		Splice:           splice,
+		SharedInputValue: channel.Capacity,
+		FetchCoin: func(op wire.OutPoint) (*chanfunding.Coin, error) {
+			if op == *chanPoint {
+				return nil, lnwallet.ErrNotMine
+			}
+
+			// The wallet returns its own error for outputs that
+			// don't belong to it.
+			utxo, err := r.server.cc.Wallet.FetchInputInfo(&op)
+			if errors.Is(err, wallet.ErrNotMine) {
+				return nil, lnwallet.ErrNotMine
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return &chanfunding.Coin{
+				TxOut: wire.TxOut{
+					Value:    int64(utxo.Value),
+					PkScript: utxo.PkScript,
+				},
+				OutPoint: op,
+			}, nil
+		},
+		Signer:   r.server.cc.Wallet.Cfg.Signer,
+		FeeRate:  feeRate,
+		PkScript: pkScript,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	label := labels.MakeLabel(labels.LabelTypeSpliceAbort, nil)
+	err = r.server.cc.Wallet.PublishTransaction(abortTx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[abortsplice] splice of ChannelPoint(%v) double "+
+		"spent: txid(%v)", chanPoint, abortTx.TxHash())
+
+	return &lnrpc.AbortSpliceResponse{
+		Txid: abortTx.TxHash().String(),
+	}, nil
+}
+
+// UpgradeChannel upgrades the commitment type or the constraints of an active
+// channel with a dynamic commitment upgrade. The call returns once the upgrade
+// has been applied or rejected by the remote peer. Upgrades to simple taproot
+// commitments need a new funding output, so they're done with a splice.
+func (r *rpcServer) UpgradeChannel(ctx context.Context,
+	in *lnrpc.UpgradeChannelRequest) (*lnrpc.UpgradeChannelResponse,
+	error) {
+
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	if in.GetChannelPoint() == nil {
+		return nil, fmt.Errorf("must specify channel point in " +
+			"upgrade channel")
+	}
+
+	if in.CommitmentType == lnrpc.CommitmentType_SIMPLE_TAPROOT {
+		return r.upgradeChannelToTaproot(ctx, in)
+	}
+
+	if !r.cfg.ProtocolOptions.DynamicCommitments() {
+		return nil, fmt.Errorf("dynamic commitments are not enabled, " +
+			"set protocol.dynamic-commitments to enable them")
+	}
+
+	if in.TargetConf != 0 || in.SatPerVbyte != 0 {
+		return nil, fmt.Errorf("a fee rate can only be set for "+
+			"upgrades to %v", lnrpc.CommitmentType_SIMPLE_TAPROOT)
+	}
+
+	if in.RemoteMaxHtlcs > math.MaxUint16 {
+		return nil, fmt.Errorf("remote max htlcs must be at most %v",
+			math.MaxUint16)
+	}
+
+	if in.RemoteCsvDelay > math.MaxUint16 {
+		return nil, fmt.Errorf("remote csv delay must be at most %v",
+			math.MaxUint16)
+	}
+
+	proposal := &lnwallet.UpgradeProposal{
+		DustLimit:   btcutil.Amount(in.DustLimitSat),
+		ChanReserve: btcutil.Amount(in.RemoteChanReserveSat),
+		MaxPendingAmount: lnwire.MilliSatoshi(
+			in.RemoteMaxValueInFlightMsat,
+		),
+		MaxAcceptedHtlcs: uint16(in.RemoteMaxHtlcs),
+		CsvDelay:         uint16(in.RemoteCsvDelay),
+	}
+
+	var commitType lnwallet.CommitmentType
+	switch in.CommitmentType {
+	case lnrpc.CommitmentType_UNKNOWN_COMMITMENT_TYPE:
+
+	case lnrpc.CommitmentType_LEGACY:
+		commitType = lnwallet.CommitmentTypeLegacy
+		proposal.CommitmentType = &commitType
+
+	case lnrpc.CommitmentType_STATIC_REMOTE_KEY:
+		commitType = lnwallet.CommitmentTypeTweakless
+		proposal.CommitmentType = &commitType
+
+	case lnrpc.CommitmentType_ANCHORS:
+		commitType = lnwallet.CommitmentTypeAnchorsZeroFeeHtlcTx
+		proposal.CommitmentType = &commitType
+
+	default:
+		return nil, fmt.Errorf("channels can't be upgraded to "+
+			"commitment type %v", in.CommitmentType)
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	link, err := r.server.htlcSwitch.GetLink(chanID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to upgrade inactive channel "+
+			"%v: %w", chanPoint, err)
+	}
+
+	rpcsLog.Debugf("[upgradechannel] request for ChannelPoint(%v), "+
+		"commitment_type=%v", chanPoint, in.CommitmentType)
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- link.UpgradeChannel(proposal)
+	}()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			rpcsLog.Errorf("[upgradechannel] unable to upgrade "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+			return nil, err
+		}
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	rpcsLog.Infof("[upgradechannel] ChannelPoint(%v) upgraded", chanPoint)
+
+	return &lnrpc.UpgradeChannelResponse{}, nil
+}
+
+// upgradeChannelToTaproot upgrades a channel to simple taproot commitments by
+// splicing it to a new taproot funding output. The call returns once the
+// splice transaction has been signed and broadcast. The channel is paused
+// until the splice transaction confirms.
+func (r *rpcServer) upgradeChannelToTaproot(ctx context.Context,
+	in *lnrpc.UpgradeChannelRequest) (*lnrpc.UpgradeChannelResponse,
+	error) {
+
+	if !r.cfg.ProtocolOptions.Splicing() {
+		return nil, fmt.Errorf("splicing is not enabled, set "+
+			"protocol.splicing to enable upgrades to %v",
+			lnrpc.CommitmentType_SIMPLE_TAPROOT)
+	}
+
+	// The constraints are upgraded with a dynamic commitment upgrade,
+	// which can't be combined with the splice.
+	if in.DustLimitSat != 0 || in.RemoteChanReserveSat != 0 ||
+		in.RemoteMaxValueInFlightMsat != 0 || in.RemoteMaxHtlcs != 0 ||
+		in.RemoteCsvDelay != 0 {
+
+		return nil, fmt.Errorf("the constraints of a channel can't be "+
+			"upgraded together with an upgrade to %v",
+			lnrpc.CommitmentType_SIMPLE_TAPROOT)
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	channel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := lnrpc.CalculateFeeRate(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := r.server.FindPeer(channel.IdentityPub)
+	if err != nil {
+		return nil, fmt.Errorf("unable to upgrade channel while peer "+
+			"is offline: %w", err)
+	}
+
+	rpcsLog.Debugf("[upgradechannel] request for ChannelPoint(%v), "+
+		"commitment_type=%v, sat/kw=%v", chanPoint, in.CommitmentType,
+		int64(feeRate))
+
+	commitType := lnwallet.CommitmentType(
+		lnwallet.CommitmentTypeSimpleTaproot,
+	)
+	req := &chansplicer.Request{
+		ChanPoint:      *chanPoint,
+		FeeRate:        feeRate,
+		CommitmentType: &commitType,
+		Updates:        make(chan *wire.MsgTx, 1),
+		Err:            make(chan error, 1),
+	}
+	peer.HandleLocalSpliceReq(req)
+
+	var spliceTx *wire.MsgTx
+	select {
+	case spliceTx = <-req.Updates:
+	case err := <-req.Err:
+		rpcsLog.Errorf("[upgradechannel] unable to upgrade "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	splice, err := channel.PendingSplice()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[upgradechannel] upgrade splice of ChannelPoint(%v) "+
+		"broadcast: txid(%v)", chanPoint, spliceTx.TxHash())
+
+	newChanPoint := splice.FundingOutpoint
+
+	return &lnrpc.UpgradeChannelResponse{
+		Txid: spliceTx.TxHash().String(),
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: newChanPoint.Hash[:],
+			},
+			OutputIndex: newChanPoint.Index,
+		},
+	}, nil
+}
+
 // AbandonChannel removes all channel state from the database except for a
 // close summary. This method can be used to get rid of permanently unusable
 // channels due to bugs fixed in newer versions of lnd.
@@ -4058,6 +4622,89 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
+// ClosedChannelReports returns a breakdown of the on-chain fees that we paid
+// and the funds that we recovered when our channels were closed.
+func (r *rpcServer) ClosedChannelReports(_ context.Context,
+	in *lnrpc.ClosedChannelReportsRequest) (
+	*lnrpc.ClosedChannelReportsResponse, error) {
+
+	var chanPoint *wire.OutPoint
+	if in.ChanPoint != "" {
+		op, err := wire.NewOutPointFromString(in.ChanPoint)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid chan_point: %v", err)
+		}
+		chanPoint = op
+	}
+
+	reports, err := r.server.closeReporter.ChannelReports(chanPoint)
+	switch {
+	case errors.Is(err, closereport.ErrChannelNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &lnrpc.ClosedChannelReportsResponse{
+		Reports: make([]*lnrpc.ClosedChannelReport, 0, len(reports)),
+	}
+	for _, report := range reports {
+		summary, err := r.createRPCClosedChannel(report.Summary)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcReport := &lnrpc.ClosedChannelReport{
+			Summary:           summary,
+			ClosingFeeSat:     int64(report.ClosingFee),
+			SecondLevelFeeSat: int64(report.SecondLevelFees),
+			SweepFeeSat:       int64(report.SweepFees),
+			AnchorFeeSat:      int64(report.AnchorFees),
+			TotalFeeSat:       int64(report.TotalFees()),
+			RecoveredSat:      int64(report.Recovered),
+		}
+
+		for _, tx := range report.Txs {
+			rpcReport.Transactions = append(
+				rpcReport.Transactions, &lnrpc.CloseTransaction{
+					Txid:          tx.TxHash.String(),
+					TxType:        rpcCloseTxType(tx.Type),
+					FeeSat:        int64(tx.Fee),
+					TotalFeeSat:   int64(tx.TotalFee),
+					ConfirmHeight: tx.ConfirmHeight,
+					Label:         tx.Label,
+				},
+			)
+		}
+
+		resp.Reports = append(resp.Reports, rpcReport)
+	}
+
+	return resp, nil
+}
+
+// rpcCloseTxType converts the type of a transaction of a channel close to its
+// rpc counterpart.
+func rpcCloseTxType(
+	txType closereport.TxType) lnrpc.CloseTransaction_TransactionType {
+
+	switch txType {
+	case closereport.TxTypeSecondLevel:
+		return lnrpc.CloseTransaction_SECOND_LEVEL
+
+	case closereport.TxTypeSweep:
+		return lnrpc.CloseTransaction_SWEEP
+
+	case closereport.TxTypeAnchorSweep:
+		return lnrpc.CloseTransaction_ANCHOR_SWEEP
+
+	default:
+		return lnrpc.CloseTransaction_CLOSING
+	}
+}
+
 // LookupHtlcResolution retrieves a final htlc resolution from the database. If
 // the htlc has no final resolution yet, a NotFound grpc status code is
 // returned.
@@ -5567,24 +6214,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
-	defaultDelta := r.cfg.Bitcoin.TimeLockDelta
-
-	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
-		AddInvoice:        r.server.invoices.AddInvoice,
-		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
-		ChainParams:       r.cfg.ActiveNetParams.Params,
-		NodeSigner:        r.server.nodeSigner,
-		DefaultCLTVExpiry: defaultDelta,
-		ChanDB:            r.server.chanStateDB,
-		Graph:             r.server.graphDB,
-		GenInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoice)
-		},
-		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
-		},
-		GetAlias: r.server.aliasMgr.GetPeerAlias,
-	}
+	addInvoiceCfg := r.server.newAddInvoiceConfig()
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
 	if err != nil {
@@ -5606,6 +6236,17 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Private:         invoice.Private,
 		RouteHints:      routeHints,
 		Amp:             invoice.IsAmp,
+		Blind:           invoice.IsBlinded,
+	}
+
+	if invoice.IsBlinded {
+		pathCfg, err := invoicesrpc.UnmarshalBlindedPathConfig(
+			invoice.BlindedPathConfig,
+		)
+		if err != nil {
+			return nil, err
+		}
+		addInvoiceData.BlindedPathCfg = pathCfg
 	}
 
 	if invoice.RPreimage != nil {
@@ -6027,7 +6668,7 @@ func marshalDBRoutingPolicy(
 
 	customRecords := marshalExtraOpaqueData(policy.ExtraOpaqueData)
 
-	return &lnrpc.RoutingPolicy{
+	rpcPolicy := &lnrpc.RoutingPolicy{
 		TimeLockDelta:    uint32(policy.TimeLockDelta),
 		MinHtlc:          int64(policy.MinHTLC),
 		MaxHtlcMsat:      uint64(policy.MaxHTLC),
@@ -6037,6 +6678,20 @@ func marshalDBRoutingPolicy(
 		LastUpdate:       uint32(policy.LastUpdate.Unix()),
 		CustomRecords:    customRecords,
 	}
+
+	// The inbound fee is left at zero if it can't be parsed.
+	inboundFee, err := policy.InboundFee()
+	if err != nil {
+		rpcsLog.Debugf("Unable to parse inbound fee of channel %v: %v",
+			policy.ChannelID, err)
+
+		return rpcPolicy
+	}
+
+	rpcPolicy.InboundFeeBaseMsat = inboundFee.Base
+	rpcPolicy.InboundFeeRateMilliMsat = inboundFee.Rate
+
+	return rpcPolicy
 }
 
 // GetNodeMetrics returns all available node metrics calculated from the
@@ -6572,6 +7227,45 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		)
 	}
 
+	// Attach the status filter if set.
+	for _, rpcStatus := range req.Statuses {
+		status, err := unmarshallPaymentStatus(rpcStatus)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Statuses = append(query.Statuses, status)
+	}
+
+	// Attach the destination filter if set.
+	if len(req.Destination) > 0 {
+		dest, err := route.NewVertexFromBytes(req.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination: %w", err)
+		}
+
+		query.Destination = &dest
+	}
+
+	// Attach the amount and fee filters. A zero value leaves the
+	// respective bound unset.
+	if req.MinAmtMsat != 0 && req.MaxAmtMsat != 0 &&
+		req.MinAmtMsat > req.MaxAmtMsat {
+
+		return nil, fmt.Errorf("min amount(%v) must not be above max "+
+			"amount(%v)", req.MinAmtMsat, req.MaxAmtMsat)
+	}
+	if req.MinFeeMsat != 0 && req.MaxFeeMsat != 0 &&
+		req.MinFeeMsat > req.MaxFeeMsat {
+
+		return nil, fmt.Errorf("min fee(%v) must not be above max "+
+			"fee(%v)", req.MinFeeMsat, req.MaxFeeMsat)
+	}
+	query.MinAmount = lnwire.MilliSatoshi(req.MinAmtMsat)
+	query.MaxAmount = lnwire.MilliSatoshi(req.MaxAmtMsat)
+	query.MinFee = lnwire.MilliSatoshi(req.MinFeeMsat)
+	query.MaxFee = lnwire.MilliSatoshi(req.MaxFeeMsat)
+
 	// If the maximum number of payments wasn't specified, then we'll
 	// default to return the maximal number of payments representable.
 	if req.MaxPayments == 0 {
@@ -6605,6 +7299,29 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 	return paymentsResp, nil
 }
 
+// unmarshallPaymentStatus converts an rpc payment status to the status of
+// the payment in the database.
+func unmarshallPaymentStatus(status lnrpc.Payment_PaymentStatus) (
+	channeldb.PaymentStatus, error) {
+
+	switch status {
+	case lnrpc.Payment_INITIATED:
+		return channeldb.StatusInitiated, nil
+
+	case lnrpc.Payment_IN_FLIGHT:
+		return channeldb.StatusInFlight, nil
+
+	case lnrpc.Payment_SUCCEEDED:
+		return channeldb.StatusSucceeded, nil
+
+	case lnrpc.Payment_FAILED:
+		return channeldb.StatusFailed, nil
+
+	default:
+		return 0, fmt.Errorf("unknown payment status %v", status)
+	}
+}
+
 // DeletePayment deletes a payment from the DB given its payment hash. If
 // failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
 // deleted.
@@ -6742,6 +7459,9 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		RouteHints:      routeHints,
 		PaymentAddr:     paymentAddr,
 		Features:        invoicesrpc.CreateRPCFeatures(payReq.Features),
+		BlindedPaths: invoicesrpc.CreateRPCBlindedPayments(
+			payReq.BlindedPaymentPaths,
+		),
 	}, nil
 }
 
@@ -6974,6 +7694,14 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		FeeRate: feeRateFixed,
 	}
 
+	// The inbound fee is only changed if it's set in the request.
+	if req.InboundFee != nil {
+		feeSchema.InboundFee = &models.InboundFee{
+			Base: req.InboundFee.BaseFeeMsat,
+			Rate: req.InboundFee.FeeRatePpm,
+		}
+	}
+
 	maxHtlc := lnwire.MilliSatoshi(req.MaxHtlcMsat)
 	var minHtlc *lnwire.MilliSatoshi
 	if req.MinHtlcMsatSpecified {
@@ -6990,9 +7718,9 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy "+
 		"base_fee=%v, rate_fixed=%v, time_lock_delta: %v, "+
-		"min_htlc=%v, max_htlc=%v, targets=%v",
+		"min_htlc=%v, max_htlc=%v, inbound_fee=%v, targets=%v",
 		req.BaseFeeMsat, feeRateFixed, req.TimeLockDelta,
-		minHtlc, maxHtlc,
+		minHtlc, maxHtlc, feeSchema.InboundFee,
 		spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the local channel
@@ -7161,6 +7889,110 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	return resp, nil
 }
 
+// ExportLedger streams a normalised ledger of the forwards, payments, invoices
+// and on-chain transactions of the node within the target time range, ordered
+// by their timestamp.
+func (r *rpcServer) ExportLedger(req *lnrpc.ExportLedgerRequest,
+	updateStream lnrpc.Lightning_ExportLedgerServer) error {
+
+	var unit accounting.Unit
+	switch req.Unit {
+	case lnrpc.LedgerUnit_MSAT:
+		unit = accounting.UnitMsat
+
+	case lnrpc.LedgerUnit_SAT:
+		unit = accounting.UnitSat
+
+	case lnrpc.LedgerUnit_BTC:
+		unit = accounting.UnitBTC
+
+	default:
+		return fmt.Errorf("unknown ledger unit: %v", req.Unit)
+	}
+
+	startTime := time.Unix(int64(req.StartTime), 0)
+
+	// If the end time wasn't specified, assume a default end time of now.
+	endTime := time.Now()
+	if req.EndTime != 0 {
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	// Make sure that all forwarding events are on disk before we query
+	// the forwarding log.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return fmt.Errorf("unable to flush forwarding events: %w", err)
+	}
+
+	// The entries are sent as soon as the ledger produces them, so the
+	// ledger is never held in memory as a whole.
+	sendEntry := func(entry *accounting.Entry) error {
+		rpcEntry := &lnrpc.LedgerEntry{
+			TimestampNs: uint64(entry.Timestamp.UnixNano()),
+			EntryType:   rpcLedgerEntryType(entry.Type),
+			AmountMsat:  entry.Amount,
+			FeeMsat:     uint64(entry.Fee),
+			Amount:      unit.Format(entry.Amount),
+			Fee:         unit.Format(int64(entry.Fee)),
+			ChanIdIn:    entry.IncomingChanID.ToUint64(),
+			ChanIdOut:   entry.OutgoingChanID.ToUint64(),
+			Label:       entry.Label,
+		}
+		if entry.TxHash != nil {
+			rpcEntry.Txid = entry.TxHash.String()
+		}
+		if entry.PaymentHash != nil {
+			rpcEntry.PaymentHash = entry.PaymentHash.String()
+		}
+
+		return updateStream.Send(rpcEntry)
+	}
+
+	err := r.server.ledger.ForEachEntry(
+		updateStream.Context(), startTime, endTime, sendEntry,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export ledger: %w", err)
+	}
+
+	return nil
+}
+
+// rpcLedgerEntryType converts the type of a ledger entry to its rpc
+// counterpart.
+func rpcLedgerEntryType(
+	entryType accounting.EntryType) lnrpc.LedgerEntry_EntryType {
+
+	switch entryType {
+	case accounting.EntryTypePayment:
+		return lnrpc.LedgerEntry_PAYMENT
+
+	case accounting.EntryTypeInvoice:
+		return lnrpc.LedgerEntry_INVOICE
+
+	case accounting.EntryTypeChannelOpen:
+		return lnrpc.LedgerEntry_CHANNEL_OPEN
+
+	case accounting.EntryTypeChannelClose:
+		return lnrpc.LedgerEntry_CHANNEL_CLOSE
+
+	case accounting.EntryTypeSweep:
+		return lnrpc.LedgerEntry_SWEEP
+
+	case accounting.EntryTypeJustice:
+		return lnrpc.LedgerEntry_JUSTICE
+
+	case accounting.EntryTypeOnChain:
+		return lnrpc.LedgerEntry_ONCHAIN
+
+	case accounting.EntryTypeChannelFee:
+		return lnrpc.LedgerEntry_CHANNEL_FEE
+
+	default:
+		return lnrpc.LedgerEntry_FORWARD
+	}
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -24,6 +24,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/go-errors/errors"
 	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/accounting"
 	"github.com/lightningnetwork/lnd/aliasmgr"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
@@ -32,11 +33,14 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/chanfitness"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/closereport"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/feepolicy"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/healthcheck"
 	"github.com/lightningnetwork/lnd/htlcswitch"
@@ -50,15 +54,20 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/rpcwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmessage"
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/pool"
+	"github.com/lightningnetwork/lnd/prober"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/localchans"
@@ -67,6 +76,7 @@ import (
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/trampoline"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
@@ -257,6 +267,15 @@ type server struct {
 
 	peerNotifier *peernotifier.PeerNotifier
 
+	// onionMessenger relays, sends and receives onion messages. It is nil
+	// if onion messages are disabled.
+	onionMessenger *onionmessage.Messenger
+
+	// offersManager creates and pays BOLT 12 offers, and replies to the
+	// invoice requests for our offers. It is nil if onion messages are
+	// disabled.
+	offersManager *offers.Manager
+
 	htlcNotifier *htlcswitch.HtlcNotifier
 
 	witnessBeacon contractcourt.WitnessBeacon
@@ -273,10 +292,28 @@ type server struct {
 
 	localChanMgr *localchans.Manager
 
+	// feePolicyMgr automatically updates the forwarding policies of our
+	// channels if enabled.
+	feePolicyMgr *feepolicy.Manager
+
+	// trampolineForwarder pays the recipients of trampoline payments on
+	// behalf of their payers if trampoline routing is enabled.
+	trampolineForwarder *trampoline.Forwarder
+
+	// prober runs the probe campaigns that warm up mission control.
+	prober *prober.Prober
+
 	utxoNursery *contractcourt.UtxoNursery
 
 	sweeper *sweep.UtxoSweeper
 
+	// closeReporter builds the reports of the on-chain fees and recovered
+	// funds of our closed channels.
+	closeReporter *closereport.Reporter
+
+	// ledger builds the accounting ledger of the node.
+	ledger *accounting.Ledger
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
@@ -541,6 +578,13 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NoOptionScidAlias:        !cfg.ProtocolOptions.ScidAlias(),
 		NoZeroConf:               !cfg.ProtocolOptions.ZeroConf(),
 		NoAnySegwit:              cfg.ProtocolOptions.NoAnySegwit(),
+		NoOnionMessages:          !cfg.ProtocolOptions.OnionMessages(),
+		NoDualFund:               !cfg.ProtocolOptions.DualFunding(),
+		NoSplice:                 !cfg.ProtocolOptions.Splicing(),
+		NoQuiescence:             !cfg.ProtocolOptions.Quiescence(),
+		NoDynamicCommitments:     !cfg.ProtocolOptions.DynamicCommitments(),
+		NoTrampoline:             !cfg.Trampoline.Active,
+		NoSimpleClose:            !cfg.ProtocolOptions.SimpleClose(),
 		CustomFeatures:           cfg.ProtocolOptions.ExperimentalProtocol.CustomFeatures(),
 		NoTaprootChans:           !cfg.ProtocolOptions.TaprootChans,
 	})
@@ -583,7 +627,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
-		sphinx: hop.NewOnionProcessor(sphinxRouter),
+		sphinx: hop.NewOnionProcessor(sphinxRouter, nodeKeyDesc.PubKey),
 
 		torController: torController,
 
@@ -930,10 +974,10 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	}
 
 	srvrLog.Debugf("Instantiating payment session source with config: "+
-		"AttemptCost=%v + %v%%, MinRouteProbability=%v",
+		"AttemptCost=%v + %v%%, MinRouteProbability=%v, Splitter=%v",
 		int64(routingConfig.AttemptCost),
 		float64(routingConfig.AttemptCostPPM)/10000,
-		routingConfig.MinRouteProbability)
+		routingConfig.MinRouteProbability, routingConfig.Splitter)
 
 	pathFindingConfig := routing.PathFindingConfig{
 		AttemptCost: lnwire.NewMSatFromSatoshis(
@@ -941,6 +985,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		),
 		AttemptCostPPM: routingConfig.AttemptCostPPM,
 		MinProbability: routingConfig.MinRouteProbability,
+		Splitter:       routingConfig.Splitter,
 	}
 
 	sourceNode, err := chanGraph.SourceNode()
@@ -953,6 +998,9 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		MissionControl:    s.missionControl,
 		GetLink:           s.htlcSwitch.GetLinkByShortID,
 		PathFindingConfig: pathFindingConfig,
+
+		PathFindingTraces: dbs.ChanStateDB,
+		Clock:             clock.NewDefaultClock(),
 	}
 
 	paymentControl := channeldb.NewPaymentControl(dbs.ChanStateDB)
@@ -980,6 +1028,9 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		Clock:               clock.NewDefaultClock(),
 		StrictZombiePruning: strictPruning,
 		IsAlias:             aliasmgr.IsAlias,
+
+		PathFindingTraces:         dbs.ChanStateDB,
+		PathFindingTraceRetention: routingConfig.TraceRetention,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
@@ -1039,6 +1090,76 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FetchChannel:              s.chanStateDB.FetchChannel,
 	}
 
+	if cfg.FeePolicy.Active {
+		strategy, err := feepolicy.NewStrategy(
+			cfg.FeePolicy.Strategy, feepolicy.StrategyConfig{
+				BaseFee: lnwire.MilliSatoshi(
+					cfg.FeePolicy.BaseFee,
+				),
+				MinFeeRate: cfg.FeePolicy.MinFeeRate,
+				MaxFeeRate: cfg.FeePolicy.MaxFeeRate,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		router := s.chanRouter
+		s.feePolicyMgr = feepolicy.NewManager(&feepolicy.Config{
+			Strategy:               strategy,
+			ForwardingWindow:       cfg.FeePolicy.ForwardingWindow,
+			MinUpdateInterval:      cfg.FeePolicy.MinUpdateInterval,
+			MaxUpdates:             cfg.FeePolicy.MaxUpdates,
+			MinChange:              cfg.FeePolicy.MinChange,
+			ForAllOutgoingChannels: router.ForAllOutgoingChannels,
+			FetchChannel:           s.chanStateDB.FetchChannel,
+			QueryForwardingLog:     s.miscDB.ForwardingLog().Query,
+			SubscribeHtlcEvents: func() (subscribe.Subscription,
+				error) {
+
+				return s.htlcNotifier.SubscribeHtlcEvents()
+			},
+			UpdatePolicy: s.localChanMgr.UpdatePolicy,
+			Ticker:       ticker.New(cfg.FeePolicy.Interval),
+			Clock:        clock.NewDefaultClock(),
+		})
+	}
+
+	if cfg.Trampoline.Active {
+		router := s.chanRouter
+		sendPayment := func(payment *routing.LightningPayment) error {
+			session, shards, err := router.PreparePayment(payment)
+			if err != nil {
+				return err
+			}
+
+			return router.SendPaymentAsync(payment, session, shards)
+		}
+
+		trampolineCfg := &trampoline.Config{
+			NodeKey: nodeKeyECDH,
+			BaseFee: lnwire.MilliSatoshi(
+				cfg.Trampoline.BaseFee,
+			),
+			FeeRate:           cfg.Trampoline.FeeRate,
+			CltvDelta:         cfg.Trampoline.CltvDelta,
+			MppTimeout:        cfg.Trampoline.MppTimeout,
+			MaxParts:          cfg.Trampoline.MaxParts,
+			PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+			SendPayment:       sendPayment,
+			SubscribePayment:  s.controlTower.SubscribePayment,
+			Clock:             clock.NewDefaultClock(),
+		}
+		s.trampolineForwarder = trampoline.NewForwarder(trampolineCfg)
+	}
+
+	s.prober = prober.New(&prober.Config{
+		SendProbe: s.sendProbe,
+		Clock:     clock.NewDefaultClock(),
+		Store:     dbs.ChanStateDB,
+		Retention: routingConfig.ProbeCampaignRetention,
+	})
+
 	utxnStore, err := contractcourt.NewNurseryStore(
 		s.cfg.ActiveNetParams.GenesisHash, dbs.ChanStateDB,
 	)
@@ -1076,6 +1197,50 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
 	})
 
+	s.closeReporter = closereport.NewReporter(&closereport.Config{
+		ChainHash:              *s.cfg.ActiveNetParams.GenesisHash,
+		FetchClosedChannels:    s.chanStateDB.FetchClosedChannels,
+		FetchHistoricalChannel: s.chanStateDB.FetchHistoricalChannel,
+		FetchChannelReports:    s.miscDB.FetchChannelReports,
+		ListSweepRecords:       s.sweeper.ListSweepRecords,
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return cc.Wallet.ListTransactionDetails(
+				0, btcwallet.UnconfirmedHeight, "",
+			)
+		},
+	})
+
+	s.ledger = accounting.NewLedger(&accounting.Config{
+		QueryForwardingLog: s.miscDB.ForwardingLog().Query,
+		QueryPayments:      s.miscDB.QueryPayments,
+		QueryInvoices:      s.miscDB.QueryInvoices,
+		ListTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return cc.Wallet.ListTransactionDetails(
+				0, btcwallet.UnconfirmedHeight, "",
+			)
+		},
+		ChannelReports: func() ([]*closereport.ChannelReport, error) {
+			return s.closeReporter.ChannelReports(nil)
+		},
+		BlockTimestamp: func(height int32) (time.Time, error) {
+			hash, err := cc.ChainIO.GetBlockHash(int64(height))
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			block, err := cc.ChainIO.GetBlock(hash)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			return block.Header.Timestamp, nil
+		},
+	})
+
 	s.utxoNursery = contractcourt.NewUtxoNursery(&contractcourt.NurseryConfig{
 		ChainIO:             cc.ChainIO,
 		ConfDepth:           1,
@@ -1212,9 +1377,26 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		Registry:                      s.invoices,
 		NotifyClosedChannel:           s.channelNotifier.NotifyClosedChannelEvent,
 		NotifyFullyResolvedChannel:    s.channelNotifier.NotifyFullyResolvedChannelEvent,
+		NotifySplicedChannel:          s.handleSplicedChannel,
+		NotifySpliceAborted:           s.handleAbortedSplice,
+		DrainSplicedChannel:           s.drainSplicedChannel,
 		OnionProcessor:                s.sphinx,
 		PaymentsExpirationGracePeriod: cfg.PaymentsExpirationGracePeriod,
 		IsForwardedHTLC:               s.htlcSwitch.IsForwardedHTLC,
+		QueryIncomingCircuit: func(
+			circuit models.CircuitKey) *models.CircuitKey {
+
+			// Get the circuit map.
+			circuits := s.htlcSwitch.CircuitLookup()
+
+			// Lookup the outgoing circuit.
+			pc := circuits.LookupOpenCircuit(circuit)
+			if pc == nil {
+				return nil
+			}
+
+			return &pc.Incoming
+		},
 		Clock:                         clock.NewDefaultClock(),
 		SubscribeBreachComplete:       s.breachArbiter.SubscribeBreachComplete,
 		PutFinalHtlcOutcome:           s.chanStateDB.PutOnchainFinalHtlcOutcome, //nolint: lll
@@ -1481,6 +1663,36 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	// to peer online and offline events.
 	s.peerNotifier = peernotifier.New()
 
+	// If onion messages are enabled, create the messenger that relays
+	// them for our peers and dispatches the ones addressed to us.
+	if cfg.ProtocolOptions.OnionMessages() {
+		s.onionMessenger = onionmessage.New(&onionmessage.Config{
+			NodeKey:          nodeKeyECDH,
+			ChainParams:      cfg.ActiveNetParams.Params,
+			SendMessage:      s.sendOnionMessage,
+			FetchChannelPeer: s.fetchChannelPeer,
+			MessageRate:      onionmessage.DefaultMessageRate,
+			MessageBurst:     onionmessage.DefaultMessageBurst,
+		})
+
+		s.offersManager, err = offers.New(&offers.Config{
+			NodeKey:            nodeKeyECDH,
+			Signer:             cc.KeyRing,
+			NodeKeyLoc:         nodeKeyDesc.KeyLocator,
+			ChainParams:        cfg.ActiveNetParams.Params,
+			Messenger:          s.onionMessenger,
+			FindPath:           s.findOnionMessagePath,
+			AddInvoice:         s.addOfferInvoice,
+			IsInvoicePending:   s.isOfferInvoicePending,
+			MaxPendingInvoices: offers.DefaultMaxPendingInvoices,
+			SendPayment:        s.payBlindedPaths,
+			Clock:              clock.NewDefaultClock(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create a channel event store which monitors all open channels.
 	s.chanEventStore = chanfitness.NewChannelEventStore(&chanfitness.Config{
 		SubscribeChannelEvents: func() (subscribe.Subscription, error) {
@@ -1914,6 +2126,21 @@ func (s *server) Start() error {
 		cleanup = cleanup.add(func() error {
 			return s.peerNotifier.Stop()
 		})
+
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.onionMessenger.Stop)
+
+			if err := s.offersManager.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.offersManager.Stop)
+		}
+
 		if err := s.htlcNotifier.Start(); err != nil {
 			startErr = err
 			return
@@ -2019,6 +2246,28 @@ func (s *server) Start() error {
 			return nil
 		})
 
+		if s.feePolicyMgr != nil {
+			if err := s.feePolicyMgr.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.feePolicyMgr.Stop)
+		}
+
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.trampolineForwarder.Stop)
+		}
+
+		if err := s.prober.Start(); err != nil {
+			startErr = err
+			return
+		}
+		cleanup = cleanup.add(s.prober.Stop)
+
 		s.missionControl.RunStoreTicker()
 		cleanup.add(func() error {
 			s.missionControl.StopStoreTicker()
@@ -2273,6 +2522,18 @@ func (s *server) Stop() error {
 		if err := s.peerNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop peerNotifier: %v", err)
 		}
+		if s.offersManager != nil {
+			if err := s.offersManager.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop offersManager: "+
+					"%v", err)
+			}
+		}
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop onionMessenger: "+
+					"%v", err)
+			}
+		}
 		if err := s.htlcNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcNotifier: %v", err)
 		}
@@ -2283,6 +2544,21 @@ func (s *server) Stop() error {
 			srvrLog.Warnf("Unable to stop ChainNotifier: %v", err)
 		}
 		s.chanEventStore.Stop()
+		if s.feePolicyMgr != nil {
+			if err := s.feePolicyMgr.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop fee policy "+
+					"manager: %v", err)
+			}
+		}
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Stop(); err != nil {
+				srvrLog.Warnf("Unable to stop trampoline "+
+					"forwarder: %v", err)
+			}
+		}
+		if err := s.prober.Stop(); err != nil {
+			srvrLog.Warnf("Unable to stop prober: %v", err)
+		}
 		s.missionControl.StopStoreTicker()
 
 		// Disconnect from each active peers to ensure that
@@ -3390,6 +3666,112 @@ func (s *server) NotifyWhenOffline(peerPubKey [33]byte) <-chan struct{} {
 	return c
 }
 
+// handleSplicedChannel is called by the ChainArbitrator once the splice of a
+// channel has confirmed. The spliced channel is removed from the peer, and
+// the funding manager takes over the new channel until it's announced with
+// its new short channel ID. The forwarding policy of the spliced channel is
+// carried over to the new channel.
+func (s *server) handleSplicedChannel(oldChanPoint wire.OutPoint,
+	newChan *channeldb.OpenChannel) {
+
+	var forwardingPolicy *models.ForwardingPolicy
+	info, p1, p2, err := s.graphDB.FetchChannelEdgesByOutpoint(
+		&oldChanPoint,
+	)
+	if err == nil {
+		var ourKey [33]byte
+		copy(ourKey[:], s.identityECDH.PubKey().SerializeCompressed())
+
+		ourPolicy := p2
+		if info.NodeKey1Bytes == ourKey {
+			ourPolicy = p1
+		}
+
+		if ourPolicy != nil {
+			// A malformed inbound fee record is treated as no
+			// inbound fee.
+			inboundFee, _ := ourPolicy.InboundFee()
+
+			feeRate := ourPolicy.FeeProportionalMillionths
+			forwardingPolicy = &models.ForwardingPolicy{
+				MinHTLCOut:    ourPolicy.MinHTLC,
+				MaxHTLC:       ourPolicy.MaxHTLC,
+				BaseFee:       ourPolicy.FeeBaseMSat,
+				FeeRate:       feeRate,
+				TimeLockDelta: uint32(ourPolicy.TimeLockDelta),
+				InboundFee:    inboundFee,
+			}
+		}
+	}
+
+	newChanID := lnwire.NewChanIDFromOutPoint(&newChan.FundingOutpoint)
+	if peer, err := s.FindPeer(newChan.IdentityPub); err == nil {
+		peer.WipeChannel(&oldChanPoint)
+
+		err := peer.AddPendingChannel(newChanID, s.quit)
+		if err != nil {
+			srvrLog.Errorf("Unable to add spliced channel %v to "+
+				"peer: %v", newChan.FundingOutpoint, err)
+		}
+	}
+
+	err = s.fundingMgr.HandleSplicedChannel(newChan, forwardingPolicy)
+	if err != nil {
+		srvrLog.Errorf("Unable to handle spliced channel %v: %v",
+			newChan.FundingOutpoint, err)
+	}
+}
+
+// handleAbortedSplice is called by the ChainArbitrator once the pending splice
+// of a channel has been aborted. The link of the channel still signs
+// commitments for the funding output of the splice, so we disconnect the peer
+// to reload the channel on reconnect.
+func (s *server) handleAbortedSplice(chanPoint wire.OutPoint,
+	peerKey *btcec.PublicKey) {
+
+	peer, err := s.FindPeer(peerKey)
+	if err != nil {
+		return
+	}
+
+	peer.Disconnect(fmt.Errorf("splice of ChannelPoint(%v) aborted",
+		chanPoint))
+}
+
+// drainSplicedChannel is called by the ChainArbitrator once the splice
+// transaction of a channel has reached a reorg safe depth. If the peer is
+// online, its link is drained. Otherwise, the splice can only be completed if
+// the channel has no HTLCs left, as they are resolved once the peer
+// reconnects.
+func (s *server) drainSplicedChannel(chanPoint wire.OutPoint,
+	peerKey *btcec.PublicKey) error {
+
+	if p, err := s.FindPeer(peerKey); err == nil {
+		err := p.DrainSplicedChannel(chanPoint)
+		if !errors.Is(err, peer.ErrChannelNotFound) {
+			return err
+		}
+	}
+
+	dbChan, err := s.chanStateDB.FetchChannel(nil, chanPoint)
+	if err != nil {
+		return err
+	}
+
+	lnChan, err := lnwallet.NewLightningChannel(
+		s.cc.Wallet.Cfg.Signer, dbChan, nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !lnChan.IsChannelClean() {
+		return contractcourt.ErrSpliceNotDrained
+	}
+
+	return nil
+}
+
 // FindPeer will return the peer that corresponds to the passed in public key.
 // This function is used by the funding manager, allowing it to update the
 // daemon's local representation of the remote peer.
@@ -3758,6 +4140,55 @@ func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
 	return s.customMessageServer.Subscribe()
 }
 
+// handleOnionMessage hands an onion message that was received from a peer to
+// the onion messenger.
+func (s *server) handleOnionMessage(peer [33]byte, msg *lnwire.OnionMessage) {
+	s.onionMessenger.HandleMessage(route.Vertex(peer), msg)
+}
+
+// sendOnionMessage sends an onion message to one of our peers, provided that
+// the peer supports onion messages.
+func (s *server) sendOnionMessage(peerPub route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	if !peer.RemoteFeatures().HasFeature(lnwire.OnionMessagesOptional) {
+		return fmt.Errorf("peer %v doesn't support onion messages",
+			peerPub)
+	}
+
+	// Onion messages are sent as low-priority, so that they don't delay
+	// any channel updates.
+	return peer.SendMessageLazy(false, msg)
+}
+
+// fetchChannelPeer returns the node on the other end of one of our channels.
+func (s *server) fetchChannelPeer(
+	scid lnwire.ShortChannelID) (route.Vertex, error) {
+
+	info, _, _, err := s.graphDB.FetchChannelEdgesByID(scid.ToUint64())
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	ourKey := route.NewVertex(s.identityECDH.PubKey())
+	switch ourKey {
+	case info.NodeKey1Bytes:
+		return info.NodeKey2Bytes, nil
+
+	case info.NodeKey2Bytes:
+		return info.NodeKey1Bytes, nil
+
+	default:
+		return route.Vertex{}, fmt.Errorf("channel %v is not ours",
+			scid)
+	}
+}
+
 // peerConnected is a function that handles initialization a newly connected
 // peer by adding it to the server's global list of all active peers, and
 // starting all the goroutines the peer needs to function properly. The inbound
@@ -3796,6 +4227,13 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		}
 	}
 
+	// The trampoline handler is only set if trampoline routing is enabled,
+	// so that the links reject trampoline htlcs otherwise.
+	var trampolineHandler htlcswitch.TrampolineHandler
+	if s.trampolineForwarder != nil {
+		trampolineHandler = s.trampolineForwarder
+	}
+
 	// Now that we've established a connection, create a peer, and it to the
 	// set of currently active peers. Configure the peer with the incoming
 	// and outgoing broadcast deltas to prevent htlcs from being accepted or
@@ -3831,6 +4269,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		Sphinx:                  s.sphinx,
 		WitnessBeacon:           s.witnessBeacon,
 		Invoices:                s.invoices,
+		Trampoline:              trampolineHandler,
 		ChannelNotifier:         s.channelNotifier,
 		HtlcNotifier:            s.htlcNotifier,
 		TowerClient:             s.towerClient,
@@ -3864,12 +4303,19 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		GetAliases:             s.aliasMgr.GetAliases,
 		RequestAlias:           s.aliasMgr.RequestAlias,
 		AddLocalAlias:          s.aliasMgr.AddLocalAlias,
+		MaxChanSize:            btcutil.Amount(s.cfg.MaxChanSize),
+		MaxLocalCSVDelay:       s.cfg.Bitcoin.MaxLocalDelay,
 		Quit:                   s.quit,
 	}
 
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
+	// Only handle onion messages if we signaled support for them.
+	if s.onionMessenger != nil {
+		pCfg.HandleOnionMessage = s.handleOnionMessage
+	}
+
 	p := peer.NewBrontide(pCfg)
 
 	// TODO(roasbeef): update IP address for link-node
@@ -4330,6 +4776,10 @@ func (s *server) removePeer(p *peer.Brontide) {
 	copy(pubKey[:], pubSer)
 
 	s.peerNotifier.NotifyPeerOffline(pubKey)
+
+	if s.onionMessenger != nil {
+		s.onionMessenger.RemovePeer(route.Vertex(pubKey))
+	}
 }
 
 // ConnectToPeer requests that the server connect to a Lightning Network peer
@@ -4710,3 +5160,48 @@ func shouldPeerBootstrap(cfg *Config) bool {
 	// covering the bootstrapping process.
 	return !cfg.NoNetBootstrap && !isDevNetwork
 }
+
+// sendProbe sends a payment with a random hash to the target of the probe and
+// waits for its result. The payment can't be settled, so it fails with
+// incorrect payment details once it reaches the target. Mission control learns
+// from the attempts of the payment, which is removed from the payments
+// database afterwards.
+func (s *server) sendProbe(req *prober.ProbeRequest) error {
+	var hash lntypes.Hash
+	if _, err := rand.Read(hash[:]); err != nil {
+		return err
+	}
+
+	payment := &routing.LightningPayment{
+		Target:            req.Target,
+		Amount:            req.Amount,
+		FeeLimit:          req.FeeLimit,
+		CltvLimit:         s.cfg.MaxOutgoingCltvExpiry,
+		FinalCLTVDelta:    uint16(s.cfg.Bitcoin.TimeLockDelta),
+		PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+		MaxParts:          1,
+	}
+	if req.OutgoingChan != 0 {
+		payment.OutgoingChannelIDs = []uint64{req.OutgoingChan}
+	}
+
+	if err := payment.SetPaymentHash(hash); err != nil {
+		return err
+	}
+
+	_, _, err := s.chanRouter.SendPayment(payment)
+
+	// The payment doesn't exist if the probe was rejected before it was
+	// initiated.
+	if delErr := s.miscDB.DeletePayment(hash, false); delErr != nil {
+		srvrLog.Debugf("Unable to delete probe %v: %v", hash, delErr)
+	}
+
+	if err == nil ||
+		errors.Is(err, channeldb.FailureReasonPaymentDetails) {
+
+		return nil
+	}
+
+	return err
+}
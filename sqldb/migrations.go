@@ -15,9 +15,10 @@ import (
 
 // applyMigrations executes all database migration files found in the given file
 // system under the given path, using the passed database driver and database
-// name.
+// name. The migrations with the given skipped versions are marked as applied
+// without being executed.
 func applyMigrations(fs fs.FS, driver database.Driver, path,
-	dbName string) error {
+	dbName string, skippedVersions ...uint) error {
 
 	// With the migrate instance open, we'll create a new migration source
 	// using the embedded file system stored in sqlSchemas. The library
@@ -37,6 +38,13 @@ func applyMigrations(fs fs.FS, driver database.Driver, path,
 	if err != nil {
 		return err
 	}
+	for _, version := range skippedVersions {
+		err := skipMigration(sqlMigrate, version)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = sqlMigrate.Up()
 	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		return err
@@ -45,6 +53,37 @@ func applyMigrations(fs fs.FS, driver database.Driver, path,
 	return nil
 }
 
+// skipMigration applies all migrations before the given version and then
+// marks the migration with the given version as applied without executing it.
+// Nothing is done if the database is already past that version.
+func skipMigration(sqlMigrate *migrate.Migrate, version uint) error {
+	current, dirty, err := sqlMigrate.Version()
+	switch {
+	case errors.Is(err, migrate.ErrNilVersion):
+		current, dirty = 0, false
+
+	case err != nil:
+		return err
+	}
+
+	// A failed attempt to execute the skipped migration leaves the
+	// database dirty at its version, so we only need to fix its version.
+	if current > version || (current == version && !dirty) {
+		return nil
+	}
+
+	if current < version-1 {
+		err := sqlMigrate.Migrate(version - 1)
+		if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return err
+		}
+	}
+
+	log.Infof("Skipping migration %d", version)
+
+	return sqlMigrate.Force(int(version))
+}
+
 // replacerFS is an implementation of a fs.FS virtual file system that wraps an
 // existing file system but does a search-and-replace operation on each file
 // when it is opened.
@@ -1,15 +1,18 @@
 package sqldb
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"testing"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	postgres_migrate "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file" // Read migrations from files. // nolint:lll
+	_ "github.com/jackc/pgx/v4/stdlib"                   // Register the pgx driver.
 	"github.com/lightningnetwork/lnd/sqldb/sqlc"
-	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -23,24 +26,58 @@ var (
 	// fully executed yet. So this time needs to be chosen correctly to be
 	// longer than the longest expected individual test run time.
 	DefaultPostgresFixtureLifetime = 10 * time.Minute
+
+	// postgresSchemaReplacements is a map of schema strings that need to be
+	// replaced for postgres. This is needed because we write the schemas
+	// to work with sqlite primarily, and postgres has some differences.
+	postgresSchemaReplacements = map[string]string{
+		"BLOB":                "BYTEA",
+		"INTEGER PRIMARY KEY": "SERIAL PRIMARY KEY",
+		"BIGINT PRIMARY KEY":  "BIGSERIAL PRIMARY KEY",
+		"TIMESTAMP":           "TIMESTAMP WITHOUT TIME ZONE",
+	}
+
+	// postgresSkippedMigrations are the versions of the migrations that
+	// are never applied to postgres databases. The invoice events
+	// migration uses string literals and a foreign key that only sqlite
+	// accepts, so it could never be applied to postgres. The tables it
+	// defines are created by the invoice store migration instead.
+	postgresSkippedMigrations = []uint{3}
 )
 
 // PostgresConfig holds the postgres database configuration.
 //
 //nolint:lll
 type PostgresConfig struct {
-	SkipMigrations     bool   `long:"skipmigrations" description:"Skip applying migrations on startup."`
-	Host               string `long:"host" description:"Database server hostname."`
-	Port               int    `long:"port" description:"Database server port."`
-	User               string `long:"user" description:"Database user."`
-	Password           string `long:"password" description:"Database user's password."`
-	DBName             string `long:"dbname" description:"Database name to use."`
-	MaxOpenConnections int    `long:"maxconnections" description:"Max open connections to keep alive to the database server."`
-	RequireSSL         bool   `long:"requiressl" description:"Whether to require using SSL (mode: require) when connecting to the server."`
+	SkipMigrations     bool          `long:"skipmigrations" description:"Skip applying migrations on startup."`
+	Host               string        `long:"host" description:"Database server hostname."`
+	Port               int           `long:"port" description:"Database server port."`
+	User               string        `long:"user" description:"Database user."`
+	Password           string        `long:"password" description:"Database user's password."`
+	DBName             string        `long:"dbname" description:"Database name to use."`
+	MaxOpenConnections int           `long:"maxconnections" description:"Max open connections to keep alive to the database server."`
+	RequireSSL         bool          `long:"requiressl" description:"Whether to require using SSL (mode: require) when connecting to the server."`
+	Dsn                string        `long:"dsn" description:"Database connection string. If set, it is used instead of the individual connection options."`
+	Timeout            time.Duration `long:"timeout" description:"Database connection timeout. Set to zero to disable."`
 }
 
 // DSN returns the dns to connect to the database.
 func (s *PostgresConfig) DSN(hidePassword bool) string {
+	if s.Dsn != "" {
+		if !hidePassword {
+			return s.Dsn
+		}
+
+		sanitizedDSN, err := replacePasswordInDSN(s.Dsn)
+		if err != nil {
+			// Don't risk logging the password of a DSN we
+			// can't parse.
+			return "****"
+		}
+
+		return sanitizedDSN
+	}
+
 	var sslMode = "disable"
 	if s.RequireSSL {
 		sslMode = "require"
@@ -56,6 +93,56 @@ func (s *PostgresConfig) DSN(hidePassword bool) string {
 		s.DBName, sslMode)
 }
 
+// databaseName returns the name of the database to connect to.
+func (s *PostgresConfig) databaseName() (string, error) {
+	if s.Dsn != "" {
+		return getDatabaseNameFromDSN(s.Dsn)
+	}
+
+	return s.DBName, nil
+}
+
+// replacePasswordInDSN takes a DSN string and returns it with the password
+// replaced by "***".
+func replacePasswordInDSN(dsn string) (string, error) {
+	// Parse the DSN as a URL.
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if the URL has a user info part.
+	if u.User != nil {
+		username := u.User.Username()
+
+		// Reconstruct user info with "***" as password.
+		userInfo := username + ":***@"
+
+		// Rebuild the DSN with the modified user info.
+		sanitizeDSN := strings.Replace(
+			dsn, u.User.String()+"@", userInfo, 1,
+		)
+
+		return sanitizeDSN, nil
+	}
+
+	// Return the original DSN if no user info is present.
+	return dsn, nil
+}
+
+// getDatabaseNameFromDSN extracts the database name from a DSN string.
+func getDatabaseNameFromDSN(dsn string) (string, error) {
+	// Parse the DSN as a URL.
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", err
+	}
+
+	// The database name is the last segment of the path. Trim leading
+	// slash and return the last segment.
+	return path.Base(u.Path), nil
+}
+
 // PostgresStore is a database store implementation that uses a Postgres
 // backend.
 type PostgresStore struct {
@@ -69,11 +156,32 @@ type PostgresStore struct {
 func NewPostgresStore(cfg *PostgresConfig) (*PostgresStore, error) {
 	log.Infof("Using SQL database '%s'", cfg.DSN(true))
 
+	dbName, err := cfg.databaseName()
+	if err != nil {
+		return nil, err
+	}
+
 	rawDB, err := sql.Open("pgx", cfg.DSN(false))
 	if err != nil {
 		return nil, err
 	}
 
+	// Make sure the database can be reached within the configured
+	// timeout, so we don't hang on an unreachable server.
+	if cfg.Timeout > 0 {
+		ctx, cancel := context.WithTimeout(
+			context.Background(), cfg.Timeout,
+		)
+		err = rawDB.PingContext(ctx)
+		cancel()
+		if err != nil {
+			_ = rawDB.Close()
+
+			return nil, fmt.Errorf("unable to connect to "+
+				"database: %w", err)
+		}
+	}
+
 	maxConns := defaultMaxConns
 	if cfg.MaxOpenConnections > 0 {
 		maxConns = cfg.MaxOpenConnections
@@ -97,14 +205,13 @@ func NewPostgresStore(cfg *PostgresConfig) (*PostgresStore, error) {
 			return nil, err
 		}
 
-		postgresFS := newReplacerFS(sqlSchemas, map[string]string{
-			"BLOB":                "BYTEA",
-			"INTEGER PRIMARY KEY": "SERIAL PRIMARY KEY",
-			"TIMESTAMP":           "TIMESTAMP WITHOUT TIME ZONE",
-		})
+		postgresFS := newReplacerFS(
+			sqlSchemas, postgresSchemaReplacements,
+		)
 
 		err = applyMigrations(
-			postgresFS, driver, "sqlc/migrations", cfg.DBName,
+			postgresFS, driver, "sqlc/migrations", dbName,
+			postgresSkippedMigrations...,
 		)
 		if err != nil {
 			return nil, err
@@ -121,21 +228,3 @@ func NewPostgresStore(cfg *PostgresConfig) (*PostgresStore, error) {
 		},
 	}, nil
 }
-
-// NewTestPostgresDB is a helper function that creates a Postgres database for
-// testing.
-func NewTestPostgresDB(t *testing.T) *PostgresStore {
-	t.Helper()
-
-	t.Logf("Creating new Postgres DB for testing")
-
-	sqlFixture := NewTestPgFixture(t, DefaultPostgresFixtureLifetime)
-	store, err := NewPostgresStore(sqlFixture.GetConfig())
-	require.NoError(t, err)
-
-	t.Cleanup(func() {
-		sqlFixture.TearDown(t)
-	})
-
-	return store
-}
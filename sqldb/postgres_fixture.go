@@ -138,3 +138,21 @@ func (f *TestPgFixture) ClearDB(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+// NewTestPostgresDB is a helper function that creates a Postgres database for
+// testing.
+func NewTestPostgresDB(t *testing.T) *PostgresStore {
+	t.Helper()
+
+	t.Logf("Creating new Postgres DB for testing")
+
+	sqlFixture := NewTestPgFixture(t, DefaultPostgresFixtureLifetime)
+	store, err := NewPostgresStore(sqlFixture.GetConfig())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		sqlFixture.TearDown(t)
+	})
+
+	return store
+}
@@ -0,0 +1,178 @@
+-- graph_nodes holds the vertices of the channel graph. Nodes for which we
+-- haven't received a node announcement yet (shell nodes) only have their
+-- public key set.
+CREATE TABLE IF NOT EXISTS graph_nodes (
+    -- The id of the node.
+    id BIGINT PRIMARY KEY,
+
+    -- The 33 byte compressed identity public key of the node.
+    pub_key BLOB NOT NULL UNIQUE,
+
+    -- Whether we have received a node announcement for this node. If not, all
+    -- the announcement specific fields below are NULL.
+    have_announcement BOOLEAN NOT NULL,
+
+    -- The unix timestamp of the last node announcement received for this
+    -- node, or zero if we never received one.
+    last_update BIGINT NOT NULL,
+
+    -- The alias of the node.
+    alias TEXT,
+
+    -- The color of the node, serialized as its three RGB bytes.
+    color BLOB,
+
+    -- The signature of the last node announcement.
+    signature BLOB,
+
+    -- Any extra opaque data that was included in the node announcement.
+    extra_opaque_data BLOB
+);
+
+CREATE INDEX IF NOT EXISTS graph_nodes_last_update_idx ON graph_nodes(last_update);
+
+-- graph_node_features holds the feature bits advertised by a node.
+CREATE TABLE IF NOT EXISTS graph_node_features (
+    -- The node this feature belongs to.
+    node_id BIGINT NOT NULL REFERENCES graph_nodes(id) ON DELETE CASCADE,
+
+    -- The feature bit that is set.
+    feature_bit INTEGER NOT NULL,
+
+    UNIQUE (node_id, feature_bit)
+);
+
+-- graph_node_addresses holds the network addresses advertised by a node. The
+-- addresses are serialized using the same encoding as the key-value graph.
+CREATE TABLE IF NOT EXISTS graph_node_addresses (
+    -- The node this address belongs to.
+    node_id BIGINT NOT NULL REFERENCES graph_nodes(id) ON DELETE CASCADE,
+
+    -- The position of the address within the node announcement, used to
+    -- return the addresses in the advertised order.
+    position INTEGER NOT NULL,
+
+    -- The serialized address.
+    address BLOB NOT NULL,
+
+    UNIQUE (node_id, position)
+);
+
+-- graph_source_nodes holds a reference to the node that represents the local
+-- node. There's at most a single entry in this table.
+CREATE TABLE IF NOT EXISTS graph_source_nodes (
+    node_id BIGINT NOT NULL UNIQUE REFERENCES graph_nodes(id) ON DELETE CASCADE
+);
+
+-- graph_channels holds the static information of the (undirected) edges of the
+-- channel graph.
+CREATE TABLE IF NOT EXISTS graph_channels (
+    -- The id of the channel.
+    id BIGINT PRIMARY KEY,
+
+    -- The short channel id of the channel, serialized as 8 big endian bytes
+    -- so that range queries follow the block height ordering.
+    scid BLOB NOT NULL UNIQUE,
+
+    -- The genesis hash of the chain the channel was opened on.
+    chain_hash BLOB NOT NULL,
+
+    -- The funding outpoint of the channel, in the txid:index format.
+    outpoint TEXT NOT NULL UNIQUE,
+
+    -- The capacity of the channel in satoshis.
+    capacity BIGINT NOT NULL,
+
+    -- The two nodes of the channel, ordered by their public keys.
+    node_id_1 BIGINT NOT NULL REFERENCES graph_nodes(id) ON DELETE CASCADE,
+    node_id_2 BIGINT NOT NULL REFERENCES graph_nodes(id) ON DELETE CASCADE,
+
+    -- The bitcoin multisig keys of the funding output.
+    bitcoin_key_1 BLOB NOT NULL,
+    bitcoin_key_2 BLOB NOT NULL,
+
+    -- The raw serialized channel feature vector.
+    features BLOB,
+
+    -- The signatures of the channel announcement. They are all NULL if the
+    -- channel hasn't been announced yet (private channel).
+    node_1_signature BLOB,
+    node_2_signature BLOB,
+    bitcoin_1_signature BLOB,
+    bitcoin_2_signature BLOB,
+
+    -- Any extra opaque data that was included in the channel announcement.
+    extra_opaque_data BLOB
+);
+
+CREATE INDEX IF NOT EXISTS graph_channels_node_id_1_idx ON graph_channels(node_id_1);
+CREATE INDEX IF NOT EXISTS graph_channels_node_id_2_idx ON graph_channels(node_id_2);
+
+-- graph_channel_policies holds the routing policies of the directed edges of
+-- a channel.
+CREATE TABLE IF NOT EXISTS graph_channel_policies (
+    -- The id of the policy.
+    id BIGINT PRIMARY KEY,
+
+    -- The channel this policy belongs to.
+    channel_id BIGINT NOT NULL REFERENCES graph_channels(id) ON DELETE CASCADE,
+
+    -- The direction of the policy: zero if the policy was announced by
+    -- node_id_1, one if it was announced by node_id_2.
+    direction SMALLINT NOT NULL,
+
+    -- The unix timestamp of the channel update.
+    last_update BIGINT NOT NULL,
+
+    -- The message and channel flags of the channel update.
+    message_flags SMALLINT NOT NULL,
+    channel_flags SMALLINT NOT NULL,
+
+    -- The time lock delta of the channel.
+    timelock INTEGER NOT NULL,
+
+    -- The htlc limits of the channel in milli-satoshis. The max htlc is only
+    -- set if it's signalled by the message flags.
+    min_htlc_msat BIGINT NOT NULL,
+    max_htlc_msat BIGINT,
+
+    -- The fees charged for forwarding over the channel.
+    fee_base_msat BIGINT NOT NULL,
+    fee_ppm BIGINT NOT NULL,
+
+    -- Whether the disabled bit is set in the channel flags, used to quickly
+    -- find the disabled channels.
+    disabled BOOLEAN NOT NULL,
+
+    -- The signature of the channel update.
+    signature BLOB,
+
+    -- Any extra opaque data that was included in the channel update.
+    extra_opaque_data BLOB,
+
+    UNIQUE (channel_id, direction)
+);
+
+CREATE INDEX IF NOT EXISTS graph_channel_policies_last_update_idx ON graph_channel_policies(last_update);
+
+-- graph_zombie_channels holds the channels that we consider zombies. The node
+-- keys determine which of the nodes is allowed to resurrect the channel, a
+-- blank key means the respective node can't resurrect it.
+CREATE TABLE IF NOT EXISTS graph_zombie_channels (
+    scid BLOB NOT NULL UNIQUE,
+    node_key_1 BLOB NOT NULL,
+    node_key_2 BLOB NOT NULL
+);
+
+-- graph_closed_scids holds the short channel ids of the channels that we know
+-- have been closed on-chain, so that we can ignore any announcements for them.
+CREATE TABLE IF NOT EXISTS graph_closed_scids (
+    scid BLOB NOT NULL UNIQUE
+);
+
+-- graph_prune_log holds the blocks that were used to prune the channel graph.
+-- The entry with the highest block height is the prune tip.
+CREATE TABLE IF NOT EXISTS graph_prune_log (
+    block_height BIGINT NOT NULL UNIQUE,
+    block_hash BLOB NOT NULL
+);
@@ -0,0 +1,277 @@
+DROP INDEX IF EXISTS invoice_events_event_type_idx;
+DROP INDEX IF EXISTS invoice_events_set_id_idx;
+DROP INDEX IF EXISTS invoice_events_htlc_id_idx;
+DROP INDEX IF EXISTS invoice_events_invoice_id_idx;
+DROP INDEX IF EXISTS invoice_events_created_at_idx;
+DROP TABLE IF EXISTS invoice_events;
+
+DROP INDEX IF EXISTS amp_sub_invoice_htlcs_htlc_id_idx;
+DROP INDEX IF EXISTS amp_sub_invoice_htlcs_set_id_idx;
+DROP INDEX IF EXISTS amp_sub_invoice_htlcs_invoice_id_idx;
+DROP TABLE IF EXISTS amp_sub_invoice_htlcs;
+
+DROP INDEX IF EXISTS amp_sub_invoices_settle_index_idx;
+DROP INDEX IF EXISTS amp_sub_invoices_invoice_id_idx;
+DROP TABLE IF EXISTS amp_sub_invoices;
+
+DROP TABLE IF EXISTS invoice_sequences;
+
+DROP INDEX IF EXISTS invoice_htlc_custom_records_htlc_id_idx;
+DROP TABLE IF EXISTS invoice_htlc_custom_records;
+
+DROP INDEX IF EXISTS invoice_htlc_invoice_id_idx;
+DROP TABLE IF EXISTS invoice_htlcs;
+
+DROP INDEX IF EXISTS invoice_feature_invoice_id_idx;
+DROP TABLE IF EXISTS invoice_features;
+
+DROP INDEX IF EXISTS invoices_settle_index_idx;
+DROP INDEX IF EXISTS invoices_created_at_idx;
+DROP INDEX IF EXISTS invoices_state_idx;
+DROP INDEX IF EXISTS invoices_payment_addr_idx;
+DROP INDEX IF EXISTS invoices_preimage_idx;
+DROP INDEX IF EXISTS invoices_hash_idx;
+DROP TABLE IF EXISTS invoices;
+
+-- Restore the invoice tables of the first migrations.
+-- invoices table contains all the information shared by all the invoice types. 
+CREATE TABLE IF NOT EXISTS invoices (
+    -- The id of the invoice. Translates to the AddIndex.
+    id INTEGER PRIMARY KEY,
+
+    -- The hash for this invoice. The invoice hash will always identify that 
+    -- invoice.
+    hash BLOB NOT NULL UNIQUE,
+
+    -- The preimage for the hash in this invoice. Some invoices may have this 
+    -- field empty, like unsettled hodl invoices or AMP invoices.
+    preimage BLOB,
+
+    -- An optional memo to attach along with the invoice. 
+    memo TEXT,
+
+    -- The amount of the invoice in millisatoshis.
+    amount_msat BIGINT NOT NULL, 
+
+    -- Delta to use for the time-lock of the CLTV extended to the final hop.
+    -- BOLT12 invoices will have this field set to NULL.
+    cltv_delta INTEGER,
+
+    -- The time before this invoice expiries, in seconds.
+    expiry INTEGER NOT NULL,
+
+    -- A randomly generated value include in the MPP record by the sender to 
+    -- prevent probing of the receiver.
+    -- This field corresponds to the `payment_secret` specified in BOLT 11.
+    payment_addr BLOB UNIQUE,
+
+    -- The encoded payment request for this invoice. Some invoice types may 
+    -- not have leave this empty, like Keysends.
+    payment_request TEXT UNIQUE, 
+
+    -- The invoice state.
+    state SMALLINT NOT NULL,
+
+    -- The accumulated value of all the htlcs settled for this invoice.
+    amount_paid_msat BIGINT NOT NULL,
+
+    -- This field will be true for AMP invoices.
+    is_amp BOOLEAN NOT NULL,
+
+    -- This field will be true for hodl invoices, independently of they being
+    -- settled or not.
+    is_hodl BOOLEAN NOT NULL,
+
+    -- This field will be true for keysend invoices.
+    is_keysend BOOLEAN NOT NULL,
+
+    -- Timestamp of when this invoice was created.
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoices_hash_idx ON invoices(hash);
+CREATE INDEX IF NOT EXISTS invoices_preimage_idx ON invoices(preimage);
+CREATE INDEX IF NOT EXISTS invoices_payment_addr_idx ON invoices(payment_addr);
+CREATE INDEX IF NOT EXISTS invoices_state_idx ON invoices(state);
+CREATE INDEX IF NOT EXISTS invoices_created_at_idx ON invoices(created_at);
+
+-- invoice_features contains the feature bits of an invoice.
+CREATE TABLE IF NOT EXISTS invoice_features (
+    -- The feature bit.
+    feature INTEGER NOT NULL,
+
+    -- The invoice id this feature belongs to.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id),
+
+    -- The feature bit is unique per invoice.
+    UNIQUE (feature, invoice_id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_feature_invoice_id_idx ON invoice_features(invoice_id);
+
+-- invoice_htlcs contains the information of a htlcs related to one of the node 
+-- invocies. 
+CREATE TABLE IF NOT EXISTS invoice_htlcs (
+    -- The id for this htlc. Used in foreign keys instead of the 
+    -- htlc_id/chan_id combination.
+    id INTEGER PRIMARY KEY,
+
+    -- The uint64 htlc id. This field is a counter so it is safe to store it as 
+    -- int64 in the database. The application layer must check that there is no 
+    -- overflow when storing/loading this column.
+    htlc_id BIGINT NOT NULL,
+
+    -- Short chan id indicating the htlc's origin. uint64 stored as text.
+    chan_id TEXT NOT NULL, 
+    
+    -- The htlc's amount in millisatoshis.
+    amount_msat BIGINT NOT NULL,
+
+    -- The total amount expected for the htlcs in a multi-path payment.
+    total_mpp_msat BIGINT, 
+
+    -- The block height at which this htlc was accepted.
+    accept_height INTEGER NOT NULL,
+
+    -- The timestamp at which this htlc was accepted.
+    accept_time TIMESTAMP NOT NULL,
+
+    -- The block height at which this htlc expiries. 
+    expiry_height INTEGER NOT NULL,
+
+    -- The htlc state.
+    state SMALLINT NOT NULL,
+
+    -- Timestamp of when this htlc was resolved (settled/canceled).
+    resolve_time TIMESTAMP,
+
+    -- The id of the invoice this htlc belongs to.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id),
+
+    -- The htlc_id and chan_id identify the htlc.
+    UNIQUE (htlc_id, chan_id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_htlc_invoice_id_idx ON invoice_htlcs(invoice_id);
+
+-- invoice_htlc_custom_records stores the custom key/value pairs that 
+-- accompanied an htlc.
+CREATE TABLE IF NOT EXISTS invoice_htlc_custom_records (
+    -- The custom type identifier for this record.
+    -- The range of values for custom records key is defined in BOLT 01.
+    key BIGINT NOT NULL,
+
+    -- The custom value for this record.
+    value BLOB NOT NULL,
+
+    -- The htlc id this record belongs to. 
+    htlc_id BIGINT NOT NULL REFERENCES invoice_htlcs(id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_htlc_custom_records_htlc_id_idx ON invoice_htlc_custom_records(htlc_id);
+
+-- invoice_payments contains the information of a settled invoice payment. 
+CREATE TABLE IF NOT EXISTS invoice_payments (
+    -- The id for this invoice payment. Translates to SettleIndex.
+    id INTEGER PRIMARY KEY,
+    
+    -- When the payment was settled.
+    settled_at TIMESTAMP NOT NULL,
+
+    -- The amount of the payment in millisatoshis. This is the sum of all the 
+    -- the htlcs settled for this payment.
+    amount_paid_msat BIGINT NOT NULL,
+
+    -- The invoice id this payment is for.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_payments_settled_at_idx ON invoice_payments(settled_at);
+CREATE INDEX IF NOT EXISTS invoice_payments_invoice_id_idx ON invoice_payments(invoice_id);
+
+-- amp_invoices_payments  
+CREATE TABLE IF NOT EXISTS amp_invoice_payments (
+    -- The set id identifying the payment. 
+    set_id BLOB PRIMARY KEY,
+
+    -- The state of this amp payment. This matches the state for all the htlcs 
+    -- belonging to this set id. The A in AMP stands for Atomic.
+    state SMALLINT NOT NULL,
+
+    -- Timestamp of when the first htlc for this payment was accepted.
+    created_at TIMESTAMP NOT NULL,
+
+    -- If settled, the invoice payment related to this set id.
+    settled_index INTEGER REFERENCES invoice_payments(id),
+
+    -- The invoice id this set id is related to.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id)
+);
+
+CREATE INDEX IF NOT EXISTS amp_invoice_payments_invoice_id_idx ON amp_invoice_payments(invoice_id);
+
+-- amp_invoice_htlcs contains the complementary information for an htlc related 
+-- to an AMP invoice.
+CREATE TABLE IF NOT EXISTS amp_invoice_htlcs (
+    -- The set id identifying the payment this htlc belongs to.
+    set_id BLOB NOT NULL REFERENCES amp_invoice_payments(set_id),
+
+    -- The id of the htlc this entry blongs to.
+    htlc_id BIGINT NOT NULL REFERENCES invoice_htlcs(id),
+
+    -- The invoice id this entry is related to.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id),
+
+    -- The root share for this amp htlc.
+    root_share BLOB NOT NULL,
+    
+    -- The child index for this amp htlc.
+    child_index BIGINT NOT NULL,
+
+    -- The htlc-level payment hash. An AMP htlc will carry a different payment
+    -- hash from the invoice it might be satisfying. They are needed to ensure
+    -- that we reconstruct the preimage correctly.
+    hash BLOB NOT NULL,
+    
+    -- The HTLC-level preimage that satisfies the AMP htlc's Hash.
+    -- The preimage will be derived either from secret share reconstruction of 
+    -- the shares in the AMP payload.
+    preimage BLOB 
+);
+
+CREATE INDEX IF NOT EXISTS amp_htlcs_set_id_idx ON amp_invoice_htlcs(set_id);
+CREATE INDEX IF NOT EXISTS amp_htlcs_invoice_id_idx ON amp_invoice_htlcs(invoice_id);
+CREATE INDEX IF NOT EXISTS amp_htlcs_htlc_id_idx ON amp_invoice_htlcs(htlc_id);
+
+
+-- invoice_events stores all events related to the node invoices.
+CREATE TABLE IF NOT EXISTS invoice_events (
+    id INTEGER PRIMARY KEY,
+
+    -- created_at is the creation time of this event.
+    created_at TIMESTAMP NOT NULL,
+
+    -- invoice_id is the reference to the invoice this event was emitted for.
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id),
+
+    -- htlc_id is the reference to the htlc this event was emitted for, may be 
+    -- null.
+    htlc_id BIGINT REFERENCES invoice_htlcs(htlc_id),
+
+    -- set_id is the reference to the set_id this event was emitted for, may be
+    -- null.
+    set_id BLOB NOT NULL REFERENCES amp_invoice_payments(set_id),
+
+    -- event_type is the type of this event.
+    event_type INTEGER NOT NULL REFERENCES invoice_event_types(id),
+
+    -- event_metadata is a TLV encoding any relevant information for this kind 
+    -- of events.
+    event_metadata BLOB
+);
+
+CREATE INDEX IF NOT EXISTS invoice_events_created_at_idx ON invoice_events(created_at);
+CREATE INDEX IF NOT EXISTS invoice_events_invoice_id_idx ON invoice_events(invoice_id);
+CREATE INDEX IF NOT EXISTS invoice_events_htlc_id_idx ON invoice_events(htlc_id);
+CREATE INDEX IF NOT EXISTS invoice_events_set_id_idx ON invoice_events(set_id);
+CREATE INDEX IF NOT EXISTS invoice_events_event_type_idx ON invoice_events(event_type);
@@ -9,26 +9,103 @@ import (
 	"time"
 )
 
-type AmpInvoiceHtlc struct {
+type AmpSubInvoice struct {
+	SetID       []byte
+	State       int16
+	CreatedAt   time.Time
+	SettleIndex sql.NullInt64
+	SettledAt   sql.NullTime
+	InvoiceID   int64
+}
+
+type AmpSubInvoiceHtlc struct {
+	InvoiceID  int64
 	SetID      []byte
 	HtlcID     int64
-	InvoiceID  int32
 	RootShare  []byte
 	ChildIndex int64
 	Hash       []byte
 	Preimage   []byte
 }
 
-type AmpInvoicePayment struct {
-	SetID        []byte
-	State        int16
-	CreatedAt    time.Time
-	SettledIndex sql.NullInt32
-	InvoiceID    int32
+type GraphChannel struct {
+	ID                int64
+	Scid              []byte
+	ChainHash         []byte
+	Outpoint          string
+	Capacity          int64
+	NodeID1           int64
+	NodeID2           int64
+	BitcoinKey1       []byte
+	BitcoinKey2       []byte
+	Features          []byte
+	Node1Signature    []byte
+	Node2Signature    []byte
+	Bitcoin1Signature []byte
+	Bitcoin2Signature []byte
+	ExtraOpaqueData   []byte
+}
+
+type GraphChannelPolicy struct {
+	ID              int64
+	ChannelID       int64
+	Direction       int16
+	LastUpdate      int64
+	MessageFlags    int16
+	ChannelFlags    int16
+	Timelock        int32
+	MinHtlcMsat     int64
+	MaxHtlcMsat     sql.NullInt64
+	FeeBaseMsat     int64
+	FeePpm          int64
+	Disabled        bool
+	Signature       []byte
+	ExtraOpaqueData []byte
+}
+
+type GraphClosedScid struct {
+	Scid []byte
+}
+
+type GraphNode struct {
+	ID               int64
+	PubKey           []byte
+	HaveAnnouncement bool
+	LastUpdate       int64
+	Alias            sql.NullString
+	Color            []byte
+	Signature        []byte
+	ExtraOpaqueData  []byte
+}
+
+type GraphNodeAddress struct {
+	NodeID   int64
+	Position int32
+	Address  []byte
+}
+
+type GraphNodeFeature struct {
+	NodeID     int64
+	FeatureBit int32
+}
+
+type GraphPruneLog struct {
+	BlockHeight int64
+	BlockHash   []byte
+}
+
+type GraphSourceNode struct {
+	NodeID int64
+}
+
+type GraphZombieChannel struct {
+	Scid     []byte
+	NodeKey1 []byte
+	NodeKey2 []byte
 }
 
 type Invoice struct {
-	ID             int32
+	ID             int64
 	Hash           []byte
 	Preimage       []byte
 	Memo           sql.NullString
@@ -43,12 +120,14 @@ type Invoice struct {
 	IsHodl         bool
 	IsKeysend      bool
 	CreatedAt      time.Time
+	SettleIndex    sql.NullInt64
+	SettledAt      sql.NullTime
 }
 
 type InvoiceEvent struct {
-	ID            int32
+	ID            int64
 	CreatedAt     time.Time
-	InvoiceID     int32
+	InvoiceID     int64
 	HtlcID        sql.NullInt64
 	SetID         []byte
 	EventType     int32
@@ -62,11 +141,11 @@ type InvoiceEventType struct {
 
 type InvoiceFeature struct {
 	Feature   int32
-	InvoiceID int32
+	InvoiceID int64
 }
 
 type InvoiceHtlc struct {
-	ID           int32
+	ID           int64
 	HtlcID       int64
 	ChanID       string
 	AmountMsat   int64
@@ -76,7 +155,7 @@ type InvoiceHtlc struct {
 	ExpiryHeight int32
 	State        int16
 	ResolveTime  sql.NullTime
-	InvoiceID    int32
+	InvoiceID    int64
 }
 
 type InvoiceHtlcCustomRecord struct {
@@ -85,9 +164,7 @@ type InvoiceHtlcCustomRecord struct {
 	HtlcID int64
 }
 
-type InvoicePayment struct {
-	ID             int32
-	SettledAt      time.Time
-	AmountPaidMsat int64
-	InvoiceID      int32
+type InvoiceSequence struct {
+	Name         string
+	CurrentValue int64
 }
@@ -6,46 +6,86 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
-	DeleteAMPHTLCCustomRecords(ctx context.Context, invoiceID int32) error
-	DeleteAMPHTLCs(ctx context.Context, invoiceID int32) error
-	DeleteAMPInvoiceHTLC(ctx context.Context, setID []byte) error
-	DeleteInvoice(ctx context.Context, arg DeleteInvoiceParams) error
-	DeleteInvoiceEvents(ctx context.Context, invoiceID int32) error
-	DeleteInvoiceFeatures(ctx context.Context, invoiceID int32) error
-	DeleteInvoiceHTLC(ctx context.Context, htlcID int64) error
-	DeleteInvoiceHTLCCustomRecords(ctx context.Context, invoiceID int32) error
-	DeleteInvoiceHTLCs(ctx context.Context, invoiceID int32) error
-	FilterInvoicePayments(ctx context.Context, arg FilterInvoicePaymentsParams) ([]FilterInvoicePaymentsRow, error)
+	AddSourceNode(ctx context.Context, nodeID int64) error
+	CountZombieChannels(ctx context.Context) (int64, error)
+	CreateChannel(ctx context.Context, arg CreateChannelParams) (int64, error)
+	DeleteAllClosedSCIDs(ctx context.Context) error
+	DeleteAllInvoices(ctx context.Context) error
+	DeleteAllNodes(ctx context.Context) error
+	DeleteAllZombieChannels(ctx context.Context) error
+	DeleteChannelBySCID(ctx context.Context, scid []byte) (sql.Result, error)
+	DeleteInvoice(ctx context.Context, arg DeleteInvoiceParams) (sql.Result, error)
+	DeleteInvoiceEvents(ctx context.Context, invoiceID int64) error
+	DeleteNodeAddresses(ctx context.Context, nodeID int64) error
+	DeleteNodeByPubKey(ctx context.Context, pubKey []byte) (sql.Result, error)
+	DeleteNodeFeatures(ctx context.Context, nodeID int64) error
+	DeletePruneLogEntriesFrom(ctx context.Context, blockHeight int64) error
+	DeleteSourceNodes(ctx context.Context) error
+	DeleteUnconnectedNodes(ctx context.Context) ([][]byte, error)
+	DeleteZombieChannel(ctx context.Context, scid []byte) (sql.Result, error)
+	FetchSettledAMPSubInvoices(ctx context.Context, settleIndex sql.NullInt64) ([]FetchSettledAMPSubInvoicesRow, error)
 	FilterInvoices(ctx context.Context, arg FilterInvoicesParams) ([]Invoice, error)
-	GetAMPInvoiceHTLCsByInvoiceID(ctx context.Context, invoiceID int32) ([]AmpInvoiceHtlc, error)
-	GetAMPInvoiceHTLCsBySetID(ctx context.Context, setID []byte) ([]AmpInvoiceHtlc, error)
+	GetChannelByOutpoint(ctx context.Context, outpoint string) (GetChannelByOutpointRow, error)
+	GetChannelBySCID(ctx context.Context, scid []byte) (GetChannelBySCIDRow, error)
+	GetChannelPolicies(ctx context.Context, channelID int64) ([]GraphChannelPolicy, error)
+	GetChannelsByNodeID(ctx context.Context, nodeID1 int64) ([]GetChannelsByNodeIDRow, error)
+	GetChannelsByPolicyLastUpdateRange(ctx context.Context, arg GetChannelsByPolicyLastUpdateRangeParams) ([][]byte, error)
+	GetChannelsBySCIDRange(ctx context.Context, arg GetChannelsBySCIDRangeParams) ([]GetChannelsBySCIDRangeRow, error)
+	GetDisabledChannelSCIDs(ctx context.Context) ([][]byte, error)
 	// This method may return more than one invoice if filter using multiple fields
 	// from different invoices. It is the caller's responsibility to ensure that
 	// we bubble up an error in those cases.
 	GetInvoice(ctx context.Context, arg GetInvoiceParams) ([]Invoice, error)
-	GetInvoiceFeatures(ctx context.Context, invoiceID int32) ([]InvoiceFeature, error)
-	GetInvoiceHTLCCustomRecords(ctx context.Context, invoiceID int32) ([]GetInvoiceHTLCCustomRecordsRow, error)
-	GetInvoiceHTLCs(ctx context.Context, invoiceID int32) ([]InvoiceHtlc, error)
-	GetInvoicePayments(ctx context.Context, invoiceID int32) ([]InvoicePayment, error)
-	GetSetIDHTLCsCustomRecords(ctx context.Context, setID []byte) ([]GetSetIDHTLCsCustomRecordsRow, error)
-	InsertAMPInvoiceHTLC(ctx context.Context, arg InsertAMPInvoiceHTLCParams) error
-	InsertAMPInvoicePayment(ctx context.Context, arg InsertAMPInvoicePaymentParams) error
-	InsertInvoice(ctx context.Context, arg InsertInvoiceParams) (int32, error)
+	GetInvoiceBySetID(ctx context.Context, setID []byte) ([]Invoice, error)
+	GetInvoiceFeatures(ctx context.Context, invoiceID int64) ([]InvoiceFeature, error)
+	GetInvoiceHTLCCustomRecords(ctx context.Context, invoiceID int64) ([]GetInvoiceHTLCCustomRecordsRow, error)
+	GetInvoiceHTLCs(ctx context.Context, invoiceID int64) ([]InvoiceHtlc, error)
+	GetNodeAddresses(ctx context.Context, nodeID int64) ([]GraphNodeAddress, error)
+	GetNodeByID(ctx context.Context, id int64) (GraphNode, error)
+	GetNodeByPubKey(ctx context.Context, pubKey []byte) (GraphNode, error)
+	GetNodeFeatures(ctx context.Context, nodeID int64) ([]GraphNodeFeature, error)
+	GetNodeIDByPubKey(ctx context.Context, pubKey []byte) (int64, error)
+	GetNodesByLastUpdateRange(ctx context.Context, arg GetNodesByLastUpdateRangeParams) ([]GraphNode, error)
+	GetPruneTip(ctx context.Context) (GraphPruneLog, error)
+	GetSourceNodeID(ctx context.Context) (int64, error)
+	GetZombieChannel(ctx context.Context, scid []byte) (GraphZombieChannel, error)
+	HighestSCID(ctx context.Context) ([]byte, error)
+	InsertAMPSubInvoiceHTLC(ctx context.Context, arg InsertAMPSubInvoiceHTLCParams) error
+	InsertClosedSCID(ctx context.Context, scid []byte) error
+	InsertInvoice(ctx context.Context, arg InsertInvoiceParams) error
 	InsertInvoiceEvent(ctx context.Context, arg InsertInvoiceEventParams) error
 	InsertInvoiceFeature(ctx context.Context, arg InsertInvoiceFeatureParams) error
-	InsertInvoiceHTLC(ctx context.Context, arg InsertInvoiceHTLCParams) error
+	InsertInvoiceHTLC(ctx context.Context, arg InsertInvoiceHTLCParams) (int64, error)
 	InsertInvoiceHTLCCustomRecord(ctx context.Context, arg InsertInvoiceHTLCCustomRecordParams) error
-	InsertInvoicePayment(ctx context.Context, arg InsertInvoicePaymentParams) (int32, error)
-	SelectAMPInvoicePayments(ctx context.Context, arg SelectAMPInvoicePaymentsParams) ([]SelectAMPInvoicePaymentsRow, error)
+	InsertNodeAddress(ctx context.Context, arg InsertNodeAddressParams) error
+	InsertNodeFeature(ctx context.Context, arg InsertNodeFeatureParams) error
+	IsClosedSCID(ctx context.Context, scid []byte) (bool, error)
+	// A node is considered public if it has at least one announced channel, or a
+	// channel with a node other than our own source node.
+	IsPublicNode(ctx context.Context, arg IsPublicNodeParams) (bool, error)
+	ListChannelsPaginated(ctx context.Context, arg ListChannelsPaginatedParams) ([]ListChannelsPaginatedRow, error)
+	ListNodesPaginated(ctx context.Context, arg ListNodesPaginatedParams) ([]GraphNode, error)
+	NextInvoiceAddIndex(ctx context.Context) (int64, error)
+	NextInvoiceSettleIndex(ctx context.Context) (int64, error)
+	SelectAMPSubInvoiceHTLCs(ctx context.Context, invoiceID int64) ([]SelectAMPSubInvoiceHTLCsRow, error)
+	SelectAMPSubInvoices(ctx context.Context, invoiceID int64) ([]AmpSubInvoice, error)
 	SelectInvoiceEvents(ctx context.Context, arg SelectInvoiceEventsParams) ([]InvoiceEvent, error)
-	UpdateAMPInvoiceHTLC(ctx context.Context, arg UpdateAMPInvoiceHTLCParams) error
-	UpdateAMPPayment(ctx context.Context, arg UpdateAMPPaymentParams) error
-	UpdateInvoice(ctx context.Context, arg UpdateInvoiceParams) error
+	SetInvoiceSequence(ctx context.Context, arg SetInvoiceSequenceParams) error
+	UpdateAMPSubInvoiceHTLCPreimage(ctx context.Context, arg UpdateAMPSubInvoiceHTLCPreimageParams) (sql.Result, error)
+	UpdateAMPSubInvoiceState(ctx context.Context, arg UpdateAMPSubInvoiceStateParams) error
+	UpdateChannel(ctx context.Context, arg UpdateChannelParams) (sql.Result, error)
+	UpdateInvoiceAmountPaid(ctx context.Context, arg UpdateInvoiceAmountPaidParams) (sql.Result, error)
 	UpdateInvoiceHTLC(ctx context.Context, arg UpdateInvoiceHTLCParams) error
-	UpdateInvoiceHTLCs(ctx context.Context, arg UpdateInvoiceHTLCsParams) error
+	UpdateInvoiceState(ctx context.Context, arg UpdateInvoiceStateParams) (sql.Result, error)
+	UpsertAMPSubInvoice(ctx context.Context, arg UpsertAMPSubInvoiceParams) (sql.Result, error)
+	UpsertChannelPolicy(ctx context.Context, arg UpsertChannelPolicyParams) (int64, error)
+	UpsertNode(ctx context.Context, arg UpsertNodeParams) (int64, error)
+	UpsertPruneLogEntry(ctx context.Context, arg UpsertPruneLogEntryParams) error
+	UpsertZombieChannel(ctx context.Context, arg UpsertZombieChannelParams) error
 }
 
 var _ Querier = (*Queries)(nil)
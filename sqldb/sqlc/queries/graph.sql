@@ -0,0 +1,311 @@
+-- name: UpsertNode :one
+INSERT INTO graph_nodes (
+    pub_key, have_announcement, last_update, alias, color, signature,
+    extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (pub_key) DO UPDATE SET
+    have_announcement = EXCLUDED.have_announcement,
+    last_update = EXCLUDED.last_update,
+    alias = EXCLUDED.alias,
+    color = EXCLUDED.color,
+    signature = EXCLUDED.signature,
+    extra_opaque_data = EXCLUDED.extra_opaque_data
+RETURNING id;
+
+-- name: GetNodeByPubKey :one
+SELECT *
+FROM graph_nodes
+WHERE pub_key = $1;
+
+-- name: GetNodeByID :one
+SELECT *
+FROM graph_nodes
+WHERE id = $1;
+
+-- name: GetNodeIDByPubKey :one
+SELECT id
+FROM graph_nodes
+WHERE pub_key = $1;
+
+-- name: DeleteNodeByPubKey :execresult
+DELETE FROM graph_nodes
+WHERE pub_key = $1;
+
+-- name: ListNodesPaginated :many
+SELECT *
+FROM graph_nodes
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: GetNodesByLastUpdateRange :many
+SELECT *
+FROM graph_nodes
+WHERE have_announcement = TRUE
+    AND last_update >= @start_time
+    AND last_update <= @end_time
+ORDER BY last_update ASC, pub_key ASC;
+
+-- name: DeleteAllNodes :exec
+DELETE FROM graph_nodes;
+
+-- name: DeleteUnconnectedNodes :many
+DELETE FROM graph_nodes
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM graph_channels c
+    WHERE c.node_id_1 = graph_nodes.id OR c.node_id_2 = graph_nodes.id
+) AND NOT EXISTS (
+    SELECT 1
+    FROM graph_source_nodes s
+    WHERE s.node_id = graph_nodes.id
+)
+RETURNING pub_key;
+
+-- name: InsertNodeFeature :exec
+INSERT INTO graph_node_features (
+    node_id, feature_bit
+) VALUES (
+    $1, $2
+);
+
+-- name: GetNodeFeatures :many
+SELECT *
+FROM graph_node_features
+WHERE node_id = $1
+ORDER BY feature_bit;
+
+-- name: DeleteNodeFeatures :exec
+DELETE FROM graph_node_features
+WHERE node_id = $1;
+
+-- name: InsertNodeAddress :exec
+INSERT INTO graph_node_addresses (
+    node_id, position, address
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetNodeAddresses :many
+SELECT *
+FROM graph_node_addresses
+WHERE node_id = $1
+ORDER BY position;
+
+-- name: DeleteNodeAddresses :exec
+DELETE FROM graph_node_addresses
+WHERE node_id = $1;
+
+-- name: AddSourceNode :exec
+INSERT INTO graph_source_nodes (
+    node_id
+) VALUES (
+    $1
+);
+
+-- name: DeleteSourceNodes :exec
+DELETE FROM graph_source_nodes;
+
+-- name: GetSourceNodeID :one
+SELECT node_id
+FROM graph_source_nodes;
+
+-- name: CreateChannel :one
+INSERT INTO graph_channels (
+    scid, chain_hash, outpoint, capacity, node_id_1, node_id_2,
+    bitcoin_key_1, bitcoin_key_2, features, node_1_signature,
+    node_2_signature, bitcoin_1_signature, bitcoin_2_signature,
+    extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+)
+RETURNING id;
+
+-- name: UpdateChannel :execresult
+UPDATE graph_channels
+SET chain_hash = $2,
+    outpoint = $3,
+    capacity = $4,
+    bitcoin_key_1 = $5,
+    bitcoin_key_2 = $6,
+    features = $7,
+    node_1_signature = $8,
+    node_2_signature = $9,
+    bitcoin_1_signature = $10,
+    bitcoin_2_signature = $11,
+    extra_opaque_data = $12
+WHERE scid = $1;
+
+-- name: GetChannelBySCID :one
+SELECT sqlc.embed(c), n1.pub_key AS node_1_pub_key, n2.pub_key AS node_2_pub_key
+FROM graph_channels c
+    JOIN graph_nodes n1 ON c.node_id_1 = n1.id
+    JOIN graph_nodes n2 ON c.node_id_2 = n2.id
+WHERE c.scid = $1;
+
+-- name: GetChannelByOutpoint :one
+SELECT sqlc.embed(c), n1.pub_key AS node_1_pub_key, n2.pub_key AS node_2_pub_key
+FROM graph_channels c
+    JOIN graph_nodes n1 ON c.node_id_1 = n1.id
+    JOIN graph_nodes n2 ON c.node_id_2 = n2.id
+WHERE c.outpoint = $1;
+
+-- name: GetChannelsByNodeID :many
+SELECT sqlc.embed(c), n1.pub_key AS node_1_pub_key, n2.pub_key AS node_2_pub_key
+FROM graph_channels c
+    JOIN graph_nodes n1 ON c.node_id_1 = n1.id
+    JOIN graph_nodes n2 ON c.node_id_2 = n2.id
+WHERE c.node_id_1 = $1 OR c.node_id_2 = $1
+ORDER BY c.scid;
+
+-- name: GetChannelsBySCIDRange :many
+SELECT sqlc.embed(c), n1.pub_key AS node_1_pub_key, n2.pub_key AS node_2_pub_key
+FROM graph_channels c
+    JOIN graph_nodes n1 ON c.node_id_1 = n1.id
+    JOIN graph_nodes n2 ON c.node_id_2 = n2.id
+WHERE c.scid >= @start_scid
+    AND c.scid <= @end_scid
+ORDER BY c.scid;
+
+-- name: ListChannelsPaginated :many
+SELECT sqlc.embed(c), n1.pub_key AS node_1_pub_key, n2.pub_key AS node_2_pub_key
+FROM graph_channels c
+    JOIN graph_nodes n1 ON c.node_id_1 = n1.id
+    JOIN graph_nodes n2 ON c.node_id_2 = n2.id
+WHERE c.id > $1
+ORDER BY c.id
+LIMIT $2;
+
+-- name: GetChannelsByPolicyLastUpdateRange :many
+SELECT DISTINCT c.scid
+FROM graph_channels c
+    JOIN graph_channel_policies p ON p.channel_id = c.id
+WHERE p.last_update >= @start_time
+    AND p.last_update <= @end_time
+ORDER BY c.scid;
+
+-- name: HighestSCID :one
+SELECT scid
+FROM graph_channels
+ORDER BY scid DESC
+LIMIT 1;
+
+-- name: DeleteChannelBySCID :execresult
+DELETE FROM graph_channels
+WHERE scid = $1;
+
+-- A node is considered public if it has at least one announced channel, or a
+-- channel with a node other than our own source node.
+
+-- name: IsPublicNode :one
+SELECT EXISTS (
+    SELECT 1
+    FROM graph_channels c
+    WHERE (c.node_id_1 = @node_id OR c.node_id_2 = @node_id)
+        AND (
+            c.node_1_signature IS NOT NULL OR
+            (c.node_id_1 <> @source_id AND c.node_id_2 <> @source_id)
+        )
+);
+
+-- name: GetDisabledChannelSCIDs :many
+SELECT c.scid
+FROM graph_channels c
+    JOIN graph_channel_policies p ON p.channel_id = c.id
+WHERE p.disabled = TRUE
+GROUP BY c.scid
+HAVING COUNT(*) = 2
+ORDER BY c.scid;
+
+-- name: UpsertChannelPolicy :one
+INSERT INTO graph_channel_policies (
+    channel_id, direction, last_update, message_flags, channel_flags,
+    timelock, min_htlc_msat, max_htlc_msat, fee_base_msat, fee_ppm,
+    disabled, signature, extra_opaque_data
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+)
+ON CONFLICT (channel_id, direction) DO UPDATE SET
+    last_update = EXCLUDED.last_update,
+    message_flags = EXCLUDED.message_flags,
+    channel_flags = EXCLUDED.channel_flags,
+    timelock = EXCLUDED.timelock,
+    min_htlc_msat = EXCLUDED.min_htlc_msat,
+    max_htlc_msat = EXCLUDED.max_htlc_msat,
+    fee_base_msat = EXCLUDED.fee_base_msat,
+    fee_ppm = EXCLUDED.fee_ppm,
+    disabled = EXCLUDED.disabled,
+    signature = EXCLUDED.signature,
+    extra_opaque_data = EXCLUDED.extra_opaque_data
+RETURNING id;
+
+-- name: GetChannelPolicies :many
+SELECT *
+FROM graph_channel_policies
+WHERE channel_id = $1
+ORDER BY direction;
+
+-- name: UpsertZombieChannel :exec
+INSERT INTO graph_zombie_channels (
+    scid, node_key_1, node_key_2
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (scid) DO UPDATE SET
+    node_key_1 = EXCLUDED.node_key_1,
+    node_key_2 = EXCLUDED.node_key_2;
+
+-- name: GetZombieChannel :one
+SELECT *
+FROM graph_zombie_channels
+WHERE scid = $1;
+
+-- name: DeleteZombieChannel :execresult
+DELETE FROM graph_zombie_channels
+WHERE scid = $1;
+
+-- name: DeleteAllZombieChannels :exec
+DELETE FROM graph_zombie_channels;
+
+-- name: CountZombieChannels :one
+SELECT COUNT(*)
+FROM graph_zombie_channels;
+
+-- name: InsertClosedSCID :exec
+INSERT INTO graph_closed_scids (
+    scid
+) VALUES (
+    $1
+)
+ON CONFLICT (scid) DO NOTHING;
+
+-- name: DeleteAllClosedSCIDs :exec
+DELETE FROM graph_closed_scids;
+
+-- name: IsClosedSCID :one
+SELECT EXISTS (
+    SELECT 1
+    FROM graph_closed_scids
+    WHERE scid = $1
+);
+
+-- name: UpsertPruneLogEntry :exec
+INSERT INTO graph_prune_log (
+    block_height, block_hash
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (block_height) DO UPDATE SET
+    block_hash = EXCLUDED.block_hash;
+
+-- name: GetPruneTip :one
+SELECT *
+FROM graph_prune_log
+ORDER BY block_height DESC
+LIMIT 1;
+
+-- name: DeletePruneLogEntriesFrom :exec
+DELETE FROM graph_prune_log
+WHERE block_height >= $1;
@@ -35,6 +35,16 @@ const (
 	connIdleLifetime = 5 * time.Minute
 )
 
+var (
+	// sqliteSchemaReplacements is a map of schema strings that need to be
+	// replaced for sqlite. This is needed because sqlite only treats
+	// INTEGER PRIMARY KEY columns as an alias for the auto incrementing
+	// rowid, which is what we want for our BIGINT primary keys.
+	sqliteSchemaReplacements = map[string]string{
+		"BIGINT PRIMARY KEY": "INTEGER PRIMARY KEY",
+	}
+)
+
 // SqliteConfig holds all the config arguments needed to interact with our
 // sqlite DB.
 //
@@ -133,8 +143,9 @@ func NewSqliteStore(cfg *SqliteConfig) (*SqliteStore, error) {
 			return nil, err
 		}
 
+		sqliteFS := newReplacerFS(sqlSchemas, sqliteSchemaReplacements)
 		err = applyMigrations(
-			sqlSchemas, driver, "sqlc/migrations", "sqlc",
+			sqliteFS, driver, "sqlc/migrations", "sqlc",
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,45 @@
+package sqldb
+
+import (
+	"database/sql"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SQLInt64 turns a numerical integer type into the NullInt64 that sql/sqlc
+// uses when an integer field can be permitted to be NULL.
+//
+// We use this constraints.Integer constraint here which maps to all signed and
+// unsigned integer types.
+func SQLInt64[T constraints.Integer](num T) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(num),
+		Valid: true,
+	}
+}
+
+// SQLStr turns a string into the NullString that sql/sqlc uses when a string
+// can be permitted to be NULL.
+//
+// NOTE: If the input string is empty, it returns a NullString with Valid set
+// to false.
+func SQLStr(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: s,
+		Valid:  true,
+	}
+}
+
+// SQLTime turns a time.Time into the NullTime that sql/sqlc uses when a time
+// can be permitted to be NULL.
+func SQLTime(t time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  t,
+		Valid: true,
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/devrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/neutrinorpc"
+	"github.com/lightningnetwork/lnd/lnrpc/offersrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/onionmsgrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/peersrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
@@ -27,6 +29,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/offers"
+	"github.com/lightningnetwork/lnd/onionmessage"
+	"github.com/lightningnetwork/lnd/prober"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/watchtower"
@@ -67,6 +72,14 @@ type subRPCServerConfigs struct {
 	// as a gRPC service.
 	PeersRPC *peersrpc.Config `group:"peersrpc" namespace:"peersrpc"`
 
+	// OnionMsgRPC is a sub-RPC server that exposes methods to send and
+	// receive onion messages as a gRPC service.
+	OnionMsgRPC *onionmsgrpc.Config `group:"onionmsgrpc" namespace:"onionmsgrpc"`
+
+	// OffersRPC is a sub-RPC server that exposes methods to create and pay
+	// BOLT 12 offers as a gRPC service.
+	OffersRPC *offersrpc.Config `group:"offersrpc" namespace:"offersrpc"`
+
 	// NeutrinoKitRPC is a sub-RPC server that exposes functionality allowing
 	// a client to interact with a running neutrino node.
 	NeutrinoKitRPC *neutrinorpc.Config `group:"neutrinorpc" namespace:"neutrinorpc"`
@@ -123,7 +136,10 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 		modifiers ...netann.NodeAnnModifier) error,
 	parseAddr func(addr string) (net.Addr, error),
 	rpcLogger btclog.Logger,
-	getAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)) error {
+	getAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error),
+	onionMessenger *onionmessage.Messenger,
+	offersManager *offers.Manager,
+	prober *prober.Prober) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -316,6 +332,10 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 				reflect.ValueOf(graphDB),
 			)
 
+			subCfgValue.FieldByName("Switch").Set(
+				reflect.ValueOf(htlcSwitch),
+			)
+
 		case *peersrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
 
@@ -331,6 +351,20 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 				reflect.ValueOf(updateNodeAnnouncement),
 			)
 
+		case *onionmsgrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("OnionMessenger").Set(
+				reflect.ValueOf(onionMessenger),
+			)
+
+		case *offersrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("OffersManager").Set(
+				reflect.ValueOf(offersManager),
+			)
+
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
 				cfg)
@@ -342,6 +376,7 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	s.RouterRPC.MacService = macService
 	s.RouterRPC.Router = chanRouter
 	s.RouterRPC.RouterBackend = routerBackend
+	s.RouterRPC.Prober = prober
 
 	return nil
 }
@@ -28,6 +28,10 @@ type mockBackend struct {
 
 	walletUtxos []*lnwallet.Utxo
 	utxoCnt     int
+
+	// packageRelay indicates whether the backend supports the submission
+	// of packages.
+	packageRelay bool
 }
 
 func newMockBackend(t *testing.T, notifier *MockNotifier) *mockBackend {
@@ -87,6 +91,32 @@ func (b *mockBackend) PublishTransaction(tx *wire.MsgTx, _ string) error {
 	return err
 }
 
+// SubmitPackage simulates the submission of a package by publishing its
+// parents that aren't unconfirmed yet, followed by the child. Only the child
+// is sent to the publish channel.
+func (b *mockBackend) SubmitPackage(txs []*wire.MsgTx, _ string) error {
+	if !b.packageRelay {
+		return lnwallet.ErrPackageRelayUnsupported
+	}
+
+	parents, child := txs[:len(txs)-1], txs[len(txs)-1]
+	for _, parent := range parents {
+		b.lock.Lock()
+		_, ok := b.unconfirmedTxes[parent.TxHash()]
+		b.lock.Unlock()
+
+		if ok {
+			continue
+		}
+
+		if err := b.publishTransaction(parent); err != nil {
+			return err
+		}
+	}
+
+	return b.PublishTransaction(child, "")
+}
+
 func (b *mockBackend) ListUnspentWitnessFromDefaultAccount(minConfs, maxConfs int32) (
 	[]*lnwallet.Utxo, error) {
 
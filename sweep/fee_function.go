@@ -0,0 +1,92 @@
+package sweep
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// linearFeeFunction raises the fee rate of a sweep linearly with each new
+// block, from a starting fee rate at the height it was created to an ending
+// fee rate at the deadline of the sweep. Past the deadline, the ending fee rate
+// is used.
+type linearFeeFunction struct {
+	// startingFeeRate is the fee rate used at the start height.
+	startingFeeRate chainfee.SatPerKWeight
+
+	// endingFeeRate is the fee rate used from the deadline height onward.
+	// It's derived from the budget of the sweep.
+	endingFeeRate chainfee.SatPerKWeight
+
+	// startHeight is the height at which the fee function was created.
+	startHeight int32
+
+	// deadlineHeight is the height by which the sweep must be confirmed.
+	deadlineHeight int32
+}
+
+// newLinearFeeFunction creates a fee function that raises the fee rate from
+// the starting to the ending fee rate between the start and deadline heights.
+// If the starting fee rate exceeds the ending fee rate, the ending fee rate is
+// used throughout.
+func newLinearFeeFunction(startingFeeRate,
+	endingFeeRate chainfee.SatPerKWeight, startHeight,
+	deadlineHeight int32) *linearFeeFunction {
+
+	if startingFeeRate > endingFeeRate {
+		log.Debugf("Starting fee rate %v exceeds ending fee rate %v, "+
+			"using ending fee rate", startingFeeRate, endingFeeRate)
+
+		startingFeeRate = endingFeeRate
+	}
+
+	return &linearFeeFunction{
+		startingFeeRate: startingFeeRate,
+		endingFeeRate:   endingFeeRate,
+		startHeight:     startHeight,
+		deadlineHeight:  deadlineHeight,
+	}
+}
+
+// feeRate returns the fee rate of the sweep at the given height.
+func (l *linearFeeFunction) feeRate(height int32) chainfee.SatPerKWeight {
+	switch {
+	case height <= l.startHeight:
+		return l.startingFeeRate
+
+	case height >= l.deadlineHeight:
+		return l.endingFeeRate
+	}
+
+	// The fee rate is raised by an equal share of the difference between
+	// the starting and ending fee rates for each block that has passed.
+	width := int64(l.deadlineHeight - l.startHeight)
+	position := int64(height - l.startHeight)
+	delta := int64(l.endingFeeRate - l.startingFeeRate)
+
+	return l.startingFeeRate +
+		chainfee.SatPerKWeight(delta*position/width)
+}
+
+// budgetFeeRate returns the fee rate at which sweeping the input on its own
+// costs the given budget. The input takes up a smaller share of the weight of
+// a sweep transaction when it's batched with other inputs, so its fees never
+// exceed the budget at this fee rate.
+func budgetFeeRate(inp input.Input,
+	budget btcutil.Amount) (chainfee.SatPerKWeight, error) {
+
+	estimator := newWeightEstimator(0, 0)
+	if err := estimator.add(inp); err != nil {
+		return 0, err
+	}
+	if inp.RequiredTxOut() != nil {
+		estimator.addOutput(inp.RequiredTxOut())
+	}
+
+	// The input is swept to a taproot output of the wallet.
+	estimator.addP2TROutput()
+
+	weight := btcutil.Amount(estimator.weight())
+
+	return chainfee.SatPerKWeight(budget * 1000 / weight), nil
+}
@@ -0,0 +1,57 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLinearFeeFunction tests that the fee rate of the linear fee function is
+// raised with each block from the starting to the ending fee rate.
+func TestLinearFeeFunction(t *testing.T) {
+	t.Parallel()
+
+	f := newLinearFeeFunction(1000, 2000, 100, 110)
+
+	require.Equal(t, chainfee.SatPerKWeight(1000), f.feeRate(90))
+	require.Equal(t, chainfee.SatPerKWeight(1000), f.feeRate(100))
+	require.Equal(t, chainfee.SatPerKWeight(1100), f.feeRate(101))
+	require.Equal(t, chainfee.SatPerKWeight(1500), f.feeRate(105))
+	require.Equal(t, chainfee.SatPerKWeight(1900), f.feeRate(109))
+	require.Equal(t, chainfee.SatPerKWeight(2000), f.feeRate(110))
+	require.Equal(t, chainfee.SatPerKWeight(2000), f.feeRate(120))
+
+	// A starting fee rate above the ending fee rate is lowered to it.
+	f = newLinearFeeFunction(3000, 2000, 100, 110)
+	require.Equal(t, chainfee.SatPerKWeight(2000), f.feeRate(100))
+	require.Equal(t, chainfee.SatPerKWeight(2000), f.feeRate(105))
+
+	// If the deadline has already passed, the ending fee rate is used
+	// right away.
+	f = newLinearFeeFunction(1000, 2000, 100, 100)
+	require.Equal(t, chainfee.SatPerKWeight(2000), f.feeRate(101))
+}
+
+// TestBudgetFeeRate tests that sweeping an input on its own at its budget fee
+// rate doesn't exceed its budget.
+func TestBudgetFeeRate(t *testing.T) {
+	t.Parallel()
+
+	inp := createTestInput(100_000, input.HtlcAcceptedSuccessSecondLevel)
+	budget := btcutil.Amount(5_000)
+
+	feeRate, err := budgetFeeRate(&inp, budget)
+	require.NoError(t, err)
+
+	estimator := newWeightEstimator(feeRate, 0)
+	require.NoError(t, estimator.add(&inp))
+	estimator.addP2TROutput()
+
+	// The fee only falls short of the budget by the rounding of the fee
+	// rate.
+	require.LessOrEqual(t, estimator.fee(), budget)
+	require.GreaterOrEqual(t, estimator.fee(), budget-1)
+}
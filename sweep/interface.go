@@ -12,6 +12,13 @@ type Wallet interface {
 	// broadcasts the passed transaction to the Bitcoin network.
 	PublishTransaction(tx *wire.MsgTx, label string) error
 
+	// SubmitPackage submits a package of transactions to the mempool, in
+	// which a child pays for its unconfirmed parents. The parents come
+	// first in the package, and the child last. If the chain backend
+	// doesn't support package relay, lnwallet.ErrPackageRelayUnsupported
+	// is returned.
+	SubmitPackage(txs []*wire.MsgTx, label string) error
+
 	// ListUnspentWitnessFromDefaultAccount returns all unspent outputs
 	// which are version 0 witness programs from the default wallet account.
 	// The 'minConfs' and 'maxConfs' parameters indicate the minimum
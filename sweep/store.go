@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"io"
+	"sort"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -17,6 +22,12 @@ var (
 	// maps: txHash -> empty slice
 	txHashesBucketKey = []byte("sweeper-tx-hashes")
 
+	// sweepRecordsBucketKey is the key that points to a bucket containing
+	// a record of each publish attempt of a sweep tx.
+	//
+	// maps: txHash -> SweepRecord
+	sweepRecordsBucketKey = []byte("sweeper-sweep-records")
+
 	// utxnChainPrefix is the bucket prefix for nursery buckets.
 	utxnChainPrefix = []byte("utxn")
 
@@ -31,8 +42,56 @@ var (
 	byteOrder = binary.BigEndian
 
 	errNoTxHashesBucket = errors.New("tx hashes bucket does not exist")
+
+	errNoSweepRecordsBucket = errors.New("sweep records bucket does not " +
+		"exist")
 )
 
+// SweepInputRecord records an input of a published sweep tx.
+type SweepInputRecord struct {
+	// OutPoint is the outpoint of the input.
+	OutPoint wire.OutPoint
+
+	// WitnessType is the witness type of the input.
+	WitnessType input.StandardWitnessType
+
+	// Amount is the value of the input.
+	Amount btcutil.Amount
+
+	// ChanPoint is the channel point of the channel that the input
+	// belongs to. It's nil for inputs that don't belong to a channel, such
+	// as wallet inputs that were added to the sweep.
+	ChanPoint *wire.OutPoint
+}
+
+// SweepRecord records a publish attempt of a sweep tx.
+type SweepRecord struct {
+	// TxHash is the hash of the sweep tx.
+	TxHash chainhash.Hash
+
+	// Inputs are the inputs of the sweep tx.
+	Inputs []SweepInputRecord
+
+	// FeeRate is the fee rate of the sweep tx.
+	FeeRate chainfee.SatPerKWeight
+
+	// Fee is the fee paid by the sweep tx.
+	Fee btcutil.Amount
+
+	// Attempt is the number of the publish attempt, starting at one. If
+	// the inputs of the sweep tx were published a different number of
+	// times before, the highest number is recorded.
+	Attempt uint32
+
+	// PublishHeight is the height at which the sweep tx was published.
+	PublishHeight int32
+
+	// ConfirmHeight is the height at which the sweep tx confirmed. It's
+	// zero if the sweep tx hasn't confirmed, for example because it was
+	// replaced by a later attempt.
+	ConfirmHeight int32
+}
+
 // SweeperStore stores published txes.
 type SweeperStore interface {
 	// IsOurTx determines whether a tx is published by us, based on its
@@ -44,6 +103,18 @@ type SweeperStore interface {
 
 	// ListSweeps lists all the sweeps we have successfully published.
 	ListSweeps() ([]chainhash.Hash, error)
+
+	// AddSweepRecord signals that we are about to publish the sweep tx of
+	// the record, and stores the record of the publish attempt.
+	AddSweepRecord(record *SweepRecord) error
+
+	// MarkSweepConfirmed records the height at which a sweep tx confirmed.
+	// Sweep txes without a record are ignored.
+	MarkSweepConfirmed(hash chainhash.Hash, height int32) error
+
+	// ListSweepRecords lists the records of all publish attempts of sweep
+	// txes, ordered by publish height.
+	ListSweepRecords() ([]*SweepRecord, error)
 }
 
 type sweeperStore struct {
@@ -55,6 +126,13 @@ func NewSweeperStore(db kvdb.Backend, chainHash *chainhash.Hash) (
 	SweeperStore, error) {
 
 	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		// The sweep records bucket was added later, so it's created
+		// separately for existing stores.
+		_, err := tx.CreateTopLevelBucket(sweepRecordsBucketKey)
+		if err != nil {
+			return err
+		}
+
 		if tx.ReadWriteBucket(txHashesBucketKey) != nil {
 			return nil
 		}
@@ -215,5 +293,240 @@ func (s *sweeperStore) ListSweeps() ([]chainhash.Hash, error) {
 	return sweepTxns, nil
 }
 
+// AddSweepRecord signals that we are about to publish the sweep tx of the
+// record, and stores the record of the publish attempt.
+func (s *sweeperStore) AddSweepRecord(record *SweepRecord) error {
+	var b bytes.Buffer
+	if err := serializeSweepRecord(&b, record); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		txHashesBucket := tx.ReadWriteBucket(txHashesBucketKey)
+		if txHashesBucket == nil {
+			return errNoTxHashesBucket
+		}
+
+		recordsBucket := tx.ReadWriteBucket(sweepRecordsBucketKey)
+		if recordsBucket == nil {
+			return errNoSweepRecordsBucket
+		}
+
+		hash := record.TxHash
+		if err := txHashesBucket.Put(hash[:], []byte{}); err != nil {
+			return err
+		}
+
+		return recordsBucket.Put(hash[:], b.Bytes())
+	}, func() {})
+}
+
+// MarkSweepConfirmed records the height at which a sweep tx confirmed. Sweep
+// txes without a record are ignored.
+func (s *sweeperStore) MarkSweepConfirmed(hash chainhash.Hash,
+	height int32) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		recordsBucket := tx.ReadWriteBucket(sweepRecordsBucketKey)
+		if recordsBucket == nil {
+			return errNoSweepRecordsBucket
+		}
+
+		recordBytes := recordsBucket.Get(hash[:])
+		if recordBytes == nil {
+			return nil
+		}
+
+		record, err := deserializeSweepRecord(
+			bytes.NewReader(recordBytes),
+		)
+		if err != nil {
+			return err
+		}
+		record.ConfirmHeight = height
+
+		var b bytes.Buffer
+		if err := serializeSweepRecord(&b, record); err != nil {
+			return err
+		}
+
+		return recordsBucket.Put(hash[:], b.Bytes())
+	}, func() {})
+}
+
+// ListSweepRecords lists the records of all publish attempts of sweep txes,
+// ordered by publish height.
+func (s *sweeperStore) ListSweepRecords() ([]*SweepRecord, error) {
+	var records []*SweepRecord
+
+	if err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		recordsBucket := tx.ReadBucket(sweepRecordsBucketKey)
+		if recordsBucket == nil {
+			return errNoSweepRecordsBucket
+		}
+
+		return recordsBucket.ForEach(func(k, v []byte) error {
+			record, err := deserializeSweepRecord(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			copy(record.TxHash[:], k)
+
+			records = append(records, record)
+
+			return nil
+		})
+	}, func() {
+		records = nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sortSweepRecords(records)
+
+	return records, nil
+}
+
+// sortSweepRecords sorts sweep records by publish height, and by attempt for
+// records of the same height.
+func sortSweepRecords(records []*SweepRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].PublishHeight != records[j].PublishHeight {
+			return records[i].PublishHeight <
+				records[j].PublishHeight
+		}
+
+		return records[i].Attempt < records[j].Attempt
+	})
+}
+
+// writeOutPoint serializes an outpoint to the given writer.
+func writeOutPoint(w io.Writer, op *wire.OutPoint) error {
+	if _, err := w.Write(op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, op.Index)
+}
+
+// readOutPoint deserializes an outpoint from the given reader.
+func readOutPoint(r io.Reader, op *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, byteOrder, &op.Index)
+}
+
+// serializeSweepRecord serializes a sweep record to the given writer. The tx
+// hash isn't serialized, because it's the key of the record.
+func serializeSweepRecord(w io.Writer, record *SweepRecord) error {
+	err := binary.Write(w, byteOrder, []uint64{
+		uint64(record.FeeRate), uint64(record.Fee),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, []uint32{
+		record.Attempt, uint32(record.PublishHeight),
+		uint32(record.ConfirmHeight), uint32(len(record.Inputs)),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, inp := range record.Inputs {
+		if err := writeOutPoint(w, &inp.OutPoint); err != nil {
+			return err
+		}
+
+		err := binary.Write(w, byteOrder, uint16(inp.WitnessType))
+		if err != nil {
+			return err
+		}
+
+		err = binary.Write(w, byteOrder, uint64(inp.Amount))
+		if err != nil {
+			return err
+		}
+
+		hasChanPoint := inp.ChanPoint != nil
+		if err := binary.Write(w, byteOrder, hasChanPoint); err != nil {
+			return err
+		}
+
+		if hasChanPoint {
+			if err := writeOutPoint(w, inp.ChanPoint); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deserializeSweepRecord deserializes a sweep record from the given reader.
+// The tx hash of the record is derived from its key by the caller.
+func deserializeSweepRecord(r io.Reader) (*SweepRecord, error) {
+	var (
+		record  SweepRecord
+		amounts [2]uint64
+		fields  [4]uint32
+	)
+
+	if err := binary.Read(r, byteOrder, &amounts); err != nil {
+		return nil, err
+	}
+	record.FeeRate = chainfee.SatPerKWeight(amounts[0])
+	record.Fee = btcutil.Amount(amounts[1])
+
+	if err := binary.Read(r, byteOrder, &fields); err != nil {
+		return nil, err
+	}
+	record.Attempt = fields[0]
+	record.PublishHeight = int32(fields[1])
+	record.ConfirmHeight = int32(fields[2])
+
+	record.Inputs = make([]SweepInputRecord, fields[3])
+	for i := range record.Inputs {
+		inp := &record.Inputs[i]
+
+		if err := readOutPoint(r, &inp.OutPoint); err != nil {
+			return nil, err
+		}
+
+		var witnessType uint16
+		err := binary.Read(r, byteOrder, &witnessType)
+		if err != nil {
+			return nil, err
+		}
+		inp.WitnessType = input.StandardWitnessType(witnessType)
+
+		var amount uint64
+		if err := binary.Read(r, byteOrder, &amount); err != nil {
+			return nil, err
+		}
+		inp.Amount = btcutil.Amount(amount)
+
+		var hasChanPoint bool
+		err = binary.Read(r, byteOrder, &hasChanPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasChanPoint {
+			inp.ChanPoint = &wire.OutPoint{}
+			if err := readOutPoint(r, inp.ChanPoint); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &record, nil
+}
+
 // Compile-time constraint to ensure sweeperStore implements SweeperStore.
 var _ SweeperStore = (*sweeperStore)(nil)
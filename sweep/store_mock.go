@@ -9,12 +9,14 @@ import (
 // exported, because it is currently used in nursery tests too.
 type MockSweeperStore struct {
 	ourTxes map[chainhash.Hash]struct{}
+	records map[chainhash.Hash]*SweepRecord
 }
 
 // NewMockSweeperStore returns a new instance.
 func NewMockSweeperStore() *MockSweeperStore {
 	return &MockSweeperStore{
 		ourTxes: make(map[chainhash.Hash]struct{}),
+		records: make(map[chainhash.Hash]*SweepRecord),
 	}
 }
 
@@ -43,5 +45,42 @@ func (s *MockSweeperStore) ListSweeps() ([]chainhash.Hash, error) {
 	return txns, nil
 }
 
+// AddSweepRecord signals that we are about to publish the sweep tx of the
+// record, and stores the record of the publish attempt.
+func (s *MockSweeperStore) AddSweepRecord(record *SweepRecord) error {
+	s.ourTxes[record.TxHash] = struct{}{}
+
+	recordCopy := *record
+	s.records[record.TxHash] = &recordCopy
+
+	return nil
+}
+
+// MarkSweepConfirmed records the height at which a sweep tx confirmed. Sweep
+// txes without a record are ignored.
+func (s *MockSweeperStore) MarkSweepConfirmed(hash chainhash.Hash,
+	height int32) error {
+
+	if record, ok := s.records[hash]; ok {
+		record.ConfirmHeight = height
+	}
+
+	return nil
+}
+
+// ListSweepRecords lists the records of all publish attempts of sweep txes,
+// ordered by publish height.
+func (s *MockSweeperStore) ListSweepRecords() ([]*SweepRecord, error) {
+	var records []*SweepRecord
+	for _, record := range s.records {
+		recordCopy := *record
+		records = append(records, &recordCopy)
+	}
+
+	sortSweepRecords(records)
+
+	return records, nil
+}
+
 // Compile-time constraint to ensure MockSweeperStore implements SweeperStore.
 var _ SweeperStore = (*MockSweeperStore)(nil)
@@ -6,6 +6,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/stretchr/testify/require"
 )
 
@@ -122,4 +123,53 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 			t.Fatalf("unexpected tx: %v", tx)
 		}
 	}
+
+	// Add the records of two publish attempts, the second of which
+	// replaces the first.
+	chanPoint := wire.OutPoint{Index: 3}
+	record1 := &SweepRecord{
+		TxHash: chainhash.Hash{1},
+		Inputs: []SweepInputRecord{{
+			OutPoint:    wire.OutPoint{Index: 4},
+			WitnessType: input.HtlcAcceptedSuccessSecondLevel,
+			Amount:      10_000,
+			ChanPoint:   &chanPoint,
+		}, {
+			OutPoint:    wire.OutPoint{Index: 5},
+			WitnessType: input.TaprootPubKeySpend,
+			Amount:      20_000,
+		}},
+		FeeRate:       1000,
+		Fee:           500,
+		Attempt:       1,
+		PublishHeight: 100,
+	}
+	record2 := *record1
+	record2.TxHash = chainhash.Hash{2}
+	record2.FeeRate = 2000
+	record2.Fee = 1000
+	record2.Attempt = 2
+	record2.PublishHeight = 101
+
+	require.NoError(t, store.AddSweepRecord(&record2))
+	require.NoError(t, store.AddSweepRecord(record1))
+
+	// The second attempt confirms. Confirmations of txes without a record
+	// are ignored.
+	require.NoError(t, store.MarkSweepConfirmed(record2.TxHash, 103))
+	require.NoError(t, store.MarkSweepConfirmed(tx1.TxHash(), 103))
+
+	store, err = createStore()
+	require.NoError(t, err)
+
+	// The txes of the records are recognized as our own.
+	ours, err = store.IsOurTx(record1.TxHash)
+	require.NoError(t, err)
+	require.True(t, ours)
+
+	records, err := store.ListSweepRecords()
+	require.NoError(t, err)
+
+	record2.ConfirmHeight = 103
+	require.Equal(t, []*SweepRecord{record1, &record2}, records)
 }
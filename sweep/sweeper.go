@@ -81,6 +81,24 @@ type Params struct {
 	// ExclusiveGroup is an identifier that, if set, prevents other inputs
 	// with the same identifier from being batched together.
 	ExclusiveGroup *uint64
+
+	// DeadlineHeight is the height by which the input must be swept. If
+	// it's set, the fee rate of the input is raised with each new block,
+	// starting from the fee preference, or the fee estimate for the
+	// deadline if no fee preference is given, and the sweep is replaced
+	// until it confirms. If it's zero, the input has no deadline.
+	DeadlineHeight int32
+
+	// Budget is the maximum fee in satoshis that we're willing to pay to
+	// sweep the input. The fee rate of an input with a deadline reaches
+	// its budget at the deadline. If it's zero, the fee rate is only
+	// bounded by the maximum fee rate of the UtxoSweeper.
+	Budget btcutil.Amount
+
+	// ChanPoint is the channel point of the channel that the input belongs
+	// to, if any. It's only used to attribute the fees of the sweep to the
+	// channel in the sweep records of the store.
+	ChanPoint *wire.OutPoint
 }
 
 // ParamsUpdate contains a new set of parameters to update a pending sweep with.
@@ -97,13 +115,14 @@ type ParamsUpdate struct {
 
 // String returns a human readable interpretation of the sweep parameters.
 func (p Params) String() string {
+	exclusiveGroup := "nil"
 	if p.ExclusiveGroup != nil {
-		return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v",
-			p.Fee, p.Force, *p.ExclusiveGroup)
+		exclusiveGroup = fmt.Sprintf("%v", *p.ExclusiveGroup)
 	}
 
-	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=nil",
-		p.Fee, p.Force)
+	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v, "+
+		"deadline_height=%v, budget=%v", p.Fee, p.Force, exclusiveGroup,
+		p.DeadlineHeight, p.Budget)
 }
 
 // pendingInput is created when an input reaches the main loop for the first
@@ -134,6 +153,11 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate chainfee.SatPerKWeight
+
+	// feeFunc raises the fee rate of the input toward its budget as its
+	// deadline approaches. It's only set for inputs with a deadline, and
+	// is created the first time a fee rate is determined for the input.
+	feeFunc *linearFeeFunction
 }
 
 // parameters returns the sweep parameters for this input.
@@ -443,9 +467,13 @@ func (s *UtxoSweeper) SweepInput(input input.Input,
 		return nil, errors.New("nil input received")
 	}
 
-	// Ensure the client provided a sane fee preference.
-	if _, err := s.feeRateForPreference(params.Fee); err != nil {
-		return nil, err
+	// Ensure the client provided a sane fee preference. Inputs with a
+	// deadline may omit it, in which case the fee estimate for the
+	// deadline is used.
+	if params.DeadlineHeight == 0 || params.Fee != (FeePreference{}) {
+		if _, err := s.feeRateForPreference(params.Fee); err != nil {
+			return nil, err
+		}
 	}
 
 	absoluteTimeLock, _ := input.RequiredLockTime()
@@ -508,6 +536,89 @@ func (s *UtxoSweeper) feeRateForPreference(
 	return feeRate, nil
 }
 
+// feeRateForInput returns the fee rate to sweep the input with at the given
+// height. Inputs without a deadline use the fee rate of their fee preference,
+// while the fee rate of inputs with a deadline is determined by their fee
+// function.
+func (s *UtxoSweeper) feeRateForInput(input *pendingInput,
+	currentHeight int32) (chainfee.SatPerKWeight, error) {
+
+	params := input.params
+	if params.DeadlineHeight == 0 {
+		return s.feeRateForPreference(params.Fee)
+	}
+
+	if input.feeFunc == nil {
+		feeFunc, err := s.newFeeFunction(input, currentHeight)
+		if err != nil {
+			return 0, err
+		}
+		input.feeFunc = feeFunc
+	}
+
+	return input.feeFunc.feeRate(currentHeight), nil
+}
+
+// newFeeFunction creates the fee function of an input with a deadline. It
+// starts at the fee rate of the fee preference of the input, or at the fee
+// estimate for its deadline if it has none, and ends at the fee rate that
+// spends the budget of the input, or the maximum fee rate if it has no budget.
+func (s *UtxoSweeper) newFeeFunction(input *pendingInput,
+	currentHeight int32) (*linearFeeFunction, error) {
+
+	params := input.params
+
+	feePref := params.Fee
+	if feePref == (FeePreference{}) {
+		// The fee estimator requires a confirmation target of at
+		// least one block, which we also use once the deadline has
+		// passed.
+		confTarget := params.DeadlineHeight - currentHeight
+		if confTarget < 1 {
+			confTarget = 1
+		}
+
+		feePref.ConfTarget = uint32(confTarget)
+	}
+
+	startingFeeRate, err := s.feeRateForPreference(feePref)
+	if err != nil {
+		return nil, err
+	}
+
+	endingFeeRate := s.cfg.MaxFeeRate.FeePerKWeight()
+	if params.Budget != 0 {
+		budgetRate, err := budgetFeeRate(input, params.Budget)
+		if err != nil {
+			return nil, err
+		}
+
+		if budgetRate < endingFeeRate {
+			endingFeeRate = budgetRate
+		}
+	}
+
+	// A budget that doesn't cover the relay fee would leave the input
+	// unswept, so the relay fee rate is used instead.
+	if endingFeeRate < s.relayFeeRate {
+		log.Warnf("Budget %v of input %v is below the relay fee rate "+
+			"%v, using relay fee rate instead", params.Budget,
+			input.OutPoint(), s.relayFeeRate)
+
+		endingFeeRate = s.relayFeeRate
+	}
+
+	log.Debugf("Created fee function for input %v at height=%v: "+
+		"starting_fee_rate=%v, ending_fee_rate=%v, deadline_height=%v",
+		input.OutPoint(), currentHeight, startingFeeRate, endingFeeRate,
+		params.DeadlineHeight)
+
+	return newLinearFeeFunction(
+		startingFeeRate, endingFeeRate, currentHeight,
+		params.DeadlineHeight,
+	), nil
+}
+
 // removeLastSweepDescendants removes any transactions from the wallet that
 // spend outputs produced by the passed spendingTx. This needs to be done in
 // cases where we're not the only ones that can sweep an output, but there may
@@ -629,6 +740,16 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 					*prevExclGroup = *pendInput.params.ExclusiveGroup
 				}
 
+				// The fee function is recreated if the deadline
+				// or budget of the input changed.
+				if pendInput.params.DeadlineHeight !=
+					input.params.DeadlineHeight ||
+					pendInput.params.Budget !=
+						input.params.Budget {
+
+					pendInput.feeFunc = nil
+				}
+
 				// Update input details and sweep parameters.
 				// The re-offered input details may contain a
 				// change to the unconfirmed parent tx info.
@@ -701,6 +822,19 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 				continue
 			}
 
+			// Record the confirmation of our sweep, so that its
+			// record tells which of the publish attempts of its
+			// inputs confirmed.
+			if isOurTx {
+				err := s.cfg.Store.MarkSweepConfirmed(
+					spendHash, spend.SpendingHeight,
+				)
+				if err != nil {
+					log.Errorf("cannot mark tx %v as "+
+						"confirmed: %v", spendHash, err)
+				}
+			}
+
 			// If this isn't our transaction, it means someone else
 			// swept outputs that we were attempting to sweep. This
 			// can happen for anchor outputs as well as justice
@@ -795,7 +929,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			// this to ensure any inputs which have had their fee
 			// rate bumped are broadcast first in order enforce the
 			// RBF policy.
-			inputClusters := s.createInputClusters()
+			inputClusters := s.createInputClusters(bestHeight)
 			sort.Slice(inputClusters, func(i, j int) bool {
 				return inputClusters[i].sweepFeeRate >
 					inputClusters[j].sweepFeeRate
@@ -902,16 +1036,24 @@ func (s *UtxoSweeper) bucketForFeeRate(
 // inputs known by the UtxoSweeper. It clusters inputs by
 // 1) Required tx locktime
 // 2) Similar fee rates.
-func (s *UtxoSweeper) createInputClusters() []inputCluster {
+//
+// The fee rates of the inputs are determined at the given height.
+func (s *UtxoSweeper) createInputClusters(
+	currentHeight int32) []inputCluster {
+
 	inputs := s.pendingInputs
 
 	// We start by getting the inputs clusters by locktime. Since the
 	// inputs commit to the locktime, they can only be clustered together
 	// if the locktime is equal.
-	lockTimeClusters, nonLockTimeInputs := s.clusterByLockTime(inputs)
+	lockTimeClusters, nonLockTimeInputs := s.clusterByLockTime(
+		inputs, currentHeight,
+	)
 
 	// Cluster the the remaining inputs by sweep fee rate.
-	feeClusters := s.clusterBySweepFeeRate(nonLockTimeInputs)
+	feeClusters := s.clusterBySweepFeeRate(
+		nonLockTimeInputs, currentHeight,
+	)
 
 	// Since the inputs that we clustered by fee rate don't commit to a
 	// specific locktime, we can try to merge a locktime cluster with a fee
@@ -924,8 +1066,8 @@ func (s *UtxoSweeper) createInputClusters() []inputCluster {
 // is determined by calculating the average fee rate of all inputs within that
 // cluster. In addition to the created clusters, inputs that did not specify a
 // required lock time are returned.
-func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
-	pendingInputs) {
+func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs,
+	currentHeight int32) ([]inputCluster, pendingInputs) {
 
 	locktimes := make(map[uint32]pendingInputs)
 	rem := make(pendingInputs)
@@ -944,11 +1086,11 @@ func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
 			cluster = make(pendingInputs)
 		}
 
-		// Get the fee rate based on the fee preference. If an error is
-		// returned, we'll skip sweeping this input for this round of
-		// cluster creation and retry it when we create the clusters
-		// from the pending inputs again.
-		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		// Get the fee rate based on the fee preference or deadline. If
+		// an error is returned, we'll skip sweeping this input for
+		// this round of cluster creation and retry it when we create
+		// the clusters from the pending inputs again.
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
@@ -991,14 +1133,16 @@ func (s *UtxoSweeper) clusterByLockTime(inputs pendingInputs) ([]inputCluster,
 // and clusters those together with similar fee rates. Each cluster contains a
 // sweep fee rate, which is determined by calculating the average fee rate of
 // all inputs within that cluster.
-func (s *UtxoSweeper) clusterBySweepFeeRate(inputs pendingInputs) []inputCluster {
+func (s *UtxoSweeper) clusterBySweepFeeRate(inputs pendingInputs,
+	currentHeight int32) []inputCluster {
+
 	bucketInputs := make(map[int]*bucketList)
 	inputFeeRates := make(map[wire.OutPoint]chainfee.SatPerKWeight)
 
 	// First, we'll group together all inputs with similar fee rates. This
 	// is done by determining the fee rate bucket they should belong in.
 	for op, input := range inputs {
-		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
@@ -1164,7 +1308,7 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 	// We'll only start our timer once we have inputs we're able to sweep.
 	startTimer := false
-	for _, cluster := range s.createInputClusters() {
+	for _, cluster := range s.createInputClusters(currentHeight) {
 		// Examine pending inputs and try to construct lists of inputs.
 		// We don't need to obtain the coin selection lock, because we
 		// just need an indication as to whether we can sweep. More
@@ -1322,6 +1466,12 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		s.currentOutputScript = pkScript
 	}
 
+	// Ensure the sweep doesn't pay more than the budget of its inputs.
+	feeRate, err := s.capFeeRateToBudget(inputs, feeRate)
+	if err != nil {
+		return fmt.Errorf("cap fee rate to budget: %v", err)
+	}
+
 	// Create sweep tx.
 	tx, err := createSweepTx(
 		inputs, nil, s.currentOutputScript, uint32(currentHeight),
@@ -1335,10 +1485,12 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 	// by this tx is ours. Otherwise if the publish doesn't return, but did
 	// publish, we loose track of this tx. Even republication on startup
 	// doesn't prevent this, because that call returns a double spend error
-	// then and would also not add the hash to the store.
-	err = s.cfg.Store.NotifyPublishTx(tx)
+	// then and would also not add the hash to the store. The record of the
+	// publish attempt is stored along with it.
+	record := s.newSweepRecord(inputs, tx, feeRate, currentHeight)
+	err = s.cfg.Store.AddSweepRecord(record)
 	if err != nil {
-		return fmt.Errorf("notify publish tx: %v", err)
+		return fmt.Errorf("add sweep record: %v", err)
 	}
 
 	// Publish sweep tx.
@@ -1351,9 +1503,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		}),
 	)
 
-	err = s.cfg.Wallet.PublishTransaction(
-		tx, labels.MakeLabel(labels.LabelTypeSweepTransaction, nil),
-	)
+	err = s.publishSweep(tx, inputs)
 
 	// In case of an unexpected error, don't try to recover.
 	if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -1386,6 +1536,21 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		// Record another publish attempt.
 		pi.publishAttempts++
 
+		// Inputs with a deadline are swept again in the next block
+		// at the raised fee rate of their fee function, which
+		// replaces this sweep, until one of their sweeps confirms.
+		if pi.params.DeadlineHeight != 0 {
+			pi.minPublishHeight = currentHeight + 1
+
+			log.Debugf("Rescheduling input %v with deadline "+
+				"height %v after %v attempts at height %v",
+				input.PreviousOutPoint,
+				pi.params.DeadlineHeight, pi.publishAttempts,
+				pi.minPublishHeight)
+
+			continue
+		}
+
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
 		// needs to be retried. Call NextAttemptDeltaFunc to calculate
@@ -1417,6 +1582,176 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 	return nil
 }
 
+// publishSweep publishes a sweep tx. If any of its inputs has a known
+// unconfirmed parent, the sweep tx is submitted along with its parents as a
+// package, so that parents below the minimum fee rate of the mempool are
+// accepted along with it. If the package can't be submitted, the sweep tx is
+// published on its own, which succeeds if its parents are already in the
+// mempool or confirmed.
+func (s *UtxoSweeper) publishSweep(tx *wire.MsgTx, inputs inputSet) error {
+	label := labels.MakeLabel(labels.LabelTypeSweepTransaction, nil)
+
+	parents := unconfirmedParents(inputs)
+	if len(parents) == 0 {
+		return s.cfg.Wallet.PublishTransaction(tx, label)
+	}
+
+	log.Debugf("Submitting sweep tx %v as package with %v parents",
+		tx.TxHash(), len(parents))
+
+	err := s.cfg.Wallet.SubmitPackage(append(parents, tx), label)
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.Is(err, lnwallet.ErrPackageRelayUnsupported):
+		log.Debugf("Package relay unsupported, publishing sweep tx "+
+			"%v on its own", tx.TxHash())
+
+	default:
+		log.Warnf("Unable to submit package of sweep tx %v, "+
+			"publishing it on its own: %v", tx.TxHash(), err)
+	}
+
+	return s.cfg.Wallet.PublishTransaction(tx, label)
+}
+
+// unconfirmedParents returns the known unconfirmed parents of the given
+// inputs. Inputs that share a parent yield it only once.
+func unconfirmedParents(inputs inputSet) []*wire.MsgTx {
+	var (
+		parents []*wire.MsgTx
+		seen    = make(map[chainhash.Hash]struct{})
+	)
+	for _, inp := range inputs {
+		parent := inp.UnconfParent()
+		if parent == nil || parent.Tx == nil {
+			continue
+		}
+
+		hash := parent.Tx.TxHash()
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+
+		parents = append(parents, parent.Tx)
+	}
+
+	return parents
+}
+
+// newSweepRecord creates the record of a publish attempt of the sweep tx of
+// the given inputs.
+func (s *UtxoSweeper) newSweepRecord(inputs inputSet, tx *wire.MsgTx,
+	feeRate chainfee.SatPerKWeight, currentHeight int32) *SweepRecord {
+
+	record := &SweepRecord{
+		TxHash:        tx.TxHash(),
+		FeeRate:       feeRate,
+		Attempt:       1,
+		PublishHeight: currentHeight,
+	}
+
+	var totalInput btcutil.Amount
+	for _, inp := range inputs {
+		amt := btcutil.Amount(inp.SignDesc().Output.Value)
+		totalInput += amt
+
+		inpRecord := SweepInputRecord{
+			OutPoint: *inp.OutPoint(),
+			Amount:   amt,
+		}
+
+		// All the witness types of the input package are standard
+		// witness types.
+		witnessType, ok := inp.WitnessType().(input.StandardWitnessType)
+		if ok {
+			inpRecord.WitnessType = witnessType
+		}
+
+		// Wallet inputs that were added to the sweep aren't pending
+		// inputs, and don't belong to a channel.
+		pi, ok := s.pendingInputs[*inp.OutPoint()]
+		if ok {
+			inpRecord.ChanPoint = pi.params.ChanPoint
+
+			attempt := uint32(pi.publishAttempts) + 1
+			if attempt > record.Attempt {
+				record.Attempt = attempt
+			}
+		}
+
+		record.Inputs = append(record.Inputs, inpRecord)
+	}
+
+	var totalOutput btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		totalOutput += btcutil.Amount(txOut.Value)
+	}
+	record.Fee = totalInput - totalOutput
+
+	return record
+}
+
+// capFeeRateToBudget lowers the fee rate of a sweep of the given inputs, so
+// that its fee doesn't exceed the total budget of the inputs. The fee rate is
+// left unchanged if any of the inputs has no budget. Wallet inputs that are
+// added to the sweep don't have a budget, and are ignored.
+func (s *UtxoSweeper) capFeeRateToBudget(inputs inputSet,
+	feeRate chainfee.SatPerKWeight) (chainfee.SatPerKWeight, error) {
+
+	var budget btcutil.Amount
+	for _, inp := range inputs {
+		pi, ok := s.pendingInputs[*inp.OutPoint()]
+		if !ok {
+			continue
+		}
+
+		if pi.params.Budget == 0 {
+			return feeRate, nil
+		}
+
+		budget += pi.params.Budget
+	}
+
+	if budget == 0 {
+		return feeRate, nil
+	}
+
+	_, estimator, err := getWeightEstimate(
+		inputs, nil, feeRate, s.cfg.MaxFeeRate.FeePerKWeight(),
+		s.currentOutputScript,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if estimator.fee() <= budget {
+		return feeRate, nil
+	}
+
+	// The fee also pays for any unconfirmed parents of the inputs, so
+	// their weight and fee are accounted for in the fee rate at which the
+	// fee matches the budget.
+	totalWeight := int64(estimator.weight()) + estimator.parentsWeight
+	budgetRate := chainfee.SatPerKWeight(
+		(budget + estimator.parentsFee) * 1000 /
+			btcutil.Amount(totalWeight),
+	)
+
+	// A sweep below the relay fee rate wouldn't propagate, so we pay at
+	// least the relay fee.
+	if budgetRate < s.relayFeeRate {
+		budgetRate = s.relayFeeRate
+	}
+
+	log.Infof("Fee rate %v exceeds budget %v of %v inputs, using fee "+
+		"rate %v instead", feeRate, budget, len(inputs), budgetRate)
+
+	return budgetRate, nil
+}
+
 // waitForSpend registers a spend notification with the chain notifier. It
 // returns a cancel function that can be used to cancel the registration.
 func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
@@ -1581,6 +1916,10 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 
 	pendingInput.params = newParams
 
+	// The fee function of an input with a deadline is recreated from the
+	// new fee preference.
+	pendingInput.feeFunc = nil
+
 	// We'll reset the input's publish height to the current so that a new
 	// transaction can be created that replaces the transaction currently
 	// spending the input. We only do this for inputs that have been
@@ -1651,6 +1990,12 @@ func (s *UtxoSweeper) ListSweeps() ([]chainhash.Hash, error) {
 	return s.cfg.Store.ListSweeps()
 }
 
+// ListSweepRecords returns the records of all publish attempts of sweeps
+// recorded by the sweep store, ordered by publish height.
+func (s *UtxoSweeper) ListSweepRecords() ([]*SweepRecord, error) {
+	return s.cfg.Store.ListSweepRecords()
+}
+
 // init initializes the random generator for random input rescheduling.
 func init() {
 	rand.Seed(time.Now().Unix())
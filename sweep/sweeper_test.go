@@ -2,6 +2,7 @@ package sweep
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"reflect"
 	"runtime/debug"
@@ -1216,6 +1217,163 @@ func TestBumpFeeRBF(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestDeadlineFeeBump asserts that the sweep of an input with a deadline is
+// replaced in each new block, with its fee rate raised from the fee estimate
+// for the deadline toward its budget, and that it isn't given up on.
+func TestDeadlineFeeBump(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// The input must be swept within 10 blocks of the current height.
+	startFeeRate := chainfee.SatPerKWeight(1000)
+	ctx.estimator.blocksToFee[10] = startFeeRate
+
+	input := createTestInput(
+		btcutil.SatoshiPerBitcoin, input.CommitmentTimeLock,
+	)
+	budget := btcutil.Amount(50_000)
+	params := Params{
+		DeadlineHeight: mockChainHeight + 10,
+		Budget:         budget,
+	}
+	sweepResult, err := ctx.sweeper.SweepInput(&input, params)
+	require.NoError(t, err)
+
+	endFeeRate, err := budgetFeeRate(&input, budget)
+	require.NoError(t, err)
+
+	changePk, err := ctx.sweeper.cfg.GenSweepScript()
+	require.NoError(t, err)
+
+	// The first sweep uses the fee estimate for the deadline.
+	ctx.tick()
+	tx := ctx.receiveTx()
+	assertTxFeeRate(t, &tx, startFeeRate, changePk, &input)
+
+	// Halfway to the deadline, the fee rate is raised halfway toward the
+	// budget.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 5)
+	ctx.tick()
+	tx = ctx.receiveTx()
+	assertTxFeeRate(
+		t, &tx, startFeeRate+(endFeeRate-startFeeRate)/2, changePk,
+		&input,
+	)
+
+	// At the deadline, and past it, the budget is used. The input is
+	// still swept after exceeding the maximum number of attempts.
+	ctx.notifier.NotifyEpoch(mockChainHeight + 10)
+	ctx.tick()
+	tx = ctx.receiveTx()
+	assertTxFeeRate(t, &tx, endFeeRate, changePk, &input)
+
+	ctx.notifier.NotifyEpoch(mockChainHeight + 11)
+	ctx.tick()
+	tx = ctx.receiveTx()
+	assertTxFeeRate(t, &tx, endFeeRate, changePk, &input)
+
+	ctx.backend.mine()
+	ctx.expectResult(sweepResult, nil)
+
+	ctx.finish(1)
+}
+
+// TestBudget asserts that the fee of a sweep doesn't exceed the budget of its
+// inputs.
+func TestBudget(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	highFeePref := FeePreference{ConfTarget: 6}
+	ctx.estimator.blocksToFee[highFeePref.ConfTarget] =
+		DefaultMaxFeeRate.FeePerKWeight()
+
+	inp := createTestInput(
+		btcutil.SatoshiPerBitcoin, input.CommitmentTimeLock,
+	)
+	budget := btcutil.Amount(1_000)
+	sweepResult, err := ctx.sweeper.SweepInput(&inp, Params{
+		Fee:    highFeePref,
+		Budget: budget,
+	})
+	require.NoError(t, err)
+
+	changePk, err := ctx.sweeper.cfg.GenSweepScript()
+	require.NoError(t, err)
+
+	_, estimator, err := getWeightEstimate(
+		[]input.Input{&inp}, nil, 0, 0, changePk,
+	)
+	require.NoError(t, err)
+	budgetRate := chainfee.SatPerKWeight(
+		budget * 1000 / btcutil.Amount(estimator.weight()),
+	)
+
+	ctx.tick()
+	tx := ctx.receiveTx()
+	assertTxFeeRate(t, &tx, budgetRate, changePk, &inp)
+
+	ctx.backend.mine()
+	ctx.expectResult(sweepResult, nil)
+
+	ctx.finish(1)
+}
+
+// TestSweepRecords asserts that a record of each publish attempt of a sweep is
+// stored, with the inputs and fee of the attempt.
+func TestSweepRecords(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	ctx.estimator.blocksToFee[10] = 1000
+
+	inp := createTestInput(
+		btcutil.SatoshiPerBitcoin, input.CommitmentTimeLock,
+	)
+	chanPoint := wire.OutPoint{Index: 5}
+	sweepResult, err := ctx.sweeper.SweepInput(&inp, Params{
+		DeadlineHeight: mockChainHeight + 10,
+		Budget:         50_000,
+		ChanPoint:      &chanPoint,
+	})
+	require.NoError(t, err)
+
+	// The input is swept again at a higher fee rate in the next block.
+	ctx.tick()
+	tx1 := ctx.receiveTx()
+
+	ctx.notifier.NotifyEpoch(mockChainHeight + 1)
+	ctx.tick()
+	tx2 := ctx.receiveTx()
+
+	records, err := ctx.sweeper.ListSweepRecords()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	for i, tx := range []wire.MsgTx{tx1, tx2} {
+		record := records[i]
+
+		require.Equal(t, tx.TxHash(), record.TxHash)
+		require.EqualValues(t, i+1, record.Attempt)
+		require.Equal(t, mockChainHeight+int32(i), record.PublishHeight)
+		require.Zero(t, record.ConfirmHeight)
+
+		fee := btcutil.Amount(inp.SignDesc().Output.Value -
+			tx.TxOut[0].Value)
+		require.Equal(t, fee, record.Fee)
+
+		require.Equal(t, []SweepInputRecord{{
+			OutPoint:    *inp.OutPoint(),
+			WitnessType: input.CommitmentTimeLock,
+			Amount:      btcutil.SatoshiPerBitcoin,
+			ChanPoint:   &chanPoint,
+		}}, record.Inputs)
+	}
+	require.Greater(t, records[1].FeeRate, records[0].FeeRate)
+
+	ctx.backend.mine()
+	ctx.expectResult(sweepResult, nil)
+
+	ctx.finish(1)
+}
+
 // TestExclusiveGroup tests the sweeper exclusive group functionality.
 func TestExclusiveGroup(t *testing.T) {
 	ctx := createSweeperTestContext(t)
@@ -1344,6 +1502,73 @@ func TestCpfp(t *testing.T) {
 	ctx.finish(1)
 }
 
+// TestCpfpPackage tests that the sweep of an input with a known unconfirmed
+// parent is submitted along with the parent as a package, and that it's
+// published on its own if the backend doesn't support package relay.
+func TestCpfpPackage(t *testing.T) {
+	for _, packageRelay := range []bool{true, false} {
+		packageRelay := packageRelay
+
+		name := fmt.Sprintf("package relay=%v", packageRelay)
+		t.Run(name, func(t *testing.T) {
+			testCpfpPackage(t, packageRelay)
+		})
+	}
+}
+
+func testCpfpPackage(t *testing.T, packageRelay bool) {
+	ctx := createSweeperTestContext(t)
+	ctx.backend.packageRelay = packageRelay
+
+	ctx.estimator.updateFees(5000, chainfee.FeePerKwFloor)
+
+	// The parent tx pays 250 sat/kw, which is below the minimum relay fee
+	// rate, so it's only accepted along with its child.
+	parentTx := wire.NewMsgTx(2)
+	parentTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 1},
+	})
+	parentTx.AddTxOut(&wire.TxOut{Value: 330})
+
+	inp := input.MakeBaseInput(
+		&wire.OutPoint{Hash: parentTx.TxHash()},
+		input.CommitmentTimeLock,
+		&input.SignDescriptor{
+			Output: parentTx.TxOut[0],
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: testPubKey,
+			},
+		},
+		0,
+		&input.TxInfo{
+			Weight: 300,
+			Fee:    75,
+			Tx:     parentTx,
+		},
+	)
+
+	result, err := ctx.sweeper.SweepInput(&inp, Params{
+		Fee:   FeePreference{ConfTarget: 6},
+		Force: true,
+	})
+	require.NoError(t, err)
+
+	ctx.tick()
+	tx := ctx.receiveTx()
+	require.Equal(t, *inp.OutPoint(), tx.TxIn[0].PreviousOutPoint)
+
+	// The parent is only submitted if the backend supports package relay.
+	ctx.backend.lock.Lock()
+	_, ok := ctx.backend.unconfirmedTxes[parentTx.TxHash()]
+	ctx.backend.lock.Unlock()
+	require.Equal(t, packageRelay, ok)
+
+	ctx.backend.mine()
+	ctx.expectResult(result, nil)
+
+	ctx.finish(1)
+}
+
 var (
 	testInputsA = pendingInputs{
 		wire.OutPoint{Hash: chainhash.Hash{}, Index: 0}: &pendingInput{},
@@ -2515,7 +2740,9 @@ func TestClusterByLockTime(t *testing.T) {
 			applyFeeRate(tc.testFeeRate)
 
 			// Call the method under test.
-			clusters, remainingInputs := s.clusterByLockTime(inputs)
+			clusters, remainingInputs := s.clusterByLockTime(
+				inputs, 0,
+			)
 
 			// Sort by locktime as the order is not guaranteed.
 			sort.Slice(clusters, func(i, j int) bool {
@@ -130,15 +130,35 @@ func (w *weightEstimator) fee() btcutil.Amount {
 		return fee
 	}
 
-	// Clamp the fee to the max fee rate.
-	maxFee := w.maxFeeRate.FeeForWeight(childWeight)
+	// Clamp the fee to the max fee rate. The max fee rate applies to the
+	// package of this tx and its unconfirmed parents, as the child pays
+	// for its parents, which may be below the minimum fee rate of the
+	// mempool. If the parents already pay more than the max fee rate,
+	// this goes negative, so we clamp it at zero.
+	maxFee := w.maxFeeRate.FeeForWeight(totalWeight) - w.parentsFee
+	if maxFee < 0 {
+		maxFee = 0
+	}
+
+	// If the parents pay more than the max fee rate, they don't need a
+	// bump, so we skip the cpfp and only pay for this tx.
+	weight, paidFee := totalWeight, w.parentsFee
+	childMaxFee := w.maxFeeRate.FeeForWeight(childWeight)
+	if maxFee < childMaxFee {
+		log.Debugf("Parents pay more than max allowed fee rate %v, "+
+			"skipping cpfp", w.maxFeeRate)
+
+		fee, maxFee = childFee, childMaxFee
+		weight, paidFee = childWeight, 0
+	}
+
 	if fee > maxFee {
 		// Calculate the effective fee rate for logging.
-		childFeeRate := chainfee.SatPerKWeight(
-			fee * 1000 / btcutil.Amount(childWeight),
+		effectiveFeeRate := chainfee.SatPerKWeight(
+			(fee + paidFee) * 1000 / btcutil.Amount(weight),
 		)
-		log.Warnf("Child fee rate %v exceeds max allowed fee rate %v, "+
-			"returning fee %v instead of %v", childFeeRate,
+		log.Warnf("Fee rate %v exceeds max allowed fee rate %v, "+
+			"returning fee %v instead of %v", effectiveFeeRate,
 			w.maxFeeRate, maxFee, fee)
 
 		fee = maxFee
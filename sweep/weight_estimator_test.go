@@ -82,11 +82,12 @@ func TestWeightEstimator(t *testing.T) {
 }
 
 // TestWeightEstimatorMaxFee tests that the weight estimator correctly caps the
-// fee at the maximum allowed fee.
+// fee of the package of a tx and its unconfirmed parents at the maximum
+// allowed fee.
 func TestWeightEstimatorMaxFee(t *testing.T) {
 	t.Parallel()
 
-	testFeeRate := chainfee.SatPerKWeight(9_000)
+	testFeeRate := chainfee.SatPerKWeight(12_000)
 	maxFeeRate := chainfee.SatPerKWeight(10_000)
 
 	w := newWeightEstimator(testFeeRate, maxFeeRate)
@@ -113,12 +114,71 @@ func TestWeightEstimatorMaxFee(t *testing.T) {
 	//
 	// totalWeight = childWeight + parentWeight = 422
 	// fee = totalWeight * testFeeRate - parentsFee =
-	// 	422 * 9_000 / 1000 - 100 = 3598
-	// maxFee = childWeight * maxFeeRate = 422 * 10_000 / 1000 = 3220
+	// 	422 * 12_000 / 1000 - 100 = 4964
+	// maxFee = totalWeight * maxFeeRate - parentsFee =
+	// 	422 * 10_000 / 1000 - 100 = 4120
 	//
 	// Thus we cap at the maxFee.
-	expectedFee := maxFeeRate.FeeForWeight(childWeight)
+	const parentsWeight = 100
+	expectedFee := maxFeeRate.FeeForWeight(childWeight+parentsWeight) -
+		parentTxLowFee.Fee
 	require.Equal(t, expectedFee, w.fee())
+
+	// A package fee rate below the maximum isn't capped, even if the fee
+	// rate of the child on its own exceeds it.
+	//
+	// fee = 422 * 9_000 / 1000 - 100 = 3698
+	// childFeeRate = 3698 * 1000 / 322 = 11484
+	w.feeRate = 9_000
+	expectedFee = w.feeRate.FeeForWeight(childWeight+parentsWeight) -
+		parentTxLowFee.Fee
+	require.Equal(t, btcutil.Amount(3698), expectedFee)
+	require.Equal(t, expectedFee, w.fee())
+}
+
+// TestWeightEstimatorMaxFeeHighFeeParent tests that the weight estimator
+// skips the cpfp bump if the unconfirmed parents already pay more than the
+// maximum allowed fee rate, and only caps the fee of the child.
+func TestWeightEstimatorMaxFeeHighFeeParent(t *testing.T) {
+	t.Parallel()
+
+	testFeeRate := chainfee.SatPerKWeight(12_000)
+	maxFeeRate := chainfee.SatPerKWeight(10_000)
+
+	w := newWeightEstimator(testFeeRate, maxFeeRate)
+
+	// Define a parent transaction that pays a fee of 50_000 sat/kw, so
+	// that the fee left for the child under the max fee rate of the
+	// package is negative.
+	parentTxHighFee := &input.TxInfo{
+		Weight: 100,
+		Fee:    5_000,
+	}
+
+	childInput := input.MakeBaseInput(
+		&wire.OutPoint{}, input.CommitmentAnchor,
+		&input.SignDescriptor{}, 0, parentTxHighFee,
+	)
+	require.NoError(t, w.add(&childInput))
+
+	const childWeight = 322
+	require.Equal(t, childWeight, w.weight())
+
+	// The calculations,
+	//
+	// maxFee = totalWeight * maxFeeRate - parentsFee =
+	// 	422 * 10_000 / 1000 - 5000 = -780
+	// childFee = childWeight * testFeeRate = 322 * 12_000 / 1000 = 3864
+	// childMaxFee = childWeight * maxFeeRate = 322 * 10_000 / 1000 = 3220
+	//
+	// Thus the child only pays for itself, capped at the max fee rate.
+	require.Equal(t, btcutil.Amount(3220), w.fee())
+
+	// Below the max fee rate, the child pays its own fee rate.
+	//
+	// childFee = 322 * 9_000 / 1000 = 2898
+	w.feeRate = 9_000
+	require.Equal(t, btcutil.Amount(2898), w.fee())
 }
 
 // TestWeightEstimatorAddOutput tests that adding the raw P2WKH output to the
@@ -0,0 +1,757 @@
+// Package trampoline lets our node act as a trampoline node for payers that
+// don't know a route to the recipient of their payment. The payer sends the
+// payment to us and nests the instructions to reach the recipient in our onion
+// payload. Once all htlcs of the payment have arrived, we find a route to the
+// recipient and pay it through the regular payment lifecycle. The incoming
+// htlcs are settled with the preimage that the recipient revealed, or failed
+// back if the recipient can't be paid.
+package trampoline
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// DefaultBaseFee is the default base fee in millisatoshi that we
+	// charge for trampoline payments.
+	DefaultBaseFee = 1000
+
+	// DefaultFeeRate is the default proportional fee in parts per million
+	// that we charge for trampoline payments. The fee that payers pay on
+	// top of it is the budget for the route to the recipient.
+	DefaultFeeRate = 1000
+
+	// DefaultCltvDelta is the default time lock delta that we keep for
+	// ourselves between the incoming htlcs and the route to the recipient.
+	DefaultCltvDelta = 80
+
+	// DefaultMppTimeout is the default time that we wait for all htlcs of
+	// a trampoline payment to arrive.
+	DefaultMppTimeout = 2 * time.Minute
+
+	// DefaultMaxParts is the default number of parts that the payment to
+	// the recipient may be split into.
+	DefaultMaxParts = 16
+)
+
+var (
+	// errShuttingDown is returned when the forwarder is shutting down.
+	errShuttingDown = errors.New("trampoline forwarder shutting down")
+
+	// errNextTrampoline is returned when a trampoline onion instructs us
+	// to forward the payment to another trampoline node.
+	errNextTrampoline = errors.New("forwarding to another trampoline " +
+		"node isn't supported")
+)
+
+// Config holds the configuration of the trampoline forwarder.
+type Config struct {
+	// NodeKey is our node key, which we peel the trampoline onions of
+	// incoming htlcs with.
+	NodeKey keychain.SingleKeyECDH
+
+	// BaseFee is the base fee that we charge for trampoline payments.
+	BaseFee lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee in parts per million that we charge
+	// for trampoline payments.
+	FeeRate uint32
+
+	// CltvDelta is the time lock delta that we keep for ourselves between
+	// the incoming htlcs and the route to the recipient. The remaining
+	// time lock delta that the payer reserved for us is the budget for
+	// the route.
+	CltvDelta uint16
+
+	// MppTimeout is the time that we wait for all htlcs of a trampoline
+	// payment to arrive before we fail them back.
+	MppTimeout time.Duration
+
+	// MaxParts is the maximum number of parts that the payment to the
+	// recipient may be split into.
+	MaxParts uint32
+
+	// PayAttemptTimeout is the time after which we stop trying to find a
+	// route to the recipient.
+	PayAttemptTimeout time.Duration
+
+	// SendPayment launches the payment to the recipient without waiting
+	// for its result.
+	SendPayment func(*routing.LightningPayment) error
+
+	// SubscribePayment subscribes to the updates of the payment with the
+	// given hash. The current state of the payment is sent immediately.
+	SubscribePayment func(lntypes.Hash) (routing.ControlTowerSubscriber,
+		error)
+
+	// Clock is the time source of the forwarder.
+	Clock clock.Clock
+}
+
+// heldHtlc is an incoming htlc of a trampoline payment that hasn't been
+// resolved yet.
+type heldHtlc struct {
+	amt          lnwire.MilliSatoshi
+	expiry       uint32
+	acceptHeight int32
+
+	// hodlChan is the channel on which the resolution of the htlc is
+	// delivered. It is nil if the link of the htlc went away, in which
+	// case the resolution is handed out once the htlc is replayed.
+	hodlChan chan<- interface{}
+}
+
+// htlcSet is the set of incoming htlcs that make up a trampoline payment.
+type htlcSet struct {
+	hash        lntypes.Hash
+	paymentAddr [32]byte
+	total       lnwire.MilliSatoshi
+	payload     *record.TrampolinePayload
+
+	htlcs map[models.CircuitKey]*heldHtlc
+
+	// complete is closed once all htlcs of the set have arrived or the
+	// set was resolved before.
+	complete chan struct{}
+
+	// dispatched is true once the payment to the recipient was launched.
+	dispatched bool
+
+	// recovered is true if the set timed out, but the payment to the
+	// recipient was found to be launched before we restarted. The htlcs
+	// of the set that are replayed late are held until the payment
+	// resolves then.
+	recovered bool
+
+	// resolved is true once the outcome of the set is known. Either the
+	// preimage or the failure outcome is set then.
+	resolved bool
+	preimage lntypes.Preimage
+	outcome  invoices.FailResolutionResult
+}
+
+// received returns the sum of the amounts of the htlcs of the set.
+func (s *htlcSet) received() lnwire.MilliSatoshi {
+	var received lnwire.MilliSatoshi
+	for _, htlc := range s.htlcs {
+		received += htlc.amt
+	}
+
+	return received
+}
+
+// minExpiry returns the lowest expiry of the htlcs of the set.
+func (s *htlcSet) minExpiry() uint32 {
+	minExpiry := uint32(math.MaxUint32)
+	for _, htlc := range s.htlcs {
+		if htlc.expiry < minExpiry {
+			minExpiry = htlc.expiry
+		}
+	}
+
+	return minExpiry
+}
+
+// resolution returns the resolution of the given htlc of a resolved set.
+func (s *htlcSet) resolution(key models.CircuitKey,
+	htlc *heldHtlc) invoices.HtlcResolution {
+
+	if s.outcome != 0 {
+		return invoices.NewFailResolution(
+			key, htlc.acceptHeight, s.outcome,
+		)
+	}
+
+	return invoices.NewSettleResolution(
+		s.preimage, key, htlc.acceptHeight, invoices.ResultSettled,
+	)
+}
+
+// Forwarder gathers the incoming htlcs of trampoline payments, and pays their
+// recipients on behalf of the payers.
+type Forwarder struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// sets holds the htlc sets of the trampoline payments that haven't
+	// been fully resolved yet, by payment hash.
+	sets map[lntypes.Hash]*htlcSet
+	mu   sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewForwarder creates a new trampoline forwarder from the given config.
+func NewForwarder(cfg *Config) *Forwarder {
+	return &Forwarder{
+		cfg:  cfg,
+		sets: make(map[lntypes.Hash]*htlcSet),
+		quit: make(chan struct{}),
+	}
+}
+
+// peelOnion peels our layer off the trampoline onion of an htlc, which reveals
+// the instructions to pay the recipient. The payment hash is the associated
+// data of the onion.
+func (f *Forwarder) peelOnion(hash lntypes.Hash,
+	onion *hop.TrampolineOnionPacket) (*record.TrampolinePayload, error) {
+
+	payload, next, err := onion.Peel(f.cfg.NodeKey, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// We only pay recipients that don't support trampoline routing
+	// themselves, so we must be the last trampoline node.
+	if next != nil {
+		return nil, errNextTrampoline
+	}
+
+	return record.DecodeTrampolinePayload(bytes.NewReader(payload))
+}
+
+// Start starts the forwarder.
+func (f *Forwarder) Start() error {
+	f.started.Do(func() {
+		log.Infof("Trampoline forwarder starting, base_fee=%v, "+
+			"fee_rate=%v, cltv_delta=%v", f.cfg.BaseFee,
+			f.cfg.FeeRate, f.cfg.CltvDelta)
+	})
+
+	return nil
+}
+
+// Stop stops the forwarder. Payments that were launched continue in the
+// payment lifecycle, their incoming htlcs are resolved once they are
+// replayed after a restart.
+func (f *Forwarder) Stop() error {
+	f.stopped.Do(func() {
+		log.Info("Trampoline forwarder shutting down...")
+		defer log.Debug("Trampoline forwarder shutdown complete")
+
+		close(f.quit)
+		f.wg.Wait()
+	})
+
+	return nil
+}
+
+// NotifyTrampolineHtlc adds an incoming htlc that carries a trampoline onion
+// to the set of its payment, once it peeled the onion to find the recipient.
+// The return value describes how the htlc should be resolved. If the htlc
+// can't be resolved immediately, nil is returned and the resolution is sent on
+// the passed hodlChan later.
+func (f *Forwarder) NotifyTrampolineHtlc(hash lntypes.Hash,
+	amt lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
+	circuitKey models.CircuitKey, hodlChan chan<- interface{},
+	mpp *record.MPP, onion *hop.TrampolineOnionPacket) (
+	invoices.HtlcResolution, error) {
+
+	fail := func(outcome invoices.FailResolutionResult) (
+		invoices.HtlcResolution, error) {
+
+		log.Debugf("Failing trampoline htlc %v of payment %v: %v",
+			circuitKey, hash, outcome)
+
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight, outcome,
+		), nil
+	}
+
+	payload, err := f.peelOnion(hash, onion)
+	if err != nil {
+		log.Debugf("Unable to peel trampoline onion of htlc %v: %v",
+			circuitKey, err)
+
+		return fail(invoices.ResultTrampolineOnionInvalid)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	set, ok := f.sets[hash]
+
+	// If the htlc is replayed, for example because its link was
+	// restarted, we deliver the resolution to the new link.
+	if ok {
+		if htlc, ok := set.htlcs[circuitKey]; ok {
+			if !set.resolved {
+				htlc.hodlChan = hodlChan
+				return nil, nil
+			}
+
+			delete(set.htlcs, circuitKey)
+			if len(set.htlcs) == 0 {
+				delete(f.sets, hash)
+			}
+
+			return set.resolution(circuitKey, htlc), nil
+		}
+	}
+
+	// The payment of a recovered set may already have paid the
+	// recipient, so its late htlcs must share the outcome of the payment
+	// rather than being failed back.
+	if ok && set.recovered && mpp.PaymentAddr() == set.paymentAddr {
+		htlc := &heldHtlc{
+			amt:          amt,
+			expiry:       expiry,
+			acceptHeight: currentHeight,
+			hodlChan:     hodlChan,
+		}
+
+		if set.resolved {
+			return set.resolution(circuitKey, htlc), nil
+		}
+
+		log.Debugf("Holding late trampoline htlc %v of recovered "+
+			"payment %v", circuitKey, hash)
+
+		set.htlcs[circuitKey] = htlc
+
+		return nil, nil
+	}
+
+	switch {
+	// The payment to the recipient is already underway, no more htlcs
+	// can be added to it.
+	case ok && (set.dispatched || set.resolved):
+		return fail(invoices.ResultHtlcSetOverpayment)
+
+	case ok && mpp.PaymentAddr() != set.paymentAddr:
+		return fail(invoices.ResultAddressMismatch)
+
+	case ok && mpp.TotalMsat() != set.total:
+		return fail(invoices.ResultHtlcSetTotalMismatch)
+
+	// We only pay the full amount to the recipient, the payer can't pay
+	// parts of it through other trampoline nodes.
+	case payload.MPP.TotalMsat() != payload.AmtToForward:
+		return fail(invoices.ResultHtlcSetTotalMismatch)
+	}
+
+	if !ok {
+		set = &htlcSet{
+			hash:        hash,
+			paymentAddr: mpp.PaymentAddr(),
+			total:       mpp.TotalMsat(),
+			payload:     payload,
+			htlcs:       make(map[models.CircuitKey]*heldHtlc),
+			complete:    make(chan struct{}),
+		}
+		f.sets[hash] = set
+
+		f.wg.Add(1)
+		go f.waitForSet(set)
+	}
+
+	set.htlcs[circuitKey] = &heldHtlc{
+		amt:          amt,
+		expiry:       expiry,
+		acceptHeight: currentHeight,
+		hodlChan:     hodlChan,
+	}
+
+	received := set.received()
+	if received < set.total {
+		log.Debugf("Holding trampoline htlc %v of payment %v, "+
+			"received %v of %v", circuitKey, hash, received,
+			set.total)
+
+		return nil, nil
+	}
+
+	close(set.complete)
+
+	// Now that all htlcs have arrived, make sure that they pay our fee
+	// and leave us enough time to pay the recipient.
+	fee := received - payload.AmtToForward
+	minFee := f.cfg.BaseFee +
+		payload.AmtToForward*lnwire.MilliSatoshi(f.cfg.FeeRate)/1e6
+
+	minExpiry := set.minExpiry()
+	finalCltvDelta := int64(payload.OutgoingCltv) -
+		int64(currentHeight) - int64(routing.BlockPadding)
+
+	switch {
+	case received < payload.AmtToForward || fee < minFee:
+		return f.resolveSet(
+			set, circuitKey,
+			invoices.ResultTrampolineFeeInsufficient,
+		)
+
+	case minExpiry < payload.OutgoingCltv+uint32(f.cfg.CltvDelta),
+		finalCltvDelta <= 0, finalCltvDelta > math.MaxUint16:
+
+		return f.resolveSet(
+			set, circuitKey, invoices.ResultTrampolineExpiryTooSoon,
+		)
+	}
+
+	payment, err := f.newPayment(
+		set, fee-minFee, minExpiry, uint32(currentHeight),
+		uint16(finalCltvDelta),
+	)
+	if err != nil {
+		log.Errorf("Unable to create trampoline payment %v: %v", hash,
+			err)
+
+		return f.resolveSet(
+			set, circuitKey, invoices.ResultTrampolineFailed,
+		)
+	}
+
+	log.Infof("Paying %v to %x for trampoline payment %v", payment.Amount,
+		payment.Target[:], hash)
+
+	set.dispatched = true
+
+	f.wg.Add(1)
+	go f.pay(set, payment)
+
+	return nil, nil
+}
+
+// HodlUnsubscribeAll removes the given subscriber from all held htlcs. Their
+// resolutions are handed out once the htlcs are replayed.
+func (f *Forwarder) HodlUnsubscribeAll(subscriber chan<- interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, set := range f.sets {
+		for _, htlc := range set.htlcs {
+			if htlc.hodlChan == subscriber {
+				htlc.hodlChan = nil
+			}
+		}
+	}
+}
+
+// newPayment creates the payment to the recipient of a complete htlc set.
+func (f *Forwarder) newPayment(set *htlcSet, feeLimit lnwire.MilliSatoshi,
+	minExpiry, currentHeight uint32,
+	finalCltvDelta uint16) (*routing.LightningPayment, error) {
+
+	payload := set.payload
+
+	routeHints := make([][]zpay32.HopHint, 0, len(payload.RouteHints))
+	for _, routeHint := range payload.RouteHints {
+		hint := make([]zpay32.HopHint, 0, len(routeHint))
+		for _, hop := range routeHint {
+			hint = append(hint, zpay32.HopHint{
+				NodeID:          hop.NodeID,
+				ChannelID:       hop.ChannelID,
+				FeeBaseMSat:     hop.FeeBaseMSat,
+				CLTVExpiryDelta: hop.CLTVExpiryDelta,
+				FeeProportionalMillionths: hop.
+					FeeProportionalMillionths,
+			})
+		}
+
+		routeHints = append(routeHints, hint)
+	}
+
+	paymentAddr := payload.MPP.PaymentAddr()
+	payment := &routing.LightningPayment{
+		Target:   route.NewVertex(payload.NextNode),
+		Amount:   payload.AmtToForward,
+		FeeLimit: feeLimit,
+
+		// The route to the recipient may use the time between the
+		// expiry of the incoming htlcs and our cltv delta.
+		CltvLimit: minExpiry - uint32(f.cfg.CltvDelta) -
+			currentHeight,
+
+		FinalCLTVDelta:    finalCltvDelta,
+		PayAttemptTimeout: f.cfg.PayAttemptTimeout,
+		RouteHints:        routeHints,
+		DestFeatures:      payload.Features,
+		PaymentAddr:       &paymentAddr,
+		MaxParts:          f.cfg.MaxParts,
+	}
+
+	if err := payment.SetPaymentHash(set.hash); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// waitForSet fails the htlcs of the set back if not all of them arrive
+// within the mpp timeout. Since htlc sets only live in memory, the htlcs of a
+// set that was paid out before we restarted may not all be replayed in time.
+// If the payment to the recipient exists and hasn't failed, the htlcs are
+// therefore held until the payment resolves instead.
+func (f *Forwarder) waitForSet(set *htlcSet) {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case <-f.cfg.Clock.TickAfter(f.cfg.MppTimeout):
+		case <-set.complete:
+			return
+		case <-f.quit:
+			return
+		}
+
+		inFlight, err := f.paymentInFlight(set.hash)
+		switch {
+		case errors.Is(err, errShuttingDown):
+			return
+
+		// We can't tell whether the recipient was paid, so we don't
+		// dare to fail the htlcs back and check again later.
+		case err != nil:
+			log.Errorf("Unable to look up trampoline payment %v: "+
+				"%v", set.hash, err)
+
+			continue
+		}
+
+		f.mu.Lock()
+
+		// The set may have completed while we were waiting for the
+		// lock.
+		select {
+		case <-set.complete:
+			f.mu.Unlock()
+			return
+		default:
+		}
+
+		close(set.complete)
+
+		if inFlight {
+			log.Infof("Trampoline payment %v timed out with %v of "+
+				"%v received, but was launched before, "+
+				"holding htlcs until it resolves", set.hash,
+				set.received(), set.total)
+
+			set.dispatched = true
+			set.recovered = true
+
+			f.wg.Add(1)
+			go f.pay(set, nil)
+
+			f.mu.Unlock()
+			return
+		}
+
+		log.Debugf("Trampoline payment %v timed out with %v of %v "+
+			"received", set.hash, set.received(), set.total)
+
+		_, _ = f.resolveSet(
+			set, models.CircuitKey{}, invoices.ResultMppTimeout,
+		)
+		f.mu.Unlock()
+
+		return
+	}
+}
+
+// paymentInFlight returns true if the payment with the given hash was
+// launched and hasn't failed.
+func (f *Forwarder) paymentInFlight(hash lntypes.Hash) (bool, error) {
+	sub, err := f.cfg.SubscribePayment(hash)
+	if errors.Is(err, channeldb.ErrPaymentNotInitiated) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer sub.Close()
+
+	// The current state of the payment is sent immediately.
+	select {
+	case update, ok := <-sub.Updates():
+		if !ok {
+			return false, errors.New("payment subscription closed")
+		}
+
+		mpp, ok := update.(*channeldb.MPPayment)
+		if !ok {
+			return false, fmt.Errorf("unexpected payment update "+
+				"%T", update)
+		}
+
+		return mpp.Status != channeldb.StatusFailed, nil
+
+	case <-f.quit:
+		return false, errShuttingDown
+	}
+}
+
+// pay launches the payment to the recipient, unless it was launched before we
+// restarted, and resolves the htlc set with its result. If the payment is
+// nil, only the result of the payment that was launched before is awaited.
+func (f *Forwarder) pay(set *htlcSet, payment *routing.LightningPayment) {
+	defer f.wg.Done()
+
+	preimage, err := f.sendPayment(set.hash, payment)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case errors.Is(err, errShuttingDown):
+		return
+
+	case err != nil:
+		log.Errorf("Trampoline payment %v failed: %v", set.hash, err)
+
+		_, _ = f.resolveSet(
+			set, models.CircuitKey{},
+			invoices.ResultTrampolineFailed,
+		)
+
+	default:
+		log.Infof("Trampoline payment %v succeeded", set.hash)
+
+		set.preimage = *preimage
+		_, _ = f.resolveSet(set, models.CircuitKey{}, 0)
+	}
+}
+
+// sendPayment pays the recipient and waits for the result of the payment. If
+// the payment was launched before, for example because we restarted while it
+// was in flight, we wait for the result of that payment instead.
+func (f *Forwarder) sendPayment(hash lntypes.Hash,
+	payment *routing.LightningPayment) (*lntypes.Preimage, error) {
+
+	var launched bool
+	sub, err := f.cfg.SubscribePayment(hash)
+	if errors.Is(err, channeldb.ErrPaymentNotInitiated) && payment != nil {
+		launched = true
+		sub, err = f.launchPayment(hash, payment)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return nil, errors.New("payment subscription " +
+					"closed")
+			}
+
+			mpp, ok := update.(*channeldb.MPPayment)
+			if !ok {
+				continue
+			}
+
+			switch mpp.Status {
+			case channeldb.StatusSucceeded:
+				sub.Close()
+
+				settle, _ := mpp.TerminalInfo()
+				if settle == nil {
+					return nil, errors.New("settled " +
+						"payment without preimage")
+				}
+
+				return &settle.Preimage, nil
+
+			case channeldb.StatusFailed:
+				sub.Close()
+
+				if launched || payment == nil {
+					return nil, fmt.Errorf("payment "+
+						"failed: %v", mpp.FailureReason)
+				}
+
+				// A payment that failed before we were
+				// restarted, or that was made for an earlier
+				// set of htlcs, is retried once.
+				launched = true
+				sub, err = f.launchPayment(hash, payment)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+		case <-f.quit:
+			sub.Close()
+
+			return nil, errShuttingDown
+		}
+	}
+}
+
+// launchPayment sends the payment and subscribes to its updates.
+func (f *Forwarder) launchPayment(hash lntypes.Hash,
+	payment *routing.LightningPayment) (routing.ControlTowerSubscriber,
+	error) {
+
+	if err := f.cfg.SendPayment(payment); err != nil {
+		return nil, err
+	}
+
+	return f.cfg.SubscribePayment(hash)
+}
+
+// resolveSet resolves all htlcs of the set with the given outcome, or settles
+// them with the preimage of the set if the outcome is zero. The resolution of
+// the htlc with the given circuit key is returned instead of being sent to its
+// subscriber.
+//
+// NOTE: The caller must hold the lock of the forwarder.
+func (f *Forwarder) resolveSet(set *htlcSet, circuitKey models.CircuitKey,
+	outcome invoices.FailResolutionResult) (invoices.HtlcResolution,
+	error) {
+
+	set.resolved = true
+	set.outcome = outcome
+
+	var resolution invoices.HtlcResolution
+	for key, htlc := range set.htlcs {
+		if key == circuitKey {
+			resolution = set.resolution(key, htlc)
+			delete(set.htlcs, key)
+
+			continue
+		}
+
+		// If the link of the htlc went away, the resolution is handed
+		// out once the htlc is replayed.
+		if htlc.hodlChan == nil {
+			continue
+		}
+
+		select {
+		case htlc.hodlChan <- set.resolution(key, htlc):
+		case <-f.quit:
+			return nil, errShuttingDown
+		}
+
+		delete(set.htlcs, key)
+	}
+
+	if len(set.htlcs) == 0 {
+		delete(f.sets, set.hash)
+	}
+
+	return resolution, nil
+}
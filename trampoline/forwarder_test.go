@@ -0,0 +1,490 @@
+package trampoline
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channeldb/models"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHeight    = 900
+	testCltv      = 1_000
+	testExpiry    = 1_200
+	testAmt       = lnwire.MilliSatoshi(100_000)
+	testTotal     = lnwire.MilliSatoshi(102_000)
+	testHalf      = testTotal / 2
+	testFeeLimit  = testTotal - testAmt - 1_100
+	testCltvLimit = testExpiry - DefaultCltvDelta - testHeight
+)
+
+var (
+	testTimeout = 5 * time.Second
+
+	testPreimage = lntypes.Preimage{1}
+	testHash     = testPreimage.Hash()
+
+	testOuterAddr = [32]byte{1}
+	testInnerAddr = [32]byte{2}
+)
+
+// mockSubscriber delivers the payment updates of the mock payer.
+type mockSubscriber struct {
+	updates chan interface{}
+}
+
+func (m *mockSubscriber) Updates() <-chan interface{} {
+	return m.updates
+}
+
+func (m *mockSubscriber) Close() {}
+
+// mockPayer records the payments that the forwarder sends, and lets the test
+// deliver their updates.
+type mockPayer struct {
+	mu        sync.Mutex
+	initiated bool
+
+	payments chan *routing.LightningPayment
+	updates  chan interface{}
+}
+
+func newMockPayer() *mockPayer {
+	return &mockPayer{
+		payments: make(chan *routing.LightningPayment, 1),
+		updates:  make(chan interface{}, 1),
+	}
+}
+
+func (m *mockPayer) SendPayment(payment *routing.LightningPayment) error {
+	m.mu.Lock()
+	m.initiated = true
+	m.mu.Unlock()
+
+	m.payments <- payment
+
+	return nil
+}
+
+func (m *mockPayer) SubscribePayment(
+	lntypes.Hash) (routing.ControlTowerSubscriber, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.initiated {
+		return nil, channeldb.ErrPaymentNotInitiated
+	}
+
+	return &mockSubscriber{updates: m.updates}, nil
+}
+
+// expectPayment asserts that a payment was sent and returns it.
+func (m *mockPayer) expectPayment(t *testing.T) *routing.LightningPayment {
+	t.Helper()
+
+	select {
+	case payment := <-m.payments:
+		return payment
+
+	case <-time.After(testTimeout):
+		t.Fatal("no payment sent")
+	}
+
+	return nil
+}
+
+// forwarderTestContext holds a forwarder and its mocked dependencies.
+type forwarderTestContext struct {
+	t          *testing.T
+	forwarder  *Forwarder
+	payer      *mockPayer
+	clock      *clock.TestClock
+	tickSignal chan time.Duration
+	nodeKey    *btcec.PrivateKey
+	payload    *record.TrampolinePayload
+}
+
+func newForwarderTestContext(t *testing.T) *forwarderTestContext {
+	t.Helper()
+
+	recipient, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	tickSignal := make(chan time.Duration, 1)
+	testClock := clock.NewTestClockWithTickSignal(time.Unix(1, 0),
+		tickSignal)
+
+	payer := newMockPayer()
+	forwarder := NewForwarder(&Config{
+		NodeKey:          &keychain.PrivKeyECDH{PrivKey: nodeKey},
+		BaseFee:          DefaultBaseFee,
+		FeeRate:          DefaultFeeRate,
+		CltvDelta:        DefaultCltvDelta,
+		MppTimeout:       DefaultMppTimeout,
+		MaxParts:         DefaultMaxParts,
+		SendPayment:      payer.SendPayment,
+		SubscribePayment: payer.SubscribePayment,
+		Clock:            testClock,
+	})
+	require.NoError(t, forwarder.Start())
+	t.Cleanup(func() {
+		require.NoError(t, forwarder.Stop())
+	})
+
+	return &forwarderTestContext{
+		t:          t,
+		forwarder:  forwarder,
+		payer:      payer,
+		clock:      testClock,
+		tickSignal: tickSignal,
+		nodeKey:    nodeKey,
+		payload: &record.TrampolinePayload{
+			AmtToForward: testAmt,
+			OutgoingCltv: testCltv,
+			NextNode:     recipient.PubKey(),
+			MPP:          record.NewMPP(testAmt, testInnerAddr),
+		},
+	}
+}
+
+// onion creates a trampoline onion that holds the test payload for the
+// passed route of trampoline nodes.
+func (c *forwarderTestContext) onion(
+	nodeKeys ...*btcec.PublicKey) *hop.TrampolineOnionPacket {
+
+	c.t.Helper()
+
+	payload, err := record.EncodeTrampolinePayload(c.payload)
+	require.NoError(c.t, err)
+
+	hops := make([]hop.TrampolineHop, 0, len(nodeKeys))
+	for _, nodeKey := range nodeKeys {
+		hops = append(hops, hop.TrampolineHop{
+			NodeKey: nodeKey,
+			Payload: payload,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(c.t, err)
+
+	packet, err := hop.NewTrampolineOnion(hops, sessionKey, testHash[:])
+	require.NoError(c.t, err)
+
+	return packet
+}
+
+// notify hands an htlc of the test payment to the forwarder.
+func (c *forwarderTestContext) notify(htlcID uint64, amt lnwire.MilliSatoshi,
+	expiry uint32, hodlChan chan<- interface{}) invoices.HtlcResolution {
+
+	c.t.Helper()
+
+	return c.notifyOnion(
+		htlcID, amt, expiry, hodlChan, c.onion(c.nodeKey.PubKey()),
+	)
+}
+
+// notifyOnion hands an htlc of the test payment with the given trampoline
+// onion to the forwarder.
+func (c *forwarderTestContext) notifyOnion(htlcID uint64,
+	amt lnwire.MilliSatoshi, expiry uint32, hodlChan chan<- interface{},
+	onion *hop.TrampolineOnionPacket) invoices.HtlcResolution {
+
+	c.t.Helper()
+
+	resolution, err := c.forwarder.NotifyTrampolineHtlc(
+		testHash, amt, expiry, testHeight,
+		models.CircuitKey{HtlcID: htlcID}, hodlChan,
+		record.NewMPP(testTotal, testOuterAddr), onion,
+	)
+	require.NoError(c.t, err)
+
+	return resolution
+}
+
+// expectResolution asserts that a resolution is delivered on the given hodl
+// channel and returns it.
+func expectResolution(t *testing.T,
+	hodlChan chan interface{}) invoices.HtlcResolution {
+
+	t.Helper()
+
+	select {
+	case resolution := <-hodlChan:
+		return resolution.(invoices.HtlcResolution)
+
+	case <-time.After(testTimeout):
+		t.Fatal("no resolution delivered")
+	}
+
+	return nil
+}
+
+// requireFailure asserts that the resolution fails the htlc with the given
+// outcome.
+func requireFailure(t *testing.T, resolution invoices.HtlcResolution,
+	outcome invoices.FailResolutionResult) {
+
+	t.Helper()
+
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok, "expected fail resolution, got %T", resolution)
+	require.Equal(t, outcome, fail.Outcome)
+}
+
+// TestForwarderSettle tests that the htlcs of a trampoline payment are held
+// until all of them have arrived, and that they are settled once the
+// recipient is paid.
+func TestForwarderSettle(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	hodlChan1 := make(chan interface{}, 1)
+	hodlChan2 := make(chan interface{}, 1)
+
+	require.Nil(t, ctx.notify(1, testHalf, testExpiry, hodlChan1))
+	require.Nil(t, ctx.notify(2, testHalf, testExpiry, hodlChan2))
+
+	// The recipient is paid with the instructions of the trampoline
+	// payload, the fee that remains after our own fee is the budget for
+	// the route.
+	payment := ctx.payer.expectPayment(t)
+	require.Equal(t, route.NewVertex(ctx.payload.NextNode), payment.Target)
+	require.Equal(t, testAmt, payment.Amount)
+	require.Equal(t, testFeeLimit, payment.FeeLimit)
+	require.EqualValues(t, testCltvLimit, payment.CltvLimit)
+	require.EqualValues(t, testCltv-testHeight-routing.BlockPadding,
+		payment.FinalCLTVDelta)
+	require.Equal(t, testInnerAddr, *payment.PaymentAddr)
+	require.Equal(t, [32]byte(testHash), payment.Identifier())
+
+	// Another htlc can't be added to a payment that is underway.
+	hodlChan3 := make(chan interface{}, 1)
+	requireFailure(
+		t, ctx.notify(3, testHalf, testExpiry, hodlChan3),
+		invoices.ResultHtlcSetOverpayment,
+	)
+
+	ctx.payer.updates <- &channeldb.MPPayment{
+		Status: channeldb.StatusSucceeded,
+		HTLCs: []channeldb.HTLCAttempt{{
+			Settle: &channeldb.HTLCSettleInfo{
+				Preimage: testPreimage,
+			},
+		}},
+	}
+
+	for _, hodlChan := range []chan interface{}{hodlChan1, hodlChan2} {
+		resolution := expectResolution(t, hodlChan)
+
+		settle, ok := resolution.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, testPreimage, settle.Preimage)
+	}
+}
+
+// TestForwarderInsufficient tests that trampoline payments that don't pay our
+// fee or don't leave us enough time to pay the recipient are failed.
+func TestForwarderInsufficient(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	hodlChan1 := make(chan interface{}, 1)
+	hodlChan2 := make(chan interface{}, 1)
+
+	// The htlc that completes the set receives the resolution directly.
+	require.Nil(t, ctx.notify(1, testHalf, testExpiry, hodlChan1))
+	requireFailure(
+		t, ctx.notify(2, testHalf, testCltv+1, hodlChan2),
+		invoices.ResultTrampolineExpiryTooSoon,
+	)
+	requireFailure(
+		t, expectResolution(t, hodlChan1),
+		invoices.ResultTrampolineExpiryTooSoon,
+	)
+
+	ctx = newForwarderTestContext(t)
+	ctx.payload.AmtToForward = testTotal - 1_000
+	ctx.payload.MPP = record.NewMPP(testTotal-1_000, testInnerAddr)
+
+	requireFailure(
+		t, ctx.notify(1, testTotal, testExpiry, hodlChan1),
+		invoices.ResultTrampolineFeeInsufficient,
+	)
+}
+
+// TestForwarderInvalidOnion tests that htlcs whose trampoline onion we can't
+// peel, or that ask us to forward to another trampoline node, are failed.
+func TestForwarderInvalidOnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	hodlChan := make(chan interface{}, 1)
+
+	// An onion that was created for another node doesn't authenticate.
+	requireFailure(
+		t, ctx.notifyOnion(
+			1, testTotal, testExpiry, hodlChan,
+			ctx.onion(otherKey.PubKey()),
+		),
+		invoices.ResultTrampolineOnionInvalid,
+	)
+
+	// We only pay recipients that aren't trampoline nodes themselves.
+	requireFailure(
+		t, ctx.notifyOnion(
+			2, testTotal, testExpiry, hodlChan,
+			ctx.onion(ctx.nodeKey.PubKey(), otherKey.PubKey()),
+		),
+		invoices.ResultTrampolineOnionInvalid,
+	)
+
+	// Neither htlc was added to a set.
+	require.Empty(t, ctx.forwarder.sets)
+}
+
+// TestForwarderMppTimeout tests that the htlcs of an incomplete trampoline
+// payment are failed back after the mpp timeout.
+func TestForwarderMppTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	hodlChan := make(chan interface{}, 1)
+	require.Nil(t, ctx.notify(1, testHalf, testExpiry, hodlChan))
+
+	select {
+	case <-ctx.tickSignal:
+	case <-time.After(testTimeout):
+		t.Fatal("mpp timeout not started")
+	}
+
+	ctx.clock.SetTime(ctx.clock.Now().Add(DefaultMppTimeout))
+
+	requireFailure(
+		t, expectResolution(t, hodlChan), invoices.ResultMppTimeout,
+	)
+}
+
+// TestForwarderReplay tests that the resolution of an htlc whose link went
+// away is handed out once the htlc is replayed.
+func TestForwarderReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := newForwarderTestContext(t)
+
+	hodlChan1 := make(chan interface{}, 1)
+	hodlChan2 := make(chan interface{}, 1)
+
+	require.Nil(t, ctx.notify(1, testHalf, testExpiry, hodlChan1))
+	ctx.forwarder.HodlUnsubscribeAll(hodlChan1)
+	require.Nil(t, ctx.notify(2, testHalf, testExpiry, hodlChan2))
+
+	ctx.payer.expectPayment(t)
+
+	reason := channeldb.FailureReasonNoRoute
+	ctx.payer.updates <- &channeldb.MPPayment{
+		Status:        channeldb.StatusFailed,
+		FailureReason: &reason,
+	}
+
+	requireFailure(
+		t, expectResolution(t, hodlChan2),
+		invoices.ResultTrampolineFailed,
+	)
+
+	// The replayed htlc receives its resolution directly, after which the
+	// payment is forgotten.
+	requireFailure(
+		t, ctx.notify(1, testHalf, testExpiry, hodlChan1),
+		invoices.ResultTrampolineFailed,
+	)
+	ctx.forwarder.mu.Lock()
+	require.Empty(t, ctx.forwarder.sets)
+	ctx.forwarder.mu.Unlock()
+
+	require.Empty(t, hodlChan1)
+}
+
+// TestForwarderRestartInFlight tests that the htlcs of a trampoline payment
+// whose outgoing payment was launched before we restarted aren't failed back
+// when not all of them are replayed within the mpp timeout, and that they are
+// settled once the payment succeeds.
+func TestForwarderRestartInFlight(t *testing.T) {
+	t.Parallel()
+
+	// The forwarder starts with an empty memory, while the payment to the
+	// recipient is still in flight in the control tower.
+	ctx := newForwarderTestContext(t)
+	ctx.payer.initiated = true
+
+	hodlChan1 := make(chan interface{}, 1)
+	require.Nil(t, ctx.notify(1, testHalf, testExpiry, hodlChan1))
+
+	select {
+	case <-ctx.tickSignal:
+	case <-time.After(testTimeout):
+		t.Fatal("mpp timeout not started")
+	}
+
+	ctx.payer.updates <- &channeldb.MPPayment{
+		Status: channeldb.StatusInFlight,
+	}
+	ctx.clock.SetTime(ctx.clock.Now().Add(DefaultMppTimeout))
+
+	require.Eventually(t, func() bool {
+		ctx.forwarder.mu.Lock()
+		defer ctx.forwarder.mu.Unlock()
+
+		set, ok := ctx.forwarder.sets[testHash]
+
+		return ok && set.recovered
+	}, testTimeout, 10*time.Millisecond)
+
+	// The htlc of the peer that comes back late is held as well, and the
+	// payment isn't launched a second time.
+	hodlChan2 := make(chan interface{}, 1)
+	require.Nil(t, ctx.notify(2, testHalf, testExpiry, hodlChan2))
+	require.Empty(t, hodlChan1)
+	require.Empty(t, ctx.payer.payments)
+
+	ctx.payer.updates <- &channeldb.MPPayment{
+		Status: channeldb.StatusSucceeded,
+		HTLCs: []channeldb.HTLCAttempt{{
+			Settle: &channeldb.HTLCSettleInfo{
+				Preimage: testPreimage,
+			},
+		}},
+	}
+
+	for _, hodlChan := range []chan interface{}{hodlChan1, hodlChan2} {
+		resolution := expectResolution(t, hodlChan)
+
+		settle, ok := resolution.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, testPreimage, settle.Preimage)
+	}
+}
@@ -0,0 +1,269 @@
+package zpay32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxNumHopsPerPath is the maximum number of blinded hops that we
+	// allow in a single blinded payment path. The number of hops is
+	// encoded as a single byte, but the length of a tagged field limits
+	// the practical number of hops long before that.
+	maxNumHopsPerPath = 7
+
+	// maxCipherTextLength is the maximum length that we allow for the
+	// cipher text of a single blinded hop.
+	maxCipherTextLength = 255
+)
+
+// BlindedPaymentPath holds all the information a payer needs to know about a
+// blinded path to a receiver of a payment.
+type BlindedPaymentPath struct {
+	// FeeBaseMsat is the total base fee for the path in milli-satoshis.
+	FeeBaseMsat uint32
+
+	// FeeRate is the total fee rate for the path in parts per million.
+	FeeRate uint32
+
+	// CltvExpiryDelta is the total CLTV delta to apply to the path.
+	CltvExpiryDelta uint16
+
+	// HTLCMinMsat is the minimum number of milli-satoshis that any hop in
+	// the path will route.
+	HTLCMinMsat uint64
+
+	// HTLCMaxMsat is the maximum number of milli-satoshis that a hop in
+	// the path will route.
+	HTLCMaxMsat uint64
+
+	// Features is the feature bit vector for the path.
+	Features *lnwire.FeatureVector
+
+	// BlindedPath is the sphinx blinded path that payments along this
+	// path must use.
+	*sphinx.BlindedPath
+}
+
+// BlindedPath is a functional option that allows callers of NewInvoice to
+// attach a blinded payment path to the invoice. The option can be used
+// multiple times to add more than one path.
+func BlindedPath(path *BlindedPaymentPath) func(*Invoice) {
+	return func(i *Invoice) {
+		i.BlindedPaymentPaths = append(i.BlindedPaymentPaths, path)
+	}
+}
+
+// encode serializes the blinded payment path in its base256 form.
+func (p *BlindedPaymentPath) encode(w io.Writer) error {
+	var b [8]byte
+
+	binary.BigEndian.PutUint32(b[:4], p.FeeBaseMsat)
+	if _, err := w.Write(b[:4]); err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint32(b[:4], p.FeeRate)
+	if _, err := w.Write(b[:4]); err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint16(b[:2], p.CltvExpiryDelta)
+	if _, err := w.Write(b[:2]); err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint64(b[:], p.HTLCMinMsat)
+	if _, err := w.Write(b[:]); err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint64(b[:], p.HTLCMaxMsat)
+	if _, err := w.Write(b[:]); err != nil {
+		return err
+	}
+
+	features := lnwire.EmptyFeatureVector().RawFeatureVector
+	if p.Features != nil {
+		features = p.Features.RawFeatureVector
+	}
+	if err := features.Encode(w); err != nil {
+		return err
+	}
+
+	if len(p.BlindedHops) > maxNumHopsPerPath {
+		return fmt.Errorf("blinded path has %d hops, maximum is %d",
+			len(p.BlindedHops), maxNumHopsPerPath)
+	}
+
+	_, err := w.Write(p.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(p.BlindingPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte{byte(len(p.BlindedHops))}); err != nil {
+		return err
+	}
+
+	for _, hop := range p.BlindedHops {
+		if len(hop.CipherText) > maxCipherTextLength {
+			return fmt.Errorf("blinded hop cipher text of %d "+
+				"bytes exceeds maximum of %d",
+				len(hop.CipherText), maxCipherTextLength)
+		}
+
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		binary.BigEndian.PutUint16(b[:2], uint16(len(hop.CipherText)))
+		if _, err := w.Write(b[:2]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeBlindedPaymentPath parses a blinded payment path from its base256
+// form.
+func decodeBlindedPaymentPath(r io.Reader) (*BlindedPaymentPath, error) {
+	var (
+		path BlindedPaymentPath
+		b    [8]byte
+	)
+
+	if _, err := io.ReadFull(r, b[:4]); err != nil {
+		return nil, err
+	}
+	path.FeeBaseMsat = binary.BigEndian.Uint32(b[:4])
+
+	if _, err := io.ReadFull(r, b[:4]); err != nil {
+		return nil, err
+	}
+	path.FeeRate = binary.BigEndian.Uint32(b[:4])
+
+	if _, err := io.ReadFull(r, b[:2]); err != nil {
+		return nil, err
+	}
+	path.CltvExpiryDelta = binary.BigEndian.Uint16(b[:2])
+
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+	path.HTLCMinMsat = binary.BigEndian.Uint64(b[:])
+
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, err
+	}
+	path.HTLCMaxMsat = binary.BigEndian.Uint64(b[:])
+
+	rawFeatures := lnwire.NewRawFeatureVector()
+	if err := rawFeatures.Decode(r); err != nil {
+		return nil, err
+	}
+	path.Features = lnwire.NewFeatureVector(rawFeatures, lnwire.Features)
+
+	introductionPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	blindingPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return nil, err
+	}
+	numHops := int(b[0])
+	if numHops == 0 || numHops > maxNumHopsPerPath {
+		return nil, fmt.Errorf("invalid number of blinded hops: %d",
+			numHops)
+	}
+
+	path.BlindedPath = &sphinx.BlindedPath{
+		IntroductionPoint: introductionPoint,
+		BlindingPoint:     blindingPoint,
+		BlindedHops:       make([]*sphinx.BlindedHopInfo, numHops),
+	}
+
+	for i := 0; i < numHops; i++ {
+		nodePub, err := readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.ReadFull(r, b[:2]); err != nil {
+			return nil, err
+		}
+		cipherText := make([]byte, binary.BigEndian.Uint16(b[:2]))
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops[i] = &sphinx.BlindedHopInfo{
+			BlindedNodePub: nodePub,
+			CipherText:     cipherText,
+		}
+	}
+
+	return &path, nil
+}
+
+// readPubKey reads a 33-byte compressed public key from the passed reader.
+func readPubKey(r io.Reader) (*btcec.PublicKey, error) {
+	var keyBytes [btcec.PubKeyBytesLenCompressed]byte
+	if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(keyBytes[:])
+}
+
+// parseBlindedPaymentPath converts the data (encoded in base32) into a
+// blinded payment path.
+func parseBlindedPaymentPath(data []byte) (*BlindedPaymentPath, error) {
+	base256Data, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeBlindedPaymentPath(bytes.NewReader(base256Data))
+}
+
+// writeBlindedPaymentPath encodes the blinded payment path as base32 and
+// writes it to bufferBase32 as a tagged field.
+func writeBlindedPaymentPath(bufferBase32 *bytes.Buffer,
+	path *BlindedPaymentPath) error {
+
+	var b bytes.Buffer
+	if err := path.encode(&b); err != nil {
+		return err
+	}
+
+	base32, err := bech32.ConvertBits(b.Bytes(), 8, 5, true)
+	if err != nil {
+		return err
+	}
+
+	return writeTaggedField(bufferBase32, fieldTypeB, base32)
+}
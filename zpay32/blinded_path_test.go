@@ -0,0 +1,99 @@
+package zpay32
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// randBlindedPath creates a blinded payment path with the given number of hops
+// using freshly generated keys.
+func randBlindedPath(t *testing.T, numHops int) *BlindedPaymentPath {
+	t.Helper()
+
+	newKey := func() *btcec.PublicKey {
+		priv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return priv.PubKey()
+	}
+
+	hops := make([]*sphinx.BlindedHopInfo, numHops)
+	for i := range hops {
+		hops[i] = &sphinx.BlindedHopInfo{
+			BlindedNodePub: newKey(),
+			CipherText:     []byte{byte(i), 1, 2, 3, 4},
+		}
+	}
+
+	return &BlindedPaymentPath{
+		FeeBaseMsat:     1000,
+		FeeRate:         150,
+		CltvExpiryDelta: 160,
+		HTLCMinMsat:     1,
+		HTLCMaxMsat:     100_000_000,
+		Features: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(), lnwire.Features,
+		),
+		BlindedPath: &sphinx.BlindedPath{
+			IntroductionPoint: newKey(),
+			BlindingPoint:     newKey(),
+			BlindedHops:       hops,
+		},
+	}
+}
+
+// TestBlindedPaymentPathEncodeDecode tests that blinded payment paths added to
+// an invoice survive an encoding round trip.
+func TestBlindedPaymentPathEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	path1 := randBlindedPath(t, 1)
+	path2 := randBlindedPath(t, 3)
+
+	invoice, err := NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash, time.Unix(10, 0),
+		Description(testCupOfCoffee), PaymentAddr(specPaymentAddr),
+		BlindedPath(path1), BlindedPath(path2),
+	)
+	require.NoError(t, err)
+
+	encoded, err := invoice.Encode(testMessageSigner)
+	require.NoError(t, err)
+
+	decoded, err := Decode(encoded, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	require.Len(t, decoded.BlindedPaymentPaths, 2)
+	require.Equal(t, path1, decoded.BlindedPaymentPaths[0])
+	require.Equal(t, path2, decoded.BlindedPaymentPaths[1])
+}
+
+// TestBlindedPaymentPathValidation tests that an invoice can't have both route
+// hints and blinded payment paths.
+func TestBlindedPaymentPathValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash, time.Unix(10, 0),
+		Description(testCupOfCoffee), RouteHint(testSingleHop),
+		BlindedPath(randBlindedPath(t, 2)),
+	)
+	require.ErrorContains(t, err, "both route hints and blinded")
+
+	// A path with too many hops can't be encoded.
+	invoice, err := NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash, time.Unix(10, 0),
+		Description(testCupOfCoffee),
+		BlindedPath(randBlindedPath(t, maxNumHopsPerPath+1)),
+	)
+	require.NoError(t, err)
+
+	_, err = invoice.Encode(testMessageSigner)
+	require.Error(t, err)
+}
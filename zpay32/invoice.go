@@ -76,6 +76,11 @@ const (
 	// probing the recipient.
 	fieldTypeS = 16
 
+	// fieldTypeB contains blinded payment path information. This byte has
+	// been chosen arbitrarily since there is not yet a BOLT that defines
+	// the tag for blinded paths in BOLT 11 invoices.
+	fieldTypeB = 20
+
 	// maxInvoiceLength is the maximum total length an invoice can have.
 	// This is chosen to be the maximum number of bytes that can fit into a
 	// single QR code: https://en.wikipedia.org/wiki/QR_code#Storage
@@ -190,6 +195,13 @@ type Invoice struct {
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// BlindedPaymentPaths is a set of blinded paths that the payer can use
+	// to reach the receiver without learning its identity. If set, the
+	// invoice must not contain any route hints.
+	//
+	// NOTE: This is optional.
+	BlindedPaymentPaths []*BlindedPaymentPath
 }
 
 // Amount is a functional option that allows callers of NewInvoice to set the
@@ -385,5 +397,14 @@ func validateInvoice(invoice *Invoice) error {
 		return fmt.Errorf("missing feature vector")
 	}
 
+	// Route hints and blinded paths are two different ways of reaching
+	// the receiver, so an invoice may only contain one of them.
+	if len(invoice.RouteHints) != 0 &&
+		len(invoice.BlindedPaymentPaths) != 0 {
+
+		return fmt.Errorf("cannot have both route hints and blinded " +
+			"payment paths")
+	}
+
 	return nil
 }